@@ -0,0 +1,31 @@
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"sthl/constants"
+	"sthl/utils"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// AdminToken: operator routes only for requests carrying ADMIN_TOKEN as bearer token,
+// compared in constant time
+func AdminToken(l *zap.Logger, adminToken string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization := r.Header.Get("Authorization")
+			token, ok := strings.CutPrefix(authorization, "bearer ")
+			if !ok {
+				token, ok = strings.CutPrefix(authorization, "Bearer ")
+			}
+			if !ok || adminToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+				l.Info("invalid admin token")
+				utils.HttpErrorResponseSend(w, constants.ErrUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
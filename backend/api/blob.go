@@ -0,0 +1,54 @@
+package api
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"sthl/constants"
+	"sthl/storage"
+	"sthl/utils"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+// HandleGetBlob: public, object of store served by api, private object only by signed url
+func HandleGetBlob(l *zap.Logger, store storage.ServedBlobStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key, err := url.PathUnescape(chi.URLParam(r, "*"))
+		if err != nil {
+			utils.HttpErrorResponseSend(w, constants.ErrBadRequest)
+			return
+		}
+		body, info, err := store.Get(r.Context(), key)
+		if err != nil {
+			utils.HttpErrorResponseSend(w, err)
+			return
+		}
+		defer body.Close()
+		if !info.Public {
+			err = store.VerifySignedUrl(key, r.URL.Query())
+			if err != nil {
+				l.Info("fail to store.VerifySignedUrl", zap.String("key", key), zap.Error(err))
+				utils.HttpErrorResponseSend(w, err)
+				return
+			}
+		}
+
+		// served from api origin, never let browser run it as document
+		w.Header().Set("content-type", info.ContentType)
+		w.Header().Set("x-content-type-options", "nosniff")
+		w.Header().Set("content-security-policy", "default-src 'none'")
+		w.Header().Set("content-disposition", mime.FormatMediaType("inline", map[string]string{"filename": path.Base(key)}))
+		w.Header().Set("content-length", strconv.FormatInt(info.Size, 10))
+		w.Header().Set("last-modified", info.UpdatedAt.UTC().Format(http.TimeFormat))
+		w.WriteHeader(http.StatusOK)
+		_, err = io.Copy(w, body)
+		if err != nil {
+			l.Info("fail to write blob", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
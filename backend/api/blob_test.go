@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sthl/constants"
+	"sthl/logger"
+	"sthl/storage"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_HandleGetBlob: public object by url, private object only by signed url
+func Test_HandleGetBlob(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.TODO()
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NoError(err)
+	store := storage.NewMemoryBlobStore("http://localhost:4000", []byte("testsecret"))
+	r := chi.NewRouter()
+	r.Get(constants.BlobPath+"/*", HandleGetBlob(zapLogger, store))
+	get := func(rawUrl string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("GET", strings.TrimPrefix(rawUrl, "http://localhost:4000"), nil)
+		assert.NoError(err)
+		return executeHttpTestRequest(req, r)
+	}
+
+	publicUrl, err := store.Put(ctx, "owner/public", strings.NewReader("public"), "image/png", true)
+	assert.NoError(err)
+	privateUrl, err := store.Put(ctx, "owner/private", strings.NewReader("private"), "image/png", false)
+	assert.NoError(err)
+
+	// public object, query ignored
+	rr := get(publicUrl)
+	assert.Equal(http.StatusOK, rr.Code)
+	assert.Equal("public", rr.Body.String())
+	assert.Equal(http.StatusOK, get(publicUrl+"?expires=1&signature=x").Code)
+
+	// private object without or with forged signature
+	assert.Equal(http.StatusForbidden, get(privateUrl).Code)
+	assert.Equal(http.StatusForbidden, get(privateUrl+"?expires=9999999999&signature=x").Code)
+
+	// private object by signed url
+	signedUrl, err := store.SignedUrl(ctx, "owner/private", time.Minute)
+	assert.NoError(err)
+	rr = get(signedUrl)
+	assert.Equal(http.StatusOK, rr.Code)
+	assert.Equal("private", rr.Body.String())
+
+	// signed url of other key
+	signedPublicUrl, err := store.SignedUrl(ctx, "owner/public", time.Minute)
+	assert.NoError(err)
+	_, query, _ := strings.Cut(signedPublicUrl, "?")
+	assert.Equal(http.StatusForbidden, get(privateUrl+"?"+query).Code)
+
+	// missing object
+	assert.Equal(http.StatusNotFound, get(constants.BlobPath+"/owner/missing").Code)
+}
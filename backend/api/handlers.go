@@ -1,6 +1,8 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"os"
 	"sthl/constants"
@@ -9,6 +11,7 @@ import (
 	"sthl/utils"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"go.uber.org/zap"
 )
 
@@ -35,19 +38,64 @@ type IHandler interface {
 	HandleSignup(w http.ResponseWriter, r *http.Request)
 	HandleLogin(w http.ResponseWriter, r *http.Request)
 	HandleCheckUserExist(w http.ResponseWriter, r *http.Request)
+	HandleGetJwks(w http.ResponseWriter, r *http.Request)
+	HandleRefreshAccessToken(w http.ResponseWriter, r *http.Request)
+	HandleVerifyEmail(w http.ResponseWriter, r *http.Request)
+	HandleForgotPassword(w http.ResponseWriter, r *http.Request)
+	HandleResetPassword(w http.ResponseWriter, r *http.Request)
+	HandleVerifyMfaLogin(w http.ResponseWriter, r *http.Request)
 	HandleGetProducts(w http.ResponseWriter, r *http.Request)
 	HandleGetProductById(w http.ResponseWriter, r *http.Request)
 	HandleGetSiteUiByUserId(w http.ResponseWriter, r *http.Request)
+	HandleGetCategories(w http.ResponseWriter, r *http.Request)
+	HandleGetCollections(w http.ResponseWriter, r *http.Request)
 	// private
-	HandleRefreshAccessToken(w http.ResponseWriter, r *http.Request)
+	HandleLogout(w http.ResponseWriter, r *http.Request)
+	HandleLogoutAll(w http.ResponseWriter, r *http.Request)
+	HandleResendVerificationEmail(w http.ResponseWriter, r *http.Request)
+	HandleGetLoginLockouts(w http.ResponseWriter, r *http.Request)
 	HandleGetMe(w http.ResponseWriter, r *http.Request)
+	HandleGetUsage(w http.ResponseWriter, r *http.Request)
 	HandleUpdateUserPasswordById(w http.ResponseWriter, r *http.Request)
+	HandleSwitchShop(w http.ResponseWriter, r *http.Request)
+	HandleCreateApiKey(w http.ResponseWriter, r *http.Request)
+	HandleGetApiKeys(w http.ResponseWriter, r *http.Request)
+	HandleRevokeApiKey(w http.ResponseWriter, r *http.Request)
+	HandleEnrollTotp(w http.ResponseWriter, r *http.Request)
+	HandleConfirmTotp(w http.ResponseWriter, r *http.Request)
+	HandleDisableTotp(w http.ResponseWriter, r *http.Request)
+	HandleGetMyShops(w http.ResponseWriter, r *http.Request)
+	HandleGetShopMembers(w http.ResponseWriter, r *http.Request)
+	HandleInviteShopMember(w http.ResponseWriter, r *http.Request)
+	HandleAcceptShopInvite(w http.ResponseWriter, r *http.Request)
+	HandleUpdateShopMemberRole(w http.ResponseWriter, r *http.Request)
+	HandleRemoveShopMember(w http.ResponseWriter, r *http.Request)
+	HandleUpdateShopPricing(w http.ResponseWriter, r *http.Request)
+	HandleGetShopProducts(w http.ResponseWriter, r *http.Request)
+	HandleImportProducts(w http.ResponseWriter, r *http.Request)
+	HandleExportProducts(w http.ResponseWriter, r *http.Request)
 	HandleCreateProduct(w http.ResponseWriter, r *http.Request)
 	HandleUpdateProductById(w http.ResponseWriter, r *http.Request)
 	HandleDeleteProductById(w http.ResponseWriter, r *http.Request)
+	HandleGetInventoryMovements(w http.ResponseWriter, r *http.Request)
+	HandleAdjustProductQuantity(w http.ResponseWriter, r *http.Request)
+	HandleCreateProductVariant(w http.ResponseWriter, r *http.Request)
+	HandleUpdateProductVariantById(w http.ResponseWriter, r *http.Request)
+	HandleDeleteProductVariantById(w http.ResponseWriter, r *http.Request)
+	HandleAdjustProductVariantQuantity(w http.ResponseWriter, r *http.Request)
+	HandleSetProductCategories(w http.ResponseWriter, r *http.Request)
+	HandleSetProductMedia(w http.ResponseWriter, r *http.Request)
+	HandleCreateCategory(w http.ResponseWriter, r *http.Request)
+	HandleUpdateCategoryById(w http.ResponseWriter, r *http.Request)
+	HandleDeleteCategoryById(w http.ResponseWriter, r *http.Request)
+	HandleCreateCollection(w http.ResponseWriter, r *http.Request)
+	HandleUpdateCollectionById(w http.ResponseWriter, r *http.Request)
+	HandleDeleteCollectionById(w http.ResponseWriter, r *http.Request)
 	HandleCreateOrder(w http.ResponseWriter, r *http.Request)
+	HandleCreateShopOrder(w http.ResponseWriter, r *http.Request)
 	HandleGetOrders(w http.ResponseWriter, r *http.Request)
 	HandleGetOrderById(w http.ResponseWriter, r *http.Request)
+	HandleGetOrderEvents(w http.ResponseWriter, r *http.Request)
 	HandleUpdateOrderById(w http.ResponseWriter, r *http.Request)
 	HandleDeleteOrderById(w http.ResponseWriter, r *http.Request)
 	HandleUpsertSiteUiByUserId(w http.ResponseWriter, r *http.Request)
@@ -55,17 +103,23 @@ type IHandler interface {
 	HandleGetAlbumImgs(w http.ResponseWriter, r *http.Request)
 	HandleUpdateS3ImageDataById(w http.ResponseWriter, r *http.Request)
 
+	// operator
+	HandleUpdateUserPlanById(w http.ResponseWriter, r *http.Request)
+
 	// for test
 	HandleGetUsers(w http.ResponseWriter, r *http.Request)
 	HandleGetUserById(w http.ResponseWriter, r *http.Request)
 }
 type Handler struct {
-	logger     *zap.Logger
-	userSvc    service.IUserService
-	productSvc service.IProductService
-	orderSvc   service.IOrderService
-	siteuiSvc  service.ISiteUiService
-	albumSvc   service.IAlbumService
+	logger      *zap.Logger
+	userSvc     service.IUserService
+	productSvc  service.IProductService
+	orderSvc    service.IOrderService
+	siteuiSvc   service.ISiteUiService
+	albumSvc    service.IAlbumService
+	shopSvc     service.IShopService
+	categorySvc service.ICategoryService
+	usageSvc    service.IUsageService
 }
 
 func NewHandler(l *zap.Logger,
@@ -74,14 +128,20 @@ func NewHandler(l *zap.Logger,
 	orderSvc service.IOrderService,
 	siteuiSvc service.ISiteUiService,
 	albumSvc service.IAlbumService,
+	shopSvc service.IShopService,
+	categorySvc service.ICategoryService,
+	usageSvc service.IUsageService,
 ) IHandler {
 	return &Handler{
-		logger:     l,
-		userSvc:    userSvc,
-		productSvc: productSvc,
-		orderSvc:   orderSvc,
-		siteuiSvc:  siteuiSvc,
-		albumSvc:   albumSvc,
+		logger:      l,
+		userSvc:     userSvc,
+		productSvc:  productSvc,
+		orderSvc:    orderSvc,
+		siteuiSvc:   siteuiSvc,
+		albumSvc:    albumSvc,
+		shopSvc:     shopSvc,
+		categorySvc: categorySvc,
+		usageSvc:    usageSvc,
 	}
 }
 
@@ -113,8 +173,8 @@ func (h *Handler) HandleSignup(w http.ResponseWriter, r *http.Request) {
 
 // public: HandleLogin
 func (h *Handler) HandleLogin(w http.ResponseWriter, r *http.Request) {
-	// extract ctx from request
-	ctx := r.Context()
+	// extract ctx from request, with client ip for login throttle
+	ctx := context.WithValue(r.Context(), constants.ClientIpKey, utils.GetClientIp(r))
 
 	// extract request body
 	payload, err := utils.GetRequestBody[dto.LoginDto](r.Body)
@@ -154,18 +214,20 @@ func (h *Handler) HandleCheckUserExist(w http.ResponseWriter, r *http.Request) {
 	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
 }
 
-// private: HandleRefreshAccessToken
-func (h *Handler) HandleRefreshAccessToken(w http.ResponseWriter, r *http.Request) {
+// public: HandleGetJwks
+func (h *Handler) HandleGetJwks(w http.ResponseWriter, r *http.Request) {
 	// extract ctx from request
 	ctx := r.Context()
 
-	// extract AccessTokenInfo from ctx
-	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
-	if !ok {
-		h.logger.Info("fail to extract userInfo from ctx")
-		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
-		return
-	}
+	// call service to GetJwks
+	result := h.userSvc.GetJwks(ctx)
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// public: HandleRefreshAccessToken
+func (h *Handler) HandleRefreshAccessToken(w http.ResponseWriter, r *http.Request) {
+	// extract ctx from request
+	ctx := r.Context()
 
 	// extract request body
 	payload, err := utils.GetRequestBody[dto.RefreshAccessTokenDto](r.Body)
@@ -174,10 +236,9 @@ func (h *Handler) HandleRefreshAccessToken(w http.ResponseWriter, r *http.Reques
 		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
 		return
 	}
-	h.logger.Info("request body", zap.Any("payload", payload))
 
 	// call service to RefreshAccessToken
-	result, err := h.userSvc.RefreshAccessToken(ctx, authenticatedUserInfo, payload)
+	result, err := h.userSvc.RefreshAccessToken(ctx, payload)
 	if err != nil {
 		h.logger.Info("fail to userSvc.RefreshAccessToken", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
@@ -186,101 +247,100 @@ func (h *Handler) HandleRefreshAccessToken(w http.ResponseWriter, r *http.Reques
 	utils.ResponseSend(w, http.StatusOK, "ok", result)
 }
 
-// private: HandleGetMe
-func (h *Handler) HandleGetMe(w http.ResponseWriter, r *http.Request) {
+// public: HandleVerifyEmail
+func (h *Handler) HandleVerifyEmail(w http.ResponseWriter, r *http.Request) {
 	// extract ctx from request
 	ctx := r.Context()
 
-	// extract AccessTokenInfo from ctx
-	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
-	if !ok {
-		h.logger.Info("fail to extract userInfo from ctx")
-		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.VerifyEmailDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
 		return
 	}
 
-	// call service to GetUserById
-	result, err := h.userSvc.GetUserById(ctx, authenticatedUserInfo)
+	// call service to VerifyEmail
+	_, err = h.userSvc.VerifyEmail(ctx, payload)
 	if err != nil {
-		h.logger.Info("fail to userSvc.GetUserById", zap.Error(err))
+		h.logger.Info("fail to userSvc.VerifyEmail", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
-	utils.ResponseSend(w, http.StatusOK, "ok", result)
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
 }
 
-// private: HandleUpdateUserPasswordById
-func (h *Handler) HandleUpdateUserPasswordById(w http.ResponseWriter, r *http.Request) {
+// public: HandleForgotPassword
+func (h *Handler) HandleForgotPassword(w http.ResponseWriter, r *http.Request) {
 	// extract ctx from request
 	ctx := r.Context()
 
-	// extract AccessTokenInfo from ctx
-	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
-	if !ok {
-		h.logger.Info("fail to extract userInfo from ctx")
-		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
-		return
-	}
-
 	// extract request body
-	payload, err := utils.GetRequestBody[dto.UpdateUserPasswordDto](r.Body)
+	payload, err := utils.GetRequestBody[dto.ForgotPasswordDto](r.Body)
 	if err != nil {
 		h.logger.Info("fail to GetRequestBody", zap.Error(err))
 		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
 		return
 	}
-	h.logger.Info("request body", zap.Any("payload", payload))
 
-	// call service to UpdateUserPasswordById
-	result, err := h.userSvc.UpdateUserPasswordById(ctx, authenticatedUserInfo, payload)
+	// call service to ForgotPassword
+	_, err = h.userSvc.ForgotPassword(ctx, payload)
 	if err != nil {
-		h.logger.Info("fail to userSvc.UpdateUserPasswordById", zap.Error(err))
+		h.logger.Info("fail to userSvc.ForgotPassword", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
-	utils.ResponseSend(w, http.StatusOK, "ok", result)
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
 }
 
-// private: HandleGetUsers
-func (h *Handler) HandleGetUsers(w http.ResponseWriter, r *http.Request) {
-	// get request ctx
+// public: HandleResetPassword
+func (h *Handler) HandleResetPassword(w http.ResponseWriter, r *http.Request) {
+	// extract ctx from request
 	ctx := r.Context()
 
-	// extract paging
-	paging := dto.ExtractPaging(r)
-	payload := dto.NewQueryUsersDto(*paging)
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.ResetPasswordDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
 
-	result, err := h.userSvc.GetUsers(ctx, payload)
+	// call service to ResetPassword
+	_, err = h.userSvc.ResetPassword(ctx, payload)
 	if err != nil {
-		h.logger.Info("fail to userSvc.GetUsers", zap.Error(err))
+		h.logger.Info("fail to userSvc.ResetPassword", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
-	utils.ResponseSend(w, http.StatusOK, "ok", result)
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
 }
 
-// private: HandleGetUserById
-func (h *Handler) HandleGetUserById(w http.ResponseWriter, r *http.Request) {
-	// extract ctx from request
-	ctx := r.Context()
+// public: HandleVerifyMfaLogin
+func (h *Handler) HandleVerifyMfaLogin(w http.ResponseWriter, r *http.Request) {
+	// extract ctx from request, with client ip for login throttle
+	ctx := context.WithValue(r.Context(), constants.ClientIpKey, utils.GetClientIp(r))
 
-	// get url param
-	userIdParam := chi.URLParam(r, "userId")
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.VerifyMfaLoginDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
 
-	// call service to GetUserById
-	result, err := h.userSvc.GetUserById(ctx, userIdParam)
+	// call service to VerifyMfaLogin
+	result, err := h.userSvc.VerifyMfaLogin(ctx, payload)
 	if err != nil {
-		h.logger.Info("fail to userSvc.GetUserById", zap.Error(err))
+		h.logger.Info("fail to userSvc.VerifyMfaLogin", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
 	utils.ResponseSend(w, http.StatusOK, "ok", result)
 }
 
-// ****Product
-
-// private: HandleCreateProduct
-func (h *Handler) HandleCreateProduct(w http.ResponseWriter, r *http.Request) {
+// private: HandleLogout
+func (h *Handler) HandleLogout(w http.ResponseWriter, r *http.Request) {
 	// extract ctx from request
 	ctx := r.Context()
 
@@ -291,153 +351,120 @@ func (h *Handler) HandleCreateProduct(w http.ResponseWriter, r *http.Request) {
 		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
 		return
 	}
+
 	// extract request body
-	payload, err := utils.GetRequestBody[dto.CreateProductDto](r.Body)
+	payload, err := utils.GetRequestBody[dto.LogoutDto](r.Body)
 	if err != nil {
 		h.logger.Info("fail to GetRequestBody", zap.Error(err))
 		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
 		return
 	}
-	h.logger.Info("request body", zap.Any("payload", payload))
 
-	// call service to signup
-	result, err := h.productSvc.CreateProduct(ctx, authenticatedUserInfo, payload)
+	// call service to Logout
+	_, err = h.userSvc.Logout(ctx, authenticatedUserInfo, payload)
 	if err != nil {
-		h.logger.Info("fail to productSvc.CreateProduct", zap.Error(err))
+		h.logger.Info("fail to userSvc.Logout", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
-	utils.ResponseSend(w, http.StatusCreated, "created", result)
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
 }
 
-// public: HandleGetProducts
-func (h *Handler) HandleGetProducts(w http.ResponseWriter, r *http.Request) {
-	// get request ctx
+// private: HandleLogoutAll
+func (h *Handler) HandleLogoutAll(w http.ResponseWriter, r *http.Request) {
+	// extract ctx from request
 	ctx := r.Context()
 
-	// extract paging
-	paging := dto.ExtractPaging(r)
-	payload := dto.NewQueryProductsDto(*paging)
-
-	// get url param
-	userIdParam := chi.URLParam(r, "userId")
-
-	result, err := h.productSvc.GetPrdoucts(ctx, userIdParam, payload)
-	if err != nil {
-		h.logger.Info("fail to productSvc.GetPrdoucts", zap.Error(err))
-		utils.HttpErrorResponseSend(w, err)
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
 		return
 	}
-	utils.ResponseSend(w, http.StatusOK, "ok", result)
-}
-
-// public: HandleGetProductById
-func (h *Handler) HandleGetProductById(w http.ResponseWriter, r *http.Request) {
-	// get request ctx
-	ctx := r.Context()
-
-	// get url param
-	// userIdParam := chi.URLParam(r, "userId")
-	productIdParam := chi.URLParam(r, "productId")
 
-	result, err := h.productSvc.GetProductById(ctx, productIdParam)
+	// call service to LogoutAll
+	_, err := h.userSvc.LogoutAll(ctx, authenticatedUserInfo)
 	if err != nil {
-		h.logger.Info("fail to productSvc.GetProductById", zap.Error(err))
+		h.logger.Info("fail to userSvc.LogoutAll", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
-	utils.ResponseSend(w, http.StatusOK, "ok", result)
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
 }
 
-// private: HandleUpdateProductById
-func (h *Handler) HandleUpdateProductById(w http.ResponseWriter, r *http.Request) {
-	// get request ctx
+// private: HandleResendVerificationEmail
+func (h *Handler) HandleResendVerificationEmail(w http.ResponseWriter, r *http.Request) {
+	// extract ctx from request
 	ctx := r.Context()
 
-	// get url param
-	userIdParam := chi.URLParam(r, "userId")
-	productIdParam := chi.URLParam(r, "productId")
-
-	// extract request body
-	payload, err := utils.GetRequestBody[dto.UpdateProductDto](r.Body)
-	if err != nil {
-		h.logger.Info("fail to GetRequestBody", zap.Error(err))
-		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
 		return
 	}
-	h.logger.Info("request body", zap.Any("payload", payload))
 
-	result, err := h.productSvc.UpdateProductById(ctx, userIdParam, productIdParam, payload)
+	// call service to ResendVerificationEmail
+	_, err := h.userSvc.ResendVerificationEmail(ctx, authenticatedUserInfo)
 	if err != nil {
-		h.logger.Info("fail to productSvc.UpdateProductById", zap.Error(err))
+		h.logger.Info("fail to userSvc.ResendVerificationEmail", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
-	utils.ResponseSend(w, http.StatusOK, "ok", result)
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
 }
 
-// private: HandleDeleteProductById
-func (h *Handler) HandleDeleteProductById(w http.ResponseWriter, r *http.Request) {
-	// get request ctx
+// private: HandleGetLoginLockouts
+func (h *Handler) HandleGetLoginLockouts(w http.ResponseWriter, r *http.Request) {
+	// extract ctx from request
 	ctx := r.Context()
 
-	// get url param
-	userIdParam := chi.URLParam(r, "userId")
-	productIdParam := chi.URLParam(r, "productId")
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
 
-	_, err := h.productSvc.SoftDeleteProductById(ctx, userIdParam, productIdParam)
+	// call service to GetLoginLockouts
+	result, err := h.userSvc.GetLoginLockouts(ctx, authenticatedUserInfo)
 	if err != nil {
-		h.logger.Info("fail to productSvc.SoftDeleteProductById", zap.Error(err))
+		h.logger.Info("fail to userSvc.GetLoginLockouts", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
-	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
+	utils.ResponseSend(w, http.StatusOK, "ok", &result)
 }
 
-// ****Order
-
-// private: HandleCreateOrder
-func (h *Handler) HandleCreateOrder(w http.ResponseWriter, r *http.Request) {
-	// get request ctx
+// private: HandleGetMe
+func (h *Handler) HandleGetMe(w http.ResponseWriter, r *http.Request) {
+	// extract ctx from request
 	ctx := r.Context()
 
-	// // extract AccessTokenInfo from ctx
-	// authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
-	// if !ok {
-	// 	h.logger.Info("fail to extract userInfo from ctx")
-	// 	utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
-	// 	return
-	// }
-
-	userId := chi.URLParam(r, "userId")
-	if userId == "" {
-		h.logger.Info("fail to extract userId")
-		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
-		return
-	}
-
-	// extract request body
-	payload, err := utils.GetRequestBody[dto.CreateOrderDto](r.Body)
-	if err != nil {
-		h.logger.Info("fail to GetRequestBody", zap.Error(err))
-		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
 		return
 	}
-	h.logger.Info("request body", zap.Any("payload", payload))
 
-	// call service to signup
-	result, err := h.orderSvc.CreateOrder(ctx, userId, payload)
+	// call service to GetUserById
+	result, err := h.userSvc.GetUserById(ctx, authenticatedUserInfo)
 	if err != nil {
-		h.logger.Info("fail to orderSvc.CreateOrder", zap.Error(err))
+		h.logger.Info("fail to userSvc.GetUserById", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
-	utils.ResponseSend(w, http.StatusCreated, "created", result)
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
 }
 
-// private: HandleGetOrders
-func (h *Handler) HandleGetOrders(w http.ResponseWriter, r *http.Request) {
-	// get request ctx
+// private: HandleGetUsage
+func (h *Handler) HandleGetUsage(w http.ResponseWriter, r *http.Request) {
+	// extract ctx from request
 	ctx := r.Context()
 
 	// extract AccessTokenInfo from ctx
@@ -448,22 +475,19 @@ func (h *Handler) HandleGetOrders(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// extract paging
-	paging := dto.ExtractPaging(r)
-	payload := dto.NewQueryOrdersDto(*paging)
-
-	result, err := h.orderSvc.GetOrders(ctx, authenticatedUserInfo, payload)
+	// call service to GetUsage
+	result, err := h.usageSvc.GetUsage(ctx, authenticatedUserInfo)
 	if err != nil {
-		h.logger.Info("fail to orderSvc.GetOrders", zap.Error(err))
+		h.logger.Info("fail to usageSvc.GetUsage", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
 	utils.ResponseSend(w, http.StatusOK, "ok", result)
 }
 
-// private: HandleGetOrderById
-func (h *Handler) HandleGetOrderById(w http.ResponseWriter, r *http.Request) {
-	// get request ctx
+// private: HandleUpdateUserPasswordById
+func (h *Handler) HandleUpdateUserPasswordById(w http.ResponseWriter, r *http.Request) {
+	// extract ctx from request
 	ctx := r.Context()
 
 	// extract AccessTokenInfo from ctx
@@ -474,18 +498,27 @@ func (h *Handler) HandleGetOrderById(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	orderIdParam := chi.URLParam(r, "orderId")
-	result, err := h.orderSvc.GetOrderById(ctx, authenticatedUserInfo, orderIdParam)
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.UpdateUserPasswordDto](r.Body)
 	if err != nil {
-		h.logger.Info("fail to orderSvc.GetOrderById", zap.Error(err))
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	// call service to UpdateUserPasswordById
+	result, err := h.userSvc.UpdateUserPasswordById(ctx, authenticatedUserInfo, payload)
+	if err != nil {
+		h.logger.Info("fail to userSvc.UpdateUserPasswordById", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
 	utils.ResponseSend(w, http.StatusOK, "ok", result)
 }
 
-// private: HandleUpdateOrderById
-func (h *Handler) HandleUpdateOrderById(w http.ResponseWriter, r *http.Request) {
+// private: HandleCreateApiKey
+func (h *Handler) HandleCreateApiKey(w http.ResponseWriter, r *http.Request) {
 	// get request ctx
 	ctx := r.Context()
 
@@ -497,9 +530,8 @@ func (h *Handler) HandleUpdateOrderById(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	orderIdParam := chi.URLParam(r, "orderId")
 	// extract request body
-	payload, err := utils.GetRequestBody[dto.UpdateOrderDto](r.Body)
+	payload, err := utils.GetRequestBody[dto.CreateApiKeyDto](r.Body)
 	if err != nil {
 		h.logger.Info("fail to GetRequestBody", zap.Error(err))
 		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
@@ -507,17 +539,17 @@ func (h *Handler) HandleUpdateOrderById(w http.ResponseWriter, r *http.Request)
 	}
 	h.logger.Info("request body", zap.Any("payload", payload))
 
-	result, err := h.orderSvc.UpdateOrderById(ctx, authenticatedUserInfo, orderIdParam, payload)
+	result, err := h.userSvc.CreateApiKey(ctx, authenticatedUserInfo, payload)
 	if err != nil {
-		h.logger.Info("fail to orderSvc.GetOrderById", zap.Error(err))
+		h.logger.Info("fail to userSvc.CreateApiKey", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
-	utils.ResponseSend(w, http.StatusOK, "ok", result)
+	utils.ResponseSend(w, http.StatusCreated, "ok", result)
 }
 
-// private: HandleDeleteOrderById
-func (h *Handler) HandleDeleteOrderById(w http.ResponseWriter, r *http.Request) {
+// private: HandleGetApiKeys
+func (h *Handler) HandleGetApiKeys(w http.ResponseWriter, r *http.Request) {
 	// get request ctx
 	ctx := r.Context()
 
@@ -528,18 +560,1213 @@ func (h *Handler) HandleDeleteOrderById(w http.ResponseWriter, r *http.Request)
 		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
 		return
 	}
-	orderIdParam := chi.URLParam(r, "orderId")
 
-	result, err := h.orderSvc.SoftDeleteOrderById(ctx, authenticatedUserInfo, orderIdParam)
-	if err != nil || !result {
-		h.logger.Info("fail to orderSvc.SoftDeleteOrderById", zap.Error(err))
+	result, err := h.userSvc.GetApiKeys(ctx, authenticatedUserInfo)
+	if err != nil {
+		h.logger.Info("fail to userSvc.GetApiKeys", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
-	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
+	utils.ResponseSend(w, http.StatusOK, "ok", &result)
 }
 
-// ****Site
+// private: HandleRevokeApiKey
+func (h *Handler) HandleRevokeApiKey(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+	apiKeyIdParam := chi.URLParam(r, "apiKeyId")
+
+	_, err := h.userSvc.RevokeApiKey(ctx, authenticatedUserInfo, apiKeyIdParam)
+	if err != nil {
+		h.logger.Info("fail to userSvc.RevokeApiKey", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
+}
+
+// private: HandleEnrollTotp
+func (h *Handler) HandleEnrollTotp(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	result, err := h.userSvc.EnrollTotp(ctx, authenticatedUserInfo)
+	if err != nil {
+		h.logger.Info("fail to userSvc.EnrollTotp", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleConfirmTotp
+func (h *Handler) HandleConfirmTotp(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.ConfirmTotpDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+
+	result, err := h.userSvc.ConfirmTotp(ctx, authenticatedUserInfo, payload)
+	if err != nil {
+		h.logger.Info("fail to userSvc.ConfirmTotp", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleDisableTotp
+func (h *Handler) HandleDisableTotp(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.DisableTotpDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+
+	_, err = h.userSvc.DisableTotp(ctx, authenticatedUserInfo, payload)
+	if err != nil {
+		h.logger.Info("fail to userSvc.DisableTotp", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
+}
+
+// private: HandleSwitchShop
+func (h *Handler) HandleSwitchShop(w http.ResponseWriter, r *http.Request) {
+	// extract ctx from request
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.SwitchShopDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+
+	// call service to SwitchShop
+	result, err := h.userSvc.SwitchShop(ctx, authenticatedUserInfo, payload)
+	if err != nil {
+		h.logger.Info("fail to userSvc.SwitchShop", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleGetUsers
+func (h *Handler) HandleGetUsers(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract paging
+	paging := dto.ExtractPaging(r)
+	payload := dto.NewQueryUsersDto(*paging)
+
+	result, err := h.userSvc.GetUsers(ctx, payload)
+	if err != nil {
+		h.logger.Info("fail to userSvc.GetUsers", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleGetUserById
+func (h *Handler) HandleGetUserById(w http.ResponseWriter, r *http.Request) {
+	// extract ctx from request
+	ctx := r.Context()
+
+	// get url param
+	userIdParam := chi.URLParam(r, "userId")
+
+	// call service to GetUserById
+	result, err := h.userSvc.GetUserById(ctx, userIdParam)
+	if err != nil {
+		h.logger.Info("fail to userSvc.GetUserById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// operator: HandleUpdateUserPlanById
+func (h *Handler) HandleUpdateUserPlanById(w http.ResponseWriter, r *http.Request) {
+	// extract ctx from request
+	ctx := r.Context()
+
+	// get url param
+	userIdParam := chi.URLParam(r, "userId")
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.UpdateUserPlanDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+
+	// call service to UpdateUserPlanById
+	result, err := h.userSvc.UpdateUserPlanById(ctx, userIdParam, payload)
+	if err != nil {
+		h.logger.Info("fail to userSvc.UpdateUserPlanById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// ****Product
+
+// private: HandleCreateProduct
+func (h *Handler) HandleCreateProduct(w http.ResponseWriter, r *http.Request) {
+	// extract ctx from request
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.CreateProductDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	// call service to signup
+	result, err := h.productSvc.CreateProduct(ctx, authenticatedUserInfo, payload)
+	if err != nil {
+		h.logger.Info("fail to productSvc.CreateProduct", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusCreated, "created", result)
+}
+
+// public: HandleGetProducts
+func (h *Handler) HandleGetProducts(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract paging and filters
+	payload := dto.ExtractQueryProductsDto(r)
+
+	// get url param
+	userIdParam := chi.URLParam(r, "userId")
+
+	result, err := h.productSvc.GetPrdoucts(ctx, userIdParam, payload)
+	if err != nil {
+		h.logger.Info("fail to productSvc.GetPrdoucts", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleGetShopProducts
+func (h *Handler) HandleGetShopProducts(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// extract paging and filters
+	payload := dto.ExtractQueryProductsDto(r)
+
+	result, err := h.productSvc.GetShopProducts(ctx, authenticatedUserInfo, payload)
+	if err != nil {
+		h.logger.Info("fail to productSvc.GetShopProducts", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleImportProducts
+func (h *Handler) HandleImportProducts(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// extract csv file, optional mapping as json and dry run
+	err := r.ParseMultipartForm(constants.MaxFileSize)
+	if err != nil {
+		h.logger.Info("fail to r.ParseMultipartForm", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		h.logger.Info("fail to r.FormFile", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	defer file.Close()
+	mapping := map[string]string{}
+	if r.FormValue("mapping") != "" {
+		err = json.Unmarshal([]byte(r.FormValue("mapping")), &mapping)
+		if err != nil {
+			h.logger.Info("fail to json.Unmarshal mapping", zap.Error(err))
+			utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+			return
+		}
+	}
+	payload := dto.NewImportProductsDto(mapping, r.URL.Query().Get("dryRun") == "true")
+
+	result, err := h.productSvc.ImportProducts(ctx, authenticatedUserInfo, payload, file)
+	if err != nil {
+		h.logger.Info("fail to productSvc.ImportProducts", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleExportProducts
+func (h *Handler) HandleExportProducts(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// stream csv, error can only be sent before first write
+	w.Header().Set("content-type", "text/csv")
+	w.Header().Set("content-disposition", `attachment; filename="products.csv"`)
+	ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+	err := h.productSvc.ExportProducts(ctx, authenticatedUserInfo, ww)
+	if err != nil {
+		h.logger.Info("fail to productSvc.ExportProducts", zap.Error(err))
+		if ww.BytesWritten() == 0 {
+			w.Header().Set("content-type", "application/json")
+			w.Header().Del("content-disposition")
+			utils.HttpErrorResponseSend(w, err)
+		}
+	}
+}
+
+// public: HandleGetProductById
+func (h *Handler) HandleGetProductById(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// get url param
+	userIdParam := chi.URLParam(r, "userId")
+	productIdParam := chi.URLParam(r, "productId")
+
+	result, err := h.productSvc.GetProductById(ctx, userIdParam, productIdParam)
+	if err != nil {
+		h.logger.Info("fail to productSvc.GetProductById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.SetETag(w, result.Version)
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleUpdateProductById
+func (h *Handler) HandleUpdateProductById(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx, path userId checked by RequirePathUser
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// get url param
+	productIdParam := chi.URLParam(r, "productId")
+
+	// extract expected version from If-Match
+	version, err := utils.GetIfMatchVersion(r)
+	if err != nil {
+		h.logger.Info("fail to GetIfMatchVersion", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.UpdateProductDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.productSvc.UpdateProductById(ctx, authenticatedUserInfo, productIdParam, version, payload)
+	if err != nil {
+		h.logger.Info("fail to productSvc.UpdateProductById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.SetETag(w, result.Version)
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleDeleteProductById
+func (h *Handler) HandleDeleteProductById(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx, path userId checked by RequirePathUser
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// get url param
+	productIdParam := chi.URLParam(r, "productId")
+
+	// extract expected version from If-Match
+	version, err := utils.GetIfMatchVersion(r)
+	if err != nil {
+		h.logger.Info("fail to GetIfMatchVersion", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+
+	_, err = h.productSvc.SoftDeleteProductById(ctx, authenticatedUserInfo, productIdParam, version)
+	if err != nil {
+		h.logger.Info("fail to productSvc.SoftDeleteProductById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
+}
+
+// private: HandleGetInventoryMovements
+func (h *Handler) HandleGetInventoryMovements(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// extract paging
+	paging := dto.ExtractPaging(r)
+	payload := dto.NewQueryInventoryMovementsDto(*paging)
+
+	// get url param
+	productIdParam := chi.URLParam(r, "productId")
+
+	result, err := h.productSvc.GetInventoryMovements(ctx, authenticatedUserInfo, productIdParam, payload)
+	if err != nil {
+		h.logger.Info("fail to productSvc.GetInventoryMovements", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleAdjustProductQuantity
+func (h *Handler) HandleAdjustProductQuantity(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// get url param
+	productIdParam := chi.URLParam(r, "productId")
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.AdjustInventoryDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.productSvc.AdjustProductQuantityById(ctx, authenticatedUserInfo, productIdParam, payload)
+	if err != nil {
+		h.logger.Info("fail to productSvc.AdjustProductQuantityById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.SetETag(w, result.Version)
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleCreateProductVariant
+func (h *Handler) HandleCreateProductVariant(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// get url param
+	productIdParam := chi.URLParam(r, "productId")
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.CreateProductVariantDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.productSvc.CreateProductVariant(ctx, authenticatedUserInfo, productIdParam, payload)
+	if err != nil {
+		h.logger.Info("fail to productSvc.CreateProductVariant", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.SetETag(w, result.Version)
+	utils.ResponseSend(w, http.StatusCreated, "created", result)
+}
+
+// private: HandleUpdateProductVariantById
+func (h *Handler) HandleUpdateProductVariantById(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// get url param
+	productIdParam := chi.URLParam(r, "productId")
+	variantIdParam := chi.URLParam(r, "variantId")
+
+	// extract expected version from If-Match
+	version, err := utils.GetIfMatchVersion(r)
+	if err != nil {
+		h.logger.Info("fail to GetIfMatchVersion", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.UpdateProductVariantDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.productSvc.UpdateProductVariantById(ctx, authenticatedUserInfo, productIdParam, variantIdParam, version, payload)
+	if err != nil {
+		h.logger.Info("fail to productSvc.UpdateProductVariantById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.SetETag(w, result.Version)
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleDeleteProductVariantById
+func (h *Handler) HandleDeleteProductVariantById(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// get url param
+	productIdParam := chi.URLParam(r, "productId")
+	variantIdParam := chi.URLParam(r, "variantId")
+
+	// extract expected version from If-Match
+	version, err := utils.GetIfMatchVersion(r)
+	if err != nil {
+		h.logger.Info("fail to GetIfMatchVersion", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+
+	_, err = h.productSvc.SoftDeleteProductVariantById(ctx, authenticatedUserInfo, productIdParam, variantIdParam, version)
+	if err != nil {
+		h.logger.Info("fail to productSvc.SoftDeleteProductVariantById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
+}
+
+// private: HandleAdjustProductVariantQuantity
+func (h *Handler) HandleAdjustProductVariantQuantity(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// get url param
+	productIdParam := chi.URLParam(r, "productId")
+	variantIdParam := chi.URLParam(r, "variantId")
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.AdjustInventoryDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.productSvc.AdjustProductVariantQuantityById(ctx, authenticatedUserInfo, productIdParam, variantIdParam, payload)
+	if err != nil {
+		h.logger.Info("fail to productSvc.AdjustProductVariantQuantityById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.SetETag(w, result.Version)
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// ****Category
+
+// public: HandleGetCategories
+func (h *Handler) HandleGetCategories(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// get url param
+	userIdParam := chi.URLParam(r, "userId")
+
+	result, err := h.categorySvc.GetCategories(ctx, userIdParam)
+	if err != nil {
+		h.logger.Info("fail to categorySvc.GetCategories", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", &result)
+}
+
+// public: HandleGetCollections
+func (h *Handler) HandleGetCollections(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// get url param
+	userIdParam := chi.URLParam(r, "userId")
+
+	result, err := h.categorySvc.GetCollections(ctx, userIdParam)
+	if err != nil {
+		h.logger.Info("fail to categorySvc.GetCollections", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", &result)
+}
+
+// private: HandleSetProductCategories
+func (h *Handler) HandleSetProductCategories(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// get url param
+	productIdParam := chi.URLParam(r, "productId")
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.SetProductCategoriesDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.categorySvc.SetProductCategories(ctx, authenticatedUserInfo, productIdParam, payload)
+	if err != nil {
+		h.logger.Info("fail to categorySvc.SetProductCategories", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", dto.NewSetProductCategoriesDto(result))
+}
+
+// private: HandleSetProductMedia
+func (h *Handler) HandleSetProductMedia(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// get url param
+	productIdParam := chi.URLParam(r, "productId")
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.SetProductMediaDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.productSvc.SetProductMedia(ctx, authenticatedUserInfo, productIdParam, payload)
+	if err != nil {
+		h.logger.Info("fail to productSvc.SetProductMedia", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", &result)
+}
+
+// private: HandleCreateCategory
+func (h *Handler) HandleCreateCategory(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.CreateCategoryDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.categorySvc.CreateCategory(ctx, authenticatedUserInfo, payload)
+	if err != nil {
+		h.logger.Info("fail to categorySvc.CreateCategory", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.SetETag(w, result.Version)
+	utils.ResponseSend(w, http.StatusCreated, "created", result)
+}
+
+// private: HandleUpdateCategoryById
+func (h *Handler) HandleUpdateCategoryById(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// get url param
+	categoryIdParam := chi.URLParam(r, "categoryId")
+
+	// extract expected version from If-Match
+	version, err := utils.GetIfMatchVersion(r)
+	if err != nil {
+		h.logger.Info("fail to GetIfMatchVersion", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.UpdateCategoryDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.categorySvc.UpdateCategoryById(ctx, authenticatedUserInfo, categoryIdParam, version, payload)
+	if err != nil {
+		h.logger.Info("fail to categorySvc.UpdateCategoryById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.SetETag(w, result.Version)
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleDeleteCategoryById
+func (h *Handler) HandleDeleteCategoryById(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// get url param
+	categoryIdParam := chi.URLParam(r, "categoryId")
+
+	// extract expected version from If-Match
+	version, err := utils.GetIfMatchVersion(r)
+	if err != nil {
+		h.logger.Info("fail to GetIfMatchVersion", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+
+	err = h.categorySvc.DeleteCategoryById(ctx, authenticatedUserInfo, categoryIdParam, version)
+	if err != nil {
+		h.logger.Info("fail to categorySvc.DeleteCategoryById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
+}
+
+// private: HandleCreateCollection
+func (h *Handler) HandleCreateCollection(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.CreateCollectionDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.categorySvc.CreateCollection(ctx, authenticatedUserInfo, payload)
+	if err != nil {
+		h.logger.Info("fail to categorySvc.CreateCollection", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.SetETag(w, result.Version)
+	utils.ResponseSend(w, http.StatusCreated, "created", result)
+}
+
+// private: HandleUpdateCollectionById
+func (h *Handler) HandleUpdateCollectionById(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// get url param
+	collectionIdParam := chi.URLParam(r, "collectionId")
+
+	// extract expected version from If-Match
+	version, err := utils.GetIfMatchVersion(r)
+	if err != nil {
+		h.logger.Info("fail to GetIfMatchVersion", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.UpdateCollectionDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.categorySvc.UpdateCollectionById(ctx, authenticatedUserInfo, collectionIdParam, version, payload)
+	if err != nil {
+		h.logger.Info("fail to categorySvc.UpdateCollectionById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.SetETag(w, result.Version)
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleDeleteCollectionById
+func (h *Handler) HandleDeleteCollectionById(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// get url param
+	collectionIdParam := chi.URLParam(r, "collectionId")
+
+	// extract expected version from If-Match
+	version, err := utils.GetIfMatchVersion(r)
+	if err != nil {
+		h.logger.Info("fail to GetIfMatchVersion", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+
+	err = h.categorySvc.DeleteCollectionById(ctx, authenticatedUserInfo, collectionIdParam, version)
+	if err != nil {
+		h.logger.Info("fail to categorySvc.DeleteCollectionById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
+}
+
+// ****Order
+
+// private: HandleCreateOrder
+func (h *Handler) HandleCreateOrder(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// // extract AccessTokenInfo from ctx
+	// authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	// if !ok {
+	// 	h.logger.Info("fail to extract userInfo from ctx")
+	// 	utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+	// 	return
+	// }
+
+	userId := chi.URLParam(r, "userId")
+	if userId == "" {
+		h.logger.Info("fail to extract userId")
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.CreateOrderDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	// call service to signup
+	result, err := h.orderSvc.CreateOrder(ctx, userId, payload)
+	if err != nil {
+		h.logger.Info("fail to orderSvc.CreateOrder", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusCreated, "created", result)
+}
+
+// private: HandleCreateShopOrder
+func (h *Handler) HandleCreateShopOrder(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.CreateOrderDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.orderSvc.CreateShopOrder(ctx, authenticatedUserInfo, payload)
+	if err != nil {
+		h.logger.Info("fail to orderSvc.CreateShopOrder", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusCreated, "created", result)
+}
+
+// private: HandleGetOrders
+func (h *Handler) HandleGetOrders(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// extract paging
+	paging := dto.ExtractPaging(r)
+	payload := dto.NewQueryOrdersDto(*paging)
+
+	result, err := h.orderSvc.GetOrders(ctx, authenticatedUserInfo, payload)
+	if err != nil {
+		h.logger.Info("fail to orderSvc.GetOrders", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleGetOrderById
+func (h *Handler) HandleGetOrderById(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	orderIdParam := chi.URLParam(r, "orderId")
+	result, err := h.orderSvc.GetOrderById(ctx, authenticatedUserInfo, orderIdParam)
+	if err != nil {
+		h.logger.Info("fail to orderSvc.GetOrderById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.SetETag(w, result.Version)
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleGetOrderEvents
+func (h *Handler) HandleGetOrderEvents(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	orderIdParam := chi.URLParam(r, "orderId")
+	result, err := h.orderSvc.GetOrderEvents(ctx, authenticatedUserInfo, orderIdParam)
+	if err != nil {
+		h.logger.Info("fail to orderSvc.GetOrderEvents", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", &result)
+}
+
+// private: HandleUpdateOrderById
+func (h *Handler) HandleUpdateOrderById(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	orderIdParam := chi.URLParam(r, "orderId")
+	// extract expected version from If-Match
+	version, err := utils.GetIfMatchVersion(r)
+	if err != nil {
+		h.logger.Info("fail to GetIfMatchVersion", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.UpdateOrderDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.orderSvc.UpdateOrderById(ctx, authenticatedUserInfo, orderIdParam, version, payload)
+	if err != nil {
+		h.logger.Info("fail to orderSvc.GetOrderById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.SetETag(w, result.Version)
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleDeleteOrderById
+func (h *Handler) HandleDeleteOrderById(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+	orderIdParam := chi.URLParam(r, "orderId")
+
+	// extract expected version from If-Match
+	version, err := utils.GetIfMatchVersion(r)
+	if err != nil {
+		h.logger.Info("fail to GetIfMatchVersion", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+
+	result, err := h.orderSvc.SoftDeleteOrderById(ctx, authenticatedUserInfo, orderIdParam, version)
+	if err != nil || !result {
+		h.logger.Info("fail to orderSvc.SoftDeleteOrderById", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
+}
+
+// ****Site
 
 // public: HandleGetSiteUiByUserId
 func (h *Handler) HandleGetSiteUiByUserId(w http.ResponseWriter, r *http.Request) {
@@ -560,6 +1787,7 @@ func (h *Handler) HandleGetSiteUiByUserId(w http.ResponseWriter, r *http.Request
 		utils.HttpErrorResponseSend(w, err)
 		return
 	}
+	utils.SetETag(w, result.Version)
 	utils.ResponseSend(w, http.StatusOK, "ok", result)
 }
 
@@ -576,6 +1804,18 @@ func (h *Handler) HandleUpsertSiteUiByUserId(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// If-None-Match: * creates siteui, otherwise expected version from If-Match
+	var version int64
+	if !utils.IsIfNoneMatchAny(r) {
+		ifMatchVersion, err := utils.GetIfMatchVersion(r)
+		if err != nil {
+			h.logger.Info("fail to GetIfMatchVersion", zap.Error(err))
+			utils.HttpErrorResponseSend(w, err)
+			return
+		}
+		version = ifMatchVersion
+	}
+
 	// extract request body
 	payload, err := utils.GetRequestBody[dto.UpsertSiteUiDto](r.Body)
 	if err != nil {
@@ -584,7 +1824,7 @@ func (h *Handler) HandleUpsertSiteUiByUserId(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	result, err := h.siteuiSvc.UpsertSiteUiByUserId(ctx, authenticatedUserInfo, payload)
+	result, err := h.siteuiSvc.UpsertSiteUiByUserId(ctx, authenticatedUserInfo, version, payload)
 	if err != nil || !result {
 		h.logger.Info("fail to siteuiSvc.UpsertSiteUiByUserId", zap.Error(err))
 		utils.HttpErrorResponseSend(w, err)
@@ -642,3 +1882,196 @@ func (h *Handler) HandleUpdateS3ImageDataById(w http.ResponseWriter, r *http.Req
 	}
 	utils.ResponseSend(w, http.StatusOK, "ok", &result)
 }
+
+// ****Shop
+
+// private: HandleGetMyShops
+func (h *Handler) HandleGetMyShops(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	result, err := h.shopSvc.GetMyShops(ctx, authenticatedUserInfo)
+	if err != nil {
+		h.logger.Info("fail to shopSvc.GetMyShops", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", &result)
+}
+
+// private: HandleGetShopMembers
+func (h *Handler) HandleGetShopMembers(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	result, err := h.shopSvc.GetShopMembers(ctx, authenticatedUserInfo)
+	if err != nil {
+		h.logger.Info("fail to shopSvc.GetShopMembers", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", &result)
+}
+
+// private: HandleInviteShopMember
+func (h *Handler) HandleInviteShopMember(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.CreateShopInviteDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	_, err = h.shopSvc.InviteShopMember(ctx, authenticatedUserInfo, payload)
+	if err != nil {
+		h.logger.Info("fail to shopSvc.InviteShopMember", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend[any](w, http.StatusCreated, "ok", nil)
+}
+
+// private: HandleAcceptShopInvite
+func (h *Handler) HandleAcceptShopInvite(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.AcceptShopInviteDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+
+	result, err := h.shopSvc.AcceptShopInvite(ctx, authenticatedUserInfo, payload)
+	if err != nil {
+		h.logger.Info("fail to shopSvc.AcceptShopInvite", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleUpdateShopMemberRole
+func (h *Handler) HandleUpdateShopMemberRole(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	memberUserIdParam := chi.URLParam(r, "userId")
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.UpdateShopMemberDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.shopSvc.UpdateShopMemberRole(ctx, authenticatedUserInfo, memberUserIdParam, payload)
+	if err != nil {
+		h.logger.Info("fail to shopSvc.UpdateShopMemberRole", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
+
+// private: HandleRemoveShopMember
+func (h *Handler) HandleRemoveShopMember(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+	memberUserIdParam := chi.URLParam(r, "userId")
+
+	_, err := h.shopSvc.RemoveShopMember(ctx, authenticatedUserInfo, memberUserIdParam)
+	if err != nil {
+		h.logger.Info("fail to shopSvc.RemoveShopMember", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend[any](w, http.StatusOK, "ok", nil)
+}
+
+// private: HandleUpdateShopPricing
+func (h *Handler) HandleUpdateShopPricing(w http.ResponseWriter, r *http.Request) {
+	// get request ctx
+	ctx := r.Context()
+
+	// extract AccessTokenInfo from ctx
+	authenticatedUserInfo, ok := ctx.Value(constants.AccessTokenInfoKey).(string)
+	if !ok {
+		h.logger.Info("fail to extract userInfo from ctx")
+		utils.ResponseSend[any](w, http.StatusInternalServerError, "", nil)
+		return
+	}
+
+	// extract request body
+	payload, err := utils.GetRequestBody[dto.UpdateShopPricingDto](r.Body)
+	if err != nil {
+		h.logger.Info("fail to GetRequestBody", zap.Error(err))
+		utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+		return
+	}
+	h.logger.Info("request body", zap.Any("payload", payload))
+
+	result, err := h.shopSvc.UpdateShopPricing(ctx, authenticatedUserInfo, payload)
+	if err != nil {
+		h.logger.Info("fail to shopSvc.UpdateShopPricing", zap.Error(err))
+		utils.HttpErrorResponseSend(w, err)
+		return
+	}
+	utils.ResponseSend(w, http.StatusOK, "ok", result)
+}
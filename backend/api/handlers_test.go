@@ -5,19 +5,30 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"image"
+	"image/png"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"sthl/authentication"
 	"sthl/config"
+	"sthl/constants"
 	"sthl/dto"
 	"sthl/ent"
 	"sthl/logger"
+	"sthl/mailer"
+	"sthl/money"
 	"sthl/repository"
 	"sthl/service"
 	"sthl/storage"
 	"sthl/utils"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/go-chi/chi/v5"
@@ -66,6 +77,12 @@ func setTestEnv(t *testing.T) {
 
 // handlersTestSetup
 func handlersTestSetup(ctx context.Context, t *testing.T) (*assert.Assertions, *chi.Mux) {
+	assert, r, _ := handlersTestSetupWithMailer(ctx, t)
+	return assert, r
+}
+
+// handlersTestSetupWithMailer: expose log mailer to read sent tokens
+func handlersTestSetupWithMailer(ctx context.Context, t *testing.T) (*assert.Assertions, *chi.Mux, *mailer.LogMailer) {
 	assert := assert.New(t)
 	// zapLogger, err := logger.NewDevInfoZapLogger()
 	zapLogger, err := logger.NewDevErrorZapLogger()
@@ -78,6 +95,15 @@ func handlersTestSetup(ctx context.Context, t *testing.T) (*assert.Assertions, *
 	var orderRepo repository.IOrderRepository
 	var siteuiRepo repository.ISiteUiRepository
 	var imageInfoRepo repository.IImgInfoRepository
+	var refreshTokenRepo repository.IRefreshTokenRepository
+	var emailVerifyRepo repository.IEmailVerificationRepository
+	var pwResetRepo repository.IPasswordResetRepository
+	var loginAttemptRepo repository.ILoginAttemptRepository
+	var shopRepo repository.IShopRepository
+	var apiKeyRepo repository.IApiKeyRepository
+	var mfaRepo repository.IMfaRepository
+	var idempotencyRepo repository.IIdempotencyRepository
+	var categoryRepo repository.ICategoryRepository
 
 	// services
 	var userSvc service.IUserService
@@ -85,11 +111,20 @@ func handlersTestSetup(ctx context.Context, t *testing.T) (*assert.Assertions, *
 	var orderSvc service.IOrderService
 	var siteuiSvc service.ISiteUiService
 	var albumSvc service.IAlbumService
+	var shopSvc service.IShopService
+	var idempotencySvc service.IIdempotencyService
+	var categorySvc service.ICategoryService
+	var usageSvc service.IUsageService
 
 	setTestEnv(t)
 	cfg, ok := config.NewConfig(zapLogger)
 	assert.NotEmpty(cfg)
 	assert.Equal(true, ok)
+	keySet, err := authentication.NewKeySetFromConfig(zapLogger, cfg)
+	assert.NotEmpty(keySet)
+	assert.NoError(err)
+	logMailer := mailer.NewLogMailer(zapLogger, cfg.GetMailFrom(), cfg.GetMailLinkBaseUrl(), "")
+	blobStore := storage.NewMemoryBlobStore(cfg.GetBlobPublicUrl(), []byte("testsecret"))
 
 	if testing.Short() {
 		// case unit test
@@ -97,13 +132,27 @@ func handlersTestSetup(ctx context.Context, t *testing.T) (*assert.Assertions, *
 		productRepo = repository.NewProductRepositoryMock()
 		orderRepo = repository.NewOrderRepositoryMock()
 		siteuiRepo = nil
-		imageInfoRepo = nil
-
-		userSvc = service.NewUserService(zapLogger, nil, userRepo)
-		productSvc = service.NewProductService(zapLogger, nil, userRepo, productRepo)
-		orderSvc = service.NewOrderService(zapLogger, nil, userRepo, productRepo, orderRepo)
-		siteuiSvc = service.NewSiteUiService(zapLogger, nil, userRepo, siteuiRepo)
-		albumSvc = service.NewAlbumService(zapLogger, nil, nil, imageInfoRepo)
+		imageInfoRepo = repository.NewImgInfoRepositoryMock()
+
+		refreshTokenRepo = repository.NewRefreshTokenRepositoryMock()
+		emailVerifyRepo = repository.NewEmailVerificationRepositoryMock()
+		pwResetRepo = repository.NewPasswordResetRepositoryMock()
+		loginAttemptRepo = repository.NewLoginAttemptRepositoryMemory()
+		shopRepo = repository.NewShopRepositoryMock()
+		apiKeyRepo = repository.NewApiKeyRepositoryMock()
+		mfaRepo = repository.NewMfaRepositoryMock()
+		idempotencyRepo = repository.NewIdempotencyRepositoryMock()
+		categoryRepo = repository.NewCategoryRepositoryMock()
+
+		userSvc = service.NewUserService(zapLogger, nil, keySet, logMailer, userRepo, refreshTokenRepo, emailVerifyRepo, pwResetRepo, loginAttemptRepo, shopRepo, apiKeyRepo, mfaRepo)
+		productSvc = service.NewProductService(zapLogger, nil, userRepo, productRepo, shopRepo, categoryRepo, imageInfoRepo)
+		orderSvc = service.NewOrderService(zapLogger, nil, userRepo, productRepo, orderRepo, shopRepo)
+		siteuiSvc = service.NewSiteUiService(zapLogger, nil, userRepo, siteuiRepo, shopRepo)
+		albumSvc = service.NewAlbumService(zapLogger, nil, blobStore, userRepo, imageInfoRepo, shopRepo, productRepo, siteuiRepo)
+		shopSvc = service.NewShopService(zapLogger, nil, logMailer, userRepo, shopRepo)
+		idempotencySvc = service.NewIdempotencyService(zapLogger, nil, idempotencyRepo)
+		categorySvc = service.NewCategoryService(zapLogger, nil, productRepo, categoryRepo, shopRepo)
+		usageSvc = service.NewUsageService(zapLogger, nil, userRepo, productRepo, orderRepo, imageInfoRepo, shopRepo)
 	} else {
 		// case integration test
 
@@ -125,18 +174,32 @@ func handlersTestSetup(ctx context.Context, t *testing.T) (*assert.Assertions, *
 		productRepo = repository.NewProductRepository(zapLogger)
 		orderRepo = repository.NewOrderRepository(zapLogger)
 		siteuiRepo = nil
-		imageInfoRepo = nil
-
-		userSvc = service.NewUserService(zapLogger, dbclient, userRepo)
-		productSvc = service.NewProductService(zapLogger, dbclient, userRepo, productRepo)
-		orderSvc = service.NewOrderService(zapLogger, dbclient, userRepo, productRepo, orderRepo)
-		siteuiSvc = service.NewSiteUiService(zapLogger, dbclient, userRepo, siteuiRepo)
-		albumSvc = service.NewAlbumService(zapLogger, dbclient, nil, imageInfoRepo)
+		imageInfoRepo = repository.NewImgInfoRepository(zapLogger)
+
+		refreshTokenRepo = repository.NewRefreshTokenRepository(zapLogger)
+		emailVerifyRepo = repository.NewEmailVerificationRepository(zapLogger)
+		pwResetRepo = repository.NewPasswordResetRepository(zapLogger)
+		loginAttemptRepo = repository.NewLoginAttemptRepository(zapLogger)
+		shopRepo = repository.NewShopRepository(zapLogger)
+		apiKeyRepo = repository.NewApiKeyRepository(zapLogger)
+		mfaRepo = repository.NewMfaRepository(zapLogger)
+		idempotencyRepo = repository.NewIdempotencyRepository(zapLogger)
+		categoryRepo = repository.NewCategoryRepository(zapLogger)
+
+		userSvc = service.NewUserService(zapLogger, dbclient, keySet, logMailer, userRepo, refreshTokenRepo, emailVerifyRepo, pwResetRepo, loginAttemptRepo, shopRepo, apiKeyRepo, mfaRepo)
+		productSvc = service.NewProductService(zapLogger, dbclient, userRepo, productRepo, shopRepo, categoryRepo, imageInfoRepo)
+		orderSvc = service.NewOrderService(zapLogger, dbclient, userRepo, productRepo, orderRepo, shopRepo)
+		siteuiSvc = service.NewSiteUiService(zapLogger, dbclient, userRepo, siteuiRepo, shopRepo)
+		albumSvc = service.NewAlbumService(zapLogger, dbclient, blobStore, userRepo, imageInfoRepo, shopRepo, productRepo, siteuiRepo)
+		shopSvc = service.NewShopService(zapLogger, dbclient, logMailer, userRepo, shopRepo)
+		idempotencySvc = service.NewIdempotencyService(zapLogger, dbclient, idempotencyRepo)
+		categorySvc = service.NewCategoryService(zapLogger, dbclient, productRepo, categoryRepo, shopRepo)
+		usageSvc = service.NewUsageService(zapLogger, dbclient, userRepo, productRepo, orderRepo, imageInfoRepo, shopRepo)
 	}
 
-	hdlers := NewHandler(zapLogger, userSvc, productSvc, orderSvc, siteuiSvc, albumSvc)
-	r := NewChiRouter(zapLogger, cfg, userSvc, hdlers)
-	return assert, r
+	hdlers := NewHandler(zapLogger, userSvc, productSvc, orderSvc, siteuiSvc, albumSvc, shopSvc, categorySvc, usageSvc)
+	r := NewChiRouter(zapLogger, cfg, userSvc, idempotencySvc, blobStore, hdlers)
+	return assert, r, logMailer
 }
 
 // pre signup user
@@ -183,6 +246,16 @@ func preSignupLoginUser(assert *assert.Assertions, r *chi.Mux) (*authentication.
 	return validPp, validLoginUser, validUser
 }
 
+// sentMailToken: token of latest mail sent to email
+func sentMailToken(assert *assert.Assertions, logMailer *mailer.LogMailer, email string) string {
+	msg, ok := logMailer.LastSentTo(email)
+	assert.True(ok)
+	assert.NotEmpty(msg)
+	found := regexp.MustCompile(`token=([A-Za-z0-9_-]+)`).FindStringSubmatch(msg.Body)
+	assert.Len(found, 2)
+	return found[1]
+}
+
 // ****tests****
 
 // Test_HandlePing
@@ -339,7 +412,7 @@ func Test_HandleRefreshAccessToken(t *testing.T) {
 			},
 		},
 		{
-			name:        "login with invalid param, header token",
+			name:        "login with reused refresh token",
 			headerToken: "qwdqwdwdqf",
 			input:       dto.NewRefreshAccessTokenDto(&validPp.RefreshToken),
 			exec: func(rr *httptest.ResponseRecorder) {
@@ -377,6 +450,455 @@ func Test_HandleRefreshAccessToken(t *testing.T) {
 	}
 }
 
+// Test_HandleLogout
+type handleLogoutTestCase struct {
+	name        string
+	headerToken string
+	input       *dto.LogoutDto
+	exec        func(*httptest.ResponseRecorder)
+}
+
+func Test_HandleLogout(t *testing.T) {
+	ctx := context.TODO()
+	assert, r := handlersTestSetup(ctx, t)
+
+	validPp, _, _ := preSignupLoginUser(assert, r)
+
+	testCases := []handleLogoutTestCase{
+		{
+			name:        "logout with invalid param, header token",
+			headerToken: "qwdqwdwdqf",
+			input:       dto.NewLogoutDto(&validPp.RefreshToken),
+			exec: func(rr *httptest.ResponseRecorder) {
+				assert.Equal(http.StatusUnauthorized, rr.Code)
+			},
+		},
+		{
+			name:        "logout with invalid param, refresh token",
+			headerToken: validPp.AccessToken,
+			input:       dto.NewLogoutDto(utils.PtrOf("invalidrt")),
+			exec: func(rr *httptest.ResponseRecorder) {
+				assert.Equal(http.StatusBadRequest, rr.Code)
+			},
+		},
+		{
+			name:        "logout with valid param",
+			headerToken: validPp.AccessToken,
+			input:       dto.NewLogoutDto(&validPp.RefreshToken),
+			exec: func(rr *httptest.ResponseRecorder) {
+				var rs utils.ResponseMessage[any]
+				err := json.Unmarshal(rr.Body.Bytes(), &rs)
+				assert.NotEmpty(rs)
+				assert.NoError(err)
+				assert.Equal(http.StatusOK, rr.Code)
+
+				// refresh token of logged out session rejected
+				b := generateHttpTestRequestBody(assert, *dto.NewRefreshAccessTokenDto(&validPp.RefreshToken))
+				req, err := http.NewRequest("POST", "/api/v1/users/refreshToken", b)
+				assert.NoError(err)
+				assert.Equal(http.StatusUnauthorized, executeHttpTestRequest(req, r).Code)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			b := generateHttpTestRequestBody(assert, *test.input)
+			req, err := http.NewRequest("POST", "/api/v1/users/logout", b)
+			req.Header.Add("authorization", "bearer "+test.headerToken)
+			assert.NotEmpty(req)
+			assert.NoError(err)
+
+			test.exec(executeHttpTestRequest(req, r))
+		})
+	}
+}
+
+// Test_HandleVerifyEmail
+type handleVerifyEmailTestCase struct {
+	name  string
+	input *dto.VerifyEmailDto
+	exec  func(*httptest.ResponseRecorder)
+}
+
+func Test_HandleVerifyEmail(t *testing.T) {
+	ctx := context.TODO()
+	assert, r, logMailer := handlersTestSetupWithMailer(ctx, t)
+
+	validPp, validLoginUser, _ := preSignupLoginUser(assert, r)
+	validToken := sentMailToken(assert, logMailer, *validLoginUser.Email)
+
+	testCases := []handleVerifyEmailTestCase{
+		{
+			name:  "verify with invalid param, token",
+			input: dto.NewVerifyEmailDto(utils.PtrOf("invalidtoken")),
+			exec: func(rr *httptest.ResponseRecorder) {
+				assert.Equal(http.StatusBadRequest, rr.Code)
+			},
+		},
+		{
+			name:  "verify with valid param",
+			input: dto.NewVerifyEmailDto(&validToken),
+			exec: func(rr *httptest.ResponseRecorder) {
+				var rs utils.ResponseMessage[any]
+				err := json.Unmarshal(rr.Body.Bytes(), &rs)
+				assert.NotEmpty(rs)
+				assert.NoError(err)
+				assert.Equal(http.StatusOK, rr.Code)
+
+				// me is verified
+				req, err := http.NewRequest("GET", "/api/v1/users/me", nil)
+				req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+				assert.NoError(err)
+				meRr := executeHttpTestRequest(req, r)
+				var meRs utils.ResponseMessage[ent.User]
+				err = json.Unmarshal(meRr.Body.Bytes(), &meRs)
+				assert.NoError(err)
+				assert.True(meRs.Data.EmailVerified)
+			},
+		},
+		{
+			name:  "verify with used token",
+			input: dto.NewVerifyEmailDto(&validToken),
+			exec: func(rr *httptest.ResponseRecorder) {
+				assert.Equal(http.StatusBadRequest, rr.Code)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			b := generateHttpTestRequestBody(assert, *test.input)
+			req, err := http.NewRequest("POST", "/api/v1/users/verify-email", b)
+			assert.NotEmpty(req)
+			assert.NoError(err)
+
+			test.exec(executeHttpTestRequest(req, r))
+		})
+	}
+}
+
+// Test_HandleResendVerificationEmail
+func Test_HandleResendVerificationEmail(t *testing.T) {
+	ctx := context.TODO()
+	assert, r := handlersTestSetup(ctx, t)
+
+	validPp, _, _ := preSignupLoginUser(assert, r)
+
+	// throttled right after signup mail
+	req, err := http.NewRequest("POST", "/api/v1/users/verify-email/resend", nil)
+	req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+	assert.NoError(err)
+	rr := executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusTooManyRequests, rr.Code)
+
+	// unauthorized without token
+	req, err = http.NewRequest("POST", "/api/v1/users/verify-email/resend", nil)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusUnauthorized, rr.Code)
+}
+
+// Test_HandleLoginThrottle
+func Test_HandleLoginThrottle(t *testing.T) {
+	ctx := context.TODO()
+	assert, r := handlersTestSetup(ctx, t)
+
+	validPp, validLoginUser, _ := preSignupLoginUser(assert, r)
+
+	// wrong password until backoff
+	wrongLoginDto := dto.NewLoginDto(validLoginUser.Email, utils.PtrOf("wrongpassword"))
+	for i := 0; i < constants.LoginBackoffThreshold; i++ {
+		b := generateHttpTestRequestBody(assert, *wrongLoginDto)
+		req, err := http.NewRequest("POST", "/api/v1/users/login", b)
+		assert.NoError(err)
+		rr := executeHttpTestRequest(req, r)
+		assert.Equal(http.StatusBadRequest, rr.Code)
+	}
+
+	// rejected within backoff even with correct password
+	b := generateHttpTestRequestBody(assert, *validLoginUser)
+	req, err := http.NewRequest("POST", "/api/v1/users/login", b)
+	assert.NoError(err)
+	rr := executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusTooManyRequests, rr.Code)
+
+	// no lockout yet
+	req, err = http.NewRequest("GET", "/api/v1/users/me/lockouts", nil)
+	req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+	var rs utils.ResponseMessage[[]*ent.LoginLockoutEvent]
+	err = json.Unmarshal(rr.Body.Bytes(), &rs)
+	assert.NoError(err)
+	assert.NotNil(rs.Data)
+	assert.Len(*rs.Data, 0)
+
+	// unauthorized without token
+	req, err = http.NewRequest("GET", "/api/v1/users/me/lockouts", nil)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusUnauthorized, rr.Code)
+}
+
+// Test_HandleForgotResetPassword
+func Test_HandleForgotResetPassword(t *testing.T) {
+	ctx := context.TODO()
+	assert, r, logMailer := handlersTestSetupWithMailer(ctx, t)
+
+	_, validLoginUser, _ := preSignupLoginUser(assert, r)
+
+	// forgot with unregistered and registered email, same response
+	for _, email := range []string{gofakeit.Email(), *validLoginUser.Email} {
+		b := generateHttpTestRequestBody(assert, *dto.NewForgotPasswordDto(&email))
+		req, err := http.NewRequest("POST", "/api/v1/users/password/forgot", b)
+		assert.NoError(err)
+		rr := executeHttpTestRequest(req, r)
+		assert.Equal(http.StatusOK, rr.Code)
+	}
+	resetToken := sentMailToken(assert, logMailer, *validLoginUser.Email)
+	newPw := gofakeit.Password(true, true, true, true, false, 8)
+
+	// reset with invalid token
+	b := generateHttpTestRequestBody(assert, *dto.NewResetPasswordDto(utils.PtrOf("invalidtoken"), &newPw))
+	req, err := http.NewRequest("POST", "/api/v1/users/password/reset", b)
+	assert.NoError(err)
+	rr := executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusBadRequest, rr.Code)
+
+	// reset with valid token
+	b = generateHttpTestRequestBody(assert, *dto.NewResetPasswordDto(&resetToken, &newPw))
+	req, err = http.NewRequest("POST", "/api/v1/users/password/reset", b)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+
+	// login with new password
+	preLoginUser(assert, r, dto.NewLoginDto(validLoginUser.Email, &newPw))
+}
+
+// Test_HandleShopStaff
+func Test_HandleShopStaff(t *testing.T) {
+	ctx := context.TODO()
+	assert, r, logMailer := handlersTestSetupWithMailer(ctx, t)
+
+	ownerPp, _, _ := preSignupLoginUser(assert, r)
+	staffPp, staffLogin, _ := preSignupLoginUser(assert, r)
+
+	// owner invites staff as fulfilment
+	b := generateHttpTestRequestBody(assert, *dto.NewCreateShopInviteDto(staffLogin.Email, utils.PtrOf(constants.ShopRole.Fulfilment)))
+	req, err := http.NewRequest("POST", "/api/v1/shops/invites", b)
+	req.Header.Add("authorization", "bearer "+ownerPp.AccessToken)
+	assert.NoError(err)
+	rr := executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusCreated, rr.Code)
+	inviteToken := sentMailToken(assert, logMailer, *staffLogin.Email)
+
+	// staff accepts
+	b = generateHttpTestRequestBody(assert, *dto.NewAcceptShopInviteDto(&inviteToken))
+	req, err = http.NewRequest("POST", "/api/v1/shops/invites/accept", b)
+	req.Header.Add("authorization", "bearer "+staffPp.AccessToken)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+	var memberRs utils.ResponseMessage[ent.ShopMember]
+	err = json.Unmarshal(rr.Body.Bytes(), &memberRs)
+	assert.NoError(err)
+	assert.NotEmpty(memberRs.Data)
+
+	// staff lists own and joined shop
+	req, err = http.NewRequest("GET", "/api/v1/shops", nil)
+	req.Header.Add("authorization", "bearer "+staffPp.AccessToken)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+	var shopsRs utils.ResponseMessage[[]*dto.ShopMembershipResponseDto]
+	err = json.Unmarshal(rr.Body.Bytes(), &shopsRs)
+	assert.NoError(err)
+	assert.Len(*shopsRs.Data, 2)
+
+	// staff switches to shop
+	b = generateHttpTestRequestBody(assert, *dto.NewSwitchShopDto(utils.PtrOf(memberRs.Data.ShopID.String())))
+	req, err = http.NewRequest("POST", "/api/v1/users/me/shop", b)
+	req.Header.Add("authorization", "bearer "+staffPp.AccessToken)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+	var switchedRs utils.ResponseMessage[authentication.Passport]
+	err = json.Unmarshal(rr.Body.Bytes(), &switchedRs)
+	assert.NoError(err)
+	assert.NotEmpty(switchedRs.Data)
+
+	// fulfilment cannot create product or manage staff in shop
+	b = generateHttpTestRequestBody(assert, *dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+		utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil))
+	req, err = http.NewRequest("POST", "/api/v1/products", b)
+	req.Header.Add("authorization", "bearer "+switchedRs.Data.AccessToken)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusForbidden, rr.Code)
+	req, err = http.NewRequest("GET", "/api/v1/shops/members", nil)
+	req.Header.Add("authorization", "bearer "+switchedRs.Data.AccessToken)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusForbidden, rr.Code)
+
+	// fulfilment cannot act as owner by owner id in path
+	product := preCreateProductByToken(assert, r, ownerPp.AccessToken)
+	b = generateHttpTestRequestBody(assert, *dto.NewUpdateProductDto(
+		&product.Name, &product.Price, nil, utils.PtrOf("edited"), &product.Status, &product.ImgURL))
+	req, err = http.NewRequest("PUT", fmt.Sprintf("/api/v1/products/%s/%s", product.UserID, product.ID), b)
+	req.Header.Add("authorization", "bearer "+switchedRs.Data.AccessToken)
+	req.Header.Add("if-match", utils.FormatETag(product.Version))
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusForbidden, rr.Code)
+
+	// owner lists members
+	req, err = http.NewRequest("GET", "/api/v1/shops/members", nil)
+	req.Header.Add("authorization", "bearer "+ownerPp.AccessToken)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+}
+
+// Test_HandleApiKey
+func Test_HandleApiKey(t *testing.T) {
+	ctx := context.TODO()
+	assert, r := handlersTestSetup(ctx, t)
+
+	validPp, _, _ := preSignupLoginUser(assert, r)
+
+	// create key with orders:read only
+	b := generateHttpTestRequestBody(assert, *dto.NewCreateApiKeyDto(
+		utils.PtrOf("integration"), []string{constants.ApiKeyScope.OrdersRead}, nil))
+	req, err := http.NewRequest("POST", "/api/v1/users/me/apikeys", b)
+	req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+	assert.NoError(err)
+	rr := executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusCreated, rr.Code)
+	var createdRs utils.ResponseMessage[dto.CreateApiKeyResponseDto]
+	err = json.Unmarshal(rr.Body.Bytes(), &createdRs)
+	assert.NoError(err)
+	assert.NotEmpty(createdRs.Data.Key)
+	assert.NotContains(rr.Body.String(), "key_hash")
+
+	// key reads orders
+	req, err = http.NewRequest("GET", "/api/v1/orders", nil)
+	req.Header.Add(constants.ApiKeyHeader, createdRs.Data.Key)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+
+	// key without scope, session only route and unknown key
+	req, err = http.NewRequest("DELETE", "/api/v1/orders/"+uuid.NewString(), nil)
+	req.Header.Add(constants.ApiKeyHeader, createdRs.Data.Key)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusForbidden, rr.Code)
+	req, err = http.NewRequest("GET", "/api/v1/users/me/apikeys", nil)
+	req.Header.Add(constants.ApiKeyHeader, createdRs.Data.Key)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusForbidden, rr.Code)
+	req, err = http.NewRequest("GET", "/api/v1/orders", nil)
+	req.Header.Add(constants.ApiKeyHeader, createdRs.Data.Key+"x")
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusUnauthorized, rr.Code)
+
+	// session lists keys with last used
+	req, err = http.NewRequest("GET", "/api/v1/users/me/apikeys", nil)
+	req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+	var keysRs utils.ResponseMessage[[]*ent.ApiKey]
+	err = json.Unmarshal(rr.Body.Bytes(), &keysRs)
+	assert.NoError(err)
+	assert.Len(*keysRs.Data, 1)
+	assert.NotNil((*keysRs.Data)[0].LastUsedAt)
+
+	// revoked key no longer authenticates
+	req, err = http.NewRequest("DELETE", "/api/v1/users/me/apikeys/"+createdRs.Data.ApiKey.ID.String(), nil)
+	req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+	req, err = http.NewRequest("GET", "/api/v1/orders", nil)
+	req.Header.Add(constants.ApiKeyHeader, createdRs.Data.Key)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusUnauthorized, rr.Code)
+}
+
+// Test_HandleMfaLogin
+func Test_HandleMfaLogin(t *testing.T) {
+	ctx := context.TODO()
+	assert, r := handlersTestSetup(ctx, t)
+
+	validPp, validLogin, _ := preSignupLoginUser(assert, r)
+
+	// enroll and confirm
+	req, err := http.NewRequest("POST", "/api/v1/users/me/mfa/totp", nil)
+	req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+	assert.NoError(err)
+	rr := executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+	var enrolRs utils.ResponseMessage[dto.TotpEnrolmentResponseDto]
+	err = json.Unmarshal(rr.Body.Bytes(), &enrolRs)
+	assert.NoError(err)
+	step := authentication.TotpStep(time.Now())
+	code, err := authentication.TotpCode(enrolRs.Data.Secret, step-1)
+	assert.NoError(err)
+	b := generateHttpTestRequestBody(assert, *dto.NewConfirmTotpDto(&code))
+	req, err = http.NewRequest("POST", "/api/v1/users/me/mfa/totp/confirm", b)
+	req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+
+	// login now needs second step
+	pending := preLoginUser(assert, r, validLogin)
+	assert.NotEmpty(pending.MfaToken)
+	assert.Empty(pending.AccessToken)
+	code, err = authentication.TotpCode(enrolRs.Data.Secret, step)
+	assert.NoError(err)
+	b = generateHttpTestRequestBody(assert, *dto.NewVerifyMfaLoginDto(&pending.MfaToken, &code))
+	req, err = http.NewRequest("POST", "/api/v1/users/login/mfa", b)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+	var ppRs utils.ResponseMessage[authentication.Passport]
+	err = json.Unmarshal(rr.Body.Bytes(), &ppRs)
+	assert.NoError(err)
+	assert.NotEmpty(ppRs.Data.AccessToken)
+
+	// mfa token is not an access token
+	req, err = http.NewRequest("GET", "/api/v1/users/me", nil)
+	req.Header.Add("authorization", "bearer "+pending.MfaToken)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusUnauthorized, rr.Code)
+
+	// disable needs password and code
+	code, err = authentication.TotpCode(enrolRs.Data.Secret, step+1)
+	assert.NoError(err)
+	b = generateHttpTestRequestBody(assert, *dto.NewDisableTotpDto(validLogin.Password, &code))
+	req, err = http.NewRequest("POST", "/api/v1/users/me/mfa/totp/disable", b)
+	req.Header.Add("authorization", "bearer "+ppRs.Data.AccessToken)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+	assert.NotEmpty(preLoginUser(assert, r, validLogin).AccessToken)
+}
+
 // Test_HandleGetMe
 type handleGetMeTestCase struct {
 	name        string
@@ -427,6 +949,108 @@ func Test_HandleGetMe(t *testing.T) {
 	}
 }
 
+// Test_HandleGetUsage
+func Test_HandleGetUsage(t *testing.T) {
+	ctx := context.TODO()
+	assert, r := handlersTestSetup(ctx, t)
+
+	validPp, _, _ := preSignupLoginUser(assert, r)
+	req, err := http.NewRequest("POST", "/api/v1/products", generateHttpTestRequestBody(assert, dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+		utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil)))
+	assert.NoError(err)
+	req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+	assert.Equal(http.StatusCreated, executeHttpTestRequest(req, r).Code)
+
+	// usage against free plan
+	req, err = http.NewRequest("GET", "/api/v1/users/me/usage", nil)
+	assert.NoError(err)
+	req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+	rr := executeHttpTestRequest(req, r)
+	var rs utils.ResponseMessage[dto.UsageResponseDto]
+	assert.NoError(json.Unmarshal(rr.Body.Bytes(), &rs))
+	assert.Equal(http.StatusOK, rr.Code)
+	limit := constants.Plan.GetLimit(constants.Plan.Free)
+	assert.Equal(constants.Plan.Free, rs.Data.Plan)
+	assert.Equal(dto.QuotaDto{Used: 1, Limit: int64(limit.Products)}, rs.Data.Products)
+	assert.Equal(dto.QuotaDto{Used: 0, Limit: int64(limit.AlbumImgs)}, rs.Data.AlbumImgs)
+	assert.Equal(dto.QuotaDto{Used: 0, Limit: limit.StorageBytes}, rs.Data.StorageBytes)
+	assert.Equal(dto.QuotaDto{Used: 0, Limit: int64(limit.MonthlyOrders)}, rs.Data.MonthlyOrders)
+	assert.Equal(1, rs.Data.PeriodStart.Day())
+
+	// unauthorized
+	req, err = http.NewRequest("GET", "/api/v1/users/me/usage", nil)
+	assert.NoError(err)
+	req.Header.Add("authorization", "bearer "+validPp.AccessToken+"wrong")
+	assert.Equal(http.StatusUnauthorized, executeHttpTestRequest(req, r).Code)
+}
+
+// Test_HandleUploadAlbumImage
+func Test_HandleUploadAlbumImage(t *testing.T) {
+	ctx := context.TODO()
+	assert, r := handlersTestSetup(ctx, t)
+
+	validPp, _, _ := preSignupLoginUser(assert, r)
+	uploadImg := func(filename string, contentType string, img []byte) *http.Request {
+		b := &bytes.Buffer{}
+		form := multipart.NewWriter(b)
+		part, err := form.CreatePart(map[string][]string{
+			"Content-Disposition": {fmt.Sprintf(`form-data; name="file"; filename="%s"`, filename)},
+			"Content-Type":        {contentType},
+		})
+		assert.NoError(err)
+		_, err = part.Write(img)
+		assert.NoError(err)
+		assert.NoError(form.Close())
+		req, err := http.NewRequest("POST", "/api/v1/album", b)
+		assert.NoError(err)
+		req.Header.Set("content-type", form.FormDataContentType())
+		req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+		return req
+	}
+
+	// html or svg claimed as image rejected by sniffed content type
+	for i, body := range []string{
+		"<!DOCTYPE html><html><script>alert(1)</script></html>",
+		`<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`,
+	} {
+		req := uploadImg(fmt.Sprintf("evil%d.png", i), "image/png", []byte(body))
+		assert.Equal(http.StatusBadRequest, executeHttpTestRequest(req, r).Code)
+	}
+
+	// png stored with sniffed content type whatever client claims
+	buf := &bytes.Buffer{}
+	assert.NoError(png.Encode(buf, image.NewRGBA(image.Rect(0, 0, 2, 2))))
+	img := buf.Bytes()
+	req := uploadImg("cover.png", "text/html", img)
+	rr := executeHttpTestRequest(req, r)
+	var rs utils.ResponseMessage[ent.Imageinfo]
+	assert.NoError(json.Unmarshal(rr.Body.Bytes(), &rs))
+	assert.Equal(http.StatusCreated, rr.Code)
+	assert.Equal(int64(len(img)), rs.Data.ImgSize)
+	assert.Regexp("^http://localhost:4000"+constants.BlobPath+"/", rs.Data.ImgURL)
+
+	// uploaded image served by api
+	req, err := http.NewRequest("GET", strings.TrimPrefix(rs.Data.ImgURL, "http://localhost:4000"), nil)
+	assert.NoError(err)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+	assert.Equal("image/png", rr.Header().Get("content-type"))
+	assert.Equal("nosniff", rr.Header().Get("x-content-type-options"))
+	assert.Equal("default-src 'none'", rr.Header().Get("content-security-policy"))
+	assert.Regexp("^inline; filename=", rr.Header().Get("content-disposition"))
+	assert.Equal(img, rr.Body.Bytes())
+
+	// missing object
+	req, err = http.NewRequest("GET", constants.BlobPath+"/"+uuid.NewString(), nil)
+	assert.NoError(err)
+	assert.Equal(http.StatusNotFound, executeHttpTestRequest(req, r).Code)
+}
+
 // Test_HandleUpdateUserPasswordById
 type handleUpdateUserPasswordByIdTestCase struct {
 	name        string
@@ -631,10 +1255,11 @@ func Test_HandleCreateProduct(t *testing.T) {
 	validPp, _, _ := preSignupLoginUser(assert, r)
 	validCreateProductDto := dto.NewCreateProductDto(
 		utils.PtrOf(gofakeit.Fruit()),
-		utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 		utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 		utils.PtrOf(gofakeit.LetterN(100)),
-		utils.PtrOf(gofakeit.LetterN(100)))
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil)
 
 	testCases := []handleCreateProductTestCase{
 		{
@@ -675,6 +1300,58 @@ func Test_HandleCreateProduct(t *testing.T) {
 	}
 }
 
+// preCreateProductByToken
+func preCreateProductByToken(assert *assert.Assertions, r *chi.Mux, token string) *ent.Product {
+	b := generateHttpTestRequestBody(assert, *dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+		utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil))
+	req, err := http.NewRequest("POST", "/api/v1/products", b)
+	assert.NoError(err)
+	req.Header.Add("authorization", "bearer "+token)
+	rr := executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusCreated, rr.Code)
+	var rs utils.ResponseMessage[ent.Product]
+	assert.NoError(json.Unmarshal(rr.Body.Bytes(), &rs))
+	return rs.Data
+}
+
+// Test_HandleProductPathUser: product routes of {userId} act as caller only
+func Test_HandleProductPathUser(t *testing.T) {
+	ctx := context.TODO()
+	assert, r := handlersTestSetup(ctx, t)
+
+	ownerPp, _, _ := preSignupLoginUser(assert, r)
+	otherPp, _, otherUser := preSignupLoginUser(assert, r)
+	product := preCreateProductByToken(assert, r, ownerPp.AccessToken)
+	send := func(method string, userId string, token string) *httptest.ResponseRecorder {
+		b := generateHttpTestRequestBody(assert, *dto.NewUpdateProductDto(
+			&product.Name, &product.Price, nil, utils.PtrOf("edited"), &product.Status, &product.ImgURL))
+		req, err := http.NewRequest(method, fmt.Sprintf("/api/v1/products/%s/%s", userId, product.ID), b)
+		assert.NoError(err)
+		req.Header.Add("authorization", "bearer "+token)
+		req.Header.Add("if-match", utils.FormatETag(product.Version))
+		return executeHttpTestRequest(req, r)
+	}
+
+	// other merchant with owner id in path
+	assert.Equal(http.StatusForbidden, send("PUT", product.UserID.String(), otherPp.AccessToken).Code)
+	assert.Equal(http.StatusForbidden, send("DELETE", product.UserID.String(), otherPp.AccessToken).Code)
+	req, err := http.NewRequest("GET", fmt.Sprintf("/api/v1/products/%s/%s/movements", product.UserID, product.ID), nil)
+	assert.NoError(err)
+	req.Header.Add("authorization", "bearer "+otherPp.AccessToken)
+	assert.Equal(http.StatusForbidden, executeHttpTestRequest(req, r).Code)
+
+	// other merchant with own id in path, product not of its shop
+	assert.Equal(http.StatusUnauthorized, send("PUT", otherUser.ID.String(), otherPp.AccessToken).Code)
+
+	// owner
+	assert.Equal(http.StatusOK, send("PUT", product.UserID.String(), ownerPp.AccessToken).Code)
+}
+
 // Test_HandleGetProducts
 type handleGetProductsTestCase struct {
 	name   string
@@ -713,12 +1390,225 @@ func Test_HandleGetProducts(t *testing.T) {
 	}
 }
 
+// Test_HandleImportExportProducts
+func Test_HandleImportExportProducts(t *testing.T) {
+	ctx := context.TODO()
+	assert, r := handlersTestSetup(ctx, t)
+
+	validPp, _, _ := preSignupLoginUser(assert, r)
+	importProducts := func(token string, csv string, mapping string, dryRun bool) *httptest.ResponseRecorder {
+		b := &bytes.Buffer{}
+		form := multipart.NewWriter(b)
+		file, err := form.CreateFormFile("file", "products.csv")
+		assert.NoError(err)
+		_, err = file.Write([]byte(csv))
+		assert.NoError(err)
+		if mapping != "" {
+			assert.NoError(form.WriteField("mapping", mapping))
+		}
+		assert.NoError(form.Close())
+		req, err := http.NewRequest("POST", fmt.Sprintf("/api/v1/products/import?dryRun=%t", dryRun), b)
+		assert.NoError(err)
+		req.Header.Set("content-type", form.FormDataContentType())
+		req.Header.Add("authorization", "bearer "+token)
+		return executeHttpTestRequest(req, r)
+	}
+	csv := "Handle,Title,Price,Stock\nA-1,Apple,1.50,3\nB-1,Banana,2,4\nC-1,'=1+1,1,1\n"
+	mapping := `{"sku":"Handle","name":"Title","price":"Price","quantity":"Stock"}`
+
+	// dry run writes nothing
+	rr := importProducts(validPp.AccessToken, csv, mapping, true)
+	var rs utils.ResponseMessage[dto.ImportProductsResponseDto]
+	assert.NoError(json.Unmarshal(rr.Body.Bytes(), &rs))
+	assert.Equal(http.StatusOK, rr.Code)
+	assert.Equal(3, rs.Data.Created)
+	assert.False(rs.Data.Imported)
+
+	// import
+	rr = importProducts(validPp.AccessToken, csv, mapping, false)
+	assert.NoError(json.Unmarshal(rr.Body.Bytes(), &rs))
+	assert.Equal(http.StatusOK, rr.Code)
+	assert.True(rs.Data.Imported)
+
+	// invalid mapping
+	rr = importProducts(validPp.AccessToken, csv, `{"status":"Title"}`, false)
+	assert.Equal(http.StatusBadRequest, rr.Code)
+
+	// unauthorized
+	rr = importProducts(validPp.AccessToken+"wrong", csv, mapping, false)
+	assert.Equal(http.StatusUnauthorized, rr.Code)
+
+	// export
+	req, err := http.NewRequest("GET", "/api/v1/products/export", nil)
+	assert.NoError(err)
+	req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+	rr = executeHttpTestRequest(req, r)
+	assert.Equal(http.StatusOK, rr.Code)
+	assert.Equal("text/csv", rr.Header().Get("content-type"))
+	assert.Equal("sku,name,price,quantity,description,imgUrl,currency,status\n"+
+		"A-1,Apple,1.50,3,,,"+constants.DefaultCurrency+",initiated\n"+
+		"B-1,Banana,2.00,4,,,"+constants.DefaultCurrency+",initiated\n"+
+		"C-1,'=1+1,1.00,1,,,"+constants.DefaultCurrency+",initiated\n", rr.Body.String())
+}
+
+// Test_HandleCreateOrderConcurrent: concurrent checkouts never oversell
+func Test_HandleCreateOrderConcurrent(t *testing.T) {
+	ctx := context.TODO()
+	assert, r, logMailer := handlersTestSetupWithMailer(ctx, t)
+
+	// pre verified merchant
+	validPp, validLoginUser, validUser := preSignupLoginUser(assert, r)
+	validToken := sentMailToken(assert, logMailer, *validLoginUser.Email)
+	req, err := http.NewRequest("POST", "/api/v1/users/verify-email", generateHttpTestRequestBody(assert, *dto.NewVerifyEmailDto(&validToken)))
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, executeHttpTestRequest(req, r).Code)
+
+	preCreateProduct := func(quantity int32) *ent.Product {
+		b := generateHttpTestRequestBody(assert, *dto.NewCreateProductDto(
+			utils.PtrOf(gofakeit.LetterN(20)),
+			utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000))),
+			utils.PtrOf(quantity),
+			utils.PtrOf(gofakeit.LetterN(100)),
+			utils.PtrOf(gofakeit.LetterN(100)),
+			nil))
+		req, err := http.NewRequest("POST", "/api/v1/products", b)
+		assert.NoError(err)
+		req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+		rr := executeHttpTestRequest(req, r)
+		assert.Equal(http.StatusCreated, rr.Code)
+		var rs utils.ResponseMessage[ent.Product]
+		assert.NoError(json.Unmarshal(rr.Body.Bytes(), &rs))
+		return rs.Data
+	}
+	productQuantity := func(p *ent.Product) int32 {
+		req, err := http.NewRequest("GET", fmt.Sprintf("/api/v1/products/%s/%s", validUser.ID, p.ID), nil)
+		assert.NoError(err)
+		rr := executeHttpTestRequest(req, r)
+		assert.Equal(http.StatusOK, rr.Code)
+		var rs utils.ResponseMessage[ent.Product]
+		assert.NoError(json.Unmarshal(rr.Body.Bytes(), &rs))
+		return rs.Data.Quantity
+	}
+	checkout := func(products ...*ent.Product) int {
+		items := []*dto.OrderItem{}
+		total := money.Amount(0)
+		for _, p := range products {
+			items = append(items, dto.NewOrderItem(utils.PtrOf(p.ID.String()), nil, utils.PtrOf(p.Name), utils.PtrOf(p.Price), utils.PtrOf(1)))
+			total += p.Price
+		}
+		b := generateHttpTestRequestBody(assert, *dto.NewCreateOrderDto(
+			items,
+			utils.PtrOf(gofakeit.LetterN(100)),
+			utils.PtrOf(money.RateOne),
+			utils.PtrOf(total),
+			utils.PtrOf(constants.PaymentMethod.Card),
+			utils.PtrOf(gofakeit.Address().Address),
+		))
+		req, err := http.NewRequest("POST", fmt.Sprintf("/api/v1/orders/%s", validUser.ID), b)
+		assert.NoError(err)
+		return executeHttpTestRequest(req, r).Code
+	}
+	// hammer: run n checkouts at once, count created orders
+	hammer := func(n int, fn func(i int) int) int {
+		var created int32
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				code := fn(i)
+				if code == http.StatusCreated {
+					atomic.AddInt32(&created, 1)
+					return
+				}
+				// lost the race for stock, never a server error
+				assert.Equal(http.StatusBadRequest, code)
+			}(i)
+		}
+		wg.Wait()
+		return int(created)
+	}
+
+	t.Run("one product, more buyers than stock", func(t *testing.T) {
+		p1 := preCreateProduct(10)
+		created := hammer(30, func(_ int) int { return checkout(p1) })
+		assert.Equal(10, created)
+		assert.Equal(int32(0), productQuantity(p1))
+	})
+
+	t.Run("two products, locked in opposite item order", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("mock repos have no row lock or rollback, a failed checkout may keep partial stock")
+		}
+		p1 := preCreateProduct(10)
+		p2 := preCreateProduct(10)
+		created := hammer(30, func(i int) int {
+			if i%2 == 0 {
+				return checkout(p1, p2)
+			}
+			return checkout(p2, p1)
+		})
+		assert.Equal(10, created)
+		assert.Equal(int32(0), productQuantity(p1))
+		assert.Equal(int32(0), productQuantity(p2))
+	})
+
+	t.Run("import and checkout of same products at once", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("mock repos have no row lock, lock order is only exercised on postgres")
+		}
+		skus := []string{gofakeit.LetterN(10), gofakeit.LetterN(10)}
+		importProducts := func() int {
+			b := &bytes.Buffer{}
+			form := multipart.NewWriter(b)
+			file, err := form.CreateFormFile("file", "products.csv")
+			assert.NoError(err)
+			_, err = fmt.Fprintf(file, "sku,name,price,quantity\n%s,Kiwi,1.00,1000\n%s,Lime,1.00,1000\n", skus[0], skus[1])
+			assert.NoError(err)
+			assert.NoError(form.Close())
+			req, err := http.NewRequest("POST", "/api/v1/products/import", b)
+			assert.NoError(err)
+			req.Header.Set("content-type", form.FormDataContentType())
+			req.Header.Add("authorization", "bearer "+validPp.AccessToken)
+			return executeHttpTestRequest(req, r).Code
+		}
+		assert.Equal(http.StatusOK, importProducts())
+		req, err := http.NewRequest("GET", fmt.Sprintf("/api/v1/products/%s?limit=100", validUser.ID), nil)
+		assert.NoError(err)
+		rr := executeHttpTestRequest(req, r)
+		assert.Equal(http.StatusOK, rr.Code)
+		var rs utils.ResponseMessage[dto.QueryProductsResponseDto]
+		assert.NoError(json.Unmarshal(rr.Body.Bytes(), &rs))
+		imported := []*ent.Product{}
+		for _, p := range rs.Data.Data {
+			if p.Sku != nil && (*p.Sku == skus[0] || *p.Sku == skus[1]) {
+				imported = append(imported, p)
+			}
+		}
+		assert.Len(imported, 2)
+
+		// import locks owner then products like checkout, neither deadlocks into a server error
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if i%2 == 0 {
+					assert.Equal(http.StatusOK, importProducts())
+					return
+				}
+				assert.Equal(http.StatusCreated, checkout(imported...))
+			}(i)
+		}
+		wg.Wait()
+	})
+}
+
 // todo:
 // User: HandleCheckUserExist
 // Product: HandleGetProductById
 // Product: HandleUpdateProductById
 // Product: HandleDeleteProductById
-// Order: HandleCreateOrder
 // Order: HandleGetOrders
 // Order: HandleGetOrderById
 // Order: HandleUpdateOrderById
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sthl/constants"
+	"sthl/service"
+	"sthl/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// idempotencyRecorder: pass response through and keep a copy for replay, etag read from headers once done
+type idempotencyRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (rec *idempotencyRecorder) WriteHeader(statusCode int) {
+	if rec.statusCode == 0 {
+		rec.statusCode = statusCode
+	}
+	rec.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (rec *idempotencyRecorder) Write(b []byte) (int, error) {
+	if rec.statusCode == 0 {
+		rec.statusCode = http.StatusOK
+	}
+	rec.body.Write(b)
+	return rec.ResponseWriter.Write(b)
+}
+
+// idempotencyScope: key is unique per method, path and caller,
+// anonymous caller is told apart by hash of client ip and user agent, false if anonymous key is no uuid
+func idempotencyScope(r *http.Request, key string) (string, bool) {
+	scope := r.Method + " " + r.URL.Path
+	if userId, ok := r.Context().Value(constants.AccessTokenInfoKey).(string); ok && userId != "" {
+		return scope + " " + userId, true
+	}
+
+	// keys of anonymous callers must not be guessable, else one could replay another's response
+	_, err := uuid.Parse(key)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256([]byte(utils.GetClientIp(r) + "\n" + r.UserAgent()))
+	return scope + " anonymous " + hex.EncodeToString(sum[:]), true
+}
+
+// Idempotency: requests with Idempotency-Key run once within retention window,
+// a retry with same key and body replays the stored response,
+// server errors are not stored so the key can be retried
+func Idempotency(l *zap.Logger, idempotencySvc service.IIdempotencyService) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(constants.IdempotencyKeyHeader)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			scope, ok := idempotencyScope(r, key)
+			if !ok {
+				l.Info("anonymous idempotency key is no uuid")
+				utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+				return
+			}
+
+			// fingerprint body, then restore it for handler
+			body, err := io.ReadAll(io.LimitReader(r.Body, constants.IdempotencyMaxRequestSize+1))
+			if err != nil || int64(len(body)) > constants.IdempotencyMaxRequestSize {
+				l.Info("fail to read idempotent request body", zap.Error(err))
+				utils.ResponseSend[any](w, http.StatusBadRequest, "", nil)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			sum := sha256.Sum256(body)
+			fingerprint := hex.EncodeToString(sum[:])
+
+			record, isReplay, err := idempotencySvc.BeginIdempotentRequest(r.Context(), scope, key, fingerprint)
+			if err != nil {
+				l.Info("fail to idempotencySvc.BeginIdempotentRequest", zap.Error(err))
+				utils.HttpErrorResponseSend(w, err)
+				return
+			}
+			if isReplay {
+				w.Header().Set(constants.IdempotentReplayedHeader, "true")
+				if record.Etag != "" {
+					w.Header().Set("ETag", record.Etag)
+				}
+				w.WriteHeader(record.StatusCode)
+				_, _ = w.Write(record.ResponseBody)
+				return
+			}
+
+			// request ctx may be canceled by client, the claim must still be settled
+			rec := &idempotencyRecorder{ResponseWriter: w}
+			settled := false
+			defer func() {
+				if settled {
+					return
+				}
+				err := idempotencySvc.ReleaseIdempotentRequest(context.Background(), record.ID.String(), record.ClaimToken.String())
+				if err != nil {
+					l.Info("fail to idempotencySvc.ReleaseIdempotentRequest", zap.Error(err))
+				}
+			}()
+			// handler must finish within lease, otherwise a retry could claim key while it still runs
+			handlerCtx, cancel := context.WithTimeout(r.Context(), constants.IdempotentRequestTimeout)
+			defer cancel()
+			next.ServeHTTP(rec, r.WithContext(handlerCtx))
+			if rec.statusCode == 0 || rec.statusCode >= http.StatusInternalServerError {
+				return
+			}
+			err = idempotencySvc.CompleteIdempotentRequest(context.Background(), record.ID.String(), record.ClaimToken.String(), rec.statusCode, rec.body.Bytes(), rec.Header().Get("ETag"))
+			if err != nil {
+				l.Info("fail to idempotencySvc.CompleteIdempotentRequest", zap.Error(err))
+				return
+			}
+			settled = true
+		})
+	}
+}
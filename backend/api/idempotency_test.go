@@ -0,0 +1,112 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sthl/constants"
+	"sthl/logger"
+	"sthl/repository"
+	"sthl/service"
+	"sthl/utils"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// idempotencyTestRouter: counting handler behind Idempotency middleware, fails on status query
+func idempotencyTestRouter(t *testing.T) (*assert.Assertions, *chi.Mux, *int32) {
+	assert := assert.New(t)
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NoError(err)
+	idempotencySvc := service.NewIdempotencyService(zapLogger, nil, repository.NewIdempotencyRepositoryMock())
+
+	var calls int32
+	r := chi.NewRouter()
+	r.With(Idempotency(zapLogger, idempotencySvc)).Post("/resources/{userId}", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		if r.URL.Query().Get("fail") != "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		utils.SetETag(w, int64(n))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte{'0' + byte(n)})
+	})
+	return assert, r, &calls
+}
+
+func idempotentRequest(r *chi.Mux, path string, key string, body string) *httptest.ResponseRecorder {
+	return idempotentRequestFrom(r, "192.0.2.1:1234", "test", path, key, body)
+}
+
+func idempotentRequestFrom(r *chi.Mux, remoteAddr string, userAgent string, path string, key string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", path, strings.NewReader(body))
+	req.RemoteAddr = remoteAddr
+	req.Header.Set("User-Agent", userAgent)
+	if key != "" {
+		req.Header.Set(constants.IdempotencyKeyHeader, key)
+	}
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+	return rr
+}
+
+// ****Test_Idempotency
+func Test_Idempotency(t *testing.T) {
+	assert, r, calls := idempotencyTestRouter(t)
+	path := "/resources/" + uuid.NewString()
+	key := uuid.NewString()
+
+	// without key, every request runs
+	assert.Equal(http.StatusCreated, idempotentRequest(r, path, "", `{"a":1}`).Code)
+	assert.Equal(http.StatusCreated, idempotentRequest(r, path, "", `{"a":1}`).Code)
+	assert.Equal(int32(2), *calls)
+
+	// first request runs, retry replays stored response
+	rr := idempotentRequest(r, path, key, `{"a":1}`)
+	assert.Equal(http.StatusCreated, rr.Code)
+	assert.Equal("3", rr.Body.String())
+	assert.Empty(rr.Header().Get(constants.IdempotentReplayedHeader))
+	rr = idempotentRequest(r, path, key, `{"a":1}`)
+	assert.Equal(http.StatusCreated, rr.Code)
+	assert.Equal("3", rr.Body.String())
+	assert.Equal("true", rr.Header().Get(constants.IdempotentReplayedHeader))
+	assert.Equal(`"3"`, rr.Header().Get("ETag"))
+	assert.Equal(int32(3), *calls)
+
+	// anonymous callers of other ip or user agent do not share the key
+	rr = idempotentRequestFrom(r, "198.51.100.2:1234", "test", path, key, `{"a":1}`)
+	assert.Equal(http.StatusCreated, rr.Code)
+	assert.Empty(rr.Header().Get(constants.IdempotentReplayedHeader))
+	rr = idempotentRequestFrom(r, "192.0.2.1:1234", "other", path, key, `{"a":1}`)
+	assert.Equal(http.StatusCreated, rr.Code)
+	assert.Empty(rr.Header().Get(constants.IdempotentReplayedHeader))
+	assert.Equal(int32(5), *calls)
+
+	// anonymous key must be uuid
+	rr = idempotentRequest(r, path, "order-1", `{"a":1}`)
+	assert.Equal(http.StatusBadRequest, rr.Code)
+	assert.Equal(int32(5), *calls)
+
+	// same key with other payload conflicts
+	rr = idempotentRequest(r, path, key, `{"a":2}`)
+	assert.Equal(http.StatusConflict, rr.Code)
+	assert.Equal(int32(5), *calls)
+
+	// same key on other path runs
+	rr = idempotentRequest(r, "/resources/"+uuid.NewString(), key, `{"a":1}`)
+	assert.Equal(http.StatusCreated, rr.Code)
+	assert.Equal(int32(6), *calls)
+
+	// server error is not stored, retry runs again
+	failKey := uuid.NewString()
+	rr = idempotentRequest(r, path+"?fail=1", failKey, `{"a":1}`)
+	assert.Equal(http.StatusInternalServerError, rr.Code)
+	rr = idempotentRequest(r, path, failKey, `{"a":1}`)
+	assert.Equal(http.StatusCreated, rr.Code)
+	assert.Equal(int32(8), *calls)
+}
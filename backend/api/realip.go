@@ -0,0 +1,66 @@
+package api
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// RealIp: remote address replaced by client ip from X-Forwarded-For or X-Real-IP,
+// only if the request came through a trusted proxy, so a client cannot pick its own ip.
+// X-Forwarded-For is read right to left, trusted proxy hops skipped
+func RealIp(trustedProxies []netip.Prefix) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			peer, ok := parseIp(r.RemoteAddr)
+			if ok && isTrustedProxy(trustedProxies, peer) {
+				if clientIp, ok := forwardedClientIp(trustedProxies, r.Header); ok {
+					r.RemoteAddr = clientIp.String()
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// forwardedClientIp: first untrusted hop of X-Forwarded-For from the right, or X-Real-IP,
+// false if header missing or invalid
+func forwardedClientIp(trustedProxies []netip.Prefix, header http.Header) (netip.Addr, bool) {
+	forwardedFor := header.Values("X-Forwarded-For")
+	if len(forwardedFor) > 0 {
+		hops := strings.Split(strings.Join(forwardedFor, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop, ok := parseIp(strings.TrimSpace(hops[i]))
+			if !ok {
+				return netip.Addr{}, false
+			}
+			if i == 0 || !isTrustedProxy(trustedProxies, hop) {
+				return hop, true
+			}
+		}
+	}
+	return parseIp(strings.TrimSpace(header.Get("X-Real-IP")))
+}
+
+// parseIp: ip with or without port
+func parseIp(value string) (netip.Addr, bool) {
+	host, _, err := net.SplitHostPort(value)
+	if err != nil {
+		host = value
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
+
+func isTrustedProxy(trustedProxies []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sthl/utils"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ****Test_RealIp
+type realIpTestCase struct {
+	name       string
+	remoteAddr string
+	headers    map[string][]string
+	expected   string
+}
+
+func Test_RealIp(t *testing.T) {
+	assert := assert.New(t)
+	trustedProxies := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+	var clientIp string
+	handler := RealIp(trustedProxies)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientIp = utils.GetClientIp(r)
+	}))
+
+	testCases := []realIpTestCase{
+		{
+			name:       "direct client, forwarding headers ignored",
+			remoteAddr: "203.0.113.7:5000",
+			headers:    map[string][]string{"X-Forwarded-For": {"198.51.100.1"}, "X-Real-Ip": {"198.51.100.2"}},
+			expected:   "203.0.113.7",
+		},
+		{
+			name:       "trusted proxy, no header",
+			remoteAddr: "10.0.0.2:5000",
+			expected:   "10.0.0.2",
+		},
+		{
+			name:       "trusted proxy, first untrusted hop from the right",
+			remoteAddr: "10.0.0.2:5000",
+			headers:    map[string][]string{"X-Forwarded-For": {"198.51.100.1, 203.0.113.7", "10.0.0.3"}},
+			expected:   "203.0.113.7",
+		},
+		{
+			name:       "trusted proxy, all hops trusted",
+			remoteAddr: "10.0.0.2:5000",
+			headers:    map[string][]string{"X-Forwarded-For": {"10.0.0.4, 10.0.0.3"}},
+			expected:   "10.0.0.4",
+		},
+		{
+			name:       "trusted proxy, x-real-ip",
+			remoteAddr: "10.0.0.2:5000",
+			headers:    map[string][]string{"X-Real-Ip": {"203.0.113.7"}},
+			expected:   "203.0.113.7",
+		},
+		{
+			name:       "trusted proxy, invalid hop",
+			remoteAddr: "10.0.0.2:5000",
+			headers:    map[string][]string{"X-Forwarded-For": {"203.0.113.7, nonsense"}},
+			expected:   "10.0.0.2",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = test.remoteAddr
+			for key, values := range test.headers {
+				req.Header[key] = values
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+			assert.Equal(test.expected, clientIp)
+		})
+	}
+}
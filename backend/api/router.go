@@ -5,7 +5,9 @@ import (
 	"net/http"
 	"sthl/authentication"
 	"sthl/config"
+	"sthl/constants"
 	"sthl/service"
+	"sthl/storage"
 	"strings"
 
 	"github.com/go-chi/chi/v5"
@@ -15,10 +17,11 @@ import (
 	"go.uber.org/zap"
 )
 
-func NewChiRouter(l *zap.Logger, cfg *config.Config, userSvc service.IUserService, handlers IHandler) *chi.Mux {
+func NewChiRouter(l *zap.Logger, cfg *config.Config, userSvc service.IUserService, idempotencySvc service.IIdempotencyService,
+	blobStore storage.BlobStore, handlers IHandler) *chi.Mux {
 	r := chi.NewRouter()
 	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
+	r.Use(RealIp(cfg.GetTrustedProxies()))
 	r.Use(middleware.Logger)
 	r.Use(middleware.SetHeader("content-type", "application/json"))
 
@@ -34,21 +37,39 @@ func NewChiRouter(l *zap.Logger, cfg *config.Config, userSvc service.IUserServic
 		"Access-Control-Allow-Methods",
 		"Access-Control-Allow-Credentials",
 		"Authorization",
+		"X-Api-Key",
+		"If-Match",
+		"If-None-Match",
+		constants.IdempotencyKeyHeader,
 	}
 	r.Use(cors.Handler(cors.Options{
 		// AllowOriginFunc:  func(r *http.Request, origin string) bool { return true },
 		AllowedOrigins:   []string{cfg.GetAllowOrigin()},
 		AllowedMethods:   allowMethods,
 		AllowedHeaders:   allowHeaders,
+		ExposedHeaders:   []string{"ETag", constants.IdempotentReplayedHeader},
 		AllowCredentials: true,
 		MaxAge:           300, // Maximum value not ignored by any of major browsers
 	}))
 	r.Use(middleware.Recoverer)
-	RegisterRoute(l, r, userSvc, handlers)
+	RegisterRoute(l, r, userSvc, idempotencySvc, handlers)
+	// operator, disabled unless ADMIN_TOKEN set
+	adminToken := cfg.GetAdminToken()
+	if adminToken != "" {
+		r.With(AdminToken(l, adminToken)).Put("/api/v1/admin/users/{userId}/plan", handlers.HandleUpdateUserPlanById)
+	}
+	// local and memory blob store objects served by api, s3 objects served by s3
+	servedBlobStore, ok := blobStore.(storage.ServedBlobStore)
+	if ok {
+		r.Get(constants.BlobPath+"/*", HandleGetBlob(l, servedBlobStore))
+	}
 	return r
 }
 
-func RegisterRoute(l *zap.Logger, r *chi.Mux, authentor authentication.Authenticator, hdlr IHandler) {
+func RegisterRoute(l *zap.Logger, r *chi.Mux, authentor authentication.Authenticator, idempotencySvc service.IIdempotencyService, hdlr IHandler) {
+	// opt in by Idempotency-Key header, for mutating routes not safe to retry
+	idempotent := Idempotency(l, idempotencySvc)
+
 	r.Get("/swagger/*", httpSwagger.Handler(
 		httpSwagger.URL("http://localhost:4000/swagger/doc.json"), //The url pointing to API definition
 	))
@@ -58,32 +79,91 @@ func RegisterRoute(l *zap.Logger, r *chi.Mux, authentor authentication.Authentic
 		rt.Get("/api/v1/ping", HandlePing)
 		rt.Post("/api/v1/users", hdlr.HandleSignup)
 		rt.Post("/api/v1/users/login", hdlr.HandleLogin)
+		rt.Post("/api/v1/users/login/mfa", hdlr.HandleVerifyMfaLogin)
 		rt.Get("/api/v1/users/exist/{userId}", hdlr.HandleCheckUserExist)
+		rt.Get("/api/v1/.well-known/jwks.json", hdlr.HandleGetJwks)
+		rt.Post("/api/v1/users/refreshToken", hdlr.HandleRefreshAccessToken)
+		rt.Post("/api/v1/users/verify-email", hdlr.HandleVerifyEmail)
+		rt.Post("/api/v1/users/password/forgot", hdlr.HandleForgotPassword)
+		rt.Post("/api/v1/users/password/reset", hdlr.HandleResetPassword)
 		rt.Get("/api/v1/products/{userId}", hdlr.HandleGetProducts)
 		rt.Get("/api/v1/products/{userId}/{productId}", hdlr.HandleGetProductById)
-		rt.Post("/api/v1/orders/{userId}", hdlr.HandleCreateOrder)
+		rt.Get("/api/v1/categories/{userId}", hdlr.HandleGetCategories)
+		rt.Get("/api/v1/collections/{userId}", hdlr.HandleGetCollections)
+		rt.With(idempotent).Post("/api/v1/orders/{userId}", hdlr.HandleCreateOrder)
 		rt.Get("/api/v1/siteui/{userId}", hdlr.HandleGetSiteUiByUserId)
 	})
 	// private
 	r.Group(func(rt chi.Router) {
 		rt.Use(authentication.AuthInterceptor(l, authentor))
-		rt.Post("/api/v1/users/refreshToken", hdlr.HandleRefreshAccessToken)
-		rt.Get("/api/v1/users/me", hdlr.HandleGetMe)
-		rt.Put("/api/v1/users/me/pw", hdlr.HandleUpdateUserPasswordById)
-		rt.Post("/api/v1/products", hdlr.HandleCreateProduct)
-		rt.Put("/api/v1/products/{userId}/{productId}", hdlr.HandleUpdateProductById)
-		rt.Delete("/api/v1/products/{userId}/{productId}", hdlr.HandleDeleteProductById)
-		rt.Get("/api/v1/orders", hdlr.HandleGetOrders)
-		rt.Get("/api/v1/orders/{orderId}", hdlr.HandleGetOrderById)
-		rt.Put("/api/v1/orders/{orderId}", hdlr.HandleUpdateOrderById)
-		rt.Delete("/api/v1/orders/{orderId}", hdlr.HandleDeleteOrderById)
-		rt.Put("/api/v1/siteui", hdlr.HandleUpsertSiteUiByUserId)
-		rt.Post("/api/v1/album", hdlr.HandleUploadAlbumImage)
-		rt.Get("/api/v1/album", hdlr.HandleGetAlbumImgs)
-		rt.Put("/api/v1/album/{imgInfoId}", hdlr.HandleUpdateS3ImageDataById)
-		// for test
-		rt.Get("/api/v1/users/{userId}", hdlr.HandleGetUserById)
-		rt.Get("/api/v1/users", hdlr.HandleGetUsers)
+		// session only, api key cannot manage account, staff or keys
+		rt.Group(func(rt chi.Router) {
+			rt.Use(authentication.SessionOnly(l))
+			rt.Post("/api/v1/users/logout", hdlr.HandleLogout)
+			rt.Post("/api/v1/users/logout/all", hdlr.HandleLogoutAll)
+			rt.Post("/api/v1/users/verify-email/resend", hdlr.HandleResendVerificationEmail)
+			rt.Get("/api/v1/users/me", hdlr.HandleGetMe)
+			rt.Get("/api/v1/users/me/lockouts", hdlr.HandleGetLoginLockouts)
+			rt.Get("/api/v1/users/me/usage", hdlr.HandleGetUsage)
+			rt.Put("/api/v1/users/me/pw", hdlr.HandleUpdateUserPasswordById)
+			rt.Post("/api/v1/users/me/shop", hdlr.HandleSwitchShop)
+			rt.Post("/api/v1/users/me/apikeys", hdlr.HandleCreateApiKey)
+			rt.Get("/api/v1/users/me/apikeys", hdlr.HandleGetApiKeys)
+			rt.Delete("/api/v1/users/me/apikeys/{apiKeyId}", hdlr.HandleRevokeApiKey)
+			rt.Post("/api/v1/users/me/mfa/totp", hdlr.HandleEnrollTotp)
+			rt.Post("/api/v1/users/me/mfa/totp/confirm", hdlr.HandleConfirmTotp)
+			rt.Post("/api/v1/users/me/mfa/totp/disable", hdlr.HandleDisableTotp)
+			rt.Get("/api/v1/shops", hdlr.HandleGetMyShops)
+			rt.Get("/api/v1/shops/members", hdlr.HandleGetShopMembers)
+			rt.Put("/api/v1/shops/pricing", hdlr.HandleUpdateShopPricing)
+			rt.Put("/api/v1/shops/members/{userId}", hdlr.HandleUpdateShopMemberRole)
+			rt.Delete("/api/v1/shops/members/{userId}", hdlr.HandleRemoveShopMember)
+			rt.Post("/api/v1/shops/invites", hdlr.HandleInviteShopMember)
+			rt.Post("/api/v1/shops/invites/accept", hdlr.HandleAcceptShopInvite)
+			// for test
+			rt.Get("/api/v1/users/{userId}", hdlr.HandleGetUserById)
+			rt.Get("/api/v1/users", hdlr.HandleGetUsers)
+		})
+		// session or api key with scope
+		productsRead := authentication.RequireScope(l, constants.ApiKeyScope.ProductsRead)
+		productsWrite := authentication.RequireScope(l, constants.ApiKeyScope.ProductsWrite)
+		ordersRead := authentication.RequireScope(l, constants.ApiKeyScope.OrdersRead)
+		ordersWrite := authentication.RequireScope(l, constants.ApiKeyScope.OrdersWrite)
+		siteUiWrite := authentication.RequireScope(l, constants.ApiKeyScope.SiteUiWrite)
+		albumRead := authentication.RequireScope(l, constants.ApiKeyScope.AlbumRead)
+		albumWrite := authentication.RequireScope(l, constants.ApiKeyScope.AlbumWrite)
+		// product routes of {userId} act as caller only
+		pathUser := authentication.RequirePathUser(l)
+		rt.With(productsRead).Get("/api/v1/products", hdlr.HandleGetShopProducts)
+		rt.With(productsRead).Get("/api/v1/products/export", hdlr.HandleExportProducts)
+		rt.With(productsWrite).Post("/api/v1/products/import", hdlr.HandleImportProducts)
+		rt.With(productsWrite, idempotent).Post("/api/v1/products", hdlr.HandleCreateProduct)
+		rt.With(productsWrite, pathUser).Put("/api/v1/products/{userId}/{productId}", hdlr.HandleUpdateProductById)
+		rt.With(productsWrite, pathUser).Delete("/api/v1/products/{userId}/{productId}", hdlr.HandleDeleteProductById)
+		rt.With(productsRead, pathUser).Get("/api/v1/products/{userId}/{productId}/movements", hdlr.HandleGetInventoryMovements)
+		rt.With(productsWrite, pathUser).Post("/api/v1/products/{userId}/{productId}/movements", hdlr.HandleAdjustProductQuantity)
+		rt.With(productsWrite, pathUser).Post("/api/v1/products/{userId}/{productId}/variants", hdlr.HandleCreateProductVariant)
+		rt.With(productsWrite, pathUser).Put("/api/v1/products/{userId}/{productId}/variants/{variantId}", hdlr.HandleUpdateProductVariantById)
+		rt.With(productsWrite, pathUser).Delete("/api/v1/products/{userId}/{productId}/variants/{variantId}", hdlr.HandleDeleteProductVariantById)
+		rt.With(productsWrite, pathUser).Post("/api/v1/products/{userId}/{productId}/variants/{variantId}/movements", hdlr.HandleAdjustProductVariantQuantity)
+		rt.With(productsWrite, pathUser).Put("/api/v1/products/{userId}/{productId}/categories", hdlr.HandleSetProductCategories)
+		rt.With(productsWrite, pathUser).Put("/api/v1/products/{userId}/{productId}/media", hdlr.HandleSetProductMedia)
+		rt.With(productsWrite).Post("/api/v1/categories", hdlr.HandleCreateCategory)
+		rt.With(productsWrite).Put("/api/v1/categories/{categoryId}", hdlr.HandleUpdateCategoryById)
+		rt.With(productsWrite).Delete("/api/v1/categories/{categoryId}", hdlr.HandleDeleteCategoryById)
+		rt.With(productsWrite).Post("/api/v1/collections", hdlr.HandleCreateCollection)
+		rt.With(productsWrite).Put("/api/v1/collections/{collectionId}", hdlr.HandleUpdateCollectionById)
+		rt.With(productsWrite).Delete("/api/v1/collections/{collectionId}", hdlr.HandleDeleteCollectionById)
+		rt.With(ordersRead).Get("/api/v1/orders", hdlr.HandleGetOrders)
+		rt.With(ordersWrite, idempotent).Post("/api/v1/orders", hdlr.HandleCreateShopOrder)
+		rt.With(ordersRead).Get("/api/v1/orders/{orderId}", hdlr.HandleGetOrderById)
+		rt.With(ordersRead).Get("/api/v1/orders/{orderId}/events", hdlr.HandleGetOrderEvents)
+		rt.With(ordersWrite).Put("/api/v1/orders/{orderId}", hdlr.HandleUpdateOrderById)
+		rt.With(ordersWrite).Delete("/api/v1/orders/{orderId}", hdlr.HandleDeleteOrderById)
+		rt.With(siteUiWrite).Put("/api/v1/siteui", hdlr.HandleUpsertSiteUiByUserId)
+		rt.With(albumWrite).Post("/api/v1/album", hdlr.HandleUploadAlbumImage)
+		rt.With(albumRead).Get("/api/v1/album", hdlr.HandleGetAlbumImgs)
+		rt.With(albumWrite).Put("/api/v1/album/{imgInfoId}", hdlr.HandleUpdateS3ImageDataById)
 	})
 
 	walkFunc := func(method string, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
@@ -0,0 +1,32 @@
+package authentication
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sthl/constants"
+	"strings"
+)
+
+// GenerateApiKey: key is "sthl_<prefix>_<secret>", prefix is stored in clear
+// so user can tell keys apart, only the hash of whole key is stored
+func GenerateApiKey() (string, string, string, error) {
+	b := make([]byte, 4)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", "", "", err
+	}
+	prefix := hex.EncodeToString(b)
+	secret, _, err := GenerateOpaqueToken()
+	if err != nil {
+		return "", "", "", err
+	}
+	key := fmt.Sprintf("%s_%s_%s", constants.ApiKeyPrefix, prefix, secret)
+	return key, prefix, HashOpaqueToken(key), nil
+}
+
+// IsApiKeyFormat: cheap check before hitting db
+func IsApiKeyFormat(key string) bool {
+	parts := strings.SplitN(key, "_", 3)
+	return len(parts) == 3 && parts[0] == constants.ApiKeyPrefix && len(parts[1]) == 8 && parts[2] != ""
+}
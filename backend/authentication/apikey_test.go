@@ -0,0 +1,98 @@
+package authentication
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sthl/constants"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// Test_GenerateApiKey
+func Test_GenerateApiKey(t *testing.T) {
+	assert := assert.New(t)
+	key, prefix, hash, err := GenerateApiKey()
+	assert.NoError(err)
+	assert.True(IsApiKeyFormat(key))
+	assert.Contains(key, constants.ApiKeyPrefix+"_"+prefix+"_")
+	assert.Equal(HashOpaqueToken(key), hash)
+
+	other, otherPrefix, _, err := GenerateApiKey()
+	assert.NoError(err)
+	assert.NotEqual(key, other)
+	assert.NotEqual(prefix, otherPrefix)
+
+	assert.False(IsApiKeyFormat(""))
+	assert.False(IsApiKeyFormat("qwdqwd"))
+	assert.False(IsApiKeyFormat("other_12345678_secret"))
+	assert.False(IsApiKeyFormat(constants.ApiKeyPrefix + "_123_secret"))
+}
+
+// ****Test_RequireScope
+type requireScopeTestCase struct {
+	name     string
+	scopes   []string
+	expected int
+}
+
+func Test_RequireScope(t *testing.T) {
+	assert := assert.New(t)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	scoped := RequireScope(zap.NewNop(), constants.ApiKeyScope.OrdersRead)(next)
+	sessionOnly := SessionOnly(zap.NewNop())(next)
+
+	testCases := []requireScopeTestCase{
+		{"session token", nil, http.StatusOK},
+		{"api key with scope", []string{constants.ApiKeyScope.OrdersRead}, http.StatusOK},
+		{"api key without scope", []string{constants.ApiKeyScope.OrdersWrite}, http.StatusForbidden},
+		{"api key without any scope", []string{}, http.StatusForbidden},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			if test.scopes != nil {
+				req = req.WithContext(context.WithValue(req.Context(), constants.ApiKeyScopesKey, test.scopes))
+			}
+			rr := httptest.NewRecorder()
+			scoped.ServeHTTP(rr, req)
+			assert.Equal(test.expected, rr.Code)
+
+			// session only rejects every api key
+			rr = httptest.NewRecorder()
+			sessionOnly.ServeHTTP(rr, req)
+			if test.scopes == nil {
+				assert.Equal(http.StatusOK, rr.Code)
+			} else {
+				assert.Equal(http.StatusForbidden, rr.Code)
+			}
+		})
+	}
+}
+
+// ****Test_RequirePathUser
+func Test_RequirePathUser(t *testing.T) {
+	assert := assert.New(t)
+	r := chi.NewRouter()
+	r.With(RequirePathUser(zap.NewNop())).Get("/products/{userId}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	send := func(pathUserId string, userId string) int {
+		req := httptest.NewRequest("GET", "/products/"+pathUserId, nil)
+		if userId != "" {
+			req = req.WithContext(context.WithValue(req.Context(), constants.AccessTokenInfoKey, userId))
+		}
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	assert.Equal(http.StatusOK, send("u1", "u1"))
+	assert.Equal(http.StatusForbidden, send("u1", "u2"))
+	assert.Equal(http.StatusForbidden, send("u1", ""))
+}
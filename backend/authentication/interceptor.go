@@ -7,11 +7,22 @@ import (
 	"sthl/utils"
 	"strings"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
 
+// AccessTokenInfo: identity carried by a verified access token or api key,
+// Scopes is nil for session token which is not limited by scope
+type AccessTokenInfo struct {
+	UserId string
+	ShopId string
+	Scopes []string
+}
+
 type Authenticator interface {
-	Authenticate(ctx context.Context, token string) (string, error)
+	Authenticate(ctx context.Context, token string) (*AccessTokenInfo, error)
+	AuthenticateApiKey(ctx context.Context, key string) (*AccessTokenInfo, error)
 }
 
 func tokenFromHeader(r *http.Request) string {
@@ -26,10 +37,17 @@ func tokenFromHeader(r *http.Request) string {
 func AuthInterceptor(l *zap.Logger, authentor Authenticator) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// extract token
-			tokenString := tokenFromHeader(r)
+			// api key takes precedence, otherwise extract token
 			ctx := r.Context()
-			result, err := authentor.Authenticate(ctx, tokenString)
+			var result *AccessTokenInfo
+			var err error
+			tokenString := ""
+			if apiKey := r.Header.Get(constants.ApiKeyHeader); apiKey != "" {
+				result, err = authentor.AuthenticateApiKey(ctx, apiKey)
+			} else {
+				tokenString = tokenFromHeader(r)
+				result, err = authentor.Authenticate(ctx, tokenString)
+			}
 			if err != nil {
 				utils.ResponseSend[any](w, http.StatusUnauthorized, "", nil)
 				l.Info("auth not pass")
@@ -37,8 +55,63 @@ func AuthInterceptor(l *zap.Logger, authentor Authenticator) func(next http.Hand
 			}
 			l.Info("auth pass", zap.Any("result", result))
 
-			r = r.WithContext(context.WithValue(ctx, constants.AccessTokenKey, tokenString))
-			r = r.WithContext(context.WithValue(ctx, constants.AccessTokenInfoKey, result))
+			ctx = context.WithValue(ctx, constants.AccessTokenKey, tokenString)
+			ctx = context.WithValue(ctx, constants.AccessTokenInfoKey, result.UserId)
+			ctx = context.WithValue(ctx, constants.ActiveShopKey, result.ShopId)
+			if result.Scopes != nil {
+				ctx = context.WithValue(ctx, constants.ApiKeyScopesKey, result.Scopes)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// apiKeyScopes: scopes of api key request, false for session token
+func apiKeyScopes(ctx context.Context) ([]string, bool) {
+	scopes, ok := ctx.Value(constants.ApiKeyScopesKey).([]string)
+	return scopes, ok
+}
+
+// RequireScope: session token passes, api key needs the scope
+func RequireScope(l *zap.Logger, scope string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scopes, isApiKey := apiKeyScopes(r.Context())
+			if isApiKey && !lo.Contains(scopes, scope) {
+				utils.ResponseSend[any](w, http.StatusForbidden, "", nil)
+				l.Info("api key missing scope", zap.String("scope", scope))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequirePathUser: path {userId} must be the authenticated user,
+// acting shop and role are resolved from the caller, never from the path
+func RequirePathUser(l *zap.Logger) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userId, ok := r.Context().Value(constants.AccessTokenInfoKey).(string)
+			if !ok || userId == "" || chi.URLParam(r, "userId") != userId {
+				utils.ResponseSend[any](w, http.StatusForbidden, "", nil)
+				l.Info("path userId is not authenticated user")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// SessionOnly: reject api key, for account and key management
+func SessionOnly(l *zap.Logger) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, isApiKey := apiKeyScopes(r.Context()); isApiKey {
+				utils.ResponseSend[any](w, http.StatusForbidden, "", nil)
+				l.Info("api key not allowed")
+				return
+			}
 			next.ServeHTTP(w, r)
 		})
 	}
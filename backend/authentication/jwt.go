@@ -7,59 +7,85 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 )
 
+// JwtCustomClaims: jti is carried by RegisteredClaims.ID,
+// TokenUse tells access and refresh tokens apart, ShopId is the active shop
 type JwtCustomClaims struct {
 	jwt.RegisteredClaims
-	UserId string
+	UserId   string
+	TokenUse string `json:"token_use"`
+	ShopId   string `json:"shop_id,omitempty"`
 }
 
-func GenerateJwtToken(duration time.Duration, userId string) (string, error) {
+// GenerateJwtToken: shopId can be empty for user without shop
+func GenerateJwtToken(ks *KeySet, tokenUse string, jti string, duration time.Duration, userId string, shopId string) (string, error) {
+	if ks == nil {
+		return "", fmt.Errorf("key set is nil")
+	}
+	if tokenUse == "" {
+		return "", fmt.Errorf("tokenUse is empty")
+	}
+	if jti == "" {
+		return "", fmt.Errorf("jti is empty")
+	}
 	if userId == "" {
 		return "", fmt.Errorf("userId is empty")
 	}
 	if duration <= 0 {
 		return "", fmt.Errorf("duration cannot smaller than 0")
 	}
-	// Get jwt secret key
-	// signingKey := []byte(utils.GetEnv("JWT_SECRET"))
-	signingKey := []byte("secret")
+
+	// Get active signing key
+	key := ks.Active()
 	claims := &JwtCustomClaims{
 		jwt.RegisteredClaims{
+			ID:        jti,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 		userId,
+		tokenUse,
+		shopId,
 	}
-	// Sign jwt
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	ss, err := token.SignedString(signingKey)
+
+	// Sign jwt with kid header
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.Kid
+	ss, err := token.SignedString(key.signKey)
 	if err != nil {
 		return "", err
 	}
 	return ss, nil
 }
 
-func VerifyJwtToken(tokenString string) (*JwtCustomClaims, error) {
+// VerifyJwtToken: verify signature, expiry and token_use
+func VerifyJwtToken(ks *KeySet, tokenUse string, tokenString string) (*JwtCustomClaims, error) {
+	if ks == nil {
+		return nil, fmt.Errorf("key set is nil")
+	}
 	if tokenString == "" {
 		return nil, fmt.Errorf("tokenString is empty")
 	}
-
 	var customClaims JwtCustomClaims
+
 	// initialize a new JWT parser
 	parser := jwt.Parser{
-		ValidMethods: []string{jwt.SigningMethodHS256.Alg()},
+		ValidMethods: ks.Algs(),
 	}
 
-	// parse the token string using the secret key
+	// parse the token string using the key found by kid
 	token, err := parser.ParseWithClaims(tokenString, &customClaims, func(token *jwt.Token) (interface{}, error) {
-		// check if the signing method is the expected one
-		if token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := ks.Lookup(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown kid: %v", kid)
+		}
+		// check if the signing method is the one bound to the key
+		if token.Method.Alg() != key.Method.Alg() {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-
-		//return the secret key
-		return []byte("secret"), nil
+		//return the verify key
+		return key.verifyKey, nil
 	})
-
 	if err != nil {
 		return nil, err
 	}
@@ -74,5 +100,9 @@ func VerifyJwtToken(tokenString string) (*JwtCustomClaims, error) {
 		return nil, fmt.Errorf("token expired")
 	}
 
+	// check if the token is used for the expected purpose
+	if customClaims.TokenUse != tokenUse {
+		return nil, fmt.Errorf("unexpected token use: %v", customClaims.TokenUse)
+	}
 	return &customClaims, nil
 }
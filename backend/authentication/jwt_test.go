@@ -1,6 +1,7 @@
 package authentication
 
 import (
+	"sthl/constants"
 	"testing"
 	"time"
 
@@ -17,8 +18,8 @@ type generateJwtTokenTestCase struct {
 }
 
 func Test_GenerateJwtToken(t *testing.T) {
-	t.Setenv("JWT_SECRET", "welvknmerbginwuenjkvnuer")
 	assert := assert.New(t)
+	ks := newTestKeySet(assert, "k1", "welvknmerbginwuenjkvnuer")
 
 	testCases := []generateJwtTokenTestCase{
 		{
@@ -60,7 +61,7 @@ func Test_GenerateJwtToken(t *testing.T) {
 	}
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			test.exec(GenerateJwtToken(test.inputDuration, test.input))
+			test.exec(GenerateJwtToken(ks, constants.TokenUse.Access, uuid.NewString(), test.inputDuration, test.input, ""))
 		})
 	}
 }
@@ -73,12 +74,16 @@ type verifyJwtTokenTestCase struct {
 }
 
 func Test_VerifyJwtToken(t *testing.T) {
-	t.Setenv("JWT_SECRET", "welvknmerbginwuenjkvnuer")
 	assert := assert.New(t)
+	ks := newTestKeySet(assert, "k1", "welvknmerbginwuenjkvnuer")
 
 	validUserId := "userId"
-	validToken, _ := GenerateJwtToken(time.Hour, validUserId)
-	expiredToken, _ := GenerateJwtToken(-time.Hour, validUserId)
+	validJti := uuid.NewString()
+	validToken, _ := GenerateJwtToken(ks, constants.TokenUse.Access, validJti, time.Hour, validUserId, "")
+	expiredToken, _ := GenerateJwtToken(ks, constants.TokenUse.Access, uuid.NewString(), -time.Hour, validUserId, "")
+	refreshToken, _ := GenerateJwtToken(ks, constants.TokenUse.Refresh, uuid.NewString(), time.Hour, validUserId, "")
+	otherKs := newTestKeySet(assert, "k2", "qwdqwfqwfqwfqwfqwfqwfqwf")
+	unknownKidToken, _ := GenerateJwtToken(otherKs, constants.TokenUse.Access, uuid.NewString(), time.Hour, validUserId, "")
 
 	testCases := []verifyJwtTokenTestCase{
 		{
@@ -88,6 +93,16 @@ func Test_VerifyJwtToken(t *testing.T) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
 				assert.Equal(validUserId, result.UserId)
+				assert.Equal(validJti, result.ID)
+				assert.Equal(constants.TokenUse.Access, result.TokenUse)
+			},
+		},
+		{
+			name:  "refresh token used as access token",
+			input: refreshToken,
+			exec: func(result *JwtCustomClaims, e error) {
+				assert.Empty(result)
+				assert.Error(e)
 			},
 		},
 		{
@@ -106,6 +121,14 @@ func Test_VerifyJwtToken(t *testing.T) {
 				assert.Error(e)
 			},
 		},
+		{
+			name:  "unknown kid token",
+			input: unknownKidToken,
+			exec: func(result *JwtCustomClaims, e error) {
+				assert.Empty(result)
+				assert.Error(e)
+			},
+		},
 		{
 			name:  "empty token",
 			input: "",
@@ -117,7 +140,7 @@ func Test_VerifyJwtToken(t *testing.T) {
 	}
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			test.exec(VerifyJwtToken(test.input))
+			test.exec(VerifyJwtToken(ks, constants.TokenUse.Access, test.input))
 		})
 	}
 }
@@ -0,0 +1,275 @@
+package authentication
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"sthl/config"
+
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+)
+
+// JwtKey: one jwt key identified by kid,
+// signKey is nil if the key is only kept for verification
+type JwtKey struct {
+	Kid       string
+	Method    jwt.SigningMethod
+	signKey   any
+	verifyKey any
+}
+
+// NewHmacKey: symmetric key, same secret for sign and verify
+func NewHmacKey(kid string, secret []byte) (*JwtKey, error) {
+	if kid == "" {
+		return nil, fmt.Errorf("kid is empty")
+	}
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("secret is empty")
+	}
+	return &JwtKey{
+		Kid:       kid,
+		Method:    jwt.SigningMethodHS256,
+		signKey:   secret,
+		verifyKey: secret,
+	}, nil
+}
+
+// NewPrivateKeyFromPem: RS256 or EdDSA key pair from pem encoded private key
+func NewPrivateKeyFromPem(kid string, alg string, pemBytes []byte) (*JwtKey, error) {
+	if kid == "" {
+		return nil, fmt.Errorf("kid is empty")
+	}
+	switch alg {
+	case jwt.SigningMethodRS256.Alg():
+		priv, err := jwt.ParseRSAPrivateKeyFromPEM(pemBytes)
+		if err != nil {
+			return nil, err
+		}
+		return &JwtKey{Kid: kid, Method: jwt.SigningMethodRS256, signKey: priv, verifyKey: &priv.PublicKey}, nil
+	case jwt.SigningMethodEdDSA.Alg():
+		priv, err := jwt.ParseEdPrivateKeyFromPEM(pemBytes)
+		if err != nil {
+			return nil, err
+		}
+		edPriv, ok := priv.(ed25519.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("not ed25519 private key")
+		}
+		return &JwtKey{Kid: kid, Method: jwt.SigningMethodEdDSA, signKey: edPriv, verifyKey: edPriv.Public()}, nil
+	default:
+		return nil, fmt.Errorf("unsupported alg: %s", alg)
+	}
+}
+
+// NewPublicKeyFromPem: RS256 or EdDSA verify only key from pem encoded public key
+func NewPublicKeyFromPem(kid string, alg string, pemBytes []byte) (*JwtKey, error) {
+	if kid == "" {
+		return nil, fmt.Errorf("kid is empty")
+	}
+	switch alg {
+	case jwt.SigningMethodRS256.Alg():
+		pub, err := jwt.ParseRSAPublicKeyFromPEM(pemBytes)
+		if err != nil {
+			return nil, err
+		}
+		return &JwtKey{Kid: kid, Method: jwt.SigningMethodRS256, verifyKey: pub}, nil
+	case jwt.SigningMethodEdDSA.Alg():
+		pub, err := jwt.ParseEdPublicKeyFromPEM(pemBytes)
+		if err != nil {
+			return nil, err
+		}
+		return &JwtKey{Kid: kid, Method: jwt.SigningMethodEdDSA, verifyKey: pub}, nil
+	default:
+		return nil, fmt.Errorf("unsupported alg: %s", alg)
+	}
+}
+
+// CanSign: return true if key holds private part or hmac secret
+func (k *JwtKey) CanSign() bool {
+	return k.signKey != nil
+}
+
+// KeySet: active key to sign new tokens,
+// plus all keys still accepted for verification, indexed by kid
+type KeySet struct {
+	active *JwtKey
+	keys   map[string]*JwtKey
+}
+
+// NewKeySet: active key must be able to sign,
+// retired keys are only used to verify tokens issued before rotation
+func NewKeySet(active *JwtKey, retired ...*JwtKey) (*KeySet, error) {
+	if active == nil {
+		return nil, fmt.Errorf("active key is nil")
+	}
+	if !active.CanSign() {
+		return nil, fmt.Errorf("active key cannot sign")
+	}
+	keys := map[string]*JwtKey{active.Kid: active}
+	for _, k := range retired {
+		if k == nil {
+			continue
+		}
+		if _, exist := keys[k.Kid]; exist {
+			return nil, fmt.Errorf("duplicated kid: %s", k.Kid)
+		}
+		keys[k.Kid] = k
+	}
+	return &KeySet{
+		active: active,
+		keys:   keys,
+	}, nil
+}
+
+// NewKeySetFromConfig: build key set from config for fx
+func NewKeySetFromConfig(l *zap.Logger, cfg *config.Config) (*KeySet, error) {
+	alg := cfg.GetJwtAlg()
+	kid := cfg.GetJwtKid()
+
+	// active key
+	var active *JwtKey
+	if alg == jwt.SigningMethodHS256.Alg() {
+		secret, err := cfg.GetJwtSecret()
+		if err != nil {
+			return nil, err
+		}
+		active, err = NewHmacKey(kid, []byte(secret))
+		if err != nil {
+			l.Info("fail to NewHmacKey", zap.Error(err))
+			return nil, err
+		}
+	} else {
+		pemBytes, err := os.ReadFile(cfg.GetJwtPrivateKeyFile())
+		if err != nil {
+			l.Info("fail to read JWT_PRIVATE_KEY_FILE", zap.Error(err))
+			return nil, err
+		}
+		active, err = NewPrivateKeyFromPem(kid, alg, pemBytes)
+		if err != nil {
+			l.Info("fail to NewPrivateKeyFromPem", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	// retired hmac keys
+	retired := []*JwtKey{}
+	prevSecrets, err := cfg.GetJwtPreviousSecrets()
+	if err != nil {
+		return nil, err
+	}
+	for prevKid, secret := range prevSecrets {
+		k, err := NewHmacKey(prevKid, []byte(secret))
+		if err != nil {
+			l.Info("fail to NewHmacKey", zap.String("kid", prevKid), zap.Error(err))
+			return nil, err
+		}
+		retired = append(retired, k)
+	}
+
+	// retired or foreign public keys, each parsed by its own alg
+	for prevKid, file := range cfg.GetJwtPublicKeyFiles() {
+		pemBytes, err := os.ReadFile(file.Path)
+		if err != nil {
+			l.Info("fail to read public key file", zap.String("kid", prevKid), zap.Error(err))
+			return nil, err
+		}
+		k, err := NewPublicKeyFromPem(prevKid, file.Alg, pemBytes)
+		if err != nil {
+			l.Info("fail to NewPublicKeyFromPem", zap.String("kid", prevKid), zap.Error(err))
+			return nil, err
+		}
+		retired = append(retired, k)
+	}
+
+	ks, err := NewKeySet(active, retired...)
+	if err != nil {
+		l.Info("fail to NewKeySet", zap.Error(err))
+		return nil, err
+	}
+	l.Info("jwt key set loaded", zap.String("alg", alg), zap.String("activeKid", kid), zap.Int("keys", len(ks.keys)))
+	return ks, nil
+}
+
+// Active
+func (ks *KeySet) Active() *JwtKey {
+	return ks.active
+}
+
+// Lookup: get verification key by kid,
+// tokens without kid header fall back to active key
+func (ks *KeySet) Lookup(kid string) (*JwtKey, bool) {
+	if kid == "" {
+		return ks.active, true
+	}
+	k, ok := ks.keys[kid]
+	return k, ok
+}
+
+// Algs: all algs accepted by the key set
+func (ks *KeySet) Algs() []string {
+	seen := map[string]bool{}
+	algs := []string{}
+	for _, k := range ks.keys {
+		alg := k.Method.Alg()
+		if !seen[alg] {
+			seen[alg] = true
+			algs = append(algs, alg)
+		}
+	}
+	sort.Strings(algs)
+	return algs
+}
+
+// ****Jwks
+
+type Jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// OKP
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type Jwks struct {
+	Keys []Jwk `json:"keys"`
+}
+
+// PublicJwks: publish asymmetric verification keys,
+// hmac secrets are never exposed
+func (ks *KeySet) PublicJwks() *Jwks {
+	result := &Jwks{Keys: []Jwk{}}
+	for _, k := range ks.keys {
+		switch pub := k.verifyKey.(type) {
+		case *rsa.PublicKey:
+			result.Keys = append(result.Keys, Jwk{
+				Kty: "RSA",
+				Kid: k.Kid,
+				Use: "sig",
+				Alg: k.Method.Alg(),
+				N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			result.Keys = append(result.Keys, Jwk{
+				Kty: "OKP",
+				Kid: k.Kid,
+				Use: "sig",
+				Alg: k.Method.Alg(),
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(pub),
+			})
+		}
+	}
+	sort.Slice(result.Keys, func(i, j int) bool { return result.Keys[i].Kid < result.Keys[j].Kid })
+	return result
+}
@@ -0,0 +1,245 @@
+package authentication
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"sthl/config"
+	"sthl/constants"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// newTestKeySet
+func newTestKeySet(assert *assert.Assertions, kid string, secret string) *KeySet {
+	key, err := NewHmacKey(kid, []byte(secret))
+	assert.NotEmpty(key)
+	assert.NoError(err)
+	ks, err := NewKeySet(key)
+	assert.NotEmpty(ks)
+	assert.NoError(err)
+	return ks
+}
+
+// newTestRsaPem
+func newTestRsaPem(assert *assert.Assertions) ([]byte, []byte) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(err)
+	pubBytes, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+	assert.NoError(err)
+	privPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(priv)})
+	pubPem := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubBytes})
+	return privPem, pubPem
+}
+
+// newTestEdPem
+func newTestEdPem(assert *assert.Assertions) ([]byte, []byte) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(err)
+	privBytes, err := x509.MarshalPKCS8PrivateKey(priv)
+	assert.NoError(err)
+	pubBytes, err := x509.MarshalPKIXPublicKey(pub)
+	assert.NoError(err)
+	privPem := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privBytes})
+	pubPem := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubBytes})
+	return privPem, pubPem
+}
+
+// ****Test_NewKeySet
+type newKeySetTestCase struct {
+	name    string
+	active  *JwtKey
+	retired []*JwtKey
+	exec    func(*KeySet, error)
+}
+
+func Test_NewKeySet(t *testing.T) {
+	assert := assert.New(t)
+	k1, _ := NewHmacKey("k1", []byte("secret1"))
+	k2, _ := NewHmacKey("k2", []byte("secret2"))
+	k1Dup, _ := NewHmacKey("k1", []byte("secret3"))
+	_, pubPem := newTestRsaPem(assert)
+	pubOnly, err := NewPublicKeyFromPem("pub", "RS256", pubPem)
+	assert.NoError(err)
+
+	testCases := []newKeySetTestCase{
+		{
+			name:    "valid case",
+			active:  k1,
+			retired: []*JwtKey{k2},
+			exec: func(result *KeySet, e error) {
+				assert.NotEmpty(result)
+				assert.NoError(e)
+				assert.Equal("k1", result.Active().Kid)
+			},
+		},
+		{
+			name:   "nil active key",
+			active: nil,
+			exec: func(result *KeySet, e error) {
+				assert.Empty(result)
+				assert.Error(e)
+			},
+		},
+		{
+			name:   "active key cannot sign",
+			active: pubOnly,
+			exec: func(result *KeySet, e error) {
+				assert.Empty(result)
+				assert.Error(e)
+			},
+		},
+		{
+			name:    "duplicated kid",
+			active:  k1,
+			retired: []*JwtKey{k1Dup},
+			exec: func(result *KeySet, e error) {
+				assert.Empty(result)
+				assert.Error(e)
+			},
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			test.exec(NewKeySet(test.active, test.retired...))
+		})
+	}
+}
+
+// ****Test_KeyRotation
+func Test_KeyRotation(t *testing.T) {
+	assert := assert.New(t)
+	userId := uuid.NewString()
+
+	// token issued before rotation
+	oldKey, _ := NewHmacKey("old", []byte("oldsecret"))
+	oldKs, err := NewKeySet(oldKey)
+	assert.NoError(err)
+	oldToken, err := GenerateJwtToken(oldKs, constants.TokenUse.Access, uuid.NewString(), time.Hour, userId, "")
+	assert.NoError(err)
+
+	// rotate: new active key, old key retired
+	newKey, _ := NewHmacKey("new", []byte("newsecret"))
+	rotatedKs, err := NewKeySet(newKey, oldKey)
+	assert.NoError(err)
+	claims, err := VerifyJwtToken(rotatedKs, constants.TokenUse.Access, oldToken)
+	assert.NoError(err)
+	assert.Equal(userId, claims.UserId)
+
+	newToken, err := GenerateJwtToken(rotatedKs, constants.TokenUse.Access, uuid.NewString(), time.Hour, userId, "")
+	assert.NoError(err)
+	claims, err = VerifyJwtToken(rotatedKs, constants.TokenUse.Access, newToken)
+	assert.NoError(err)
+	assert.Equal(userId, claims.UserId)
+
+	// drop old key: old token rejected
+	droppedKs, err := NewKeySet(newKey)
+	assert.NoError(err)
+	_, err = VerifyJwtToken(droppedKs, constants.TokenUse.Access, oldToken)
+	assert.Error(err)
+}
+
+// ****Test_AsymmetricKeys
+type asymmetricKeysTestCase struct {
+	name string
+	alg  string
+	pems func(*assert.Assertions) ([]byte, []byte)
+}
+
+func Test_AsymmetricKeys(t *testing.T) {
+	assert := assert.New(t)
+	userId := uuid.NewString()
+
+	testCases := []asymmetricKeysTestCase{
+		{name: "RS256", alg: "RS256", pems: newTestRsaPem},
+		{name: "EdDSA", alg: "EdDSA", pems: newTestEdPem},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			privPem, pubPem := test.pems(assert)
+			signer, err := NewPrivateKeyFromPem("a1", test.alg, privPem)
+			assert.NoError(err)
+			signerKs, err := NewKeySet(signer)
+			assert.NoError(err)
+			token, err := GenerateJwtToken(signerKs, constants.TokenUse.Access, uuid.NewString(), time.Hour, userId, "")
+			assert.NoError(err)
+
+			// verify with public key only, as other services do
+			verifier, err := NewPublicKeyFromPem("a1", test.alg, pubPem)
+			assert.NoError(err)
+			hmacKey, _ := NewHmacKey("h1", []byte("secret"))
+			verifierKs, err := NewKeySet(hmacKey, verifier)
+			assert.NoError(err)
+			claims, err := VerifyJwtToken(verifierKs, constants.TokenUse.Access, token)
+			assert.NoError(err)
+			assert.Equal(userId, claims.UserId)
+
+			// jwks exposes public key, never hmac secret
+			jwks := verifierKs.PublicJwks()
+			assert.Len(jwks.Keys, 1)
+			assert.Equal("a1", jwks.Keys[0].Kid)
+			assert.Equal(test.alg, jwks.Keys[0].Alg)
+		})
+	}
+}
+
+// ****Test_NewKeySetFromConfig
+func Test_NewKeySetFromConfig(t *testing.T) {
+	assert := assert.New(t)
+	userId := uuid.NewString()
+	for key, value := range map[string]string{
+		"NODE_ENV": "develop", "PORT": "4000", "DB_DOMAIN": "127.0.0.1", "DB_USER": "postgres", "DB_PASSWORD": "postgres",
+		"DB_PORT": "5432", "JWT_ALG": constants.JwtAlgHS256, "JWT_SECRET": "testsecret", "ALLOW_ORIGIN": "http://localhost:3000",
+		"AWS_ACCESS_KEY_ID": "test", "AWS_SECRET_ACCESS_KEY": "test", "AWS_REGION": "ap-east-1",
+		"S3_PATH": "http://localhost:4566", "VERSION": "v1",
+	} {
+		t.Setenv(key, value)
+	}
+
+	// public keys of other algs than active one
+	dir := t.TempDir()
+	tokens := map[string]string{}
+	files := []string{}
+	for kid, alg := range map[string]string{"r1": constants.JwtAlgRS256, "e1": constants.JwtAlgEdDSA} {
+		pems := lo.Ternary(alg == constants.JwtAlgRS256, newTestRsaPem, newTestEdPem)
+		privPem, pubPem := pems(assert)
+		signer, err := NewPrivateKeyFromPem(kid, alg, privPem)
+		assert.NoError(err)
+		signerKs, err := NewKeySet(signer)
+		assert.NoError(err)
+		tokens[kid], err = GenerateJwtToken(signerKs, constants.TokenUse.Access, uuid.NewString(), time.Hour, userId, "")
+		assert.NoError(err)
+		file := filepath.Join(dir, kid+".pem")
+		assert.NoError(os.WriteFile(file, pubPem, 0o600))
+		files = append(files, kid+":"+alg+":"+file)
+	}
+	t.Setenv("JWT_PUBLIC_KEY_FILES", strings.Join(files, ","))
+
+	cfg, ok := config.NewConfig(zap.NewNop())
+	assert.True(ok)
+	ks, err := NewKeySetFromConfig(zap.NewNop(), cfg)
+	assert.NoError(err)
+	assert.Equal([]string{constants.JwtAlgEdDSA, constants.JwtAlgHS256, constants.JwtAlgRS256}, ks.Algs())
+	for kid, token := range tokens {
+		claims, err := VerifyJwtToken(ks, constants.TokenUse.Access, token)
+		assert.NoError(err, kid)
+		assert.Equal(userId, claims.UserId)
+	}
+
+	// alg missing or unsupported
+	for _, raw := range []string{"r1:" + filepath.Join(dir, "r1.pem"), "r1:HS256:" + filepath.Join(dir, "r1.pem")} {
+		t.Setenv("JWT_PUBLIC_KEY_FILES", raw)
+		_, ok = config.NewConfig(zap.NewNop())
+		assert.False(ok, raw)
+	}
+}
@@ -2,23 +2,40 @@ package authentication
 
 import (
 	"fmt"
+	"sthl/constants"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+// Passport: MfaToken is set instead of access and refresh tokens
+// when login still needs a second factor
 type Passport struct {
 	AccessToken  string `json:"accessToken"`
 	RefreshToken string `json:"refreshToken"`
+	MfaToken     string `json:"mfaToken,omitempty"`
+}
+
+// GenerateMfaPendingPassport: short lived token only accepted by mfa verify
+func GenerateMfaPendingPassport(ks *KeySet, duration time.Duration, userId string, shopId string) (*Passport, error) {
+	mfaToken, err := GenerateJwtToken(ks, constants.TokenUse.MfaPending, uuid.NewString(), duration, userId, shopId)
+	if err != nil {
+		return nil, err
+	}
+	return &Passport{MfaToken: mfaToken}, nil
 }
 
-func GeneratePassport(atDuration time.Duration, rtDuration time.Duration, userId string) (*Passport, error) {
+// GeneratePassport: refreshJti is decided by caller so the refresh token can be stored server side
+func GeneratePassport(ks *KeySet, atDuration time.Duration, rtDuration time.Duration,
+	userId string, shopId string, refreshJti string) (*Passport, error) {
 	if userId == "" {
 		return nil, fmt.Errorf("userId cannot be empty")
 	}
-	accessToken, err := GenerateJwtToken(atDuration, userId)
+	accessToken, err := GenerateJwtToken(ks, constants.TokenUse.Access, uuid.NewString(), atDuration, userId, shopId)
 	if err != nil {
 		return nil, err
 	}
-	refreshToken, err := GenerateJwtToken(rtDuration, userId)
+	refreshToken, err := GenerateJwtToken(ks, constants.TokenUse.Refresh, refreshJti, rtDuration, userId, shopId)
 	if err != nil {
 		return nil, err
 	}
@@ -29,12 +46,12 @@ func GeneratePassport(atDuration time.Duration, rtDuration time.Duration, userId
 	return &pp, nil
 }
 
-func VerifyPassport(pp Passport) (*JwtCustomClaims, *JwtCustomClaims, error) {
-	aToken, err := VerifyJwtToken(pp.AccessToken)
+func VerifyPassport(ks *KeySet, pp Passport) (*JwtCustomClaims, *JwtCustomClaims, error) {
+	aToken, err := VerifyJwtToken(ks, constants.TokenUse.Access, pp.AccessToken)
 	if err != nil {
 		return nil, nil, err
 	}
-	rToken, err := VerifyJwtToken(pp.RefreshToken)
+	rToken, err := VerifyJwtToken(ks, constants.TokenUse.Refresh, pp.RefreshToken)
 	if err != nil {
 		return nil, nil, err
 	}
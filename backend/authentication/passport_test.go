@@ -18,8 +18,8 @@ type generatePassportTestCase struct {
 }
 
 func Test_GeneratePassport(t *testing.T) {
-	t.Setenv("JWT_SECRET", "welvknmerbginwuenjkvnuer")
 	assert := assert.New(t)
+	ks := newTestKeySet(assert, "k1", "welvknmerbginwuenjkvnuer")
 
 	validUserId := uuid.NewString()
 	testCases := []generatePassportTestCase{
@@ -48,7 +48,7 @@ func Test_GeneratePassport(t *testing.T) {
 	}
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			test.exec(GeneratePassport(test.inputAtDuration, test.inputRtDuration, test.input))
+			test.exec(GeneratePassport(ks, test.inputAtDuration, test.inputRtDuration, test.input, "", uuid.NewString()))
 		})
 	}
 }
@@ -61,19 +61,24 @@ type verifyPassportTestCase struct {
 }
 
 func Test_VerifyPassport(t *testing.T) {
-	t.Setenv("JWT_SECRET", "welvknmerbginwuenjkvnuer")
 	assert := assert.New(t)
+	ks := newTestKeySet(assert, "k1", "welvknmerbginwuenjkvnuer")
 
 	atDuration := time.Hour
 	rtDuration := 24 * time.Hour
 	validUserId := "userId"
-	validPp, err := GeneratePassport(atDuration, rtDuration, validUserId)
+	validShopId := uuid.NewString()
+	validJti := uuid.NewString()
+	validPp, err := GeneratePassport(ks, atDuration, rtDuration, validUserId, validShopId, validJti)
 	assert.NotEmpty(validPp)
 	assert.NoError(err)
 
 	invalidPp := *validPp
 	invalidPp.AccessToken += "qwdq"
 
+	swappedPp := *validPp
+	swappedPp.AccessToken, swappedPp.RefreshToken = validPp.RefreshToken, validPp.AccessToken
+
 	testCases := []verifyPassportTestCase{
 		{
 			name:  "valid case",
@@ -86,8 +91,12 @@ func Test_VerifyPassport(t *testing.T) {
 				assert.NotEmpty(resultRt.UserId)
 				assert.True(resultAt.UserId == resultRt.UserId)
 				assert.True(resultAt.UserId == validUserId)
+				assert.Equal(validShopId, resultAt.ShopId)
+				assert.Equal(validShopId, resultRt.ShopId)
 				assert.True(time.Now().Before(resultAt.ExpiresAt.Time))
 				assert.True(time.Now().Before(resultRt.ExpiresAt.Time))
+				assert.Equal(validJti, resultRt.ID)
+				assert.NotEqual(resultAt.ID, resultRt.ID)
 			},
 		},
 		{
@@ -99,10 +108,19 @@ func Test_VerifyPassport(t *testing.T) {
 				assert.Error(e)
 			},
 		},
+		{
+			name:  "swapped tokens",
+			input: swappedPp,
+			exec: func(resultAt *JwtCustomClaims, resultRt *JwtCustomClaims, e error) {
+				assert.Empty(resultAt)
+				assert.Empty(resultRt)
+				assert.Error(e)
+			},
+		},
 	}
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			test.exec(VerifyPassport(test.input))
+			test.exec(VerifyPassport(ks, test.input))
 		})
 	}
 }
@@ -0,0 +1,48 @@
+package authentication
+
+import (
+	"sthl/constants"
+
+	"github.com/samber/lo"
+)
+
+// shopRolePermissions: permissions granted to each shop role
+var shopRolePermissions = map[string][]string{
+	constants.ShopRole.Owner: {
+		constants.ShopPermission.ProductRead,
+		constants.ShopPermission.ProductWrite,
+		constants.ShopPermission.OrderRead,
+		constants.ShopPermission.OrderWrite,
+		constants.ShopPermission.SiteUiWrite,
+		constants.ShopPermission.AlbumRead,
+		constants.ShopPermission.AlbumWrite,
+		constants.ShopPermission.StaffManage,
+		constants.ShopPermission.ShopSettings,
+	},
+	constants.ShopRole.Manager: {
+		constants.ShopPermission.ProductRead,
+		constants.ShopPermission.ProductWrite,
+		constants.ShopPermission.OrderRead,
+		constants.ShopPermission.OrderWrite,
+		constants.ShopPermission.SiteUiWrite,
+		constants.ShopPermission.AlbumRead,
+		constants.ShopPermission.AlbumWrite,
+		constants.ShopPermission.ShopSettings,
+	},
+	constants.ShopRole.Fulfilment: {
+		constants.ShopPermission.ProductRead,
+		constants.ShopPermission.OrderRead,
+		constants.ShopPermission.OrderWrite,
+		constants.ShopPermission.AlbumRead,
+	},
+	constants.ShopRole.ReadOnly: {
+		constants.ShopPermission.ProductRead,
+		constants.ShopPermission.OrderRead,
+		constants.ShopPermission.AlbumRead,
+	},
+}
+
+// ShopRoleHasPermission: unknown role has no permission
+func ShopRoleHasPermission(role string, permission string) bool {
+	return lo.Contains(shopRolePermissions[role], permission)
+}
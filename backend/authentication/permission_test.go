@@ -0,0 +1,35 @@
+package authentication
+
+import (
+	"sthl/constants"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ****Test_ShopRoleHasPermission
+type shopRoleHasPermissionTestCase struct {
+	name       string
+	role       string
+	permission string
+	expected   bool
+}
+
+func Test_ShopRoleHasPermission(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []shopRoleHasPermissionTestCase{
+		{"owner manage staff", constants.ShopRole.Owner, constants.ShopPermission.StaffManage, true},
+		{"manager write product", constants.ShopRole.Manager, constants.ShopPermission.ProductWrite, true},
+		{"manager cannot manage staff", constants.ShopRole.Manager, constants.ShopPermission.StaffManage, false},
+		{"fulfilment write order", constants.ShopRole.Fulfilment, constants.ShopPermission.OrderWrite, true},
+		{"fulfilment cannot write product", constants.ShopRole.Fulfilment, constants.ShopPermission.ProductWrite, false},
+		{"readOnly read order", constants.ShopRole.ReadOnly, constants.ShopPermission.OrderRead, true},
+		{"readOnly cannot write order", constants.ShopRole.ReadOnly, constants.ShopPermission.OrderWrite, false},
+		{"unknown role", "unknown", constants.ShopPermission.ProductRead, false},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(test.expected, ShopRoleHasPermission(test.role, test.permission))
+		})
+	}
+}
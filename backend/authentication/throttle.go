@@ -0,0 +1,36 @@
+package authentication
+
+import (
+	"sthl/constants"
+	"strings"
+	"time"
+)
+
+// LoginThrottleKey: counter key of scope, value is case insensitive
+func LoginThrottleKey(scope string, value string) string {
+	return scope + ":" + strings.ToLower(strings.TrimSpace(value))
+}
+
+// LoginBackoff: wait required after consecutive failures,
+// zero below threshold, then doubling from base up to max
+func LoginBackoff(failures int) time.Duration {
+	if failures < constants.LoginBackoffThreshold {
+		return 0
+	}
+	delay := constants.LoginBackoffBase
+	for i := constants.LoginBackoffThreshold; i < failures; i++ {
+		delay *= 2
+		if delay >= constants.LoginBackoffMax {
+			return constants.LoginBackoffMax
+		}
+	}
+	return delay
+}
+
+// LoginLockoutThreshold: failures that lock scope out
+func LoginLockoutThreshold(scope string) int {
+	if scope == constants.LoginThrottleScope.Ip {
+		return constants.LoginIpLockoutThreshold
+	}
+	return constants.LoginAccountLockoutThreshold
+}
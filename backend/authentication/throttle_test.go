@@ -0,0 +1,74 @@
+package authentication
+
+import (
+	"sthl/constants"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ****Test_LoginBackoff
+type loginBackoffTestCase struct {
+	name  string
+	input int
+	exec  func(time.Duration)
+}
+
+func Test_LoginBackoff(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []loginBackoffTestCase{
+		{
+			name:  "no failure",
+			input: 0,
+			exec: func(result time.Duration) {
+				assert.Equal(time.Duration(0), result)
+			},
+		},
+		{
+			name:  "below threshold",
+			input: constants.LoginBackoffThreshold - 1,
+			exec: func(result time.Duration) {
+				assert.Equal(time.Duration(0), result)
+			},
+		},
+		{
+			name:  "at threshold",
+			input: constants.LoginBackoffThreshold,
+			exec: func(result time.Duration) {
+				assert.Equal(constants.LoginBackoffBase, result)
+			},
+		},
+		{
+			name:  "doubling",
+			input: constants.LoginBackoffThreshold + 2,
+			exec: func(result time.Duration) {
+				assert.Equal(4*constants.LoginBackoffBase, result)
+			},
+		},
+		{
+			name:  "capped",
+			input: 1000,
+			exec: func(result time.Duration) {
+				assert.Equal(constants.LoginBackoffMax, result)
+			},
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			test.exec(LoginBackoff(test.input))
+		})
+	}
+}
+
+// ****Test_LoginThrottleKey
+func Test_LoginThrottleKey(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(
+		LoginThrottleKey(constants.LoginThrottleScope.Account, "Foo@Example.com "),
+		LoginThrottleKey(constants.LoginThrottleScope.Account, "foo@example.com"))
+	assert.NotEqual(
+		LoginThrottleKey(constants.LoginThrottleScope.Account, "1.2.3.4"),
+		LoginThrottleKey(constants.LoginThrottleScope.Ip, "1.2.3.4"))
+}
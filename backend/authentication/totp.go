@@ -0,0 +1,117 @@
+package authentication
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sthl/constants"
+	"strings"
+	"time"
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTotpSecret: 160 bit base32 secret as recommended by RFC 4226
+func GenerateTotpSecret() (string, error) {
+	b := make([]byte, 20)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TotpProvisioningUri: otpauth uri rendered as QR code by authenticator apps
+func TotpProvisioningUri(secret string, account string) string {
+	label := url.PathEscape(constants.TotpIssuer + ":" + account)
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", constants.TotpIssuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(constants.TotpDigits))
+	query.Set("period", fmt.Sprint(constants.TotpPeriod))
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// TotpStep: time step counter of t
+func TotpStep(t time.Time) int64 {
+	return t.Unix() / constants.TotpPeriod
+}
+
+// TotpCode: RFC 6238 code of secret at step, HMAC-SHA1 with dynamic truncation
+func TotpCode(secret string, step int64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", err
+	}
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < constants.TotpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", constants.TotpDigits, value%mod), nil
+}
+
+// IsTotpCodeFormat: digits only with TotpDigits length, anything else is a recovery code
+func IsTotpCodeFormat(code string) bool {
+	if len(code) != constants.TotpDigits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifyTotp: accept code within TotpSkewSteps of now,
+// return matched step so caller can reject replay
+func VerifyTotp(secret string, code string, now time.Time) (int64, bool) {
+	if !IsTotpCodeFormat(code) {
+		return 0, false
+	}
+	current := TotpStep(now)
+	for step := current - constants.TotpSkewSteps; step <= current+constants.TotpSkewSteps; step++ {
+		expected, err := TotpCode(secret, step)
+		if err != nil {
+			return 0, false
+		}
+		if hmac.Equal([]byte(expected), []byte(code)) {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// GenerateRecoveryCodes: one time codes shown once, formatted as xxxxx-xxxxx
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	result := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		b := make([]byte, 5)
+		_, err := rand.Read(b)
+		if err != nil {
+			return nil, err
+		}
+		code := hex.EncodeToString(b)
+		result = append(result, code[:5]+"-"+code[5:])
+	}
+	return result, nil
+}
+
+// HashRecoveryCode: ignore case, spaces and dashes user may type
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return HashOpaqueToken(normalized)
+}
@@ -0,0 +1,92 @@
+package authentication
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ****Test_TotpCode, RFC 6238 SHA1 vectors truncated to 6 digits
+type totpCodeTestCase struct {
+	name     string
+	unix     int64
+	expected string
+}
+
+func Test_TotpCode(t *testing.T) {
+	assert := assert.New(t)
+	// base32 of ascii "12345678901234567890"
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	testCases := []totpCodeTestCase{
+		{"t 59", 59, "287082"},
+		{"t 1111111109", 1111111109, "081804"},
+		{"t 1234567890", 1234567890, "005924"},
+		{"t 20000000000", 20000000000, "353130"},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			code, err := TotpCode(secret, TotpStep(time.Unix(test.unix, 0)))
+			assert.NoError(err)
+			assert.Equal(test.expected, code)
+		})
+	}
+
+	_, err := TotpCode("not base32!", 1)
+	assert.Error(err)
+}
+
+// Test_VerifyTotp
+func Test_VerifyTotp(t *testing.T) {
+	assert := assert.New(t)
+	secret, err := GenerateTotpSecret()
+	assert.NoError(err)
+	now := time.Now()
+	step := TotpStep(now)
+
+	for _, s := range []int64{step - 1, step, step + 1} {
+		code, err := TotpCode(secret, s)
+		assert.NoError(err)
+		matched, ok := VerifyTotp(secret, code, now)
+		assert.True(ok)
+		assert.Equal(s, matched)
+	}
+	code, err := TotpCode(secret, step+2)
+	assert.NoError(err)
+	_, ok := VerifyTotp(secret, code, now)
+	assert.False(ok)
+	_, ok = VerifyTotp(secret, "12345", now)
+	assert.False(ok)
+	_, ok = VerifyTotp(secret, "abcdef", now)
+	assert.False(ok)
+}
+
+// Test_TotpProvisioningUri
+func Test_TotpProvisioningUri(t *testing.T) {
+	assert := assert.New(t)
+	uri := TotpProvisioningUri("JBSWY3DPEHPK3PXP", "a+b@test.local")
+	parsed, err := url.Parse(uri)
+	assert.NoError(err)
+	assert.Equal("otpauth", parsed.Scheme)
+	assert.Equal("totp", parsed.Host)
+	assert.Equal("/sthl:a+b@test.local", parsed.Path)
+	assert.Equal("JBSWY3DPEHPK3PXP", parsed.Query().Get("secret"))
+	assert.Equal("sthl", parsed.Query().Get("issuer"))
+}
+
+// Test_GenerateRecoveryCodes
+func Test_GenerateRecoveryCodes(t *testing.T) {
+	assert := assert.New(t)
+	codes, err := GenerateRecoveryCodes(10)
+	assert.NoError(err)
+	assert.Len(codes, 10)
+	seen := map[string]bool{}
+	for _, code := range codes {
+		assert.Len(code, 11)
+		assert.False(seen[code])
+		seen[code] = true
+		assert.Equal(HashRecoveryCode(code), HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", ""))))
+	}
+}
@@ -1,11 +1,15 @@
 package config
 
 import (
+	"fmt"
+	"net/netip"
 	"os"
 	"sthl/constants"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
 
@@ -18,11 +22,32 @@ type Config struct {
 	dbUser             string
 	dbPw               bool
 	jwtsecret          bool
+	jwtAlg             string
+	jwtKid             string
+	jwtPrevSecrets     bool
+	jwtPrivateKeyFile  string
+	jwtPublicKeyFiles  map[string]JwtPublicKeyFile
 	allowOrigin        string
 	awsAccessKeyId     bool
 	awsSecretAccessKey bool
 	awsRegion          string
 	s3Path             string
+	blobStore          string
+	blobBucket         string
+	blobLocalDir       string
+	blobPublicUrl      string
+	blobSigningSecret  bool
+	mailer             string
+	mailFrom           string
+	mailLinkBaseUrl    string
+	mailLogFile        string
+	smtpHost           string
+	smtpPort           int
+	smtpUsername       string
+	smtpPassword       bool
+	loginAttemptStore  string
+	trustedProxies     []netip.Prefix
+	adminToken         bool
 }
 
 // new config by env, return false if not found
@@ -81,13 +106,50 @@ func NewConfig(logger *zap.Logger) (*Config, bool) {
 		return nil, false
 	}
 
-	// JWT_SECRET
-	_, exist = os.LookupEnv("JWT_SECRET")
-	if !exist {
+	// JWT_ALG, optional, default HS256
+	jwtAlg, exist := os.LookupEnv("JWT_ALG")
+	if !exist || jwtAlg == "" {
+		jwtAlg = constants.JwtAlgHS256
+	}
+	if jwtAlg != constants.JwtAlgHS256 && jwtAlg != constants.JwtAlgRS256 && jwtAlg != constants.JwtAlgEdDSA {
+		logger.Info("fail to NewConfig", zap.String("err", "JWT_ALG not supported"))
+		return nil, false
+	}
+
+	// JWT_KID, optional, default primary
+	jwtKid, exist := os.LookupEnv("JWT_KID")
+	if !exist || jwtKid == "" {
+		jwtKid = constants.JwtDefaultKid
+	}
+
+	// JWT_SECRET, required for HS256
+	_, jwtSecretExist := os.LookupEnv("JWT_SECRET")
+	if !jwtSecretExist && jwtAlg == constants.JwtAlgHS256 {
 		logger.Info("fail to NewConfig", zap.String("err", "JWT_SECRET not set"))
 		return nil, false
 	}
 
+	// JWT_PRIVATE_KEY_FILE, required for RS256 and EdDSA
+	jwtPrivateKeyFile, exist := os.LookupEnv("JWT_PRIVATE_KEY_FILE")
+	if !exist && jwtAlg != constants.JwtAlgHS256 {
+		logger.Info("fail to NewConfig", zap.String("err", "JWT_PRIVATE_KEY_FILE not set"))
+		return nil, false
+	}
+
+	// JWT_PREVIOUS_SECRETS, optional, "kid:secret,kid:secret"
+	_prevSecrets, jwtPrevSecretsExist := os.LookupEnv("JWT_PREVIOUS_SECRETS")
+	if _, err := parseKidPairs(_prevSecrets); err != nil {
+		logger.Info("fail to NewConfig", zap.String("err", "JWT_PREVIOUS_SECRETS invalid"))
+		return nil, false
+	}
+
+	// JWT_PUBLIC_KEY_FILES, optional, "kid:alg:path,kid:alg:path", alg RS256 or EdDSA per key
+	jwtPublicKeyFiles, err := parseJwtPublicKeyFiles(os.Getenv("JWT_PUBLIC_KEY_FILES"))
+	if err != nil {
+		logger.Info("fail to NewConfig", zap.String("err", "JWT_PUBLIC_KEY_FILES invalid"))
+		return nil, false
+	}
+
 	// ALLOW_ORIGIN
 	allowOrigin, exist := os.LookupEnv("ALLOW_ORIGIN")
 	if !exist {
@@ -95,32 +157,127 @@ func NewConfig(logger *zap.Logger) (*Config, bool) {
 		return nil, false
 	}
 
-	// AWS_ACCESS_KEY_ID
-	_, exist = os.LookupEnv("AWS_ACCESS_KEY_ID")
-	if !exist {
+	// BLOB_STORE, optional, default s3
+	blobStore, exist := os.LookupEnv("BLOB_STORE")
+	if !exist || blobStore == "" {
+		blobStore = constants.BlobStore.S3
+	}
+	if !lo.Contains(constants.BlobStore.GetList(), blobStore) {
+		logger.Info("fail to NewConfig", zap.String("err", "BLOB_STORE not supported"))
+		return nil, false
+	}
+	isS3 := blobStore == constants.BlobStore.S3
+
+	// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, AWS_REGION, S3_PATH, required for s3 blob store
+	_, awsAccessKeyIdExist := os.LookupEnv("AWS_ACCESS_KEY_ID")
+	if isS3 && !awsAccessKeyIdExist {
 		logger.Info("fail to NewConfig", zap.String("err", "AWS_ACCESS_KEY_ID not set"))
 		return nil, false
 	}
-	// AWS_SECRET_ACCESS_KEY
-	_, exist = os.LookupEnv("AWS_SECRET_ACCESS_KEY")
-	if !exist {
+	_, awsSecretAccessKeyExist := os.LookupEnv("AWS_SECRET_ACCESS_KEY")
+	if isS3 && !awsSecretAccessKeyExist {
 		logger.Info("fail to NewConfig", zap.String("err", "AWS_SECRET_ACCESS_KEY not set"))
 		return nil, false
 	}
-	// AWS_REGION
 	awsRegion, exist := os.LookupEnv("AWS_REGION")
-	if !exist {
+	if isS3 && !exist {
 		logger.Info("fail to NewConfig", zap.String("err", "AWS_REGION not set"))
 		return nil, false
 	}
-
-	// S3_PATH
 	s3Path, exist := os.LookupEnv("S3_PATH")
-	if !exist {
+	if isS3 && !exist {
 		logger.Info("fail to NewConfig", zap.String("err", "S3_PATH not set"))
 		return nil, false
 	}
 
+	// BLOB_BUCKET, optional, s3 bucket or sub directory of local store
+	blobBucket, exist := os.LookupEnv("BLOB_BUCKET")
+	if !exist || blobBucket == "" {
+		blobBucket = constants.S3BucketName
+	}
+
+	// BLOB_LOCAL_DIR, required for local blob store
+	blobLocalDir, exist := os.LookupEnv("BLOB_LOCAL_DIR")
+	if blobStore == constants.BlobStore.Local && (!exist || blobLocalDir == "") {
+		logger.Info("fail to NewConfig", zap.String("err", "BLOB_LOCAL_DIR not set"))
+		return nil, false
+	}
+
+	// BLOB_PUBLIC_URL, optional, origin of api serving local and memory blobs
+	blobPublicUrl, exist := os.LookupEnv("BLOB_PUBLIC_URL")
+	if !exist || blobPublicUrl == "" {
+		blobPublicUrl = fmt.Sprintf("http://localhost:%d", port)
+	}
+	blobPublicUrl = strings.TrimRight(blobPublicUrl, "/")
+
+	// BLOB_SIGNING_SECRET, optional, signed urls of local and memory blobs only valid until restart if not set
+	_, blobSigningSecretExist := os.LookupEnv("BLOB_SIGNING_SECRET")
+
+	// ADMIN_TOKEN, optional, operator routes such as plan assignment disabled if not set
+	adminToken, adminTokenExist := os.LookupEnv("ADMIN_TOKEN")
+
+	// MAILER, optional, default log
+	mailer, exist := os.LookupEnv("MAILER")
+	if !exist || mailer == "" {
+		mailer = constants.MailerType.Log
+	}
+	if mailer != constants.MailerType.Smtp && mailer != constants.MailerType.Log {
+		logger.Info("fail to NewConfig", zap.String("err", "MAILER not supported"))
+		return nil, false
+	}
+
+	// MAIL_FROM, optional
+	mailFrom, exist := os.LookupEnv("MAIL_FROM")
+	if !exist || mailFrom == "" {
+		mailFrom = "no-reply@sthl.local"
+	}
+
+	// MAIL_LINK_BASE_URL, optional, default ALLOW_ORIGIN
+	mailLinkBaseUrl, exist := os.LookupEnv("MAIL_LINK_BASE_URL")
+	if !exist || mailLinkBaseUrl == "" {
+		mailLinkBaseUrl = allowOrigin
+	}
+
+	// MAIL_LOG_FILE, optional, log mailer appends to file if set
+	mailLogFile := os.Getenv("MAIL_LOG_FILE")
+
+	// SMTP_HOST, SMTP_PORT, required for smtp mailer
+	smtpHost, smtpHostExist := os.LookupEnv("SMTP_HOST")
+	_smtpPort, smtpPortExist := os.LookupEnv("SMTP_PORT")
+	if mailer == constants.MailerType.Smtp && (!smtpHostExist || !smtpPortExist) {
+		logger.Info("fail to NewConfig", zap.String("err", "SMTP_HOST or SMTP_PORT not set"))
+		return nil, false
+	}
+	smtpPort := 0
+	if smtpPortExist {
+		smtpPort, err = strconv.Atoi(_smtpPort)
+		if err != nil {
+			logger.Info("fail to NewConfig", zap.String("err", "_smtpPort cannot convert to int"))
+			return nil, false
+		}
+	}
+	// SMTP_USERNAME, SMTP_PASSWORD, optional
+	smtpUsername := os.Getenv("SMTP_USERNAME")
+	_, smtpPasswordExist := os.LookupEnv("SMTP_PASSWORD")
+
+	// LOGIN_ATTEMPT_STORE, optional, default postgres so replicas share counters
+	loginAttemptStore, exist := os.LookupEnv("LOGIN_ATTEMPT_STORE")
+	if !exist || loginAttemptStore == "" {
+		loginAttemptStore = constants.LoginAttemptStore.Postgres
+	}
+	if loginAttemptStore != constants.LoginAttemptStore.Memory && loginAttemptStore != constants.LoginAttemptStore.Postgres {
+		logger.Info("fail to NewConfig", zap.String("err", "LOGIN_ATTEMPT_STORE not supported"))
+		return nil, false
+	}
+
+	// TRUSTED_PROXIES, optional, comma separated ips or cidrs of proxies whose forwarding headers are honoured,
+	// none by default so client ip is the remote address
+	trustedProxies, err := parseTrustedProxies(os.Getenv("TRUSTED_PROXIES"))
+	if err != nil {
+		logger.Info("fail to NewConfig", zap.String("err", "TRUSTED_PROXIES invalid"), zap.Error(err))
+		return nil, false
+	}
+
 	val := &Config{
 		logger:             logger,
 		nodeEnv:            nodeEnv,
@@ -129,12 +286,33 @@ func NewConfig(logger *zap.Logger) (*Config, bool) {
 		dbUser:             dbUser,
 		dbPw:               true,
 		dbPort:             dbPort,
-		jwtsecret:          true,
+		jwtsecret:          jwtSecretExist,
+		jwtAlg:             jwtAlg,
+		jwtKid:             jwtKid,
+		jwtPrevSecrets:     jwtPrevSecretsExist,
+		jwtPrivateKeyFile:  jwtPrivateKeyFile,
+		jwtPublicKeyFiles:  jwtPublicKeyFiles,
 		allowOrigin:        allowOrigin,
-		awsAccessKeyId:     true,
-		awsSecretAccessKey: true,
+		awsAccessKeyId:     awsAccessKeyIdExist,
+		awsSecretAccessKey: awsSecretAccessKeyExist,
 		awsRegion:          awsRegion,
 		s3Path:             s3Path,
+		blobStore:          blobStore,
+		blobBucket:         blobBucket,
+		blobLocalDir:       blobLocalDir,
+		blobPublicUrl:      blobPublicUrl,
+		blobSigningSecret:  blobSigningSecretExist,
+		mailer:             mailer,
+		mailFrom:           mailFrom,
+		mailLinkBaseUrl:    mailLinkBaseUrl,
+		mailLogFile:        mailLogFile,
+		smtpHost:           smtpHost,
+		smtpPort:           smtpPort,
+		smtpUsername:       smtpUsername,
+		smtpPassword:       smtpPasswordExist,
+		loginAttemptStore:  loginAttemptStore,
+		trustedProxies:     trustedProxies,
+		adminToken:         adminTokenExist && adminToken != "",
 	}
 	val.Print()
 	return val, true
@@ -148,11 +326,32 @@ func (c *Config) Print() {
 		zap.Bool("DB_PASSWORD", c.dbPw),
 		zap.Int("DB_PORT", c.dbPort),
 		zap.Bool("JWT_SECRET", c.jwtsecret),
+		zap.String("JWT_ALG", c.jwtAlg),
+		zap.String("JWT_KID", c.jwtKid),
+		zap.Bool("JWT_PREVIOUS_SECRETS", c.jwtPrevSecrets),
+		zap.String("JWT_PRIVATE_KEY_FILE", c.jwtPrivateKeyFile),
+		zap.Any("JWT_PUBLIC_KEY_FILES", c.jwtPublicKeyFiles),
 		zap.String("ALLOW_ORIGIN", c.allowOrigin),
 		zap.Bool("AWS_ACCESS_KEY_ID", c.awsAccessKeyId),
 		zap.Bool("AWS_SECRET_ACCESS_KEY", c.awsSecretAccessKey),
 		zap.String("AWS_REGION", c.awsRegion),
 		zap.String("S3_PATH", c.s3Path),
+		zap.String("BLOB_STORE", c.blobStore),
+		zap.String("BLOB_BUCKET", c.blobBucket),
+		zap.String("BLOB_LOCAL_DIR", c.blobLocalDir),
+		zap.String("BLOB_PUBLIC_URL", c.blobPublicUrl),
+		zap.Bool("BLOB_SIGNING_SECRET", c.blobSigningSecret),
+		zap.String("MAILER", c.mailer),
+		zap.String("MAIL_FROM", c.mailFrom),
+		zap.String("MAIL_LINK_BASE_URL", c.mailLinkBaseUrl),
+		zap.String("MAIL_LOG_FILE", c.mailLogFile),
+		zap.String("SMTP_HOST", c.smtpHost),
+		zap.Int("SMTP_PORT", c.smtpPort),
+		zap.String("SMTP_USERNAME", c.smtpUsername),
+		zap.Bool("SMTP_PASSWORD", c.smtpPassword),
+		zap.String("LOGIN_ATTEMPT_STORE", c.loginAttemptStore),
+		zap.Any("TRUSTED_PROXIES", c.trustedProxies),
+		zap.Bool("ADMIN_TOKEN", c.adminToken),
 	)
 }
 
@@ -190,6 +389,29 @@ func (c *Config) GetJwtSecret() (string, error) {
 	}
 	return secret, nil
 }
+func (c *Config) GetJwtAlg() string {
+	return c.jwtAlg
+}
+func (c *Config) GetJwtKid() string {
+	return c.jwtKid
+}
+
+// GetJwtPreviousSecrets: retired hmac secrets by kid, still accepted for verification
+func (c *Config) GetJwtPreviousSecrets() (map[string]string, error) {
+	pairs, err := parseKidPairs(os.Getenv("JWT_PREVIOUS_SECRETS"))
+	if err != nil {
+		c.logger.Error("fail to GetJwtPreviousSecrets")
+		return nil, err
+	}
+	return pairs, nil
+}
+func (c *Config) GetJwtPrivateKeyFile() string {
+	return c.jwtPrivateKeyFile
+}
+func (c *Config) GetJwtPublicKeyFiles() map[string]JwtPublicKeyFile {
+	return c.jwtPublicKeyFiles
+}
+
 func (c *Config) GetAllowOrigin() string {
 	return c.allowOrigin
 }
@@ -215,3 +437,121 @@ func (c *Config) GetAwsSecretAccessKey() (string, error) {
 func (c *Config) GetAwsRegion() string {
 	return c.awsRegion
 }
+func (c *Config) GetBlobStore() string {
+	return c.blobStore
+}
+func (c *Config) GetBlobBucket() string {
+	return c.blobBucket
+}
+func (c *Config) GetBlobLocalDir() string {
+	return c.blobLocalDir
+}
+func (c *Config) GetBlobPublicUrl() string {
+	return c.blobPublicUrl
+}
+func (c *Config) GetBlobSigningSecret() string {
+	return os.Getenv("BLOB_SIGNING_SECRET")
+}
+func (c *Config) GetAdminToken() string {
+	return os.Getenv("ADMIN_TOKEN")
+}
+func (c *Config) GetMailer() string {
+	return c.mailer
+}
+func (c *Config) GetMailFrom() string {
+	return c.mailFrom
+}
+func (c *Config) GetMailLinkBaseUrl() string {
+	return c.mailLinkBaseUrl
+}
+func (c *Config) GetMailLogFile() string {
+	return c.mailLogFile
+}
+func (c *Config) GetSmtpHost() string {
+	return c.smtpHost
+}
+func (c *Config) GetSmtpPort() int {
+	return c.smtpPort
+}
+func (c *Config) GetSmtpUsername() string {
+	return c.smtpUsername
+}
+func (c *Config) GetSmtpPassword() string {
+	return os.Getenv("SMTP_PASSWORD")
+}
+func (c *Config) GetLoginAttemptStore() string {
+	return c.loginAttemptStore
+}
+func (c *Config) GetTrustedProxies() []netip.Prefix {
+	return c.trustedProxies
+}
+
+// JwtPublicKeyFile: pem file of verify only key, alg of key independent of JWT_ALG
+type JwtPublicKeyFile struct {
+	Alg  string
+	Path string
+}
+
+// parseJwtPublicKeyFiles: parse "kid:alg:path,kid:alg:path" into map by kid, empty string gives empty map
+func parseJwtPublicKeyFiles(raw string) (map[string]JwtPublicKeyFile, error) {
+	pairs, err := parseKidPairs(raw)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]JwtPublicKeyFile{}
+	for kid, value := range pairs {
+		alg, path, found := strings.Cut(value, ":")
+		if !found || path == "" {
+			return nil, fmt.Errorf("kid %s: alg or path missing", kid)
+		}
+		if alg != constants.JwtAlgRS256 && alg != constants.JwtAlgEdDSA {
+			return nil, fmt.Errorf("kid %s: unsupported alg: %s", kid, alg)
+		}
+		result[kid] = JwtPublicKeyFile{Alg: alg, Path: path}
+	}
+	return result, nil
+}
+
+// parseKidPairs: parse "kid:value,kid:value" into map, empty string gives empty map
+func parseKidPairs(raw string) (map[string]string, error) {
+	result := map[string]string{}
+	if strings.TrimSpace(raw) == "" {
+		return result, nil
+	}
+	for _, pair := range strings.Split(raw, ",") {
+		kid, value, found := strings.Cut(strings.TrimSpace(pair), ":")
+		if !found || kid == "" || value == "" {
+			return nil, fmt.Errorf("invalid kid pair")
+		}
+		if _, exist := result[kid]; exist {
+			return nil, fmt.Errorf("duplicated kid: %s", kid)
+		}
+		result[kid] = value
+	}
+	return result, nil
+}
+
+// parseTrustedProxies: parse "ip,cidr" into prefixes, single ip as full length prefix, empty string gives none
+func parseTrustedProxies(raw string) ([]netip.Prefix, error) {
+	result := []netip.Prefix{}
+	if strings.TrimSpace(raw) == "" {
+		return result, nil
+	}
+	for _, value := range strings.Split(raw, ",") {
+		value = strings.TrimSpace(value)
+		if strings.Contains(value, "/") {
+			prefix, err := netip.ParsePrefix(value)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return result, nil
+}
@@ -8,22 +8,202 @@ const (
 	// Auth
 	AccessTokenKey       contextKey    = "accessToken"
 	AccessTokenInfoKey   contextKey    = "accessTokenInfo"
-	AccessTokenDuration  time.Duration = 1200 * time.Hour
+	AccessTokenDuration  time.Duration = 15 * time.Minute
 	RefreshTokenKey      contextKey    = "refreshToken"
-	RefreshTokenDuration time.Duration = 2400 * time.Hour
+	RefreshTokenDuration time.Duration = 30 * 24 * time.Hour
+	JwtAlgHS256          string        = "HS256"
+	JwtAlgRS256          string        = "RS256"
+	JwtAlgEdDSA          string        = "EdDSA"
+	JwtDefaultKid        string        = "primary"
+	// Email verification
+	EmailVerificationTokenDuration  time.Duration = 24 * time.Hour
+	EmailVerificationResendInterval time.Duration = time.Minute
+	EmailVerificationResendWindow   time.Duration = time.Hour
+	EmailVerificationResendMax      int           = 5
+	EmailVerificationPath           string        = "/verify-email"
+	// Password reset
+	PasswordResetTokenDuration time.Duration = time.Hour
+	PasswordResetInterval      time.Duration = time.Minute
+	PasswordResetPath          string        = "/reset-password"
+	// Login throttle
+	ClientIpKey                  contextKey    = "clientIp"
+	LoginBackoffThreshold        int           = 3
+	LoginBackoffBase             time.Duration = time.Second
+	LoginBackoffMax              time.Duration = 5 * time.Minute
+	LoginAccountLockoutThreshold int           = 10
+	LoginIpLockoutThreshold      int           = 50
+	LoginLockoutDuration         time.Duration = 15 * time.Minute
+	LoginFailureWindow           time.Duration = time.Hour
+	MaxLoginLockoutEvents        int           = 50
+	// Shop
+	ActiveShopKey      contextKey    = "activeShop"
+	ShopInviteDuration time.Duration = 7 * 24 * time.Hour
+	ShopInvitePath     string        = "/accept-invite"
+	// Money
+	DefaultCurrency string = "USD"
+	// Api key
+	ApiKeyHeader           string        = "X-Api-Key"
+	ApiKeyPrefix           string        = "sthl"
+	ApiKeyScopesKey        contextKey    = "apiKeyScopes"
+	ApiKeyDefaultDuration  time.Duration = 90 * 24 * time.Hour
+	ApiKeyMaxDurationDays  int           = 365
+	ApiKeyLastUsedInterval time.Duration = time.Minute
+	MaxApiKeys             int           = 20
+	// Mfa
+	MfaPendingTokenDuration time.Duration = 5 * time.Minute
+	MfaRecoveryCodeCount    int           = 10
+	TotpIssuer              string        = "sthl"
+	TotpPeriod              int64         = 30
+	TotpDigits              int           = 6
+	TotpSkewSteps           int64         = 1
+	// Idempotency
+	IdempotencyKeyHeader        string        = "Idempotency-Key"
+	IdempotentReplayedHeader    string        = "Idempotent-Replayed"
+	IdempotencyKeyMaxLength     int           = 255
+	IdempotencyKeyDuration      time.Duration = 24 * time.Hour
+	IdempotencyKeyLeaseDuration time.Duration = time.Minute
+	IdempotentRequestTimeout    time.Duration = 30 * time.Second // must stay below lease
+	IdempotencyKeySweepInterval time.Duration = 10 * time.Minute
+	IdempotencyMaxRequestSize   int64         = 1 << 20
+	// Stock reservation
+	StockReservationDuration      time.Duration = 30 * time.Minute
+	StockReservationSweepInterval time.Duration = time.Minute
+	StockReservationSweepBatch    int           = 100
 	// DB
 	AccountServiceDbName string = "account_db"
 	// s3
 	S3BucketName string = "sthl-dev"
+	// blob store, objects of local and memory store served under BlobPath
+	BlobPath          string        = "/api/v1/blobs"
+	BlobProbeDuration time.Duration = 10 * time.Second
 	// User pw
 	UserPwHashCost int = 10
 	// file
 	MaxFileSize  int64 = 4 << 20
 	MaxProducts  int   = 1000
 	MaxAlbumImgs int   = 1000
+	// plan limits of pro, free limits in PlanLimits
+	MaxStorageBytes  int64 = 2 << 30
+	MaxMonthlyOrders int   = 10000
+	// products read per query of csv export
+	ProductExportBatch int = 100
+	// product variant
+	MaxProductOptions      int = 3
+	MaxProductOptionValues int = 50
+	MaxProductVariants     int = 100
+	// product media
+	MaxProductMedia int = 20
+	// category and collection
+	MaxCategories         int = 500
+	MaxCategoryDepth      int = 5
+	MaxProductCategories  int = 20
+	MaxCollections        int = 100
+	MaxCollectionRules    int = 10
+	MaxCollectionProducts int = 500
+	// product search, generated tsvector column over name and description
+	ProductSearchColumn string = "search_vector"
+	// ProductSearchConfig: text search config of column and query, must match for index use
+	ProductSearchConfig string = "english"
+	// ProductSearchSimilarity: min trigram word similarity of query to name for typo fallback
+	ProductSearchSimilarity float64 = 0.3
 )
 
 var (
+	// Token Use
+	TokenUse = tokenUseType{
+		Access:     "access",
+		Refresh:    "refresh",
+		MfaPending: "mfaPending",
+	}
+	// Refresh Token Revoke Reason
+	RefreshTokenRevokeReason = refreshTokenRevokeReasonType{
+		Rotated:       "rotated",
+		ReuseDetected: "reuseDetected",
+		Logout:        "logout",
+		LogoutAll:     "logoutAll",
+		PasswordReset: "passwordReset",
+	}
+	// Login Throttle Scope
+	LoginThrottleScope = loginThrottleScopeType{
+		Account: "account",
+		Ip:      "ip",
+	}
+	// Login Attempt Store
+	LoginAttemptStore = loginAttemptStoreType{
+		Memory:   "memory",
+		Postgres: "postgres",
+	}
+	// Plan
+	Plan = planType{
+		Free: "free",
+		Pro:  "pro",
+	}
+	// PlanLimits: quota of each plan, pro up to the Max limits
+	PlanLimits = map[string]PlanLimit{
+		Plan.Free: {
+			Products:      100,
+			AlbumImgs:     100,
+			StorageBytes:  100 << 20,
+			MonthlyOrders: 100,
+		},
+		Plan.Pro: {
+			Products:      MaxProducts,
+			AlbumImgs:     MaxAlbumImgs,
+			StorageBytes:  MaxStorageBytes,
+			MonthlyOrders: MaxMonthlyOrders,
+		},
+	}
+	// Shop Role
+	ShopRole = shopRoleType{
+		Owner:      "owner",
+		Manager:    "manager",
+		Fulfilment: "fulfilment",
+		ReadOnly:   "readOnly",
+	}
+	// Shop Permission
+	ShopPermission = shopPermissionType{
+		ProductRead:  "productRead",
+		ProductWrite: "productWrite",
+		OrderRead:    "orderRead",
+		OrderWrite:   "orderWrite",
+		SiteUiWrite:  "siteUiWrite",
+		AlbumRead:    "albumRead",
+		AlbumWrite:   "albumWrite",
+		StaffManage:  "staffManage",
+		ShopSettings: "shopSettings",
+	}
+	// Api Key Scope
+	ApiKeyScope = apiKeyScopeType{
+		ProductsRead:  "products:read",
+		ProductsWrite: "products:write",
+		OrdersRead:    "orders:read",
+		OrdersWrite:   "orders:write",
+		SiteUiWrite:   "siteui:write",
+		AlbumRead:     "album:read",
+		AlbumWrite:    "album:write",
+	}
+	// Blob Store
+	BlobStore = blobStoreType{
+		S3:     "s3",
+		Local:  "local",
+		Memory: "memory",
+	}
+	// Album image content types sniffed from file, svg excluded as it can carry script
+	AlbumImgContentTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp"}
+	// Mailer
+	MailerType = mailerType{
+		Smtp: "smtp",
+		Log:  "log",
+	}
+	// Currency, ISO 4217 codes with 2 minor digits only, amounts held at money.AmountScale
+	Currency = currencyType{
+		Usd: "USD",
+		Hkd: "HKD",
+		Eur: "EUR",
+		Gbp: "GBP",
+		Cny: "CNY",
+		Sgd: "SGD",
+	}
 	// Product Status
 	ProductStatus = productStatusType{
 		Initiated:  "initiated",
@@ -31,6 +211,31 @@ var (
 		OutOfStock: "outOfStock",
 		Inactive:   "inactive",
 	}
+	// Product Sort
+	ProductSort = productSortType{
+		Price:     "price",
+		Name:      "name",
+		Created:   "created",
+		Updated:   "updated",
+		Stock:     "stock",
+		Relevance: "relevance",
+	}
+	// Product Csv Field
+	ProductCsvField = productCsvFieldType{
+		Sku:         "sku",
+		Name:        "name",
+		Price:       "price",
+		Quantity:    "quantity",
+		Description: "description",
+		ImgUrl:      "imgUrl",
+		Currency:    "currency",
+		Status:      "status",
+	}
+	// Sort Order
+	SortOrder = sortOrderType{
+		Asc:  "asc",
+		Desc: "desc",
+	}
 	// Order Status
 	OrderStatus = orderStatusType{
 		Initiated: "initiated",
@@ -59,4 +264,54 @@ var (
 		Shipping:  "shipping",
 		Completed: "completed",
 	}
+	// Order Event Type
+	OrderEventType = orderEventTypeType{
+		Created:         "created",
+		StatusChanged:   "statusChanged",
+		PaymentChanged:  "paymentChanged",
+		DeliveryChanged: "deliveryChanged",
+		TrackingChanged: "trackingChanged",
+		ItemsChanged:    "itemsChanged",
+		PricingChanged:  "pricingChanged",
+		AddressChanged:  "addressChanged",
+		NoteChanged:     "noteChanged",
+		Archived:        "archived",
+		Released:        "released",
+	}
+	// Order Event Actor
+	OrderEventActor = orderEventActorType{
+		Customer: "customer",
+		Staff:    "staff",
+		System:   "system",
+	}
+	// Inventory Movement Reason
+	InventoryReason = inventoryReasonType{
+		Initial:       "initial",
+		OrderPlaced:   "orderPlaced",
+		OrderEdited:   "orderEdited",
+		OrderCanceled: "orderCanceled",
+		Manual:        "manual",
+		Import:        "import",
+		Return:        "return",
+		Released:      "released",
+	}
+	// Collection Type
+	CollectionType = collectionTypeType{
+		Manual: "manual",
+		Rule:   "rule",
+	}
+	// Collection Rule Field
+	CollectionRuleField = collectionRuleFieldType{
+		Name:     "name",
+		Price:    "price",
+		Quantity: "quantity",
+		Status:   "status",
+	}
+	// Collection Rule Operator
+	CollectionRuleOperator = collectionRuleOperatorType{
+		Eq:       "eq",
+		Gte:      "gte",
+		Lte:      "lte",
+		Contains: "contains",
+	}
 )
@@ -6,8 +6,22 @@ import "errors"
 var (
 	ErrBadRequest     = errors.New("bad_request")
 	ErrUnauthorized   = errors.New("unauthorized")
+	ErrForbidden      = errors.New("forbidden")
+	ErrTooManyRequest = errors.New("too_many_request")
 	ErrNotFound       = errors.New("not_found")
 	ErrInternalServer = errors.New("internal_server_error")
 	ErrExisted        = errors.New("existed")
 	ErrValidation     = errors.New("validate_fail")
+	// ErrInvalidTransition: wrapped with description of rejected state change
+	ErrInvalidTransition = errors.New("invalid_transition")
+	// ErrPreconditionRequired: If-Match header missing on conditional write
+	ErrPreconditionRequired = errors.New("precondition_required")
+	// ErrPreconditionFailed: If-Match version not equal to current version
+	ErrPreconditionFailed = errors.New("precondition_failed")
+	// ErrIdempotencyKeyReused: same Idempotency-Key with different request payload
+	ErrIdempotencyKeyReused = errors.New("idempotency_key_reused")
+	// ErrIdempotencyInProgress: first request of Idempotency-Key not finished yet
+	ErrIdempotencyInProgress = errors.New("idempotency_request_in_progress")
+	// ErrQuotaExceeded: wrapped with description of plan limit reached
+	ErrQuotaExceeded = errors.New("quota_exceeded")
 )
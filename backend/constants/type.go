@@ -2,6 +2,182 @@ package constants
 
 type contextKey string
 
+// Token Use Type
+type tokenUseType struct {
+	Access     string
+	Refresh    string
+	MfaPending string
+}
+
+// Refresh Token Revoke Reason Type
+type refreshTokenRevokeReasonType struct {
+	Rotated       string
+	ReuseDetected string
+	Logout        string
+	LogoutAll     string
+	PasswordReset string
+}
+
+// Login Throttle Scope Type
+type loginThrottleScopeType struct {
+	Account string
+	Ip      string
+}
+
+// Login Attempt Store Type
+type loginAttemptStoreType struct {
+	Memory   string
+	Postgres string
+}
+
+func (l loginAttemptStoreType) GetList() []string {
+	return []string{
+		l.Memory,
+		l.Postgres,
+	}
+}
+
+// Blob Store Type
+type blobStoreType struct {
+	S3     string
+	Local  string
+	Memory string
+}
+
+func (b blobStoreType) GetList() []string {
+	return []string{
+		b.S3,
+		b.Local,
+		b.Memory,
+	}
+}
+
+// Plan Type
+type planType struct {
+	Free string
+	Pro  string
+}
+
+func (p planType) GetList() []string {
+	return []string{
+		p.Free,
+		p.Pro,
+	}
+}
+
+// GetLimit: limits of plan, unknown plan as free
+func (p planType) GetLimit(plan string) PlanLimit {
+	limit, ok := PlanLimits[plan]
+	if !ok {
+		return PlanLimits[p.Free]
+	}
+	return limit
+}
+
+// PlanLimit: quota of a plan, MonthlyOrders counted from start of calendar month in utc
+type PlanLimit struct {
+	Products      int
+	AlbumImgs     int
+	StorageBytes  int64
+	MonthlyOrders int
+}
+
+// Shop Role Type
+type shopRoleType struct {
+	Owner      string
+	Manager    string
+	Fulfilment string
+	ReadOnly   string
+}
+
+func (s shopRoleType) GetList() []string {
+	return []string{
+		s.Owner,
+		s.Manager,
+		s.Fulfilment,
+		s.ReadOnly,
+	}
+}
+
+// GetInvitableList: owner cannot be invited or assigned
+func (s shopRoleType) GetInvitableList() []string {
+	return []string{
+		s.Manager,
+		s.Fulfilment,
+		s.ReadOnly,
+	}
+}
+
+// Shop Permission Type
+type shopPermissionType struct {
+	ProductRead  string
+	ProductWrite string
+	OrderRead    string
+	OrderWrite   string
+	SiteUiWrite  string
+	AlbumRead    string
+	AlbumWrite   string
+	StaffManage  string
+	ShopSettings string
+}
+
+// Api Key Scope Type
+type apiKeyScopeType struct {
+	ProductsRead  string
+	ProductsWrite string
+	OrdersRead    string
+	OrdersWrite   string
+	SiteUiWrite   string
+	AlbumRead     string
+	AlbumWrite    string
+}
+
+func (a apiKeyScopeType) GetList() []string {
+	return []string{
+		a.ProductsRead,
+		a.ProductsWrite,
+		a.OrdersRead,
+		a.OrdersWrite,
+		a.SiteUiWrite,
+		a.AlbumRead,
+		a.AlbumWrite,
+	}
+}
+
+// Mailer Type
+type mailerType struct {
+	Smtp string
+	Log  string
+}
+
+func (m mailerType) GetList() []string {
+	return []string{
+		m.Smtp,
+		m.Log,
+	}
+}
+
+// Currency Type
+type currencyType struct {
+	Usd string
+	Hkd string
+	Eur string
+	Gbp string
+	Cny string
+	Sgd string
+}
+
+func (c currencyType) GetList() []string {
+	return []string{
+		c.Usd,
+		c.Hkd,
+		c.Eur,
+		c.Gbp,
+		c.Cny,
+		c.Sgd,
+	}
+}
+
 // Product Status Type
 type productStatusType struct {
 	Initiated  string
@@ -19,6 +195,81 @@ func (p productStatusType) GetList() []string {
 	}
 }
 
+// Product Sort Type
+type productSortType struct {
+	Price   string
+	Name    string
+	Created string
+	Updated string
+	Stock   string
+	// Relevance: search rank, requires query
+	Relevance string
+}
+
+func (p productSortType) GetList() []string {
+	return []string{
+		p.Price,
+		p.Name,
+		p.Created,
+		p.Updated,
+		p.Stock,
+		p.Relevance,
+	}
+}
+
+// Product Csv Field Type
+type productCsvFieldType struct {
+	Sku         string
+	Name        string
+	Price       string
+	Quantity    string
+	Description string
+	ImgUrl      string
+	Currency    string
+	// Status: export only
+	Status string
+}
+
+// GetList: columns of export
+func (p productCsvFieldType) GetList() []string {
+	return []string{
+		p.Sku,
+		p.Name,
+		p.Price,
+		p.Quantity,
+		p.Description,
+		p.ImgUrl,
+		p.Currency,
+		p.Status,
+	}
+}
+
+// GetImportList: columns of import, status of created product is initiated and kept on update
+func (p productCsvFieldType) GetImportList() []string {
+	return []string{
+		p.Sku,
+		p.Name,
+		p.Price,
+		p.Quantity,
+		p.Description,
+		p.ImgUrl,
+		p.Currency,
+	}
+}
+
+// Sort Order Type
+type sortOrderType struct {
+	Asc  string
+	Desc string
+}
+
+func (s sortOrderType) GetList() []string {
+	return []string{
+		s.Asc,
+		s.Desc,
+	}
+}
+
 // Order Status Type
 type orderStatusType struct {
 	Initiated string
@@ -38,6 +289,14 @@ func (o orderStatusType) GetList() []string {
 	}
 }
 
+// GetItemsEditableList: order before shipping, items and stock still changeable
+func (o orderStatusType) GetItemsEditableList() []string {
+	return []string{
+		o.Initiated,
+		o.Confirmed,
+	}
+}
+
 // Payment Status Type
 type paymentStatusType struct {
 	Pending         string
@@ -61,6 +320,14 @@ func (p paymentStatusType) GetList() []string {
 	}
 }
 
+// GetUnpaidList: order not paid, its reserved stock may be released
+func (p paymentStatusType) GetUnpaidList() []string {
+	return []string{
+		p.Pending,
+		p.Fail,
+	}
+}
+
 // Payment MethodType
 type paymentMethodType struct {
 	// Cash         string
@@ -87,3 +354,123 @@ func (d deliveryStatusType) GetList() []string {
 		d.Completed,
 	}
 }
+
+// Order Event Type
+type orderEventTypeType struct {
+	Created         string
+	StatusChanged   string
+	PaymentChanged  string
+	DeliveryChanged string
+	TrackingChanged string
+	ItemsChanged    string
+	PricingChanged  string
+	AddressChanged  string
+	NoteChanged     string
+	Archived        string
+	Released        string
+}
+
+func (o orderEventTypeType) GetList() []string {
+	return []string{
+		o.Created,
+		o.StatusChanged,
+		o.PaymentChanged,
+		o.DeliveryChanged,
+		o.TrackingChanged,
+		o.ItemsChanged,
+		o.PricingChanged,
+		o.AddressChanged,
+		o.NoteChanged,
+		o.Archived,
+		o.Released,
+	}
+}
+
+// Order Event Actor Type
+type orderEventActorType struct {
+	Customer string
+	Staff    string
+	System   string
+}
+
+// Inventory Movement Reason Type
+type inventoryReasonType struct {
+	Initial       string
+	OrderPlaced   string
+	OrderEdited   string
+	OrderCanceled string
+	Manual        string
+	Import        string
+	Return        string
+	Released      string
+}
+
+func (i inventoryReasonType) GetList() []string {
+	return []string{
+		i.Initial,
+		i.OrderPlaced,
+		i.OrderEdited,
+		i.OrderCanceled,
+		i.Manual,
+		i.Import,
+		i.Return,
+		i.Released,
+	}
+}
+
+// GetManualList: reasons staff can adjust stock with, others recorded by system
+func (i inventoryReasonType) GetManualList() []string {
+	return []string{
+		i.Manual,
+		i.Return,
+	}
+}
+
+type collectionTypeType struct {
+	Manual string
+	Rule   string
+}
+
+func (c collectionTypeType) GetList() []string {
+	return []string{
+		c.Manual,
+		c.Rule,
+	}
+}
+
+type collectionRuleFieldType struct {
+	Name     string
+	Price    string
+	Quantity string
+	Status   string
+}
+
+func (c collectionRuleFieldType) GetList() []string {
+	return []string{
+		c.Name,
+		c.Price,
+		c.Quantity,
+		c.Status,
+	}
+}
+
+type collectionRuleOperatorType struct {
+	Eq       string
+	Gte      string
+	Lte      string
+	Contains string
+}
+
+// GetListByField: operators applicable to rule field, text compared by eq or contains, numbers by eq or range
+func (c collectionRuleOperatorType) GetListByField(field string) []string {
+	switch field {
+	case CollectionRuleField.Name:
+		return []string{c.Eq, c.Contains}
+	case CollectionRuleField.Price, CollectionRuleField.Quantity:
+		return []string{c.Eq, c.Gte, c.Lte}
+	case CollectionRuleField.Status:
+		return []string{c.Eq}
+	default:
+		return []string{}
+	}
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"sthl/ent"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+/* ****CreateApiKeyDto
+ */
+type CreateApiKeyDto struct {
+	Name          *string  `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays *int     `json:"expiresInDays"`
+}
+
+func NewCreateApiKeyDto(name *string, scopes []string, expiresInDays *int) *CreateApiKeyDto {
+	return &CreateApiKeyDto{
+		Name:          name,
+		Scopes:        scopes,
+		ExpiresInDays: expiresInDays,
+	}
+}
+func (d CreateApiKeyDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Name, ApiKeyNameRule...),
+		validation.Field(&d.Scopes, ApiKeyScopesRule...),
+		validation.Field(&d.ExpiresInDays, ApiKeyExpiresInDaysRule...),
+	)
+}
+
+/* ****CreateApiKeyResponseDto
+ */
+// CreateApiKeyResponseDto: raw key is only returned once on creation
+type CreateApiKeyResponseDto struct {
+	ApiKey *ent.ApiKey `json:"apiKey"`
+	Key    string      `json:"key"`
+}
+
+func NewCreateApiKeyResponseDto(apiKey *ent.ApiKey, key string) *CreateApiKeyResponseDto {
+	return &CreateApiKeyResponseDto{
+		ApiKey: apiKey,
+		Key:    key,
+	}
+}
@@ -0,0 +1,186 @@
+package dto
+
+import (
+	"sthl/ent"
+	"sthl/ent/schema"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// ****CreateCategoryDto
+type CreateCategoryDto struct {
+	Name        *string `json:"name"`
+	Slug        *string `json:"slug"`
+	Description *string `json:"description"`
+	ParentId    *string `json:"parentId"`
+	Position    *int32  `json:"position"`
+}
+
+func NewCreateCategoryDto(name *string, slug *string, desc *string, parentId *string, position *int32) *CreateCategoryDto {
+	return &CreateCategoryDto{
+		Name:        name,
+		Slug:        slug,
+		Description: desc,
+		ParentId:    parentId,
+		Position:    position,
+	}
+}
+
+func (d CreateCategoryDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Name, CategoryNameRule...),
+		validation.Field(&d.Slug, CategorySlugRule...),
+		validation.Field(&d.Description, CategoryDescriptionRule...),
+		validation.Field(&d.ParentId, CategoryParentIdRule...),
+		validation.Field(&d.Position, CategoryPositionRule...),
+	)
+}
+
+// ****UpdateCategoryDto
+// UpdateCategoryDto: replaces all fields, nil parentId moves category to top level
+type UpdateCategoryDto struct {
+	Name        *string `json:"name"`
+	Slug        *string `json:"slug"`
+	Description *string `json:"description"`
+	ParentId    *string `json:"parentId"`
+	Position    *int32  `json:"position"`
+}
+
+func NewUpdateCategoryDto(name *string, slug *string, desc *string, parentId *string, position *int32) *UpdateCategoryDto {
+	return &UpdateCategoryDto{
+		Name:        name,
+		Slug:        slug,
+		Description: desc,
+		ParentId:    parentId,
+		Position:    position,
+	}
+}
+
+func (d UpdateCategoryDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Name, CategoryNameRule...),
+		validation.Field(&d.Slug, CategorySlugRule...),
+		validation.Field(&d.Description, CategoryDescriptionRule...),
+		validation.Field(&d.ParentId, CategoryParentIdRule...),
+		validation.Field(&d.Position, CategoryPositionRule...),
+	)
+}
+
+// ****SetProductCategoriesDto
+// SetProductCategoriesDto: replaces categories of product, empty to unassign all
+type SetProductCategoriesDto struct {
+	CategoryIds []string `json:"categoryIds"`
+}
+
+func NewSetProductCategoriesDto(categoryIds []string) *SetProductCategoriesDto {
+	return &SetProductCategoriesDto{
+		CategoryIds: categoryIds,
+	}
+}
+
+func (d SetProductCategoriesDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.CategoryIds, ProductCategoryIdsRule...),
+	)
+}
+
+// ****CreateCollectionDto
+// CreateCollectionDto: rules for rule collection, productIds for manual collection
+type CreateCollectionDto struct {
+	Name        *string                 `json:"name"`
+	Slug        *string                 `json:"slug"`
+	Description *string                 `json:"description"`
+	Kind        *string                 `json:"kind"`
+	Rules       []schema.CollectionRule `json:"rules"`
+	ProductIds  []string                `json:"productIds"`
+}
+
+func NewCreateCollectionDto(
+	name *string, slug *string, desc *string, kind *string, rules []schema.CollectionRule, productIds []string) *CreateCollectionDto {
+	return &CreateCollectionDto{
+		Name:        name,
+		Slug:        slug,
+		Description: desc,
+		Kind:        kind,
+		Rules:       rules,
+		ProductIds:  productIds,
+	}
+}
+
+func (d CreateCollectionDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Name, CollectionNameRule...),
+		validation.Field(&d.Slug, CategorySlugRule...),
+		validation.Field(&d.Description, CategoryDescriptionRule...),
+		validation.Field(&d.Kind, CollectionKindRule...),
+		validation.Field(&d.Rules, CollectionRulesRule...),
+		validation.Field(&d.ProductIds, CollectionProductIdsRule...),
+	)
+}
+
+// ****UpdateCollectionDto
+// UpdateCollectionDto: replaces all fields, kind of collection cannot change
+type UpdateCollectionDto struct {
+	Name        *string                 `json:"name"`
+	Slug        *string                 `json:"slug"`
+	Description *string                 `json:"description"`
+	Rules       []schema.CollectionRule `json:"rules"`
+	ProductIds  []string                `json:"productIds"`
+}
+
+func NewUpdateCollectionDto(
+	name *string, slug *string, desc *string, rules []schema.CollectionRule, productIds []string) *UpdateCollectionDto {
+	return &UpdateCollectionDto{
+		Name:        name,
+		Slug:        slug,
+		Description: desc,
+		Rules:       rules,
+		ProductIds:  productIds,
+	}
+}
+
+func (d UpdateCollectionDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Name, CollectionNameRule...),
+		validation.Field(&d.Slug, CategorySlugRule...),
+		validation.Field(&d.Description, CategoryDescriptionRule...),
+		validation.Field(&d.Rules, CollectionRulesRule...),
+		validation.Field(&d.ProductIds, CollectionProductIdsRule...),
+	)
+}
+
+// ****CollectionResponseDto
+// CollectionResponseDto: productIds empty for rule collection, its products found by listing filter
+type CollectionResponseDto struct {
+	*ent.Collection `json:","`
+	ProductIds      []string `json:"productIds"`
+}
+
+func NewCollectionResponseDto(collection *ent.Collection, productIds []string) *CollectionResponseDto {
+	return &CollectionResponseDto{
+		collection,
+		productIds,
+	}
+}
+
+// ****ProductsFilterDto
+// ProductsFilterDto: storefront filter resolved by service from category and collection of QueryProductsDto,
+// nil field is not filtered on
+type ProductsFilterDto struct {
+	// product in any of categories, category with its descendants
+	CategoryIds []string
+	// products of manual collection
+	ProductIds []string
+	// all rules of rule collection
+	Rules []schema.CollectionRule
+	// Storefront: archived and inactive products hidden from shoppers
+	Storefront bool
+}
+
+func NewProductsFilterDto(categoryIds []string, productIds []string, rules []schema.CollectionRule) *ProductsFilterDto {
+	return &ProductsFilterDto{
+		CategoryIds: categoryIds,
+		ProductIds:  productIds,
+		Rules:       rules,
+	}
+}
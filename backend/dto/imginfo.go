@@ -45,13 +45,17 @@ func NewQueryImgsInfoResponseDto(data []*ent.Imageinfo, paging PagingResponse) *
 // ****UpdateImgInfoDto
 type UpdateImgInfoDto struct {
 	// ImgName *string `json:"imgName"`
-	ImgSize *int64 `json:"imgSize"`
+	ImgURL     *string `json:"imgUrl"`
+	ImgSize    *int64  `json:"imgSize"`
+	ImgS3IdKey *string `json:"imgS3IdKey"`
 }
 
-func NewUpdateImgInfoDto(imgSize *int64) *UpdateImgInfoDto {
+func NewUpdateImgInfoDto(imgURL *string, imgSize *int64, imgS3IdKey *string) *UpdateImgInfoDto {
 	return &UpdateImgInfoDto{
 		// ImgName: imgName,
-		ImgSize: imgSize,
+		ImgURL:     imgURL,
+		ImgSize:    imgSize,
+		ImgS3IdKey: imgS3IdKey,
 	}
 }
 
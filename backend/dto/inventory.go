@@ -0,0 +1,105 @@
+package dto
+
+import (
+	"sthl/ent"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// ****CreateInventoryMovementDto
+// CreateInventoryMovementDto: built by service, variantId nil for product stock, actorId nil for storefront customer
+type CreateInventoryMovementDto struct {
+	VariantId   *string
+	Delta       int32
+	Reason      string
+	ReferenceId string
+	ActorId     *string
+	Note        string
+}
+
+func NewCreateInventoryMovementDto(
+	variantId *string, delta int32, reason string, referenceId string, actorId *string, note string) *CreateInventoryMovementDto {
+	return &CreateInventoryMovementDto{
+		VariantId:   variantId,
+		Delta:       delta,
+		Reason:      reason,
+		ReferenceId: referenceId,
+		ActorId:     actorId,
+		Note:        note,
+	}
+}
+
+// ****AdjustInventoryDto
+type AdjustInventoryDto struct {
+	Delta  *int32  `json:"delta"`
+	Reason *string `json:"reason"`
+	Note   *string `json:"note"`
+}
+
+func NewAdjustInventoryDto(delta *int32, reason *string, note *string) *AdjustInventoryDto {
+	return &AdjustInventoryDto{
+		Delta:  delta,
+		Reason: reason,
+		Note:   note,
+	}
+}
+
+func (d AdjustInventoryDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Delta, InventoryDeltaRule...),
+		validation.Field(&d.Reason, InventoryReasonRule...),
+		validation.Field(&d.Note, InventoryNoteRule...),
+	)
+}
+
+// ****QueryInventoryMovementsDto
+type QueryInventoryMovementsDto struct {
+	Paging
+}
+
+func NewQueryInventoryMovementsDto(paging Paging) *QueryInventoryMovementsDto {
+	return &QueryInventoryMovementsDto{
+		Paging: paging,
+	}
+}
+
+// QueryInventoryMovementsResponseDto: newest first, movements of variants included,
+// ledgerQuantity is sum of product movements and reconciled when equal to product quantity
+type QueryInventoryMovementsResponseDto struct {
+	Data           []*ent.InventoryMovement        `json:"movements"`
+	Quantity       int32                           `json:"quantity"`
+	LedgerQuantity int32                           `json:"ledgerQuantity"`
+	Reconciled     bool                            `json:"reconciled"`
+	Variants       []*InventoryVariantReconcileDto `json:"variants"`
+	PagingResponse `json:""`
+}
+
+func NewQueryInventoryMovementsResponseDto(
+	data []*ent.InventoryMovement, quantity int32, ledgerQuantity int32, variants []*InventoryVariantReconcileDto,
+	paging PagingResponse) *QueryInventoryMovementsResponseDto {
+	return &QueryInventoryMovementsResponseDto{
+		Data:           data,
+		Quantity:       quantity,
+		LedgerQuantity: ledgerQuantity,
+		Reconciled:     quantity == ledgerQuantity,
+		Variants:       variants,
+		PagingResponse: paging,
+	}
+}
+
+// InventoryVariantReconcileDto: ledgerQuantity is sum of variant movements
+type InventoryVariantReconcileDto struct {
+	VariantId      string `json:"variantId"`
+	Quantity       int32  `json:"quantity"`
+	LedgerQuantity int32  `json:"ledgerQuantity"`
+	Reconciled     bool   `json:"reconciled"`
+}
+
+func NewInventoryVariantReconcileDto(variantId string, quantity int32, ledgerQuantity int32) *InventoryVariantReconcileDto {
+	return &InventoryVariantReconcileDto{
+		VariantId:      variantId,
+		Quantity:       quantity,
+		LedgerQuantity: ledgerQuantity,
+		Reconciled:     quantity == ledgerQuantity,
+	}
+}
@@ -0,0 +1,90 @@
+package dto
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+/* ****ConfirmTotpDto
+ */
+type ConfirmTotpDto struct {
+	Code *string `json:"code"`
+}
+
+func NewConfirmTotpDto(code *string) *ConfirmTotpDto {
+	return &ConfirmTotpDto{
+		Code: code,
+	}
+}
+func (d ConfirmTotpDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Code, TotpCodeRule...),
+	)
+}
+
+/* ****DisableTotpDto
+ */
+type DisableTotpDto struct {
+	Password *string `json:"password"`
+	Code     *string `json:"code"`
+}
+
+func NewDisableTotpDto(pw *string, code *string) *DisableTotpDto {
+	return &DisableTotpDto{
+		Password: pw,
+		Code:     code,
+	}
+}
+func (d DisableTotpDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Password, UserPasswordRule...),
+		validation.Field(&d.Code, MfaCodeRule...),
+	)
+}
+
+/* ****VerifyMfaLoginDto
+ */
+type VerifyMfaLoginDto struct {
+	MfaToken *string `json:"mfaToken"`
+	Code     *string `json:"code"`
+}
+
+func NewVerifyMfaLoginDto(mfaToken *string, code *string) *VerifyMfaLoginDto {
+	return &VerifyMfaLoginDto{
+		MfaToken: mfaToken,
+		Code:     code,
+	}
+}
+func (d VerifyMfaLoginDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.MfaToken, MfaTokenRule...),
+		validation.Field(&d.Code, MfaCodeRule...),
+	)
+}
+
+/* ****TotpEnrolmentResponseDto
+ */
+// TotpEnrolmentResponseDto: uri is rendered as QR code, secret for manual entry
+type TotpEnrolmentResponseDto struct {
+	Secret string `json:"secret"`
+	Uri    string `json:"uri"`
+}
+
+func NewTotpEnrolmentResponseDto(secret string, uri string) *TotpEnrolmentResponseDto {
+	return &TotpEnrolmentResponseDto{
+		Secret: secret,
+		Uri:    uri,
+	}
+}
+
+/* ****MfaRecoveryCodesResponseDto
+ */
+// MfaRecoveryCodesResponseDto: codes are only returned once on confirm
+type MfaRecoveryCodesResponseDto struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+func NewMfaRecoveryCodesResponseDto(codes []string) *MfaRecoveryCodesResponseDto {
+	return &MfaRecoveryCodesResponseDto{
+		RecoveryCodes: codes,
+	}
+}
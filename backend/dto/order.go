@@ -2,6 +2,8 @@ package dto
 
 import (
 	"sthl/ent"
+	"sthl/money"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
@@ -10,15 +12,17 @@ import (
 
 // OrderItem
 type OrderItem struct {
-	ProductId      *string  `json:"productId"`
-	PurchasedName  *string  `json:"purchasedName"`
-	PurchasedPrice *float64 `json:"purchasedPrice"`
-	Quantity       *int     `json:"quantity"`
+	ProductId      *string       `json:"productId"`
+	VariantId      *string       `json:"variantId"`
+	PurchasedName  *string       `json:"purchasedName"`
+	PurchasedPrice *money.Amount `json:"purchasedPrice"`
+	Quantity       *int          `json:"quantity"`
 }
 
-func NewOrderItem(productId *string, purchasedName *string, purchasedPrice *float64, quantity *int) *OrderItem {
+func NewOrderItem(productId *string, variantId *string, purchasedName *string, purchasedPrice *money.Amount, quantity *int) *OrderItem {
 	return &OrderItem{
 		ProductId:      productId,
+		VariantId:      variantId,
 		PurchasedName:  purchasedName,
 		PurchasedPrice: purchasedPrice,
 		Quantity:       quantity,
@@ -27,24 +31,38 @@ func NewOrderItem(productId *string, purchasedName *string, purchasedPrice *floa
 func (d OrderItem) Validate() error {
 	return validation.ValidateStruct(&d,
 		validation.Field(&d.ProductId, OrderItemProductIdRule...),
+		validation.Field(&d.VariantId, OrderItemVariantIdRule...),
 		validation.Field(&d.PurchasedName, OrderItemPurchasedNameRule...),
 		validation.Field(&d.PurchasedPrice, OrderItemPurchasedPriceRule...),
 		validation.Field(&d.Quantity, OrderItemQuantityRule...),
 	)
 }
 
+// LineKey: identity of order line, product with variant if any
+func (d OrderItem) LineKey() string {
+	return OrderLineKey(*d.ProductId, d.VariantId)
+}
+
+// OrderLineKey: product id, joined with variant id for variant line
+func OrderLineKey(productId string, variantId *string) string {
+	if variantId == nil {
+		return productId
+	}
+	return productId + "/" + *variantId
+}
+
 // CreateOrderDto
 type CreateOrderDto struct {
-	Items           []*OrderItem `json:"items"`
-	Remark          *string      `json:"remark"`
-	Discount        *float64     `json:"discount"`
-	TotalAmount     *float64     `json:"totalAmount"`
-	PaymentMethod   *string      `json:"paymentMethod"`
-	ShippingAddress *string      `json:"shippingAddress"`
+	Items           []*OrderItem  `json:"items"`
+	Remark          *string       `json:"remark"`
+	Discount        *money.Rate   `json:"discount"`
+	TotalAmount     *money.Amount `json:"totalAmount"`
+	PaymentMethod   *string       `json:"paymentMethod"`
+	ShippingAddress *string       `json:"shippingAddress"`
 }
 
 func NewCreateOrderDto(
-	items []*OrderItem, remark *string, discount *float64, totalAmount *float64, paymentMethod *string, shippingAddress *string) *CreateOrderDto {
+	items []*OrderItem, remark *string, discount *money.Rate, totalAmount *money.Amount, paymentMethod *string, shippingAddress *string) *CreateOrderDto {
 	return &CreateOrderDto{
 		Items:           items,
 		Remark:          remark,
@@ -69,40 +87,92 @@ func (d CreateOrderDto) Validate() error {
 type CreateOrderDtoMappedDto struct {
 	Items           []*OrderItem
 	Remark          *string
-	Discount        *float64
-	TotalAmount     *float64
+	Breakdown       *OrderBreakdownDto
 	Status          *string
 	PaymentStatus   *string
 	PaymentMethod   *string
 	DeliveryStatus  *string
 	ShippingAddress *string
 	TrackingNumber  *string
+	ReservedUntil   *time.Time
 }
 
-func (d *CreateOrderDto) MapToSchema(status string, paymentStatus string, deliveryStatus string, trackingNumber string) *CreateOrderDtoMappedDto {
+// MapToSchema: items, breakdown and reservation are computed by the service, not taken from the payload
+func (d *CreateOrderDto) MapToSchema(
+	items []*OrderItem, breakdown *OrderBreakdownDto,
+	status string, paymentStatus string, deliveryStatus string, trackingNumber string, reservedUntil *time.Time) *CreateOrderDtoMappedDto {
 	return &CreateOrderDtoMappedDto{
-		Items:           d.Items,
+		Items:           items,
 		Remark:          d.Remark,
-		Discount:        d.Discount,
-		TotalAmount:     d.TotalAmount,
+		Breakdown:       breakdown,
 		Status:          &status,
 		PaymentStatus:   &paymentStatus,
 		PaymentMethod:   d.PaymentMethod,
 		DeliveryStatus:  &deliveryStatus,
 		ShippingAddress: d.ShippingAddress,
 		TrackingNumber:  &trackingNumber,
+		ReservedUntil:   reservedUntil,
+	}
+}
+
+// OrderBreakdownDto
+// totalAmount = subtotal - discountAmount + taxAmount + shippingFee
+type OrderBreakdownDto struct {
+	Currency       string       `json:"currency"`
+	Subtotal       money.Amount `json:"subtotal"`
+	Discount       money.Rate   `json:"discount"`
+	DiscountAmount money.Amount `json:"discountAmount"`
+	TaxRate        money.Rate   `json:"taxRate"`
+	TaxAmount      money.Amount `json:"taxAmount"`
+	ShippingFee    money.Amount `json:"shippingFee"`
+	TotalAmount    money.Amount `json:"totalAmount"`
+}
+
+func NewOrderBreakdownDto(order *ent.Order) *OrderBreakdownDto {
+	return &OrderBreakdownDto{
+		Currency:       order.Currency,
+		Subtotal:       order.Subtotal,
+		Discount:       order.Discount,
+		DiscountAmount: order.DiscountAmount,
+		TaxRate:        order.TaxRate,
+		TaxAmount:      order.TaxAmount,
+		ShippingFee:    order.ShippingFee,
+		TotalAmount:    order.TotalAmount,
 	}
 }
 
 type OrderResponseDto struct {
 	*ent.Order `json:","`
-	Items      []*ent.OrderItem `json:"items"`
+	Items      []*ent.OrderItem   `json:"items"`
+	Breakdown  *OrderBreakdownDto `json:"breakdown"`
 }
 
 func NewOrderResponseDto(order *ent.Order, orderItems []*ent.OrderItem) *OrderResponseDto {
 	return &OrderResponseDto{
 		order,
 		orderItems,
+		NewOrderBreakdownDto(order),
+	}
+}
+
+// ****CreateOrderEventDto
+// CreateOrderEventDto: built by service, actorId nil for storefront customer
+type CreateOrderEventDto struct {
+	Type      string
+	ActorType string
+	ActorId   *string
+	Before    map[string]any
+	After     map[string]any
+}
+
+func NewCreateOrderEventDto(
+	eventType string, actorType string, actorId *string, before map[string]any, after map[string]any) *CreateOrderEventDto {
+	return &CreateOrderEventDto{
+		Type:      eventType,
+		ActorType: actorType,
+		ActorId:   actorId,
+		Before:    before,
+		After:     after,
 	}
 }
 
@@ -131,20 +201,20 @@ func NewQueryOrdersResponseDto(data []*OrderResponseDto, paging PagingResponse)
 
 // ****UpdateOrderDto
 type UpdateOrderDto struct {
-	Items           []*OrderItem `json:"items"`
-	Remark          *string      `json:"remark"`
-	Discount        *float64     `json:"discount"`
-	TotalAmount     *float64     `json:"totalAmount"`
-	Status          *string      `json:"status"`
-	PaymentStatus   *string      `json:"paymentStatus"`
-	PaymentMethod   *string      `json:"paymentMethod"`
-	DeliveryStatus  *string      `json:"deliveryStatus"`
-	ShippingAddress *string      `json:"shippingAddress"`
-	TrackingNumber  *string      `json:"trackingNumber"`
+	Items           []*OrderItem  `json:"items"`
+	Remark          *string       `json:"remark"`
+	Discount        *money.Rate   `json:"discount"`
+	TotalAmount     *money.Amount `json:"totalAmount"`
+	Status          *string       `json:"status"`
+	PaymentStatus   *string       `json:"paymentStatus"`
+	PaymentMethod   *string       `json:"paymentMethod"`
+	DeliveryStatus  *string       `json:"deliveryStatus"`
+	ShippingAddress *string       `json:"shippingAddress"`
+	TrackingNumber  *string       `json:"trackingNumber"`
 }
 
 func NewUpdateOrderDto(
-	items []*OrderItem, remark *string, discount *float64, totalAmount *float64,
+	items []*OrderItem, remark *string, discount *money.Rate, totalAmount *money.Amount,
 	status *string, paymentStatus *string, paymentMethod *string,
 	deliveryStatus *string, shippingAddress *string, trackingNumber *string) *UpdateOrderDto {
 	return &UpdateOrderDto{
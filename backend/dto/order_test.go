@@ -2,6 +2,7 @@ package dto
 
 import (
 	"sthl/constants"
+	"sthl/money"
 	"sthl/utils"
 	"testing"
 
@@ -14,9 +15,9 @@ func generateMockOrderItems(nums uint) []*OrderItem {
 	result := []*OrderItem{}
 	for i := uint(0); i < nums; i++ {
 		item := NewOrderItem(
-			utils.PtrOf(uuid.NewString()),
+			utils.PtrOf(uuid.NewString()), nil,
 			utils.PtrOf(gofakeit.Fruit()),
-			utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+			utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 			utils.PtrOf(gofakeit.IntRange(0, 1000000)),
 		)
 
@@ -32,7 +33,7 @@ func generateMockOrderItems(nums uint) []*OrderItem {
 // 			utils.PtrOf(uuid.NewString()),
 // 			utils.PtrOf(uuid.NewString()),
 // 			utils.PtrOf(gofakeit.Fruit()),
-// 			utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+// 			utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 // 			utils.PtrOf(gofakeit.IntRange(0, 1000000)),
 // 		)
 
@@ -57,16 +58,16 @@ func Test_CreateOrderDtoValidate(t *testing.T) {
 	validOrder := NewCreateOrderDto(
 		validOrderItems,
 		utils.PtrOf(gofakeit.LetterN(10)),
-		utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-		utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+		utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 		utils.PtrOf(constants.PaymentMethod.Card),
 		utils.PtrOf(gofakeit.Address().Address),
 	)
 	validOrder2 := NewCreateOrderDto(
 		validOrderItems2,
 		utils.PtrOf(gofakeit.LetterN(10)),
-		utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-		utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+		utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 		utils.PtrOf(constants.PaymentMethod.Card),
 		utils.PtrOf(gofakeit.Address().Address),
 	)
@@ -91,8 +92,8 @@ func Test_CreateOrderDtoValidate(t *testing.T) {
 			input: NewCreateOrderDto(
 				duplicateOrderItems,
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.PaymentMethod.Card),
 				utils.PtrOf(gofakeit.Address().Address),
 			),
@@ -105,8 +106,8 @@ func Test_CreateOrderDtoValidate(t *testing.T) {
 			input: NewCreateOrderDto(
 				nil,
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.PaymentMethod.Card),
 				utils.PtrOf(gofakeit.Address().Address),
 			),
@@ -119,8 +120,8 @@ func Test_CreateOrderDtoValidate(t *testing.T) {
 			input: NewCreateOrderDto(
 				[]*OrderItem{},
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.PaymentMethod.Card),
 				utils.PtrOf(gofakeit.Address().Address),
 			),
@@ -133,8 +134,8 @@ func Test_CreateOrderDtoValidate(t *testing.T) {
 			input: NewCreateOrderDto(
 				validOrderItems,
 				nil,
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.PaymentMethod.Card),
 				utils.PtrOf(gofakeit.Address().Address),
 			),
@@ -147,8 +148,8 @@ func Test_CreateOrderDtoValidate(t *testing.T) {
 			input: NewCreateOrderDto(
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(0)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.PaymentMethod.Card),
 				utils.PtrOf(gofakeit.Address().Address),
 			),
@@ -162,7 +163,7 @@ func Test_CreateOrderDtoValidate(t *testing.T) {
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(10)),
 				nil,
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.PaymentMethod.Card),
 				utils.PtrOf(gofakeit.Address().Address),
 			),
@@ -175,8 +176,8 @@ func Test_CreateOrderDtoValidate(t *testing.T) {
 			input: NewCreateOrderDto(
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(0.0),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.PaymentMethod.Card),
 				utils.PtrOf(gofakeit.Address().Address),
 			),
@@ -189,8 +190,8 @@ func Test_CreateOrderDtoValidate(t *testing.T) {
 			input: NewCreateOrderDto(
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				nil,
 				utils.PtrOf(gofakeit.Address().Address),
 			),
@@ -203,8 +204,8 @@ func Test_CreateOrderDtoValidate(t *testing.T) {
 			input: NewCreateOrderDto(
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(""),
 				utils.PtrOf(gofakeit.Address().Address),
 			),
@@ -217,8 +218,8 @@ func Test_CreateOrderDtoValidate(t *testing.T) {
 			input: NewCreateOrderDto(
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.PaymentMethod.Card),
 				nil,
 			),
@@ -231,8 +232,8 @@ func Test_CreateOrderDtoValidate(t *testing.T) {
 			input: NewCreateOrderDto(
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.PaymentMethod.Card),
 				utils.PtrOf(""),
 			),
@@ -264,8 +265,8 @@ func Test_UpdateOrderDtoValidate(t *testing.T) {
 	validOrder := NewUpdateOrderDto(
 		validOrderItems,
 		utils.PtrOf(gofakeit.LetterN(10)),
-		utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-		utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+		utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 		utils.PtrOf(constants.OrderStatus.Initiated),
 		utils.PtrOf(constants.PaymentStatus.Pending),
 		utils.PtrOf(constants.PaymentMethod.Card),
@@ -287,8 +288,8 @@ func Test_UpdateOrderDtoValidate(t *testing.T) {
 			input: NewUpdateOrderDto(
 				nil,
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.OrderStatus.Initiated),
 				utils.PtrOf(constants.PaymentStatus.Pending),
 				utils.PtrOf(constants.PaymentMethod.Card),
@@ -305,8 +306,8 @@ func Test_UpdateOrderDtoValidate(t *testing.T) {
 			input: NewUpdateOrderDto(
 				[]*OrderItem{},
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.OrderStatus.Initiated),
 				utils.PtrOf(constants.PaymentStatus.Pending),
 				utils.PtrOf(constants.PaymentMethod.Card),
@@ -323,8 +324,8 @@ func Test_UpdateOrderDtoValidate(t *testing.T) {
 			input: NewUpdateOrderDto(
 				validOrderItems,
 				nil,
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.OrderStatus.Initiated),
 				utils.PtrOf(constants.PaymentStatus.Pending),
 				utils.PtrOf(constants.PaymentMethod.Card),
@@ -341,8 +342,8 @@ func Test_UpdateOrderDtoValidate(t *testing.T) {
 			input: NewUpdateOrderDto(
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(0)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.OrderStatus.Initiated),
 				utils.PtrOf(constants.PaymentStatus.Pending),
 				utils.PtrOf(constants.PaymentMethod.Card),
@@ -360,7 +361,7 @@ func Test_UpdateOrderDtoValidate(t *testing.T) {
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(10)),
 				nil,
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.OrderStatus.Initiated),
 				utils.PtrOf(constants.PaymentStatus.Pending),
 				utils.PtrOf(constants.PaymentMethod.Card),
@@ -377,8 +378,8 @@ func Test_UpdateOrderDtoValidate(t *testing.T) {
 			input: NewUpdateOrderDto(
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(0.0),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.OrderStatus.Initiated),
 				utils.PtrOf(constants.PaymentStatus.Pending),
 				utils.PtrOf(constants.PaymentMethod.Card),
@@ -395,8 +396,8 @@ func Test_UpdateOrderDtoValidate(t *testing.T) {
 			input: NewUpdateOrderDto(
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.OrderStatus.Initiated),
 				utils.PtrOf(constants.PaymentStatus.Pending),
 				nil,
@@ -413,8 +414,8 @@ func Test_UpdateOrderDtoValidate(t *testing.T) {
 			input: NewUpdateOrderDto(
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.OrderStatus.Initiated),
 				utils.PtrOf(constants.PaymentStatus.Pending),
 				utils.PtrOf(""),
@@ -431,8 +432,8 @@ func Test_UpdateOrderDtoValidate(t *testing.T) {
 			input: NewUpdateOrderDto(
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.OrderStatus.Initiated),
 				utils.PtrOf(constants.PaymentStatus.Pending),
 				utils.PtrOf(constants.PaymentMethod.Card),
@@ -449,8 +450,8 @@ func Test_UpdateOrderDtoValidate(t *testing.T) {
 			input: NewUpdateOrderDto(
 				validOrderItems,
 				utils.PtrOf(gofakeit.LetterN(10)),
-				utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-				utils.PtrOf(gofakeit.Float64Range(0, 10000000)),
+				utils.PtrOf(money.Rate(gofakeit.IntRange(100000, 1000000))),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(0, 1000000000))),
 				utils.PtrOf(constants.OrderStatus.Initiated),
 				utils.PtrOf(constants.PaymentStatus.Pending),
 				utils.PtrOf(constants.PaymentMethod.Card),
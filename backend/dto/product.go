@@ -1,69 +1,173 @@
 package dto
 
 import (
+	"net/http"
+	"sthl/constants"
 	"sthl/ent"
+	"sthl/ent/schema"
+	"sthl/money"
 	"sthl/utils"
+	"strings"
+	"unicode"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/samber/lo"
 )
 
 // ****CreateProductDto
 type CreateProductDto struct {
-	Name        *string  `json:"name"`
-	Price       *float64 `json:"price"`
-	Quantity    *int32   `json:"quantity"`
-	Description *string  `json:"description"`
-	ImgUrl      *string  `json:"imgUrl"`
+	Sku         *string                `json:"sku"`
+	Name        *string                `json:"name"`
+	Price       *money.Amount          `json:"price"`
+	Quantity    *int32                 `json:"quantity"`
+	Description *string                `json:"description"`
+	ImgUrl      *string                `json:"imgUrl"`
+	Currency    *string                `json:"currency"`
+	Options     []schema.ProductOption `json:"options"`
 }
 type CreateProductDtoMappedDto struct {
+	Sku         *string
 	Name        *string
-	Price       *float64
+	Price       *money.Amount
 	Quantity    *int32
 	Description *string
 	ImgUrl      *string
+	Currency    *string
 	Status      *string
+	Options     []schema.ProductOption
 }
 
-func NewCreateProductDto(name *string, price *float64, quantity *int32, desc *string, imgUrl *string) *CreateProductDto {
+func NewCreateProductDto(name *string, price *money.Amount, quantity *int32, desc *string, imgUrl *string, currency *string) *CreateProductDto {
 	return &CreateProductDto{
 		Name:        name,
 		Price:       price,
 		Quantity:    quantity,
 		Description: desc,
 		ImgUrl:      imgUrl,
+		Currency:    currency,
 	}
 }
 
 func (d CreateProductDto) Validate() error {
 	return validation.ValidateStruct(&d,
+		validation.Field(&d.Sku, ProductSkuRule...),
 		validation.Field(&d.Name, ProductNameRule...),
 		validation.Field(&d.Price, ProductPriceRule...),
 		validation.Field(&d.Quantity, ProductQuantityRule...),
 		validation.Field(&d.Description, ProductDescriptionRule...),
 		validation.Field(&d.ImgUrl, ProductImgUrlRule...),
+		validation.Field(&d.Currency, ProductCurrencyRule...),
+		validation.Field(&d.Options, ProductOptionsRule...),
 	)
 }
 
+// MapToSchema: currency defaults to constants.DefaultCurrency
 func (d *CreateProductDto) MapToSchema(status string) *CreateProductDtoMappedDto {
 	return &CreateProductDtoMappedDto{
+		Sku:         d.Sku,
 		Name:        d.Name,
 		Price:       d.Price,
 		Quantity:    d.Quantity,
 		Description: d.Description,
 		ImgUrl:      d.ImgUrl,
+		Currency:    utils.PtrOf(lo.FromPtrOr(d.Currency, constants.DefaultCurrency)),
 		Status:      utils.PtrOf(status),
+		Options:     lo.Ternary(d.Options == nil, []schema.ProductOption{}, d.Options),
 	}
 }
 
 // ****QueryProductsDto
+// QueryProductsDto: category and collection referred by id or slug, empty or nil field for no filter
 type QueryProductsDto struct {
 	Paging
+	Category   string
+	Collection string
+	// Sort: constants.ProductSort, by relevance if searching and newest first otherwise if empty
+	Sort string
+	// Order: constants.SortOrder, default by Sort
+	Order    string
+	MinPrice *money.Amount
+	MaxPrice *money.Amount
+	// Statuses: product in any of constants.ProductStatus
+	Statuses []string
+	// InStock: product or any of its active variants has stock
+	InStock bool
+	// priceErr: unparsable price of query string, reported by Validate
+	priceErr error
 }
 
-func NewQueryProductsDto(paging Paging) *QueryProductsDto {
+// ExtractQueryProductsDto: paging and filters from query string, unparsable paging ignored,
+// prices in major units as json and rejected by Validate if unparsable, status given as comma separated list
+func ExtractQueryProductsDto(r *http.Request) *QueryProductsDto {
+	q := r.URL.Query()
+	d := NewQueryProductsDto(*ExtractPaging(r), q.Get("category"), q.Get("collection"))
+	d.Sort = q.Get("sort")
+	d.Order = q.Get("order")
+	d.MinPrice, d.priceErr = extractQueryPrice(q.Get("minPrice"), d.priceErr)
+	d.MaxPrice, d.priceErr = extractQueryPrice(q.Get("maxPrice"), d.priceErr)
+	d.Statuses = lo.Compact(strings.Split(q.Get("status"), ","))
+	d.InStock = q.Get("inStock") == "true"
+	return d
+}
+
+// extractQueryPrice: nil if not given, first error kept
+func extractQueryPrice(value string, prevErr error) (*money.Amount, error) {
+	if value == "" {
+		return nil, prevErr
+	}
+	price, err := money.ParseAmount(value)
+	if err != nil {
+		return nil, lo.Ternary(prevErr != nil, prevErr, err)
+	}
+	return utils.PtrOf(price), prevErr
+}
+
+func NewQueryProductsDto(paging Paging, category string, collection string) *QueryProductsDto {
 	return &QueryProductsDto{
-		Paging: paging,
+		Paging:     paging,
+		Category:   category,
+		Collection: collection,
+	}
+}
+
+// Validate: filters only, paging is ensured separately
+func (d QueryProductsDto) Validate() error {
+	if d.priceErr != nil {
+		return d.priceErr
+	}
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Sort,
+			validation.In(lo.ToAnySlice(constants.ProductSort.GetList())...),
+			validation.When(len(d.SearchTerms()) == 0, validation.NotIn(constants.ProductSort.Relevance)),
+		),
+		validation.Field(&d.Order, validation.In(lo.ToAnySlice(constants.SortOrder.GetList())...)),
+		validation.Field(&d.MinPrice, validation.Min(money.Amount(0))),
+		validation.Field(&d.MaxPrice, validation.Min(lo.FromPtr(d.MinPrice))),
+		validation.Field(&d.Statuses, validation.Each(validation.In(lo.ToAnySlice(constants.ProductStatus.GetList())...))),
+	)
+}
+
+// SearchTerms: lower cased words of query, punctuation dropped so terms are safe as tsquery lexemes
+func (d QueryProductsDto) SearchTerms() []string {
+	return strings.FieldsFunc(strings.ToLower(d.Query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// SortBy: sort field and order, by relevance if searching and newest first otherwise,
+// names and prices ascending and others descending by default
+func (d QueryProductsDto) SortBy() (string, string) {
+	sort := d.Sort
+	if sort == "" {
+		sort = lo.Ternary(len(d.SearchTerms()) > 0, constants.ProductSort.Relevance, constants.ProductSort.Created)
+	}
+	if d.Order != "" {
+		return sort, d.Order
+	}
+	if sort == constants.ProductSort.Name || sort == constants.ProductSort.Price {
+		return sort, constants.SortOrder.Asc
 	}
+	return sort, constants.SortOrder.Desc
 }
 
 type QueryProductsResponseDto struct {
@@ -80,15 +184,17 @@ func NewQueryProductsResponseDto(data []*ent.Product, paging PagingResponse) *Qu
 
 // ****UpdateProductDto
 type UpdateProductDto struct {
-	Name        *string  `json:"name"`
-	Price       *float64 `json:"price"`
-	Quantity    *int32   `json:"quantity"`
-	Description *string  `json:"description"`
-	Status      *string  `json:"status"`
-	ImgUrl      *string  `json:"imgUrl"`
+	Sku         *string                `json:"sku"`
+	Name        *string                `json:"name"`
+	Price       *money.Amount          `json:"price"`
+	Quantity    *int32                 `json:"quantity"`
+	Description *string                `json:"description"`
+	Status      *string                `json:"status"`
+	ImgUrl      *string                `json:"imgUrl"`
+	Options     []schema.ProductOption `json:"options"`
 }
 
-func NewUpdateProductDto(name *string, price *float64, quantity *int32, desc *string, status *string, imgUrl *string) *UpdateProductDto {
+func NewUpdateProductDto(name *string, price *money.Amount, quantity *int32, desc *string, status *string, imgUrl *string) *UpdateProductDto {
 	return &UpdateProductDto{
 		Name:        name,
 		Price:       price,
@@ -100,11 +206,32 @@ func NewUpdateProductDto(name *string, price *float64, quantity *int32, desc *st
 }
 func (d UpdateProductDto) Validate() error {
 	return validation.ValidateStruct(&d,
+		validation.Field(&d.Sku, ProductSkuRule...),
 		validation.Field(&d.Name, ProductNameRule...),
 		validation.Field(&d.Price, ProductPriceRule...),
-		validation.Field(&d.Quantity, ProductQuantityRule...),
+		validation.Field(&d.Quantity, ProductUpdateQuantityRule...),
 		validation.Field(&d.Description, ProductDescriptionRule...),
 		validation.Field(&d.Status, ProductStatusRule...),
 		validation.Field(&d.ImgUrl, ProductImgUrlRule...),
+		validation.Field(&d.Options, ProductOptionsRule...),
 	)
 }
+
+// ****ProductResponseDto
+// ProductResponseDto: product with its variant matrix, categories and gallery, variants empty for product without option
+type ProductResponseDto struct {
+	*ent.Product `json:","`
+	Variants     []*ent.ProductVariant      `json:"variants"`
+	CategoryIds  []string                   `json:"categoryIds"`
+	Media        []*ProductMediaResponseDto `json:"media"`
+}
+
+func NewProductResponseDto(product *ent.Product, variants []*ent.ProductVariant, categoryIds []string,
+	media []*ProductMediaResponseDto) *ProductResponseDto {
+	return &ProductResponseDto{
+		product,
+		variants,
+		categoryIds,
+		media,
+	}
+}
@@ -1,11 +1,16 @@
 package dto
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"sthl/constants"
+	"sthl/ent/schema"
+	"sthl/money"
 	"sthl/utils"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v6"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,10 +26,11 @@ func Test_CreateProductDtoValidate(t *testing.T) {
 
 	validParam := NewCreateProductDto(
 		utils.PtrOf(gofakeit.Fruit()),
-		utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 		utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 		utils.PtrOf(gofakeit.LetterN(100)),
-		utils.PtrOf(gofakeit.LetterN(100)))
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil)
 
 	testCases := []createProductDtoValidateTestCase{
 		{
@@ -38,10 +44,11 @@ func Test_CreateProductDtoValidate(t *testing.T) {
 			name: "validate with invalid param, name empty",
 			input: NewCreateProductDto(
 				utils.PtrOf(""),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(501)),
-				utils.PtrOf(gofakeit.LetterN(100))),
+				utils.PtrOf(gofakeit.LetterN(100)),
+				nil),
 			exec: func(e error) {
 				assert.Error(e)
 			},
@@ -50,10 +57,11 @@ func Test_CreateProductDtoValidate(t *testing.T) {
 			name: "validate with invalid param, description exceed limit",
 			input: NewCreateProductDto(
 				utils.PtrOf(gofakeit.Fruit()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(513)),
-				utils.PtrOf(gofakeit.LetterN(100))),
+				utils.PtrOf(gofakeit.LetterN(100)),
+				nil),
 			exec: func(e error) {
 				assert.Error(e)
 			},
@@ -62,10 +70,11 @@ func Test_CreateProductDtoValidate(t *testing.T) {
 			name: "validate with invalid param, price min",
 			input: NewCreateProductDto(
 				utils.PtrOf(gofakeit.Fruit()),
-				utils.PtrOf(float64(0.9999)),
+				utils.PtrOf(money.Amount(99)),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(100)),
-				utils.PtrOf(gofakeit.LetterN(100))),
+				utils.PtrOf(gofakeit.LetterN(100)),
+				nil),
 			exec: func(e error) {
 				assert.Error(e)
 			},
@@ -74,10 +83,11 @@ func Test_CreateProductDtoValidate(t *testing.T) {
 			name: "validate with valid param, quantity zero",
 			input: NewCreateProductDto(
 				utils.PtrOf(gofakeit.Fruit()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(0)),
 				utils.PtrOf(gofakeit.LetterN(100)),
-				utils.PtrOf(gofakeit.LetterN(100))),
+				utils.PtrOf(gofakeit.LetterN(100)),
+				nil),
 			exec: func(e error) {
 				assert.NoError(e)
 			},
@@ -86,17 +96,49 @@ func Test_CreateProductDtoValidate(t *testing.T) {
 			name: "validate with invalid param, quantity smaller than zero",
 			input: NewCreateProductDto(
 				utils.PtrOf(gofakeit.Fruit()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(-1)),
 				utils.PtrOf(gofakeit.LetterN(100)),
-				utils.PtrOf(gofakeit.LetterN(100))),
+				utils.PtrOf(gofakeit.LetterN(100)),
+				nil),
 			exec: func(e error) {
 				assert.Error(e)
 			},
 		},
 		{
 			name:  "validate with invalid param, all nil",
-			input: NewCreateProductDto(nil, nil, nil, nil, nil),
+			input: NewCreateProductDto(nil, nil, nil, nil, nil, nil),
+			exec: func(e error) {
+				assert.Error(e)
+			},
+		},
+		{
+			name: "validate with valid param, options",
+			input: withProductOptions(validParam, []schema.ProductOption{
+				{Name: "Size", Values: []string{"S", "M"}}, {Name: "Colour", Values: []string{"Red"}}}),
+			exec: func(e error) {
+				assert.NoError(e)
+			},
+		},
+		{
+			name:  "validate with invalid param, option values duplicated",
+			input: withProductOptions(validParam, []schema.ProductOption{{Name: "Size", Values: []string{"S", "S"}}}),
+			exec: func(e error) {
+				assert.Error(e)
+			},
+		},
+		{
+			name:  "validate with invalid param, option without value",
+			input: withProductOptions(validParam, []schema.ProductOption{{Name: "Size", Values: []string{}}}),
+			exec: func(e error) {
+				assert.Error(e)
+			},
+		},
+		{
+			name: "validate with invalid param, options exceed limit",
+			input: withProductOptions(validParam, []schema.ProductOption{
+				{Name: "A", Values: []string{"1"}}, {Name: "B", Values: []string{"1"}},
+				{Name: "C", Values: []string{"1"}}, {Name: "D", Values: []string{"1"}}}),
 			exec: func(e error) {
 				assert.Error(e)
 			},
@@ -110,6 +152,12 @@ func Test_CreateProductDtoValidate(t *testing.T) {
 	}
 }
 
+func withProductOptions(d *CreateProductDto, options []schema.ProductOption) *CreateProductDto {
+	result := *d
+	result.Options = options
+	return &result
+}
+
 // ****Test_UpdateProductDtoValidate
 type updateProductDtoValidateTestCase struct {
 	name  string
@@ -125,7 +173,7 @@ func Test_UpdateProductDtoValidate(t *testing.T) {
 			name: "validate with valid param",
 			input: NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Fruit()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(100)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -138,7 +186,7 @@ func Test_UpdateProductDtoValidate(t *testing.T) {
 			name: "validate with invalid param, name empty",
 			input: NewUpdateProductDto(
 				utils.PtrOf(""),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(501)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -151,7 +199,7 @@ func Test_UpdateProductDtoValidate(t *testing.T) {
 			name: "validate with invalid param, description exceed limit",
 			input: NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Fruit()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(513)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -164,7 +212,7 @@ func Test_UpdateProductDtoValidate(t *testing.T) {
 			name: "validate with invalid param, price min",
 			input: NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Fruit()),
-				utils.PtrOf(float64(0.9999)),
+				utils.PtrOf(money.Amount(99)),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(100)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -177,7 +225,7 @@ func Test_UpdateProductDtoValidate(t *testing.T) {
 			name: "validate with valid param, quantity zero",
 			input: NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Fruit()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(0)),
 				utils.PtrOf(gofakeit.LetterN(100)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -190,7 +238,7 @@ func Test_UpdateProductDtoValidate(t *testing.T) {
 			name: "validate with invalid param, quantity smaller than zero",
 			input: NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Fruit()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(-1)),
 				utils.PtrOf(gofakeit.LetterN(100)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -213,3 +261,139 @@ func Test_UpdateProductDtoValidate(t *testing.T) {
 		})
 	}
 }
+
+// ****Test_QueryProductsDtoValidate
+type queryProductsDtoValidateTestCase struct {
+	name  string
+	query string
+	exec  func(*QueryProductsDto)
+}
+
+func Test_QueryProductsDtoValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []queryProductsDtoValidateTestCase{
+		{
+			name:  "valid param, default sort",
+			query: "",
+			exec: func(d *QueryProductsDto) {
+				assert.NoError(d.Validate())
+				sort, order := d.SortBy()
+				assert.Equal(constants.ProductSort.Created, sort)
+				assert.Equal(constants.SortOrder.Desc, order)
+			},
+		},
+		{
+			name:  "valid param, all filters",
+			query: "sort=price&minPrice=1&maxPrice=2.5&status=active,,outOfStock&inStock=true",
+			exec: func(d *QueryProductsDto) {
+				assert.NoError(d.Validate())
+				sort, order := d.SortBy()
+				assert.Equal(constants.ProductSort.Price, sort)
+				assert.Equal(constants.SortOrder.Asc, order)
+				assert.Equal(money.Amount(100), *d.MinPrice)
+				assert.Equal(money.Amount(250), *d.MaxPrice)
+				assert.Equal([]string{constants.ProductStatus.Active, constants.ProductStatus.OutOfStock}, d.Statuses)
+				assert.True(d.InStock)
+			},
+		},
+		{
+			name:  "valid param, order",
+			query: "order=asc&sort=stock",
+			exec: func(d *QueryProductsDto) {
+				assert.NoError(d.Validate())
+				assert.Nil(d.MinPrice)
+				_, order := d.SortBy()
+				assert.Equal(constants.SortOrder.Asc, order)
+			},
+		},
+		{
+			name:  "invalid param, unparsable min price",
+			query: "minPrice=abc",
+			exec: func(d *QueryProductsDto) {
+				assert.Error(d.Validate())
+				assert.Nil(d.MinPrice)
+			},
+		},
+		{
+			name:  "invalid param, unparsable max price",
+			query: "minPrice=1&maxPrice=1e2",
+			exec: func(d *QueryProductsDto) {
+				assert.Error(d.Validate())
+			},
+		},
+		{
+			name:  "valid param, relevance by default if searching",
+			query: "query=Red-Shirt!",
+			exec: func(d *QueryProductsDto) {
+				assert.NoError(d.Validate())
+				assert.Equal([]string{"red", "shirt"}, d.SearchTerms())
+				sort, order := d.SortBy()
+				assert.Equal(constants.ProductSort.Relevance, sort)
+				assert.Equal(constants.SortOrder.Desc, order)
+			},
+		},
+		{
+			name:  "invalid param, relevance without query",
+			query: "query=!!&sort=relevance",
+			exec: func(d *QueryProductsDto) {
+				assert.Error(d.Validate())
+			},
+		},
+		{
+			name:  "invalid param, sort",
+			query: "sort=wrong",
+			exec: func(d *QueryProductsDto) {
+				assert.Error(d.Validate())
+			},
+		},
+		{
+			name:  "invalid param, order",
+			query: "order=wrong",
+			exec: func(d *QueryProductsDto) {
+				assert.Error(d.Validate())
+			},
+		},
+		{
+			name:  "invalid param, negative price",
+			query: "minPrice=-1",
+			exec: func(d *QueryProductsDto) {
+				assert.Error(d.Validate())
+			},
+		},
+		{
+			name:  "invalid param, max price smaller than min price",
+			query: "minPrice=2&maxPrice=1",
+			exec: func(d *QueryProductsDto) {
+				assert.Error(d.Validate())
+			},
+		},
+		{
+			name:  "invalid param, status",
+			query: "status=wrong",
+			exec: func(d *QueryProductsDto) {
+				assert.Error(d.Validate())
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			test.exec(ExtractQueryProductsDto(httptest.NewRequest(http.MethodGet, "/?"+test.query, nil)))
+		})
+	}
+}
+
+// ****Test_ProductCurrencyRule
+func Test_ProductCurrencyRule(t *testing.T) {
+	assert := assert.New(t)
+
+	// allowlist only, currencies with other than 2 minor digits are not listed
+	for _, currency := range constants.Currency.GetList() {
+		assert.NoError(validation.Validate(&currency, ProductCurrencyRule...), currency)
+	}
+	for _, currency := range []string{"JPY", "KRW", "KWD", "BHD"} {
+		assert.Error(validation.Validate(&currency, ProductCurrencyRule...), currency)
+	}
+	assert.NoError(validation.Validate((*string)(nil), ProductCurrencyRule...))
+}
@@ -0,0 +1,169 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/money"
+	"sthl/utils"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/samber/lo"
+)
+
+// ****ImportProductsDto
+// ImportProductsDto: Mapping of constants.ProductCsvField to csv column, field not mapped read from column of same name,
+// DryRun validates rows without import
+type ImportProductsDto struct {
+	Mapping map[string]string `json:"mapping"`
+	DryRun  bool              `json:"dryRun"`
+}
+
+func NewImportProductsDto(mapping map[string]string, dryRun bool) *ImportProductsDto {
+	return &ImportProductsDto{
+		Mapping: mapping,
+		DryRun:  dryRun,
+	}
+}
+
+func (d ImportProductsDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Mapping, ProductCsvMappingRule...),
+	)
+}
+
+// Columns: index in header of each mapped field, fields without column left out,
+// name, price and quantity required
+func (d ImportProductsDto) Columns(header []string) (map[string]int, error) {
+	header = lo.Map(header, func(column string, _ int) string { return strings.TrimSpace(column) })
+	columns := map[string]int{}
+	for _, field := range constants.ProductCsvField.GetImportList() {
+		column, ok := d.Mapping[field]
+		if !ok {
+			column = field
+		}
+		i := lo.IndexOf(header, column)
+		if i != -1 {
+			columns[field] = i
+		}
+	}
+	for _, field := range []string{constants.ProductCsvField.Name, constants.ProductCsvField.Price, constants.ProductCsvField.Quantity} {
+		if _, ok := columns[field]; !ok {
+			return nil, fmt.Errorf("column of %s not found", field)
+		}
+	}
+	return columns, nil
+}
+
+// csvFormulaPrefixes: leading chars spreadsheet apps run a cell as formula on
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// csvEscapeCell: text cell quoted by ' if spreadsheet would read it as formula,
+// cell already quoted by ' gets one more so import restores it
+func csvEscapeCell(v string) string {
+	unquoted := strings.TrimLeft(v, "'")
+	if unquoted != "" && strings.ContainsRune(csvFormulaPrefixes, rune(unquoted[0])) {
+		return "'" + v
+	}
+	return v
+}
+
+// csvUnescapeCell: drop one ' quoting a formula like cell, reverse of csvEscapeCell
+func csvUnescapeCell(v string) string {
+	if strings.HasPrefix(v, "'") && csvEscapeCell(v[1:]) != v[1:] {
+		return v[1:]
+	}
+	return v
+}
+
+// NewCreateProductDtoFromCsv: row to CreateProductDto by columns of ImportProductsDto,
+// price in major units as json, empty sku and currency as nil, missing description and image url as empty,
+// ' quoting formula like sku, name and description dropped
+func NewCreateProductDtoFromCsv(record []string, columns map[string]int) (*CreateProductDto, error) {
+	value := func(field string) string {
+		i, ok := columns[field]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		v := strings.TrimSpace(record[i])
+		if field == constants.ProductCsvField.Sku || field == constants.ProductCsvField.Name || field == constants.ProductCsvField.Description {
+			v = csvUnescapeCell(v)
+		}
+		return v
+	}
+	valueOrNil := func(field string) *string {
+		v := value(field)
+		return lo.Ternary(v == "", nil, &v)
+	}
+	price, err := money.ParseAmount(value(constants.ProductCsvField.Price))
+	if err != nil {
+		return nil, fmt.Errorf("price: %w", err)
+	}
+	quantity, err := strconv.ParseInt(value(constants.ProductCsvField.Quantity), 10, 32)
+	if err != nil {
+		return nil, errors.New("quantity: must be an integer")
+	}
+	d := NewCreateProductDto(
+		utils.PtrOf(value(constants.ProductCsvField.Name)),
+		utils.PtrOf(price),
+		utils.PtrOf(int32(quantity)),
+		utils.PtrOf(value(constants.ProductCsvField.Description)),
+		utils.PtrOf(value(constants.ProductCsvField.ImgUrl)),
+		valueOrNil(constants.ProductCsvField.Currency))
+	d.Sku = valueOrNil(constants.ProductCsvField.Sku)
+	return d, nil
+}
+
+// NewImportUpdateProductDto: row of csv import as update of product with sku, status, options and currency kept
+func NewImportUpdateProductDto(d *CreateProductDto, status string) *UpdateProductDto {
+	u := NewUpdateProductDto(d.Name, d.Price, d.Quantity, d.Description, &status, d.ImgUrl)
+	u.Sku = d.Sku
+	return u
+}
+
+// ****ImportProductsResponseDto
+// ImportProductRowErrorDto: Row is line in csv with header at 1, 0 for whole file
+type ImportProductRowErrorDto struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+// ImportProductsResponseDto: Created and Updated count valid rows, Imported only if all rows valid and not DryRun
+type ImportProductsResponseDto struct {
+	DryRun   bool                       `json:"dryRun"`
+	Imported bool                       `json:"imported"`
+	Total    int                        `json:"total"`
+	Created  int                        `json:"created"`
+	Updated  int                        `json:"updated"`
+	Errors   []ImportProductRowErrorDto `json:"errors"`
+}
+
+func NewImportProductsResponseDto(dryRun bool) *ImportProductsResponseDto {
+	return &ImportProductsResponseDto{
+		DryRun: dryRun,
+		Errors: []ImportProductRowErrorDto{},
+	}
+}
+
+func (d *ImportProductsResponseDto) AddError(row int, err error) {
+	d.Errors = append(d.Errors, ImportProductRowErrorDto{Row: row, Message: err.Error()})
+}
+
+// ****ProductCsvRecord
+// NewProductCsvRecord: product as row of constants.ProductCsvField columns,
+// free text cells quoted against formula injection when opened in spreadsheet
+func NewProductCsvRecord(p *ent.Product) []string {
+	return []string{
+		csvEscapeCell(lo.FromPtr(p.Sku)),
+		csvEscapeCell(p.Name),
+		p.Price.String(),
+		strconv.FormatInt(int64(p.Quantity), 10),
+		csvEscapeCell(p.Description),
+		p.ImgURL,
+		p.Currency,
+		p.Status,
+	}
+}
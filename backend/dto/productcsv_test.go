@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/utils"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ****Test_ProductCsvFormulaCells
+func Test_ProductCsvFormulaCells(t *testing.T) {
+	assert := assert.New(t)
+
+	// formula like cells quoted on export
+	record := NewProductCsvRecord(&ent.Product{
+		Sku:         utils.PtrOf("@SUM(A1)"),
+		Name:        "=HYPERLINK(\"http://evil\")",
+		Description: "-2+3",
+		ImgURL:      "+1",
+		Currency:    constants.DefaultCurrency,
+	})
+	assert.Equal("'@SUM(A1)", record[0])
+	assert.Equal("'=HYPERLINK(\"http://evil\")", record[1])
+	assert.Equal("'-2+3", record[4])
+	assert.Equal("+1", record[5])
+	record = NewProductCsvRecord(&ent.Product{Name: "Apple", Description: "'quoted", Sku: utils.PtrOf("'=A1")})
+	assert.Equal("Apple", record[1])
+	assert.Equal("'quoted", record[4])
+	assert.Equal("''=A1", record[0])
+
+	// quote dropped on import, exported row imports as original
+	columns, err := NewImportProductsDto(nil, false).Columns(
+		[]string{"sku", "name", "price", "quantity", "description"})
+	assert.NoError(err)
+	for _, c := range []struct{ cell, expected string }{
+		{"'=HYPERLINK(\"http://evil\")", "=HYPERLINK(\"http://evil\")"},
+		{"'@SUM(A1)", "@SUM(A1)"},
+		{"''=A1", "'=A1"},
+		{"'quoted", "'quoted"},
+		{"=1+1", "=1+1"},
+	} {
+		d, err := NewCreateProductDtoFromCsv([]string{c.cell, c.cell, "1", "1", c.cell}, columns)
+		assert.NoError(err)
+		assert.Equal(c.expected, *d.Sku, c.cell)
+		assert.Equal(c.expected, *d.Name, c.cell)
+		assert.Equal(c.expected, *d.Description, c.cell)
+	}
+}
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"sthl/ent"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/samber/lo"
+)
+
+// ****SetProductMediaDto
+// ProductMediaItem: album image in gallery, nil alt text as empty
+type ProductMediaItem struct {
+	ImgInfoId *int    `json:"imgInfoId"`
+	AltText   *string `json:"altText"`
+	IsPrimary bool    `json:"isPrimary"`
+}
+
+func NewProductMediaItem(imgInfoId *int, altText *string, isPrimary bool) *ProductMediaItem {
+	return &ProductMediaItem{
+		ImgInfoId: imgInfoId,
+		AltText:   altText,
+		IsPrimary: isPrimary,
+	}
+}
+
+func (d ProductMediaItem) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.ImgInfoId, ProductMediaImgInfoIdRule...),
+		validation.Field(&d.AltText, ProductMediaAltTextRule...),
+	)
+}
+
+// SetProductMediaDto: replaces gallery of product in display order, empty to clear,
+// first image primary if none marked
+type SetProductMediaDto struct {
+	Media []*ProductMediaItem `json:"media"`
+}
+
+func NewSetProductMediaDto(media []*ProductMediaItem) *SetProductMediaDto {
+	return &SetProductMediaDto{
+		Media: media,
+	}
+}
+
+func (d SetProductMediaDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Media, ProductMediaRule...),
+	)
+}
+
+// ImgInfoIds: album images in display order
+func (d SetProductMediaDto) ImgInfoIds() []int {
+	return lo.Map(d.Media, func(item *ProductMediaItem, _ int) int { return *item.ImgInfoId })
+}
+
+// PrimaryIndex: index of primary image, 0 if none marked
+func (d SetProductMediaDto) PrimaryIndex() int {
+	_, i, ok := lo.FindIndexOf(d.Media, func(item *ProductMediaItem) bool { return item.IsPrimary })
+	return lo.Ternary(ok, i, 0)
+}
+
+// ****ProductMediaResponseDto
+// ProductMediaResponseDto: gallery entry with url resolved from album image
+type ProductMediaResponseDto struct {
+	*ent.ProductMedia `json:","`
+	ImgUrl            string `json:"imgUrl"`
+}
+
+func NewProductMediaResponseDto(media *ent.ProductMedia, imgUrl string) *ProductMediaResponseDto {
+	return &ProductMediaResponseDto{
+		media,
+		imgUrl,
+	}
+}
@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"sthl/money"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// ****CreateProductVariantDto
+type CreateProductVariantDto struct {
+	Sku      *string           `json:"sku"`
+	Options  map[string]string `json:"options"`
+	Price    *money.Amount     `json:"price"`
+	Quantity *int32            `json:"quantity"`
+	ImgUrl   *string           `json:"imgUrl"`
+}
+
+func NewCreateProductVariantDto(sku *string, options map[string]string, price *money.Amount, quantity *int32, imgUrl *string) *CreateProductVariantDto {
+	return &CreateProductVariantDto{
+		Sku:      sku,
+		Options:  options,
+		Price:    price,
+		Quantity: quantity,
+		ImgUrl:   imgUrl,
+	}
+}
+
+func (d CreateProductVariantDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Sku, ProductVariantSkuRule...),
+		validation.Field(&d.Options, ProductVariantOptionsRule...),
+		validation.Field(&d.Price, ProductVariantPriceRule...),
+		validation.Field(&d.Quantity, ProductQuantityRule...),
+		validation.Field(&d.ImgUrl, ProductImgUrlRule...),
+	)
+}
+
+// ****UpdateProductVariantDto
+type UpdateProductVariantDto struct {
+	Sku      *string           `json:"sku"`
+	Options  map[string]string `json:"options"`
+	Price    *money.Amount     `json:"price"`
+	Quantity *int32            `json:"quantity"`
+	ImgUrl   *string           `json:"imgUrl"`
+}
+
+func NewUpdateProductVariantDto(sku *string, options map[string]string, price *money.Amount, quantity *int32, imgUrl *string) *UpdateProductVariantDto {
+	return &UpdateProductVariantDto{
+		Sku:      sku,
+		Options:  options,
+		Price:    price,
+		Quantity: quantity,
+		ImgUrl:   imgUrl,
+	}
+}
+
+func (d UpdateProductVariantDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Sku, ProductVariantSkuRule...),
+		validation.Field(&d.Options, ProductVariantOptionsRule...),
+		validation.Field(&d.Price, ProductVariantPriceRule...),
+		validation.Field(&d.Quantity, ProductUpdateQuantityRule...),
+		validation.Field(&d.ImgUrl, ProductImgUrlRule...),
+	)
+}
@@ -0,0 +1,119 @@
+package dto
+
+import (
+	"sthl/ent"
+	"sthl/money"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+/* ****SwitchShopDto
+ */
+type SwitchShopDto struct {
+	ShopId *string `json:"shopId"`
+}
+
+func NewSwitchShopDto(shopId *string) *SwitchShopDto {
+	return &SwitchShopDto{
+		ShopId: shopId,
+	}
+}
+func (d SwitchShopDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.ShopId, ShopIdRule...),
+	)
+}
+
+/* ****CreateShopInviteDto
+ */
+type CreateShopInviteDto struct {
+	Email *string `json:"email"`
+	Role  *string `json:"role"`
+}
+
+func NewCreateShopInviteDto(email *string, role *string) *CreateShopInviteDto {
+	return &CreateShopInviteDto{
+		Email: email,
+		Role:  role,
+	}
+}
+func (d CreateShopInviteDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Email, UserEmailRule...),
+		validation.Field(&d.Role, ShopInviteRoleRule...),
+	)
+}
+
+/* ****AcceptShopInviteDto
+ */
+type AcceptShopInviteDto struct {
+	Token *string `json:"token"`
+}
+
+func NewAcceptShopInviteDto(token *string) *AcceptShopInviteDto {
+	return &AcceptShopInviteDto{
+		Token: token,
+	}
+}
+func (d AcceptShopInviteDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Token, ShopInviteTokenRule...),
+	)
+}
+
+/* ****UpdateShopMemberDto
+ */
+type UpdateShopMemberDto struct {
+	Role *string `json:"role"`
+}
+
+func NewUpdateShopMemberDto(role *string) *UpdateShopMemberDto {
+	return &UpdateShopMemberDto{
+		Role: role,
+	}
+}
+func (d UpdateShopMemberDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Role, ShopInviteRoleRule...),
+	)
+}
+
+/* ****UpdateShopPricingDto
+ */
+type UpdateShopPricingDto struct {
+	TaxRate     *money.Rate   `json:"taxRate"`
+	ShippingFee *money.Amount `json:"shippingFee"`
+}
+
+func NewUpdateShopPricingDto(taxRate *money.Rate, shippingFee *money.Amount) *UpdateShopPricingDto {
+	return &UpdateShopPricingDto{
+		TaxRate:     taxRate,
+		ShippingFee: shippingFee,
+	}
+}
+func (d UpdateShopPricingDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.TaxRate, ShopTaxRateRule...),
+		validation.Field(&d.ShippingFee, ShopShippingFeeRule...),
+	)
+}
+
+/* ****ShopMembershipResponseDto
+ */
+type ShopMembershipResponseDto struct {
+	ShopId   string `json:"shopId"`
+	ShopName string `json:"shopName"`
+	Role     string `json:"role"`
+}
+
+// NewShopMembershipResponseDto: member with shop edge loaded
+func NewShopMembershipResponseDto(m *ent.ShopMember) *ShopMembershipResponseDto {
+	result := &ShopMembershipResponseDto{
+		ShopId: m.ShopID.String(),
+		Role:   m.Role,
+	}
+	if m.Edges.Shop != nil {
+		result.ShopName = m.Edges.Shop.Name
+	}
+	return result
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"sthl/constants"
+	"time"
+)
+
+// ****UsageResponseDto
+// QuotaDto: Used against Limit of plan
+type QuotaDto struct {
+	Used  int64 `json:"used"`
+	Limit int64 `json:"limit"`
+}
+
+// UsageResponseDto: MonthlyOrders counted since PeriodStart
+type UsageResponseDto struct {
+	Plan          string    `json:"plan"`
+	PeriodStart   time.Time `json:"periodStart"`
+	Products      QuotaDto  `json:"products"`
+	AlbumImgs     QuotaDto  `json:"albumImgs"`
+	StorageBytes  QuotaDto  `json:"storageBytes"`
+	MonthlyOrders QuotaDto  `json:"monthlyOrders"`
+}
+
+func NewUsageResponseDto(plan string, periodStart time.Time,
+	products int, albumImgs int, storageBytes int64, monthlyOrders int) *UsageResponseDto {
+	limit := constants.Plan.GetLimit(plan)
+	return &UsageResponseDto{
+		Plan:          plan,
+		PeriodStart:   periodStart,
+		Products:      QuotaDto{Used: int64(products), Limit: int64(limit.Products)},
+		AlbumImgs:     QuotaDto{Used: int64(albumImgs), Limit: int64(limit.AlbumImgs)},
+		StorageBytes:  QuotaDto{Used: storageBytes, Limit: limit.StorageBytes},
+		MonthlyOrders: QuotaDto{Used: int64(monthlyOrders), Limit: int64(limit.MonthlyOrders)},
+	}
+}
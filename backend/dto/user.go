@@ -75,6 +75,77 @@ func (d RefreshAccessTokenDto) Validate() error {
 	)
 }
 
+/* ****LogoutDto
+ */
+type LogoutDto struct {
+	RefreshToken *string `json:"refreshToken"`
+}
+
+func NewLogoutDto(rt *string) *LogoutDto {
+	return &LogoutDto{
+		RefreshToken: rt,
+	}
+}
+func (d LogoutDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.RefreshToken, validation.Required),
+	)
+}
+
+/* ****VerifyEmailDto
+ */
+type VerifyEmailDto struct {
+	Token *string `json:"token"`
+}
+
+func NewVerifyEmailDto(token *string) *VerifyEmailDto {
+	return &VerifyEmailDto{
+		Token: token,
+	}
+}
+func (d VerifyEmailDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Token, validation.Required, validation.Length(1, 128)),
+	)
+}
+
+/* ****ForgotPasswordDto
+ */
+type ForgotPasswordDto struct {
+	Email *string `json:"email"`
+}
+
+func NewForgotPasswordDto(email *string) *ForgotPasswordDto {
+	return &ForgotPasswordDto{
+		Email: email,
+	}
+}
+func (d ForgotPasswordDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Email, UserEmailRule...),
+	)
+}
+
+/* ****ResetPasswordDto
+ */
+type ResetPasswordDto struct {
+	Token       *string `json:"token"`
+	NewPassword *string `json:"newPassword"`
+}
+
+func NewResetPasswordDto(token *string, newP *string) *ResetPasswordDto {
+	return &ResetPasswordDto{
+		Token:       token,
+		NewPassword: newP,
+	}
+}
+func (d ResetPasswordDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Token, validation.Required, validation.Length(1, 128)),
+		validation.Field(&d.NewPassword, UserPasswordRule...),
+	)
+}
+
 /* ****UpdateUserPasswordDto
  */
 type UpdateUserPasswordDto struct {
@@ -96,6 +167,23 @@ func (d UpdateUserPasswordDto) Validate() error {
 	)
 }
 
+/* ****UpdateUserPlanDto
+ */
+type UpdateUserPlanDto struct {
+	Plan *string `json:"plan"`
+}
+
+func NewUpdateUserPlanDto(plan *string) *UpdateUserPlanDto {
+	return &UpdateUserPlanDto{
+		Plan: plan,
+	}
+}
+func (d UpdateUserPlanDto) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Plan, UserPlanRule...),
+	)
+}
+
 /* ****QueryUsersDto
  */
 type QueryUsersDto struct {
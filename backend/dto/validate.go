@@ -32,6 +32,10 @@ func NotEquals(target any, fields string) validation.RuleFunc {
 			if !ok {
 				return retreiveErr
 			}
+			// nil of optional field never equal
+			if v == nil {
+				return nil
+			}
 			if *v == *s {
 				return equalErr
 			}
@@ -2,12 +2,17 @@ package dto
 
 import (
 	"errors"
+	"regexp"
 	"sthl/constants"
+	"sthl/ent/schema"
+	"sthl/money"
 	"sthl/utils"
+	"strconv"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 // ****User
@@ -24,6 +29,55 @@ var (
 	UserPasswordRule = []validation.Rule{
 		validation.Required, validation.Length(6, 255),
 	}
+	UserPlanRule = []validation.Rule{
+		validation.Required, validation.In(lo.ToAnySlice(constants.Plan.GetList())...),
+	}
+)
+
+// ****Mfa
+var (
+	TotpCodeRule = []validation.Rule{
+		validation.Required, validation.Length(constants.TotpDigits, constants.TotpDigits), is.Digit,
+	}
+	// MfaCodeRule: totp code or recovery code
+	MfaCodeRule = []validation.Rule{
+		validation.Required, validation.Length(constants.TotpDigits, 16),
+	}
+	MfaTokenRule = []validation.Rule{
+		validation.Required,
+	}
+)
+
+// ****Shop
+var (
+	ShopIdRule = []validation.Rule{
+		validation.Required, is.UUID,
+	}
+	ShopInviteRoleRule = []validation.Rule{
+		validation.Required, validation.By(InStrings(constants.ShopRole.GetInvitableList(), "shop role")),
+	}
+	ShopInviteTokenRule = []validation.Rule{
+		validation.Required, validation.Length(1, 128),
+	}
+	ShopTaxRateRule = []validation.Rule{
+		validation.NotNil, validation.Min(money.Rate(0)), validation.Max(money.RateOne),
+	}
+	ShopShippingFeeRule = []validation.Rule{
+		validation.NotNil, validation.Min(money.Amount(0)),
+	}
+)
+
+// ****Api key
+var (
+	ApiKeyNameRule = []validation.Rule{
+		validation.Required, validation.Length(1, 64),
+	}
+	ApiKeyScopesRule = []validation.Rule{
+		validation.Required, validation.Each(validation.By(InStrings(constants.ApiKeyScope.GetList(), "api key scope"))),
+	}
+	ApiKeyExpiresInDaysRule = []validation.Rule{
+		validation.Min(1), validation.Max(constants.ApiKeyMaxDurationDays),
+	}
 )
 
 // ****Product
@@ -32,11 +86,19 @@ var (
 		validation.Required, validation.Length(2, 128),
 	}
 	ProductPriceRule = []validation.Rule{
-		validation.Required, validation.Min(1.0),
+		validation.Required, validation.Min(money.Amount(100)), validation.Max(money.Amount(1000000000)),
+	}
+	// optional, nil means default currency
+	ProductCurrencyRule = []validation.Rule{
+		validation.NilOrNotEmpty, validation.In(lo.ToAnySlice(constants.Currency.GetList())...),
 	}
 	ProductQuantityRule = []validation.Rule{
 		validation.NotNil, validation.Min(0),
 	}
+	// optional on update, nil keeps current stock so sales since read are not overwritten
+	ProductUpdateQuantityRule = []validation.Rule{
+		validation.Min(0),
+	}
 	ProductDescriptionRule = []validation.Rule{
 		validation.NotNil, validation.Length(0, 512),
 	}
@@ -46,6 +108,233 @@ var (
 	ProductImgUrlRule = []validation.Rule{
 		validation.NotNil, validation.Length(0, 512),
 	}
+	// optional, nil means no sku on create and unchanged on update
+	ProductSkuRule = []validation.Rule{
+		validation.NilOrNotEmpty, validation.Length(1, 64),
+	}
+)
+
+// ****ProductVariant
+var (
+	checkProductOptions = func(value interface{}) error {
+		s, ok := value.([]schema.ProductOption)
+		if !ok {
+			return errors.New("fail to parse value to []schema.ProductOption")
+		}
+		if len(s) > constants.MaxProductOptions {
+			return errors.New("too many product options")
+		}
+		names := lo.Map(s, func(option schema.ProductOption, _ int) string { return option.Name })
+		if len(lo.Uniq(names)) != len(names) {
+			return errors.New("product option names not unique")
+		}
+		for _, option := range s {
+			err := validation.Validate(option.Name, validation.Required, validation.Length(1, 32))
+			if err != nil {
+				return err
+			}
+			if len(option.Values) == 0 || len(option.Values) > constants.MaxProductOptionValues {
+				return errors.New("product option values out of range")
+			}
+			if len(lo.Uniq(option.Values)) != len(option.Values) {
+				return errors.New("product option values not unique")
+			}
+			err = validation.Validate(option.Values, validation.Each(validation.Required, validation.Length(1, 64)))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// optional, nil means no option on create and unchanged on update
+	ProductOptionsRule = []validation.Rule{
+		validation.By(checkProductOptions),
+	}
+	ProductVariantSkuRule = []validation.Rule{
+		validation.Required, validation.Length(1, 64),
+	}
+	// checked against options of product by service
+	ProductVariantOptionsRule = []validation.Rule{
+		validation.Required,
+	}
+	// optional, nil means product price
+	ProductVariantPriceRule = []validation.Rule{
+		validation.NilOrNotEmpty, validation.Min(money.Amount(100)), validation.Max(money.Amount(1000000000)),
+	}
+)
+
+// ****Category
+var (
+	slugRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	// ids unique, each a non zero uuid
+	checkUniqueIds = func(value interface{}) error {
+		s, ok := value.([]string)
+		if !ok {
+			return errors.New("fail to parse value to []string")
+		}
+		if len(lo.Uniq(s)) != len(s) {
+			return errors.New("ids not unique")
+		}
+		return validation.Validate(s, validation.Each(validation.Required, is.UUID,
+			validation.By(NotEquals(uuid.Nil.String(), "id and zero uuid"))))
+	}
+	CategoryNameRule = []validation.Rule{
+		validation.Required, validation.Length(1, 64),
+	}
+	// lower case words joined by hyphen, eg summer-sale
+	CategorySlugRule = []validation.Rule{
+		validation.Required, validation.Length(1, 64), validation.Match(slugRegexp),
+	}
+	CategoryDescriptionRule = []validation.Rule{
+		validation.NotNil, validation.Length(0, 255),
+	}
+	// optional, nil for top level category
+	CategoryParentIdRule = []validation.Rule{
+		validation.NilOrNotEmpty, is.UUID, validation.By(NotEquals(utils.PtrOf(uuid.Nil.String()), "category parentId and zero uuid")),
+	}
+	CategoryPositionRule = []validation.Rule{
+		validation.NotNil, validation.Min(int32(0)), validation.Max(int32(10000)),
+	}
+	ProductCategoryIdsRule = []validation.Rule{
+		validation.NotNil, validation.Length(0, constants.MaxProductCategories), validation.By(checkUniqueIds),
+	}
+)
+
+// ****ProductMedia
+var (
+	// each item valid, images unique, at most one primary
+	checkProductMedia = func(value interface{}) error {
+		s, ok := value.([]*ProductMediaItem)
+		if !ok {
+			return errors.New("fail to parse value to []*ProductMediaItem")
+		}
+		for _, item := range s {
+			if item == nil {
+				return errors.New("media item is nil")
+			}
+			err := item.Validate()
+			if err != nil {
+				return err
+			}
+		}
+		ids := lo.Map(s, func(item *ProductMediaItem, _ int) int { return *item.ImgInfoId })
+		if len(lo.Uniq(ids)) != len(ids) {
+			return errors.New("images not unique")
+		}
+		if lo.CountBy(s, func(item *ProductMediaItem) bool { return item.IsPrimary }) > 1 {
+			return errors.New("more than one primary image")
+		}
+		return nil
+	}
+	ProductMediaRule = []validation.Rule{
+		validation.NotNil, validation.Length(0, constants.MaxProductMedia), validation.By(checkProductMedia),
+	}
+	ProductMediaImgInfoIdRule = []validation.Rule{
+		validation.Required, validation.Min(1),
+	}
+	ProductMediaAltTextRule = []validation.Rule{
+		validation.Length(0, 255),
+	}
+)
+
+// ****ProductCsv
+var (
+	// fields importable, columns not empty and unique
+	checkProductCsvMapping = func(value interface{}) error {
+		m, ok := value.(map[string]string)
+		if !ok {
+			return errors.New("fail to parse value to map[string]string")
+		}
+		for field, column := range m {
+			if !lo.Contains(constants.ProductCsvField.GetImportList(), field) {
+				return errors.New("field not importable")
+			}
+			err := validation.Validate(column, validation.Required, validation.Length(1, 255))
+			if err != nil {
+				return err
+			}
+		}
+		if len(lo.Uniq(lo.Values(m))) != len(m) {
+			return errors.New("columns not unique")
+		}
+		return nil
+	}
+	// optional, nil means columns named as fields
+	ProductCsvMappingRule = []validation.Rule{
+		validation.By(checkProductCsvMapping),
+	}
+)
+
+// ****Collection
+var (
+	checkCollectionRules = func(value interface{}) error {
+		s, ok := value.([]schema.CollectionRule)
+		if !ok {
+			return errors.New("fail to parse value to []schema.CollectionRule")
+		}
+		if len(s) > constants.MaxCollectionRules {
+			return errors.New("too many collection rules")
+		}
+		for _, rule := range s {
+			if !lo.Contains(constants.CollectionRuleField.GetList(), rule.Field) {
+				return errors.New("collection rule field not supported")
+			}
+			if !lo.Contains(constants.CollectionRuleOperator.GetListByField(rule.Field), rule.Operator) {
+				return errors.New("collection rule operator not supported by field")
+			}
+			var err error
+			switch rule.Field {
+			case constants.CollectionRuleField.Name:
+				err = validation.Validate(rule.Value, validation.Required, validation.Length(1, 128))
+			case constants.CollectionRuleField.Price:
+				// major units as listing filter and product json
+				var n money.Amount
+				n, err = money.ParseAmount(rule.Value)
+				if err == nil && n < 0 {
+					err = errors.New("collection rule value negative")
+				}
+			case constants.CollectionRuleField.Quantity:
+				var n int64
+				n, err = strconv.ParseInt(rule.Value, 10, 32)
+				if err == nil && n < 0 {
+					err = errors.New("collection rule value negative")
+				}
+			case constants.CollectionRuleField.Status:
+				err = validation.Validate(rule.Value, validation.By(InStrings(constants.ProductStatus.GetList(), "status")))
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	CollectionNameRule = []validation.Rule{
+		validation.Required, validation.Length(1, 64),
+	}
+	CollectionKindRule = []validation.Rule{
+		validation.Required, validation.By(InStrings(constants.CollectionType.GetList(), "collection kind")),
+	}
+	// matched against kind of collection by service
+	CollectionRulesRule = []validation.Rule{
+		validation.By(checkCollectionRules),
+	}
+	CollectionProductIdsRule = []validation.Rule{
+		validation.Length(0, constants.MaxCollectionProducts), validation.By(checkUniqueIds),
+	}
+)
+
+// ****Inventory
+var (
+	// non zero, positive restocks and negative takes stock
+	InventoryDeltaRule = []validation.Rule{
+		validation.Required, validation.Min(int32(-10000000)), validation.Max(int32(10000000)),
+	}
+	InventoryReasonRule = []validation.Rule{
+		validation.Required, validation.By(InStrings(constants.InventoryReason.GetManualList(), "inventory reason")),
+	}
+	InventoryNoteRule = []validation.Rule{
+		validation.NotNil, validation.Length(0, 255),
+	}
 )
 
 // ****Order
@@ -54,10 +343,10 @@ var (
 		validation.NotNil, validation.Length(0, 255),
 	}
 	OrderDiscountRule = []validation.Rule{
-		validation.Required, validation.Min(0.1), validation.Max(1.0),
+		validation.Required, validation.Min(money.Rate(100000)), validation.Max(money.RateOne),
 	}
 	OrderTotalAmountRule = []validation.Rule{
-		validation.NotNil, validation.Min(0.0),
+		validation.NotNil, validation.Min(money.Amount(0)),
 	}
 	OrderStatusRule = []validation.Rule{
 		validation.Required, validation.By(InStrings(constants.OrderStatus.GetList(), "order status")),
@@ -74,30 +363,32 @@ var (
 	OrderShippingAddressRule = []validation.Rule{
 		validation.Required, validation.Length(1, 255),
 	}
+	// empty until shipped
 	OrderTrackingNumberRule = []validation.Rule{
-		validation.Required, validation.Length(1, 128),
+		validation.NotNil, validation.Length(0, 128),
 	}
-	checkOrderItemsProductIdIsUnique = func(value interface{}) error {
+	// one line per product, or per variant of product
+	checkOrderItemsLineIsUnique = func(value interface{}) error {
 		s, ok := value.([]*OrderItem)
 		if !ok {
 			return errors.New("fail to parse value to []*OrderItem")
 		}
-		ids := []string{}
+		keys := []string{}
 		for _, item := range s {
 			err := item.Validate()
 			if err != nil {
 				return err
 			}
-			_, foundIndex := utils.Find(ids, func(index int, id string) bool { return id == *item.ProductId })
+			_, foundIndex := utils.Find(keys, func(index int, key string) bool { return key == item.LineKey() })
 			if foundIndex != -1 {
 				return errors.New("order items not unique")
 			}
-			ids = append(ids, *item.ProductId)
+			keys = append(keys, item.LineKey())
 		}
 		return nil
 	}
 	OrderItemsRule = []validation.Rule{
-		validation.Required, validation.By(checkOrderItemsProductIdIsUnique),
+		validation.Required, validation.By(checkOrderItemsLineIsUnique),
 	}
 	OrderItemIdRule = []validation.Rule{
 		validation.Required, is.UUID, validation.By(NotEquals(utils.PtrOf(uuid.Nil.String()), "order item id and zero uuid")),
@@ -105,11 +396,15 @@ var (
 	OrderItemProductIdRule = []validation.Rule{
 		validation.Required, is.UUID, validation.By(NotEquals(utils.PtrOf(uuid.Nil.String()), "order item productId and zero uuid")),
 	}
+	// optional, nil for product without variant
+	OrderItemVariantIdRule = []validation.Rule{
+		validation.NilOrNotEmpty, is.UUID, validation.By(NotEquals(utils.PtrOf(uuid.Nil.String()), "order item variantId and zero uuid")),
+	}
 	OrderItemPurchasedNameRule = []validation.Rule{
 		validation.Required, validation.Length(2, 128),
 	}
 	OrderItemPurchasedPriceRule = []validation.Rule{
-		validation.Required, validation.Min(1.0),
+		validation.Required, validation.Min(money.Amount(100)),
 	}
 	OrderItemQuantityRule = []validation.Rule{
 		validation.Required, validation.Min(1),
@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sthl/ent/collection"
+	"sthl/ent/schema"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Collection is the model entity for the Collection schema.
+type Collection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"userId"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind"`
+	// Rules holds the value of the "rules" field.
+	Rules []schema.CollectionRule `json:"rules"`
+	// Version holds the value of the "version" field.
+	Version int64 `json:"version"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CollectionQuery when eager-loading is set.
+	Edges CollectionEdges `json:"-"`
+}
+
+// CollectionEdges holds the relations/edges for other nodes in the graph.
+type CollectionEdges struct {
+	// Products holds the value of the products edge.
+	Products []*Product `json:"products,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProductsOrErr returns the Products value or an error if the edge
+// was not loaded in eager-loading.
+func (e CollectionEdges) ProductsOrErr() ([]*Product, error) {
+	if e.loadedTypes[0] {
+		return e.Products, nil
+	}
+	return nil, &NotLoadedError{edge: "products"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Collection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case collection.FieldRules:
+			values[i] = new([]byte)
+		case collection.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case collection.FieldName, collection.FieldSlug, collection.FieldDescription, collection.FieldKind:
+			values[i] = new(sql.NullString)
+		case collection.FieldCreatedAt, collection.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case collection.FieldID, collection.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Collection", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Collection fields.
+func (c *Collection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case collection.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				c.ID = *value
+			}
+		case collection.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case collection.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		case collection.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				c.UserID = *value
+			}
+		case collection.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case collection.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				c.Slug = value.String
+			}
+		case collection.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				c.Description = value.String
+			}
+		case collection.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				c.Kind = value.String
+			}
+		case collection.FieldRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Rules); err != nil {
+					return fmt.Errorf("unmarshal field rules: %w", err)
+				}
+			}
+		case collection.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				c.Version = value.Int64
+			}
+		}
+	}
+	return nil
+}
+
+// QueryProducts queries the "products" edge of the Collection entity.
+func (c *Collection) QueryProducts() *ProductQuery {
+	return NewCollectionClient(c.config).QueryProducts(c)
+}
+
+// Update returns a builder for updating this Collection.
+// Note that you need to call Collection.Unwrap() before calling this method if this Collection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Collection) Update() *CollectionUpdateOne {
+	return NewCollectionClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Collection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Collection) Unwrap() *Collection {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Collection is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Collection) String() string {
+	var builder strings.Builder
+	builder.WriteString("Collection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(c.Name)
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(c.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(c.Description)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(c.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("rules=")
+	builder.WriteString(fmt.Sprintf("%v", c.Rules))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", c.Version))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Collections is a parsable slice of Collection.
+type Collections []*Collection
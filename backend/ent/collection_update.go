@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/collection"
+	"sthl/ent/predicate"
+	"sthl/ent/product"
+	"sthl/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CollectionUpdate is the builder for updating Collection entities.
+type CollectionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CollectionMutation
+}
+
+// Where appends a list predicates to the CollectionUpdate builder.
+func (cu *CollectionUpdate) Where(ps ...predicate.Collection) *CollectionUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CollectionUpdate) SetUpdatedAt(t time.Time) *CollectionUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetUserID sets the "user_id" field.
+func (cu *CollectionUpdate) SetUserID(u uuid.UUID) *CollectionUpdate {
+	cu.mutation.SetUserID(u)
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CollectionUpdate) SetName(s string) *CollectionUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetSlug sets the "slug" field.
+func (cu *CollectionUpdate) SetSlug(s string) *CollectionUpdate {
+	cu.mutation.SetSlug(s)
+	return cu
+}
+
+// SetDescription sets the "description" field.
+func (cu *CollectionUpdate) SetDescription(s string) *CollectionUpdate {
+	cu.mutation.SetDescription(s)
+	return cu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cu *CollectionUpdate) SetNillableDescription(s *string) *CollectionUpdate {
+	if s != nil {
+		cu.SetDescription(*s)
+	}
+	return cu
+}
+
+// SetKind sets the "kind" field.
+func (cu *CollectionUpdate) SetKind(s string) *CollectionUpdate {
+	cu.mutation.SetKind(s)
+	return cu
+}
+
+// SetRules sets the "rules" field.
+func (cu *CollectionUpdate) SetRules(sr []schema.CollectionRule) *CollectionUpdate {
+	cu.mutation.SetRules(sr)
+	return cu
+}
+
+// AppendRules appends sr to the "rules" field.
+func (cu *CollectionUpdate) AppendRules(sr []schema.CollectionRule) *CollectionUpdate {
+	cu.mutation.AppendRules(sr)
+	return cu
+}
+
+// SetVersion sets the "version" field.
+func (cu *CollectionUpdate) SetVersion(i int64) *CollectionUpdate {
+	cu.mutation.ResetVersion()
+	cu.mutation.SetVersion(i)
+	return cu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cu *CollectionUpdate) SetNillableVersion(i *int64) *CollectionUpdate {
+	if i != nil {
+		cu.SetVersion(*i)
+	}
+	return cu
+}
+
+// AddVersion adds i to the "version" field.
+func (cu *CollectionUpdate) AddVersion(i int64) *CollectionUpdate {
+	cu.mutation.AddVersion(i)
+	return cu
+}
+
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (cu *CollectionUpdate) AddProductIDs(ids ...uuid.UUID) *CollectionUpdate {
+	cu.mutation.AddProductIDs(ids...)
+	return cu
+}
+
+// AddProducts adds the "products" edges to the Product entity.
+func (cu *CollectionUpdate) AddProducts(p ...*Product) *CollectionUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.AddProductIDs(ids...)
+}
+
+// Mutation returns the CollectionMutation object of the builder.
+func (cu *CollectionUpdate) Mutation() *CollectionMutation {
+	return cu.mutation
+}
+
+// ClearProducts clears all "products" edges to the Product entity.
+func (cu *CollectionUpdate) ClearProducts() *CollectionUpdate {
+	cu.mutation.ClearProducts()
+	return cu
+}
+
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (cu *CollectionUpdate) RemoveProductIDs(ids ...uuid.UUID) *CollectionUpdate {
+	cu.mutation.RemoveProductIDs(ids...)
+	return cu
+}
+
+// RemoveProducts removes "products" edges to Product entities.
+func (cu *CollectionUpdate) RemoveProducts(p ...*Product) *CollectionUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.RemoveProductIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CollectionUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks[int, CollectionMutation](ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CollectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CollectionUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CollectionUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CollectionUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := collection.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CollectionUpdate) check() error {
+	if v, ok := cu.mutation.Name(); ok {
+		if err := collection.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Collection.name": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Slug(); ok {
+		if err := collection.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Collection.slug": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Description(); ok {
+		if err := collection.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Collection.description": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Kind(); ok {
+		if err := collection.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Collection.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cu *CollectionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collection.Table, collection.Columns, sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(collection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.UserID(); ok {
+		_spec.SetField(collection.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(collection.FieldName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Slug(); ok {
+		_spec.SetField(collection.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Description(); ok {
+		_spec.SetField(collection.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Kind(); ok {
+		_spec.SetField(collection.FieldKind, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Rules(); ok {
+		_spec.SetField(collection.FieldRules, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, collection.FieldRules, value)
+		})
+	}
+	if value, ok := cu.mutation.Version(); ok {
+		_spec.SetField(collection.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedVersion(); ok {
+		_spec.AddField(collection.FieldVersion, field.TypeInt64, value)
+	}
+	if cu.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collection.ProductsTable,
+			Columns: collection.ProductsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: product.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedProductsIDs(); len(nodes) > 0 && !cu.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collection.ProductsTable,
+			Columns: collection.ProductsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ProductsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collection.ProductsTable,
+			Columns: collection.ProductsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CollectionUpdateOne is the builder for updating a single Collection entity.
+type CollectionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CollectionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CollectionUpdateOne) SetUpdatedAt(t time.Time) *CollectionUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetUserID sets the "user_id" field.
+func (cuo *CollectionUpdateOne) SetUserID(u uuid.UUID) *CollectionUpdateOne {
+	cuo.mutation.SetUserID(u)
+	return cuo
+}
+
+// SetName sets the "name" field.
+func (cuo *CollectionUpdateOne) SetName(s string) *CollectionUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetSlug sets the "slug" field.
+func (cuo *CollectionUpdateOne) SetSlug(s string) *CollectionUpdateOne {
+	cuo.mutation.SetSlug(s)
+	return cuo
+}
+
+// SetDescription sets the "description" field.
+func (cuo *CollectionUpdateOne) SetDescription(s string) *CollectionUpdateOne {
+	cuo.mutation.SetDescription(s)
+	return cuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cuo *CollectionUpdateOne) SetNillableDescription(s *string) *CollectionUpdateOne {
+	if s != nil {
+		cuo.SetDescription(*s)
+	}
+	return cuo
+}
+
+// SetKind sets the "kind" field.
+func (cuo *CollectionUpdateOne) SetKind(s string) *CollectionUpdateOne {
+	cuo.mutation.SetKind(s)
+	return cuo
+}
+
+// SetRules sets the "rules" field.
+func (cuo *CollectionUpdateOne) SetRules(sr []schema.CollectionRule) *CollectionUpdateOne {
+	cuo.mutation.SetRules(sr)
+	return cuo
+}
+
+// AppendRules appends sr to the "rules" field.
+func (cuo *CollectionUpdateOne) AppendRules(sr []schema.CollectionRule) *CollectionUpdateOne {
+	cuo.mutation.AppendRules(sr)
+	return cuo
+}
+
+// SetVersion sets the "version" field.
+func (cuo *CollectionUpdateOne) SetVersion(i int64) *CollectionUpdateOne {
+	cuo.mutation.ResetVersion()
+	cuo.mutation.SetVersion(i)
+	return cuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (cuo *CollectionUpdateOne) SetNillableVersion(i *int64) *CollectionUpdateOne {
+	if i != nil {
+		cuo.SetVersion(*i)
+	}
+	return cuo
+}
+
+// AddVersion adds i to the "version" field.
+func (cuo *CollectionUpdateOne) AddVersion(i int64) *CollectionUpdateOne {
+	cuo.mutation.AddVersion(i)
+	return cuo
+}
+
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (cuo *CollectionUpdateOne) AddProductIDs(ids ...uuid.UUID) *CollectionUpdateOne {
+	cuo.mutation.AddProductIDs(ids...)
+	return cuo
+}
+
+// AddProducts adds the "products" edges to the Product entity.
+func (cuo *CollectionUpdateOne) AddProducts(p ...*Product) *CollectionUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.AddProductIDs(ids...)
+}
+
+// Mutation returns the CollectionMutation object of the builder.
+func (cuo *CollectionUpdateOne) Mutation() *CollectionMutation {
+	return cuo.mutation
+}
+
+// ClearProducts clears all "products" edges to the Product entity.
+func (cuo *CollectionUpdateOne) ClearProducts() *CollectionUpdateOne {
+	cuo.mutation.ClearProducts()
+	return cuo
+}
+
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (cuo *CollectionUpdateOne) RemoveProductIDs(ids ...uuid.UUID) *CollectionUpdateOne {
+	cuo.mutation.RemoveProductIDs(ids...)
+	return cuo
+}
+
+// RemoveProducts removes "products" edges to Product entities.
+func (cuo *CollectionUpdateOne) RemoveProducts(p ...*Product) *CollectionUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.RemoveProductIDs(ids...)
+}
+
+// Where appends a list predicates to the CollectionUpdate builder.
+func (cuo *CollectionUpdateOne) Where(ps ...predicate.Collection) *CollectionUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CollectionUpdateOne) Select(field string, fields ...string) *CollectionUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Collection entity.
+func (cuo *CollectionUpdateOne) Save(ctx context.Context) (*Collection, error) {
+	cuo.defaults()
+	return withHooks[*Collection, CollectionMutation](ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CollectionUpdateOne) SaveX(ctx context.Context) *Collection {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CollectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CollectionUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CollectionUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := collection.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CollectionUpdateOne) check() error {
+	if v, ok := cuo.mutation.Name(); ok {
+		if err := collection.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Collection.name": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Slug(); ok {
+		if err := collection.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Collection.slug": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Description(); ok {
+		if err := collection.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Collection.description": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Kind(); ok {
+		if err := collection.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Collection.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cuo *CollectionUpdateOne) sqlSave(ctx context.Context) (_node *Collection, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(collection.Table, collection.Columns, sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Collection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, collection.FieldID)
+		for _, f := range fields {
+			if !collection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != collection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(collection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.UserID(); ok {
+		_spec.SetField(collection.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(collection.FieldName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Slug(); ok {
+		_spec.SetField(collection.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Description(); ok {
+		_spec.SetField(collection.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Kind(); ok {
+		_spec.SetField(collection.FieldKind, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Rules(); ok {
+		_spec.SetField(collection.FieldRules, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, collection.FieldRules, value)
+		})
+	}
+	if value, ok := cuo.mutation.Version(); ok {
+		_spec.SetField(collection.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedVersion(); ok {
+		_spec.AddField(collection.FieldVersion, field.TypeInt64, value)
+	}
+	if cuo.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collection.ProductsTable,
+			Columns: collection.ProductsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: product.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedProductsIDs(); len(nodes) > 0 && !cuo.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collection.ProductsTable,
+			Columns: collection.ProductsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ProductsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   collection.ProductsTable,
+			Columns: collection.ProductsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Collection{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{collection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
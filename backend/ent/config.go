@@ -27,20 +27,56 @@ type config struct {
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Imageinfo []ent.Hook
-		Order     []ent.Hook
-		OrderItem []ent.Hook
-		Product   []ent.Hook
-		Siteui    []ent.Hook
-		User      []ent.Hook
+		ApiKey                 []ent.Hook
+		Category               []ent.Hook
+		Collection             []ent.Hook
+		EmailVerificationToken []ent.Hook
+		IdempotencyKey         []ent.Hook
+		Imageinfo              []ent.Hook
+		InventoryMovement      []ent.Hook
+		LoginLockoutEvent      []ent.Hook
+		LoginThrottle          []ent.Hook
+		MfaRecoveryCode        []ent.Hook
+		Order                  []ent.Hook
+		OrderEvent             []ent.Hook
+		OrderItem              []ent.Hook
+		PasswordResetToken     []ent.Hook
+		Product                []ent.Hook
+		ProductMedia           []ent.Hook
+		ProductVariant         []ent.Hook
+		RefreshToken           []ent.Hook
+		Shop                   []ent.Hook
+		ShopInvite             []ent.Hook
+		ShopMember             []ent.Hook
+		Siteui                 []ent.Hook
+		User                   []ent.Hook
+		UserTotp               []ent.Hook
 	}
 	inters struct {
-		Imageinfo []ent.Interceptor
-		Order     []ent.Interceptor
-		OrderItem []ent.Interceptor
-		Product   []ent.Interceptor
-		Siteui    []ent.Interceptor
-		User      []ent.Interceptor
+		ApiKey                 []ent.Interceptor
+		Category               []ent.Interceptor
+		Collection             []ent.Interceptor
+		EmailVerificationToken []ent.Interceptor
+		IdempotencyKey         []ent.Interceptor
+		Imageinfo              []ent.Interceptor
+		InventoryMovement      []ent.Interceptor
+		LoginLockoutEvent      []ent.Interceptor
+		LoginThrottle          []ent.Interceptor
+		MfaRecoveryCode        []ent.Interceptor
+		Order                  []ent.Interceptor
+		OrderEvent             []ent.Interceptor
+		OrderItem              []ent.Interceptor
+		PasswordResetToken     []ent.Interceptor
+		Product                []ent.Interceptor
+		ProductMedia           []ent.Interceptor
+		ProductVariant         []ent.Interceptor
+		RefreshToken           []ent.Interceptor
+		Shop                   []ent.Interceptor
+		ShopInvite             []ent.Interceptor
+		ShopMember             []ent.Interceptor
+		Siteui                 []ent.Interceptor
+		User                   []ent.Interceptor
+		UserTotp               []ent.Interceptor
 	}
 )
 
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sthl/ent/emailverificationtoken"
+	"sthl/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// EmailVerificationToken is the model entity for the EmailVerificationToken schema.
+type EmailVerificationToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"userId"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expiresAt"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt *time.Time `json:"usedAt"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EmailVerificationTokenQuery when eager-loading is set.
+	Edges EmailVerificationTokenEdges `json:"-"`
+}
+
+// EmailVerificationTokenEdges holds the relations/edges for other nodes in the graph.
+type EmailVerificationTokenEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EmailVerificationTokenEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailVerificationToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailverificationtoken.FieldTokenHash:
+			values[i] = new(sql.NullString)
+		case emailverificationtoken.FieldCreatedAt, emailverificationtoken.FieldUpdatedAt, emailverificationtoken.FieldExpiresAt, emailverificationtoken.FieldUsedAt:
+			values[i] = new(sql.NullTime)
+		case emailverificationtoken.FieldID, emailverificationtoken.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type EmailVerificationToken", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailVerificationToken fields.
+func (evt *EmailVerificationToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailverificationtoken.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				evt.ID = *value
+			}
+		case emailverificationtoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				evt.CreatedAt = value.Time
+			}
+		case emailverificationtoken.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				evt.UpdatedAt = value.Time
+			}
+		case emailverificationtoken.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				evt.UserID = *value
+			}
+		case emailverificationtoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				evt.TokenHash = value.String
+			}
+		case emailverificationtoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				evt.ExpiresAt = value.Time
+			}
+		case emailverificationtoken.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				evt.UsedAt = new(time.Time)
+				*evt.UsedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryOwner queries the "owner" edge of the EmailVerificationToken entity.
+func (evt *EmailVerificationToken) QueryOwner() *UserQuery {
+	return NewEmailVerificationTokenClient(evt.config).QueryOwner(evt)
+}
+
+// Update returns a builder for updating this EmailVerificationToken.
+// Note that you need to call EmailVerificationToken.Unwrap() before calling this method if this EmailVerificationToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (evt *EmailVerificationToken) Update() *EmailVerificationTokenUpdateOne {
+	return NewEmailVerificationTokenClient(evt.config).UpdateOne(evt)
+}
+
+// Unwrap unwraps the EmailVerificationToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (evt *EmailVerificationToken) Unwrap() *EmailVerificationToken {
+	_tx, ok := evt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailVerificationToken is not a transactional entity")
+	}
+	evt.config.driver = _tx.drv
+	return evt
+}
+
+// String implements the fmt.Stringer.
+func (evt *EmailVerificationToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailVerificationToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", evt.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(evt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(evt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", evt.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(evt.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := evt.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailVerificationTokens is a parsable slice of EmailVerificationToken.
+type EmailVerificationTokens []*EmailVerificationToken
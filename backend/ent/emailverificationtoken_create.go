@@ -0,0 +1,818 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/emailverificationtoken"
+	"sthl/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EmailVerificationTokenCreate is the builder for creating a EmailVerificationToken entity.
+type EmailVerificationTokenCreate struct {
+	config
+	mutation *EmailVerificationTokenMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (evtc *EmailVerificationTokenCreate) SetCreatedAt(t time.Time) *EmailVerificationTokenCreate {
+	evtc.mutation.SetCreatedAt(t)
+	return evtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (evtc *EmailVerificationTokenCreate) SetNillableCreatedAt(t *time.Time) *EmailVerificationTokenCreate {
+	if t != nil {
+		evtc.SetCreatedAt(*t)
+	}
+	return evtc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (evtc *EmailVerificationTokenCreate) SetUpdatedAt(t time.Time) *EmailVerificationTokenCreate {
+	evtc.mutation.SetUpdatedAt(t)
+	return evtc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (evtc *EmailVerificationTokenCreate) SetNillableUpdatedAt(t *time.Time) *EmailVerificationTokenCreate {
+	if t != nil {
+		evtc.SetUpdatedAt(*t)
+	}
+	return evtc
+}
+
+// SetUserID sets the "user_id" field.
+func (evtc *EmailVerificationTokenCreate) SetUserID(u uuid.UUID) *EmailVerificationTokenCreate {
+	evtc.mutation.SetUserID(u)
+	return evtc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (evtc *EmailVerificationTokenCreate) SetTokenHash(s string) *EmailVerificationTokenCreate {
+	evtc.mutation.SetTokenHash(s)
+	return evtc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (evtc *EmailVerificationTokenCreate) SetExpiresAt(t time.Time) *EmailVerificationTokenCreate {
+	evtc.mutation.SetExpiresAt(t)
+	return evtc
+}
+
+// SetUsedAt sets the "used_at" field.
+func (evtc *EmailVerificationTokenCreate) SetUsedAt(t time.Time) *EmailVerificationTokenCreate {
+	evtc.mutation.SetUsedAt(t)
+	return evtc
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (evtc *EmailVerificationTokenCreate) SetNillableUsedAt(t *time.Time) *EmailVerificationTokenCreate {
+	if t != nil {
+		evtc.SetUsedAt(*t)
+	}
+	return evtc
+}
+
+// SetID sets the "id" field.
+func (evtc *EmailVerificationTokenCreate) SetID(u uuid.UUID) *EmailVerificationTokenCreate {
+	evtc.mutation.SetID(u)
+	return evtc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (evtc *EmailVerificationTokenCreate) SetNillableID(u *uuid.UUID) *EmailVerificationTokenCreate {
+	if u != nil {
+		evtc.SetID(*u)
+	}
+	return evtc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (evtc *EmailVerificationTokenCreate) SetOwnerID(id uuid.UUID) *EmailVerificationTokenCreate {
+	evtc.mutation.SetOwnerID(id)
+	return evtc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (evtc *EmailVerificationTokenCreate) SetOwner(u *User) *EmailVerificationTokenCreate {
+	return evtc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the EmailVerificationTokenMutation object of the builder.
+func (evtc *EmailVerificationTokenCreate) Mutation() *EmailVerificationTokenMutation {
+	return evtc.mutation
+}
+
+// Save creates the EmailVerificationToken in the database.
+func (evtc *EmailVerificationTokenCreate) Save(ctx context.Context) (*EmailVerificationToken, error) {
+	evtc.defaults()
+	return withHooks[*EmailVerificationToken, EmailVerificationTokenMutation](ctx, evtc.sqlSave, evtc.mutation, evtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (evtc *EmailVerificationTokenCreate) SaveX(ctx context.Context) *EmailVerificationToken {
+	v, err := evtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (evtc *EmailVerificationTokenCreate) Exec(ctx context.Context) error {
+	_, err := evtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evtc *EmailVerificationTokenCreate) ExecX(ctx context.Context) {
+	if err := evtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (evtc *EmailVerificationTokenCreate) defaults() {
+	if _, ok := evtc.mutation.CreatedAt(); !ok {
+		v := emailverificationtoken.DefaultCreatedAt()
+		evtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := evtc.mutation.UpdatedAt(); !ok {
+		v := emailverificationtoken.DefaultUpdatedAt()
+		evtc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := evtc.mutation.ID(); !ok {
+		v := emailverificationtoken.DefaultID()
+		evtc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (evtc *EmailVerificationTokenCreate) check() error {
+	if _, ok := evtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailVerificationToken.created_at"`)}
+	}
+	if _, ok := evtc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EmailVerificationToken.updated_at"`)}
+	}
+	if _, ok := evtc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "EmailVerificationToken.user_id"`)}
+	}
+	if _, ok := evtc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "EmailVerificationToken.token_hash"`)}
+	}
+	if v, ok := evtc.mutation.TokenHash(); ok {
+		if err := emailverificationtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := evtc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "EmailVerificationToken.expires_at"`)}
+	}
+	if _, ok := evtc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "EmailVerificationToken.owner"`)}
+	}
+	return nil
+}
+
+func (evtc *EmailVerificationTokenCreate) sqlSave(ctx context.Context) (*EmailVerificationToken, error) {
+	if err := evtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := evtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, evtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	evtc.mutation.id = &_node.ID
+	evtc.mutation.done = true
+	return _node, nil
+}
+
+func (evtc *EmailVerificationTokenCreate) createSpec() (*EmailVerificationToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailVerificationToken{config: evtc.config}
+		_spec = sqlgraph.NewCreateSpec(emailverificationtoken.Table, sqlgraph.NewFieldSpec(emailverificationtoken.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = evtc.conflict
+	if id, ok := evtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := evtc.mutation.CreatedAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := evtc.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := evtc.mutation.TokenHash(); ok {
+		_spec.SetField(emailverificationtoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := evtc.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := evtc.mutation.UsedAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if nodes := evtc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverificationtoken.OwnerTable,
+			Columns: []string{emailverificationtoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailVerificationToken.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailVerificationTokenUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (evtc *EmailVerificationTokenCreate) OnConflict(opts ...sql.ConflictOption) *EmailVerificationTokenUpsertOne {
+	evtc.conflict = opts
+	return &EmailVerificationTokenUpsertOne{
+		create: evtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailVerificationToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (evtc *EmailVerificationTokenCreate) OnConflictColumns(columns ...string) *EmailVerificationTokenUpsertOne {
+	evtc.conflict = append(evtc.conflict, sql.ConflictColumns(columns...))
+	return &EmailVerificationTokenUpsertOne{
+		create: evtc,
+	}
+}
+
+type (
+	// EmailVerificationTokenUpsertOne is the builder for "upsert"-ing
+	//  one EmailVerificationToken node.
+	EmailVerificationTokenUpsertOne struct {
+		create *EmailVerificationTokenCreate
+	}
+
+	// EmailVerificationTokenUpsert is the "OnConflict" setter.
+	EmailVerificationTokenUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailVerificationTokenUpsert) SetUpdatedAt(v time.Time) *EmailVerificationTokenUpsert {
+	u.Set(emailverificationtoken.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsert) UpdateUpdatedAt() *EmailVerificationTokenUpsert {
+	u.SetExcluded(emailverificationtoken.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmailVerificationTokenUpsert) SetUserID(v uuid.UUID) *EmailVerificationTokenUpsert {
+	u.Set(emailverificationtoken.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsert) UpdateUserID() *EmailVerificationTokenUpsert {
+	u.SetExcluded(emailverificationtoken.FieldUserID)
+	return u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *EmailVerificationTokenUpsert) SetTokenHash(v string) *EmailVerificationTokenUpsert {
+	u.Set(emailverificationtoken.FieldTokenHash, v)
+	return u
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsert) UpdateTokenHash() *EmailVerificationTokenUpsert {
+	u.SetExcluded(emailverificationtoken.FieldTokenHash)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *EmailVerificationTokenUpsert) SetExpiresAt(v time.Time) *EmailVerificationTokenUpsert {
+	u.Set(emailverificationtoken.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsert) UpdateExpiresAt() *EmailVerificationTokenUpsert {
+	u.SetExcluded(emailverificationtoken.FieldExpiresAt)
+	return u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *EmailVerificationTokenUpsert) SetUsedAt(v time.Time) *EmailVerificationTokenUpsert {
+	u.Set(emailverificationtoken.FieldUsedAt, v)
+	return u
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsert) UpdateUsedAt() *EmailVerificationTokenUpsert {
+	u.SetExcluded(emailverificationtoken.FieldUsedAt)
+	return u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *EmailVerificationTokenUpsert) ClearUsedAt() *EmailVerificationTokenUpsert {
+	u.SetNull(emailverificationtoken.FieldUsedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmailVerificationToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailverificationtoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailVerificationTokenUpsertOne) UpdateNewValues() *EmailVerificationTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(emailverificationtoken.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(emailverificationtoken.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailVerificationToken.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmailVerificationTokenUpsertOne) Ignore() *EmailVerificationTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailVerificationTokenUpsertOne) DoNothing() *EmailVerificationTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailVerificationTokenCreate.OnConflict
+// documentation for more info.
+func (u *EmailVerificationTokenUpsertOne) Update(set func(*EmailVerificationTokenUpsert)) *EmailVerificationTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailVerificationTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailVerificationTokenUpsertOne) SetUpdatedAt(v time.Time) *EmailVerificationTokenUpsertOne {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsertOne) UpdateUpdatedAt() *EmailVerificationTokenUpsertOne {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmailVerificationTokenUpsertOne) SetUserID(v uuid.UUID) *EmailVerificationTokenUpsertOne {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsertOne) UpdateUserID() *EmailVerificationTokenUpsertOne {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *EmailVerificationTokenUpsertOne) SetTokenHash(v string) *EmailVerificationTokenUpsertOne {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsertOne) UpdateTokenHash() *EmailVerificationTokenUpsertOne {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *EmailVerificationTokenUpsertOne) SetExpiresAt(v time.Time) *EmailVerificationTokenUpsertOne {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsertOne) UpdateExpiresAt() *EmailVerificationTokenUpsertOne {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *EmailVerificationTokenUpsertOne) SetUsedAt(v time.Time) *EmailVerificationTokenUpsertOne {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsertOne) UpdateUsedAt() *EmailVerificationTokenUpsertOne {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *EmailVerificationTokenUpsertOne) ClearUsedAt() *EmailVerificationTokenUpsertOne {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailVerificationTokenUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailVerificationTokenCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailVerificationTokenUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmailVerificationTokenUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmailVerificationTokenUpsertOne.ID is not supported by MySQL driver. Use EmailVerificationTokenUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmailVerificationTokenUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EmailVerificationTokenCreateBulk is the builder for creating many EmailVerificationToken entities in bulk.
+type EmailVerificationTokenCreateBulk struct {
+	config
+	builders []*EmailVerificationTokenCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EmailVerificationToken entities in the database.
+func (evtcb *EmailVerificationTokenCreateBulk) Save(ctx context.Context) ([]*EmailVerificationToken, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(evtcb.builders))
+	nodes := make([]*EmailVerificationToken, len(evtcb.builders))
+	mutators := make([]Mutator, len(evtcb.builders))
+	for i := range evtcb.builders {
+		func(i int, root context.Context) {
+			builder := evtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailVerificationTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, evtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = evtcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, evtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, evtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evtcb *EmailVerificationTokenCreateBulk) SaveX(ctx context.Context) []*EmailVerificationToken {
+	v, err := evtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (evtcb *EmailVerificationTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := evtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evtcb *EmailVerificationTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := evtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailVerificationToken.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailVerificationTokenUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (evtcb *EmailVerificationTokenCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmailVerificationTokenUpsertBulk {
+	evtcb.conflict = opts
+	return &EmailVerificationTokenUpsertBulk{
+		create: evtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailVerificationToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (evtcb *EmailVerificationTokenCreateBulk) OnConflictColumns(columns ...string) *EmailVerificationTokenUpsertBulk {
+	evtcb.conflict = append(evtcb.conflict, sql.ConflictColumns(columns...))
+	return &EmailVerificationTokenUpsertBulk{
+		create: evtcb,
+	}
+}
+
+// EmailVerificationTokenUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmailVerificationToken nodes.
+type EmailVerificationTokenUpsertBulk struct {
+	create *EmailVerificationTokenCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmailVerificationToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailverificationtoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailVerificationTokenUpsertBulk) UpdateNewValues() *EmailVerificationTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(emailverificationtoken.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(emailverificationtoken.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailVerificationToken.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmailVerificationTokenUpsertBulk) Ignore() *EmailVerificationTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailVerificationTokenUpsertBulk) DoNothing() *EmailVerificationTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailVerificationTokenCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmailVerificationTokenUpsertBulk) Update(set func(*EmailVerificationTokenUpsert)) *EmailVerificationTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailVerificationTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EmailVerificationTokenUpsertBulk) SetUpdatedAt(v time.Time) *EmailVerificationTokenUpsertBulk {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsertBulk) UpdateUpdatedAt() *EmailVerificationTokenUpsertBulk {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmailVerificationTokenUpsertBulk) SetUserID(v uuid.UUID) *EmailVerificationTokenUpsertBulk {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsertBulk) UpdateUserID() *EmailVerificationTokenUpsertBulk {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *EmailVerificationTokenUpsertBulk) SetTokenHash(v string) *EmailVerificationTokenUpsertBulk {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsertBulk) UpdateTokenHash() *EmailVerificationTokenUpsertBulk {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *EmailVerificationTokenUpsertBulk) SetExpiresAt(v time.Time) *EmailVerificationTokenUpsertBulk {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsertBulk) UpdateExpiresAt() *EmailVerificationTokenUpsertBulk {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *EmailVerificationTokenUpsertBulk) SetUsedAt(v time.Time) *EmailVerificationTokenUpsertBulk {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *EmailVerificationTokenUpsertBulk) UpdateUsedAt() *EmailVerificationTokenUpsertBulk {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *EmailVerificationTokenUpsertBulk) ClearUsedAt() *EmailVerificationTokenUpsertBulk {
+	return u.Update(func(s *EmailVerificationTokenUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailVerificationTokenUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmailVerificationTokenCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailVerificationTokenCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailVerificationTokenUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/emailverificationtoken"
+	"sthl/ent/predicate"
+	"sthl/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EmailVerificationTokenUpdate is the builder for updating EmailVerificationToken entities.
+type EmailVerificationTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailVerificationTokenMutation
+}
+
+// Where appends a list predicates to the EmailVerificationTokenUpdate builder.
+func (evtu *EmailVerificationTokenUpdate) Where(ps ...predicate.EmailVerificationToken) *EmailVerificationTokenUpdate {
+	evtu.mutation.Where(ps...)
+	return evtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (evtu *EmailVerificationTokenUpdate) SetUpdatedAt(t time.Time) *EmailVerificationTokenUpdate {
+	evtu.mutation.SetUpdatedAt(t)
+	return evtu
+}
+
+// SetUserID sets the "user_id" field.
+func (evtu *EmailVerificationTokenUpdate) SetUserID(u uuid.UUID) *EmailVerificationTokenUpdate {
+	evtu.mutation.SetUserID(u)
+	return evtu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (evtu *EmailVerificationTokenUpdate) SetTokenHash(s string) *EmailVerificationTokenUpdate {
+	evtu.mutation.SetTokenHash(s)
+	return evtu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (evtu *EmailVerificationTokenUpdate) SetExpiresAt(t time.Time) *EmailVerificationTokenUpdate {
+	evtu.mutation.SetExpiresAt(t)
+	return evtu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (evtu *EmailVerificationTokenUpdate) SetUsedAt(t time.Time) *EmailVerificationTokenUpdate {
+	evtu.mutation.SetUsedAt(t)
+	return evtu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (evtu *EmailVerificationTokenUpdate) SetNillableUsedAt(t *time.Time) *EmailVerificationTokenUpdate {
+	if t != nil {
+		evtu.SetUsedAt(*t)
+	}
+	return evtu
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (evtu *EmailVerificationTokenUpdate) ClearUsedAt() *EmailVerificationTokenUpdate {
+	evtu.mutation.ClearUsedAt()
+	return evtu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (evtu *EmailVerificationTokenUpdate) SetOwnerID(id uuid.UUID) *EmailVerificationTokenUpdate {
+	evtu.mutation.SetOwnerID(id)
+	return evtu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (evtu *EmailVerificationTokenUpdate) SetOwner(u *User) *EmailVerificationTokenUpdate {
+	return evtu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the EmailVerificationTokenMutation object of the builder.
+func (evtu *EmailVerificationTokenUpdate) Mutation() *EmailVerificationTokenMutation {
+	return evtu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (evtu *EmailVerificationTokenUpdate) ClearOwner() *EmailVerificationTokenUpdate {
+	evtu.mutation.ClearOwner()
+	return evtu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (evtu *EmailVerificationTokenUpdate) Save(ctx context.Context) (int, error) {
+	evtu.defaults()
+	return withHooks[int, EmailVerificationTokenMutation](ctx, evtu.sqlSave, evtu.mutation, evtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evtu *EmailVerificationTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := evtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (evtu *EmailVerificationTokenUpdate) Exec(ctx context.Context) error {
+	_, err := evtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evtu *EmailVerificationTokenUpdate) ExecX(ctx context.Context) {
+	if err := evtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (evtu *EmailVerificationTokenUpdate) defaults() {
+	if _, ok := evtu.mutation.UpdatedAt(); !ok {
+		v := emailverificationtoken.UpdateDefaultUpdatedAt()
+		evtu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (evtu *EmailVerificationTokenUpdate) check() error {
+	if v, ok := evtu.mutation.TokenHash(); ok {
+		if err := emailverificationtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := evtu.mutation.OwnerID(); evtu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "EmailVerificationToken.owner"`)
+	}
+	return nil
+}
+
+func (evtu *EmailVerificationTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := evtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailverificationtoken.Table, emailverificationtoken.Columns, sqlgraph.NewFieldSpec(emailverificationtoken.FieldID, field.TypeUUID))
+	if ps := evtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := evtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := evtu.mutation.TokenHash(); ok {
+		_spec.SetField(emailverificationtoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := evtu.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := evtu.mutation.UsedAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if evtu.mutation.UsedAtCleared() {
+		_spec.ClearField(emailverificationtoken.FieldUsedAt, field.TypeTime)
+	}
+	if evtu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverificationtoken.OwnerTable,
+			Columns: []string{emailverificationtoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := evtu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverificationtoken.OwnerTable,
+			Columns: []string{emailverificationtoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, evtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverificationtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	evtu.mutation.done = true
+	return n, nil
+}
+
+// EmailVerificationTokenUpdateOne is the builder for updating a single EmailVerificationToken entity.
+type EmailVerificationTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailVerificationTokenMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (evtuo *EmailVerificationTokenUpdateOne) SetUpdatedAt(t time.Time) *EmailVerificationTokenUpdateOne {
+	evtuo.mutation.SetUpdatedAt(t)
+	return evtuo
+}
+
+// SetUserID sets the "user_id" field.
+func (evtuo *EmailVerificationTokenUpdateOne) SetUserID(u uuid.UUID) *EmailVerificationTokenUpdateOne {
+	evtuo.mutation.SetUserID(u)
+	return evtuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (evtuo *EmailVerificationTokenUpdateOne) SetTokenHash(s string) *EmailVerificationTokenUpdateOne {
+	evtuo.mutation.SetTokenHash(s)
+	return evtuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (evtuo *EmailVerificationTokenUpdateOne) SetExpiresAt(t time.Time) *EmailVerificationTokenUpdateOne {
+	evtuo.mutation.SetExpiresAt(t)
+	return evtuo
+}
+
+// SetUsedAt sets the "used_at" field.
+func (evtuo *EmailVerificationTokenUpdateOne) SetUsedAt(t time.Time) *EmailVerificationTokenUpdateOne {
+	evtuo.mutation.SetUsedAt(t)
+	return evtuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (evtuo *EmailVerificationTokenUpdateOne) SetNillableUsedAt(t *time.Time) *EmailVerificationTokenUpdateOne {
+	if t != nil {
+		evtuo.SetUsedAt(*t)
+	}
+	return evtuo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (evtuo *EmailVerificationTokenUpdateOne) ClearUsedAt() *EmailVerificationTokenUpdateOne {
+	evtuo.mutation.ClearUsedAt()
+	return evtuo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (evtuo *EmailVerificationTokenUpdateOne) SetOwnerID(id uuid.UUID) *EmailVerificationTokenUpdateOne {
+	evtuo.mutation.SetOwnerID(id)
+	return evtuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (evtuo *EmailVerificationTokenUpdateOne) SetOwner(u *User) *EmailVerificationTokenUpdateOne {
+	return evtuo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the EmailVerificationTokenMutation object of the builder.
+func (evtuo *EmailVerificationTokenUpdateOne) Mutation() *EmailVerificationTokenMutation {
+	return evtuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (evtuo *EmailVerificationTokenUpdateOne) ClearOwner() *EmailVerificationTokenUpdateOne {
+	evtuo.mutation.ClearOwner()
+	return evtuo
+}
+
+// Where appends a list predicates to the EmailVerificationTokenUpdate builder.
+func (evtuo *EmailVerificationTokenUpdateOne) Where(ps ...predicate.EmailVerificationToken) *EmailVerificationTokenUpdateOne {
+	evtuo.mutation.Where(ps...)
+	return evtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (evtuo *EmailVerificationTokenUpdateOne) Select(field string, fields ...string) *EmailVerificationTokenUpdateOne {
+	evtuo.fields = append([]string{field}, fields...)
+	return evtuo
+}
+
+// Save executes the query and returns the updated EmailVerificationToken entity.
+func (evtuo *EmailVerificationTokenUpdateOne) Save(ctx context.Context) (*EmailVerificationToken, error) {
+	evtuo.defaults()
+	return withHooks[*EmailVerificationToken, EmailVerificationTokenMutation](ctx, evtuo.sqlSave, evtuo.mutation, evtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (evtuo *EmailVerificationTokenUpdateOne) SaveX(ctx context.Context) *EmailVerificationToken {
+	node, err := evtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (evtuo *EmailVerificationTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := evtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (evtuo *EmailVerificationTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := evtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (evtuo *EmailVerificationTokenUpdateOne) defaults() {
+	if _, ok := evtuo.mutation.UpdatedAt(); !ok {
+		v := emailverificationtoken.UpdateDefaultUpdatedAt()
+		evtuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (evtuo *EmailVerificationTokenUpdateOne) check() error {
+	if v, ok := evtuo.mutation.TokenHash(); ok {
+		if err := emailverificationtoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := evtuo.mutation.OwnerID(); evtuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "EmailVerificationToken.owner"`)
+	}
+	return nil
+}
+
+func (evtuo *EmailVerificationTokenUpdateOne) sqlSave(ctx context.Context) (_node *EmailVerificationToken, err error) {
+	if err := evtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailverificationtoken.Table, emailverificationtoken.Columns, sqlgraph.NewFieldSpec(emailverificationtoken.FieldID, field.TypeUUID))
+	id, ok := evtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailVerificationToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := evtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailverificationtoken.FieldID)
+		for _, f := range fields {
+			if !emailverificationtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailverificationtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := evtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := evtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := evtuo.mutation.TokenHash(); ok {
+		_spec.SetField(emailverificationtoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := evtuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := evtuo.mutation.UsedAt(); ok {
+		_spec.SetField(emailverificationtoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if evtuo.mutation.UsedAtCleared() {
+		_spec.ClearField(emailverificationtoken.FieldUsedAt, field.TypeTime)
+	}
+	if evtuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverificationtoken.OwnerTable,
+			Columns: []string{emailverificationtoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := evtuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverificationtoken.OwnerTable,
+			Columns: []string{emailverificationtoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EmailVerificationToken{config: evtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, evtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailverificationtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	evtuo.mutation.done = true
+	return _node, nil
+}
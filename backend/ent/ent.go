@@ -7,12 +7,30 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sthl/ent/apikey"
+	"sthl/ent/category"
+	"sthl/ent/collection"
+	"sthl/ent/emailverificationtoken"
+	"sthl/ent/idempotencykey"
 	"sthl/ent/imageinfo"
+	"sthl/ent/inventorymovement"
+	"sthl/ent/loginlockoutevent"
+	"sthl/ent/loginthrottle"
+	"sthl/ent/mfarecoverycode"
 	"sthl/ent/order"
+	"sthl/ent/orderevent"
 	"sthl/ent/orderitem"
+	"sthl/ent/passwordresettoken"
 	"sthl/ent/product"
+	"sthl/ent/productmedia"
+	"sthl/ent/productvariant"
+	"sthl/ent/refreshtoken"
+	"sthl/ent/shop"
+	"sthl/ent/shopinvite"
+	"sthl/ent/shopmember"
 	"sthl/ent/siteui"
 	"sthl/ent/user"
+	"sthl/ent/usertotp"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -44,12 +62,30 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		imageinfo.Table: imageinfo.ValidColumn,
-		order.Table:     order.ValidColumn,
-		orderitem.Table: orderitem.ValidColumn,
-		product.Table:   product.ValidColumn,
-		siteui.Table:    siteui.ValidColumn,
-		user.Table:      user.ValidColumn,
+		apikey.Table:                 apikey.ValidColumn,
+		category.Table:               category.ValidColumn,
+		collection.Table:             collection.ValidColumn,
+		emailverificationtoken.Table: emailverificationtoken.ValidColumn,
+		idempotencykey.Table:         idempotencykey.ValidColumn,
+		imageinfo.Table:              imageinfo.ValidColumn,
+		inventorymovement.Table:      inventorymovement.ValidColumn,
+		loginlockoutevent.Table:      loginlockoutevent.ValidColumn,
+		loginthrottle.Table:          loginthrottle.ValidColumn,
+		mfarecoverycode.Table:        mfarecoverycode.ValidColumn,
+		order.Table:                  order.ValidColumn,
+		orderevent.Table:             orderevent.ValidColumn,
+		orderitem.Table:              orderitem.ValidColumn,
+		passwordresettoken.Table:     passwordresettoken.ValidColumn,
+		product.Table:                product.ValidColumn,
+		productmedia.Table:           productmedia.ValidColumn,
+		productvariant.Table:         productvariant.ValidColumn,
+		refreshtoken.Table:           refreshtoken.ValidColumn,
+		shop.Table:                   shop.ValidColumn,
+		shopinvite.Table:             shopinvite.ValidColumn,
+		shopmember.Table:             shopmember.ValidColumn,
+		siteui.Table:                 siteui.ValidColumn,
+		user.Table:                   user.ValidColumn,
+		usertotp.Table:               usertotp.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
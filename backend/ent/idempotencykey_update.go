@@ -0,0 +1,587 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/idempotencykey"
+	"sthl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKeyUpdate is the builder for updating IdempotencyKey entities.
+type IdempotencyKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// Where appends a list predicates to the IdempotencyKeyUpdate builder.
+func (iku *IdempotencyKeyUpdate) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdate {
+	iku.mutation.Where(ps...)
+	return iku
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iku *IdempotencyKeyUpdate) SetUpdatedAt(t time.Time) *IdempotencyKeyUpdate {
+	iku.mutation.SetUpdatedAt(t)
+	return iku
+}
+
+// SetScope sets the "scope" field.
+func (iku *IdempotencyKeyUpdate) SetScope(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetScope(s)
+	return iku
+}
+
+// SetKey sets the "key" field.
+func (iku *IdempotencyKeyUpdate) SetKey(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetKey(s)
+	return iku
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (iku *IdempotencyKeyUpdate) SetFingerprint(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetFingerprint(s)
+	return iku
+}
+
+// SetStatusCode sets the "status_code" field.
+func (iku *IdempotencyKeyUpdate) SetStatusCode(i int) *IdempotencyKeyUpdate {
+	iku.mutation.ResetStatusCode()
+	iku.mutation.SetStatusCode(i)
+	return iku
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableStatusCode(i *int) *IdempotencyKeyUpdate {
+	if i != nil {
+		iku.SetStatusCode(*i)
+	}
+	return iku
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (iku *IdempotencyKeyUpdate) AddStatusCode(i int) *IdempotencyKeyUpdate {
+	iku.mutation.AddStatusCode(i)
+	return iku
+}
+
+// SetResponseBody sets the "response_body" field.
+func (iku *IdempotencyKeyUpdate) SetResponseBody(b []byte) *IdempotencyKeyUpdate {
+	iku.mutation.SetResponseBody(b)
+	return iku
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (iku *IdempotencyKeyUpdate) ClearResponseBody() *IdempotencyKeyUpdate {
+	iku.mutation.ClearResponseBody()
+	return iku
+}
+
+// SetEtag sets the "etag" field.
+func (iku *IdempotencyKeyUpdate) SetEtag(s string) *IdempotencyKeyUpdate {
+	iku.mutation.SetEtag(s)
+	return iku
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableEtag(s *string) *IdempotencyKeyUpdate {
+	if s != nil {
+		iku.SetEtag(*s)
+	}
+	return iku
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (iku *IdempotencyKeyUpdate) ClearEtag() *IdempotencyKeyUpdate {
+	iku.mutation.ClearEtag()
+	return iku
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iku *IdempotencyKeyUpdate) SetExpiresAt(t time.Time) *IdempotencyKeyUpdate {
+	iku.mutation.SetExpiresAt(t)
+	return iku
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (iku *IdempotencyKeyUpdate) SetLeaseExpiresAt(t time.Time) *IdempotencyKeyUpdate {
+	iku.mutation.SetLeaseExpiresAt(t)
+	return iku
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableLeaseExpiresAt(t *time.Time) *IdempotencyKeyUpdate {
+	if t != nil {
+		iku.SetLeaseExpiresAt(*t)
+	}
+	return iku
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (iku *IdempotencyKeyUpdate) ClearLeaseExpiresAt() *IdempotencyKeyUpdate {
+	iku.mutation.ClearLeaseExpiresAt()
+	return iku
+}
+
+// SetClaimToken sets the "claim_token" field.
+func (iku *IdempotencyKeyUpdate) SetClaimToken(u uuid.UUID) *IdempotencyKeyUpdate {
+	iku.mutation.SetClaimToken(u)
+	return iku
+}
+
+// SetNillableClaimToken sets the "claim_token" field if the given value is not nil.
+func (iku *IdempotencyKeyUpdate) SetNillableClaimToken(u *uuid.UUID) *IdempotencyKeyUpdate {
+	if u != nil {
+		iku.SetClaimToken(*u)
+	}
+	return iku
+}
+
+// ClearClaimToken clears the value of the "claim_token" field.
+func (iku *IdempotencyKeyUpdate) ClearClaimToken() *IdempotencyKeyUpdate {
+	iku.mutation.ClearClaimToken()
+	return iku
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (iku *IdempotencyKeyUpdate) Mutation() *IdempotencyKeyMutation {
+	return iku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iku *IdempotencyKeyUpdate) Save(ctx context.Context) (int, error) {
+	iku.defaults()
+	return withHooks[int, IdempotencyKeyMutation](ctx, iku.sqlSave, iku.mutation, iku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := iku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iku *IdempotencyKeyUpdate) Exec(ctx context.Context) error {
+	_, err := iku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iku *IdempotencyKeyUpdate) ExecX(ctx context.Context) {
+	if err := iku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iku *IdempotencyKeyUpdate) defaults() {
+	if _, ok := iku.mutation.UpdatedAt(); !ok {
+		v := idempotencykey.UpdateDefaultUpdatedAt()
+		iku.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iku *IdempotencyKeyUpdate) check() error {
+	if v, ok := iku.mutation.Scope(); ok {
+		if err := idempotencykey.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.scope": %w`, err)}
+		}
+	}
+	if v, ok := iku.mutation.Key(); ok {
+		if err := idempotencykey.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.key": %w`, err)}
+		}
+	}
+	if v, ok := iku.mutation.Fingerprint(); ok {
+		if err := idempotencykey.FingerprintValidator(v); err != nil {
+			return &ValidationError{Name: "fingerprint", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.fingerprint": %w`, err)}
+		}
+	}
+	if v, ok := iku.mutation.Etag(); ok {
+		if err := idempotencykey.EtagValidator(v); err != nil {
+			return &ValidationError{Name: "etag", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.etag": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iku *IdempotencyKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(idempotencykey.Table, idempotencykey.Columns, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID))
+	if ps := iku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iku.mutation.UpdatedAt(); ok {
+		_spec.SetField(idempotencykey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := iku.mutation.Scope(); ok {
+		_spec.SetField(idempotencykey.FieldScope, field.TypeString, value)
+	}
+	if value, ok := iku.mutation.Key(); ok {
+		_spec.SetField(idempotencykey.FieldKey, field.TypeString, value)
+	}
+	if value, ok := iku.mutation.Fingerprint(); ok {
+		_spec.SetField(idempotencykey.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := iku.mutation.StatusCode(); ok {
+		_spec.SetField(idempotencykey.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := iku.mutation.AddedStatusCode(); ok {
+		_spec.AddField(idempotencykey.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := iku.mutation.ResponseBody(); ok {
+		_spec.SetField(idempotencykey.FieldResponseBody, field.TypeBytes, value)
+	}
+	if iku.mutation.ResponseBodyCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseBody, field.TypeBytes)
+	}
+	if value, ok := iku.mutation.Etag(); ok {
+		_spec.SetField(idempotencykey.FieldEtag, field.TypeString, value)
+	}
+	if iku.mutation.EtagCleared() {
+		_spec.ClearField(idempotencykey.FieldEtag, field.TypeString)
+	}
+	if value, ok := iku.mutation.ExpiresAt(); ok {
+		_spec.SetField(idempotencykey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := iku.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(idempotencykey.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if iku.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(idempotencykey.FieldLeaseExpiresAt, field.TypeTime)
+	}
+	if value, ok := iku.mutation.ClaimToken(); ok {
+		_spec.SetField(idempotencykey.FieldClaimToken, field.TypeUUID, value)
+	}
+	if iku.mutation.ClaimTokenCleared() {
+		_spec.ClearField(idempotencykey.FieldClaimToken, field.TypeUUID)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iku.mutation.done = true
+	return n, nil
+}
+
+// IdempotencyKeyUpdateOne is the builder for updating a single IdempotencyKey entity.
+type IdempotencyKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IdempotencyKeyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetUpdatedAt(t time.Time) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetUpdatedAt(t)
+	return ikuo
+}
+
+// SetScope sets the "scope" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetScope(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetScope(s)
+	return ikuo
+}
+
+// SetKey sets the "key" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetKey(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetKey(s)
+	return ikuo
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetFingerprint(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetFingerprint(s)
+	return ikuo
+}
+
+// SetStatusCode sets the "status_code" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetStatusCode(i int) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ResetStatusCode()
+	ikuo.mutation.SetStatusCode(i)
+	return ikuo
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableStatusCode(i *int) *IdempotencyKeyUpdateOne {
+	if i != nil {
+		ikuo.SetStatusCode(*i)
+	}
+	return ikuo
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (ikuo *IdempotencyKeyUpdateOne) AddStatusCode(i int) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.AddStatusCode(i)
+	return ikuo
+}
+
+// SetResponseBody sets the "response_body" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetResponseBody(b []byte) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetResponseBody(b)
+	return ikuo
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearResponseBody() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearResponseBody()
+	return ikuo
+}
+
+// SetEtag sets the "etag" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetEtag(s string) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetEtag(s)
+	return ikuo
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableEtag(s *string) *IdempotencyKeyUpdateOne {
+	if s != nil {
+		ikuo.SetEtag(*s)
+	}
+	return ikuo
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearEtag() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearEtag()
+	return ikuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetExpiresAt(t time.Time) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetExpiresAt(t)
+	return ikuo
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetLeaseExpiresAt(t time.Time) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetLeaseExpiresAt(t)
+	return ikuo
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableLeaseExpiresAt(t *time.Time) *IdempotencyKeyUpdateOne {
+	if t != nil {
+		ikuo.SetLeaseExpiresAt(*t)
+	}
+	return ikuo
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearLeaseExpiresAt() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearLeaseExpiresAt()
+	return ikuo
+}
+
+// SetClaimToken sets the "claim_token" field.
+func (ikuo *IdempotencyKeyUpdateOne) SetClaimToken(u uuid.UUID) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.SetClaimToken(u)
+	return ikuo
+}
+
+// SetNillableClaimToken sets the "claim_token" field if the given value is not nil.
+func (ikuo *IdempotencyKeyUpdateOne) SetNillableClaimToken(u *uuid.UUID) *IdempotencyKeyUpdateOne {
+	if u != nil {
+		ikuo.SetClaimToken(*u)
+	}
+	return ikuo
+}
+
+// ClearClaimToken clears the value of the "claim_token" field.
+func (ikuo *IdempotencyKeyUpdateOne) ClearClaimToken() *IdempotencyKeyUpdateOne {
+	ikuo.mutation.ClearClaimToken()
+	return ikuo
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (ikuo *IdempotencyKeyUpdateOne) Mutation() *IdempotencyKeyMutation {
+	return ikuo.mutation
+}
+
+// Where appends a list predicates to the IdempotencyKeyUpdate builder.
+func (ikuo *IdempotencyKeyUpdateOne) Where(ps ...predicate.IdempotencyKey) *IdempotencyKeyUpdateOne {
+	ikuo.mutation.Where(ps...)
+	return ikuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ikuo *IdempotencyKeyUpdateOne) Select(field string, fields ...string) *IdempotencyKeyUpdateOne {
+	ikuo.fields = append([]string{field}, fields...)
+	return ikuo
+}
+
+// Save executes the query and returns the updated IdempotencyKey entity.
+func (ikuo *IdempotencyKeyUpdateOne) Save(ctx context.Context) (*IdempotencyKey, error) {
+	ikuo.defaults()
+	return withHooks[*IdempotencyKey, IdempotencyKeyMutation](ctx, ikuo.sqlSave, ikuo.mutation, ikuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) SaveX(ctx context.Context) *IdempotencyKey {
+	node, err := ikuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ikuo *IdempotencyKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := ikuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikuo *IdempotencyKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := ikuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ikuo *IdempotencyKeyUpdateOne) defaults() {
+	if _, ok := ikuo.mutation.UpdatedAt(); !ok {
+		v := idempotencykey.UpdateDefaultUpdatedAt()
+		ikuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ikuo *IdempotencyKeyUpdateOne) check() error {
+	if v, ok := ikuo.mutation.Scope(); ok {
+		if err := idempotencykey.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.scope": %w`, err)}
+		}
+	}
+	if v, ok := ikuo.mutation.Key(); ok {
+		if err := idempotencykey.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.key": %w`, err)}
+		}
+	}
+	if v, ok := ikuo.mutation.Fingerprint(); ok {
+		if err := idempotencykey.FingerprintValidator(v); err != nil {
+			return &ValidationError{Name: "fingerprint", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.fingerprint": %w`, err)}
+		}
+	}
+	if v, ok := ikuo.mutation.Etag(); ok {
+		if err := idempotencykey.EtagValidator(v); err != nil {
+			return &ValidationError{Name: "etag", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.etag": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ikuo *IdempotencyKeyUpdateOne) sqlSave(ctx context.Context) (_node *IdempotencyKey, err error) {
+	if err := ikuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(idempotencykey.Table, idempotencykey.Columns, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID))
+	id, ok := ikuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IdempotencyKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ikuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, idempotencykey.FieldID)
+		for _, f := range fields {
+			if !idempotencykey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != idempotencykey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ikuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ikuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(idempotencykey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ikuo.mutation.Scope(); ok {
+		_spec.SetField(idempotencykey.FieldScope, field.TypeString, value)
+	}
+	if value, ok := ikuo.mutation.Key(); ok {
+		_spec.SetField(idempotencykey.FieldKey, field.TypeString, value)
+	}
+	if value, ok := ikuo.mutation.Fingerprint(); ok {
+		_spec.SetField(idempotencykey.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := ikuo.mutation.StatusCode(); ok {
+		_spec.SetField(idempotencykey.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := ikuo.mutation.AddedStatusCode(); ok {
+		_spec.AddField(idempotencykey.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := ikuo.mutation.ResponseBody(); ok {
+		_spec.SetField(idempotencykey.FieldResponseBody, field.TypeBytes, value)
+	}
+	if ikuo.mutation.ResponseBodyCleared() {
+		_spec.ClearField(idempotencykey.FieldResponseBody, field.TypeBytes)
+	}
+	if value, ok := ikuo.mutation.Etag(); ok {
+		_spec.SetField(idempotencykey.FieldEtag, field.TypeString, value)
+	}
+	if ikuo.mutation.EtagCleared() {
+		_spec.ClearField(idempotencykey.FieldEtag, field.TypeString)
+	}
+	if value, ok := ikuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(idempotencykey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := ikuo.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(idempotencykey.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if ikuo.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(idempotencykey.FieldLeaseExpiresAt, field.TypeTime)
+	}
+	if value, ok := ikuo.mutation.ClaimToken(); ok {
+		_spec.SetField(idempotencykey.FieldClaimToken, field.TypeUUID, value)
+	}
+	if ikuo.mutation.ClaimTokenCleared() {
+		_spec.ClearField(idempotencykey.FieldClaimToken, field.TypeUUID)
+	}
+	_node = &IdempotencyKey{config: ikuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ikuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotencykey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ikuo.mutation.done = true
+	return _node, nil
+}
@@ -41,9 +41,11 @@ type Imageinfo struct {
 type ImageinfoEdges struct {
 	// Owner holds the value of the owner edge.
 	Owner *User `json:"owner,omitempty"`
+	// Productmedia holds the value of the productmedia edge.
+	Productmedia []*ProductMedia `json:"productmedia,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -59,6 +61,15 @@ func (e ImageinfoEdges) OwnerOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "owner"}
 }
 
+// ProductmediaOrErr returns the Productmedia value or an error if the edge
+// was not loaded in eager-loading.
+func (e ImageinfoEdges) ProductmediaOrErr() ([]*ProductMedia, error) {
+	if e.loadedTypes[1] {
+		return e.Productmedia, nil
+	}
+	return nil, &NotLoadedError{edge: "productmedia"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Imageinfo) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -145,6 +156,11 @@ func (i *Imageinfo) QueryOwner() *UserQuery {
 	return NewImageinfoClient(i.config).QueryOwner(i)
 }
 
+// QueryProductmedia queries the "productmedia" edge of the Imageinfo entity.
+func (i *Imageinfo) QueryProductmedia() *ProductMediaQuery {
+	return NewImageinfoClient(i.config).QueryProductmedia(i)
+}
+
 // Update returns a builder for updating this Imageinfo.
 // Note that you need to call Imageinfo.Unwrap() before calling this method if this Imageinfo
 // was returned from a transaction, and the transaction was committed or rolled back.
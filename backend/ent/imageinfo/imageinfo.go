@@ -27,6 +27,8 @@ const (
 	FieldImgS3IDKey = "img_s3_id_key"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
+	// EdgeProductmedia holds the string denoting the productmedia edge name in mutations.
+	EdgeProductmedia = "productmedia"
 	// Table holds the table name of the imageinfo in the database.
 	Table = "imageinfos"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -36,6 +38,13 @@ const (
 	OwnerInverseTable = "users"
 	// OwnerColumn is the table column denoting the owner relation/edge.
 	OwnerColumn = "user_id"
+	// ProductmediaTable is the table that holds the productmedia relation/edge.
+	ProductmediaTable = "product_media"
+	// ProductmediaInverseTable is the table name for the ProductMedia entity.
+	// It exists in this package in order to avoid circular dependency with the "productmedia" package.
+	ProductmediaInverseTable = "product_media"
+	// ProductmediaColumn is the table column denoting the productmedia relation/edge.
+	ProductmediaColumn = "imageinfo_id"
 )
 
 // Columns holds all SQL columns for imageinfo fields.
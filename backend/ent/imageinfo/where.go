@@ -453,6 +453,33 @@ func HasOwnerWith(preds ...predicate.User) predicate.Imageinfo {
 	})
 }
 
+// HasProductmedia applies the HasEdge predicate on the "productmedia" edge.
+func HasProductmedia() predicate.Imageinfo {
+	return predicate.Imageinfo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ProductmediaTable, ProductmediaColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductmediaWith applies the HasEdge predicate on the "productmedia" edge with a given conditions (other predicates).
+func HasProductmediaWith(preds ...predicate.ProductMedia) predicate.Imageinfo {
+	return predicate.Imageinfo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ProductmediaInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ProductmediaTable, ProductmediaColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Imageinfo) predicate.Imageinfo {
 	return predicate.Imageinfo(func(s *sql.Selector) {
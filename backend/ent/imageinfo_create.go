@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"sthl/ent/imageinfo"
+	"sthl/ent/productmedia"
 	"sthl/ent/user"
 	"time"
 
@@ -93,6 +94,21 @@ func (ic *ImageinfoCreate) SetOwner(u *User) *ImageinfoCreate {
 	return ic.SetOwnerID(u.ID)
 }
 
+// AddProductmediumIDs adds the "productmedia" edge to the ProductMedia entity by IDs.
+func (ic *ImageinfoCreate) AddProductmediumIDs(ids ...uuid.UUID) *ImageinfoCreate {
+	ic.mutation.AddProductmediumIDs(ids...)
+	return ic
+}
+
+// AddProductmedia adds the "productmedia" edges to the ProductMedia entity.
+func (ic *ImageinfoCreate) AddProductmedia(p ...*ProductMedia) *ImageinfoCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ic.AddProductmediumIDs(ids...)
+}
+
 // Mutation returns the ImageinfoMutation object of the builder.
 func (ic *ImageinfoCreate) Mutation() *ImageinfoMutation {
 	return ic.mutation
@@ -255,6 +271,25 @@ func (ic *ImageinfoCreate) createSpec() (*Imageinfo, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ic.mutation.ProductmediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   imageinfo.ProductmediaTable,
+			Columns: []string{imageinfo.ProductmediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,12 +4,15 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 	"sthl/ent/imageinfo"
 	"sthl/ent/predicate"
+	"sthl/ent/productmedia"
 	"sthl/ent/user"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -19,11 +22,13 @@ import (
 // ImageinfoQuery is the builder for querying Imageinfo entities.
 type ImageinfoQuery struct {
 	config
-	ctx        *QueryContext
-	order      []OrderFunc
-	inters     []Interceptor
-	predicates []predicate.Imageinfo
-	withOwner  *UserQuery
+	ctx              *QueryContext
+	order            []OrderFunc
+	inters           []Interceptor
+	predicates       []predicate.Imageinfo
+	withOwner        *UserQuery
+	withProductmedia *ProductMediaQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +87,28 @@ func (iq *ImageinfoQuery) QueryOwner() *UserQuery {
 	return query
 }
 
+// QueryProductmedia chains the current query on the "productmedia" edge.
+func (iq *ImageinfoQuery) QueryProductmedia() *ProductMediaQuery {
+	query := (&ProductMediaClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(imageinfo.Table, imageinfo.FieldID, selector),
+			sqlgraph.To(productmedia.Table, productmedia.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, imageinfo.ProductmediaTable, imageinfo.ProductmediaColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Imageinfo entity from the query.
 // Returns a *NotFoundError when no Imageinfo was found.
 func (iq *ImageinfoQuery) First(ctx context.Context) (*Imageinfo, error) {
@@ -269,12 +296,13 @@ func (iq *ImageinfoQuery) Clone() *ImageinfoQuery {
 		return nil
 	}
 	return &ImageinfoQuery{
-		config:     iq.config,
-		ctx:        iq.ctx.Clone(),
-		order:      append([]OrderFunc{}, iq.order...),
-		inters:     append([]Interceptor{}, iq.inters...),
-		predicates: append([]predicate.Imageinfo{}, iq.predicates...),
-		withOwner:  iq.withOwner.Clone(),
+		config:           iq.config,
+		ctx:              iq.ctx.Clone(),
+		order:            append([]OrderFunc{}, iq.order...),
+		inters:           append([]Interceptor{}, iq.inters...),
+		predicates:       append([]predicate.Imageinfo{}, iq.predicates...),
+		withOwner:        iq.withOwner.Clone(),
+		withProductmedia: iq.withProductmedia.Clone(),
 		// clone intermediate query.
 		sql:  iq.sql.Clone(),
 		path: iq.path,
@@ -292,6 +320,17 @@ func (iq *ImageinfoQuery) WithOwner(opts ...func(*UserQuery)) *ImageinfoQuery {
 	return iq
 }
 
+// WithProductmedia tells the query-builder to eager-load the nodes that are connected to
+// the "productmedia" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ImageinfoQuery) WithProductmedia(opts ...func(*ProductMediaQuery)) *ImageinfoQuery {
+	query := (&ProductMediaClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withProductmedia = query
+	return iq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,8 +409,9 @@ func (iq *ImageinfoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Im
 	var (
 		nodes       = []*Imageinfo{}
 		_spec       = iq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			iq.withOwner != nil,
+			iq.withProductmedia != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -383,6 +423,9 @@ func (iq *ImageinfoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Im
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(iq.modifiers) > 0 {
+		_spec.Modifiers = iq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -398,6 +441,13 @@ func (iq *ImageinfoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Im
 			return nil, err
 		}
 	}
+	if query := iq.withProductmedia; query != nil {
+		if err := iq.loadProductmedia(ctx, query, nodes,
+			func(n *Imageinfo) { n.Edges.Productmedia = []*ProductMedia{} },
+			func(n *Imageinfo, e *ProductMedia) { n.Edges.Productmedia = append(n.Edges.Productmedia, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -430,9 +480,39 @@ func (iq *ImageinfoQuery) loadOwner(ctx context.Context, query *UserQuery, nodes
 	}
 	return nil
 }
+func (iq *ImageinfoQuery) loadProductmedia(ctx context.Context, query *ProductMediaQuery, nodes []*Imageinfo, init func(*Imageinfo), assign func(*Imageinfo, *ProductMedia)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Imageinfo)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.ProductMedia(func(s *sql.Selector) {
+		s.Where(sql.InValues(imageinfo.ProductmediaColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ImageinfoID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "imageinfo_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (iq *ImageinfoQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := iq.querySpec()
+	if len(iq.modifiers) > 0 {
+		_spec.Modifiers = iq.modifiers
+	}
 	_spec.Node.Columns = iq.ctx.Fields
 	if len(iq.ctx.Fields) > 0 {
 		_spec.Unique = iq.ctx.Unique != nil && *iq.ctx.Unique
@@ -495,6 +575,9 @@ func (iq *ImageinfoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if iq.ctx.Unique != nil && *iq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range iq.modifiers {
+		m(selector)
+	}
 	for _, p := range iq.predicates {
 		p(selector)
 	}
@@ -512,6 +595,32 @@ func (iq *ImageinfoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (iq *ImageinfoQuery) ForUpdate(opts ...sql.LockOption) *ImageinfoQuery {
+	if iq.driver.Dialect() == dialect.Postgres {
+		iq.Unique(false)
+	}
+	iq.modifiers = append(iq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return iq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (iq *ImageinfoQuery) ForShare(opts ...sql.LockOption) *ImageinfoQuery {
+	if iq.driver.Dialect() == dialect.Postgres {
+		iq.Unique(false)
+	}
+	iq.modifiers = append(iq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return iq
+}
+
 // ImageinfoGroupBy is the group-by builder for Imageinfo entities.
 type ImageinfoGroupBy struct {
 	selector
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sthl/ent/imageinfo"
 	"sthl/ent/predicate"
+	"sthl/ent/productmedia"
 	"sthl/ent/user"
 	"time"
 
@@ -84,6 +85,21 @@ func (iu *ImageinfoUpdate) SetOwner(u *User) *ImageinfoUpdate {
 	return iu.SetOwnerID(u.ID)
 }
 
+// AddProductmediumIDs adds the "productmedia" edge to the ProductMedia entity by IDs.
+func (iu *ImageinfoUpdate) AddProductmediumIDs(ids ...uuid.UUID) *ImageinfoUpdate {
+	iu.mutation.AddProductmediumIDs(ids...)
+	return iu
+}
+
+// AddProductmedia adds the "productmedia" edges to the ProductMedia entity.
+func (iu *ImageinfoUpdate) AddProductmedia(p ...*ProductMedia) *ImageinfoUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return iu.AddProductmediumIDs(ids...)
+}
+
 // Mutation returns the ImageinfoMutation object of the builder.
 func (iu *ImageinfoUpdate) Mutation() *ImageinfoMutation {
 	return iu.mutation
@@ -95,6 +111,27 @@ func (iu *ImageinfoUpdate) ClearOwner() *ImageinfoUpdate {
 	return iu
 }
 
+// ClearProductmedia clears all "productmedia" edges to the ProductMedia entity.
+func (iu *ImageinfoUpdate) ClearProductmedia() *ImageinfoUpdate {
+	iu.mutation.ClearProductmedia()
+	return iu
+}
+
+// RemoveProductmediumIDs removes the "productmedia" edge to ProductMedia entities by IDs.
+func (iu *ImageinfoUpdate) RemoveProductmediumIDs(ids ...uuid.UUID) *ImageinfoUpdate {
+	iu.mutation.RemoveProductmediumIDs(ids...)
+	return iu
+}
+
+// RemoveProductmedia removes "productmedia" edges to ProductMedia entities.
+func (iu *ImageinfoUpdate) RemoveProductmedia(p ...*ProductMedia) *ImageinfoUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return iu.RemoveProductmediumIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (iu *ImageinfoUpdate) Save(ctx context.Context) (int, error) {
 	iu.defaults()
@@ -224,6 +261,60 @@ func (iu *ImageinfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iu.mutation.ProductmediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   imageinfo.ProductmediaTable,
+			Columns: []string{imageinfo.ProductmediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedProductmediaIDs(); len(nodes) > 0 && !iu.mutation.ProductmediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   imageinfo.ProductmediaTable,
+			Columns: []string{imageinfo.ProductmediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.ProductmediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   imageinfo.ProductmediaTable,
+			Columns: []string{imageinfo.ProductmediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{imageinfo.Label}
@@ -298,6 +389,21 @@ func (iuo *ImageinfoUpdateOne) SetOwner(u *User) *ImageinfoUpdateOne {
 	return iuo.SetOwnerID(u.ID)
 }
 
+// AddProductmediumIDs adds the "productmedia" edge to the ProductMedia entity by IDs.
+func (iuo *ImageinfoUpdateOne) AddProductmediumIDs(ids ...uuid.UUID) *ImageinfoUpdateOne {
+	iuo.mutation.AddProductmediumIDs(ids...)
+	return iuo
+}
+
+// AddProductmedia adds the "productmedia" edges to the ProductMedia entity.
+func (iuo *ImageinfoUpdateOne) AddProductmedia(p ...*ProductMedia) *ImageinfoUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return iuo.AddProductmediumIDs(ids...)
+}
+
 // Mutation returns the ImageinfoMutation object of the builder.
 func (iuo *ImageinfoUpdateOne) Mutation() *ImageinfoMutation {
 	return iuo.mutation
@@ -309,6 +415,27 @@ func (iuo *ImageinfoUpdateOne) ClearOwner() *ImageinfoUpdateOne {
 	return iuo
 }
 
+// ClearProductmedia clears all "productmedia" edges to the ProductMedia entity.
+func (iuo *ImageinfoUpdateOne) ClearProductmedia() *ImageinfoUpdateOne {
+	iuo.mutation.ClearProductmedia()
+	return iuo
+}
+
+// RemoveProductmediumIDs removes the "productmedia" edge to ProductMedia entities by IDs.
+func (iuo *ImageinfoUpdateOne) RemoveProductmediumIDs(ids ...uuid.UUID) *ImageinfoUpdateOne {
+	iuo.mutation.RemoveProductmediumIDs(ids...)
+	return iuo
+}
+
+// RemoveProductmedia removes "productmedia" edges to ProductMedia entities.
+func (iuo *ImageinfoUpdateOne) RemoveProductmedia(p ...*ProductMedia) *ImageinfoUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return iuo.RemoveProductmediumIDs(ids...)
+}
+
 // Where appends a list predicates to the ImageinfoUpdate builder.
 func (iuo *ImageinfoUpdateOne) Where(ps ...predicate.Imageinfo) *ImageinfoUpdateOne {
 	iuo.mutation.Where(ps...)
@@ -468,6 +595,60 @@ func (iuo *ImageinfoUpdateOne) sqlSave(ctx context.Context) (_node *Imageinfo, e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if iuo.mutation.ProductmediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   imageinfo.ProductmediaTable,
+			Columns: []string{imageinfo.ProductmediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedProductmediaIDs(); len(nodes) > 0 && !iuo.mutation.ProductmediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   imageinfo.ProductmediaTable,
+			Columns: []string{imageinfo.ProductmediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.ProductmediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   imageinfo.ProductmediaTable,
+			Columns: []string{imageinfo.ProductmediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Imageinfo{config: iuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sthl/ent/inventorymovement"
+	"sthl/ent/product"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// InventoryMovement is the model entity for the InventoryMovement schema.
+type InventoryMovement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID uuid.UUID `json:"productId"`
+	// VariantID holds the value of the "variant_id" field.
+	VariantID *uuid.UUID `json:"variantId"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason"`
+	// Delta holds the value of the "delta" field.
+	Delta int32 `json:"delta"`
+	// QuantityAfter holds the value of the "quantity_after" field.
+	QuantityAfter int32 `json:"quantityAfter"`
+	// ReferenceID holds the value of the "reference_id" field.
+	ReferenceID string `json:"referenceId"`
+	// ActorID holds the value of the "actor_id" field.
+	ActorID *uuid.UUID `json:"actorId"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InventoryMovementQuery when eager-loading is set.
+	Edges InventoryMovementEdges `json:"-"`
+}
+
+// InventoryMovementEdges holds the relations/edges for other nodes in the graph.
+type InventoryMovementEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *Product `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InventoryMovementEdges) OwnerOrErr() (*Product, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: product.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InventoryMovement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case inventorymovement.FieldVariantID, inventorymovement.FieldActorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case inventorymovement.FieldDelta, inventorymovement.FieldQuantityAfter:
+			values[i] = new(sql.NullInt64)
+		case inventorymovement.FieldReason, inventorymovement.FieldReferenceID, inventorymovement.FieldNote:
+			values[i] = new(sql.NullString)
+		case inventorymovement.FieldCreatedAt, inventorymovement.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case inventorymovement.FieldID, inventorymovement.FieldProductID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type InventoryMovement", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InventoryMovement fields.
+func (im *InventoryMovement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case inventorymovement.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				im.ID = *value
+			}
+		case inventorymovement.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				im.CreatedAt = value.Time
+			}
+		case inventorymovement.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				im.UpdatedAt = value.Time
+			}
+		case inventorymovement.FieldProductID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value != nil {
+				im.ProductID = *value
+			}
+		case inventorymovement.FieldVariantID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field variant_id", values[i])
+			} else if value.Valid {
+				im.VariantID = new(uuid.UUID)
+				*im.VariantID = *value.S.(*uuid.UUID)
+			}
+		case inventorymovement.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				im.Reason = value.String
+			}
+		case inventorymovement.FieldDelta:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delta", values[i])
+			} else if value.Valid {
+				im.Delta = int32(value.Int64)
+			}
+		case inventorymovement.FieldQuantityAfter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity_after", values[i])
+			} else if value.Valid {
+				im.QuantityAfter = int32(value.Int64)
+			}
+		case inventorymovement.FieldReferenceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_id", values[i])
+			} else if value.Valid {
+				im.ReferenceID = value.String
+			}
+		case inventorymovement.FieldActorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				im.ActorID = new(uuid.UUID)
+				*im.ActorID = *value.S.(*uuid.UUID)
+			}
+		case inventorymovement.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				im.Note = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryOwner queries the "owner" edge of the InventoryMovement entity.
+func (im *InventoryMovement) QueryOwner() *ProductQuery {
+	return NewInventoryMovementClient(im.config).QueryOwner(im)
+}
+
+// Update returns a builder for updating this InventoryMovement.
+// Note that you need to call InventoryMovement.Unwrap() before calling this method if this InventoryMovement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (im *InventoryMovement) Update() *InventoryMovementUpdateOne {
+	return NewInventoryMovementClient(im.config).UpdateOne(im)
+}
+
+// Unwrap unwraps the InventoryMovement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (im *InventoryMovement) Unwrap() *InventoryMovement {
+	_tx, ok := im.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InventoryMovement is not a transactional entity")
+	}
+	im.config.driver = _tx.drv
+	return im
+}
+
+// String implements the fmt.Stringer.
+func (im *InventoryMovement) String() string {
+	var builder strings.Builder
+	builder.WriteString("InventoryMovement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", im.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(im.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(im.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", im.ProductID))
+	builder.WriteString(", ")
+	if v := im.VariantID; v != nil {
+		builder.WriteString("variant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(im.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("delta=")
+	builder.WriteString(fmt.Sprintf("%v", im.Delta))
+	builder.WriteString(", ")
+	builder.WriteString("quantity_after=")
+	builder.WriteString(fmt.Sprintf("%v", im.QuantityAfter))
+	builder.WriteString(", ")
+	builder.WriteString("reference_id=")
+	builder.WriteString(im.ReferenceID)
+	builder.WriteString(", ")
+	if v := im.ActorID; v != nil {
+		builder.WriteString("actor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(im.Note)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InventoryMovements is a parsable slice of InventoryMovement.
+type InventoryMovements []*InventoryMovement
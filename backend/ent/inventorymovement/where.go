@@ -0,0 +1,641 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventorymovement
+
+import (
+	"sthl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldProductID, v))
+}
+
+// VariantID applies equality check predicate on the "variant_id" field. It's identical to VariantIDEQ.
+func VariantID(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldVariantID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldReason, v))
+}
+
+// Delta applies equality check predicate on the "delta" field. It's identical to DeltaEQ.
+func Delta(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldDelta, v))
+}
+
+// QuantityAfter applies equality check predicate on the "quantity_after" field. It's identical to QuantityAfterEQ.
+func QuantityAfter(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldQuantityAfter, v))
+}
+
+// ReferenceID applies equality check predicate on the "reference_id" field. It's identical to ReferenceIDEQ.
+func ReferenceID(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldReferenceID, v))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldActorID, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// VariantIDEQ applies the EQ predicate on the "variant_id" field.
+func VariantIDEQ(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldVariantID, v))
+}
+
+// VariantIDNEQ applies the NEQ predicate on the "variant_id" field.
+func VariantIDNEQ(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNEQ(FieldVariantID, v))
+}
+
+// VariantIDIn applies the In predicate on the "variant_id" field.
+func VariantIDIn(vs ...uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldIn(FieldVariantID, vs...))
+}
+
+// VariantIDNotIn applies the NotIn predicate on the "variant_id" field.
+func VariantIDNotIn(vs ...uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNotIn(FieldVariantID, vs...))
+}
+
+// VariantIDGT applies the GT predicate on the "variant_id" field.
+func VariantIDGT(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGT(FieldVariantID, v))
+}
+
+// VariantIDGTE applies the GTE predicate on the "variant_id" field.
+func VariantIDGTE(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGTE(FieldVariantID, v))
+}
+
+// VariantIDLT applies the LT predicate on the "variant_id" field.
+func VariantIDLT(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLT(FieldVariantID, v))
+}
+
+// VariantIDLTE applies the LTE predicate on the "variant_id" field.
+func VariantIDLTE(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLTE(FieldVariantID, v))
+}
+
+// VariantIDIsNil applies the IsNil predicate on the "variant_id" field.
+func VariantIDIsNil() predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldIsNull(FieldVariantID))
+}
+
+// VariantIDNotNil applies the NotNil predicate on the "variant_id" field.
+func VariantIDNotNil() predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNotNull(FieldVariantID))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldContainsFold(FieldReason, v))
+}
+
+// DeltaEQ applies the EQ predicate on the "delta" field.
+func DeltaEQ(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldDelta, v))
+}
+
+// DeltaNEQ applies the NEQ predicate on the "delta" field.
+func DeltaNEQ(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNEQ(FieldDelta, v))
+}
+
+// DeltaIn applies the In predicate on the "delta" field.
+func DeltaIn(vs ...int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldIn(FieldDelta, vs...))
+}
+
+// DeltaNotIn applies the NotIn predicate on the "delta" field.
+func DeltaNotIn(vs ...int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNotIn(FieldDelta, vs...))
+}
+
+// DeltaGT applies the GT predicate on the "delta" field.
+func DeltaGT(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGT(FieldDelta, v))
+}
+
+// DeltaGTE applies the GTE predicate on the "delta" field.
+func DeltaGTE(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGTE(FieldDelta, v))
+}
+
+// DeltaLT applies the LT predicate on the "delta" field.
+func DeltaLT(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLT(FieldDelta, v))
+}
+
+// DeltaLTE applies the LTE predicate on the "delta" field.
+func DeltaLTE(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLTE(FieldDelta, v))
+}
+
+// QuantityAfterEQ applies the EQ predicate on the "quantity_after" field.
+func QuantityAfterEQ(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldQuantityAfter, v))
+}
+
+// QuantityAfterNEQ applies the NEQ predicate on the "quantity_after" field.
+func QuantityAfterNEQ(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNEQ(FieldQuantityAfter, v))
+}
+
+// QuantityAfterIn applies the In predicate on the "quantity_after" field.
+func QuantityAfterIn(vs ...int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldIn(FieldQuantityAfter, vs...))
+}
+
+// QuantityAfterNotIn applies the NotIn predicate on the "quantity_after" field.
+func QuantityAfterNotIn(vs ...int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNotIn(FieldQuantityAfter, vs...))
+}
+
+// QuantityAfterGT applies the GT predicate on the "quantity_after" field.
+func QuantityAfterGT(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGT(FieldQuantityAfter, v))
+}
+
+// QuantityAfterGTE applies the GTE predicate on the "quantity_after" field.
+func QuantityAfterGTE(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGTE(FieldQuantityAfter, v))
+}
+
+// QuantityAfterLT applies the LT predicate on the "quantity_after" field.
+func QuantityAfterLT(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLT(FieldQuantityAfter, v))
+}
+
+// QuantityAfterLTE applies the LTE predicate on the "quantity_after" field.
+func QuantityAfterLTE(v int32) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLTE(FieldQuantityAfter, v))
+}
+
+// ReferenceIDEQ applies the EQ predicate on the "reference_id" field.
+func ReferenceIDEQ(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldReferenceID, v))
+}
+
+// ReferenceIDNEQ applies the NEQ predicate on the "reference_id" field.
+func ReferenceIDNEQ(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNEQ(FieldReferenceID, v))
+}
+
+// ReferenceIDIn applies the In predicate on the "reference_id" field.
+func ReferenceIDIn(vs ...string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldIn(FieldReferenceID, vs...))
+}
+
+// ReferenceIDNotIn applies the NotIn predicate on the "reference_id" field.
+func ReferenceIDNotIn(vs ...string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNotIn(FieldReferenceID, vs...))
+}
+
+// ReferenceIDGT applies the GT predicate on the "reference_id" field.
+func ReferenceIDGT(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGT(FieldReferenceID, v))
+}
+
+// ReferenceIDGTE applies the GTE predicate on the "reference_id" field.
+func ReferenceIDGTE(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGTE(FieldReferenceID, v))
+}
+
+// ReferenceIDLT applies the LT predicate on the "reference_id" field.
+func ReferenceIDLT(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLT(FieldReferenceID, v))
+}
+
+// ReferenceIDLTE applies the LTE predicate on the "reference_id" field.
+func ReferenceIDLTE(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLTE(FieldReferenceID, v))
+}
+
+// ReferenceIDContains applies the Contains predicate on the "reference_id" field.
+func ReferenceIDContains(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldContains(FieldReferenceID, v))
+}
+
+// ReferenceIDHasPrefix applies the HasPrefix predicate on the "reference_id" field.
+func ReferenceIDHasPrefix(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldHasPrefix(FieldReferenceID, v))
+}
+
+// ReferenceIDHasSuffix applies the HasSuffix predicate on the "reference_id" field.
+func ReferenceIDHasSuffix(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldHasSuffix(FieldReferenceID, v))
+}
+
+// ReferenceIDEqualFold applies the EqualFold predicate on the "reference_id" field.
+func ReferenceIDEqualFold(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEqualFold(FieldReferenceID, v))
+}
+
+// ReferenceIDContainsFold applies the ContainsFold predicate on the "reference_id" field.
+func ReferenceIDContainsFold(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldContainsFold(FieldReferenceID, v))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGT(FieldActorID, v))
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGTE(FieldActorID, v))
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLT(FieldActorID, v))
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v uuid.UUID) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLTE(FieldActorID, v))
+}
+
+// ActorIDIsNil applies the IsNil predicate on the "actor_id" field.
+func ActorIDIsNil() predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldIsNull(FieldActorID))
+}
+
+// ActorIDNotNil applies the NotNil predicate on the "actor_id" field.
+func ActorIDNotNil() predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNotNull(FieldActorID))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.InventoryMovement {
+	return predicate.InventoryMovement(sql.FieldContainsFold(FieldNote, v))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.InventoryMovement {
+	return predicate.InventoryMovement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.Product) predicate.InventoryMovement {
+	return predicate.InventoryMovement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OwnerInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InventoryMovement) predicate.InventoryMovement {
+	return predicate.InventoryMovement(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InventoryMovement) predicate.InventoryMovement {
+	return predicate.InventoryMovement(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InventoryMovement) predicate.InventoryMovement {
+	return predicate.InventoryMovement(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,777 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/inventorymovement"
+	"sthl/ent/product"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// InventoryMovementCreate is the builder for creating a InventoryMovement entity.
+type InventoryMovementCreate struct {
+	config
+	mutation *InventoryMovementMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (imc *InventoryMovementCreate) SetCreatedAt(t time.Time) *InventoryMovementCreate {
+	imc.mutation.SetCreatedAt(t)
+	return imc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (imc *InventoryMovementCreate) SetNillableCreatedAt(t *time.Time) *InventoryMovementCreate {
+	if t != nil {
+		imc.SetCreatedAt(*t)
+	}
+	return imc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (imc *InventoryMovementCreate) SetUpdatedAt(t time.Time) *InventoryMovementCreate {
+	imc.mutation.SetUpdatedAt(t)
+	return imc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (imc *InventoryMovementCreate) SetNillableUpdatedAt(t *time.Time) *InventoryMovementCreate {
+	if t != nil {
+		imc.SetUpdatedAt(*t)
+	}
+	return imc
+}
+
+// SetProductID sets the "product_id" field.
+func (imc *InventoryMovementCreate) SetProductID(u uuid.UUID) *InventoryMovementCreate {
+	imc.mutation.SetProductID(u)
+	return imc
+}
+
+// SetVariantID sets the "variant_id" field.
+func (imc *InventoryMovementCreate) SetVariantID(u uuid.UUID) *InventoryMovementCreate {
+	imc.mutation.SetVariantID(u)
+	return imc
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (imc *InventoryMovementCreate) SetNillableVariantID(u *uuid.UUID) *InventoryMovementCreate {
+	if u != nil {
+		imc.SetVariantID(*u)
+	}
+	return imc
+}
+
+// SetReason sets the "reason" field.
+func (imc *InventoryMovementCreate) SetReason(s string) *InventoryMovementCreate {
+	imc.mutation.SetReason(s)
+	return imc
+}
+
+// SetDelta sets the "delta" field.
+func (imc *InventoryMovementCreate) SetDelta(i int32) *InventoryMovementCreate {
+	imc.mutation.SetDelta(i)
+	return imc
+}
+
+// SetQuantityAfter sets the "quantity_after" field.
+func (imc *InventoryMovementCreate) SetQuantityAfter(i int32) *InventoryMovementCreate {
+	imc.mutation.SetQuantityAfter(i)
+	return imc
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (imc *InventoryMovementCreate) SetReferenceID(s string) *InventoryMovementCreate {
+	imc.mutation.SetReferenceID(s)
+	return imc
+}
+
+// SetNillableReferenceID sets the "reference_id" field if the given value is not nil.
+func (imc *InventoryMovementCreate) SetNillableReferenceID(s *string) *InventoryMovementCreate {
+	if s != nil {
+		imc.SetReferenceID(*s)
+	}
+	return imc
+}
+
+// SetActorID sets the "actor_id" field.
+func (imc *InventoryMovementCreate) SetActorID(u uuid.UUID) *InventoryMovementCreate {
+	imc.mutation.SetActorID(u)
+	return imc
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (imc *InventoryMovementCreate) SetNillableActorID(u *uuid.UUID) *InventoryMovementCreate {
+	if u != nil {
+		imc.SetActorID(*u)
+	}
+	return imc
+}
+
+// SetNote sets the "note" field.
+func (imc *InventoryMovementCreate) SetNote(s string) *InventoryMovementCreate {
+	imc.mutation.SetNote(s)
+	return imc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (imc *InventoryMovementCreate) SetNillableNote(s *string) *InventoryMovementCreate {
+	if s != nil {
+		imc.SetNote(*s)
+	}
+	return imc
+}
+
+// SetID sets the "id" field.
+func (imc *InventoryMovementCreate) SetID(u uuid.UUID) *InventoryMovementCreate {
+	imc.mutation.SetID(u)
+	return imc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (imc *InventoryMovementCreate) SetNillableID(u *uuid.UUID) *InventoryMovementCreate {
+	if u != nil {
+		imc.SetID(*u)
+	}
+	return imc
+}
+
+// SetOwnerID sets the "owner" edge to the Product entity by ID.
+func (imc *InventoryMovementCreate) SetOwnerID(id uuid.UUID) *InventoryMovementCreate {
+	imc.mutation.SetOwnerID(id)
+	return imc
+}
+
+// SetOwner sets the "owner" edge to the Product entity.
+func (imc *InventoryMovementCreate) SetOwner(p *Product) *InventoryMovementCreate {
+	return imc.SetOwnerID(p.ID)
+}
+
+// Mutation returns the InventoryMovementMutation object of the builder.
+func (imc *InventoryMovementCreate) Mutation() *InventoryMovementMutation {
+	return imc.mutation
+}
+
+// Save creates the InventoryMovement in the database.
+func (imc *InventoryMovementCreate) Save(ctx context.Context) (*InventoryMovement, error) {
+	imc.defaults()
+	return withHooks[*InventoryMovement, InventoryMovementMutation](ctx, imc.sqlSave, imc.mutation, imc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (imc *InventoryMovementCreate) SaveX(ctx context.Context) *InventoryMovement {
+	v, err := imc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (imc *InventoryMovementCreate) Exec(ctx context.Context) error {
+	_, err := imc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (imc *InventoryMovementCreate) ExecX(ctx context.Context) {
+	if err := imc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (imc *InventoryMovementCreate) defaults() {
+	if _, ok := imc.mutation.CreatedAt(); !ok {
+		v := inventorymovement.DefaultCreatedAt()
+		imc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := imc.mutation.UpdatedAt(); !ok {
+		v := inventorymovement.DefaultUpdatedAt()
+		imc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := imc.mutation.ReferenceID(); !ok {
+		v := inventorymovement.DefaultReferenceID
+		imc.mutation.SetReferenceID(v)
+	}
+	if _, ok := imc.mutation.Note(); !ok {
+		v := inventorymovement.DefaultNote
+		imc.mutation.SetNote(v)
+	}
+	if _, ok := imc.mutation.ID(); !ok {
+		v := inventorymovement.DefaultID()
+		imc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (imc *InventoryMovementCreate) check() error {
+	if _, ok := imc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InventoryMovement.created_at"`)}
+	}
+	if _, ok := imc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InventoryMovement.updated_at"`)}
+	}
+	if _, ok := imc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "InventoryMovement.product_id"`)}
+	}
+	if _, ok := imc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "InventoryMovement.reason"`)}
+	}
+	if v, ok := imc.mutation.Reason(); ok {
+		if err := inventorymovement.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "InventoryMovement.reason": %w`, err)}
+		}
+	}
+	if _, ok := imc.mutation.Delta(); !ok {
+		return &ValidationError{Name: "delta", err: errors.New(`ent: missing required field "InventoryMovement.delta"`)}
+	}
+	if _, ok := imc.mutation.QuantityAfter(); !ok {
+		return &ValidationError{Name: "quantity_after", err: errors.New(`ent: missing required field "InventoryMovement.quantity_after"`)}
+	}
+	if _, ok := imc.mutation.ReferenceID(); !ok {
+		return &ValidationError{Name: "reference_id", err: errors.New(`ent: missing required field "InventoryMovement.reference_id"`)}
+	}
+	if v, ok := imc.mutation.ReferenceID(); ok {
+		if err := inventorymovement.ReferenceIDValidator(v); err != nil {
+			return &ValidationError{Name: "reference_id", err: fmt.Errorf(`ent: validator failed for field "InventoryMovement.reference_id": %w`, err)}
+		}
+	}
+	if _, ok := imc.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "InventoryMovement.note"`)}
+	}
+	if v, ok := imc.mutation.Note(); ok {
+		if err := inventorymovement.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "InventoryMovement.note": %w`, err)}
+		}
+	}
+	if _, ok := imc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "InventoryMovement.owner"`)}
+	}
+	return nil
+}
+
+func (imc *InventoryMovementCreate) sqlSave(ctx context.Context) (*InventoryMovement, error) {
+	if err := imc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := imc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, imc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	imc.mutation.id = &_node.ID
+	imc.mutation.done = true
+	return _node, nil
+}
+
+func (imc *InventoryMovementCreate) createSpec() (*InventoryMovement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InventoryMovement{config: imc.config}
+		_spec = sqlgraph.NewCreateSpec(inventorymovement.Table, sqlgraph.NewFieldSpec(inventorymovement.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = imc.conflict
+	if id, ok := imc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := imc.mutation.CreatedAt(); ok {
+		_spec.SetField(inventorymovement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := imc.mutation.UpdatedAt(); ok {
+		_spec.SetField(inventorymovement.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := imc.mutation.VariantID(); ok {
+		_spec.SetField(inventorymovement.FieldVariantID, field.TypeUUID, value)
+		_node.VariantID = &value
+	}
+	if value, ok := imc.mutation.Reason(); ok {
+		_spec.SetField(inventorymovement.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := imc.mutation.Delta(); ok {
+		_spec.SetField(inventorymovement.FieldDelta, field.TypeInt32, value)
+		_node.Delta = value
+	}
+	if value, ok := imc.mutation.QuantityAfter(); ok {
+		_spec.SetField(inventorymovement.FieldQuantityAfter, field.TypeInt32, value)
+		_node.QuantityAfter = value
+	}
+	if value, ok := imc.mutation.ReferenceID(); ok {
+		_spec.SetField(inventorymovement.FieldReferenceID, field.TypeString, value)
+		_node.ReferenceID = value
+	}
+	if value, ok := imc.mutation.ActorID(); ok {
+		_spec.SetField(inventorymovement.FieldActorID, field.TypeUUID, value)
+		_node.ActorID = &value
+	}
+	if value, ok := imc.mutation.Note(); ok {
+		_spec.SetField(inventorymovement.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if nodes := imc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventorymovement.OwnerTable,
+			Columns: []string{inventorymovement.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProductID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InventoryMovement.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InventoryMovementUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (imc *InventoryMovementCreate) OnConflict(opts ...sql.ConflictOption) *InventoryMovementUpsertOne {
+	imc.conflict = opts
+	return &InventoryMovementUpsertOne{
+		create: imc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InventoryMovement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (imc *InventoryMovementCreate) OnConflictColumns(columns ...string) *InventoryMovementUpsertOne {
+	imc.conflict = append(imc.conflict, sql.ConflictColumns(columns...))
+	return &InventoryMovementUpsertOne{
+		create: imc,
+	}
+}
+
+type (
+	// InventoryMovementUpsertOne is the builder for "upsert"-ing
+	//  one InventoryMovement node.
+	InventoryMovementUpsertOne struct {
+		create *InventoryMovementCreate
+	}
+
+	// InventoryMovementUpsert is the "OnConflict" setter.
+	InventoryMovementUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InventoryMovementUpsert) SetUpdatedAt(v time.Time) *InventoryMovementUpsert {
+	u.Set(inventorymovement.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InventoryMovementUpsert) UpdateUpdatedAt() *InventoryMovementUpsert {
+	u.SetExcluded(inventorymovement.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InventoryMovement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(inventorymovement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InventoryMovementUpsertOne) UpdateNewValues() *InventoryMovementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(inventorymovement.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(inventorymovement.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.ProductID(); exists {
+			s.SetIgnore(inventorymovement.FieldProductID)
+		}
+		if _, exists := u.create.mutation.VariantID(); exists {
+			s.SetIgnore(inventorymovement.FieldVariantID)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(inventorymovement.FieldReason)
+		}
+		if _, exists := u.create.mutation.Delta(); exists {
+			s.SetIgnore(inventorymovement.FieldDelta)
+		}
+		if _, exists := u.create.mutation.QuantityAfter(); exists {
+			s.SetIgnore(inventorymovement.FieldQuantityAfter)
+		}
+		if _, exists := u.create.mutation.ReferenceID(); exists {
+			s.SetIgnore(inventorymovement.FieldReferenceID)
+		}
+		if _, exists := u.create.mutation.ActorID(); exists {
+			s.SetIgnore(inventorymovement.FieldActorID)
+		}
+		if _, exists := u.create.mutation.Note(); exists {
+			s.SetIgnore(inventorymovement.FieldNote)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InventoryMovement.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InventoryMovementUpsertOne) Ignore() *InventoryMovementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InventoryMovementUpsertOne) DoNothing() *InventoryMovementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InventoryMovementCreate.OnConflict
+// documentation for more info.
+func (u *InventoryMovementUpsertOne) Update(set func(*InventoryMovementUpsert)) *InventoryMovementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InventoryMovementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InventoryMovementUpsertOne) SetUpdatedAt(v time.Time) *InventoryMovementUpsertOne {
+	return u.Update(func(s *InventoryMovementUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InventoryMovementUpsertOne) UpdateUpdatedAt() *InventoryMovementUpsertOne {
+	return u.Update(func(s *InventoryMovementUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InventoryMovementUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InventoryMovementCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InventoryMovementUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InventoryMovementUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: InventoryMovementUpsertOne.ID is not supported by MySQL driver. Use InventoryMovementUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InventoryMovementUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InventoryMovementCreateBulk is the builder for creating many InventoryMovement entities in bulk.
+type InventoryMovementCreateBulk struct {
+	config
+	builders []*InventoryMovementCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InventoryMovement entities in the database.
+func (imcb *InventoryMovementCreateBulk) Save(ctx context.Context) ([]*InventoryMovement, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(imcb.builders))
+	nodes := make([]*InventoryMovement, len(imcb.builders))
+	mutators := make([]Mutator, len(imcb.builders))
+	for i := range imcb.builders {
+		func(i int, root context.Context) {
+			builder := imcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InventoryMovementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, imcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = imcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, imcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, imcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (imcb *InventoryMovementCreateBulk) SaveX(ctx context.Context) []*InventoryMovement {
+	v, err := imcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (imcb *InventoryMovementCreateBulk) Exec(ctx context.Context) error {
+	_, err := imcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (imcb *InventoryMovementCreateBulk) ExecX(ctx context.Context) {
+	if err := imcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InventoryMovement.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InventoryMovementUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (imcb *InventoryMovementCreateBulk) OnConflict(opts ...sql.ConflictOption) *InventoryMovementUpsertBulk {
+	imcb.conflict = opts
+	return &InventoryMovementUpsertBulk{
+		create: imcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InventoryMovement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (imcb *InventoryMovementCreateBulk) OnConflictColumns(columns ...string) *InventoryMovementUpsertBulk {
+	imcb.conflict = append(imcb.conflict, sql.ConflictColumns(columns...))
+	return &InventoryMovementUpsertBulk{
+		create: imcb,
+	}
+}
+
+// InventoryMovementUpsertBulk is the builder for "upsert"-ing
+// a bulk of InventoryMovement nodes.
+type InventoryMovementUpsertBulk struct {
+	create *InventoryMovementCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InventoryMovement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(inventorymovement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InventoryMovementUpsertBulk) UpdateNewValues() *InventoryMovementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(inventorymovement.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(inventorymovement.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.ProductID(); exists {
+				s.SetIgnore(inventorymovement.FieldProductID)
+			}
+			if _, exists := b.mutation.VariantID(); exists {
+				s.SetIgnore(inventorymovement.FieldVariantID)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(inventorymovement.FieldReason)
+			}
+			if _, exists := b.mutation.Delta(); exists {
+				s.SetIgnore(inventorymovement.FieldDelta)
+			}
+			if _, exists := b.mutation.QuantityAfter(); exists {
+				s.SetIgnore(inventorymovement.FieldQuantityAfter)
+			}
+			if _, exists := b.mutation.ReferenceID(); exists {
+				s.SetIgnore(inventorymovement.FieldReferenceID)
+			}
+			if _, exists := b.mutation.ActorID(); exists {
+				s.SetIgnore(inventorymovement.FieldActorID)
+			}
+			if _, exists := b.mutation.Note(); exists {
+				s.SetIgnore(inventorymovement.FieldNote)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InventoryMovement.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InventoryMovementUpsertBulk) Ignore() *InventoryMovementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InventoryMovementUpsertBulk) DoNothing() *InventoryMovementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InventoryMovementCreateBulk.OnConflict
+// documentation for more info.
+func (u *InventoryMovementUpsertBulk) Update(set func(*InventoryMovementUpsert)) *InventoryMovementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InventoryMovementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InventoryMovementUpsertBulk) SetUpdatedAt(v time.Time) *InventoryMovementUpsertBulk {
+	return u.Update(func(s *InventoryMovementUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InventoryMovementUpsertBulk) UpdateUpdatedAt() *InventoryMovementUpsertBulk {
+	return u.Update(func(s *InventoryMovementUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InventoryMovementUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InventoryMovementCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InventoryMovementCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InventoryMovementUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sthl/ent/inventorymovement"
+	"sthl/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryMovementDelete is the builder for deleting a InventoryMovement entity.
+type InventoryMovementDelete struct {
+	config
+	hooks    []Hook
+	mutation *InventoryMovementMutation
+}
+
+// Where appends a list predicates to the InventoryMovementDelete builder.
+func (imd *InventoryMovementDelete) Where(ps ...predicate.InventoryMovement) *InventoryMovementDelete {
+	imd.mutation.Where(ps...)
+	return imd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (imd *InventoryMovementDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, InventoryMovementMutation](ctx, imd.sqlExec, imd.mutation, imd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (imd *InventoryMovementDelete) ExecX(ctx context.Context) int {
+	n, err := imd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (imd *InventoryMovementDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(inventorymovement.Table, sqlgraph.NewFieldSpec(inventorymovement.FieldID, field.TypeUUID))
+	if ps := imd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, imd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	imd.mutation.done = true
+	return affected, err
+}
+
+// InventoryMovementDeleteOne is the builder for deleting a single InventoryMovement entity.
+type InventoryMovementDeleteOne struct {
+	imd *InventoryMovementDelete
+}
+
+// Where appends a list predicates to the InventoryMovementDelete builder.
+func (imdo *InventoryMovementDeleteOne) Where(ps ...predicate.InventoryMovement) *InventoryMovementDeleteOne {
+	imdo.imd.mutation.Where(ps...)
+	return imdo
+}
+
+// Exec executes the deletion query.
+func (imdo *InventoryMovementDeleteOne) Exec(ctx context.Context) error {
+	n, err := imdo.imd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{inventorymovement.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (imdo *InventoryMovementDeleteOne) ExecX(ctx context.Context) {
+	if err := imdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
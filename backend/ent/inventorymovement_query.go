@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sthl/ent/inventorymovement"
+	"sthl/ent/predicate"
+	"sthl/ent/product"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// InventoryMovementQuery is the builder for querying InventoryMovement entities.
+type InventoryMovementQuery struct {
+	config
+	ctx        *QueryContext
+	order      []OrderFunc
+	inters     []Interceptor
+	predicates []predicate.InventoryMovement
+	withOwner  *ProductQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InventoryMovementQuery builder.
+func (imq *InventoryMovementQuery) Where(ps ...predicate.InventoryMovement) *InventoryMovementQuery {
+	imq.predicates = append(imq.predicates, ps...)
+	return imq
+}
+
+// Limit the number of records to be returned by this query.
+func (imq *InventoryMovementQuery) Limit(limit int) *InventoryMovementQuery {
+	imq.ctx.Limit = &limit
+	return imq
+}
+
+// Offset to start from.
+func (imq *InventoryMovementQuery) Offset(offset int) *InventoryMovementQuery {
+	imq.ctx.Offset = &offset
+	return imq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (imq *InventoryMovementQuery) Unique(unique bool) *InventoryMovementQuery {
+	imq.ctx.Unique = &unique
+	return imq
+}
+
+// Order specifies how the records should be ordered.
+func (imq *InventoryMovementQuery) Order(o ...OrderFunc) *InventoryMovementQuery {
+	imq.order = append(imq.order, o...)
+	return imq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (imq *InventoryMovementQuery) QueryOwner() *ProductQuery {
+	query := (&ProductClient{config: imq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := imq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := imq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(inventorymovement.Table, inventorymovement.FieldID, selector),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, inventorymovement.OwnerTable, inventorymovement.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(imq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first InventoryMovement entity from the query.
+// Returns a *NotFoundError when no InventoryMovement was found.
+func (imq *InventoryMovementQuery) First(ctx context.Context) (*InventoryMovement, error) {
+	nodes, err := imq.Limit(1).All(setContextOp(ctx, imq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{inventorymovement.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (imq *InventoryMovementQuery) FirstX(ctx context.Context) *InventoryMovement {
+	node, err := imq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InventoryMovement ID from the query.
+// Returns a *NotFoundError when no InventoryMovement ID was found.
+func (imq *InventoryMovementQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = imq.Limit(1).IDs(setContextOp(ctx, imq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{inventorymovement.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (imq *InventoryMovementQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := imq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InventoryMovement entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InventoryMovement entity is found.
+// Returns a *NotFoundError when no InventoryMovement entities are found.
+func (imq *InventoryMovementQuery) Only(ctx context.Context) (*InventoryMovement, error) {
+	nodes, err := imq.Limit(2).All(setContextOp(ctx, imq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{inventorymovement.Label}
+	default:
+		return nil, &NotSingularError{inventorymovement.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (imq *InventoryMovementQuery) OnlyX(ctx context.Context) *InventoryMovement {
+	node, err := imq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InventoryMovement ID in the query.
+// Returns a *NotSingularError when more than one InventoryMovement ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (imq *InventoryMovementQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = imq.Limit(2).IDs(setContextOp(ctx, imq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{inventorymovement.Label}
+	default:
+		err = &NotSingularError{inventorymovement.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (imq *InventoryMovementQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := imq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InventoryMovements.
+func (imq *InventoryMovementQuery) All(ctx context.Context) ([]*InventoryMovement, error) {
+	ctx = setContextOp(ctx, imq.ctx, "All")
+	if err := imq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*InventoryMovement, *InventoryMovementQuery]()
+	return withInterceptors[[]*InventoryMovement](ctx, imq, qr, imq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (imq *InventoryMovementQuery) AllX(ctx context.Context) []*InventoryMovement {
+	nodes, err := imq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InventoryMovement IDs.
+func (imq *InventoryMovementQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if imq.ctx.Unique == nil && imq.path != nil {
+		imq.Unique(true)
+	}
+	ctx = setContextOp(ctx, imq.ctx, "IDs")
+	if err = imq.Select(inventorymovement.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (imq *InventoryMovementQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := imq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (imq *InventoryMovementQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, imq.ctx, "Count")
+	if err := imq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, imq, querierCount[*InventoryMovementQuery](), imq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (imq *InventoryMovementQuery) CountX(ctx context.Context) int {
+	count, err := imq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (imq *InventoryMovementQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, imq.ctx, "Exist")
+	switch _, err := imq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (imq *InventoryMovementQuery) ExistX(ctx context.Context) bool {
+	exist, err := imq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InventoryMovementQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (imq *InventoryMovementQuery) Clone() *InventoryMovementQuery {
+	if imq == nil {
+		return nil
+	}
+	return &InventoryMovementQuery{
+		config:     imq.config,
+		ctx:        imq.ctx.Clone(),
+		order:      append([]OrderFunc{}, imq.order...),
+		inters:     append([]Interceptor{}, imq.inters...),
+		predicates: append([]predicate.InventoryMovement{}, imq.predicates...),
+		withOwner:  imq.withOwner.Clone(),
+		// clone intermediate query.
+		sql:  imq.sql.Clone(),
+		path: imq.path,
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (imq *InventoryMovementQuery) WithOwner(opts ...func(*ProductQuery)) *InventoryMovementQuery {
+	query := (&ProductClient{config: imq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	imq.withOwner = query
+	return imq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InventoryMovement.Query().
+//		GroupBy(inventorymovement.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (imq *InventoryMovementQuery) GroupBy(field string, fields ...string) *InventoryMovementGroupBy {
+	imq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &InventoryMovementGroupBy{build: imq}
+	grbuild.flds = &imq.ctx.Fields
+	grbuild.label = inventorymovement.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//	}
+//
+//	client.InventoryMovement.Query().
+//		Select(inventorymovement.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (imq *InventoryMovementQuery) Select(fields ...string) *InventoryMovementSelect {
+	imq.ctx.Fields = append(imq.ctx.Fields, fields...)
+	sbuild := &InventoryMovementSelect{InventoryMovementQuery: imq}
+	sbuild.label = inventorymovement.Label
+	sbuild.flds, sbuild.scan = &imq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a InventoryMovementSelect configured with the given aggregations.
+func (imq *InventoryMovementQuery) Aggregate(fns ...AggregateFunc) *InventoryMovementSelect {
+	return imq.Select().Aggregate(fns...)
+}
+
+func (imq *InventoryMovementQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range imq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, imq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range imq.ctx.Fields {
+		if !inventorymovement.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if imq.path != nil {
+		prev, err := imq.path(ctx)
+		if err != nil {
+			return err
+		}
+		imq.sql = prev
+	}
+	return nil
+}
+
+func (imq *InventoryMovementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InventoryMovement, error) {
+	var (
+		nodes       = []*InventoryMovement{}
+		_spec       = imq.querySpec()
+		loadedTypes = [1]bool{
+			imq.withOwner != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*InventoryMovement).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &InventoryMovement{config: imq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(imq.modifiers) > 0 {
+		_spec.Modifiers = imq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, imq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := imq.withOwner; query != nil {
+		if err := imq.loadOwner(ctx, query, nodes, nil,
+			func(n *InventoryMovement, e *Product) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (imq *InventoryMovementQuery) loadOwner(ctx context.Context, query *ProductQuery, nodes []*InventoryMovement, init func(*InventoryMovement), assign func(*InventoryMovement, *Product)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*InventoryMovement)
+	for i := range nodes {
+		fk := nodes[i].ProductID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(product.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (imq *InventoryMovementQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := imq.querySpec()
+	if len(imq.modifiers) > 0 {
+		_spec.Modifiers = imq.modifiers
+	}
+	_spec.Node.Columns = imq.ctx.Fields
+	if len(imq.ctx.Fields) > 0 {
+		_spec.Unique = imq.ctx.Unique != nil && *imq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, imq.driver, _spec)
+}
+
+func (imq *InventoryMovementQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(inventorymovement.Table, inventorymovement.Columns, sqlgraph.NewFieldSpec(inventorymovement.FieldID, field.TypeUUID))
+	_spec.From = imq.sql
+	if unique := imq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if imq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := imq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventorymovement.FieldID)
+		for i := range fields {
+			if fields[i] != inventorymovement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := imq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := imq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := imq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := imq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (imq *InventoryMovementQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(imq.driver.Dialect())
+	t1 := builder.Table(inventorymovement.Table)
+	columns := imq.ctx.Fields
+	if len(columns) == 0 {
+		columns = inventorymovement.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if imq.sql != nil {
+		selector = imq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if imq.ctx.Unique != nil && *imq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range imq.modifiers {
+		m(selector)
+	}
+	for _, p := range imq.predicates {
+		p(selector)
+	}
+	for _, p := range imq.order {
+		p(selector)
+	}
+	if offset := imq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := imq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (imq *InventoryMovementQuery) ForUpdate(opts ...sql.LockOption) *InventoryMovementQuery {
+	if imq.driver.Dialect() == dialect.Postgres {
+		imq.Unique(false)
+	}
+	imq.modifiers = append(imq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return imq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (imq *InventoryMovementQuery) ForShare(opts ...sql.LockOption) *InventoryMovementQuery {
+	if imq.driver.Dialect() == dialect.Postgres {
+		imq.Unique(false)
+	}
+	imq.modifiers = append(imq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return imq
+}
+
+// InventoryMovementGroupBy is the group-by builder for InventoryMovement entities.
+type InventoryMovementGroupBy struct {
+	selector
+	build *InventoryMovementQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (imgb *InventoryMovementGroupBy) Aggregate(fns ...AggregateFunc) *InventoryMovementGroupBy {
+	imgb.fns = append(imgb.fns, fns...)
+	return imgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (imgb *InventoryMovementGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, imgb.build.ctx, "GroupBy")
+	if err := imgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InventoryMovementQuery, *InventoryMovementGroupBy](ctx, imgb.build, imgb, imgb.build.inters, v)
+}
+
+func (imgb *InventoryMovementGroupBy) sqlScan(ctx context.Context, root *InventoryMovementQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(imgb.fns))
+	for _, fn := range imgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*imgb.flds)+len(imgb.fns))
+		for _, f := range *imgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*imgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := imgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// InventoryMovementSelect is the builder for selecting fields of InventoryMovement entities.
+type InventoryMovementSelect struct {
+	*InventoryMovementQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ims *InventoryMovementSelect) Aggregate(fns ...AggregateFunc) *InventoryMovementSelect {
+	ims.fns = append(ims.fns, fns...)
+	return ims
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ims *InventoryMovementSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ims.ctx, "Select")
+	if err := ims.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InventoryMovementQuery, *InventoryMovementSelect](ctx, ims.InventoryMovementQuery, ims, ims.inters, v)
+}
+
+func (ims *InventoryMovementSelect) sqlScan(ctx context.Context, root *InventoryMovementQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ims.fns))
+	for _, fn := range ims.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ims.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ims.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
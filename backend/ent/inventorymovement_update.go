@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/inventorymovement"
+	"sthl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InventoryMovementUpdate is the builder for updating InventoryMovement entities.
+type InventoryMovementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InventoryMovementMutation
+}
+
+// Where appends a list predicates to the InventoryMovementUpdate builder.
+func (imu *InventoryMovementUpdate) Where(ps ...predicate.InventoryMovement) *InventoryMovementUpdate {
+	imu.mutation.Where(ps...)
+	return imu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (imu *InventoryMovementUpdate) SetUpdatedAt(t time.Time) *InventoryMovementUpdate {
+	imu.mutation.SetUpdatedAt(t)
+	return imu
+}
+
+// Mutation returns the InventoryMovementMutation object of the builder.
+func (imu *InventoryMovementUpdate) Mutation() *InventoryMovementMutation {
+	return imu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (imu *InventoryMovementUpdate) Save(ctx context.Context) (int, error) {
+	imu.defaults()
+	return withHooks[int, InventoryMovementMutation](ctx, imu.sqlSave, imu.mutation, imu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (imu *InventoryMovementUpdate) SaveX(ctx context.Context) int {
+	affected, err := imu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (imu *InventoryMovementUpdate) Exec(ctx context.Context) error {
+	_, err := imu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (imu *InventoryMovementUpdate) ExecX(ctx context.Context) {
+	if err := imu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (imu *InventoryMovementUpdate) defaults() {
+	if _, ok := imu.mutation.UpdatedAt(); !ok {
+		v := inventorymovement.UpdateDefaultUpdatedAt()
+		imu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (imu *InventoryMovementUpdate) check() error {
+	if _, ok := imu.mutation.OwnerID(); imu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "InventoryMovement.owner"`)
+	}
+	return nil
+}
+
+func (imu *InventoryMovementUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := imu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inventorymovement.Table, inventorymovement.Columns, sqlgraph.NewFieldSpec(inventorymovement.FieldID, field.TypeUUID))
+	if ps := imu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := imu.mutation.UpdatedAt(); ok {
+		_spec.SetField(inventorymovement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if imu.mutation.VariantIDCleared() {
+		_spec.ClearField(inventorymovement.FieldVariantID, field.TypeUUID)
+	}
+	if imu.mutation.ActorIDCleared() {
+		_spec.ClearField(inventorymovement.FieldActorID, field.TypeUUID)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, imu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventorymovement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	imu.mutation.done = true
+	return n, nil
+}
+
+// InventoryMovementUpdateOne is the builder for updating a single InventoryMovement entity.
+type InventoryMovementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InventoryMovementMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (imuo *InventoryMovementUpdateOne) SetUpdatedAt(t time.Time) *InventoryMovementUpdateOne {
+	imuo.mutation.SetUpdatedAt(t)
+	return imuo
+}
+
+// Mutation returns the InventoryMovementMutation object of the builder.
+func (imuo *InventoryMovementUpdateOne) Mutation() *InventoryMovementMutation {
+	return imuo.mutation
+}
+
+// Where appends a list predicates to the InventoryMovementUpdate builder.
+func (imuo *InventoryMovementUpdateOne) Where(ps ...predicate.InventoryMovement) *InventoryMovementUpdateOne {
+	imuo.mutation.Where(ps...)
+	return imuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (imuo *InventoryMovementUpdateOne) Select(field string, fields ...string) *InventoryMovementUpdateOne {
+	imuo.fields = append([]string{field}, fields...)
+	return imuo
+}
+
+// Save executes the query and returns the updated InventoryMovement entity.
+func (imuo *InventoryMovementUpdateOne) Save(ctx context.Context) (*InventoryMovement, error) {
+	imuo.defaults()
+	return withHooks[*InventoryMovement, InventoryMovementMutation](ctx, imuo.sqlSave, imuo.mutation, imuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (imuo *InventoryMovementUpdateOne) SaveX(ctx context.Context) *InventoryMovement {
+	node, err := imuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (imuo *InventoryMovementUpdateOne) Exec(ctx context.Context) error {
+	_, err := imuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (imuo *InventoryMovementUpdateOne) ExecX(ctx context.Context) {
+	if err := imuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (imuo *InventoryMovementUpdateOne) defaults() {
+	if _, ok := imuo.mutation.UpdatedAt(); !ok {
+		v := inventorymovement.UpdateDefaultUpdatedAt()
+		imuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (imuo *InventoryMovementUpdateOne) check() error {
+	if _, ok := imuo.mutation.OwnerID(); imuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "InventoryMovement.owner"`)
+	}
+	return nil
+}
+
+func (imuo *InventoryMovementUpdateOne) sqlSave(ctx context.Context) (_node *InventoryMovement, err error) {
+	if err := imuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inventorymovement.Table, inventorymovement.Columns, sqlgraph.NewFieldSpec(inventorymovement.FieldID, field.TypeUUID))
+	id, ok := imuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InventoryMovement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := imuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventorymovement.FieldID)
+		for _, f := range fields {
+			if !inventorymovement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != inventorymovement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := imuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := imuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(inventorymovement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if imuo.mutation.VariantIDCleared() {
+		_spec.ClearField(inventorymovement.FieldVariantID, field.TypeUUID)
+	}
+	if imuo.mutation.ActorIDCleared() {
+		_spec.ClearField(inventorymovement.FieldActorID, field.TypeUUID)
+	}
+	_node = &InventoryMovement{config: imuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, imuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventorymovement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	imuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sthl/ent/loginlockoutevent"
+	"sthl/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// LoginLockoutEvent is the model entity for the LoginLockoutEvent schema.
+type LoginLockoutEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"userId"`
+	// Scope holds the value of the "scope" field.
+	Scope string `json:"scope"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip"`
+	// Failures holds the value of the "failures" field.
+	Failures int `json:"failures"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil time.Time `json:"lockedUntil"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LoginLockoutEventQuery when eager-loading is set.
+	Edges LoginLockoutEventEdges `json:"-"`
+}
+
+// LoginLockoutEventEdges holds the relations/edges for other nodes in the graph.
+type LoginLockoutEventEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoginLockoutEventEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginLockoutEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginlockoutevent.FieldFailures:
+			values[i] = new(sql.NullInt64)
+		case loginlockoutevent.FieldScope, loginlockoutevent.FieldIP:
+			values[i] = new(sql.NullString)
+		case loginlockoutevent.FieldCreatedAt, loginlockoutevent.FieldUpdatedAt, loginlockoutevent.FieldLockedUntil:
+			values[i] = new(sql.NullTime)
+		case loginlockoutevent.FieldID, loginlockoutevent.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type LoginLockoutEvent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginLockoutEvent fields.
+func (lle *LoginLockoutEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginlockoutevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				lle.ID = *value
+			}
+		case loginlockoutevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				lle.CreatedAt = value.Time
+			}
+		case loginlockoutevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				lle.UpdatedAt = value.Time
+			}
+		case loginlockoutevent.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				lle.UserID = *value
+			}
+		case loginlockoutevent.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				lle.Scope = value.String
+			}
+		case loginlockoutevent.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				lle.IP = value.String
+			}
+		case loginlockoutevent.FieldFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failures", values[i])
+			} else if value.Valid {
+				lle.Failures = int(value.Int64)
+			}
+		case loginlockoutevent.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				lle.LockedUntil = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryOwner queries the "owner" edge of the LoginLockoutEvent entity.
+func (lle *LoginLockoutEvent) QueryOwner() *UserQuery {
+	return NewLoginLockoutEventClient(lle.config).QueryOwner(lle)
+}
+
+// Update returns a builder for updating this LoginLockoutEvent.
+// Note that you need to call LoginLockoutEvent.Unwrap() before calling this method if this LoginLockoutEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lle *LoginLockoutEvent) Update() *LoginLockoutEventUpdateOne {
+	return NewLoginLockoutEventClient(lle.config).UpdateOne(lle)
+}
+
+// Unwrap unwraps the LoginLockoutEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lle *LoginLockoutEvent) Unwrap() *LoginLockoutEvent {
+	_tx, ok := lle.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginLockoutEvent is not a transactional entity")
+	}
+	lle.config.driver = _tx.drv
+	return lle
+}
+
+// String implements the fmt.Stringer.
+func (lle *LoginLockoutEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginLockoutEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lle.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(lle.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(lle.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", lle.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(lle.Scope)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(lle.IP)
+	builder.WriteString(", ")
+	builder.WriteString("failures=")
+	builder.WriteString(fmt.Sprintf("%v", lle.Failures))
+	builder.WriteString(", ")
+	builder.WriteString("locked_until=")
+	builder.WriteString(lle.LockedUntil.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginLockoutEvents is a parsable slice of LoginLockoutEvent.
+type LoginLockoutEvents []*LoginLockoutEvent
@@ -0,0 +1,888 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/loginlockoutevent"
+	"sthl/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LoginLockoutEventCreate is the builder for creating a LoginLockoutEvent entity.
+type LoginLockoutEventCreate struct {
+	config
+	mutation *LoginLockoutEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (llec *LoginLockoutEventCreate) SetCreatedAt(t time.Time) *LoginLockoutEventCreate {
+	llec.mutation.SetCreatedAt(t)
+	return llec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (llec *LoginLockoutEventCreate) SetNillableCreatedAt(t *time.Time) *LoginLockoutEventCreate {
+	if t != nil {
+		llec.SetCreatedAt(*t)
+	}
+	return llec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (llec *LoginLockoutEventCreate) SetUpdatedAt(t time.Time) *LoginLockoutEventCreate {
+	llec.mutation.SetUpdatedAt(t)
+	return llec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (llec *LoginLockoutEventCreate) SetNillableUpdatedAt(t *time.Time) *LoginLockoutEventCreate {
+	if t != nil {
+		llec.SetUpdatedAt(*t)
+	}
+	return llec
+}
+
+// SetUserID sets the "user_id" field.
+func (llec *LoginLockoutEventCreate) SetUserID(u uuid.UUID) *LoginLockoutEventCreate {
+	llec.mutation.SetUserID(u)
+	return llec
+}
+
+// SetScope sets the "scope" field.
+func (llec *LoginLockoutEventCreate) SetScope(s string) *LoginLockoutEventCreate {
+	llec.mutation.SetScope(s)
+	return llec
+}
+
+// SetIP sets the "ip" field.
+func (llec *LoginLockoutEventCreate) SetIP(s string) *LoginLockoutEventCreate {
+	llec.mutation.SetIP(s)
+	return llec
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (llec *LoginLockoutEventCreate) SetNillableIP(s *string) *LoginLockoutEventCreate {
+	if s != nil {
+		llec.SetIP(*s)
+	}
+	return llec
+}
+
+// SetFailures sets the "failures" field.
+func (llec *LoginLockoutEventCreate) SetFailures(i int) *LoginLockoutEventCreate {
+	llec.mutation.SetFailures(i)
+	return llec
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (llec *LoginLockoutEventCreate) SetLockedUntil(t time.Time) *LoginLockoutEventCreate {
+	llec.mutation.SetLockedUntil(t)
+	return llec
+}
+
+// SetID sets the "id" field.
+func (llec *LoginLockoutEventCreate) SetID(u uuid.UUID) *LoginLockoutEventCreate {
+	llec.mutation.SetID(u)
+	return llec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (llec *LoginLockoutEventCreate) SetNillableID(u *uuid.UUID) *LoginLockoutEventCreate {
+	if u != nil {
+		llec.SetID(*u)
+	}
+	return llec
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (llec *LoginLockoutEventCreate) SetOwnerID(id uuid.UUID) *LoginLockoutEventCreate {
+	llec.mutation.SetOwnerID(id)
+	return llec
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (llec *LoginLockoutEventCreate) SetOwner(u *User) *LoginLockoutEventCreate {
+	return llec.SetOwnerID(u.ID)
+}
+
+// Mutation returns the LoginLockoutEventMutation object of the builder.
+func (llec *LoginLockoutEventCreate) Mutation() *LoginLockoutEventMutation {
+	return llec.mutation
+}
+
+// Save creates the LoginLockoutEvent in the database.
+func (llec *LoginLockoutEventCreate) Save(ctx context.Context) (*LoginLockoutEvent, error) {
+	llec.defaults()
+	return withHooks[*LoginLockoutEvent, LoginLockoutEventMutation](ctx, llec.sqlSave, llec.mutation, llec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (llec *LoginLockoutEventCreate) SaveX(ctx context.Context) *LoginLockoutEvent {
+	v, err := llec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (llec *LoginLockoutEventCreate) Exec(ctx context.Context) error {
+	_, err := llec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (llec *LoginLockoutEventCreate) ExecX(ctx context.Context) {
+	if err := llec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (llec *LoginLockoutEventCreate) defaults() {
+	if _, ok := llec.mutation.CreatedAt(); !ok {
+		v := loginlockoutevent.DefaultCreatedAt()
+		llec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := llec.mutation.UpdatedAt(); !ok {
+		v := loginlockoutevent.DefaultUpdatedAt()
+		llec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := llec.mutation.IP(); !ok {
+		v := loginlockoutevent.DefaultIP
+		llec.mutation.SetIP(v)
+	}
+	if _, ok := llec.mutation.ID(); !ok {
+		v := loginlockoutevent.DefaultID()
+		llec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (llec *LoginLockoutEventCreate) check() error {
+	if _, ok := llec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginLockoutEvent.created_at"`)}
+	}
+	if _, ok := llec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LoginLockoutEvent.updated_at"`)}
+	}
+	if _, ok := llec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LoginLockoutEvent.user_id"`)}
+	}
+	if _, ok := llec.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "LoginLockoutEvent.scope"`)}
+	}
+	if v, ok := llec.mutation.Scope(); ok {
+		if err := loginlockoutevent.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "LoginLockoutEvent.scope": %w`, err)}
+		}
+	}
+	if _, ok := llec.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "LoginLockoutEvent.ip"`)}
+	}
+	if v, ok := llec.mutation.IP(); ok {
+		if err := loginlockoutevent.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "LoginLockoutEvent.ip": %w`, err)}
+		}
+	}
+	if _, ok := llec.mutation.Failures(); !ok {
+		return &ValidationError{Name: "failures", err: errors.New(`ent: missing required field "LoginLockoutEvent.failures"`)}
+	}
+	if v, ok := llec.mutation.Failures(); ok {
+		if err := loginlockoutevent.FailuresValidator(v); err != nil {
+			return &ValidationError{Name: "failures", err: fmt.Errorf(`ent: validator failed for field "LoginLockoutEvent.failures": %w`, err)}
+		}
+	}
+	if _, ok := llec.mutation.LockedUntil(); !ok {
+		return &ValidationError{Name: "locked_until", err: errors.New(`ent: missing required field "LoginLockoutEvent.locked_until"`)}
+	}
+	if _, ok := llec.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "LoginLockoutEvent.owner"`)}
+	}
+	return nil
+}
+
+func (llec *LoginLockoutEventCreate) sqlSave(ctx context.Context) (*LoginLockoutEvent, error) {
+	if err := llec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := llec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, llec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	llec.mutation.id = &_node.ID
+	llec.mutation.done = true
+	return _node, nil
+}
+
+func (llec *LoginLockoutEventCreate) createSpec() (*LoginLockoutEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginLockoutEvent{config: llec.config}
+		_spec = sqlgraph.NewCreateSpec(loginlockoutevent.Table, sqlgraph.NewFieldSpec(loginlockoutevent.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = llec.conflict
+	if id, ok := llec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := llec.mutation.CreatedAt(); ok {
+		_spec.SetField(loginlockoutevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := llec.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginlockoutevent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := llec.mutation.Scope(); ok {
+		_spec.SetField(loginlockoutevent.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := llec.mutation.IP(); ok {
+		_spec.SetField(loginlockoutevent.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := llec.mutation.Failures(); ok {
+		_spec.SetField(loginlockoutevent.FieldFailures, field.TypeInt, value)
+		_node.Failures = value
+	}
+	if value, ok := llec.mutation.LockedUntil(); ok {
+		_spec.SetField(loginlockoutevent.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = value
+	}
+	if nodes := llec.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loginlockoutevent.OwnerTable,
+			Columns: []string{loginlockoutevent.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginLockoutEvent.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginLockoutEventUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (llec *LoginLockoutEventCreate) OnConflict(opts ...sql.ConflictOption) *LoginLockoutEventUpsertOne {
+	llec.conflict = opts
+	return &LoginLockoutEventUpsertOne{
+		create: llec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginLockoutEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (llec *LoginLockoutEventCreate) OnConflictColumns(columns ...string) *LoginLockoutEventUpsertOne {
+	llec.conflict = append(llec.conflict, sql.ConflictColumns(columns...))
+	return &LoginLockoutEventUpsertOne{
+		create: llec,
+	}
+}
+
+type (
+	// LoginLockoutEventUpsertOne is the builder for "upsert"-ing
+	//  one LoginLockoutEvent node.
+	LoginLockoutEventUpsertOne struct {
+		create *LoginLockoutEventCreate
+	}
+
+	// LoginLockoutEventUpsert is the "OnConflict" setter.
+	LoginLockoutEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LoginLockoutEventUpsert) SetUpdatedAt(v time.Time) *LoginLockoutEventUpsert {
+	u.Set(loginlockoutevent.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsert) UpdateUpdatedAt() *LoginLockoutEventUpsert {
+	u.SetExcluded(loginlockoutevent.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LoginLockoutEventUpsert) SetUserID(v uuid.UUID) *LoginLockoutEventUpsert {
+	u.Set(loginlockoutevent.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsert) UpdateUserID() *LoginLockoutEventUpsert {
+	u.SetExcluded(loginlockoutevent.FieldUserID)
+	return u
+}
+
+// SetScope sets the "scope" field.
+func (u *LoginLockoutEventUpsert) SetScope(v string) *LoginLockoutEventUpsert {
+	u.Set(loginlockoutevent.FieldScope, v)
+	return u
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsert) UpdateScope() *LoginLockoutEventUpsert {
+	u.SetExcluded(loginlockoutevent.FieldScope)
+	return u
+}
+
+// SetIP sets the "ip" field.
+func (u *LoginLockoutEventUpsert) SetIP(v string) *LoginLockoutEventUpsert {
+	u.Set(loginlockoutevent.FieldIP, v)
+	return u
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsert) UpdateIP() *LoginLockoutEventUpsert {
+	u.SetExcluded(loginlockoutevent.FieldIP)
+	return u
+}
+
+// SetFailures sets the "failures" field.
+func (u *LoginLockoutEventUpsert) SetFailures(v int) *LoginLockoutEventUpsert {
+	u.Set(loginlockoutevent.FieldFailures, v)
+	return u
+}
+
+// UpdateFailures sets the "failures" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsert) UpdateFailures() *LoginLockoutEventUpsert {
+	u.SetExcluded(loginlockoutevent.FieldFailures)
+	return u
+}
+
+// AddFailures adds v to the "failures" field.
+func (u *LoginLockoutEventUpsert) AddFailures(v int) *LoginLockoutEventUpsert {
+	u.Add(loginlockoutevent.FieldFailures, v)
+	return u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *LoginLockoutEventUpsert) SetLockedUntil(v time.Time) *LoginLockoutEventUpsert {
+	u.Set(loginlockoutevent.FieldLockedUntil, v)
+	return u
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsert) UpdateLockedUntil() *LoginLockoutEventUpsert {
+	u.SetExcluded(loginlockoutevent.FieldLockedUntil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LoginLockoutEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(loginlockoutevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LoginLockoutEventUpsertOne) UpdateNewValues() *LoginLockoutEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(loginlockoutevent.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(loginlockoutevent.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginLockoutEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LoginLockoutEventUpsertOne) Ignore() *LoginLockoutEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginLockoutEventUpsertOne) DoNothing() *LoginLockoutEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginLockoutEventCreate.OnConflict
+// documentation for more info.
+func (u *LoginLockoutEventUpsertOne) Update(set func(*LoginLockoutEventUpsert)) *LoginLockoutEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginLockoutEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LoginLockoutEventUpsertOne) SetUpdatedAt(v time.Time) *LoginLockoutEventUpsertOne {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsertOne) UpdateUpdatedAt() *LoginLockoutEventUpsertOne {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LoginLockoutEventUpsertOne) SetUserID(v uuid.UUID) *LoginLockoutEventUpsertOne {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsertOne) UpdateUserID() *LoginLockoutEventUpsertOne {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetScope sets the "scope" field.
+func (u *LoginLockoutEventUpsertOne) SetScope(v string) *LoginLockoutEventUpsertOne {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.SetScope(v)
+	})
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsertOne) UpdateScope() *LoginLockoutEventUpsertOne {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.UpdateScope()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *LoginLockoutEventUpsertOne) SetIP(v string) *LoginLockoutEventUpsertOne {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsertOne) UpdateIP() *LoginLockoutEventUpsertOne {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// SetFailures sets the "failures" field.
+func (u *LoginLockoutEventUpsertOne) SetFailures(v int) *LoginLockoutEventUpsertOne {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.SetFailures(v)
+	})
+}
+
+// AddFailures adds v to the "failures" field.
+func (u *LoginLockoutEventUpsertOne) AddFailures(v int) *LoginLockoutEventUpsertOne {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.AddFailures(v)
+	})
+}
+
+// UpdateFailures sets the "failures" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsertOne) UpdateFailures() *LoginLockoutEventUpsertOne {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.UpdateFailures()
+	})
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *LoginLockoutEventUpsertOne) SetLockedUntil(v time.Time) *LoginLockoutEventUpsertOne {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.SetLockedUntil(v)
+	})
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsertOne) UpdateLockedUntil() *LoginLockoutEventUpsertOne {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.UpdateLockedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *LoginLockoutEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginLockoutEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginLockoutEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LoginLockoutEventUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LoginLockoutEventUpsertOne.ID is not supported by MySQL driver. Use LoginLockoutEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LoginLockoutEventUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LoginLockoutEventCreateBulk is the builder for creating many LoginLockoutEvent entities in bulk.
+type LoginLockoutEventCreateBulk struct {
+	config
+	builders []*LoginLockoutEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LoginLockoutEvent entities in the database.
+func (llecb *LoginLockoutEventCreateBulk) Save(ctx context.Context) ([]*LoginLockoutEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(llecb.builders))
+	nodes := make([]*LoginLockoutEvent, len(llecb.builders))
+	mutators := make([]Mutator, len(llecb.builders))
+	for i := range llecb.builders {
+		func(i int, root context.Context) {
+			builder := llecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginLockoutEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, llecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = llecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, llecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, llecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (llecb *LoginLockoutEventCreateBulk) SaveX(ctx context.Context) []*LoginLockoutEvent {
+	v, err := llecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (llecb *LoginLockoutEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := llecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (llecb *LoginLockoutEventCreateBulk) ExecX(ctx context.Context) {
+	if err := llecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LoginLockoutEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LoginLockoutEventUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (llecb *LoginLockoutEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *LoginLockoutEventUpsertBulk {
+	llecb.conflict = opts
+	return &LoginLockoutEventUpsertBulk{
+		create: llecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LoginLockoutEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (llecb *LoginLockoutEventCreateBulk) OnConflictColumns(columns ...string) *LoginLockoutEventUpsertBulk {
+	llecb.conflict = append(llecb.conflict, sql.ConflictColumns(columns...))
+	return &LoginLockoutEventUpsertBulk{
+		create: llecb,
+	}
+}
+
+// LoginLockoutEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of LoginLockoutEvent nodes.
+type LoginLockoutEventUpsertBulk struct {
+	create *LoginLockoutEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LoginLockoutEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(loginlockoutevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LoginLockoutEventUpsertBulk) UpdateNewValues() *LoginLockoutEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(loginlockoutevent.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(loginlockoutevent.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LoginLockoutEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LoginLockoutEventUpsertBulk) Ignore() *LoginLockoutEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LoginLockoutEventUpsertBulk) DoNothing() *LoginLockoutEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LoginLockoutEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *LoginLockoutEventUpsertBulk) Update(set func(*LoginLockoutEventUpsert)) *LoginLockoutEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LoginLockoutEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LoginLockoutEventUpsertBulk) SetUpdatedAt(v time.Time) *LoginLockoutEventUpsertBulk {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsertBulk) UpdateUpdatedAt() *LoginLockoutEventUpsertBulk {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LoginLockoutEventUpsertBulk) SetUserID(v uuid.UUID) *LoginLockoutEventUpsertBulk {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsertBulk) UpdateUserID() *LoginLockoutEventUpsertBulk {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetScope sets the "scope" field.
+func (u *LoginLockoutEventUpsertBulk) SetScope(v string) *LoginLockoutEventUpsertBulk {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.SetScope(v)
+	})
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsertBulk) UpdateScope() *LoginLockoutEventUpsertBulk {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.UpdateScope()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *LoginLockoutEventUpsertBulk) SetIP(v string) *LoginLockoutEventUpsertBulk {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsertBulk) UpdateIP() *LoginLockoutEventUpsertBulk {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// SetFailures sets the "failures" field.
+func (u *LoginLockoutEventUpsertBulk) SetFailures(v int) *LoginLockoutEventUpsertBulk {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.SetFailures(v)
+	})
+}
+
+// AddFailures adds v to the "failures" field.
+func (u *LoginLockoutEventUpsertBulk) AddFailures(v int) *LoginLockoutEventUpsertBulk {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.AddFailures(v)
+	})
+}
+
+// UpdateFailures sets the "failures" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsertBulk) UpdateFailures() *LoginLockoutEventUpsertBulk {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.UpdateFailures()
+	})
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *LoginLockoutEventUpsertBulk) SetLockedUntil(v time.Time) *LoginLockoutEventUpsertBulk {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.SetLockedUntil(v)
+	})
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *LoginLockoutEventUpsertBulk) UpdateLockedUntil() *LoginLockoutEventUpsertBulk {
+	return u.Update(func(s *LoginLockoutEventUpsert) {
+		s.UpdateLockedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *LoginLockoutEventUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LoginLockoutEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LoginLockoutEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LoginLockoutEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sthl/ent/loginlockoutevent"
+	"sthl/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginLockoutEventDelete is the builder for deleting a LoginLockoutEvent entity.
+type LoginLockoutEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *LoginLockoutEventMutation
+}
+
+// Where appends a list predicates to the LoginLockoutEventDelete builder.
+func (lled *LoginLockoutEventDelete) Where(ps ...predicate.LoginLockoutEvent) *LoginLockoutEventDelete {
+	lled.mutation.Where(ps...)
+	return lled
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lled *LoginLockoutEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, LoginLockoutEventMutation](ctx, lled.sqlExec, lled.mutation, lled.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lled *LoginLockoutEventDelete) ExecX(ctx context.Context) int {
+	n, err := lled.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lled *LoginLockoutEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(loginlockoutevent.Table, sqlgraph.NewFieldSpec(loginlockoutevent.FieldID, field.TypeUUID))
+	if ps := lled.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lled.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lled.mutation.done = true
+	return affected, err
+}
+
+// LoginLockoutEventDeleteOne is the builder for deleting a single LoginLockoutEvent entity.
+type LoginLockoutEventDeleteOne struct {
+	lled *LoginLockoutEventDelete
+}
+
+// Where appends a list predicates to the LoginLockoutEventDelete builder.
+func (lledo *LoginLockoutEventDeleteOne) Where(ps ...predicate.LoginLockoutEvent) *LoginLockoutEventDeleteOne {
+	lledo.lled.mutation.Where(ps...)
+	return lledo
+}
+
+// Exec executes the deletion query.
+func (lledo *LoginLockoutEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := lledo.lled.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{loginlockoutevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lledo *LoginLockoutEventDeleteOne) ExecX(ctx context.Context) {
+	if err := lledo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sthl/ent/loginlockoutevent"
+	"sthl/ent/predicate"
+	"sthl/ent/user"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LoginLockoutEventQuery is the builder for querying LoginLockoutEvent entities.
+type LoginLockoutEventQuery struct {
+	config
+	ctx        *QueryContext
+	order      []OrderFunc
+	inters     []Interceptor
+	predicates []predicate.LoginLockoutEvent
+	withOwner  *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LoginLockoutEventQuery builder.
+func (lleq *LoginLockoutEventQuery) Where(ps ...predicate.LoginLockoutEvent) *LoginLockoutEventQuery {
+	lleq.predicates = append(lleq.predicates, ps...)
+	return lleq
+}
+
+// Limit the number of records to be returned by this query.
+func (lleq *LoginLockoutEventQuery) Limit(limit int) *LoginLockoutEventQuery {
+	lleq.ctx.Limit = &limit
+	return lleq
+}
+
+// Offset to start from.
+func (lleq *LoginLockoutEventQuery) Offset(offset int) *LoginLockoutEventQuery {
+	lleq.ctx.Offset = &offset
+	return lleq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lleq *LoginLockoutEventQuery) Unique(unique bool) *LoginLockoutEventQuery {
+	lleq.ctx.Unique = &unique
+	return lleq
+}
+
+// Order specifies how the records should be ordered.
+func (lleq *LoginLockoutEventQuery) Order(o ...OrderFunc) *LoginLockoutEventQuery {
+	lleq.order = append(lleq.order, o...)
+	return lleq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (lleq *LoginLockoutEventQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: lleq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lleq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lleq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loginlockoutevent.Table, loginlockoutevent.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loginlockoutevent.OwnerTable, loginlockoutevent.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lleq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LoginLockoutEvent entity from the query.
+// Returns a *NotFoundError when no LoginLockoutEvent was found.
+func (lleq *LoginLockoutEventQuery) First(ctx context.Context) (*LoginLockoutEvent, error) {
+	nodes, err := lleq.Limit(1).All(setContextOp(ctx, lleq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{loginlockoutevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lleq *LoginLockoutEventQuery) FirstX(ctx context.Context) *LoginLockoutEvent {
+	node, err := lleq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LoginLockoutEvent ID from the query.
+// Returns a *NotFoundError when no LoginLockoutEvent ID was found.
+func (lleq *LoginLockoutEventQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = lleq.Limit(1).IDs(setContextOp(ctx, lleq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{loginlockoutevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lleq *LoginLockoutEventQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := lleq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LoginLockoutEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LoginLockoutEvent entity is found.
+// Returns a *NotFoundError when no LoginLockoutEvent entities are found.
+func (lleq *LoginLockoutEventQuery) Only(ctx context.Context) (*LoginLockoutEvent, error) {
+	nodes, err := lleq.Limit(2).All(setContextOp(ctx, lleq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{loginlockoutevent.Label}
+	default:
+		return nil, &NotSingularError{loginlockoutevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lleq *LoginLockoutEventQuery) OnlyX(ctx context.Context) *LoginLockoutEvent {
+	node, err := lleq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LoginLockoutEvent ID in the query.
+// Returns a *NotSingularError when more than one LoginLockoutEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lleq *LoginLockoutEventQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = lleq.Limit(2).IDs(setContextOp(ctx, lleq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{loginlockoutevent.Label}
+	default:
+		err = &NotSingularError{loginlockoutevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lleq *LoginLockoutEventQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := lleq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LoginLockoutEvents.
+func (lleq *LoginLockoutEventQuery) All(ctx context.Context) ([]*LoginLockoutEvent, error) {
+	ctx = setContextOp(ctx, lleq.ctx, "All")
+	if err := lleq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LoginLockoutEvent, *LoginLockoutEventQuery]()
+	return withInterceptors[[]*LoginLockoutEvent](ctx, lleq, qr, lleq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lleq *LoginLockoutEventQuery) AllX(ctx context.Context) []*LoginLockoutEvent {
+	nodes, err := lleq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LoginLockoutEvent IDs.
+func (lleq *LoginLockoutEventQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if lleq.ctx.Unique == nil && lleq.path != nil {
+		lleq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lleq.ctx, "IDs")
+	if err = lleq.Select(loginlockoutevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lleq *LoginLockoutEventQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := lleq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lleq *LoginLockoutEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lleq.ctx, "Count")
+	if err := lleq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lleq, querierCount[*LoginLockoutEventQuery](), lleq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lleq *LoginLockoutEventQuery) CountX(ctx context.Context) int {
+	count, err := lleq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lleq *LoginLockoutEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lleq.ctx, "Exist")
+	switch _, err := lleq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lleq *LoginLockoutEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := lleq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LoginLockoutEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lleq *LoginLockoutEventQuery) Clone() *LoginLockoutEventQuery {
+	if lleq == nil {
+		return nil
+	}
+	return &LoginLockoutEventQuery{
+		config:     lleq.config,
+		ctx:        lleq.ctx.Clone(),
+		order:      append([]OrderFunc{}, lleq.order...),
+		inters:     append([]Interceptor{}, lleq.inters...),
+		predicates: append([]predicate.LoginLockoutEvent{}, lleq.predicates...),
+		withOwner:  lleq.withOwner.Clone(),
+		// clone intermediate query.
+		sql:  lleq.sql.Clone(),
+		path: lleq.path,
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (lleq *LoginLockoutEventQuery) WithOwner(opts ...func(*UserQuery)) *LoginLockoutEventQuery {
+	query := (&UserClient{config: lleq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lleq.withOwner = query
+	return lleq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LoginLockoutEvent.Query().
+//		GroupBy(loginlockoutevent.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lleq *LoginLockoutEventQuery) GroupBy(field string, fields ...string) *LoginLockoutEventGroupBy {
+	lleq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LoginLockoutEventGroupBy{build: lleq}
+	grbuild.flds = &lleq.ctx.Fields
+	grbuild.label = loginlockoutevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//	}
+//
+//	client.LoginLockoutEvent.Query().
+//		Select(loginlockoutevent.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (lleq *LoginLockoutEventQuery) Select(fields ...string) *LoginLockoutEventSelect {
+	lleq.ctx.Fields = append(lleq.ctx.Fields, fields...)
+	sbuild := &LoginLockoutEventSelect{LoginLockoutEventQuery: lleq}
+	sbuild.label = loginlockoutevent.Label
+	sbuild.flds, sbuild.scan = &lleq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LoginLockoutEventSelect configured with the given aggregations.
+func (lleq *LoginLockoutEventQuery) Aggregate(fns ...AggregateFunc) *LoginLockoutEventSelect {
+	return lleq.Select().Aggregate(fns...)
+}
+
+func (lleq *LoginLockoutEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lleq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lleq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lleq.ctx.Fields {
+		if !loginlockoutevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lleq.path != nil {
+		prev, err := lleq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lleq.sql = prev
+	}
+	return nil
+}
+
+func (lleq *LoginLockoutEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LoginLockoutEvent, error) {
+	var (
+		nodes       = []*LoginLockoutEvent{}
+		_spec       = lleq.querySpec()
+		loadedTypes = [1]bool{
+			lleq.withOwner != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LoginLockoutEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LoginLockoutEvent{config: lleq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(lleq.modifiers) > 0 {
+		_spec.Modifiers = lleq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lleq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := lleq.withOwner; query != nil {
+		if err := lleq.loadOwner(ctx, query, nodes, nil,
+			func(n *LoginLockoutEvent, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (lleq *LoginLockoutEventQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*LoginLockoutEvent, init func(*LoginLockoutEvent), assign func(*LoginLockoutEvent, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*LoginLockoutEvent)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (lleq *LoginLockoutEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lleq.querySpec()
+	if len(lleq.modifiers) > 0 {
+		_spec.Modifiers = lleq.modifiers
+	}
+	_spec.Node.Columns = lleq.ctx.Fields
+	if len(lleq.ctx.Fields) > 0 {
+		_spec.Unique = lleq.ctx.Unique != nil && *lleq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lleq.driver, _spec)
+}
+
+func (lleq *LoginLockoutEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(loginlockoutevent.Table, loginlockoutevent.Columns, sqlgraph.NewFieldSpec(loginlockoutevent.FieldID, field.TypeUUID))
+	_spec.From = lleq.sql
+	if unique := lleq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lleq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lleq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginlockoutevent.FieldID)
+		for i := range fields {
+			if fields[i] != loginlockoutevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lleq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lleq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lleq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lleq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lleq *LoginLockoutEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lleq.driver.Dialect())
+	t1 := builder.Table(loginlockoutevent.Table)
+	columns := lleq.ctx.Fields
+	if len(columns) == 0 {
+		columns = loginlockoutevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lleq.sql != nil {
+		selector = lleq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lleq.ctx.Unique != nil && *lleq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range lleq.modifiers {
+		m(selector)
+	}
+	for _, p := range lleq.predicates {
+		p(selector)
+	}
+	for _, p := range lleq.order {
+		p(selector)
+	}
+	if offset := lleq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lleq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (lleq *LoginLockoutEventQuery) ForUpdate(opts ...sql.LockOption) *LoginLockoutEventQuery {
+	if lleq.driver.Dialect() == dialect.Postgres {
+		lleq.Unique(false)
+	}
+	lleq.modifiers = append(lleq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return lleq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (lleq *LoginLockoutEventQuery) ForShare(opts ...sql.LockOption) *LoginLockoutEventQuery {
+	if lleq.driver.Dialect() == dialect.Postgres {
+		lleq.Unique(false)
+	}
+	lleq.modifiers = append(lleq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return lleq
+}
+
+// LoginLockoutEventGroupBy is the group-by builder for LoginLockoutEvent entities.
+type LoginLockoutEventGroupBy struct {
+	selector
+	build *LoginLockoutEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (llegb *LoginLockoutEventGroupBy) Aggregate(fns ...AggregateFunc) *LoginLockoutEventGroupBy {
+	llegb.fns = append(llegb.fns, fns...)
+	return llegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (llegb *LoginLockoutEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, llegb.build.ctx, "GroupBy")
+	if err := llegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoginLockoutEventQuery, *LoginLockoutEventGroupBy](ctx, llegb.build, llegb, llegb.build.inters, v)
+}
+
+func (llegb *LoginLockoutEventGroupBy) sqlScan(ctx context.Context, root *LoginLockoutEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(llegb.fns))
+	for _, fn := range llegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*llegb.flds)+len(llegb.fns))
+		for _, f := range *llegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*llegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := llegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LoginLockoutEventSelect is the builder for selecting fields of LoginLockoutEvent entities.
+type LoginLockoutEventSelect struct {
+	*LoginLockoutEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (lles *LoginLockoutEventSelect) Aggregate(fns ...AggregateFunc) *LoginLockoutEventSelect {
+	lles.fns = append(lles.fns, fns...)
+	return lles
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lles *LoginLockoutEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lles.ctx, "Select")
+	if err := lles.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LoginLockoutEventQuery, *LoginLockoutEventSelect](ctx, lles.LoginLockoutEventQuery, lles, lles.inters, v)
+}
+
+func (lles *LoginLockoutEventSelect) sqlScan(ctx context.Context, root *LoginLockoutEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(lles.fns))
+	for _, fn := range lles.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*lles.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lles.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
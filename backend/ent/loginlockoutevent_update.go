@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/loginlockoutevent"
+	"sthl/ent/predicate"
+	"sthl/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LoginLockoutEventUpdate is the builder for updating LoginLockoutEvent entities.
+type LoginLockoutEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginLockoutEventMutation
+}
+
+// Where appends a list predicates to the LoginLockoutEventUpdate builder.
+func (lleu *LoginLockoutEventUpdate) Where(ps ...predicate.LoginLockoutEvent) *LoginLockoutEventUpdate {
+	lleu.mutation.Where(ps...)
+	return lleu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lleu *LoginLockoutEventUpdate) SetUpdatedAt(t time.Time) *LoginLockoutEventUpdate {
+	lleu.mutation.SetUpdatedAt(t)
+	return lleu
+}
+
+// SetUserID sets the "user_id" field.
+func (lleu *LoginLockoutEventUpdate) SetUserID(u uuid.UUID) *LoginLockoutEventUpdate {
+	lleu.mutation.SetUserID(u)
+	return lleu
+}
+
+// SetScope sets the "scope" field.
+func (lleu *LoginLockoutEventUpdate) SetScope(s string) *LoginLockoutEventUpdate {
+	lleu.mutation.SetScope(s)
+	return lleu
+}
+
+// SetIP sets the "ip" field.
+func (lleu *LoginLockoutEventUpdate) SetIP(s string) *LoginLockoutEventUpdate {
+	lleu.mutation.SetIP(s)
+	return lleu
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (lleu *LoginLockoutEventUpdate) SetNillableIP(s *string) *LoginLockoutEventUpdate {
+	if s != nil {
+		lleu.SetIP(*s)
+	}
+	return lleu
+}
+
+// SetFailures sets the "failures" field.
+func (lleu *LoginLockoutEventUpdate) SetFailures(i int) *LoginLockoutEventUpdate {
+	lleu.mutation.ResetFailures()
+	lleu.mutation.SetFailures(i)
+	return lleu
+}
+
+// AddFailures adds i to the "failures" field.
+func (lleu *LoginLockoutEventUpdate) AddFailures(i int) *LoginLockoutEventUpdate {
+	lleu.mutation.AddFailures(i)
+	return lleu
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (lleu *LoginLockoutEventUpdate) SetLockedUntil(t time.Time) *LoginLockoutEventUpdate {
+	lleu.mutation.SetLockedUntil(t)
+	return lleu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (lleu *LoginLockoutEventUpdate) SetOwnerID(id uuid.UUID) *LoginLockoutEventUpdate {
+	lleu.mutation.SetOwnerID(id)
+	return lleu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (lleu *LoginLockoutEventUpdate) SetOwner(u *User) *LoginLockoutEventUpdate {
+	return lleu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the LoginLockoutEventMutation object of the builder.
+func (lleu *LoginLockoutEventUpdate) Mutation() *LoginLockoutEventMutation {
+	return lleu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (lleu *LoginLockoutEventUpdate) ClearOwner() *LoginLockoutEventUpdate {
+	lleu.mutation.ClearOwner()
+	return lleu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lleu *LoginLockoutEventUpdate) Save(ctx context.Context) (int, error) {
+	lleu.defaults()
+	return withHooks[int, LoginLockoutEventMutation](ctx, lleu.sqlSave, lleu.mutation, lleu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lleu *LoginLockoutEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := lleu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lleu *LoginLockoutEventUpdate) Exec(ctx context.Context) error {
+	_, err := lleu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lleu *LoginLockoutEventUpdate) ExecX(ctx context.Context) {
+	if err := lleu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lleu *LoginLockoutEventUpdate) defaults() {
+	if _, ok := lleu.mutation.UpdatedAt(); !ok {
+		v := loginlockoutevent.UpdateDefaultUpdatedAt()
+		lleu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lleu *LoginLockoutEventUpdate) check() error {
+	if v, ok := lleu.mutation.Scope(); ok {
+		if err := loginlockoutevent.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "LoginLockoutEvent.scope": %w`, err)}
+		}
+	}
+	if v, ok := lleu.mutation.IP(); ok {
+		if err := loginlockoutevent.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "LoginLockoutEvent.ip": %w`, err)}
+		}
+	}
+	if v, ok := lleu.mutation.Failures(); ok {
+		if err := loginlockoutevent.FailuresValidator(v); err != nil {
+			return &ValidationError{Name: "failures", err: fmt.Errorf(`ent: validator failed for field "LoginLockoutEvent.failures": %w`, err)}
+		}
+	}
+	if _, ok := lleu.mutation.OwnerID(); lleu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LoginLockoutEvent.owner"`)
+	}
+	return nil
+}
+
+func (lleu *LoginLockoutEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lleu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginlockoutevent.Table, loginlockoutevent.Columns, sqlgraph.NewFieldSpec(loginlockoutevent.FieldID, field.TypeUUID))
+	if ps := lleu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lleu.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginlockoutevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := lleu.mutation.Scope(); ok {
+		_spec.SetField(loginlockoutevent.FieldScope, field.TypeString, value)
+	}
+	if value, ok := lleu.mutation.IP(); ok {
+		_spec.SetField(loginlockoutevent.FieldIP, field.TypeString, value)
+	}
+	if value, ok := lleu.mutation.Failures(); ok {
+		_spec.SetField(loginlockoutevent.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := lleu.mutation.AddedFailures(); ok {
+		_spec.AddField(loginlockoutevent.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := lleu.mutation.LockedUntil(); ok {
+		_spec.SetField(loginlockoutevent.FieldLockedUntil, field.TypeTime, value)
+	}
+	if lleu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loginlockoutevent.OwnerTable,
+			Columns: []string{loginlockoutevent.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lleu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loginlockoutevent.OwnerTable,
+			Columns: []string{loginlockoutevent.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lleu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginlockoutevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lleu.mutation.done = true
+	return n, nil
+}
+
+// LoginLockoutEventUpdateOne is the builder for updating a single LoginLockoutEvent entity.
+type LoginLockoutEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginLockoutEventMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lleuo *LoginLockoutEventUpdateOne) SetUpdatedAt(t time.Time) *LoginLockoutEventUpdateOne {
+	lleuo.mutation.SetUpdatedAt(t)
+	return lleuo
+}
+
+// SetUserID sets the "user_id" field.
+func (lleuo *LoginLockoutEventUpdateOne) SetUserID(u uuid.UUID) *LoginLockoutEventUpdateOne {
+	lleuo.mutation.SetUserID(u)
+	return lleuo
+}
+
+// SetScope sets the "scope" field.
+func (lleuo *LoginLockoutEventUpdateOne) SetScope(s string) *LoginLockoutEventUpdateOne {
+	lleuo.mutation.SetScope(s)
+	return lleuo
+}
+
+// SetIP sets the "ip" field.
+func (lleuo *LoginLockoutEventUpdateOne) SetIP(s string) *LoginLockoutEventUpdateOne {
+	lleuo.mutation.SetIP(s)
+	return lleuo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (lleuo *LoginLockoutEventUpdateOne) SetNillableIP(s *string) *LoginLockoutEventUpdateOne {
+	if s != nil {
+		lleuo.SetIP(*s)
+	}
+	return lleuo
+}
+
+// SetFailures sets the "failures" field.
+func (lleuo *LoginLockoutEventUpdateOne) SetFailures(i int) *LoginLockoutEventUpdateOne {
+	lleuo.mutation.ResetFailures()
+	lleuo.mutation.SetFailures(i)
+	return lleuo
+}
+
+// AddFailures adds i to the "failures" field.
+func (lleuo *LoginLockoutEventUpdateOne) AddFailures(i int) *LoginLockoutEventUpdateOne {
+	lleuo.mutation.AddFailures(i)
+	return lleuo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (lleuo *LoginLockoutEventUpdateOne) SetLockedUntil(t time.Time) *LoginLockoutEventUpdateOne {
+	lleuo.mutation.SetLockedUntil(t)
+	return lleuo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (lleuo *LoginLockoutEventUpdateOne) SetOwnerID(id uuid.UUID) *LoginLockoutEventUpdateOne {
+	lleuo.mutation.SetOwnerID(id)
+	return lleuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (lleuo *LoginLockoutEventUpdateOne) SetOwner(u *User) *LoginLockoutEventUpdateOne {
+	return lleuo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the LoginLockoutEventMutation object of the builder.
+func (lleuo *LoginLockoutEventUpdateOne) Mutation() *LoginLockoutEventMutation {
+	return lleuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (lleuo *LoginLockoutEventUpdateOne) ClearOwner() *LoginLockoutEventUpdateOne {
+	lleuo.mutation.ClearOwner()
+	return lleuo
+}
+
+// Where appends a list predicates to the LoginLockoutEventUpdate builder.
+func (lleuo *LoginLockoutEventUpdateOne) Where(ps ...predicate.LoginLockoutEvent) *LoginLockoutEventUpdateOne {
+	lleuo.mutation.Where(ps...)
+	return lleuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lleuo *LoginLockoutEventUpdateOne) Select(field string, fields ...string) *LoginLockoutEventUpdateOne {
+	lleuo.fields = append([]string{field}, fields...)
+	return lleuo
+}
+
+// Save executes the query and returns the updated LoginLockoutEvent entity.
+func (lleuo *LoginLockoutEventUpdateOne) Save(ctx context.Context) (*LoginLockoutEvent, error) {
+	lleuo.defaults()
+	return withHooks[*LoginLockoutEvent, LoginLockoutEventMutation](ctx, lleuo.sqlSave, lleuo.mutation, lleuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lleuo *LoginLockoutEventUpdateOne) SaveX(ctx context.Context) *LoginLockoutEvent {
+	node, err := lleuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lleuo *LoginLockoutEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := lleuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lleuo *LoginLockoutEventUpdateOne) ExecX(ctx context.Context) {
+	if err := lleuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lleuo *LoginLockoutEventUpdateOne) defaults() {
+	if _, ok := lleuo.mutation.UpdatedAt(); !ok {
+		v := loginlockoutevent.UpdateDefaultUpdatedAt()
+		lleuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lleuo *LoginLockoutEventUpdateOne) check() error {
+	if v, ok := lleuo.mutation.Scope(); ok {
+		if err := loginlockoutevent.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "LoginLockoutEvent.scope": %w`, err)}
+		}
+	}
+	if v, ok := lleuo.mutation.IP(); ok {
+		if err := loginlockoutevent.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "LoginLockoutEvent.ip": %w`, err)}
+		}
+	}
+	if v, ok := lleuo.mutation.Failures(); ok {
+		if err := loginlockoutevent.FailuresValidator(v); err != nil {
+			return &ValidationError{Name: "failures", err: fmt.Errorf(`ent: validator failed for field "LoginLockoutEvent.failures": %w`, err)}
+		}
+	}
+	if _, ok := lleuo.mutation.OwnerID(); lleuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "LoginLockoutEvent.owner"`)
+	}
+	return nil
+}
+
+func (lleuo *LoginLockoutEventUpdateOne) sqlSave(ctx context.Context) (_node *LoginLockoutEvent, err error) {
+	if err := lleuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginlockoutevent.Table, loginlockoutevent.Columns, sqlgraph.NewFieldSpec(loginlockoutevent.FieldID, field.TypeUUID))
+	id, ok := lleuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginLockoutEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lleuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginlockoutevent.FieldID)
+		for _, f := range fields {
+			if !loginlockoutevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginlockoutevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lleuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lleuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginlockoutevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := lleuo.mutation.Scope(); ok {
+		_spec.SetField(loginlockoutevent.FieldScope, field.TypeString, value)
+	}
+	if value, ok := lleuo.mutation.IP(); ok {
+		_spec.SetField(loginlockoutevent.FieldIP, field.TypeString, value)
+	}
+	if value, ok := lleuo.mutation.Failures(); ok {
+		_spec.SetField(loginlockoutevent.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := lleuo.mutation.AddedFailures(); ok {
+		_spec.AddField(loginlockoutevent.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := lleuo.mutation.LockedUntil(); ok {
+		_spec.SetField(loginlockoutevent.FieldLockedUntil, field.TypeTime, value)
+	}
+	if lleuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loginlockoutevent.OwnerTable,
+			Columns: []string{loginlockoutevent.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lleuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loginlockoutevent.OwnerTable,
+			Columns: []string{loginlockoutevent.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LoginLockoutEvent{config: lleuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lleuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginlockoutevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lleuo.mutation.done = true
+	return _node, nil
+}
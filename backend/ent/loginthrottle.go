@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sthl/ent/loginthrottle"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// LoginThrottle is the model entity for the LoginThrottle schema.
+type LoginThrottle struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key"`
+	// Failures holds the value of the "failures" field.
+	Failures int `json:"failures"`
+	// LastFailedAt holds the value of the "last_failed_at" field.
+	LastFailedAt time.Time `json:"lastFailedAt"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil *time.Time `json:"lockedUntil"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginThrottle) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginthrottle.FieldFailures:
+			values[i] = new(sql.NullInt64)
+		case loginthrottle.FieldKey:
+			values[i] = new(sql.NullString)
+		case loginthrottle.FieldCreatedAt, loginthrottle.FieldUpdatedAt, loginthrottle.FieldLastFailedAt, loginthrottle.FieldLockedUntil:
+			values[i] = new(sql.NullTime)
+		case loginthrottle.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type LoginThrottle", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginThrottle fields.
+func (lt *LoginThrottle) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginthrottle.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				lt.ID = *value
+			}
+		case loginthrottle.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				lt.CreatedAt = value.Time
+			}
+		case loginthrottle.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				lt.UpdatedAt = value.Time
+			}
+		case loginthrottle.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				lt.Key = value.String
+			}
+		case loginthrottle.FieldFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failures", values[i])
+			} else if value.Valid {
+				lt.Failures = int(value.Int64)
+			}
+		case loginthrottle.FieldLastFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failed_at", values[i])
+			} else if value.Valid {
+				lt.LastFailedAt = value.Time
+			}
+		case loginthrottle.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				lt.LockedUntil = new(time.Time)
+				*lt.LockedUntil = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this LoginThrottle.
+// Note that you need to call LoginThrottle.Unwrap() before calling this method if this LoginThrottle
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (lt *LoginThrottle) Update() *LoginThrottleUpdateOne {
+	return NewLoginThrottleClient(lt.config).UpdateOne(lt)
+}
+
+// Unwrap unwraps the LoginThrottle entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (lt *LoginThrottle) Unwrap() *LoginThrottle {
+	_tx, ok := lt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginThrottle is not a transactional entity")
+	}
+	lt.config.driver = _tx.drv
+	return lt
+}
+
+// String implements the fmt.Stringer.
+func (lt *LoginThrottle) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginThrottle(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", lt.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(lt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(lt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(lt.Key)
+	builder.WriteString(", ")
+	builder.WriteString("failures=")
+	builder.WriteString(fmt.Sprintf("%v", lt.Failures))
+	builder.WriteString(", ")
+	builder.WriteString("last_failed_at=")
+	builder.WriteString(lt.LastFailedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := lt.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginThrottles is a parsable slice of LoginThrottle.
+type LoginThrottles []*LoginThrottle
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sthl/ent/loginthrottle"
+	"sthl/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginThrottleDelete is the builder for deleting a LoginThrottle entity.
+type LoginThrottleDelete struct {
+	config
+	hooks    []Hook
+	mutation *LoginThrottleMutation
+}
+
+// Where appends a list predicates to the LoginThrottleDelete builder.
+func (ltd *LoginThrottleDelete) Where(ps ...predicate.LoginThrottle) *LoginThrottleDelete {
+	ltd.mutation.Where(ps...)
+	return ltd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ltd *LoginThrottleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, LoginThrottleMutation](ctx, ltd.sqlExec, ltd.mutation, ltd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltd *LoginThrottleDelete) ExecX(ctx context.Context) int {
+	n, err := ltd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ltd *LoginThrottleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(loginthrottle.Table, sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeUUID))
+	if ps := ltd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ltd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ltd.mutation.done = true
+	return affected, err
+}
+
+// LoginThrottleDeleteOne is the builder for deleting a single LoginThrottle entity.
+type LoginThrottleDeleteOne struct {
+	ltd *LoginThrottleDelete
+}
+
+// Where appends a list predicates to the LoginThrottleDelete builder.
+func (ltdo *LoginThrottleDeleteOne) Where(ps ...predicate.LoginThrottle) *LoginThrottleDeleteOne {
+	ltdo.ltd.mutation.Where(ps...)
+	return ltdo
+}
+
+// Exec executes the deletion query.
+func (ltdo *LoginThrottleDeleteOne) Exec(ctx context.Context) error {
+	n, err := ltdo.ltd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{loginthrottle.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltdo *LoginThrottleDeleteOne) ExecX(ctx context.Context) {
+	if err := ltdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/loginthrottle"
+	"sthl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginThrottleUpdate is the builder for updating LoginThrottle entities.
+type LoginThrottleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginThrottleMutation
+}
+
+// Where appends a list predicates to the LoginThrottleUpdate builder.
+func (ltu *LoginThrottleUpdate) Where(ps ...predicate.LoginThrottle) *LoginThrottleUpdate {
+	ltu.mutation.Where(ps...)
+	return ltu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ltu *LoginThrottleUpdate) SetUpdatedAt(t time.Time) *LoginThrottleUpdate {
+	ltu.mutation.SetUpdatedAt(t)
+	return ltu
+}
+
+// SetKey sets the "key" field.
+func (ltu *LoginThrottleUpdate) SetKey(s string) *LoginThrottleUpdate {
+	ltu.mutation.SetKey(s)
+	return ltu
+}
+
+// SetFailures sets the "failures" field.
+func (ltu *LoginThrottleUpdate) SetFailures(i int) *LoginThrottleUpdate {
+	ltu.mutation.ResetFailures()
+	ltu.mutation.SetFailures(i)
+	return ltu
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (ltu *LoginThrottleUpdate) SetNillableFailures(i *int) *LoginThrottleUpdate {
+	if i != nil {
+		ltu.SetFailures(*i)
+	}
+	return ltu
+}
+
+// AddFailures adds i to the "failures" field.
+func (ltu *LoginThrottleUpdate) AddFailures(i int) *LoginThrottleUpdate {
+	ltu.mutation.AddFailures(i)
+	return ltu
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (ltu *LoginThrottleUpdate) SetLastFailedAt(t time.Time) *LoginThrottleUpdate {
+	ltu.mutation.SetLastFailedAt(t)
+	return ltu
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (ltu *LoginThrottleUpdate) SetLockedUntil(t time.Time) *LoginThrottleUpdate {
+	ltu.mutation.SetLockedUntil(t)
+	return ltu
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (ltu *LoginThrottleUpdate) SetNillableLockedUntil(t *time.Time) *LoginThrottleUpdate {
+	if t != nil {
+		ltu.SetLockedUntil(*t)
+	}
+	return ltu
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (ltu *LoginThrottleUpdate) ClearLockedUntil() *LoginThrottleUpdate {
+	ltu.mutation.ClearLockedUntil()
+	return ltu
+}
+
+// Mutation returns the LoginThrottleMutation object of the builder.
+func (ltu *LoginThrottleUpdate) Mutation() *LoginThrottleMutation {
+	return ltu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ltu *LoginThrottleUpdate) Save(ctx context.Context) (int, error) {
+	ltu.defaults()
+	return withHooks[int, LoginThrottleMutation](ctx, ltu.sqlSave, ltu.mutation, ltu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ltu *LoginThrottleUpdate) SaveX(ctx context.Context) int {
+	affected, err := ltu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ltu *LoginThrottleUpdate) Exec(ctx context.Context) error {
+	_, err := ltu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltu *LoginThrottleUpdate) ExecX(ctx context.Context) {
+	if err := ltu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ltu *LoginThrottleUpdate) defaults() {
+	if _, ok := ltu.mutation.UpdatedAt(); !ok {
+		v := loginthrottle.UpdateDefaultUpdatedAt()
+		ltu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ltu *LoginThrottleUpdate) check() error {
+	if v, ok := ltu.mutation.Key(); ok {
+		if err := loginthrottle.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "LoginThrottle.key": %w`, err)}
+		}
+	}
+	if v, ok := ltu.mutation.Failures(); ok {
+		if err := loginthrottle.FailuresValidator(v); err != nil {
+			return &ValidationError{Name: "failures", err: fmt.Errorf(`ent: validator failed for field "LoginThrottle.failures": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ltu *LoginThrottleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ltu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginthrottle.Table, loginthrottle.Columns, sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeUUID))
+	if ps := ltu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ltu.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginthrottle.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ltu.mutation.Key(); ok {
+		_spec.SetField(loginthrottle.FieldKey, field.TypeString, value)
+	}
+	if value, ok := ltu.mutation.Failures(); ok {
+		_spec.SetField(loginthrottle.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := ltu.mutation.AddedFailures(); ok {
+		_spec.AddField(loginthrottle.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := ltu.mutation.LastFailedAt(); ok {
+		_spec.SetField(loginthrottle.FieldLastFailedAt, field.TypeTime, value)
+	}
+	if value, ok := ltu.mutation.LockedUntil(); ok {
+		_spec.SetField(loginthrottle.FieldLockedUntil, field.TypeTime, value)
+	}
+	if ltu.mutation.LockedUntilCleared() {
+		_spec.ClearField(loginthrottle.FieldLockedUntil, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ltu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginthrottle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ltu.mutation.done = true
+	return n, nil
+}
+
+// LoginThrottleUpdateOne is the builder for updating a single LoginThrottle entity.
+type LoginThrottleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginThrottleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ltuo *LoginThrottleUpdateOne) SetUpdatedAt(t time.Time) *LoginThrottleUpdateOne {
+	ltuo.mutation.SetUpdatedAt(t)
+	return ltuo
+}
+
+// SetKey sets the "key" field.
+func (ltuo *LoginThrottleUpdateOne) SetKey(s string) *LoginThrottleUpdateOne {
+	ltuo.mutation.SetKey(s)
+	return ltuo
+}
+
+// SetFailures sets the "failures" field.
+func (ltuo *LoginThrottleUpdateOne) SetFailures(i int) *LoginThrottleUpdateOne {
+	ltuo.mutation.ResetFailures()
+	ltuo.mutation.SetFailures(i)
+	return ltuo
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (ltuo *LoginThrottleUpdateOne) SetNillableFailures(i *int) *LoginThrottleUpdateOne {
+	if i != nil {
+		ltuo.SetFailures(*i)
+	}
+	return ltuo
+}
+
+// AddFailures adds i to the "failures" field.
+func (ltuo *LoginThrottleUpdateOne) AddFailures(i int) *LoginThrottleUpdateOne {
+	ltuo.mutation.AddFailures(i)
+	return ltuo
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (ltuo *LoginThrottleUpdateOne) SetLastFailedAt(t time.Time) *LoginThrottleUpdateOne {
+	ltuo.mutation.SetLastFailedAt(t)
+	return ltuo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (ltuo *LoginThrottleUpdateOne) SetLockedUntil(t time.Time) *LoginThrottleUpdateOne {
+	ltuo.mutation.SetLockedUntil(t)
+	return ltuo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (ltuo *LoginThrottleUpdateOne) SetNillableLockedUntil(t *time.Time) *LoginThrottleUpdateOne {
+	if t != nil {
+		ltuo.SetLockedUntil(*t)
+	}
+	return ltuo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (ltuo *LoginThrottleUpdateOne) ClearLockedUntil() *LoginThrottleUpdateOne {
+	ltuo.mutation.ClearLockedUntil()
+	return ltuo
+}
+
+// Mutation returns the LoginThrottleMutation object of the builder.
+func (ltuo *LoginThrottleUpdateOne) Mutation() *LoginThrottleMutation {
+	return ltuo.mutation
+}
+
+// Where appends a list predicates to the LoginThrottleUpdate builder.
+func (ltuo *LoginThrottleUpdateOne) Where(ps ...predicate.LoginThrottle) *LoginThrottleUpdateOne {
+	ltuo.mutation.Where(ps...)
+	return ltuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ltuo *LoginThrottleUpdateOne) Select(field string, fields ...string) *LoginThrottleUpdateOne {
+	ltuo.fields = append([]string{field}, fields...)
+	return ltuo
+}
+
+// Save executes the query and returns the updated LoginThrottle entity.
+func (ltuo *LoginThrottleUpdateOne) Save(ctx context.Context) (*LoginThrottle, error) {
+	ltuo.defaults()
+	return withHooks[*LoginThrottle, LoginThrottleMutation](ctx, ltuo.sqlSave, ltuo.mutation, ltuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ltuo *LoginThrottleUpdateOne) SaveX(ctx context.Context) *LoginThrottle {
+	node, err := ltuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ltuo *LoginThrottleUpdateOne) Exec(ctx context.Context) error {
+	_, err := ltuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ltuo *LoginThrottleUpdateOne) ExecX(ctx context.Context) {
+	if err := ltuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ltuo *LoginThrottleUpdateOne) defaults() {
+	if _, ok := ltuo.mutation.UpdatedAt(); !ok {
+		v := loginthrottle.UpdateDefaultUpdatedAt()
+		ltuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ltuo *LoginThrottleUpdateOne) check() error {
+	if v, ok := ltuo.mutation.Key(); ok {
+		if err := loginthrottle.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "LoginThrottle.key": %w`, err)}
+		}
+	}
+	if v, ok := ltuo.mutation.Failures(); ok {
+		if err := loginthrottle.FailuresValidator(v); err != nil {
+			return &ValidationError{Name: "failures", err: fmt.Errorf(`ent: validator failed for field "LoginThrottle.failures": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ltuo *LoginThrottleUpdateOne) sqlSave(ctx context.Context) (_node *LoginThrottle, err error) {
+	if err := ltuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginthrottle.Table, loginthrottle.Columns, sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeUUID))
+	id, ok := ltuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginThrottle.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ltuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginthrottle.FieldID)
+		for _, f := range fields {
+			if !loginthrottle.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginthrottle.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ltuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ltuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginthrottle.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ltuo.mutation.Key(); ok {
+		_spec.SetField(loginthrottle.FieldKey, field.TypeString, value)
+	}
+	if value, ok := ltuo.mutation.Failures(); ok {
+		_spec.SetField(loginthrottle.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := ltuo.mutation.AddedFailures(); ok {
+		_spec.AddField(loginthrottle.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := ltuo.mutation.LastFailedAt(); ok {
+		_spec.SetField(loginthrottle.FieldLastFailedAt, field.TypeTime, value)
+	}
+	if value, ok := ltuo.mutation.LockedUntil(); ok {
+		_spec.SetField(loginthrottle.FieldLockedUntil, field.TypeTime, value)
+	}
+	if ltuo.mutation.LockedUntilCleared() {
+		_spec.ClearField(loginthrottle.FieldLockedUntil, field.TypeTime)
+	}
+	_node = &LoginThrottle{config: ltuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ltuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginthrottle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ltuo.mutation.done = true
+	return _node, nil
+}
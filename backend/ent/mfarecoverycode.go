@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sthl/ent/mfarecoverycode"
+	"sthl/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// MfaRecoveryCode is the model entity for the MfaRecoveryCode schema.
+type MfaRecoveryCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"userId"`
+	// CodeHash holds the value of the "code_hash" field.
+	CodeHash string `json:"-"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt *time.Time `json:"usedAt"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MfaRecoveryCodeQuery when eager-loading is set.
+	Edges MfaRecoveryCodeEdges `json:"-"`
+}
+
+// MfaRecoveryCodeEdges holds the relations/edges for other nodes in the graph.
+type MfaRecoveryCodeEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MfaRecoveryCodeEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MfaRecoveryCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mfarecoverycode.FieldCodeHash:
+			values[i] = new(sql.NullString)
+		case mfarecoverycode.FieldCreatedAt, mfarecoverycode.FieldUpdatedAt, mfarecoverycode.FieldUsedAt:
+			values[i] = new(sql.NullTime)
+		case mfarecoverycode.FieldID, mfarecoverycode.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type MfaRecoveryCode", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MfaRecoveryCode fields.
+func (mrc *MfaRecoveryCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mfarecoverycode.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mrc.ID = *value
+			}
+		case mfarecoverycode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mrc.CreatedAt = value.Time
+			}
+		case mfarecoverycode.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mrc.UpdatedAt = value.Time
+			}
+		case mfarecoverycode.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				mrc.UserID = *value
+			}
+		case mfarecoverycode.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				mrc.CodeHash = value.String
+			}
+		case mfarecoverycode.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				mrc.UsedAt = new(time.Time)
+				*mrc.UsedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryOwner queries the "owner" edge of the MfaRecoveryCode entity.
+func (mrc *MfaRecoveryCode) QueryOwner() *UserQuery {
+	return NewMfaRecoveryCodeClient(mrc.config).QueryOwner(mrc)
+}
+
+// Update returns a builder for updating this MfaRecoveryCode.
+// Note that you need to call MfaRecoveryCode.Unwrap() before calling this method if this MfaRecoveryCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mrc *MfaRecoveryCode) Update() *MfaRecoveryCodeUpdateOne {
+	return NewMfaRecoveryCodeClient(mrc.config).UpdateOne(mrc)
+}
+
+// Unwrap unwraps the MfaRecoveryCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mrc *MfaRecoveryCode) Unwrap() *MfaRecoveryCode {
+	_tx, ok := mrc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MfaRecoveryCode is not a transactional entity")
+	}
+	mrc.config.driver = _tx.drv
+	return mrc
+}
+
+// String implements the fmt.Stringer.
+func (mrc *MfaRecoveryCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("MfaRecoveryCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mrc.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(mrc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mrc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", mrc.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("code_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := mrc.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MfaRecoveryCodes is a parsable slice of MfaRecoveryCode.
+type MfaRecoveryCodes []*MfaRecoveryCode
@@ -0,0 +1,765 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/mfarecoverycode"
+	"sthl/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MfaRecoveryCodeCreate is the builder for creating a MfaRecoveryCode entity.
+type MfaRecoveryCodeCreate struct {
+	config
+	mutation *MfaRecoveryCodeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mrcc *MfaRecoveryCodeCreate) SetCreatedAt(t time.Time) *MfaRecoveryCodeCreate {
+	mrcc.mutation.SetCreatedAt(t)
+	return mrcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mrcc *MfaRecoveryCodeCreate) SetNillableCreatedAt(t *time.Time) *MfaRecoveryCodeCreate {
+	if t != nil {
+		mrcc.SetCreatedAt(*t)
+	}
+	return mrcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mrcc *MfaRecoveryCodeCreate) SetUpdatedAt(t time.Time) *MfaRecoveryCodeCreate {
+	mrcc.mutation.SetUpdatedAt(t)
+	return mrcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mrcc *MfaRecoveryCodeCreate) SetNillableUpdatedAt(t *time.Time) *MfaRecoveryCodeCreate {
+	if t != nil {
+		mrcc.SetUpdatedAt(*t)
+	}
+	return mrcc
+}
+
+// SetUserID sets the "user_id" field.
+func (mrcc *MfaRecoveryCodeCreate) SetUserID(u uuid.UUID) *MfaRecoveryCodeCreate {
+	mrcc.mutation.SetUserID(u)
+	return mrcc
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (mrcc *MfaRecoveryCodeCreate) SetCodeHash(s string) *MfaRecoveryCodeCreate {
+	mrcc.mutation.SetCodeHash(s)
+	return mrcc
+}
+
+// SetUsedAt sets the "used_at" field.
+func (mrcc *MfaRecoveryCodeCreate) SetUsedAt(t time.Time) *MfaRecoveryCodeCreate {
+	mrcc.mutation.SetUsedAt(t)
+	return mrcc
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (mrcc *MfaRecoveryCodeCreate) SetNillableUsedAt(t *time.Time) *MfaRecoveryCodeCreate {
+	if t != nil {
+		mrcc.SetUsedAt(*t)
+	}
+	return mrcc
+}
+
+// SetID sets the "id" field.
+func (mrcc *MfaRecoveryCodeCreate) SetID(u uuid.UUID) *MfaRecoveryCodeCreate {
+	mrcc.mutation.SetID(u)
+	return mrcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mrcc *MfaRecoveryCodeCreate) SetNillableID(u *uuid.UUID) *MfaRecoveryCodeCreate {
+	if u != nil {
+		mrcc.SetID(*u)
+	}
+	return mrcc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (mrcc *MfaRecoveryCodeCreate) SetOwnerID(id uuid.UUID) *MfaRecoveryCodeCreate {
+	mrcc.mutation.SetOwnerID(id)
+	return mrcc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (mrcc *MfaRecoveryCodeCreate) SetOwner(u *User) *MfaRecoveryCodeCreate {
+	return mrcc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the MfaRecoveryCodeMutation object of the builder.
+func (mrcc *MfaRecoveryCodeCreate) Mutation() *MfaRecoveryCodeMutation {
+	return mrcc.mutation
+}
+
+// Save creates the MfaRecoveryCode in the database.
+func (mrcc *MfaRecoveryCodeCreate) Save(ctx context.Context) (*MfaRecoveryCode, error) {
+	mrcc.defaults()
+	return withHooks[*MfaRecoveryCode, MfaRecoveryCodeMutation](ctx, mrcc.sqlSave, mrcc.mutation, mrcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mrcc *MfaRecoveryCodeCreate) SaveX(ctx context.Context) *MfaRecoveryCode {
+	v, err := mrcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrcc *MfaRecoveryCodeCreate) Exec(ctx context.Context) error {
+	_, err := mrcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcc *MfaRecoveryCodeCreate) ExecX(ctx context.Context) {
+	if err := mrcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mrcc *MfaRecoveryCodeCreate) defaults() {
+	if _, ok := mrcc.mutation.CreatedAt(); !ok {
+		v := mfarecoverycode.DefaultCreatedAt()
+		mrcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mrcc.mutation.UpdatedAt(); !ok {
+		v := mfarecoverycode.DefaultUpdatedAt()
+		mrcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mrcc.mutation.ID(); !ok {
+		v := mfarecoverycode.DefaultID()
+		mrcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mrcc *MfaRecoveryCodeCreate) check() error {
+	if _, ok := mrcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MfaRecoveryCode.created_at"`)}
+	}
+	if _, ok := mrcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MfaRecoveryCode.updated_at"`)}
+	}
+	if _, ok := mrcc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "MfaRecoveryCode.user_id"`)}
+	}
+	if _, ok := mrcc.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "MfaRecoveryCode.code_hash"`)}
+	}
+	if v, ok := mrcc.mutation.CodeHash(); ok {
+		if err := mfarecoverycode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "MfaRecoveryCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := mrcc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "MfaRecoveryCode.owner"`)}
+	}
+	return nil
+}
+
+func (mrcc *MfaRecoveryCodeCreate) sqlSave(ctx context.Context) (*MfaRecoveryCode, error) {
+	if err := mrcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mrcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mrcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mrcc.mutation.id = &_node.ID
+	mrcc.mutation.done = true
+	return _node, nil
+}
+
+func (mrcc *MfaRecoveryCodeCreate) createSpec() (*MfaRecoveryCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MfaRecoveryCode{config: mrcc.config}
+		_spec = sqlgraph.NewCreateSpec(mfarecoverycode.Table, sqlgraph.NewFieldSpec(mfarecoverycode.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mrcc.conflict
+	if id, ok := mrcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mrcc.mutation.CreatedAt(); ok {
+		_spec.SetField(mfarecoverycode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mrcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(mfarecoverycode.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mrcc.mutation.CodeHash(); ok {
+		_spec.SetField(mfarecoverycode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := mrcc.mutation.UsedAt(); ok {
+		_spec.SetField(mfarecoverycode.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if nodes := mrcc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mfarecoverycode.OwnerTable,
+			Columns: []string{mfarecoverycode.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MfaRecoveryCode.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MfaRecoveryCodeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mrcc *MfaRecoveryCodeCreate) OnConflict(opts ...sql.ConflictOption) *MfaRecoveryCodeUpsertOne {
+	mrcc.conflict = opts
+	return &MfaRecoveryCodeUpsertOne{
+		create: mrcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MfaRecoveryCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mrcc *MfaRecoveryCodeCreate) OnConflictColumns(columns ...string) *MfaRecoveryCodeUpsertOne {
+	mrcc.conflict = append(mrcc.conflict, sql.ConflictColumns(columns...))
+	return &MfaRecoveryCodeUpsertOne{
+		create: mrcc,
+	}
+}
+
+type (
+	// MfaRecoveryCodeUpsertOne is the builder for "upsert"-ing
+	//  one MfaRecoveryCode node.
+	MfaRecoveryCodeUpsertOne struct {
+		create *MfaRecoveryCodeCreate
+	}
+
+	// MfaRecoveryCodeUpsert is the "OnConflict" setter.
+	MfaRecoveryCodeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MfaRecoveryCodeUpsert) SetUpdatedAt(v time.Time) *MfaRecoveryCodeUpsert {
+	u.Set(mfarecoverycode.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MfaRecoveryCodeUpsert) UpdateUpdatedAt() *MfaRecoveryCodeUpsert {
+	u.SetExcluded(mfarecoverycode.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MfaRecoveryCodeUpsert) SetUserID(v uuid.UUID) *MfaRecoveryCodeUpsert {
+	u.Set(mfarecoverycode.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MfaRecoveryCodeUpsert) UpdateUserID() *MfaRecoveryCodeUpsert {
+	u.SetExcluded(mfarecoverycode.FieldUserID)
+	return u
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (u *MfaRecoveryCodeUpsert) SetCodeHash(v string) *MfaRecoveryCodeUpsert {
+	u.Set(mfarecoverycode.FieldCodeHash, v)
+	return u
+}
+
+// UpdateCodeHash sets the "code_hash" field to the value that was provided on create.
+func (u *MfaRecoveryCodeUpsert) UpdateCodeHash() *MfaRecoveryCodeUpsert {
+	u.SetExcluded(mfarecoverycode.FieldCodeHash)
+	return u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *MfaRecoveryCodeUpsert) SetUsedAt(v time.Time) *MfaRecoveryCodeUpsert {
+	u.Set(mfarecoverycode.FieldUsedAt, v)
+	return u
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *MfaRecoveryCodeUpsert) UpdateUsedAt() *MfaRecoveryCodeUpsert {
+	u.SetExcluded(mfarecoverycode.FieldUsedAt)
+	return u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *MfaRecoveryCodeUpsert) ClearUsedAt() *MfaRecoveryCodeUpsert {
+	u.SetNull(mfarecoverycode.FieldUsedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MfaRecoveryCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(mfarecoverycode.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MfaRecoveryCodeUpsertOne) UpdateNewValues() *MfaRecoveryCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(mfarecoverycode.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(mfarecoverycode.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MfaRecoveryCode.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MfaRecoveryCodeUpsertOne) Ignore() *MfaRecoveryCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MfaRecoveryCodeUpsertOne) DoNothing() *MfaRecoveryCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MfaRecoveryCodeCreate.OnConflict
+// documentation for more info.
+func (u *MfaRecoveryCodeUpsertOne) Update(set func(*MfaRecoveryCodeUpsert)) *MfaRecoveryCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MfaRecoveryCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MfaRecoveryCodeUpsertOne) SetUpdatedAt(v time.Time) *MfaRecoveryCodeUpsertOne {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MfaRecoveryCodeUpsertOne) UpdateUpdatedAt() *MfaRecoveryCodeUpsertOne {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MfaRecoveryCodeUpsertOne) SetUserID(v uuid.UUID) *MfaRecoveryCodeUpsertOne {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MfaRecoveryCodeUpsertOne) UpdateUserID() *MfaRecoveryCodeUpsertOne {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (u *MfaRecoveryCodeUpsertOne) SetCodeHash(v string) *MfaRecoveryCodeUpsertOne {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.SetCodeHash(v)
+	})
+}
+
+// UpdateCodeHash sets the "code_hash" field to the value that was provided on create.
+func (u *MfaRecoveryCodeUpsertOne) UpdateCodeHash() *MfaRecoveryCodeUpsertOne {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.UpdateCodeHash()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *MfaRecoveryCodeUpsertOne) SetUsedAt(v time.Time) *MfaRecoveryCodeUpsertOne {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *MfaRecoveryCodeUpsertOne) UpdateUsedAt() *MfaRecoveryCodeUpsertOne {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *MfaRecoveryCodeUpsertOne) ClearUsedAt() *MfaRecoveryCodeUpsertOne {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MfaRecoveryCodeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MfaRecoveryCodeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MfaRecoveryCodeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MfaRecoveryCodeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MfaRecoveryCodeUpsertOne.ID is not supported by MySQL driver. Use MfaRecoveryCodeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MfaRecoveryCodeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MfaRecoveryCodeCreateBulk is the builder for creating many MfaRecoveryCode entities in bulk.
+type MfaRecoveryCodeCreateBulk struct {
+	config
+	builders []*MfaRecoveryCodeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MfaRecoveryCode entities in the database.
+func (mrccb *MfaRecoveryCodeCreateBulk) Save(ctx context.Context) ([]*MfaRecoveryCode, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(mrccb.builders))
+	nodes := make([]*MfaRecoveryCode, len(mrccb.builders))
+	mutators := make([]Mutator, len(mrccb.builders))
+	for i := range mrccb.builders {
+		func(i int, root context.Context) {
+			builder := mrccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MfaRecoveryCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mrccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mrccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mrccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mrccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mrccb *MfaRecoveryCodeCreateBulk) SaveX(ctx context.Context) []*MfaRecoveryCode {
+	v, err := mrccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrccb *MfaRecoveryCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := mrccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrccb *MfaRecoveryCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := mrccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MfaRecoveryCode.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MfaRecoveryCodeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mrccb *MfaRecoveryCodeCreateBulk) OnConflict(opts ...sql.ConflictOption) *MfaRecoveryCodeUpsertBulk {
+	mrccb.conflict = opts
+	return &MfaRecoveryCodeUpsertBulk{
+		create: mrccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MfaRecoveryCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mrccb *MfaRecoveryCodeCreateBulk) OnConflictColumns(columns ...string) *MfaRecoveryCodeUpsertBulk {
+	mrccb.conflict = append(mrccb.conflict, sql.ConflictColumns(columns...))
+	return &MfaRecoveryCodeUpsertBulk{
+		create: mrccb,
+	}
+}
+
+// MfaRecoveryCodeUpsertBulk is the builder for "upsert"-ing
+// a bulk of MfaRecoveryCode nodes.
+type MfaRecoveryCodeUpsertBulk struct {
+	create *MfaRecoveryCodeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MfaRecoveryCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(mfarecoverycode.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MfaRecoveryCodeUpsertBulk) UpdateNewValues() *MfaRecoveryCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(mfarecoverycode.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(mfarecoverycode.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MfaRecoveryCode.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MfaRecoveryCodeUpsertBulk) Ignore() *MfaRecoveryCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MfaRecoveryCodeUpsertBulk) DoNothing() *MfaRecoveryCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MfaRecoveryCodeCreateBulk.OnConflict
+// documentation for more info.
+func (u *MfaRecoveryCodeUpsertBulk) Update(set func(*MfaRecoveryCodeUpsert)) *MfaRecoveryCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MfaRecoveryCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MfaRecoveryCodeUpsertBulk) SetUpdatedAt(v time.Time) *MfaRecoveryCodeUpsertBulk {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MfaRecoveryCodeUpsertBulk) UpdateUpdatedAt() *MfaRecoveryCodeUpsertBulk {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MfaRecoveryCodeUpsertBulk) SetUserID(v uuid.UUID) *MfaRecoveryCodeUpsertBulk {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MfaRecoveryCodeUpsertBulk) UpdateUserID() *MfaRecoveryCodeUpsertBulk {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (u *MfaRecoveryCodeUpsertBulk) SetCodeHash(v string) *MfaRecoveryCodeUpsertBulk {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.SetCodeHash(v)
+	})
+}
+
+// UpdateCodeHash sets the "code_hash" field to the value that was provided on create.
+func (u *MfaRecoveryCodeUpsertBulk) UpdateCodeHash() *MfaRecoveryCodeUpsertBulk {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.UpdateCodeHash()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *MfaRecoveryCodeUpsertBulk) SetUsedAt(v time.Time) *MfaRecoveryCodeUpsertBulk {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *MfaRecoveryCodeUpsertBulk) UpdateUsedAt() *MfaRecoveryCodeUpsertBulk {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *MfaRecoveryCodeUpsertBulk) ClearUsedAt() *MfaRecoveryCodeUpsertBulk {
+	return u.Update(func(s *MfaRecoveryCodeUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MfaRecoveryCodeUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MfaRecoveryCodeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MfaRecoveryCodeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MfaRecoveryCodeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
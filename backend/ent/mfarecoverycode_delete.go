@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sthl/ent/mfarecoverycode"
+	"sthl/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MfaRecoveryCodeDelete is the builder for deleting a MfaRecoveryCode entity.
+type MfaRecoveryCodeDelete struct {
+	config
+	hooks    []Hook
+	mutation *MfaRecoveryCodeMutation
+}
+
+// Where appends a list predicates to the MfaRecoveryCodeDelete builder.
+func (mrcd *MfaRecoveryCodeDelete) Where(ps ...predicate.MfaRecoveryCode) *MfaRecoveryCodeDelete {
+	mrcd.mutation.Where(ps...)
+	return mrcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mrcd *MfaRecoveryCodeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, MfaRecoveryCodeMutation](ctx, mrcd.sqlExec, mrcd.mutation, mrcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcd *MfaRecoveryCodeDelete) ExecX(ctx context.Context) int {
+	n, err := mrcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mrcd *MfaRecoveryCodeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(mfarecoverycode.Table, sqlgraph.NewFieldSpec(mfarecoverycode.FieldID, field.TypeUUID))
+	if ps := mrcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mrcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mrcd.mutation.done = true
+	return affected, err
+}
+
+// MfaRecoveryCodeDeleteOne is the builder for deleting a single MfaRecoveryCode entity.
+type MfaRecoveryCodeDeleteOne struct {
+	mrcd *MfaRecoveryCodeDelete
+}
+
+// Where appends a list predicates to the MfaRecoveryCodeDelete builder.
+func (mrcdo *MfaRecoveryCodeDeleteOne) Where(ps ...predicate.MfaRecoveryCode) *MfaRecoveryCodeDeleteOne {
+	mrcdo.mrcd.mutation.Where(ps...)
+	return mrcdo
+}
+
+// Exec executes the deletion query.
+func (mrcdo *MfaRecoveryCodeDeleteOne) Exec(ctx context.Context) error {
+	n, err := mrcdo.mrcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mfarecoverycode.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcdo *MfaRecoveryCodeDeleteOne) ExecX(ctx context.Context) {
+	if err := mrcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sthl/ent/mfarecoverycode"
+	"sthl/ent/predicate"
+	"sthl/ent/user"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MfaRecoveryCodeQuery is the builder for querying MfaRecoveryCode entities.
+type MfaRecoveryCodeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []OrderFunc
+	inters     []Interceptor
+	predicates []predicate.MfaRecoveryCode
+	withOwner  *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MfaRecoveryCodeQuery builder.
+func (mrcq *MfaRecoveryCodeQuery) Where(ps ...predicate.MfaRecoveryCode) *MfaRecoveryCodeQuery {
+	mrcq.predicates = append(mrcq.predicates, ps...)
+	return mrcq
+}
+
+// Limit the number of records to be returned by this query.
+func (mrcq *MfaRecoveryCodeQuery) Limit(limit int) *MfaRecoveryCodeQuery {
+	mrcq.ctx.Limit = &limit
+	return mrcq
+}
+
+// Offset to start from.
+func (mrcq *MfaRecoveryCodeQuery) Offset(offset int) *MfaRecoveryCodeQuery {
+	mrcq.ctx.Offset = &offset
+	return mrcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mrcq *MfaRecoveryCodeQuery) Unique(unique bool) *MfaRecoveryCodeQuery {
+	mrcq.ctx.Unique = &unique
+	return mrcq
+}
+
+// Order specifies how the records should be ordered.
+func (mrcq *MfaRecoveryCodeQuery) Order(o ...OrderFunc) *MfaRecoveryCodeQuery {
+	mrcq.order = append(mrcq.order, o...)
+	return mrcq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (mrcq *MfaRecoveryCodeQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: mrcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mrcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mrcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mfarecoverycode.Table, mfarecoverycode.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mfarecoverycode.OwnerTable, mfarecoverycode.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mrcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MfaRecoveryCode entity from the query.
+// Returns a *NotFoundError when no MfaRecoveryCode was found.
+func (mrcq *MfaRecoveryCodeQuery) First(ctx context.Context) (*MfaRecoveryCode, error) {
+	nodes, err := mrcq.Limit(1).All(setContextOp(ctx, mrcq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mfarecoverycode.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mrcq *MfaRecoveryCodeQuery) FirstX(ctx context.Context) *MfaRecoveryCode {
+	node, err := mrcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MfaRecoveryCode ID from the query.
+// Returns a *NotFoundError when no MfaRecoveryCode ID was found.
+func (mrcq *MfaRecoveryCodeQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mrcq.Limit(1).IDs(setContextOp(ctx, mrcq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mfarecoverycode.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mrcq *MfaRecoveryCodeQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := mrcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MfaRecoveryCode entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MfaRecoveryCode entity is found.
+// Returns a *NotFoundError when no MfaRecoveryCode entities are found.
+func (mrcq *MfaRecoveryCodeQuery) Only(ctx context.Context) (*MfaRecoveryCode, error) {
+	nodes, err := mrcq.Limit(2).All(setContextOp(ctx, mrcq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mfarecoverycode.Label}
+	default:
+		return nil, &NotSingularError{mfarecoverycode.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mrcq *MfaRecoveryCodeQuery) OnlyX(ctx context.Context) *MfaRecoveryCode {
+	node, err := mrcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MfaRecoveryCode ID in the query.
+// Returns a *NotSingularError when more than one MfaRecoveryCode ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mrcq *MfaRecoveryCodeQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mrcq.Limit(2).IDs(setContextOp(ctx, mrcq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mfarecoverycode.Label}
+	default:
+		err = &NotSingularError{mfarecoverycode.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mrcq *MfaRecoveryCodeQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := mrcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MfaRecoveryCodes.
+func (mrcq *MfaRecoveryCodeQuery) All(ctx context.Context) ([]*MfaRecoveryCode, error) {
+	ctx = setContextOp(ctx, mrcq.ctx, "All")
+	if err := mrcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MfaRecoveryCode, *MfaRecoveryCodeQuery]()
+	return withInterceptors[[]*MfaRecoveryCode](ctx, mrcq, qr, mrcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mrcq *MfaRecoveryCodeQuery) AllX(ctx context.Context) []*MfaRecoveryCode {
+	nodes, err := mrcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MfaRecoveryCode IDs.
+func (mrcq *MfaRecoveryCodeQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if mrcq.ctx.Unique == nil && mrcq.path != nil {
+		mrcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mrcq.ctx, "IDs")
+	if err = mrcq.Select(mfarecoverycode.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mrcq *MfaRecoveryCodeQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := mrcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mrcq *MfaRecoveryCodeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mrcq.ctx, "Count")
+	if err := mrcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mrcq, querierCount[*MfaRecoveryCodeQuery](), mrcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mrcq *MfaRecoveryCodeQuery) CountX(ctx context.Context) int {
+	count, err := mrcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mrcq *MfaRecoveryCodeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mrcq.ctx, "Exist")
+	switch _, err := mrcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mrcq *MfaRecoveryCodeQuery) ExistX(ctx context.Context) bool {
+	exist, err := mrcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MfaRecoveryCodeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mrcq *MfaRecoveryCodeQuery) Clone() *MfaRecoveryCodeQuery {
+	if mrcq == nil {
+		return nil
+	}
+	return &MfaRecoveryCodeQuery{
+		config:     mrcq.config,
+		ctx:        mrcq.ctx.Clone(),
+		order:      append([]OrderFunc{}, mrcq.order...),
+		inters:     append([]Interceptor{}, mrcq.inters...),
+		predicates: append([]predicate.MfaRecoveryCode{}, mrcq.predicates...),
+		withOwner:  mrcq.withOwner.Clone(),
+		// clone intermediate query.
+		sql:  mrcq.sql.Clone(),
+		path: mrcq.path,
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (mrcq *MfaRecoveryCodeQuery) WithOwner(opts ...func(*UserQuery)) *MfaRecoveryCodeQuery {
+	query := (&UserClient{config: mrcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mrcq.withOwner = query
+	return mrcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MfaRecoveryCode.Query().
+//		GroupBy(mfarecoverycode.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mrcq *MfaRecoveryCodeQuery) GroupBy(field string, fields ...string) *MfaRecoveryCodeGroupBy {
+	mrcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MfaRecoveryCodeGroupBy{build: mrcq}
+	grbuild.flds = &mrcq.ctx.Fields
+	grbuild.label = mfarecoverycode.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//	}
+//
+//	client.MfaRecoveryCode.Query().
+//		Select(mfarecoverycode.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (mrcq *MfaRecoveryCodeQuery) Select(fields ...string) *MfaRecoveryCodeSelect {
+	mrcq.ctx.Fields = append(mrcq.ctx.Fields, fields...)
+	sbuild := &MfaRecoveryCodeSelect{MfaRecoveryCodeQuery: mrcq}
+	sbuild.label = mfarecoverycode.Label
+	sbuild.flds, sbuild.scan = &mrcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MfaRecoveryCodeSelect configured with the given aggregations.
+func (mrcq *MfaRecoveryCodeQuery) Aggregate(fns ...AggregateFunc) *MfaRecoveryCodeSelect {
+	return mrcq.Select().Aggregate(fns...)
+}
+
+func (mrcq *MfaRecoveryCodeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mrcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mrcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mrcq.ctx.Fields {
+		if !mfarecoverycode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mrcq.path != nil {
+		prev, err := mrcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mrcq.sql = prev
+	}
+	return nil
+}
+
+func (mrcq *MfaRecoveryCodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MfaRecoveryCode, error) {
+	var (
+		nodes       = []*MfaRecoveryCode{}
+		_spec       = mrcq.querySpec()
+		loadedTypes = [1]bool{
+			mrcq.withOwner != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MfaRecoveryCode).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MfaRecoveryCode{config: mrcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(mrcq.modifiers) > 0 {
+		_spec.Modifiers = mrcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mrcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mrcq.withOwner; query != nil {
+		if err := mrcq.loadOwner(ctx, query, nodes, nil,
+			func(n *MfaRecoveryCode, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mrcq *MfaRecoveryCodeQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*MfaRecoveryCode, init func(*MfaRecoveryCode), assign func(*MfaRecoveryCode, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*MfaRecoveryCode)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mrcq *MfaRecoveryCodeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mrcq.querySpec()
+	if len(mrcq.modifiers) > 0 {
+		_spec.Modifiers = mrcq.modifiers
+	}
+	_spec.Node.Columns = mrcq.ctx.Fields
+	if len(mrcq.ctx.Fields) > 0 {
+		_spec.Unique = mrcq.ctx.Unique != nil && *mrcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mrcq.driver, _spec)
+}
+
+func (mrcq *MfaRecoveryCodeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(mfarecoverycode.Table, mfarecoverycode.Columns, sqlgraph.NewFieldSpec(mfarecoverycode.FieldID, field.TypeUUID))
+	_spec.From = mrcq.sql
+	if unique := mrcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mrcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mrcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mfarecoverycode.FieldID)
+		for i := range fields {
+			if fields[i] != mfarecoverycode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mrcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mrcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mrcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mrcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mrcq *MfaRecoveryCodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mrcq.driver.Dialect())
+	t1 := builder.Table(mfarecoverycode.Table)
+	columns := mrcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = mfarecoverycode.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mrcq.sql != nil {
+		selector = mrcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mrcq.ctx.Unique != nil && *mrcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mrcq.modifiers {
+		m(selector)
+	}
+	for _, p := range mrcq.predicates {
+		p(selector)
+	}
+	for _, p := range mrcq.order {
+		p(selector)
+	}
+	if offset := mrcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mrcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mrcq *MfaRecoveryCodeQuery) ForUpdate(opts ...sql.LockOption) *MfaRecoveryCodeQuery {
+	if mrcq.driver.Dialect() == dialect.Postgres {
+		mrcq.Unique(false)
+	}
+	mrcq.modifiers = append(mrcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return mrcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mrcq *MfaRecoveryCodeQuery) ForShare(opts ...sql.LockOption) *MfaRecoveryCodeQuery {
+	if mrcq.driver.Dialect() == dialect.Postgres {
+		mrcq.Unique(false)
+	}
+	mrcq.modifiers = append(mrcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return mrcq
+}
+
+// MfaRecoveryCodeGroupBy is the group-by builder for MfaRecoveryCode entities.
+type MfaRecoveryCodeGroupBy struct {
+	selector
+	build *MfaRecoveryCodeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mrcgb *MfaRecoveryCodeGroupBy) Aggregate(fns ...AggregateFunc) *MfaRecoveryCodeGroupBy {
+	mrcgb.fns = append(mrcgb.fns, fns...)
+	return mrcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mrcgb *MfaRecoveryCodeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mrcgb.build.ctx, "GroupBy")
+	if err := mrcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MfaRecoveryCodeQuery, *MfaRecoveryCodeGroupBy](ctx, mrcgb.build, mrcgb, mrcgb.build.inters, v)
+}
+
+func (mrcgb *MfaRecoveryCodeGroupBy) sqlScan(ctx context.Context, root *MfaRecoveryCodeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mrcgb.fns))
+	for _, fn := range mrcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mrcgb.flds)+len(mrcgb.fns))
+		for _, f := range *mrcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mrcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mrcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MfaRecoveryCodeSelect is the builder for selecting fields of MfaRecoveryCode entities.
+type MfaRecoveryCodeSelect struct {
+	*MfaRecoveryCodeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mrcs *MfaRecoveryCodeSelect) Aggregate(fns ...AggregateFunc) *MfaRecoveryCodeSelect {
+	mrcs.fns = append(mrcs.fns, fns...)
+	return mrcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mrcs *MfaRecoveryCodeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mrcs.ctx, "Select")
+	if err := mrcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MfaRecoveryCodeQuery, *MfaRecoveryCodeSelect](ctx, mrcs.MfaRecoveryCodeQuery, mrcs, mrcs.inters, v)
+}
+
+func (mrcs *MfaRecoveryCodeSelect) sqlScan(ctx context.Context, root *MfaRecoveryCodeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mrcs.fns))
+	for _, fn := range mrcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mrcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mrcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
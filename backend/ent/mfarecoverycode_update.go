@@ -0,0 +1,432 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/mfarecoverycode"
+	"sthl/ent/predicate"
+	"sthl/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MfaRecoveryCodeUpdate is the builder for updating MfaRecoveryCode entities.
+type MfaRecoveryCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MfaRecoveryCodeMutation
+}
+
+// Where appends a list predicates to the MfaRecoveryCodeUpdate builder.
+func (mrcu *MfaRecoveryCodeUpdate) Where(ps ...predicate.MfaRecoveryCode) *MfaRecoveryCodeUpdate {
+	mrcu.mutation.Where(ps...)
+	return mrcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mrcu *MfaRecoveryCodeUpdate) SetUpdatedAt(t time.Time) *MfaRecoveryCodeUpdate {
+	mrcu.mutation.SetUpdatedAt(t)
+	return mrcu
+}
+
+// SetUserID sets the "user_id" field.
+func (mrcu *MfaRecoveryCodeUpdate) SetUserID(u uuid.UUID) *MfaRecoveryCodeUpdate {
+	mrcu.mutation.SetUserID(u)
+	return mrcu
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (mrcu *MfaRecoveryCodeUpdate) SetCodeHash(s string) *MfaRecoveryCodeUpdate {
+	mrcu.mutation.SetCodeHash(s)
+	return mrcu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (mrcu *MfaRecoveryCodeUpdate) SetUsedAt(t time.Time) *MfaRecoveryCodeUpdate {
+	mrcu.mutation.SetUsedAt(t)
+	return mrcu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (mrcu *MfaRecoveryCodeUpdate) SetNillableUsedAt(t *time.Time) *MfaRecoveryCodeUpdate {
+	if t != nil {
+		mrcu.SetUsedAt(*t)
+	}
+	return mrcu
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (mrcu *MfaRecoveryCodeUpdate) ClearUsedAt() *MfaRecoveryCodeUpdate {
+	mrcu.mutation.ClearUsedAt()
+	return mrcu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (mrcu *MfaRecoveryCodeUpdate) SetOwnerID(id uuid.UUID) *MfaRecoveryCodeUpdate {
+	mrcu.mutation.SetOwnerID(id)
+	return mrcu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (mrcu *MfaRecoveryCodeUpdate) SetOwner(u *User) *MfaRecoveryCodeUpdate {
+	return mrcu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the MfaRecoveryCodeMutation object of the builder.
+func (mrcu *MfaRecoveryCodeUpdate) Mutation() *MfaRecoveryCodeMutation {
+	return mrcu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (mrcu *MfaRecoveryCodeUpdate) ClearOwner() *MfaRecoveryCodeUpdate {
+	mrcu.mutation.ClearOwner()
+	return mrcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mrcu *MfaRecoveryCodeUpdate) Save(ctx context.Context) (int, error) {
+	mrcu.defaults()
+	return withHooks[int, MfaRecoveryCodeMutation](ctx, mrcu.sqlSave, mrcu.mutation, mrcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mrcu *MfaRecoveryCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := mrcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mrcu *MfaRecoveryCodeUpdate) Exec(ctx context.Context) error {
+	_, err := mrcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcu *MfaRecoveryCodeUpdate) ExecX(ctx context.Context) {
+	if err := mrcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mrcu *MfaRecoveryCodeUpdate) defaults() {
+	if _, ok := mrcu.mutation.UpdatedAt(); !ok {
+		v := mfarecoverycode.UpdateDefaultUpdatedAt()
+		mrcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mrcu *MfaRecoveryCodeUpdate) check() error {
+	if v, ok := mrcu.mutation.CodeHash(); ok {
+		if err := mfarecoverycode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "MfaRecoveryCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := mrcu.mutation.OwnerID(); mrcu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MfaRecoveryCode.owner"`)
+	}
+	return nil
+}
+
+func (mrcu *MfaRecoveryCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mrcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mfarecoverycode.Table, mfarecoverycode.Columns, sqlgraph.NewFieldSpec(mfarecoverycode.FieldID, field.TypeUUID))
+	if ps := mrcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mrcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(mfarecoverycode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mrcu.mutation.CodeHash(); ok {
+		_spec.SetField(mfarecoverycode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := mrcu.mutation.UsedAt(); ok {
+		_spec.SetField(mfarecoverycode.FieldUsedAt, field.TypeTime, value)
+	}
+	if mrcu.mutation.UsedAtCleared() {
+		_spec.ClearField(mfarecoverycode.FieldUsedAt, field.TypeTime)
+	}
+	if mrcu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mfarecoverycode.OwnerTable,
+			Columns: []string{mfarecoverycode.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mrcu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mfarecoverycode.OwnerTable,
+			Columns: []string{mfarecoverycode.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mrcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mfarecoverycode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mrcu.mutation.done = true
+	return n, nil
+}
+
+// MfaRecoveryCodeUpdateOne is the builder for updating a single MfaRecoveryCode entity.
+type MfaRecoveryCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MfaRecoveryCodeMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mrcuo *MfaRecoveryCodeUpdateOne) SetUpdatedAt(t time.Time) *MfaRecoveryCodeUpdateOne {
+	mrcuo.mutation.SetUpdatedAt(t)
+	return mrcuo
+}
+
+// SetUserID sets the "user_id" field.
+func (mrcuo *MfaRecoveryCodeUpdateOne) SetUserID(u uuid.UUID) *MfaRecoveryCodeUpdateOne {
+	mrcuo.mutation.SetUserID(u)
+	return mrcuo
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (mrcuo *MfaRecoveryCodeUpdateOne) SetCodeHash(s string) *MfaRecoveryCodeUpdateOne {
+	mrcuo.mutation.SetCodeHash(s)
+	return mrcuo
+}
+
+// SetUsedAt sets the "used_at" field.
+func (mrcuo *MfaRecoveryCodeUpdateOne) SetUsedAt(t time.Time) *MfaRecoveryCodeUpdateOne {
+	mrcuo.mutation.SetUsedAt(t)
+	return mrcuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (mrcuo *MfaRecoveryCodeUpdateOne) SetNillableUsedAt(t *time.Time) *MfaRecoveryCodeUpdateOne {
+	if t != nil {
+		mrcuo.SetUsedAt(*t)
+	}
+	return mrcuo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (mrcuo *MfaRecoveryCodeUpdateOne) ClearUsedAt() *MfaRecoveryCodeUpdateOne {
+	mrcuo.mutation.ClearUsedAt()
+	return mrcuo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (mrcuo *MfaRecoveryCodeUpdateOne) SetOwnerID(id uuid.UUID) *MfaRecoveryCodeUpdateOne {
+	mrcuo.mutation.SetOwnerID(id)
+	return mrcuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (mrcuo *MfaRecoveryCodeUpdateOne) SetOwner(u *User) *MfaRecoveryCodeUpdateOne {
+	return mrcuo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the MfaRecoveryCodeMutation object of the builder.
+func (mrcuo *MfaRecoveryCodeUpdateOne) Mutation() *MfaRecoveryCodeMutation {
+	return mrcuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (mrcuo *MfaRecoveryCodeUpdateOne) ClearOwner() *MfaRecoveryCodeUpdateOne {
+	mrcuo.mutation.ClearOwner()
+	return mrcuo
+}
+
+// Where appends a list predicates to the MfaRecoveryCodeUpdate builder.
+func (mrcuo *MfaRecoveryCodeUpdateOne) Where(ps ...predicate.MfaRecoveryCode) *MfaRecoveryCodeUpdateOne {
+	mrcuo.mutation.Where(ps...)
+	return mrcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mrcuo *MfaRecoveryCodeUpdateOne) Select(field string, fields ...string) *MfaRecoveryCodeUpdateOne {
+	mrcuo.fields = append([]string{field}, fields...)
+	return mrcuo
+}
+
+// Save executes the query and returns the updated MfaRecoveryCode entity.
+func (mrcuo *MfaRecoveryCodeUpdateOne) Save(ctx context.Context) (*MfaRecoveryCode, error) {
+	mrcuo.defaults()
+	return withHooks[*MfaRecoveryCode, MfaRecoveryCodeMutation](ctx, mrcuo.sqlSave, mrcuo.mutation, mrcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mrcuo *MfaRecoveryCodeUpdateOne) SaveX(ctx context.Context) *MfaRecoveryCode {
+	node, err := mrcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mrcuo *MfaRecoveryCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := mrcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcuo *MfaRecoveryCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := mrcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mrcuo *MfaRecoveryCodeUpdateOne) defaults() {
+	if _, ok := mrcuo.mutation.UpdatedAt(); !ok {
+		v := mfarecoverycode.UpdateDefaultUpdatedAt()
+		mrcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mrcuo *MfaRecoveryCodeUpdateOne) check() error {
+	if v, ok := mrcuo.mutation.CodeHash(); ok {
+		if err := mfarecoverycode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "MfaRecoveryCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := mrcuo.mutation.OwnerID(); mrcuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "MfaRecoveryCode.owner"`)
+	}
+	return nil
+}
+
+func (mrcuo *MfaRecoveryCodeUpdateOne) sqlSave(ctx context.Context) (_node *MfaRecoveryCode, err error) {
+	if err := mrcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mfarecoverycode.Table, mfarecoverycode.Columns, sqlgraph.NewFieldSpec(mfarecoverycode.FieldID, field.TypeUUID))
+	id, ok := mrcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MfaRecoveryCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mrcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mfarecoverycode.FieldID)
+		for _, f := range fields {
+			if !mfarecoverycode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mfarecoverycode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mrcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mrcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(mfarecoverycode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mrcuo.mutation.CodeHash(); ok {
+		_spec.SetField(mfarecoverycode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := mrcuo.mutation.UsedAt(); ok {
+		_spec.SetField(mfarecoverycode.FieldUsedAt, field.TypeTime, value)
+	}
+	if mrcuo.mutation.UsedAtCleared() {
+		_spec.ClearField(mfarecoverycode.FieldUsedAt, field.TypeTime)
+	}
+	if mrcuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mfarecoverycode.OwnerTable,
+			Columns: []string{mfarecoverycode.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mrcuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mfarecoverycode.OwnerTable,
+			Columns: []string{mfarecoverycode.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MfaRecoveryCode{config: mrcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mrcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mfarecoverycode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mrcuo.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,166 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "shop_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "prefix", Type: field.TypeString, Unique: true, Size: 16},
+		{Name: "key_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_users_apikeys",
+				Columns:    []*schema.Column{APIKeysColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[11]},
+			},
+		},
+	}
+	// CategoriesColumns holds the columns for the "categories" table.
+	CategoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "slug", Type: field.TypeString, Size: 64},
+		{Name: "description", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "position", Type: field.TypeInt32, Default: 0},
+		{Name: "version", Type: field.TypeInt64, Default: 1},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// CategoriesTable holds the schema information for the "categories" table.
+	CategoriesTable = &schema.Table{
+		Name:       "categories",
+		Columns:    CategoriesColumns,
+		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "categories_categories_children",
+				Columns:    []*schema.Column{CategoriesColumns[9]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "category_user_id_slug",
+				Unique:  true,
+				Columns: []*schema.Column{CategoriesColumns[3], CategoriesColumns[5]},
+			},
+		},
+	}
+	// CollectionsColumns holds the columns for the "collections" table.
+	CollectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "slug", Type: field.TypeString, Size: 64},
+		{Name: "description", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "kind", Type: field.TypeString, Size: 16},
+		{Name: "rules", Type: field.TypeJSON},
+		{Name: "version", Type: field.TypeInt64, Default: 1},
+	}
+	// CollectionsTable holds the schema information for the "collections" table.
+	CollectionsTable = &schema.Table{
+		Name:       "collections",
+		Columns:    CollectionsColumns,
+		PrimaryKey: []*schema.Column{CollectionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "collection_user_id_slug",
+				Unique:  true,
+				Columns: []*schema.Column{CollectionsColumns[3], CollectionsColumns[5]},
+			},
+		},
+	}
+	// EmailVerificationTokensColumns holds the columns for the "email_verification_tokens" table.
+	EmailVerificationTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// EmailVerificationTokensTable holds the schema information for the "email_verification_tokens" table.
+	EmailVerificationTokensTable = &schema.Table{
+		Name:       "email_verification_tokens",
+		Columns:    EmailVerificationTokensColumns,
+		PrimaryKey: []*schema.Column{EmailVerificationTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "email_verification_tokens_users_emailverificationtokens",
+				Columns:    []*schema.Column{EmailVerificationTokensColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailverificationtoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{EmailVerificationTokensColumns[6]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "scope", Type: field.TypeString, Size: 512},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "fingerprint", Type: field.TypeString, Size: 64},
+		{Name: "status_code", Type: field.TypeInt, Default: 0},
+		{Name: "response_body", Type: field.TypeBytes, Nullable: true},
+		{Name: "etag", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "lease_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "claim_token", Type: field.TypeUUID, Nullable: true},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_scope_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[3], IdempotencyKeysColumns[4]},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[9]},
+			},
+		},
+	}
 	// ImageinfosColumns holds the columns for the "imageinfos" table.
 	ImageinfosColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -40,13 +200,132 @@ var (
 			},
 		},
 	}
+	// InventoryMovementsColumns holds the columns for the "inventory_movements" table.
+	InventoryMovementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "variant_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Size: 32},
+		{Name: "delta", Type: field.TypeInt32},
+		{Name: "quantity_after", Type: field.TypeInt32},
+		{Name: "reference_id", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "note", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "product_id", Type: field.TypeUUID},
+	}
+	// InventoryMovementsTable holds the schema information for the "inventory_movements" table.
+	InventoryMovementsTable = &schema.Table{
+		Name:       "inventory_movements",
+		Columns:    InventoryMovementsColumns,
+		PrimaryKey: []*schema.Column{InventoryMovementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "inventory_movements_products_movements",
+				Columns:    []*schema.Column{InventoryMovementsColumns[10]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inventorymovement_product_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{InventoryMovementsColumns[10], InventoryMovementsColumns[1]},
+			},
+		},
+	}
+	// LoginLockoutEventsColumns holds the columns for the "login_lockout_events" table.
+	LoginLockoutEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "scope", Type: field.TypeString, Size: 16},
+		{Name: "ip", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "failures", Type: field.TypeInt},
+		{Name: "locked_until", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// LoginLockoutEventsTable holds the schema information for the "login_lockout_events" table.
+	LoginLockoutEventsTable = &schema.Table{
+		Name:       "login_lockout_events",
+		Columns:    LoginLockoutEventsColumns,
+		PrimaryKey: []*schema.Column{LoginLockoutEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "login_lockout_events_users_loginlockoutevents",
+				Columns:    []*schema.Column{LoginLockoutEventsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginlockoutevent_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginLockoutEventsColumns[7], LoginLockoutEventsColumns[1]},
+			},
+		},
+	}
+	// LoginThrottlesColumns holds the columns for the "login_throttles" table.
+	LoginThrottlesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "key", Type: field.TypeString, Unique: true, Size: 320},
+		{Name: "failures", Type: field.TypeInt, Default: 0},
+		{Name: "last_failed_at", Type: field.TypeTime},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+	}
+	// LoginThrottlesTable holds the schema information for the "login_throttles" table.
+	LoginThrottlesTable = &schema.Table{
+		Name:       "login_throttles",
+		Columns:    LoginThrottlesColumns,
+		PrimaryKey: []*schema.Column{LoginThrottlesColumns[0]},
+	}
+	// MfaRecoveryCodesColumns holds the columns for the "mfa_recovery_codes" table.
+	MfaRecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "code_hash", Type: field.TypeString, Size: 64},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// MfaRecoveryCodesTable holds the schema information for the "mfa_recovery_codes" table.
+	MfaRecoveryCodesTable = &schema.Table{
+		Name:       "mfa_recovery_codes",
+		Columns:    MfaRecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{MfaRecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "mfa_recovery_codes_users_mfarecoverycodes",
+				Columns:    []*schema.Column{MfaRecoveryCodesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mfarecoverycode_user_id_code_hash",
+				Unique:  true,
+				Columns: []*schema.Column{MfaRecoveryCodesColumns[5], MfaRecoveryCodesColumns[3]},
+			},
+		},
+	}
 	// OrdersColumns holds the columns for the "orders" table.
 	OrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "discount", Type: field.TypeFloat64},
-		{Name: "total_amount", Type: field.TypeFloat64},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "USD"},
+		{Name: "discount", Type: field.TypeInt64},
+		{Name: "total_amount", Type: field.TypeInt64},
+		{Name: "subtotal", Type: field.TypeInt64, Default: 0},
+		{Name: "discount_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "tax_rate", Type: field.TypeInt64, Default: 0},
+		{Name: "tax_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "shipping_fee", Type: field.TypeInt64, Default: 0},
 		{Name: "remark", Type: field.TypeString, Size: 255},
 		{Name: "status", Type: field.TypeString, Size: 255},
 		{Name: "payment_status", Type: field.TypeString, Size: 255},
@@ -55,6 +334,8 @@ var (
 		{Name: "shipping_address", Type: field.TypeString, Size: 512},
 		{Name: "tracking_number", Type: field.TypeString, Size: 255},
 		{Name: "is_archived", Type: field.TypeBool, Default: false},
+		{Name: "reserved_until", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt64, Default: 1},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
 	// OrdersTable holds the schema information for the "orders" table.
@@ -65,18 +346,59 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "orders_users_orders",
-				Columns:    []*schema.Column{OrdersColumns[13]},
+				Columns:    []*schema.Column{OrdersColumns[21]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "order_reserved_until",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[19]},
+			},
+		},
+	}
+	// OrderEventsColumns holds the columns for the "order_events" table.
+	OrderEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeString, Size: 64},
+		{Name: "actor_type", Type: field.TypeString, Size: 32},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "before", Type: field.TypeJSON, Nullable: true},
+		{Name: "after", Type: field.TypeJSON, Nullable: true},
+		{Name: "order_id", Type: field.TypeUUID},
+	}
+	// OrderEventsTable holds the schema information for the "order_events" table.
+	OrderEventsTable = &schema.Table{
+		Name:       "order_events",
+		Columns:    OrderEventsColumns,
+		PrimaryKey: []*schema.Column{OrderEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "order_events_orders_events",
+				Columns:    []*schema.Column{OrderEventsColumns[8]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "orderevent_order_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OrderEventsColumns[8], OrderEventsColumns[1]},
+			},
+		},
 	}
 	// OrderItemsColumns holds the columns for the "order_items" table.
 	OrderItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "product_id", Type: field.TypeUUID},
+		{Name: "variant_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "purchased_name", Type: field.TypeString, Size: 255},
-		{Name: "purchased_price", Type: field.TypeFloat64},
+		{Name: "purchased_price", Type: field.TypeInt64},
 		{Name: "quantity", Type: field.TypeInt},
 		{Name: "order_id", Type: field.TypeUUID},
 	}
@@ -88,24 +410,59 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "order_items_orders_orderitems",
-				Columns:    []*schema.Column{OrderItemsColumns[5]},
+				Columns:    []*schema.Column{OrderItemsColumns[6]},
 				RefColumns: []*schema.Column{OrdersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// PasswordResetTokensColumns holds the columns for the "password_reset_tokens" table.
+	PasswordResetTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// PasswordResetTokensTable holds the schema information for the "password_reset_tokens" table.
+	PasswordResetTokensTable = &schema.Table{
+		Name:       "password_reset_tokens",
+		Columns:    PasswordResetTokensColumns,
+		PrimaryKey: []*schema.Column{PasswordResetTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "password_reset_tokens_users_passwordresettokens",
+				Columns:    []*schema.Column{PasswordResetTokensColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passwordresettoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PasswordResetTokensColumns[6]},
+			},
+		},
+	}
 	// ProductsColumns holds the columns for the "products" table.
 	ProductsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
-		{Name: "price", Type: field.TypeFloat64},
+		{Name: "sku", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "price", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "USD"},
 		{Name: "quantity", Type: field.TypeInt32},
 		{Name: "description", Type: field.TypeString, Size: 255},
 		{Name: "status", Type: field.TypeString, Size: 255},
 		{Name: "is_archived", Type: field.TypeBool, Default: false},
 		{Name: "img_url", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "options", Type: field.TypeJSON},
+		{Name: "version", Type: field.TypeInt64, Default: 1},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
 	// ProductsTable holds the schema information for the "products" table.
@@ -116,11 +473,229 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "products_users_products",
-				Columns:    []*schema.Column{ProductsColumns[10]},
+				Columns:    []*schema.Column{ProductsColumns[14]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "product_user_id_sku",
+				Unique:  true,
+				Columns: []*schema.Column{ProductsColumns[14], ProductsColumns[4]},
+			},
+		},
+	}
+	// ProductMediaColumns holds the columns for the "product_media" table.
+	ProductMediaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "alt_text", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "is_primary", Type: field.TypeBool, Default: false},
+		{Name: "imageinfo_id", Type: field.TypeInt},
+		{Name: "product_id", Type: field.TypeUUID},
+	}
+	// ProductMediaTable holds the schema information for the "product_media" table.
+	ProductMediaTable = &schema.Table{
+		Name:       "product_media",
+		Columns:    ProductMediaColumns,
+		PrimaryKey: []*schema.Column{ProductMediaColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "product_media_imageinfos_productmedia",
+				Columns:    []*schema.Column{ProductMediaColumns[6]},
+				RefColumns: []*schema.Column{ImageinfosColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "product_media_products_media",
+				Columns:    []*schema.Column{ProductMediaColumns[7]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productmedia_product_id_imageinfo_id",
+				Unique:  true,
+				Columns: []*schema.Column{ProductMediaColumns[7], ProductMediaColumns[6]},
+			},
+		},
+	}
+	// ProductVariantsColumns holds the columns for the "product_variants" table.
+	ProductVariantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "sku", Type: field.TypeString, Size: 64},
+		{Name: "options", Type: field.TypeJSON},
+		{Name: "price", Type: field.TypeInt64, Nullable: true},
+		{Name: "quantity", Type: field.TypeInt32},
+		{Name: "img_url", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "is_archived", Type: field.TypeBool, Default: false},
+		{Name: "version", Type: field.TypeInt64, Default: 1},
+		{Name: "product_id", Type: field.TypeUUID},
+	}
+	// ProductVariantsTable holds the schema information for the "product_variants" table.
+	ProductVariantsTable = &schema.Table{
+		Name:       "product_variants",
+		Columns:    ProductVariantsColumns,
+		PrimaryKey: []*schema.Column{ProductVariantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "product_variants_products_variants",
+				Columns:    []*schema.Column{ProductVariantsColumns[10]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productvariant_product_id_sku",
+				Unique:  true,
+				Columns: []*schema.Column{ProductVariantsColumns[10], ProductVariantsColumns[3]},
+			},
+		},
+	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "family_id", Type: field.TypeUUID},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked", Type: field.TypeBool, Default: false},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_reason", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "replaced_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refresh_tokens_users_refreshtokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[3]},
+			},
+			{
+				Name:    "refreshtoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[9]},
+			},
+		},
+	}
+	// ShopsColumns holds the columns for the "shops" table.
+	ShopsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "tax_rate", Type: field.TypeInt64, Default: 0},
+		{Name: "shipping_fee", Type: field.TypeInt64, Default: 0},
+		{Name: "user_id", Type: field.TypeUUID, Unique: true},
+	}
+	// ShopsTable holds the schema information for the "shops" table.
+	ShopsTable = &schema.Table{
+		Name:       "shops",
+		Columns:    ShopsColumns,
+		PrimaryKey: []*schema.Column{ShopsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "shops_users_shop",
+				Columns:    []*schema.Column{ShopsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ShopInvitesColumns holds the columns for the "shop_invites" table.
+	ShopInvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "invited_by", Type: field.TypeUUID},
+		{Name: "email", Type: field.TypeString, Size: 255},
+		{Name: "role", Type: field.TypeString, Size: 16},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "shop_id", Type: field.TypeUUID},
+	}
+	// ShopInvitesTable holds the schema information for the "shop_invites" table.
+	ShopInvitesTable = &schema.Table{
+		Name:       "shop_invites",
+		Columns:    ShopInvitesColumns,
+		PrimaryKey: []*schema.Column{ShopInvitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "shop_invites_shops_invites",
+				Columns:    []*schema.Column{ShopInvitesColumns[9]},
+				RefColumns: []*schema.Column{ShopsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "shopinvite_shop_id",
+				Unique:  false,
+				Columns: []*schema.Column{ShopInvitesColumns[9]},
+			},
+		},
+	}
+	// ShopMembersColumns holds the columns for the "shop_members" table.
+	ShopMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "role", Type: field.TypeString, Size: 16},
+		{Name: "shop_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// ShopMembersTable holds the schema information for the "shop_members" table.
+	ShopMembersTable = &schema.Table{
+		Name:       "shop_members",
+		Columns:    ShopMembersColumns,
+		PrimaryKey: []*schema.Column{ShopMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "shop_members_shops_members",
+				Columns:    []*schema.Column{ShopMembersColumns[4]},
+				RefColumns: []*schema.Column{ShopsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "shop_members_users_shopmemberships",
+				Columns:    []*schema.Column{ShopMembersColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "shopmember_shop_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{ShopMembersColumns[4], ShopMembersColumns[5]},
+			},
+			{
+				Name:    "shopmember_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ShopMembersColumns[5]},
+			},
+		},
 	}
 	// SiteuisColumns holds the columns for the "siteuis" table.
 	SiteuisColumns = []*schema.Column{
@@ -131,6 +706,7 @@ var (
 		{Name: "homepage_img_url", Type: field.TypeString, Size: 512, Default: ""},
 		{Name: "homepage_text", Type: field.TypeString, Size: 255},
 		{Name: "homepage_text_color", Type: field.TypeString, Size: 16},
+		{Name: "version", Type: field.TypeInt64, Default: 1},
 		{Name: "user_id", Type: field.TypeUUID, Unique: true},
 	}
 	// SiteuisTable holds the schema information for the "siteuis" table.
@@ -141,7 +717,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "siteuis_users_siteui",
-				Columns:    []*schema.Column{SiteuisColumns[7]},
+				Columns:    []*schema.Column{SiteuisColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -150,7 +726,7 @@ var (
 			{
 				Name:    "siteui_user_id",
 				Unique:  true,
-				Columns: []*schema.Column{SiteuisColumns[7]},
+				Columns: []*schema.Column{SiteuisColumns[8]},
 			},
 		},
 	}
@@ -163,6 +739,7 @@ var (
 		{Name: "hashed_pw", Type: field.TypeString, Size: 255},
 		{Name: "email_verified", Type: field.TypeBool, Default: false},
 		{Name: "is_archived", Type: field.TypeBool, Default: false},
+		{Name: "plan", Type: field.TypeString, Size: 16, Default: "free"},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -177,21 +754,136 @@ var (
 			},
 		},
 	}
+	// UserTotpsColumns holds the columns for the "user_totps" table.
+	UserTotpsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "secret", Type: field.TypeString, Size: 64},
+		{Name: "confirmed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_step", Type: field.TypeInt64, Default: 0},
+		{Name: "user_id", Type: field.TypeUUID, Unique: true},
+	}
+	// UserTotpsTable holds the schema information for the "user_totps" table.
+	UserTotpsTable = &schema.Table{
+		Name:       "user_totps",
+		Columns:    UserTotpsColumns,
+		PrimaryKey: []*schema.Column{UserTotpsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_totps_users_totp",
+				Columns:    []*schema.Column{UserTotpsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// CategoryProductsColumns holds the columns for the "category_products" table.
+	CategoryProductsColumns = []*schema.Column{
+		{Name: "category_id", Type: field.TypeUUID},
+		{Name: "product_id", Type: field.TypeUUID},
+	}
+	// CategoryProductsTable holds the schema information for the "category_products" table.
+	CategoryProductsTable = &schema.Table{
+		Name:       "category_products",
+		Columns:    CategoryProductsColumns,
+		PrimaryKey: []*schema.Column{CategoryProductsColumns[0], CategoryProductsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "category_products_category_id",
+				Columns:    []*schema.Column{CategoryProductsColumns[0]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "category_products_product_id",
+				Columns:    []*schema.Column{CategoryProductsColumns[1]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// CollectionProductsColumns holds the columns for the "collection_products" table.
+	CollectionProductsColumns = []*schema.Column{
+		{Name: "collection_id", Type: field.TypeUUID},
+		{Name: "product_id", Type: field.TypeUUID},
+	}
+	// CollectionProductsTable holds the schema information for the "collection_products" table.
+	CollectionProductsTable = &schema.Table{
+		Name:       "collection_products",
+		Columns:    CollectionProductsColumns,
+		PrimaryKey: []*schema.Column{CollectionProductsColumns[0], CollectionProductsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "collection_products_collection_id",
+				Columns:    []*schema.Column{CollectionProductsColumns[0]},
+				RefColumns: []*schema.Column{CollectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "collection_products_product_id",
+				Columns:    []*schema.Column{CollectionProductsColumns[1]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		CategoriesTable,
+		CollectionsTable,
+		EmailVerificationTokensTable,
+		IdempotencyKeysTable,
 		ImageinfosTable,
+		InventoryMovementsTable,
+		LoginLockoutEventsTable,
+		LoginThrottlesTable,
+		MfaRecoveryCodesTable,
 		OrdersTable,
+		OrderEventsTable,
 		OrderItemsTable,
+		PasswordResetTokensTable,
 		ProductsTable,
+		ProductMediaTable,
+		ProductVariantsTable,
+		RefreshTokensTable,
+		ShopsTable,
+		ShopInvitesTable,
+		ShopMembersTable,
 		SiteuisTable,
 		UsersTable,
+		UserTotpsTable,
+		CategoryProductsTable,
+		CollectionProductsTable,
 	}
 )
 
 func init() {
+	APIKeysTable.ForeignKeys[0].RefTable = UsersTable
+	CategoriesTable.ForeignKeys[0].RefTable = CategoriesTable
+	EmailVerificationTokensTable.ForeignKeys[0].RefTable = UsersTable
 	ImageinfosTable.ForeignKeys[0].RefTable = UsersTable
+	InventoryMovementsTable.ForeignKeys[0].RefTable = ProductsTable
+	LoginLockoutEventsTable.ForeignKeys[0].RefTable = UsersTable
+	MfaRecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
 	OrdersTable.ForeignKeys[0].RefTable = UsersTable
+	OrderEventsTable.ForeignKeys[0].RefTable = OrdersTable
 	OrderItemsTable.ForeignKeys[0].RefTable = OrdersTable
+	PasswordResetTokensTable.ForeignKeys[0].RefTable = UsersTable
 	ProductsTable.ForeignKeys[0].RefTable = UsersTable
+	ProductMediaTable.ForeignKeys[0].RefTable = ImageinfosTable
+	ProductMediaTable.ForeignKeys[1].RefTable = ProductsTable
+	ProductVariantsTable.ForeignKeys[0].RefTable = ProductsTable
+	RefreshTokensTable.ForeignKeys[0].RefTable = UsersTable
+	ShopsTable.ForeignKeys[0].RefTable = UsersTable
+	ShopInvitesTable.ForeignKeys[0].RefTable = ShopsTable
+	ShopMembersTable.ForeignKeys[0].RefTable = ShopsTable
+	ShopMembersTable.ForeignKeys[1].RefTable = UsersTable
 	SiteuisTable.ForeignKeys[0].RefTable = UsersTable
+	UserTotpsTable.ForeignKeys[0].RefTable = UsersTable
+	CategoryProductsTable.ForeignKeys[0].RefTable = CategoriesTable
+	CategoryProductsTable.ForeignKeys[1].RefTable = ProductsTable
+	CollectionProductsTable.ForeignKeys[0].RefTable = CollectionsTable
+	CollectionProductsTable.ForeignKeys[1].RefTable = ProductsTable
 }
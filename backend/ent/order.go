@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sthl/ent/order"
 	"sthl/ent/user"
+	"sthl/money"
 	"strings"
 	"time"
 
@@ -24,10 +25,22 @@ type Order struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 	// UserID holds the value of the "user_id" field.
 	UserID uuid.UUID `json:"userId"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency"`
 	// Discount holds the value of the "discount" field.
-	Discount float64 `json:"discount"`
+	Discount money.Rate `json:"discount"`
 	// TotalAmount holds the value of the "total_amount" field.
-	TotalAmount float64 `json:"totalAmount"`
+	TotalAmount money.Amount `json:"totalAmount"`
+	// Subtotal holds the value of the "subtotal" field.
+	Subtotal money.Amount `json:"-"`
+	// DiscountAmount holds the value of the "discount_amount" field.
+	DiscountAmount money.Amount `json:"-"`
+	// TaxRate holds the value of the "tax_rate" field.
+	TaxRate money.Rate `json:"-"`
+	// TaxAmount holds the value of the "tax_amount" field.
+	TaxAmount money.Amount `json:"-"`
+	// ShippingFee holds the value of the "shipping_fee" field.
+	ShippingFee money.Amount `json:"-"`
 	// Remark holds the value of the "remark" field.
 	Remark string `json:"remark"`
 	// Status holds the value of the "status" field.
@@ -44,6 +57,10 @@ type Order struct {
 	TrackingNumber string `json:"trackingNumber"`
 	// IsArchived holds the value of the "is_archived" field.
 	IsArchived bool `json:"isArchived"`
+	// ReservedUntil holds the value of the "reserved_until" field.
+	ReservedUntil *time.Time `json:"reservedUntil"`
+	// Version holds the value of the "version" field.
+	Version int64 `json:"version"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrderQuery when eager-loading is set.
 	Edges OrderEdges `json:"-"`
@@ -55,9 +72,11 @@ type OrderEdges struct {
 	Owner *User `json:"owner,omitempty"`
 	// Orderitems holds the value of the orderitems edge.
 	Orderitems []*OrderItem `json:"orderitems,omitempty"`
+	// Events holds the value of the events edge.
+	Events []*OrderEvent `json:"events,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -82,6 +101,15 @@ func (e OrderEdges) OrderitemsOrErr() ([]*OrderItem, error) {
 	return nil, &NotLoadedError{edge: "orderitems"}
 }
 
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) EventsOrErr() ([]*OrderEvent, error) {
+	if e.loadedTypes[2] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Order) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -89,11 +117,11 @@ func (*Order) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case order.FieldIsArchived:
 			values[i] = new(sql.NullBool)
-		case order.FieldDiscount, order.FieldTotalAmount:
-			values[i] = new(sql.NullFloat64)
-		case order.FieldRemark, order.FieldStatus, order.FieldPaymentStatus, order.FieldPaymentMethod, order.FieldDeliveryStatus, order.FieldShippingAddress, order.FieldTrackingNumber:
+		case order.FieldDiscount, order.FieldTotalAmount, order.FieldSubtotal, order.FieldDiscountAmount, order.FieldTaxRate, order.FieldTaxAmount, order.FieldShippingFee, order.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case order.FieldCurrency, order.FieldRemark, order.FieldStatus, order.FieldPaymentStatus, order.FieldPaymentMethod, order.FieldDeliveryStatus, order.FieldShippingAddress, order.FieldTrackingNumber:
 			values[i] = new(sql.NullString)
-		case order.FieldCreatedAt, order.FieldUpdatedAt:
+		case order.FieldCreatedAt, order.FieldUpdatedAt, order.FieldReservedUntil:
 			values[i] = new(sql.NullTime)
 		case order.FieldID, order.FieldUserID:
 			values[i] = new(uuid.UUID)
@@ -136,17 +164,53 @@ func (o *Order) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				o.UserID = *value
 			}
+		case order.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				o.Currency = value.String
+			}
 		case order.FieldDiscount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field discount", values[i])
 			} else if value.Valid {
-				o.Discount = value.Float64
+				o.Discount = money.Rate(value.Int64)
 			}
 		case order.FieldTotalAmount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field total_amount", values[i])
 			} else if value.Valid {
-				o.TotalAmount = value.Float64
+				o.TotalAmount = money.Amount(value.Int64)
+			}
+		case order.FieldSubtotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subtotal", values[i])
+			} else if value.Valid {
+				o.Subtotal = money.Amount(value.Int64)
+			}
+		case order.FieldDiscountAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value.Valid {
+				o.DiscountAmount = money.Amount(value.Int64)
+			}
+		case order.FieldTaxRate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate", values[i])
+			} else if value.Valid {
+				o.TaxRate = money.Rate(value.Int64)
+			}
+		case order.FieldTaxAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_amount", values[i])
+			} else if value.Valid {
+				o.TaxAmount = money.Amount(value.Int64)
+			}
+		case order.FieldShippingFee:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field shipping_fee", values[i])
+			} else if value.Valid {
+				o.ShippingFee = money.Amount(value.Int64)
 			}
 		case order.FieldRemark:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -196,6 +260,19 @@ func (o *Order) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				o.IsArchived = value.Bool
 			}
+		case order.FieldReservedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reserved_until", values[i])
+			} else if value.Valid {
+				o.ReservedUntil = new(time.Time)
+				*o.ReservedUntil = value.Time
+			}
+		case order.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				o.Version = value.Int64
+			}
 		}
 	}
 	return nil
@@ -211,6 +288,11 @@ func (o *Order) QueryOrderitems() *OrderItemQuery {
 	return NewOrderClient(o.config).QueryOrderitems(o)
 }
 
+// QueryEvents queries the "events" edge of the Order entity.
+func (o *Order) QueryEvents() *OrderEventQuery {
+	return NewOrderClient(o.config).QueryEvents(o)
+}
+
 // Update returns a builder for updating this Order.
 // Note that you need to call Order.Unwrap() before calling this method if this Order
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -243,12 +325,30 @@ func (o *Order) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", o.UserID))
 	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(o.Currency)
+	builder.WriteString(", ")
 	builder.WriteString("discount=")
 	builder.WriteString(fmt.Sprintf("%v", o.Discount))
 	builder.WriteString(", ")
 	builder.WriteString("total_amount=")
 	builder.WriteString(fmt.Sprintf("%v", o.TotalAmount))
 	builder.WriteString(", ")
+	builder.WriteString("subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", o.Subtotal))
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", o.DiscountAmount))
+	builder.WriteString(", ")
+	builder.WriteString("tax_rate=")
+	builder.WriteString(fmt.Sprintf("%v", o.TaxRate))
+	builder.WriteString(", ")
+	builder.WriteString("tax_amount=")
+	builder.WriteString(fmt.Sprintf("%v", o.TaxAmount))
+	builder.WriteString(", ")
+	builder.WriteString("shipping_fee=")
+	builder.WriteString(fmt.Sprintf("%v", o.ShippingFee))
+	builder.WriteString(", ")
 	builder.WriteString("remark=")
 	builder.WriteString(o.Remark)
 	builder.WriteString(", ")
@@ -272,6 +372,14 @@ func (o *Order) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_archived=")
 	builder.WriteString(fmt.Sprintf("%v", o.IsArchived))
+	builder.WriteString(", ")
+	if v := o.ReservedUntil; v != nil {
+		builder.WriteString("reserved_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", o.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
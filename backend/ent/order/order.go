@@ -3,6 +3,7 @@
 package order
 
 import (
+	"sthl/money"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,10 +20,22 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
 	// FieldDiscount holds the string denoting the discount field in the database.
 	FieldDiscount = "discount"
 	// FieldTotalAmount holds the string denoting the total_amount field in the database.
 	FieldTotalAmount = "total_amount"
+	// FieldSubtotal holds the string denoting the subtotal field in the database.
+	FieldSubtotal = "subtotal"
+	// FieldDiscountAmount holds the string denoting the discount_amount field in the database.
+	FieldDiscountAmount = "discount_amount"
+	// FieldTaxRate holds the string denoting the tax_rate field in the database.
+	FieldTaxRate = "tax_rate"
+	// FieldTaxAmount holds the string denoting the tax_amount field in the database.
+	FieldTaxAmount = "tax_amount"
+	// FieldShippingFee holds the string denoting the shipping_fee field in the database.
+	FieldShippingFee = "shipping_fee"
 	// FieldRemark holds the string denoting the remark field in the database.
 	FieldRemark = "remark"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -39,10 +52,16 @@ const (
 	FieldTrackingNumber = "tracking_number"
 	// FieldIsArchived holds the string denoting the is_archived field in the database.
 	FieldIsArchived = "is_archived"
+	// FieldReservedUntil holds the string denoting the reserved_until field in the database.
+	FieldReservedUntil = "reserved_until"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// EdgeOrderitems holds the string denoting the orderitems edge name in mutations.
 	EdgeOrderitems = "orderitems"
+	// EdgeEvents holds the string denoting the events edge name in mutations.
+	EdgeEvents = "events"
 	// Table holds the table name of the order in the database.
 	Table = "orders"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -59,6 +78,13 @@ const (
 	OrderitemsInverseTable = "order_items"
 	// OrderitemsColumn is the table column denoting the orderitems relation/edge.
 	OrderitemsColumn = "order_id"
+	// EventsTable is the table that holds the events relation/edge.
+	EventsTable = "order_events"
+	// EventsInverseTable is the table name for the OrderEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "orderevent" package.
+	EventsInverseTable = "order_events"
+	// EventsColumn is the table column denoting the events relation/edge.
+	EventsColumn = "order_id"
 )
 
 // Columns holds all SQL columns for order fields.
@@ -67,8 +93,14 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldUserID,
+	FieldCurrency,
 	FieldDiscount,
 	FieldTotalAmount,
+	FieldSubtotal,
+	FieldDiscountAmount,
+	FieldTaxRate,
+	FieldTaxAmount,
+	FieldShippingFee,
 	FieldRemark,
 	FieldStatus,
 	FieldPaymentStatus,
@@ -77,6 +109,8 @@ var Columns = []string{
 	FieldShippingAddress,
 	FieldTrackingNumber,
 	FieldIsArchived,
+	FieldReservedUntil,
+	FieldVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -96,10 +130,34 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
 	// DiscountValidator is a validator for the "discount" field. It is called by the builders before save.
-	DiscountValidator func(float64) error
+	DiscountValidator func(int64) error
 	// TotalAmountValidator is a validator for the "total_amount" field. It is called by the builders before save.
-	TotalAmountValidator func(float64) error
+	TotalAmountValidator func(int64) error
+	// DefaultSubtotal holds the default value on creation for the "subtotal" field.
+	DefaultSubtotal money.Amount
+	// SubtotalValidator is a validator for the "subtotal" field. It is called by the builders before save.
+	SubtotalValidator func(int64) error
+	// DefaultDiscountAmount holds the default value on creation for the "discount_amount" field.
+	DefaultDiscountAmount money.Amount
+	// DiscountAmountValidator is a validator for the "discount_amount" field. It is called by the builders before save.
+	DiscountAmountValidator func(int64) error
+	// DefaultTaxRate holds the default value on creation for the "tax_rate" field.
+	DefaultTaxRate money.Rate
+	// TaxRateValidator is a validator for the "tax_rate" field. It is called by the builders before save.
+	TaxRateValidator func(int64) error
+	// DefaultTaxAmount holds the default value on creation for the "tax_amount" field.
+	DefaultTaxAmount money.Amount
+	// TaxAmountValidator is a validator for the "tax_amount" field. It is called by the builders before save.
+	TaxAmountValidator func(int64) error
+	// DefaultShippingFee holds the default value on creation for the "shipping_fee" field.
+	DefaultShippingFee money.Amount
+	// ShippingFeeValidator is a validator for the "shipping_fee" field. It is called by the builders before save.
+	ShippingFeeValidator func(int64) error
 	// RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
 	RemarkValidator func(string) error
 	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
@@ -116,6 +174,8 @@ var (
 	TrackingNumberValidator func(string) error
 	// DefaultIsArchived holds the default value on creation for the "is_archived" field.
 	DefaultIsArchived bool
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int64
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
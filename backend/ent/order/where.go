@@ -4,6 +4,7 @@ package order
 
 import (
 	"sthl/ent/predicate"
+	"sthl/money"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -71,14 +72,51 @@ func UserID(v uuid.UUID) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldUserID, v))
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCurrency, v))
+}
+
 // Discount applies equality check predicate on the "discount" field. It's identical to DiscountEQ.
-func Discount(v float64) predicate.Order {
-	return predicate.Order(sql.FieldEQ(FieldDiscount, v))
+func Discount(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldDiscount, vc))
 }
 
 // TotalAmount applies equality check predicate on the "total_amount" field. It's identical to TotalAmountEQ.
-func TotalAmount(v float64) predicate.Order {
-	return predicate.Order(sql.FieldEQ(FieldTotalAmount, v))
+func TotalAmount(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldTotalAmount, vc))
+}
+
+// Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
+func Subtotal(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldSubtotal, vc))
+}
+
+// DiscountAmount applies equality check predicate on the "discount_amount" field. It's identical to DiscountAmountEQ.
+func DiscountAmount(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldDiscountAmount, vc))
+}
+
+// TaxRate applies equality check predicate on the "tax_rate" field. It's identical to TaxRateEQ.
+func TaxRate(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldTaxRate, vc))
+}
+
+// TaxAmount applies equality check predicate on the "tax_amount" field. It's identical to TaxAmountEQ.
+func TaxAmount(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldTaxAmount, vc))
+}
+
+// ShippingFee applies equality check predicate on the "shipping_fee" field. It's identical to ShippingFeeEQ.
+func ShippingFee(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldShippingFee, vc))
 }
 
 // Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
@@ -121,6 +159,16 @@ func IsArchived(v bool) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldIsArchived, v))
 }
 
+// ReservedUntil applies equality check predicate on the "reserved_until" field. It's identical to ReservedUntilEQ.
+func ReservedUntil(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReservedUntil, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldCreatedAt, v))
@@ -221,84 +269,447 @@ func UserIDNotIn(vs ...uuid.UUID) predicate.Order {
 	return predicate.Order(sql.FieldNotIn(FieldUserID, vs...))
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldCurrency, v))
+}
+
 // DiscountEQ applies the EQ predicate on the "discount" field.
-func DiscountEQ(v float64) predicate.Order {
-	return predicate.Order(sql.FieldEQ(FieldDiscount, v))
+func DiscountEQ(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldDiscount, vc))
 }
 
 // DiscountNEQ applies the NEQ predicate on the "discount" field.
-func DiscountNEQ(v float64) predicate.Order {
-	return predicate.Order(sql.FieldNEQ(FieldDiscount, v))
+func DiscountNEQ(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldNEQ(FieldDiscount, vc))
 }
 
 // DiscountIn applies the In predicate on the "discount" field.
-func DiscountIn(vs ...float64) predicate.Order {
-	return predicate.Order(sql.FieldIn(FieldDiscount, vs...))
+func DiscountIn(vs ...money.Rate) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldIn(FieldDiscount, v...))
 }
 
 // DiscountNotIn applies the NotIn predicate on the "discount" field.
-func DiscountNotIn(vs ...float64) predicate.Order {
-	return predicate.Order(sql.FieldNotIn(FieldDiscount, vs...))
+func DiscountNotIn(vs ...money.Rate) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldNotIn(FieldDiscount, v...))
 }
 
 // DiscountGT applies the GT predicate on the "discount" field.
-func DiscountGT(v float64) predicate.Order {
-	return predicate.Order(sql.FieldGT(FieldDiscount, v))
+func DiscountGT(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGT(FieldDiscount, vc))
 }
 
 // DiscountGTE applies the GTE predicate on the "discount" field.
-func DiscountGTE(v float64) predicate.Order {
-	return predicate.Order(sql.FieldGTE(FieldDiscount, v))
+func DiscountGTE(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGTE(FieldDiscount, vc))
 }
 
 // DiscountLT applies the LT predicate on the "discount" field.
-func DiscountLT(v float64) predicate.Order {
-	return predicate.Order(sql.FieldLT(FieldDiscount, v))
+func DiscountLT(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLT(FieldDiscount, vc))
 }
 
 // DiscountLTE applies the LTE predicate on the "discount" field.
-func DiscountLTE(v float64) predicate.Order {
-	return predicate.Order(sql.FieldLTE(FieldDiscount, v))
+func DiscountLTE(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLTE(FieldDiscount, vc))
 }
 
 // TotalAmountEQ applies the EQ predicate on the "total_amount" field.
-func TotalAmountEQ(v float64) predicate.Order {
-	return predicate.Order(sql.FieldEQ(FieldTotalAmount, v))
+func TotalAmountEQ(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldTotalAmount, vc))
 }
 
 // TotalAmountNEQ applies the NEQ predicate on the "total_amount" field.
-func TotalAmountNEQ(v float64) predicate.Order {
-	return predicate.Order(sql.FieldNEQ(FieldTotalAmount, v))
+func TotalAmountNEQ(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldNEQ(FieldTotalAmount, vc))
 }
 
 // TotalAmountIn applies the In predicate on the "total_amount" field.
-func TotalAmountIn(vs ...float64) predicate.Order {
-	return predicate.Order(sql.FieldIn(FieldTotalAmount, vs...))
+func TotalAmountIn(vs ...money.Amount) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldIn(FieldTotalAmount, v...))
 }
 
 // TotalAmountNotIn applies the NotIn predicate on the "total_amount" field.
-func TotalAmountNotIn(vs ...float64) predicate.Order {
-	return predicate.Order(sql.FieldNotIn(FieldTotalAmount, vs...))
+func TotalAmountNotIn(vs ...money.Amount) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldNotIn(FieldTotalAmount, v...))
 }
 
 // TotalAmountGT applies the GT predicate on the "total_amount" field.
-func TotalAmountGT(v float64) predicate.Order {
-	return predicate.Order(sql.FieldGT(FieldTotalAmount, v))
+func TotalAmountGT(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGT(FieldTotalAmount, vc))
 }
 
 // TotalAmountGTE applies the GTE predicate on the "total_amount" field.
-func TotalAmountGTE(v float64) predicate.Order {
-	return predicate.Order(sql.FieldGTE(FieldTotalAmount, v))
+func TotalAmountGTE(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGTE(FieldTotalAmount, vc))
 }
 
 // TotalAmountLT applies the LT predicate on the "total_amount" field.
-func TotalAmountLT(v float64) predicate.Order {
-	return predicate.Order(sql.FieldLT(FieldTotalAmount, v))
+func TotalAmountLT(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLT(FieldTotalAmount, vc))
 }
 
 // TotalAmountLTE applies the LTE predicate on the "total_amount" field.
-func TotalAmountLTE(v float64) predicate.Order {
-	return predicate.Order(sql.FieldLTE(FieldTotalAmount, v))
+func TotalAmountLTE(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLTE(FieldTotalAmount, vc))
+}
+
+// SubtotalEQ applies the EQ predicate on the "subtotal" field.
+func SubtotalEQ(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldSubtotal, vc))
+}
+
+// SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
+func SubtotalNEQ(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldNEQ(FieldSubtotal, vc))
+}
+
+// SubtotalIn applies the In predicate on the "subtotal" field.
+func SubtotalIn(vs ...money.Amount) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldIn(FieldSubtotal, v...))
+}
+
+// SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
+func SubtotalNotIn(vs ...money.Amount) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldNotIn(FieldSubtotal, v...))
+}
+
+// SubtotalGT applies the GT predicate on the "subtotal" field.
+func SubtotalGT(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGT(FieldSubtotal, vc))
+}
+
+// SubtotalGTE applies the GTE predicate on the "subtotal" field.
+func SubtotalGTE(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGTE(FieldSubtotal, vc))
+}
+
+// SubtotalLT applies the LT predicate on the "subtotal" field.
+func SubtotalLT(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLT(FieldSubtotal, vc))
+}
+
+// SubtotalLTE applies the LTE predicate on the "subtotal" field.
+func SubtotalLTE(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLTE(FieldSubtotal, vc))
+}
+
+// DiscountAmountEQ applies the EQ predicate on the "discount_amount" field.
+func DiscountAmountEQ(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldDiscountAmount, vc))
+}
+
+// DiscountAmountNEQ applies the NEQ predicate on the "discount_amount" field.
+func DiscountAmountNEQ(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldNEQ(FieldDiscountAmount, vc))
+}
+
+// DiscountAmountIn applies the In predicate on the "discount_amount" field.
+func DiscountAmountIn(vs ...money.Amount) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldIn(FieldDiscountAmount, v...))
+}
+
+// DiscountAmountNotIn applies the NotIn predicate on the "discount_amount" field.
+func DiscountAmountNotIn(vs ...money.Amount) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldNotIn(FieldDiscountAmount, v...))
+}
+
+// DiscountAmountGT applies the GT predicate on the "discount_amount" field.
+func DiscountAmountGT(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGT(FieldDiscountAmount, vc))
+}
+
+// DiscountAmountGTE applies the GTE predicate on the "discount_amount" field.
+func DiscountAmountGTE(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGTE(FieldDiscountAmount, vc))
+}
+
+// DiscountAmountLT applies the LT predicate on the "discount_amount" field.
+func DiscountAmountLT(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLT(FieldDiscountAmount, vc))
+}
+
+// DiscountAmountLTE applies the LTE predicate on the "discount_amount" field.
+func DiscountAmountLTE(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLTE(FieldDiscountAmount, vc))
+}
+
+// TaxRateEQ applies the EQ predicate on the "tax_rate" field.
+func TaxRateEQ(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldTaxRate, vc))
+}
+
+// TaxRateNEQ applies the NEQ predicate on the "tax_rate" field.
+func TaxRateNEQ(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldNEQ(FieldTaxRate, vc))
+}
+
+// TaxRateIn applies the In predicate on the "tax_rate" field.
+func TaxRateIn(vs ...money.Rate) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldIn(FieldTaxRate, v...))
+}
+
+// TaxRateNotIn applies the NotIn predicate on the "tax_rate" field.
+func TaxRateNotIn(vs ...money.Rate) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldNotIn(FieldTaxRate, v...))
+}
+
+// TaxRateGT applies the GT predicate on the "tax_rate" field.
+func TaxRateGT(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGT(FieldTaxRate, vc))
+}
+
+// TaxRateGTE applies the GTE predicate on the "tax_rate" field.
+func TaxRateGTE(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGTE(FieldTaxRate, vc))
+}
+
+// TaxRateLT applies the LT predicate on the "tax_rate" field.
+func TaxRateLT(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLT(FieldTaxRate, vc))
+}
+
+// TaxRateLTE applies the LTE predicate on the "tax_rate" field.
+func TaxRateLTE(v money.Rate) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLTE(FieldTaxRate, vc))
+}
+
+// TaxAmountEQ applies the EQ predicate on the "tax_amount" field.
+func TaxAmountEQ(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldTaxAmount, vc))
+}
+
+// TaxAmountNEQ applies the NEQ predicate on the "tax_amount" field.
+func TaxAmountNEQ(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldNEQ(FieldTaxAmount, vc))
+}
+
+// TaxAmountIn applies the In predicate on the "tax_amount" field.
+func TaxAmountIn(vs ...money.Amount) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldIn(FieldTaxAmount, v...))
+}
+
+// TaxAmountNotIn applies the NotIn predicate on the "tax_amount" field.
+func TaxAmountNotIn(vs ...money.Amount) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldNotIn(FieldTaxAmount, v...))
+}
+
+// TaxAmountGT applies the GT predicate on the "tax_amount" field.
+func TaxAmountGT(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGT(FieldTaxAmount, vc))
+}
+
+// TaxAmountGTE applies the GTE predicate on the "tax_amount" field.
+func TaxAmountGTE(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGTE(FieldTaxAmount, vc))
+}
+
+// TaxAmountLT applies the LT predicate on the "tax_amount" field.
+func TaxAmountLT(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLT(FieldTaxAmount, vc))
+}
+
+// TaxAmountLTE applies the LTE predicate on the "tax_amount" field.
+func TaxAmountLTE(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLTE(FieldTaxAmount, vc))
+}
+
+// ShippingFeeEQ applies the EQ predicate on the "shipping_fee" field.
+func ShippingFeeEQ(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldEQ(FieldShippingFee, vc))
+}
+
+// ShippingFeeNEQ applies the NEQ predicate on the "shipping_fee" field.
+func ShippingFeeNEQ(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldNEQ(FieldShippingFee, vc))
+}
+
+// ShippingFeeIn applies the In predicate on the "shipping_fee" field.
+func ShippingFeeIn(vs ...money.Amount) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldIn(FieldShippingFee, v...))
+}
+
+// ShippingFeeNotIn applies the NotIn predicate on the "shipping_fee" field.
+func ShippingFeeNotIn(vs ...money.Amount) predicate.Order {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Order(sql.FieldNotIn(FieldShippingFee, v...))
+}
+
+// ShippingFeeGT applies the GT predicate on the "shipping_fee" field.
+func ShippingFeeGT(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGT(FieldShippingFee, vc))
+}
+
+// ShippingFeeGTE applies the GTE predicate on the "shipping_fee" field.
+func ShippingFeeGTE(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldGTE(FieldShippingFee, vc))
+}
+
+// ShippingFeeLT applies the LT predicate on the "shipping_fee" field.
+func ShippingFeeLT(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLT(FieldShippingFee, vc))
+}
+
+// ShippingFeeLTE applies the LTE predicate on the "shipping_fee" field.
+func ShippingFeeLTE(v money.Amount) predicate.Order {
+	vc := int64(v)
+	return predicate.Order(sql.FieldLTE(FieldShippingFee, vc))
 }
 
 // RemarkEQ applies the EQ predicate on the "remark" field.
@@ -766,6 +1177,96 @@ func IsArchivedNEQ(v bool) predicate.Order {
 	return predicate.Order(sql.FieldNEQ(FieldIsArchived, v))
 }
 
+// ReservedUntilEQ applies the EQ predicate on the "reserved_until" field.
+func ReservedUntilEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReservedUntil, v))
+}
+
+// ReservedUntilNEQ applies the NEQ predicate on the "reserved_until" field.
+func ReservedUntilNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldReservedUntil, v))
+}
+
+// ReservedUntilIn applies the In predicate on the "reserved_until" field.
+func ReservedUntilIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldReservedUntil, vs...))
+}
+
+// ReservedUntilNotIn applies the NotIn predicate on the "reserved_until" field.
+func ReservedUntilNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldReservedUntil, vs...))
+}
+
+// ReservedUntilGT applies the GT predicate on the "reserved_until" field.
+func ReservedUntilGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldReservedUntil, v))
+}
+
+// ReservedUntilGTE applies the GTE predicate on the "reserved_until" field.
+func ReservedUntilGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldReservedUntil, v))
+}
+
+// ReservedUntilLT applies the LT predicate on the "reserved_until" field.
+func ReservedUntilLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldReservedUntil, v))
+}
+
+// ReservedUntilLTE applies the LTE predicate on the "reserved_until" field.
+func ReservedUntilLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldReservedUntil, v))
+}
+
+// ReservedUntilIsNil applies the IsNil predicate on the "reserved_until" field.
+func ReservedUntilIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldReservedUntil))
+}
+
+// ReservedUntilNotNil applies the NotNil predicate on the "reserved_until" field.
+func ReservedUntilNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldReservedUntil))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int64) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int64) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int64) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldVersion, v))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Order {
 	return predicate.Order(func(s *sql.Selector) {
@@ -820,6 +1321,33 @@ func HasOrderitemsWith(preds ...predicate.OrderItem) predicate.Order {
 	})
 }
 
+// HasEvents applies the HasEdge predicate on the "events" edge.
+func HasEvents() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventsWith applies the HasEdge predicate on the "events" edge with a given conditions (other predicates).
+func HasEventsWith(preds ...predicate.OrderEvent) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(EventsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Order) predicate.Order {
 	return predicate.Order(func(s *sql.Selector) {
@@ -7,8 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"sthl/ent/order"
+	"sthl/ent/orderevent"
 	"sthl/ent/orderitem"
 	"sthl/ent/user"
+	"sthl/money"
 	"time"
 
 	"entgo.io/ent/dialect"
@@ -60,15 +62,99 @@ func (oc *OrderCreate) SetUserID(u uuid.UUID) *OrderCreate {
 	return oc
 }
 
+// SetCurrency sets the "currency" field.
+func (oc *OrderCreate) SetCurrency(s string) *OrderCreate {
+	oc.mutation.SetCurrency(s)
+	return oc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableCurrency(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetCurrency(*s)
+	}
+	return oc
+}
+
 // SetDiscount sets the "discount" field.
-func (oc *OrderCreate) SetDiscount(f float64) *OrderCreate {
-	oc.mutation.SetDiscount(f)
+func (oc *OrderCreate) SetDiscount(m money.Rate) *OrderCreate {
+	oc.mutation.SetDiscount(m)
 	return oc
 }
 
 // SetTotalAmount sets the "total_amount" field.
-func (oc *OrderCreate) SetTotalAmount(f float64) *OrderCreate {
-	oc.mutation.SetTotalAmount(f)
+func (oc *OrderCreate) SetTotalAmount(m money.Amount) *OrderCreate {
+	oc.mutation.SetTotalAmount(m)
+	return oc
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (oc *OrderCreate) SetSubtotal(m money.Amount) *OrderCreate {
+	oc.mutation.SetSubtotal(m)
+	return oc
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableSubtotal(m *money.Amount) *OrderCreate {
+	if m != nil {
+		oc.SetSubtotal(*m)
+	}
+	return oc
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (oc *OrderCreate) SetDiscountAmount(m money.Amount) *OrderCreate {
+	oc.mutation.SetDiscountAmount(m)
+	return oc
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableDiscountAmount(m *money.Amount) *OrderCreate {
+	if m != nil {
+		oc.SetDiscountAmount(*m)
+	}
+	return oc
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (oc *OrderCreate) SetTaxRate(m money.Rate) *OrderCreate {
+	oc.mutation.SetTaxRate(m)
+	return oc
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableTaxRate(m *money.Rate) *OrderCreate {
+	if m != nil {
+		oc.SetTaxRate(*m)
+	}
+	return oc
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (oc *OrderCreate) SetTaxAmount(m money.Amount) *OrderCreate {
+	oc.mutation.SetTaxAmount(m)
+	return oc
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableTaxAmount(m *money.Amount) *OrderCreate {
+	if m != nil {
+		oc.SetTaxAmount(*m)
+	}
+	return oc
+}
+
+// SetShippingFee sets the "shipping_fee" field.
+func (oc *OrderCreate) SetShippingFee(m money.Amount) *OrderCreate {
+	oc.mutation.SetShippingFee(m)
+	return oc
+}
+
+// SetNillableShippingFee sets the "shipping_fee" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableShippingFee(m *money.Amount) *OrderCreate {
+	if m != nil {
+		oc.SetShippingFee(*m)
+	}
 	return oc
 }
 
@@ -128,6 +214,34 @@ func (oc *OrderCreate) SetNillableIsArchived(b *bool) *OrderCreate {
 	return oc
 }
 
+// SetReservedUntil sets the "reserved_until" field.
+func (oc *OrderCreate) SetReservedUntil(t time.Time) *OrderCreate {
+	oc.mutation.SetReservedUntil(t)
+	return oc
+}
+
+// SetNillableReservedUntil sets the "reserved_until" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableReservedUntil(t *time.Time) *OrderCreate {
+	if t != nil {
+		oc.SetReservedUntil(*t)
+	}
+	return oc
+}
+
+// SetVersion sets the "version" field.
+func (oc *OrderCreate) SetVersion(i int64) *OrderCreate {
+	oc.mutation.SetVersion(i)
+	return oc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableVersion(i *int64) *OrderCreate {
+	if i != nil {
+		oc.SetVersion(*i)
+	}
+	return oc
+}
+
 // SetID sets the "id" field.
 func (oc *OrderCreate) SetID(u uuid.UUID) *OrderCreate {
 	oc.mutation.SetID(u)
@@ -168,6 +282,21 @@ func (oc *OrderCreate) AddOrderitems(o ...*OrderItem) *OrderCreate {
 	return oc.AddOrderitemIDs(ids...)
 }
 
+// AddEventIDs adds the "events" edge to the OrderEvent entity by IDs.
+func (oc *OrderCreate) AddEventIDs(ids ...uuid.UUID) *OrderCreate {
+	oc.mutation.AddEventIDs(ids...)
+	return oc
+}
+
+// AddEvents adds the "events" edges to the OrderEvent entity.
+func (oc *OrderCreate) AddEvents(o ...*OrderEvent) *OrderCreate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return oc.AddEventIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (oc *OrderCreate) Mutation() *OrderMutation {
 	return oc.mutation
@@ -211,10 +340,38 @@ func (oc *OrderCreate) defaults() {
 		v := order.DefaultUpdatedAt()
 		oc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := oc.mutation.Currency(); !ok {
+		v := order.DefaultCurrency
+		oc.mutation.SetCurrency(v)
+	}
+	if _, ok := oc.mutation.Subtotal(); !ok {
+		v := order.DefaultSubtotal
+		oc.mutation.SetSubtotal(v)
+	}
+	if _, ok := oc.mutation.DiscountAmount(); !ok {
+		v := order.DefaultDiscountAmount
+		oc.mutation.SetDiscountAmount(v)
+	}
+	if _, ok := oc.mutation.TaxRate(); !ok {
+		v := order.DefaultTaxRate
+		oc.mutation.SetTaxRate(v)
+	}
+	if _, ok := oc.mutation.TaxAmount(); !ok {
+		v := order.DefaultTaxAmount
+		oc.mutation.SetTaxAmount(v)
+	}
+	if _, ok := oc.mutation.ShippingFee(); !ok {
+		v := order.DefaultShippingFee
+		oc.mutation.SetShippingFee(v)
+	}
 	if _, ok := oc.mutation.IsArchived(); !ok {
 		v := order.DefaultIsArchived
 		oc.mutation.SetIsArchived(v)
 	}
+	if _, ok := oc.mutation.Version(); !ok {
+		v := order.DefaultVersion
+		oc.mutation.SetVersion(v)
+	}
 	if _, ok := oc.mutation.ID(); !ok {
 		v := order.DefaultID()
 		oc.mutation.SetID(v)
@@ -232,11 +389,19 @@ func (oc *OrderCreate) check() error {
 	if _, ok := oc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Order.user_id"`)}
 	}
+	if _, ok := oc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Order.currency"`)}
+	}
+	if v, ok := oc.mutation.Currency(); ok {
+		if err := order.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Order.currency": %w`, err)}
+		}
+	}
 	if _, ok := oc.mutation.Discount(); !ok {
 		return &ValidationError{Name: "discount", err: errors.New(`ent: missing required field "Order.discount"`)}
 	}
 	if v, ok := oc.mutation.Discount(); ok {
-		if err := order.DiscountValidator(v); err != nil {
+		if err := order.DiscountValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "discount", err: fmt.Errorf(`ent: validator failed for field "Order.discount": %w`, err)}
 		}
 	}
@@ -244,10 +409,50 @@ func (oc *OrderCreate) check() error {
 		return &ValidationError{Name: "total_amount", err: errors.New(`ent: missing required field "Order.total_amount"`)}
 	}
 	if v, ok := oc.mutation.TotalAmount(); ok {
-		if err := order.TotalAmountValidator(v); err != nil {
+		if err := order.TotalAmountValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "total_amount", err: fmt.Errorf(`ent: validator failed for field "Order.total_amount": %w`, err)}
 		}
 	}
+	if _, ok := oc.mutation.Subtotal(); !ok {
+		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "Order.subtotal"`)}
+	}
+	if v, ok := oc.mutation.Subtotal(); ok {
+		if err := order.SubtotalValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "subtotal", err: fmt.Errorf(`ent: validator failed for field "Order.subtotal": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`ent: missing required field "Order.discount_amount"`)}
+	}
+	if v, ok := oc.mutation.DiscountAmount(); ok {
+		if err := order.DiscountAmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`ent: validator failed for field "Order.discount_amount": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.TaxRate(); !ok {
+		return &ValidationError{Name: "tax_rate", err: errors.New(`ent: missing required field "Order.tax_rate"`)}
+	}
+	if v, ok := oc.mutation.TaxRate(); ok {
+		if err := order.TaxRateValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "tax_rate", err: fmt.Errorf(`ent: validator failed for field "Order.tax_rate": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.TaxAmount(); !ok {
+		return &ValidationError{Name: "tax_amount", err: errors.New(`ent: missing required field "Order.tax_amount"`)}
+	}
+	if v, ok := oc.mutation.TaxAmount(); ok {
+		if err := order.TaxAmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "tax_amount", err: fmt.Errorf(`ent: validator failed for field "Order.tax_amount": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.ShippingFee(); !ok {
+		return &ValidationError{Name: "shipping_fee", err: errors.New(`ent: missing required field "Order.shipping_fee"`)}
+	}
+	if v, ok := oc.mutation.ShippingFee(); ok {
+		if err := order.ShippingFeeValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "shipping_fee", err: fmt.Errorf(`ent: validator failed for field "Order.shipping_fee": %w`, err)}
+		}
+	}
 	if _, ok := oc.mutation.Remark(); !ok {
 		return &ValidationError{Name: "remark", err: errors.New(`ent: missing required field "Order.remark"`)}
 	}
@@ -307,6 +512,9 @@ func (oc *OrderCreate) check() error {
 	if _, ok := oc.mutation.IsArchived(); !ok {
 		return &ValidationError{Name: "is_archived", err: errors.New(`ent: missing required field "Order.is_archived"`)}
 	}
+	if _, ok := oc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Order.version"`)}
+	}
 	if _, ok := oc.mutation.OwnerID(); !ok {
 		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Order.owner"`)}
 	}
@@ -354,14 +562,38 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := oc.mutation.Currency(); ok {
+		_spec.SetField(order.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
 	if value, ok := oc.mutation.Discount(); ok {
-		_spec.SetField(order.FieldDiscount, field.TypeFloat64, value)
+		_spec.SetField(order.FieldDiscount, field.TypeInt64, value)
 		_node.Discount = value
 	}
 	if value, ok := oc.mutation.TotalAmount(); ok {
-		_spec.SetField(order.FieldTotalAmount, field.TypeFloat64, value)
+		_spec.SetField(order.FieldTotalAmount, field.TypeInt64, value)
 		_node.TotalAmount = value
 	}
+	if value, ok := oc.mutation.Subtotal(); ok {
+		_spec.SetField(order.FieldSubtotal, field.TypeInt64, value)
+		_node.Subtotal = value
+	}
+	if value, ok := oc.mutation.DiscountAmount(); ok {
+		_spec.SetField(order.FieldDiscountAmount, field.TypeInt64, value)
+		_node.DiscountAmount = value
+	}
+	if value, ok := oc.mutation.TaxRate(); ok {
+		_spec.SetField(order.FieldTaxRate, field.TypeInt64, value)
+		_node.TaxRate = value
+	}
+	if value, ok := oc.mutation.TaxAmount(); ok {
+		_spec.SetField(order.FieldTaxAmount, field.TypeInt64, value)
+		_node.TaxAmount = value
+	}
+	if value, ok := oc.mutation.ShippingFee(); ok {
+		_spec.SetField(order.FieldShippingFee, field.TypeInt64, value)
+		_node.ShippingFee = value
+	}
 	if value, ok := oc.mutation.Remark(); ok {
 		_spec.SetField(order.FieldRemark, field.TypeString, value)
 		_node.Remark = value
@@ -394,6 +626,14 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldIsArchived, field.TypeBool, value)
 		_node.IsArchived = value
 	}
+	if value, ok := oc.mutation.ReservedUntil(); ok {
+		_spec.SetField(order.FieldReservedUntil, field.TypeTime, value)
+		_node.ReservedUntil = &value
+	}
+	if value, ok := oc.mutation.Version(); ok {
+		_spec.SetField(order.FieldVersion, field.TypeInt64, value)
+		_node.Version = value
+	}
 	if nodes := oc.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -433,6 +673,25 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := oc.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: orderevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -509,8 +768,20 @@ func (u *OrderUpsert) UpdateUserID() *OrderUpsert {
 	return u
 }
 
+// SetCurrency sets the "currency" field.
+func (u *OrderUpsert) SetCurrency(v string) *OrderUpsert {
+	u.Set(order.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *OrderUpsert) UpdateCurrency() *OrderUpsert {
+	u.SetExcluded(order.FieldCurrency)
+	return u
+}
+
 // SetDiscount sets the "discount" field.
-func (u *OrderUpsert) SetDiscount(v float64) *OrderUpsert {
+func (u *OrderUpsert) SetDiscount(v money.Rate) *OrderUpsert {
 	u.Set(order.FieldDiscount, v)
 	return u
 }
@@ -522,13 +793,13 @@ func (u *OrderUpsert) UpdateDiscount() *OrderUpsert {
 }
 
 // AddDiscount adds v to the "discount" field.
-func (u *OrderUpsert) AddDiscount(v float64) *OrderUpsert {
+func (u *OrderUpsert) AddDiscount(v money.Rate) *OrderUpsert {
 	u.Add(order.FieldDiscount, v)
 	return u
 }
 
 // SetTotalAmount sets the "total_amount" field.
-func (u *OrderUpsert) SetTotalAmount(v float64) *OrderUpsert {
+func (u *OrderUpsert) SetTotalAmount(v money.Amount) *OrderUpsert {
 	u.Set(order.FieldTotalAmount, v)
 	return u
 }
@@ -540,11 +811,101 @@ func (u *OrderUpsert) UpdateTotalAmount() *OrderUpsert {
 }
 
 // AddTotalAmount adds v to the "total_amount" field.
-func (u *OrderUpsert) AddTotalAmount(v float64) *OrderUpsert {
+func (u *OrderUpsert) AddTotalAmount(v money.Amount) *OrderUpsert {
 	u.Add(order.FieldTotalAmount, v)
 	return u
 }
 
+// SetSubtotal sets the "subtotal" field.
+func (u *OrderUpsert) SetSubtotal(v money.Amount) *OrderUpsert {
+	u.Set(order.FieldSubtotal, v)
+	return u
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *OrderUpsert) UpdateSubtotal() *OrderUpsert {
+	u.SetExcluded(order.FieldSubtotal)
+	return u
+}
+
+// AddSubtotal adds v to the "subtotal" field.
+func (u *OrderUpsert) AddSubtotal(v money.Amount) *OrderUpsert {
+	u.Add(order.FieldSubtotal, v)
+	return u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *OrderUpsert) SetDiscountAmount(v money.Amount) *OrderUpsert {
+	u.Set(order.FieldDiscountAmount, v)
+	return u
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *OrderUpsert) UpdateDiscountAmount() *OrderUpsert {
+	u.SetExcluded(order.FieldDiscountAmount)
+	return u
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *OrderUpsert) AddDiscountAmount(v money.Amount) *OrderUpsert {
+	u.Add(order.FieldDiscountAmount, v)
+	return u
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *OrderUpsert) SetTaxRate(v money.Rate) *OrderUpsert {
+	u.Set(order.FieldTaxRate, v)
+	return u
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *OrderUpsert) UpdateTaxRate() *OrderUpsert {
+	u.SetExcluded(order.FieldTaxRate)
+	return u
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *OrderUpsert) AddTaxRate(v money.Rate) *OrderUpsert {
+	u.Add(order.FieldTaxRate, v)
+	return u
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *OrderUpsert) SetTaxAmount(v money.Amount) *OrderUpsert {
+	u.Set(order.FieldTaxAmount, v)
+	return u
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *OrderUpsert) UpdateTaxAmount() *OrderUpsert {
+	u.SetExcluded(order.FieldTaxAmount)
+	return u
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *OrderUpsert) AddTaxAmount(v money.Amount) *OrderUpsert {
+	u.Add(order.FieldTaxAmount, v)
+	return u
+}
+
+// SetShippingFee sets the "shipping_fee" field.
+func (u *OrderUpsert) SetShippingFee(v money.Amount) *OrderUpsert {
+	u.Set(order.FieldShippingFee, v)
+	return u
+}
+
+// UpdateShippingFee sets the "shipping_fee" field to the value that was provided on create.
+func (u *OrderUpsert) UpdateShippingFee() *OrderUpsert {
+	u.SetExcluded(order.FieldShippingFee)
+	return u
+}
+
+// AddShippingFee adds v to the "shipping_fee" field.
+func (u *OrderUpsert) AddShippingFee(v money.Amount) *OrderUpsert {
+	u.Add(order.FieldShippingFee, v)
+	return u
+}
+
 // SetRemark sets the "remark" field.
 func (u *OrderUpsert) SetRemark(v string) *OrderUpsert {
 	u.Set(order.FieldRemark, v)
@@ -641,6 +1002,42 @@ func (u *OrderUpsert) UpdateIsArchived() *OrderUpsert {
 	return u
 }
 
+// SetReservedUntil sets the "reserved_until" field.
+func (u *OrderUpsert) SetReservedUntil(v time.Time) *OrderUpsert {
+	u.Set(order.FieldReservedUntil, v)
+	return u
+}
+
+// UpdateReservedUntil sets the "reserved_until" field to the value that was provided on create.
+func (u *OrderUpsert) UpdateReservedUntil() *OrderUpsert {
+	u.SetExcluded(order.FieldReservedUntil)
+	return u
+}
+
+// ClearReservedUntil clears the value of the "reserved_until" field.
+func (u *OrderUpsert) ClearReservedUntil() *OrderUpsert {
+	u.SetNull(order.FieldReservedUntil)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *OrderUpsert) SetVersion(v int64) *OrderUpsert {
+	u.Set(order.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *OrderUpsert) UpdateVersion() *OrderUpsert {
+	u.SetExcluded(order.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *OrderUpsert) AddVersion(v int64) *OrderUpsert {
+	u.Add(order.FieldVersion, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -720,15 +1117,29 @@ func (u *OrderUpsertOne) UpdateUserID() *OrderUpsertOne {
 	})
 }
 
+// SetCurrency sets the "currency" field.
+func (u *OrderUpsertOne) SetCurrency(v string) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *OrderUpsertOne) UpdateCurrency() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
 // SetDiscount sets the "discount" field.
-func (u *OrderUpsertOne) SetDiscount(v float64) *OrderUpsertOne {
+func (u *OrderUpsertOne) SetDiscount(v money.Rate) *OrderUpsertOne {
 	return u.Update(func(s *OrderUpsert) {
 		s.SetDiscount(v)
 	})
 }
 
 // AddDiscount adds v to the "discount" field.
-func (u *OrderUpsertOne) AddDiscount(v float64) *OrderUpsertOne {
+func (u *OrderUpsertOne) AddDiscount(v money.Rate) *OrderUpsertOne {
 	return u.Update(func(s *OrderUpsert) {
 		s.AddDiscount(v)
 	})
@@ -742,14 +1153,14 @@ func (u *OrderUpsertOne) UpdateDiscount() *OrderUpsertOne {
 }
 
 // SetTotalAmount sets the "total_amount" field.
-func (u *OrderUpsertOne) SetTotalAmount(v float64) *OrderUpsertOne {
+func (u *OrderUpsertOne) SetTotalAmount(v money.Amount) *OrderUpsertOne {
 	return u.Update(func(s *OrderUpsert) {
 		s.SetTotalAmount(v)
 	})
 }
 
 // AddTotalAmount adds v to the "total_amount" field.
-func (u *OrderUpsertOne) AddTotalAmount(v float64) *OrderUpsertOne {
+func (u *OrderUpsertOne) AddTotalAmount(v money.Amount) *OrderUpsertOne {
 	return u.Update(func(s *OrderUpsert) {
 		s.AddTotalAmount(v)
 	})
@@ -762,6 +1173,111 @@ func (u *OrderUpsertOne) UpdateTotalAmount() *OrderUpsertOne {
 	})
 }
 
+// SetSubtotal sets the "subtotal" field.
+func (u *OrderUpsertOne) SetSubtotal(v money.Amount) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetSubtotal(v)
+	})
+}
+
+// AddSubtotal adds v to the "subtotal" field.
+func (u *OrderUpsertOne) AddSubtotal(v money.Amount) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.AddSubtotal(v)
+	})
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *OrderUpsertOne) UpdateSubtotal() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateSubtotal()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *OrderUpsertOne) SetDiscountAmount(v money.Amount) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *OrderUpsertOne) AddDiscountAmount(v money.Amount) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *OrderUpsertOne) UpdateDiscountAmount() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *OrderUpsertOne) SetTaxRate(v money.Rate) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetTaxRate(v)
+	})
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *OrderUpsertOne) AddTaxRate(v money.Rate) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.AddTaxRate(v)
+	})
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *OrderUpsertOne) UpdateTaxRate() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateTaxRate()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *OrderUpsertOne) SetTaxAmount(v money.Amount) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *OrderUpsertOne) AddTaxAmount(v money.Amount) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *OrderUpsertOne) UpdateTaxAmount() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// SetShippingFee sets the "shipping_fee" field.
+func (u *OrderUpsertOne) SetShippingFee(v money.Amount) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetShippingFee(v)
+	})
+}
+
+// AddShippingFee adds v to the "shipping_fee" field.
+func (u *OrderUpsertOne) AddShippingFee(v money.Amount) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.AddShippingFee(v)
+	})
+}
+
+// UpdateShippingFee sets the "shipping_fee" field to the value that was provided on create.
+func (u *OrderUpsertOne) UpdateShippingFee() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateShippingFee()
+	})
+}
+
 // SetRemark sets the "remark" field.
 func (u *OrderUpsertOne) SetRemark(v string) *OrderUpsertOne {
 	return u.Update(func(s *OrderUpsert) {
@@ -874,6 +1390,48 @@ func (u *OrderUpsertOne) UpdateIsArchived() *OrderUpsertOne {
 	})
 }
 
+// SetReservedUntil sets the "reserved_until" field.
+func (u *OrderUpsertOne) SetReservedUntil(v time.Time) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetReservedUntil(v)
+	})
+}
+
+// UpdateReservedUntil sets the "reserved_until" field to the value that was provided on create.
+func (u *OrderUpsertOne) UpdateReservedUntil() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateReservedUntil()
+	})
+}
+
+// ClearReservedUntil clears the value of the "reserved_until" field.
+func (u *OrderUpsertOne) ClearReservedUntil() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.ClearReservedUntil()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *OrderUpsertOne) SetVersion(v int64) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *OrderUpsertOne) AddVersion(v int64) *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *OrderUpsertOne) UpdateVersion() *OrderUpsertOne {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateVersion()
+	})
+}
+
 // Exec executes the query.
 func (u *OrderUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1116,15 +1674,29 @@ func (u *OrderUpsertBulk) UpdateUserID() *OrderUpsertBulk {
 	})
 }
 
+// SetCurrency sets the "currency" field.
+func (u *OrderUpsertBulk) SetCurrency(v string) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *OrderUpsertBulk) UpdateCurrency() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
 // SetDiscount sets the "discount" field.
-func (u *OrderUpsertBulk) SetDiscount(v float64) *OrderUpsertBulk {
+func (u *OrderUpsertBulk) SetDiscount(v money.Rate) *OrderUpsertBulk {
 	return u.Update(func(s *OrderUpsert) {
 		s.SetDiscount(v)
 	})
 }
 
 // AddDiscount adds v to the "discount" field.
-func (u *OrderUpsertBulk) AddDiscount(v float64) *OrderUpsertBulk {
+func (u *OrderUpsertBulk) AddDiscount(v money.Rate) *OrderUpsertBulk {
 	return u.Update(func(s *OrderUpsert) {
 		s.AddDiscount(v)
 	})
@@ -1138,14 +1710,14 @@ func (u *OrderUpsertBulk) UpdateDiscount() *OrderUpsertBulk {
 }
 
 // SetTotalAmount sets the "total_amount" field.
-func (u *OrderUpsertBulk) SetTotalAmount(v float64) *OrderUpsertBulk {
+func (u *OrderUpsertBulk) SetTotalAmount(v money.Amount) *OrderUpsertBulk {
 	return u.Update(func(s *OrderUpsert) {
 		s.SetTotalAmount(v)
 	})
 }
 
 // AddTotalAmount adds v to the "total_amount" field.
-func (u *OrderUpsertBulk) AddTotalAmount(v float64) *OrderUpsertBulk {
+func (u *OrderUpsertBulk) AddTotalAmount(v money.Amount) *OrderUpsertBulk {
 	return u.Update(func(s *OrderUpsert) {
 		s.AddTotalAmount(v)
 	})
@@ -1158,6 +1730,111 @@ func (u *OrderUpsertBulk) UpdateTotalAmount() *OrderUpsertBulk {
 	})
 }
 
+// SetSubtotal sets the "subtotal" field.
+func (u *OrderUpsertBulk) SetSubtotal(v money.Amount) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetSubtotal(v)
+	})
+}
+
+// AddSubtotal adds v to the "subtotal" field.
+func (u *OrderUpsertBulk) AddSubtotal(v money.Amount) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.AddSubtotal(v)
+	})
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *OrderUpsertBulk) UpdateSubtotal() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateSubtotal()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *OrderUpsertBulk) SetDiscountAmount(v money.Amount) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *OrderUpsertBulk) AddDiscountAmount(v money.Amount) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *OrderUpsertBulk) UpdateDiscountAmount() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *OrderUpsertBulk) SetTaxRate(v money.Rate) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetTaxRate(v)
+	})
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *OrderUpsertBulk) AddTaxRate(v money.Rate) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.AddTaxRate(v)
+	})
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *OrderUpsertBulk) UpdateTaxRate() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateTaxRate()
+	})
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (u *OrderUpsertBulk) SetTaxAmount(v money.Amount) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetTaxAmount(v)
+	})
+}
+
+// AddTaxAmount adds v to the "tax_amount" field.
+func (u *OrderUpsertBulk) AddTaxAmount(v money.Amount) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.AddTaxAmount(v)
+	})
+}
+
+// UpdateTaxAmount sets the "tax_amount" field to the value that was provided on create.
+func (u *OrderUpsertBulk) UpdateTaxAmount() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateTaxAmount()
+	})
+}
+
+// SetShippingFee sets the "shipping_fee" field.
+func (u *OrderUpsertBulk) SetShippingFee(v money.Amount) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetShippingFee(v)
+	})
+}
+
+// AddShippingFee adds v to the "shipping_fee" field.
+func (u *OrderUpsertBulk) AddShippingFee(v money.Amount) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.AddShippingFee(v)
+	})
+}
+
+// UpdateShippingFee sets the "shipping_fee" field to the value that was provided on create.
+func (u *OrderUpsertBulk) UpdateShippingFee() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateShippingFee()
+	})
+}
+
 // SetRemark sets the "remark" field.
 func (u *OrderUpsertBulk) SetRemark(v string) *OrderUpsertBulk {
 	return u.Update(func(s *OrderUpsert) {
@@ -1270,6 +1947,48 @@ func (u *OrderUpsertBulk) UpdateIsArchived() *OrderUpsertBulk {
 	})
 }
 
+// SetReservedUntil sets the "reserved_until" field.
+func (u *OrderUpsertBulk) SetReservedUntil(v time.Time) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetReservedUntil(v)
+	})
+}
+
+// UpdateReservedUntil sets the "reserved_until" field to the value that was provided on create.
+func (u *OrderUpsertBulk) UpdateReservedUntil() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateReservedUntil()
+	})
+}
+
+// ClearReservedUntil clears the value of the "reserved_until" field.
+func (u *OrderUpsertBulk) ClearReservedUntil() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.ClearReservedUntil()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *OrderUpsertBulk) SetVersion(v int64) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *OrderUpsertBulk) AddVersion(v int64) *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *OrderUpsertBulk) UpdateVersion() *OrderUpsertBulk {
+	return u.Update(func(s *OrderUpsert) {
+		s.UpdateVersion()
+	})
+}
+
 // Exec executes the query.
 func (u *OrderUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -7,9 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"sthl/ent/order"
+	"sthl/ent/orderevent"
 	"sthl/ent/orderitem"
 	"sthl/ent/predicate"
 	"sthl/ent/user"
+	"sthl/money"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -43,29 +45,148 @@ func (ou *OrderUpdate) SetUserID(u uuid.UUID) *OrderUpdate {
 	return ou
 }
 
+// SetCurrency sets the "currency" field.
+func (ou *OrderUpdate) SetCurrency(s string) *OrderUpdate {
+	ou.mutation.SetCurrency(s)
+	return ou
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableCurrency(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetCurrency(*s)
+	}
+	return ou
+}
+
 // SetDiscount sets the "discount" field.
-func (ou *OrderUpdate) SetDiscount(f float64) *OrderUpdate {
+func (ou *OrderUpdate) SetDiscount(m money.Rate) *OrderUpdate {
 	ou.mutation.ResetDiscount()
-	ou.mutation.SetDiscount(f)
+	ou.mutation.SetDiscount(m)
 	return ou
 }
 
-// AddDiscount adds f to the "discount" field.
-func (ou *OrderUpdate) AddDiscount(f float64) *OrderUpdate {
-	ou.mutation.AddDiscount(f)
+// AddDiscount adds m to the "discount" field.
+func (ou *OrderUpdate) AddDiscount(m money.Rate) *OrderUpdate {
+	ou.mutation.AddDiscount(m)
 	return ou
 }
 
 // SetTotalAmount sets the "total_amount" field.
-func (ou *OrderUpdate) SetTotalAmount(f float64) *OrderUpdate {
+func (ou *OrderUpdate) SetTotalAmount(m money.Amount) *OrderUpdate {
 	ou.mutation.ResetTotalAmount()
-	ou.mutation.SetTotalAmount(f)
+	ou.mutation.SetTotalAmount(m)
+	return ou
+}
+
+// AddTotalAmount adds m to the "total_amount" field.
+func (ou *OrderUpdate) AddTotalAmount(m money.Amount) *OrderUpdate {
+	ou.mutation.AddTotalAmount(m)
+	return ou
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (ou *OrderUpdate) SetSubtotal(m money.Amount) *OrderUpdate {
+	ou.mutation.ResetSubtotal()
+	ou.mutation.SetSubtotal(m)
+	return ou
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableSubtotal(m *money.Amount) *OrderUpdate {
+	if m != nil {
+		ou.SetSubtotal(*m)
+	}
+	return ou
+}
+
+// AddSubtotal adds m to the "subtotal" field.
+func (ou *OrderUpdate) AddSubtotal(m money.Amount) *OrderUpdate {
+	ou.mutation.AddSubtotal(m)
+	return ou
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (ou *OrderUpdate) SetDiscountAmount(m money.Amount) *OrderUpdate {
+	ou.mutation.ResetDiscountAmount()
+	ou.mutation.SetDiscountAmount(m)
+	return ou
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableDiscountAmount(m *money.Amount) *OrderUpdate {
+	if m != nil {
+		ou.SetDiscountAmount(*m)
+	}
+	return ou
+}
+
+// AddDiscountAmount adds m to the "discount_amount" field.
+func (ou *OrderUpdate) AddDiscountAmount(m money.Amount) *OrderUpdate {
+	ou.mutation.AddDiscountAmount(m)
+	return ou
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (ou *OrderUpdate) SetTaxRate(m money.Rate) *OrderUpdate {
+	ou.mutation.ResetTaxRate()
+	ou.mutation.SetTaxRate(m)
+	return ou
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableTaxRate(m *money.Rate) *OrderUpdate {
+	if m != nil {
+		ou.SetTaxRate(*m)
+	}
+	return ou
+}
+
+// AddTaxRate adds m to the "tax_rate" field.
+func (ou *OrderUpdate) AddTaxRate(m money.Rate) *OrderUpdate {
+	ou.mutation.AddTaxRate(m)
+	return ou
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (ou *OrderUpdate) SetTaxAmount(m money.Amount) *OrderUpdate {
+	ou.mutation.ResetTaxAmount()
+	ou.mutation.SetTaxAmount(m)
+	return ou
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableTaxAmount(m *money.Amount) *OrderUpdate {
+	if m != nil {
+		ou.SetTaxAmount(*m)
+	}
 	return ou
 }
 
-// AddTotalAmount adds f to the "total_amount" field.
-func (ou *OrderUpdate) AddTotalAmount(f float64) *OrderUpdate {
-	ou.mutation.AddTotalAmount(f)
+// AddTaxAmount adds m to the "tax_amount" field.
+func (ou *OrderUpdate) AddTaxAmount(m money.Amount) *OrderUpdate {
+	ou.mutation.AddTaxAmount(m)
+	return ou
+}
+
+// SetShippingFee sets the "shipping_fee" field.
+func (ou *OrderUpdate) SetShippingFee(m money.Amount) *OrderUpdate {
+	ou.mutation.ResetShippingFee()
+	ou.mutation.SetShippingFee(m)
+	return ou
+}
+
+// SetNillableShippingFee sets the "shipping_fee" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableShippingFee(m *money.Amount) *OrderUpdate {
+	if m != nil {
+		ou.SetShippingFee(*m)
+	}
+	return ou
+}
+
+// AddShippingFee adds m to the "shipping_fee" field.
+func (ou *OrderUpdate) AddShippingFee(m money.Amount) *OrderUpdate {
+	ou.mutation.AddShippingFee(m)
 	return ou
 }
 
@@ -125,6 +246,47 @@ func (ou *OrderUpdate) SetNillableIsArchived(b *bool) *OrderUpdate {
 	return ou
 }
 
+// SetReservedUntil sets the "reserved_until" field.
+func (ou *OrderUpdate) SetReservedUntil(t time.Time) *OrderUpdate {
+	ou.mutation.SetReservedUntil(t)
+	return ou
+}
+
+// SetNillableReservedUntil sets the "reserved_until" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableReservedUntil(t *time.Time) *OrderUpdate {
+	if t != nil {
+		ou.SetReservedUntil(*t)
+	}
+	return ou
+}
+
+// ClearReservedUntil clears the value of the "reserved_until" field.
+func (ou *OrderUpdate) ClearReservedUntil() *OrderUpdate {
+	ou.mutation.ClearReservedUntil()
+	return ou
+}
+
+// SetVersion sets the "version" field.
+func (ou *OrderUpdate) SetVersion(i int64) *OrderUpdate {
+	ou.mutation.ResetVersion()
+	ou.mutation.SetVersion(i)
+	return ou
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableVersion(i *int64) *OrderUpdate {
+	if i != nil {
+		ou.SetVersion(*i)
+	}
+	return ou
+}
+
+// AddVersion adds i to the "version" field.
+func (ou *OrderUpdate) AddVersion(i int64) *OrderUpdate {
+	ou.mutation.AddVersion(i)
+	return ou
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (ou *OrderUpdate) SetOwnerID(id uuid.UUID) *OrderUpdate {
 	ou.mutation.SetOwnerID(id)
@@ -151,6 +313,21 @@ func (ou *OrderUpdate) AddOrderitems(o ...*OrderItem) *OrderUpdate {
 	return ou.AddOrderitemIDs(ids...)
 }
 
+// AddEventIDs adds the "events" edge to the OrderEvent entity by IDs.
+func (ou *OrderUpdate) AddEventIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.AddEventIDs(ids...)
+	return ou
+}
+
+// AddEvents adds the "events" edges to the OrderEvent entity.
+func (ou *OrderUpdate) AddEvents(o ...*OrderEvent) *OrderUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.AddEventIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (ou *OrderUpdate) Mutation() *OrderMutation {
 	return ou.mutation
@@ -183,6 +360,27 @@ func (ou *OrderUpdate) RemoveOrderitems(o ...*OrderItem) *OrderUpdate {
 	return ou.RemoveOrderitemIDs(ids...)
 }
 
+// ClearEvents clears all "events" edges to the OrderEvent entity.
+func (ou *OrderUpdate) ClearEvents() *OrderUpdate {
+	ou.mutation.ClearEvents()
+	return ou
+}
+
+// RemoveEventIDs removes the "events" edge to OrderEvent entities by IDs.
+func (ou *OrderUpdate) RemoveEventIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.RemoveEventIDs(ids...)
+	return ou
+}
+
+// RemoveEvents removes "events" edges to OrderEvent entities.
+func (ou *OrderUpdate) RemoveEvents(o ...*OrderEvent) *OrderUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.RemoveEventIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ou *OrderUpdate) Save(ctx context.Context) (int, error) {
 	ou.defaults()
@@ -221,16 +419,46 @@ func (ou *OrderUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ou *OrderUpdate) check() error {
+	if v, ok := ou.mutation.Currency(); ok {
+		if err := order.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Order.currency": %w`, err)}
+		}
+	}
 	if v, ok := ou.mutation.Discount(); ok {
-		if err := order.DiscountValidator(v); err != nil {
+		if err := order.DiscountValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "discount", err: fmt.Errorf(`ent: validator failed for field "Order.discount": %w`, err)}
 		}
 	}
 	if v, ok := ou.mutation.TotalAmount(); ok {
-		if err := order.TotalAmountValidator(v); err != nil {
+		if err := order.TotalAmountValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "total_amount", err: fmt.Errorf(`ent: validator failed for field "Order.total_amount": %w`, err)}
 		}
 	}
+	if v, ok := ou.mutation.Subtotal(); ok {
+		if err := order.SubtotalValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "subtotal", err: fmt.Errorf(`ent: validator failed for field "Order.subtotal": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.DiscountAmount(); ok {
+		if err := order.DiscountAmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`ent: validator failed for field "Order.discount_amount": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.TaxRate(); ok {
+		if err := order.TaxRateValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "tax_rate", err: fmt.Errorf(`ent: validator failed for field "Order.tax_rate": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.TaxAmount(); ok {
+		if err := order.TaxAmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "tax_amount", err: fmt.Errorf(`ent: validator failed for field "Order.tax_amount": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.ShippingFee(); ok {
+		if err := order.ShippingFeeValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "shipping_fee", err: fmt.Errorf(`ent: validator failed for field "Order.shipping_fee": %w`, err)}
+		}
+	}
 	if v, ok := ou.mutation.Remark(); ok {
 		if err := order.RemarkValidator(v); err != nil {
 			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "Order.remark": %w`, err)}
@@ -287,17 +515,50 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ou.mutation.UpdatedAt(); ok {
 		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := ou.mutation.Currency(); ok {
+		_spec.SetField(order.FieldCurrency, field.TypeString, value)
+	}
 	if value, ok := ou.mutation.Discount(); ok {
-		_spec.SetField(order.FieldDiscount, field.TypeFloat64, value)
+		_spec.SetField(order.FieldDiscount, field.TypeInt64, value)
 	}
 	if value, ok := ou.mutation.AddedDiscount(); ok {
-		_spec.AddField(order.FieldDiscount, field.TypeFloat64, value)
+		_spec.AddField(order.FieldDiscount, field.TypeInt64, value)
 	}
 	if value, ok := ou.mutation.TotalAmount(); ok {
-		_spec.SetField(order.FieldTotalAmount, field.TypeFloat64, value)
+		_spec.SetField(order.FieldTotalAmount, field.TypeInt64, value)
 	}
 	if value, ok := ou.mutation.AddedTotalAmount(); ok {
-		_spec.AddField(order.FieldTotalAmount, field.TypeFloat64, value)
+		_spec.AddField(order.FieldTotalAmount, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.Subtotal(); ok {
+		_spec.SetField(order.FieldSubtotal, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedSubtotal(); ok {
+		_spec.AddField(order.FieldSubtotal, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.DiscountAmount(); ok {
+		_spec.SetField(order.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(order.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.TaxRate(); ok {
+		_spec.SetField(order.FieldTaxRate, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedTaxRate(); ok {
+		_spec.AddField(order.FieldTaxRate, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.TaxAmount(); ok {
+		_spec.SetField(order.FieldTaxAmount, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(order.FieldTaxAmount, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.ShippingFee(); ok {
+		_spec.SetField(order.FieldShippingFee, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedShippingFee(); ok {
+		_spec.AddField(order.FieldShippingFee, field.TypeInt64, value)
 	}
 	if value, ok := ou.mutation.Remark(); ok {
 		_spec.SetField(order.FieldRemark, field.TypeString, value)
@@ -323,6 +584,18 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ou.mutation.IsArchived(); ok {
 		_spec.SetField(order.FieldIsArchived, field.TypeBool, value)
 	}
+	if value, ok := ou.mutation.ReservedUntil(); ok {
+		_spec.SetField(order.FieldReservedUntil, field.TypeTime, value)
+	}
+	if ou.mutation.ReservedUntilCleared() {
+		_spec.ClearField(order.FieldReservedUntil, field.TypeTime)
+	}
+	if value, ok := ou.mutation.Version(); ok {
+		_spec.SetField(order.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedVersion(); ok {
+		_spec.AddField(order.FieldVersion, field.TypeInt64, value)
+	}
 	if ou.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -412,6 +685,60 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ou.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: orderevent.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedEventsIDs(); len(nodes) > 0 && !ou.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: orderevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: orderevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{order.Label}
@@ -444,29 +771,148 @@ func (ouo *OrderUpdateOne) SetUserID(u uuid.UUID) *OrderUpdateOne {
 	return ouo
 }
 
+// SetCurrency sets the "currency" field.
+func (ouo *OrderUpdateOne) SetCurrency(s string) *OrderUpdateOne {
+	ouo.mutation.SetCurrency(s)
+	return ouo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableCurrency(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetCurrency(*s)
+	}
+	return ouo
+}
+
 // SetDiscount sets the "discount" field.
-func (ouo *OrderUpdateOne) SetDiscount(f float64) *OrderUpdateOne {
+func (ouo *OrderUpdateOne) SetDiscount(m money.Rate) *OrderUpdateOne {
 	ouo.mutation.ResetDiscount()
-	ouo.mutation.SetDiscount(f)
+	ouo.mutation.SetDiscount(m)
 	return ouo
 }
 
-// AddDiscount adds f to the "discount" field.
-func (ouo *OrderUpdateOne) AddDiscount(f float64) *OrderUpdateOne {
-	ouo.mutation.AddDiscount(f)
+// AddDiscount adds m to the "discount" field.
+func (ouo *OrderUpdateOne) AddDiscount(m money.Rate) *OrderUpdateOne {
+	ouo.mutation.AddDiscount(m)
 	return ouo
 }
 
 // SetTotalAmount sets the "total_amount" field.
-func (ouo *OrderUpdateOne) SetTotalAmount(f float64) *OrderUpdateOne {
+func (ouo *OrderUpdateOne) SetTotalAmount(m money.Amount) *OrderUpdateOne {
 	ouo.mutation.ResetTotalAmount()
-	ouo.mutation.SetTotalAmount(f)
+	ouo.mutation.SetTotalAmount(m)
+	return ouo
+}
+
+// AddTotalAmount adds m to the "total_amount" field.
+func (ouo *OrderUpdateOne) AddTotalAmount(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.AddTotalAmount(m)
+	return ouo
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (ouo *OrderUpdateOne) SetSubtotal(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.ResetSubtotal()
+	ouo.mutation.SetSubtotal(m)
+	return ouo
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableSubtotal(m *money.Amount) *OrderUpdateOne {
+	if m != nil {
+		ouo.SetSubtotal(*m)
+	}
+	return ouo
+}
+
+// AddSubtotal adds m to the "subtotal" field.
+func (ouo *OrderUpdateOne) AddSubtotal(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.AddSubtotal(m)
+	return ouo
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (ouo *OrderUpdateOne) SetDiscountAmount(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.ResetDiscountAmount()
+	ouo.mutation.SetDiscountAmount(m)
+	return ouo
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableDiscountAmount(m *money.Amount) *OrderUpdateOne {
+	if m != nil {
+		ouo.SetDiscountAmount(*m)
+	}
+	return ouo
+}
+
+// AddDiscountAmount adds m to the "discount_amount" field.
+func (ouo *OrderUpdateOne) AddDiscountAmount(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.AddDiscountAmount(m)
+	return ouo
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (ouo *OrderUpdateOne) SetTaxRate(m money.Rate) *OrderUpdateOne {
+	ouo.mutation.ResetTaxRate()
+	ouo.mutation.SetTaxRate(m)
+	return ouo
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableTaxRate(m *money.Rate) *OrderUpdateOne {
+	if m != nil {
+		ouo.SetTaxRate(*m)
+	}
+	return ouo
+}
+
+// AddTaxRate adds m to the "tax_rate" field.
+func (ouo *OrderUpdateOne) AddTaxRate(m money.Rate) *OrderUpdateOne {
+	ouo.mutation.AddTaxRate(m)
+	return ouo
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (ouo *OrderUpdateOne) SetTaxAmount(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.ResetTaxAmount()
+	ouo.mutation.SetTaxAmount(m)
+	return ouo
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableTaxAmount(m *money.Amount) *OrderUpdateOne {
+	if m != nil {
+		ouo.SetTaxAmount(*m)
+	}
 	return ouo
 }
 
-// AddTotalAmount adds f to the "total_amount" field.
-func (ouo *OrderUpdateOne) AddTotalAmount(f float64) *OrderUpdateOne {
-	ouo.mutation.AddTotalAmount(f)
+// AddTaxAmount adds m to the "tax_amount" field.
+func (ouo *OrderUpdateOne) AddTaxAmount(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.AddTaxAmount(m)
+	return ouo
+}
+
+// SetShippingFee sets the "shipping_fee" field.
+func (ouo *OrderUpdateOne) SetShippingFee(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.ResetShippingFee()
+	ouo.mutation.SetShippingFee(m)
+	return ouo
+}
+
+// SetNillableShippingFee sets the "shipping_fee" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableShippingFee(m *money.Amount) *OrderUpdateOne {
+	if m != nil {
+		ouo.SetShippingFee(*m)
+	}
+	return ouo
+}
+
+// AddShippingFee adds m to the "shipping_fee" field.
+func (ouo *OrderUpdateOne) AddShippingFee(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.AddShippingFee(m)
 	return ouo
 }
 
@@ -526,6 +972,47 @@ func (ouo *OrderUpdateOne) SetNillableIsArchived(b *bool) *OrderUpdateOne {
 	return ouo
 }
 
+// SetReservedUntil sets the "reserved_until" field.
+func (ouo *OrderUpdateOne) SetReservedUntil(t time.Time) *OrderUpdateOne {
+	ouo.mutation.SetReservedUntil(t)
+	return ouo
+}
+
+// SetNillableReservedUntil sets the "reserved_until" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableReservedUntil(t *time.Time) *OrderUpdateOne {
+	if t != nil {
+		ouo.SetReservedUntil(*t)
+	}
+	return ouo
+}
+
+// ClearReservedUntil clears the value of the "reserved_until" field.
+func (ouo *OrderUpdateOne) ClearReservedUntil() *OrderUpdateOne {
+	ouo.mutation.ClearReservedUntil()
+	return ouo
+}
+
+// SetVersion sets the "version" field.
+func (ouo *OrderUpdateOne) SetVersion(i int64) *OrderUpdateOne {
+	ouo.mutation.ResetVersion()
+	ouo.mutation.SetVersion(i)
+	return ouo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableVersion(i *int64) *OrderUpdateOne {
+	if i != nil {
+		ouo.SetVersion(*i)
+	}
+	return ouo
+}
+
+// AddVersion adds i to the "version" field.
+func (ouo *OrderUpdateOne) AddVersion(i int64) *OrderUpdateOne {
+	ouo.mutation.AddVersion(i)
+	return ouo
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (ouo *OrderUpdateOne) SetOwnerID(id uuid.UUID) *OrderUpdateOne {
 	ouo.mutation.SetOwnerID(id)
@@ -552,6 +1039,21 @@ func (ouo *OrderUpdateOne) AddOrderitems(o ...*OrderItem) *OrderUpdateOne {
 	return ouo.AddOrderitemIDs(ids...)
 }
 
+// AddEventIDs adds the "events" edge to the OrderEvent entity by IDs.
+func (ouo *OrderUpdateOne) AddEventIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.AddEventIDs(ids...)
+	return ouo
+}
+
+// AddEvents adds the "events" edges to the OrderEvent entity.
+func (ouo *OrderUpdateOne) AddEvents(o ...*OrderEvent) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.AddEventIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (ouo *OrderUpdateOne) Mutation() *OrderMutation {
 	return ouo.mutation
@@ -584,6 +1086,27 @@ func (ouo *OrderUpdateOne) RemoveOrderitems(o ...*OrderItem) *OrderUpdateOne {
 	return ouo.RemoveOrderitemIDs(ids...)
 }
 
+// ClearEvents clears all "events" edges to the OrderEvent entity.
+func (ouo *OrderUpdateOne) ClearEvents() *OrderUpdateOne {
+	ouo.mutation.ClearEvents()
+	return ouo
+}
+
+// RemoveEventIDs removes the "events" edge to OrderEvent entities by IDs.
+func (ouo *OrderUpdateOne) RemoveEventIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.RemoveEventIDs(ids...)
+	return ouo
+}
+
+// RemoveEvents removes "events" edges to OrderEvent entities.
+func (ouo *OrderUpdateOne) RemoveEvents(o ...*OrderEvent) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.RemoveEventIDs(ids...)
+}
+
 // Where appends a list predicates to the OrderUpdate builder.
 func (ouo *OrderUpdateOne) Where(ps ...predicate.Order) *OrderUpdateOne {
 	ouo.mutation.Where(ps...)
@@ -635,16 +1158,46 @@ func (ouo *OrderUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (ouo *OrderUpdateOne) check() error {
+	if v, ok := ouo.mutation.Currency(); ok {
+		if err := order.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Order.currency": %w`, err)}
+		}
+	}
 	if v, ok := ouo.mutation.Discount(); ok {
-		if err := order.DiscountValidator(v); err != nil {
+		if err := order.DiscountValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "discount", err: fmt.Errorf(`ent: validator failed for field "Order.discount": %w`, err)}
 		}
 	}
 	if v, ok := ouo.mutation.TotalAmount(); ok {
-		if err := order.TotalAmountValidator(v); err != nil {
+		if err := order.TotalAmountValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "total_amount", err: fmt.Errorf(`ent: validator failed for field "Order.total_amount": %w`, err)}
 		}
 	}
+	if v, ok := ouo.mutation.Subtotal(); ok {
+		if err := order.SubtotalValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "subtotal", err: fmt.Errorf(`ent: validator failed for field "Order.subtotal": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.DiscountAmount(); ok {
+		if err := order.DiscountAmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`ent: validator failed for field "Order.discount_amount": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.TaxRate(); ok {
+		if err := order.TaxRateValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "tax_rate", err: fmt.Errorf(`ent: validator failed for field "Order.tax_rate": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.TaxAmount(); ok {
+		if err := order.TaxAmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "tax_amount", err: fmt.Errorf(`ent: validator failed for field "Order.tax_amount": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.ShippingFee(); ok {
+		if err := order.ShippingFeeValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "shipping_fee", err: fmt.Errorf(`ent: validator failed for field "Order.shipping_fee": %w`, err)}
+		}
+	}
 	if v, ok := ouo.mutation.Remark(); ok {
 		if err := order.RemarkValidator(v); err != nil {
 			return &ValidationError{Name: "remark", err: fmt.Errorf(`ent: validator failed for field "Order.remark": %w`, err)}
@@ -718,17 +1271,50 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 	if value, ok := ouo.mutation.UpdatedAt(); ok {
 		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := ouo.mutation.Currency(); ok {
+		_spec.SetField(order.FieldCurrency, field.TypeString, value)
+	}
 	if value, ok := ouo.mutation.Discount(); ok {
-		_spec.SetField(order.FieldDiscount, field.TypeFloat64, value)
+		_spec.SetField(order.FieldDiscount, field.TypeInt64, value)
 	}
 	if value, ok := ouo.mutation.AddedDiscount(); ok {
-		_spec.AddField(order.FieldDiscount, field.TypeFloat64, value)
+		_spec.AddField(order.FieldDiscount, field.TypeInt64, value)
 	}
 	if value, ok := ouo.mutation.TotalAmount(); ok {
-		_spec.SetField(order.FieldTotalAmount, field.TypeFloat64, value)
+		_spec.SetField(order.FieldTotalAmount, field.TypeInt64, value)
 	}
 	if value, ok := ouo.mutation.AddedTotalAmount(); ok {
-		_spec.AddField(order.FieldTotalAmount, field.TypeFloat64, value)
+		_spec.AddField(order.FieldTotalAmount, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.Subtotal(); ok {
+		_spec.SetField(order.FieldSubtotal, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedSubtotal(); ok {
+		_spec.AddField(order.FieldSubtotal, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.DiscountAmount(); ok {
+		_spec.SetField(order.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(order.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.TaxRate(); ok {
+		_spec.SetField(order.FieldTaxRate, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedTaxRate(); ok {
+		_spec.AddField(order.FieldTaxRate, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.TaxAmount(); ok {
+		_spec.SetField(order.FieldTaxAmount, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(order.FieldTaxAmount, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.ShippingFee(); ok {
+		_spec.SetField(order.FieldShippingFee, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedShippingFee(); ok {
+		_spec.AddField(order.FieldShippingFee, field.TypeInt64, value)
 	}
 	if value, ok := ouo.mutation.Remark(); ok {
 		_spec.SetField(order.FieldRemark, field.TypeString, value)
@@ -754,6 +1340,18 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 	if value, ok := ouo.mutation.IsArchived(); ok {
 		_spec.SetField(order.FieldIsArchived, field.TypeBool, value)
 	}
+	if value, ok := ouo.mutation.ReservedUntil(); ok {
+		_spec.SetField(order.FieldReservedUntil, field.TypeTime, value)
+	}
+	if ouo.mutation.ReservedUntilCleared() {
+		_spec.ClearField(order.FieldReservedUntil, field.TypeTime)
+	}
+	if value, ok := ouo.mutation.Version(); ok {
+		_spec.SetField(order.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedVersion(); ok {
+		_spec.AddField(order.FieldVersion, field.TypeInt64, value)
+	}
 	if ouo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -843,6 +1441,60 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ouo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: orderevent.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedEventsIDs(); len(nodes) > 0 && !ouo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: orderevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.EventsTable,
+			Columns: []string{order.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: orderevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Order{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sthl/ent/order"
+	"sthl/ent/orderevent"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// OrderEvent is the model entity for the OrderEvent schema.
+type OrderEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID uuid.UUID `json:"orderId"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type"`
+	// ActorType holds the value of the "actor_type" field.
+	ActorType string `json:"actorType"`
+	// ActorID holds the value of the "actor_id" field.
+	ActorID *uuid.UUID `json:"actorId"`
+	// Before holds the value of the "before" field.
+	Before map[string]interface{} `json:"before"`
+	// After holds the value of the "after" field.
+	After map[string]interface{} `json:"after"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderEventQuery when eager-loading is set.
+	Edges OrderEventEdges `json:"-"`
+}
+
+// OrderEventEdges holds the relations/edges for other nodes in the graph.
+type OrderEventEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *Order `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEventEdges) OwnerOrErr() (*Order, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: order.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrderEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case orderevent.FieldActorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case orderevent.FieldBefore, orderevent.FieldAfter:
+			values[i] = new([]byte)
+		case orderevent.FieldType, orderevent.FieldActorType:
+			values[i] = new(sql.NullString)
+		case orderevent.FieldCreatedAt, orderevent.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case orderevent.FieldID, orderevent.FieldOrderID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type OrderEvent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrderEvent fields.
+func (oe *OrderEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case orderevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				oe.ID = *value
+			}
+		case orderevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				oe.CreatedAt = value.Time
+			}
+		case orderevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				oe.UpdatedAt = value.Time
+			}
+		case orderevent.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				oe.OrderID = *value
+			}
+		case orderevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				oe.Type = value.String
+			}
+		case orderevent.FieldActorType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_type", values[i])
+			} else if value.Valid {
+				oe.ActorType = value.String
+			}
+		case orderevent.FieldActorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				oe.ActorID = new(uuid.UUID)
+				*oe.ActorID = *value.S.(*uuid.UUID)
+			}
+		case orderevent.FieldBefore:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field before", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oe.Before); err != nil {
+					return fmt.Errorf("unmarshal field before: %w", err)
+				}
+			}
+		case orderevent.FieldAfter:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field after", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oe.After); err != nil {
+					return fmt.Errorf("unmarshal field after: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// QueryOwner queries the "owner" edge of the OrderEvent entity.
+func (oe *OrderEvent) QueryOwner() *OrderQuery {
+	return NewOrderEventClient(oe.config).QueryOwner(oe)
+}
+
+// Update returns a builder for updating this OrderEvent.
+// Note that you need to call OrderEvent.Unwrap() before calling this method if this OrderEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oe *OrderEvent) Update() *OrderEventUpdateOne {
+	return NewOrderEventClient(oe.config).UpdateOne(oe)
+}
+
+// Unwrap unwraps the OrderEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oe *OrderEvent) Unwrap() *OrderEvent {
+	_tx, ok := oe.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrderEvent is not a transactional entity")
+	}
+	oe.config.driver = _tx.drv
+	return oe
+}
+
+// String implements the fmt.Stringer.
+func (oe *OrderEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrderEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oe.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(oe.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(oe.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", oe.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(oe.Type)
+	builder.WriteString(", ")
+	builder.WriteString("actor_type=")
+	builder.WriteString(oe.ActorType)
+	builder.WriteString(", ")
+	if v := oe.ActorID; v != nil {
+		builder.WriteString("actor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("before=")
+	builder.WriteString(fmt.Sprintf("%v", oe.Before))
+	builder.WriteString(", ")
+	builder.WriteString("after=")
+	builder.WriteString(fmt.Sprintf("%v", oe.After))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrderEvents is a parsable slice of OrderEvent.
+type OrderEvents []*OrderEvent
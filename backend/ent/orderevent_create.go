@@ -0,0 +1,699 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/order"
+	"sthl/ent/orderevent"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// OrderEventCreate is the builder for creating a OrderEvent entity.
+type OrderEventCreate struct {
+	config
+	mutation *OrderEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oec *OrderEventCreate) SetCreatedAt(t time.Time) *OrderEventCreate {
+	oec.mutation.SetCreatedAt(t)
+	return oec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oec *OrderEventCreate) SetNillableCreatedAt(t *time.Time) *OrderEventCreate {
+	if t != nil {
+		oec.SetCreatedAt(*t)
+	}
+	return oec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oec *OrderEventCreate) SetUpdatedAt(t time.Time) *OrderEventCreate {
+	oec.mutation.SetUpdatedAt(t)
+	return oec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (oec *OrderEventCreate) SetNillableUpdatedAt(t *time.Time) *OrderEventCreate {
+	if t != nil {
+		oec.SetUpdatedAt(*t)
+	}
+	return oec
+}
+
+// SetOrderID sets the "order_id" field.
+func (oec *OrderEventCreate) SetOrderID(u uuid.UUID) *OrderEventCreate {
+	oec.mutation.SetOrderID(u)
+	return oec
+}
+
+// SetType sets the "type" field.
+func (oec *OrderEventCreate) SetType(s string) *OrderEventCreate {
+	oec.mutation.SetType(s)
+	return oec
+}
+
+// SetActorType sets the "actor_type" field.
+func (oec *OrderEventCreate) SetActorType(s string) *OrderEventCreate {
+	oec.mutation.SetActorType(s)
+	return oec
+}
+
+// SetActorID sets the "actor_id" field.
+func (oec *OrderEventCreate) SetActorID(u uuid.UUID) *OrderEventCreate {
+	oec.mutation.SetActorID(u)
+	return oec
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (oec *OrderEventCreate) SetNillableActorID(u *uuid.UUID) *OrderEventCreate {
+	if u != nil {
+		oec.SetActorID(*u)
+	}
+	return oec
+}
+
+// SetBefore sets the "before" field.
+func (oec *OrderEventCreate) SetBefore(m map[string]interface{}) *OrderEventCreate {
+	oec.mutation.SetBefore(m)
+	return oec
+}
+
+// SetAfter sets the "after" field.
+func (oec *OrderEventCreate) SetAfter(m map[string]interface{}) *OrderEventCreate {
+	oec.mutation.SetAfter(m)
+	return oec
+}
+
+// SetID sets the "id" field.
+func (oec *OrderEventCreate) SetID(u uuid.UUID) *OrderEventCreate {
+	oec.mutation.SetID(u)
+	return oec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (oec *OrderEventCreate) SetNillableID(u *uuid.UUID) *OrderEventCreate {
+	if u != nil {
+		oec.SetID(*u)
+	}
+	return oec
+}
+
+// SetOwnerID sets the "owner" edge to the Order entity by ID.
+func (oec *OrderEventCreate) SetOwnerID(id uuid.UUID) *OrderEventCreate {
+	oec.mutation.SetOwnerID(id)
+	return oec
+}
+
+// SetOwner sets the "owner" edge to the Order entity.
+func (oec *OrderEventCreate) SetOwner(o *Order) *OrderEventCreate {
+	return oec.SetOwnerID(o.ID)
+}
+
+// Mutation returns the OrderEventMutation object of the builder.
+func (oec *OrderEventCreate) Mutation() *OrderEventMutation {
+	return oec.mutation
+}
+
+// Save creates the OrderEvent in the database.
+func (oec *OrderEventCreate) Save(ctx context.Context) (*OrderEvent, error) {
+	oec.defaults()
+	return withHooks[*OrderEvent, OrderEventMutation](ctx, oec.sqlSave, oec.mutation, oec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oec *OrderEventCreate) SaveX(ctx context.Context) *OrderEvent {
+	v, err := oec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oec *OrderEventCreate) Exec(ctx context.Context) error {
+	_, err := oec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oec *OrderEventCreate) ExecX(ctx context.Context) {
+	if err := oec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oec *OrderEventCreate) defaults() {
+	if _, ok := oec.mutation.CreatedAt(); !ok {
+		v := orderevent.DefaultCreatedAt()
+		oec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := oec.mutation.UpdatedAt(); !ok {
+		v := orderevent.DefaultUpdatedAt()
+		oec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := oec.mutation.ID(); !ok {
+		v := orderevent.DefaultID()
+		oec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oec *OrderEventCreate) check() error {
+	if _, ok := oec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OrderEvent.created_at"`)}
+	}
+	if _, ok := oec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OrderEvent.updated_at"`)}
+	}
+	if _, ok := oec.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "OrderEvent.order_id"`)}
+	}
+	if _, ok := oec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "OrderEvent.type"`)}
+	}
+	if v, ok := oec.mutation.GetType(); ok {
+		if err := orderevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "OrderEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := oec.mutation.ActorType(); !ok {
+		return &ValidationError{Name: "actor_type", err: errors.New(`ent: missing required field "OrderEvent.actor_type"`)}
+	}
+	if v, ok := oec.mutation.ActorType(); ok {
+		if err := orderevent.ActorTypeValidator(v); err != nil {
+			return &ValidationError{Name: "actor_type", err: fmt.Errorf(`ent: validator failed for field "OrderEvent.actor_type": %w`, err)}
+		}
+	}
+	if _, ok := oec.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "OrderEvent.owner"`)}
+	}
+	return nil
+}
+
+func (oec *OrderEventCreate) sqlSave(ctx context.Context) (*OrderEvent, error) {
+	if err := oec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	oec.mutation.id = &_node.ID
+	oec.mutation.done = true
+	return _node, nil
+}
+
+func (oec *OrderEventCreate) createSpec() (*OrderEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrderEvent{config: oec.config}
+		_spec = sqlgraph.NewCreateSpec(orderevent.Table, sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = oec.conflict
+	if id, ok := oec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := oec.mutation.CreatedAt(); ok {
+		_spec.SetField(orderevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := oec.mutation.UpdatedAt(); ok {
+		_spec.SetField(orderevent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := oec.mutation.GetType(); ok {
+		_spec.SetField(orderevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := oec.mutation.ActorType(); ok {
+		_spec.SetField(orderevent.FieldActorType, field.TypeString, value)
+		_node.ActorType = value
+	}
+	if value, ok := oec.mutation.ActorID(); ok {
+		_spec.SetField(orderevent.FieldActorID, field.TypeUUID, value)
+		_node.ActorID = &value
+	}
+	if value, ok := oec.mutation.Before(); ok {
+		_spec.SetField(orderevent.FieldBefore, field.TypeJSON, value)
+		_node.Before = value
+	}
+	if value, ok := oec.mutation.After(); ok {
+		_spec.SetField(orderevent.FieldAfter, field.TypeJSON, value)
+		_node.After = value
+	}
+	if nodes := oec.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderevent.OwnerTable,
+			Columns: []string{orderevent.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: order.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OrderEvent.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrderEventUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (oec *OrderEventCreate) OnConflict(opts ...sql.ConflictOption) *OrderEventUpsertOne {
+	oec.conflict = opts
+	return &OrderEventUpsertOne{
+		create: oec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OrderEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (oec *OrderEventCreate) OnConflictColumns(columns ...string) *OrderEventUpsertOne {
+	oec.conflict = append(oec.conflict, sql.ConflictColumns(columns...))
+	return &OrderEventUpsertOne{
+		create: oec,
+	}
+}
+
+type (
+	// OrderEventUpsertOne is the builder for "upsert"-ing
+	//  one OrderEvent node.
+	OrderEventUpsertOne struct {
+		create *OrderEventCreate
+	}
+
+	// OrderEventUpsert is the "OnConflict" setter.
+	OrderEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OrderEventUpsert) SetUpdatedAt(v time.Time) *OrderEventUpsert {
+	u.Set(orderevent.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OrderEventUpsert) UpdateUpdatedAt() *OrderEventUpsert {
+	u.SetExcluded(orderevent.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.OrderEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(orderevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OrderEventUpsertOne) UpdateNewValues() *OrderEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(orderevent.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(orderevent.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.OrderID(); exists {
+			s.SetIgnore(orderevent.FieldOrderID)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(orderevent.FieldType)
+		}
+		if _, exists := u.create.mutation.ActorType(); exists {
+			s.SetIgnore(orderevent.FieldActorType)
+		}
+		if _, exists := u.create.mutation.ActorID(); exists {
+			s.SetIgnore(orderevent.FieldActorID)
+		}
+		if _, exists := u.create.mutation.Before(); exists {
+			s.SetIgnore(orderevent.FieldBefore)
+		}
+		if _, exists := u.create.mutation.After(); exists {
+			s.SetIgnore(orderevent.FieldAfter)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OrderEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OrderEventUpsertOne) Ignore() *OrderEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrderEventUpsertOne) DoNothing() *OrderEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrderEventCreate.OnConflict
+// documentation for more info.
+func (u *OrderEventUpsertOne) Update(set func(*OrderEventUpsert)) *OrderEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrderEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OrderEventUpsertOne) SetUpdatedAt(v time.Time) *OrderEventUpsertOne {
+	return u.Update(func(s *OrderEventUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OrderEventUpsertOne) UpdateUpdatedAt() *OrderEventUpsertOne {
+	return u.Update(func(s *OrderEventUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *OrderEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrderEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrderEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OrderEventUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: OrderEventUpsertOne.ID is not supported by MySQL driver. Use OrderEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OrderEventUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OrderEventCreateBulk is the builder for creating many OrderEvent entities in bulk.
+type OrderEventCreateBulk struct {
+	config
+	builders []*OrderEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OrderEvent entities in the database.
+func (oecb *OrderEventCreateBulk) Save(ctx context.Context) ([]*OrderEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(oecb.builders))
+	nodes := make([]*OrderEvent, len(oecb.builders))
+	mutators := make([]Mutator, len(oecb.builders))
+	for i := range oecb.builders {
+		func(i int, root context.Context) {
+			builder := oecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, oecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = oecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, oecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, oecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oecb *OrderEventCreateBulk) SaveX(ctx context.Context) []*OrderEvent {
+	v, err := oecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oecb *OrderEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := oecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oecb *OrderEventCreateBulk) ExecX(ctx context.Context) {
+	if err := oecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OrderEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrderEventUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (oecb *OrderEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *OrderEventUpsertBulk {
+	oecb.conflict = opts
+	return &OrderEventUpsertBulk{
+		create: oecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OrderEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (oecb *OrderEventCreateBulk) OnConflictColumns(columns ...string) *OrderEventUpsertBulk {
+	oecb.conflict = append(oecb.conflict, sql.ConflictColumns(columns...))
+	return &OrderEventUpsertBulk{
+		create: oecb,
+	}
+}
+
+// OrderEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of OrderEvent nodes.
+type OrderEventUpsertBulk struct {
+	create *OrderEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OrderEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(orderevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OrderEventUpsertBulk) UpdateNewValues() *OrderEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(orderevent.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(orderevent.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.OrderID(); exists {
+				s.SetIgnore(orderevent.FieldOrderID)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(orderevent.FieldType)
+			}
+			if _, exists := b.mutation.ActorType(); exists {
+				s.SetIgnore(orderevent.FieldActorType)
+			}
+			if _, exists := b.mutation.ActorID(); exists {
+				s.SetIgnore(orderevent.FieldActorID)
+			}
+			if _, exists := b.mutation.Before(); exists {
+				s.SetIgnore(orderevent.FieldBefore)
+			}
+			if _, exists := b.mutation.After(); exists {
+				s.SetIgnore(orderevent.FieldAfter)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OrderEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OrderEventUpsertBulk) Ignore() *OrderEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrderEventUpsertBulk) DoNothing() *OrderEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrderEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *OrderEventUpsertBulk) Update(set func(*OrderEventUpsert)) *OrderEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrderEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OrderEventUpsertBulk) SetUpdatedAt(v time.Time) *OrderEventUpsertBulk {
+	return u.Update(func(s *OrderEventUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OrderEventUpsertBulk) UpdateUpdatedAt() *OrderEventUpsertBulk {
+	return u.Update(func(s *OrderEventUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *OrderEventUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OrderEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrderEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrderEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
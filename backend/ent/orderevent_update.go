@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/orderevent"
+	"sthl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OrderEventUpdate is the builder for updating OrderEvent entities.
+type OrderEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrderEventMutation
+}
+
+// Where appends a list predicates to the OrderEventUpdate builder.
+func (oeu *OrderEventUpdate) Where(ps ...predicate.OrderEvent) *OrderEventUpdate {
+	oeu.mutation.Where(ps...)
+	return oeu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oeu *OrderEventUpdate) SetUpdatedAt(t time.Time) *OrderEventUpdate {
+	oeu.mutation.SetUpdatedAt(t)
+	return oeu
+}
+
+// Mutation returns the OrderEventMutation object of the builder.
+func (oeu *OrderEventUpdate) Mutation() *OrderEventMutation {
+	return oeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oeu *OrderEventUpdate) Save(ctx context.Context) (int, error) {
+	oeu.defaults()
+	return withHooks[int, OrderEventMutation](ctx, oeu.sqlSave, oeu.mutation, oeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeu *OrderEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := oeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oeu *OrderEventUpdate) Exec(ctx context.Context) error {
+	_, err := oeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeu *OrderEventUpdate) ExecX(ctx context.Context) {
+	if err := oeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oeu *OrderEventUpdate) defaults() {
+	if _, ok := oeu.mutation.UpdatedAt(); !ok {
+		v := orderevent.UpdateDefaultUpdatedAt()
+		oeu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oeu *OrderEventUpdate) check() error {
+	if _, ok := oeu.mutation.OwnerID(); oeu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OrderEvent.owner"`)
+	}
+	return nil
+}
+
+func (oeu *OrderEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := oeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderevent.Table, orderevent.Columns, sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID))
+	if ps := oeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeu.mutation.UpdatedAt(); ok {
+		_spec.SetField(orderevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if oeu.mutation.ActorIDCleared() {
+		_spec.ClearField(orderevent.FieldActorID, field.TypeUUID)
+	}
+	if oeu.mutation.BeforeCleared() {
+		_spec.ClearField(orderevent.FieldBefore, field.TypeJSON)
+	}
+	if oeu.mutation.AfterCleared() {
+		_spec.ClearField(orderevent.FieldAfter, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oeu.mutation.done = true
+	return n, nil
+}
+
+// OrderEventUpdateOne is the builder for updating a single OrderEvent entity.
+type OrderEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrderEventMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oeuo *OrderEventUpdateOne) SetUpdatedAt(t time.Time) *OrderEventUpdateOne {
+	oeuo.mutation.SetUpdatedAt(t)
+	return oeuo
+}
+
+// Mutation returns the OrderEventMutation object of the builder.
+func (oeuo *OrderEventUpdateOne) Mutation() *OrderEventMutation {
+	return oeuo.mutation
+}
+
+// Where appends a list predicates to the OrderEventUpdate builder.
+func (oeuo *OrderEventUpdateOne) Where(ps ...predicate.OrderEvent) *OrderEventUpdateOne {
+	oeuo.mutation.Where(ps...)
+	return oeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oeuo *OrderEventUpdateOne) Select(field string, fields ...string) *OrderEventUpdateOne {
+	oeuo.fields = append([]string{field}, fields...)
+	return oeuo
+}
+
+// Save executes the query and returns the updated OrderEvent entity.
+func (oeuo *OrderEventUpdateOne) Save(ctx context.Context) (*OrderEvent, error) {
+	oeuo.defaults()
+	return withHooks[*OrderEvent, OrderEventMutation](ctx, oeuo.sqlSave, oeuo.mutation, oeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeuo *OrderEventUpdateOne) SaveX(ctx context.Context) *OrderEvent {
+	node, err := oeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oeuo *OrderEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := oeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeuo *OrderEventUpdateOne) ExecX(ctx context.Context) {
+	if err := oeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oeuo *OrderEventUpdateOne) defaults() {
+	if _, ok := oeuo.mutation.UpdatedAt(); !ok {
+		v := orderevent.UpdateDefaultUpdatedAt()
+		oeuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oeuo *OrderEventUpdateOne) check() error {
+	if _, ok := oeuo.mutation.OwnerID(); oeuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OrderEvent.owner"`)
+	}
+	return nil
+}
+
+func (oeuo *OrderEventUpdateOne) sqlSave(ctx context.Context) (_node *OrderEvent, err error) {
+	if err := oeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderevent.Table, orderevent.Columns, sqlgraph.NewFieldSpec(orderevent.FieldID, field.TypeUUID))
+	id, ok := oeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrderEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orderevent.FieldID)
+		for _, f := range fields {
+			if !orderevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != orderevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(orderevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if oeuo.mutation.ActorIDCleared() {
+		_spec.ClearField(orderevent.FieldActorID, field.TypeUUID)
+	}
+	if oeuo.mutation.BeforeCleared() {
+		_spec.ClearField(orderevent.FieldBefore, field.TypeJSON)
+	}
+	if oeuo.mutation.AfterCleared() {
+		_spec.ClearField(orderevent.FieldAfter, field.TypeJSON)
+	}
+	_node = &OrderEvent{config: oeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oeuo.mutation.done = true
+	return _node, nil
+}
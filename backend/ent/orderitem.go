@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sthl/ent/order"
 	"sthl/ent/orderitem"
+	"sthl/money"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
@@ -21,10 +22,12 @@ type OrderItem struct {
 	OrderID uuid.UUID `json:"orderId"`
 	// ProductID holds the value of the "product_id" field.
 	ProductID uuid.UUID `json:"productId"`
+	// VariantID holds the value of the "variant_id" field.
+	VariantID *uuid.UUID `json:"variantId"`
 	// PurchasedName holds the value of the "purchased_name" field.
 	PurchasedName string `json:"purchasedName"`
 	// PurchasedPrice holds the value of the "purchased_price" field.
-	PurchasedPrice float64 `json:"purchasedPrice"`
+	PurchasedPrice money.Amount `json:"purchasedPrice"`
 	// Quantity holds the value of the "quantity" field.
 	Quantity int `json:"quantity"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -59,9 +62,9 @@ func (*OrderItem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case orderitem.FieldPurchasedPrice:
-			values[i] = new(sql.NullFloat64)
-		case orderitem.FieldQuantity:
+		case orderitem.FieldVariantID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case orderitem.FieldPurchasedPrice, orderitem.FieldQuantity:
 			values[i] = new(sql.NullInt64)
 		case orderitem.FieldPurchasedName:
 			values[i] = new(sql.NullString)
@@ -100,6 +103,13 @@ func (oi *OrderItem) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				oi.ProductID = *value
 			}
+		case orderitem.FieldVariantID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field variant_id", values[i])
+			} else if value.Valid {
+				oi.VariantID = new(uuid.UUID)
+				*oi.VariantID = *value.S.(*uuid.UUID)
+			}
 		case orderitem.FieldPurchasedName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field purchased_name", values[i])
@@ -107,10 +117,10 @@ func (oi *OrderItem) assignValues(columns []string, values []any) error {
 				oi.PurchasedName = value.String
 			}
 		case orderitem.FieldPurchasedPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field purchased_price", values[i])
 			} else if value.Valid {
-				oi.PurchasedPrice = value.Float64
+				oi.PurchasedPrice = money.Amount(value.Int64)
 			}
 		case orderitem.FieldQuantity:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -157,6 +167,11 @@ func (oi *OrderItem) String() string {
 	builder.WriteString("product_id=")
 	builder.WriteString(fmt.Sprintf("%v", oi.ProductID))
 	builder.WriteString(", ")
+	if v := oi.VariantID; v != nil {
+		builder.WriteString("variant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("purchased_name=")
 	builder.WriteString(oi.PurchasedName)
 	builder.WriteString(", ")
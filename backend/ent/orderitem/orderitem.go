@@ -15,6 +15,8 @@ const (
 	FieldOrderID = "order_id"
 	// FieldProductID holds the string denoting the product_id field in the database.
 	FieldProductID = "product_id"
+	// FieldVariantID holds the string denoting the variant_id field in the database.
+	FieldVariantID = "variant_id"
 	// FieldPurchasedName holds the string denoting the purchased_name field in the database.
 	FieldPurchasedName = "purchased_name"
 	// FieldPurchasedPrice holds the string denoting the purchased_price field in the database.
@@ -39,6 +41,7 @@ var Columns = []string{
 	FieldID,
 	FieldOrderID,
 	FieldProductID,
+	FieldVariantID,
 	FieldPurchasedName,
 	FieldPurchasedPrice,
 	FieldQuantity,
@@ -58,7 +61,7 @@ var (
 	// PurchasedNameValidator is a validator for the "purchased_name" field. It is called by the builders before save.
 	PurchasedNameValidator func(string) error
 	// PurchasedPriceValidator is a validator for the "purchased_price" field. It is called by the builders before save.
-	PurchasedPriceValidator func(float64) error
+	PurchasedPriceValidator func(int64) error
 	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
 	QuantityValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
@@ -4,6 +4,7 @@ package orderitem
 
 import (
 	"sthl/ent/predicate"
+	"sthl/money"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -65,14 +66,20 @@ func ProductID(v uuid.UUID) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldEQ(FieldProductID, v))
 }
 
+// VariantID applies equality check predicate on the "variant_id" field. It's identical to VariantIDEQ.
+func VariantID(v uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldVariantID, v))
+}
+
 // PurchasedName applies equality check predicate on the "purchased_name" field. It's identical to PurchasedNameEQ.
 func PurchasedName(v string) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldEQ(FieldPurchasedName, v))
 }
 
 // PurchasedPrice applies equality check predicate on the "purchased_price" field. It's identical to PurchasedPriceEQ.
-func PurchasedPrice(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldEQ(FieldPurchasedPrice, v))
+func PurchasedPrice(v money.Amount) predicate.OrderItem {
+	vc := int64(v)
+	return predicate.OrderItem(sql.FieldEQ(FieldPurchasedPrice, vc))
 }
 
 // Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
@@ -140,6 +147,56 @@ func ProductIDLTE(v uuid.UUID) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldLTE(FieldProductID, v))
 }
 
+// VariantIDEQ applies the EQ predicate on the "variant_id" field.
+func VariantIDEQ(v uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldEQ(FieldVariantID, v))
+}
+
+// VariantIDNEQ applies the NEQ predicate on the "variant_id" field.
+func VariantIDNEQ(v uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNEQ(FieldVariantID, v))
+}
+
+// VariantIDIn applies the In predicate on the "variant_id" field.
+func VariantIDIn(vs ...uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIn(FieldVariantID, vs...))
+}
+
+// VariantIDNotIn applies the NotIn predicate on the "variant_id" field.
+func VariantIDNotIn(vs ...uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotIn(FieldVariantID, vs...))
+}
+
+// VariantIDGT applies the GT predicate on the "variant_id" field.
+func VariantIDGT(v uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGT(FieldVariantID, v))
+}
+
+// VariantIDGTE applies the GTE predicate on the "variant_id" field.
+func VariantIDGTE(v uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldGTE(FieldVariantID, v))
+}
+
+// VariantIDLT applies the LT predicate on the "variant_id" field.
+func VariantIDLT(v uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLT(FieldVariantID, v))
+}
+
+// VariantIDLTE applies the LTE predicate on the "variant_id" field.
+func VariantIDLTE(v uuid.UUID) predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldLTE(FieldVariantID, v))
+}
+
+// VariantIDIsNil applies the IsNil predicate on the "variant_id" field.
+func VariantIDIsNil() predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldIsNull(FieldVariantID))
+}
+
+// VariantIDNotNil applies the NotNil predicate on the "variant_id" field.
+func VariantIDNotNil() predicate.OrderItem {
+	return predicate.OrderItem(sql.FieldNotNull(FieldVariantID))
+}
+
 // PurchasedNameEQ applies the EQ predicate on the "purchased_name" field.
 func PurchasedNameEQ(v string) predicate.OrderItem {
 	return predicate.OrderItem(sql.FieldEQ(FieldPurchasedName, v))
@@ -206,43 +263,57 @@ func PurchasedNameContainsFold(v string) predicate.OrderItem {
 }
 
 // PurchasedPriceEQ applies the EQ predicate on the "purchased_price" field.
-func PurchasedPriceEQ(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldEQ(FieldPurchasedPrice, v))
+func PurchasedPriceEQ(v money.Amount) predicate.OrderItem {
+	vc := int64(v)
+	return predicate.OrderItem(sql.FieldEQ(FieldPurchasedPrice, vc))
 }
 
 // PurchasedPriceNEQ applies the NEQ predicate on the "purchased_price" field.
-func PurchasedPriceNEQ(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldNEQ(FieldPurchasedPrice, v))
+func PurchasedPriceNEQ(v money.Amount) predicate.OrderItem {
+	vc := int64(v)
+	return predicate.OrderItem(sql.FieldNEQ(FieldPurchasedPrice, vc))
 }
 
 // PurchasedPriceIn applies the In predicate on the "purchased_price" field.
-func PurchasedPriceIn(vs ...float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldIn(FieldPurchasedPrice, vs...))
+func PurchasedPriceIn(vs ...money.Amount) predicate.OrderItem {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.OrderItem(sql.FieldIn(FieldPurchasedPrice, v...))
 }
 
 // PurchasedPriceNotIn applies the NotIn predicate on the "purchased_price" field.
-func PurchasedPriceNotIn(vs ...float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldNotIn(FieldPurchasedPrice, vs...))
+func PurchasedPriceNotIn(vs ...money.Amount) predicate.OrderItem {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.OrderItem(sql.FieldNotIn(FieldPurchasedPrice, v...))
 }
 
 // PurchasedPriceGT applies the GT predicate on the "purchased_price" field.
-func PurchasedPriceGT(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldGT(FieldPurchasedPrice, v))
+func PurchasedPriceGT(v money.Amount) predicate.OrderItem {
+	vc := int64(v)
+	return predicate.OrderItem(sql.FieldGT(FieldPurchasedPrice, vc))
 }
 
 // PurchasedPriceGTE applies the GTE predicate on the "purchased_price" field.
-func PurchasedPriceGTE(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldGTE(FieldPurchasedPrice, v))
+func PurchasedPriceGTE(v money.Amount) predicate.OrderItem {
+	vc := int64(v)
+	return predicate.OrderItem(sql.FieldGTE(FieldPurchasedPrice, vc))
 }
 
 // PurchasedPriceLT applies the LT predicate on the "purchased_price" field.
-func PurchasedPriceLT(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldLT(FieldPurchasedPrice, v))
+func PurchasedPriceLT(v money.Amount) predicate.OrderItem {
+	vc := int64(v)
+	return predicate.OrderItem(sql.FieldLT(FieldPurchasedPrice, vc))
 }
 
 // PurchasedPriceLTE applies the LTE predicate on the "purchased_price" field.
-func PurchasedPriceLTE(v float64) predicate.OrderItem {
-	return predicate.OrderItem(sql.FieldLTE(FieldPurchasedPrice, v))
+func PurchasedPriceLTE(v money.Amount) predicate.OrderItem {
+	vc := int64(v)
+	return predicate.OrderItem(sql.FieldLTE(FieldPurchasedPrice, vc))
 }
 
 // QuantityEQ applies the EQ predicate on the "quantity" field.
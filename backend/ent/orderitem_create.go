@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sthl/ent/order"
 	"sthl/ent/orderitem"
+	"sthl/money"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -36,6 +37,20 @@ func (oic *OrderItemCreate) SetProductID(u uuid.UUID) *OrderItemCreate {
 	return oic
 }
 
+// SetVariantID sets the "variant_id" field.
+func (oic *OrderItemCreate) SetVariantID(u uuid.UUID) *OrderItemCreate {
+	oic.mutation.SetVariantID(u)
+	return oic
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (oic *OrderItemCreate) SetNillableVariantID(u *uuid.UUID) *OrderItemCreate {
+	if u != nil {
+		oic.SetVariantID(*u)
+	}
+	return oic
+}
+
 // SetPurchasedName sets the "purchased_name" field.
 func (oic *OrderItemCreate) SetPurchasedName(s string) *OrderItemCreate {
 	oic.mutation.SetPurchasedName(s)
@@ -43,8 +58,8 @@ func (oic *OrderItemCreate) SetPurchasedName(s string) *OrderItemCreate {
 }
 
 // SetPurchasedPrice sets the "purchased_price" field.
-func (oic *OrderItemCreate) SetPurchasedPrice(f float64) *OrderItemCreate {
-	oic.mutation.SetPurchasedPrice(f)
+func (oic *OrderItemCreate) SetPurchasedPrice(m money.Amount) *OrderItemCreate {
+	oic.mutation.SetPurchasedPrice(m)
 	return oic
 }
 
@@ -140,7 +155,7 @@ func (oic *OrderItemCreate) check() error {
 		return &ValidationError{Name: "purchased_price", err: errors.New(`ent: missing required field "OrderItem.purchased_price"`)}
 	}
 	if v, ok := oic.mutation.PurchasedPrice(); ok {
-		if err := orderitem.PurchasedPriceValidator(v); err != nil {
+		if err := orderitem.PurchasedPriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "purchased_price", err: fmt.Errorf(`ent: validator failed for field "OrderItem.purchased_price": %w`, err)}
 		}
 	}
@@ -195,12 +210,16 @@ func (oic *OrderItemCreate) createSpec() (*OrderItem, *sqlgraph.CreateSpec) {
 		_spec.SetField(orderitem.FieldProductID, field.TypeUUID, value)
 		_node.ProductID = value
 	}
+	if value, ok := oic.mutation.VariantID(); ok {
+		_spec.SetField(orderitem.FieldVariantID, field.TypeUUID, value)
+		_node.VariantID = &value
+	}
 	if value, ok := oic.mutation.PurchasedName(); ok {
 		_spec.SetField(orderitem.FieldPurchasedName, field.TypeString, value)
 		_node.PurchasedName = value
 	}
 	if value, ok := oic.mutation.PurchasedPrice(); ok {
-		_spec.SetField(orderitem.FieldPurchasedPrice, field.TypeFloat64, value)
+		_spec.SetField(orderitem.FieldPurchasedPrice, field.TypeInt64, value)
 		_node.PurchasedPrice = value
 	}
 	if value, ok := oic.mutation.Quantity(); ok {
@@ -303,6 +322,24 @@ func (u *OrderItemUpsert) UpdateProductID() *OrderItemUpsert {
 	return u
 }
 
+// SetVariantID sets the "variant_id" field.
+func (u *OrderItemUpsert) SetVariantID(v uuid.UUID) *OrderItemUpsert {
+	u.Set(orderitem.FieldVariantID, v)
+	return u
+}
+
+// UpdateVariantID sets the "variant_id" field to the value that was provided on create.
+func (u *OrderItemUpsert) UpdateVariantID() *OrderItemUpsert {
+	u.SetExcluded(orderitem.FieldVariantID)
+	return u
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (u *OrderItemUpsert) ClearVariantID() *OrderItemUpsert {
+	u.SetNull(orderitem.FieldVariantID)
+	return u
+}
+
 // SetPurchasedName sets the "purchased_name" field.
 func (u *OrderItemUpsert) SetPurchasedName(v string) *OrderItemUpsert {
 	u.Set(orderitem.FieldPurchasedName, v)
@@ -316,7 +353,7 @@ func (u *OrderItemUpsert) UpdatePurchasedName() *OrderItemUpsert {
 }
 
 // SetPurchasedPrice sets the "purchased_price" field.
-func (u *OrderItemUpsert) SetPurchasedPrice(v float64) *OrderItemUpsert {
+func (u *OrderItemUpsert) SetPurchasedPrice(v money.Amount) *OrderItemUpsert {
 	u.Set(orderitem.FieldPurchasedPrice, v)
 	return u
 }
@@ -328,7 +365,7 @@ func (u *OrderItemUpsert) UpdatePurchasedPrice() *OrderItemUpsert {
 }
 
 // AddPurchasedPrice adds v to the "purchased_price" field.
-func (u *OrderItemUpsert) AddPurchasedPrice(v float64) *OrderItemUpsert {
+func (u *OrderItemUpsert) AddPurchasedPrice(v money.Amount) *OrderItemUpsert {
 	u.Add(orderitem.FieldPurchasedPrice, v)
 	return u
 }
@@ -427,6 +464,27 @@ func (u *OrderItemUpsertOne) UpdateProductID() *OrderItemUpsertOne {
 	})
 }
 
+// SetVariantID sets the "variant_id" field.
+func (u *OrderItemUpsertOne) SetVariantID(v uuid.UUID) *OrderItemUpsertOne {
+	return u.Update(func(s *OrderItemUpsert) {
+		s.SetVariantID(v)
+	})
+}
+
+// UpdateVariantID sets the "variant_id" field to the value that was provided on create.
+func (u *OrderItemUpsertOne) UpdateVariantID() *OrderItemUpsertOne {
+	return u.Update(func(s *OrderItemUpsert) {
+		s.UpdateVariantID()
+	})
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (u *OrderItemUpsertOne) ClearVariantID() *OrderItemUpsertOne {
+	return u.Update(func(s *OrderItemUpsert) {
+		s.ClearVariantID()
+	})
+}
+
 // SetPurchasedName sets the "purchased_name" field.
 func (u *OrderItemUpsertOne) SetPurchasedName(v string) *OrderItemUpsertOne {
 	return u.Update(func(s *OrderItemUpsert) {
@@ -442,14 +500,14 @@ func (u *OrderItemUpsertOne) UpdatePurchasedName() *OrderItemUpsertOne {
 }
 
 // SetPurchasedPrice sets the "purchased_price" field.
-func (u *OrderItemUpsertOne) SetPurchasedPrice(v float64) *OrderItemUpsertOne {
+func (u *OrderItemUpsertOne) SetPurchasedPrice(v money.Amount) *OrderItemUpsertOne {
 	return u.Update(func(s *OrderItemUpsert) {
 		s.SetPurchasedPrice(v)
 	})
 }
 
 // AddPurchasedPrice adds v to the "purchased_price" field.
-func (u *OrderItemUpsertOne) AddPurchasedPrice(v float64) *OrderItemUpsertOne {
+func (u *OrderItemUpsertOne) AddPurchasedPrice(v money.Amount) *OrderItemUpsertOne {
 	return u.Update(func(s *OrderItemUpsert) {
 		s.AddPurchasedPrice(v)
 	})
@@ -722,6 +780,27 @@ func (u *OrderItemUpsertBulk) UpdateProductID() *OrderItemUpsertBulk {
 	})
 }
 
+// SetVariantID sets the "variant_id" field.
+func (u *OrderItemUpsertBulk) SetVariantID(v uuid.UUID) *OrderItemUpsertBulk {
+	return u.Update(func(s *OrderItemUpsert) {
+		s.SetVariantID(v)
+	})
+}
+
+// UpdateVariantID sets the "variant_id" field to the value that was provided on create.
+func (u *OrderItemUpsertBulk) UpdateVariantID() *OrderItemUpsertBulk {
+	return u.Update(func(s *OrderItemUpsert) {
+		s.UpdateVariantID()
+	})
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (u *OrderItemUpsertBulk) ClearVariantID() *OrderItemUpsertBulk {
+	return u.Update(func(s *OrderItemUpsert) {
+		s.ClearVariantID()
+	})
+}
+
 // SetPurchasedName sets the "purchased_name" field.
 func (u *OrderItemUpsertBulk) SetPurchasedName(v string) *OrderItemUpsertBulk {
 	return u.Update(func(s *OrderItemUpsert) {
@@ -737,14 +816,14 @@ func (u *OrderItemUpsertBulk) UpdatePurchasedName() *OrderItemUpsertBulk {
 }
 
 // SetPurchasedPrice sets the "purchased_price" field.
-func (u *OrderItemUpsertBulk) SetPurchasedPrice(v float64) *OrderItemUpsertBulk {
+func (u *OrderItemUpsertBulk) SetPurchasedPrice(v money.Amount) *OrderItemUpsertBulk {
 	return u.Update(func(s *OrderItemUpsert) {
 		s.SetPurchasedPrice(v)
 	})
 }
 
 // AddPurchasedPrice adds v to the "purchased_price" field.
-func (u *OrderItemUpsertBulk) AddPurchasedPrice(v float64) *OrderItemUpsertBulk {
+func (u *OrderItemUpsertBulk) AddPurchasedPrice(v money.Amount) *OrderItemUpsertBulk {
 	return u.Update(func(s *OrderItemUpsert) {
 		s.AddPurchasedPrice(v)
 	})
@@ -10,6 +10,7 @@ import (
 	"sthl/ent/orderitem"
 	"sthl/ent/predicate"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -24,6 +25,7 @@ type OrderItemQuery struct {
 	inters     []Interceptor
 	predicates []predicate.OrderItem
 	withOwner  *OrderQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -383,6 +385,9 @@ func (oiq *OrderItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*O
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(oiq.modifiers) > 0 {
+		_spec.Modifiers = oiq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -433,6 +438,9 @@ func (oiq *OrderItemQuery) loadOwner(ctx context.Context, query *OrderQuery, nod
 
 func (oiq *OrderItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := oiq.querySpec()
+	if len(oiq.modifiers) > 0 {
+		_spec.Modifiers = oiq.modifiers
+	}
 	_spec.Node.Columns = oiq.ctx.Fields
 	if len(oiq.ctx.Fields) > 0 {
 		_spec.Unique = oiq.ctx.Unique != nil && *oiq.ctx.Unique
@@ -495,6 +503,9 @@ func (oiq *OrderItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if oiq.ctx.Unique != nil && *oiq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range oiq.modifiers {
+		m(selector)
+	}
 	for _, p := range oiq.predicates {
 		p(selector)
 	}
@@ -512,6 +523,32 @@ func (oiq *OrderItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (oiq *OrderItemQuery) ForUpdate(opts ...sql.LockOption) *OrderItemQuery {
+	if oiq.driver.Dialect() == dialect.Postgres {
+		oiq.Unique(false)
+	}
+	oiq.modifiers = append(oiq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return oiq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (oiq *OrderItemQuery) ForShare(opts ...sql.LockOption) *OrderItemQuery {
+	if oiq.driver.Dialect() == dialect.Postgres {
+		oiq.Unique(false)
+	}
+	oiq.modifiers = append(oiq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return oiq
+}
+
 // OrderItemGroupBy is the group-by builder for OrderItem entities.
 type OrderItemGroupBy struct {
 	selector
@@ -9,6 +9,7 @@ import (
 	"sthl/ent/order"
 	"sthl/ent/orderitem"
 	"sthl/ent/predicate"
+	"sthl/money"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -41,6 +42,26 @@ func (oiu *OrderItemUpdate) SetProductID(u uuid.UUID) *OrderItemUpdate {
 	return oiu
 }
 
+// SetVariantID sets the "variant_id" field.
+func (oiu *OrderItemUpdate) SetVariantID(u uuid.UUID) *OrderItemUpdate {
+	oiu.mutation.SetVariantID(u)
+	return oiu
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (oiu *OrderItemUpdate) SetNillableVariantID(u *uuid.UUID) *OrderItemUpdate {
+	if u != nil {
+		oiu.SetVariantID(*u)
+	}
+	return oiu
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (oiu *OrderItemUpdate) ClearVariantID() *OrderItemUpdate {
+	oiu.mutation.ClearVariantID()
+	return oiu
+}
+
 // SetPurchasedName sets the "purchased_name" field.
 func (oiu *OrderItemUpdate) SetPurchasedName(s string) *OrderItemUpdate {
 	oiu.mutation.SetPurchasedName(s)
@@ -48,15 +69,15 @@ func (oiu *OrderItemUpdate) SetPurchasedName(s string) *OrderItemUpdate {
 }
 
 // SetPurchasedPrice sets the "purchased_price" field.
-func (oiu *OrderItemUpdate) SetPurchasedPrice(f float64) *OrderItemUpdate {
+func (oiu *OrderItemUpdate) SetPurchasedPrice(m money.Amount) *OrderItemUpdate {
 	oiu.mutation.ResetPurchasedPrice()
-	oiu.mutation.SetPurchasedPrice(f)
+	oiu.mutation.SetPurchasedPrice(m)
 	return oiu
 }
 
-// AddPurchasedPrice adds f to the "purchased_price" field.
-func (oiu *OrderItemUpdate) AddPurchasedPrice(f float64) *OrderItemUpdate {
-	oiu.mutation.AddPurchasedPrice(f)
+// AddPurchasedPrice adds m to the "purchased_price" field.
+func (oiu *OrderItemUpdate) AddPurchasedPrice(m money.Amount) *OrderItemUpdate {
+	oiu.mutation.AddPurchasedPrice(m)
 	return oiu
 }
 
@@ -130,7 +151,7 @@ func (oiu *OrderItemUpdate) check() error {
 		}
 	}
 	if v, ok := oiu.mutation.PurchasedPrice(); ok {
-		if err := orderitem.PurchasedPriceValidator(v); err != nil {
+		if err := orderitem.PurchasedPriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "purchased_price", err: fmt.Errorf(`ent: validator failed for field "OrderItem.purchased_price": %w`, err)}
 		}
 	}
@@ -160,14 +181,20 @@ func (oiu *OrderItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := oiu.mutation.ProductID(); ok {
 		_spec.SetField(orderitem.FieldProductID, field.TypeUUID, value)
 	}
+	if value, ok := oiu.mutation.VariantID(); ok {
+		_spec.SetField(orderitem.FieldVariantID, field.TypeUUID, value)
+	}
+	if oiu.mutation.VariantIDCleared() {
+		_spec.ClearField(orderitem.FieldVariantID, field.TypeUUID)
+	}
 	if value, ok := oiu.mutation.PurchasedName(); ok {
 		_spec.SetField(orderitem.FieldPurchasedName, field.TypeString, value)
 	}
 	if value, ok := oiu.mutation.PurchasedPrice(); ok {
-		_spec.SetField(orderitem.FieldPurchasedPrice, field.TypeFloat64, value)
+		_spec.SetField(orderitem.FieldPurchasedPrice, field.TypeInt64, value)
 	}
 	if value, ok := oiu.mutation.AddedPurchasedPrice(); ok {
-		_spec.AddField(orderitem.FieldPurchasedPrice, field.TypeFloat64, value)
+		_spec.AddField(orderitem.FieldPurchasedPrice, field.TypeInt64, value)
 	}
 	if value, ok := oiu.mutation.Quantity(); ok {
 		_spec.SetField(orderitem.FieldQuantity, field.TypeInt, value)
@@ -242,6 +269,26 @@ func (oiuo *OrderItemUpdateOne) SetProductID(u uuid.UUID) *OrderItemUpdateOne {
 	return oiuo
 }
 
+// SetVariantID sets the "variant_id" field.
+func (oiuo *OrderItemUpdateOne) SetVariantID(u uuid.UUID) *OrderItemUpdateOne {
+	oiuo.mutation.SetVariantID(u)
+	return oiuo
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (oiuo *OrderItemUpdateOne) SetNillableVariantID(u *uuid.UUID) *OrderItemUpdateOne {
+	if u != nil {
+		oiuo.SetVariantID(*u)
+	}
+	return oiuo
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (oiuo *OrderItemUpdateOne) ClearVariantID() *OrderItemUpdateOne {
+	oiuo.mutation.ClearVariantID()
+	return oiuo
+}
+
 // SetPurchasedName sets the "purchased_name" field.
 func (oiuo *OrderItemUpdateOne) SetPurchasedName(s string) *OrderItemUpdateOne {
 	oiuo.mutation.SetPurchasedName(s)
@@ -249,15 +296,15 @@ func (oiuo *OrderItemUpdateOne) SetPurchasedName(s string) *OrderItemUpdateOne {
 }
 
 // SetPurchasedPrice sets the "purchased_price" field.
-func (oiuo *OrderItemUpdateOne) SetPurchasedPrice(f float64) *OrderItemUpdateOne {
+func (oiuo *OrderItemUpdateOne) SetPurchasedPrice(m money.Amount) *OrderItemUpdateOne {
 	oiuo.mutation.ResetPurchasedPrice()
-	oiuo.mutation.SetPurchasedPrice(f)
+	oiuo.mutation.SetPurchasedPrice(m)
 	return oiuo
 }
 
-// AddPurchasedPrice adds f to the "purchased_price" field.
-func (oiuo *OrderItemUpdateOne) AddPurchasedPrice(f float64) *OrderItemUpdateOne {
-	oiuo.mutation.AddPurchasedPrice(f)
+// AddPurchasedPrice adds m to the "purchased_price" field.
+func (oiuo *OrderItemUpdateOne) AddPurchasedPrice(m money.Amount) *OrderItemUpdateOne {
+	oiuo.mutation.AddPurchasedPrice(m)
 	return oiuo
 }
 
@@ -344,7 +391,7 @@ func (oiuo *OrderItemUpdateOne) check() error {
 		}
 	}
 	if v, ok := oiuo.mutation.PurchasedPrice(); ok {
-		if err := orderitem.PurchasedPriceValidator(v); err != nil {
+		if err := orderitem.PurchasedPriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "purchased_price", err: fmt.Errorf(`ent: validator failed for field "OrderItem.purchased_price": %w`, err)}
 		}
 	}
@@ -391,14 +438,20 @@ func (oiuo *OrderItemUpdateOne) sqlSave(ctx context.Context) (_node *OrderItem,
 	if value, ok := oiuo.mutation.ProductID(); ok {
 		_spec.SetField(orderitem.FieldProductID, field.TypeUUID, value)
 	}
+	if value, ok := oiuo.mutation.VariantID(); ok {
+		_spec.SetField(orderitem.FieldVariantID, field.TypeUUID, value)
+	}
+	if oiuo.mutation.VariantIDCleared() {
+		_spec.ClearField(orderitem.FieldVariantID, field.TypeUUID)
+	}
 	if value, ok := oiuo.mutation.PurchasedName(); ok {
 		_spec.SetField(orderitem.FieldPurchasedName, field.TypeString, value)
 	}
 	if value, ok := oiuo.mutation.PurchasedPrice(); ok {
-		_spec.SetField(orderitem.FieldPurchasedPrice, field.TypeFloat64, value)
+		_spec.SetField(orderitem.FieldPurchasedPrice, field.TypeInt64, value)
 	}
 	if value, ok := oiuo.mutation.AddedPurchasedPrice(); ok {
-		_spec.AddField(orderitem.FieldPurchasedPrice, field.TypeFloat64, value)
+		_spec.AddField(orderitem.FieldPurchasedPrice, field.TypeInt64, value)
 	}
 	if value, ok := oiuo.mutation.Quantity(); ok {
 		_spec.SetField(orderitem.FieldQuantity, field.TypeInt, value)
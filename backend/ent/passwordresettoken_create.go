@@ -0,0 +1,818 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/passwordresettoken"
+	"sthl/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PasswordResetTokenCreate is the builder for creating a PasswordResetToken entity.
+type PasswordResetTokenCreate struct {
+	config
+	mutation *PasswordResetTokenMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prtc *PasswordResetTokenCreate) SetCreatedAt(t time.Time) *PasswordResetTokenCreate {
+	prtc.mutation.SetCreatedAt(t)
+	return prtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prtc *PasswordResetTokenCreate) SetNillableCreatedAt(t *time.Time) *PasswordResetTokenCreate {
+	if t != nil {
+		prtc.SetCreatedAt(*t)
+	}
+	return prtc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (prtc *PasswordResetTokenCreate) SetUpdatedAt(t time.Time) *PasswordResetTokenCreate {
+	prtc.mutation.SetUpdatedAt(t)
+	return prtc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (prtc *PasswordResetTokenCreate) SetNillableUpdatedAt(t *time.Time) *PasswordResetTokenCreate {
+	if t != nil {
+		prtc.SetUpdatedAt(*t)
+	}
+	return prtc
+}
+
+// SetUserID sets the "user_id" field.
+func (prtc *PasswordResetTokenCreate) SetUserID(u uuid.UUID) *PasswordResetTokenCreate {
+	prtc.mutation.SetUserID(u)
+	return prtc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (prtc *PasswordResetTokenCreate) SetTokenHash(s string) *PasswordResetTokenCreate {
+	prtc.mutation.SetTokenHash(s)
+	return prtc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (prtc *PasswordResetTokenCreate) SetExpiresAt(t time.Time) *PasswordResetTokenCreate {
+	prtc.mutation.SetExpiresAt(t)
+	return prtc
+}
+
+// SetUsedAt sets the "used_at" field.
+func (prtc *PasswordResetTokenCreate) SetUsedAt(t time.Time) *PasswordResetTokenCreate {
+	prtc.mutation.SetUsedAt(t)
+	return prtc
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (prtc *PasswordResetTokenCreate) SetNillableUsedAt(t *time.Time) *PasswordResetTokenCreate {
+	if t != nil {
+		prtc.SetUsedAt(*t)
+	}
+	return prtc
+}
+
+// SetID sets the "id" field.
+func (prtc *PasswordResetTokenCreate) SetID(u uuid.UUID) *PasswordResetTokenCreate {
+	prtc.mutation.SetID(u)
+	return prtc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (prtc *PasswordResetTokenCreate) SetNillableID(u *uuid.UUID) *PasswordResetTokenCreate {
+	if u != nil {
+		prtc.SetID(*u)
+	}
+	return prtc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (prtc *PasswordResetTokenCreate) SetOwnerID(id uuid.UUID) *PasswordResetTokenCreate {
+	prtc.mutation.SetOwnerID(id)
+	return prtc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (prtc *PasswordResetTokenCreate) SetOwner(u *User) *PasswordResetTokenCreate {
+	return prtc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the PasswordResetTokenMutation object of the builder.
+func (prtc *PasswordResetTokenCreate) Mutation() *PasswordResetTokenMutation {
+	return prtc.mutation
+}
+
+// Save creates the PasswordResetToken in the database.
+func (prtc *PasswordResetTokenCreate) Save(ctx context.Context) (*PasswordResetToken, error) {
+	prtc.defaults()
+	return withHooks[*PasswordResetToken, PasswordResetTokenMutation](ctx, prtc.sqlSave, prtc.mutation, prtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prtc *PasswordResetTokenCreate) SaveX(ctx context.Context) *PasswordResetToken {
+	v, err := prtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prtc *PasswordResetTokenCreate) Exec(ctx context.Context) error {
+	_, err := prtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prtc *PasswordResetTokenCreate) ExecX(ctx context.Context) {
+	if err := prtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prtc *PasswordResetTokenCreate) defaults() {
+	if _, ok := prtc.mutation.CreatedAt(); !ok {
+		v := passwordresettoken.DefaultCreatedAt()
+		prtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := prtc.mutation.UpdatedAt(); !ok {
+		v := passwordresettoken.DefaultUpdatedAt()
+		prtc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := prtc.mutation.ID(); !ok {
+		v := passwordresettoken.DefaultID()
+		prtc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prtc *PasswordResetTokenCreate) check() error {
+	if _, ok := prtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PasswordResetToken.created_at"`)}
+	}
+	if _, ok := prtc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PasswordResetToken.updated_at"`)}
+	}
+	if _, ok := prtc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PasswordResetToken.user_id"`)}
+	}
+	if _, ok := prtc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "PasswordResetToken.token_hash"`)}
+	}
+	if v, ok := prtc.mutation.TokenHash(); ok {
+		if err := passwordresettoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PasswordResetToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := prtc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "PasswordResetToken.expires_at"`)}
+	}
+	if _, ok := prtc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "PasswordResetToken.owner"`)}
+	}
+	return nil
+}
+
+func (prtc *PasswordResetTokenCreate) sqlSave(ctx context.Context) (*PasswordResetToken, error) {
+	if err := prtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	prtc.mutation.id = &_node.ID
+	prtc.mutation.done = true
+	return _node, nil
+}
+
+func (prtc *PasswordResetTokenCreate) createSpec() (*PasswordResetToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PasswordResetToken{config: prtc.config}
+		_spec = sqlgraph.NewCreateSpec(passwordresettoken.Table, sqlgraph.NewFieldSpec(passwordresettoken.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = prtc.conflict
+	if id, ok := prtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := prtc.mutation.CreatedAt(); ok {
+		_spec.SetField(passwordresettoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := prtc.mutation.UpdatedAt(); ok {
+		_spec.SetField(passwordresettoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := prtc.mutation.TokenHash(); ok {
+		_spec.SetField(passwordresettoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := prtc.mutation.ExpiresAt(); ok {
+		_spec.SetField(passwordresettoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := prtc.mutation.UsedAt(); ok {
+		_spec.SetField(passwordresettoken.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if nodes := prtc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordresettoken.OwnerTable,
+			Columns: []string{passwordresettoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PasswordResetToken.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasswordResetTokenUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (prtc *PasswordResetTokenCreate) OnConflict(opts ...sql.ConflictOption) *PasswordResetTokenUpsertOne {
+	prtc.conflict = opts
+	return &PasswordResetTokenUpsertOne{
+		create: prtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PasswordResetToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prtc *PasswordResetTokenCreate) OnConflictColumns(columns ...string) *PasswordResetTokenUpsertOne {
+	prtc.conflict = append(prtc.conflict, sql.ConflictColumns(columns...))
+	return &PasswordResetTokenUpsertOne{
+		create: prtc,
+	}
+}
+
+type (
+	// PasswordResetTokenUpsertOne is the builder for "upsert"-ing
+	//  one PasswordResetToken node.
+	PasswordResetTokenUpsertOne struct {
+		create *PasswordResetTokenCreate
+	}
+
+	// PasswordResetTokenUpsert is the "OnConflict" setter.
+	PasswordResetTokenUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PasswordResetTokenUpsert) SetUpdatedAt(v time.Time) *PasswordResetTokenUpsert {
+	u.Set(passwordresettoken.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsert) UpdateUpdatedAt() *PasswordResetTokenUpsert {
+	u.SetExcluded(passwordresettoken.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PasswordResetTokenUpsert) SetUserID(v uuid.UUID) *PasswordResetTokenUpsert {
+	u.Set(passwordresettoken.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsert) UpdateUserID() *PasswordResetTokenUpsert {
+	u.SetExcluded(passwordresettoken.FieldUserID)
+	return u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *PasswordResetTokenUpsert) SetTokenHash(v string) *PasswordResetTokenUpsert {
+	u.Set(passwordresettoken.FieldTokenHash, v)
+	return u
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsert) UpdateTokenHash() *PasswordResetTokenUpsert {
+	u.SetExcluded(passwordresettoken.FieldTokenHash)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PasswordResetTokenUpsert) SetExpiresAt(v time.Time) *PasswordResetTokenUpsert {
+	u.Set(passwordresettoken.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsert) UpdateExpiresAt() *PasswordResetTokenUpsert {
+	u.SetExcluded(passwordresettoken.FieldExpiresAt)
+	return u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *PasswordResetTokenUpsert) SetUsedAt(v time.Time) *PasswordResetTokenUpsert {
+	u.Set(passwordresettoken.FieldUsedAt, v)
+	return u
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsert) UpdateUsedAt() *PasswordResetTokenUpsert {
+	u.SetExcluded(passwordresettoken.FieldUsedAt)
+	return u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *PasswordResetTokenUpsert) ClearUsedAt() *PasswordResetTokenUpsert {
+	u.SetNull(passwordresettoken.FieldUsedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PasswordResetToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(passwordresettoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PasswordResetTokenUpsertOne) UpdateNewValues() *PasswordResetTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(passwordresettoken.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(passwordresettoken.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PasswordResetToken.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PasswordResetTokenUpsertOne) Ignore() *PasswordResetTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasswordResetTokenUpsertOne) DoNothing() *PasswordResetTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasswordResetTokenCreate.OnConflict
+// documentation for more info.
+func (u *PasswordResetTokenUpsertOne) Update(set func(*PasswordResetTokenUpsert)) *PasswordResetTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasswordResetTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PasswordResetTokenUpsertOne) SetUpdatedAt(v time.Time) *PasswordResetTokenUpsertOne {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsertOne) UpdateUpdatedAt() *PasswordResetTokenUpsertOne {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PasswordResetTokenUpsertOne) SetUserID(v uuid.UUID) *PasswordResetTokenUpsertOne {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsertOne) UpdateUserID() *PasswordResetTokenUpsertOne {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *PasswordResetTokenUpsertOne) SetTokenHash(v string) *PasswordResetTokenUpsertOne {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsertOne) UpdateTokenHash() *PasswordResetTokenUpsertOne {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PasswordResetTokenUpsertOne) SetExpiresAt(v time.Time) *PasswordResetTokenUpsertOne {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsertOne) UpdateExpiresAt() *PasswordResetTokenUpsertOne {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *PasswordResetTokenUpsertOne) SetUsedAt(v time.Time) *PasswordResetTokenUpsertOne {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsertOne) UpdateUsedAt() *PasswordResetTokenUpsertOne {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *PasswordResetTokenUpsertOne) ClearUsedAt() *PasswordResetTokenUpsertOne {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PasswordResetTokenUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasswordResetTokenCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasswordResetTokenUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PasswordResetTokenUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PasswordResetTokenUpsertOne.ID is not supported by MySQL driver. Use PasswordResetTokenUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PasswordResetTokenUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PasswordResetTokenCreateBulk is the builder for creating many PasswordResetToken entities in bulk.
+type PasswordResetTokenCreateBulk struct {
+	config
+	builders []*PasswordResetTokenCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PasswordResetToken entities in the database.
+func (prtcb *PasswordResetTokenCreateBulk) Save(ctx context.Context) ([]*PasswordResetToken, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(prtcb.builders))
+	nodes := make([]*PasswordResetToken, len(prtcb.builders))
+	mutators := make([]Mutator, len(prtcb.builders))
+	for i := range prtcb.builders {
+		func(i int, root context.Context) {
+			builder := prtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PasswordResetTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = prtcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prtcb *PasswordResetTokenCreateBulk) SaveX(ctx context.Context) []*PasswordResetToken {
+	v, err := prtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prtcb *PasswordResetTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := prtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prtcb *PasswordResetTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := prtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PasswordResetToken.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasswordResetTokenUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (prtcb *PasswordResetTokenCreateBulk) OnConflict(opts ...sql.ConflictOption) *PasswordResetTokenUpsertBulk {
+	prtcb.conflict = opts
+	return &PasswordResetTokenUpsertBulk{
+		create: prtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PasswordResetToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prtcb *PasswordResetTokenCreateBulk) OnConflictColumns(columns ...string) *PasswordResetTokenUpsertBulk {
+	prtcb.conflict = append(prtcb.conflict, sql.ConflictColumns(columns...))
+	return &PasswordResetTokenUpsertBulk{
+		create: prtcb,
+	}
+}
+
+// PasswordResetTokenUpsertBulk is the builder for "upsert"-ing
+// a bulk of PasswordResetToken nodes.
+type PasswordResetTokenUpsertBulk struct {
+	create *PasswordResetTokenCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PasswordResetToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(passwordresettoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PasswordResetTokenUpsertBulk) UpdateNewValues() *PasswordResetTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(passwordresettoken.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(passwordresettoken.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PasswordResetToken.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PasswordResetTokenUpsertBulk) Ignore() *PasswordResetTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasswordResetTokenUpsertBulk) DoNothing() *PasswordResetTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasswordResetTokenCreateBulk.OnConflict
+// documentation for more info.
+func (u *PasswordResetTokenUpsertBulk) Update(set func(*PasswordResetTokenUpsert)) *PasswordResetTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasswordResetTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PasswordResetTokenUpsertBulk) SetUpdatedAt(v time.Time) *PasswordResetTokenUpsertBulk {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsertBulk) UpdateUpdatedAt() *PasswordResetTokenUpsertBulk {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PasswordResetTokenUpsertBulk) SetUserID(v uuid.UUID) *PasswordResetTokenUpsertBulk {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsertBulk) UpdateUserID() *PasswordResetTokenUpsertBulk {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *PasswordResetTokenUpsertBulk) SetTokenHash(v string) *PasswordResetTokenUpsertBulk {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsertBulk) UpdateTokenHash() *PasswordResetTokenUpsertBulk {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PasswordResetTokenUpsertBulk) SetExpiresAt(v time.Time) *PasswordResetTokenUpsertBulk {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsertBulk) UpdateExpiresAt() *PasswordResetTokenUpsertBulk {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *PasswordResetTokenUpsertBulk) SetUsedAt(v time.Time) *PasswordResetTokenUpsertBulk {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *PasswordResetTokenUpsertBulk) UpdateUsedAt() *PasswordResetTokenUpsertBulk {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *PasswordResetTokenUpsertBulk) ClearUsedAt() *PasswordResetTokenUpsertBulk {
+	return u.Update(func(s *PasswordResetTokenUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PasswordResetTokenUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PasswordResetTokenCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasswordResetTokenCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasswordResetTokenUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/passwordresettoken"
+	"sthl/ent/predicate"
+	"sthl/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PasswordResetTokenUpdate is the builder for updating PasswordResetToken entities.
+type PasswordResetTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PasswordResetTokenMutation
+}
+
+// Where appends a list predicates to the PasswordResetTokenUpdate builder.
+func (prtu *PasswordResetTokenUpdate) Where(ps ...predicate.PasswordResetToken) *PasswordResetTokenUpdate {
+	prtu.mutation.Where(ps...)
+	return prtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (prtu *PasswordResetTokenUpdate) SetUpdatedAt(t time.Time) *PasswordResetTokenUpdate {
+	prtu.mutation.SetUpdatedAt(t)
+	return prtu
+}
+
+// SetUserID sets the "user_id" field.
+func (prtu *PasswordResetTokenUpdate) SetUserID(u uuid.UUID) *PasswordResetTokenUpdate {
+	prtu.mutation.SetUserID(u)
+	return prtu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (prtu *PasswordResetTokenUpdate) SetTokenHash(s string) *PasswordResetTokenUpdate {
+	prtu.mutation.SetTokenHash(s)
+	return prtu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (prtu *PasswordResetTokenUpdate) SetExpiresAt(t time.Time) *PasswordResetTokenUpdate {
+	prtu.mutation.SetExpiresAt(t)
+	return prtu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (prtu *PasswordResetTokenUpdate) SetUsedAt(t time.Time) *PasswordResetTokenUpdate {
+	prtu.mutation.SetUsedAt(t)
+	return prtu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (prtu *PasswordResetTokenUpdate) SetNillableUsedAt(t *time.Time) *PasswordResetTokenUpdate {
+	if t != nil {
+		prtu.SetUsedAt(*t)
+	}
+	return prtu
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (prtu *PasswordResetTokenUpdate) ClearUsedAt() *PasswordResetTokenUpdate {
+	prtu.mutation.ClearUsedAt()
+	return prtu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (prtu *PasswordResetTokenUpdate) SetOwnerID(id uuid.UUID) *PasswordResetTokenUpdate {
+	prtu.mutation.SetOwnerID(id)
+	return prtu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (prtu *PasswordResetTokenUpdate) SetOwner(u *User) *PasswordResetTokenUpdate {
+	return prtu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the PasswordResetTokenMutation object of the builder.
+func (prtu *PasswordResetTokenUpdate) Mutation() *PasswordResetTokenMutation {
+	return prtu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (prtu *PasswordResetTokenUpdate) ClearOwner() *PasswordResetTokenUpdate {
+	prtu.mutation.ClearOwner()
+	return prtu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (prtu *PasswordResetTokenUpdate) Save(ctx context.Context) (int, error) {
+	prtu.defaults()
+	return withHooks[int, PasswordResetTokenMutation](ctx, prtu.sqlSave, prtu.mutation, prtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prtu *PasswordResetTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := prtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (prtu *PasswordResetTokenUpdate) Exec(ctx context.Context) error {
+	_, err := prtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prtu *PasswordResetTokenUpdate) ExecX(ctx context.Context) {
+	if err := prtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prtu *PasswordResetTokenUpdate) defaults() {
+	if _, ok := prtu.mutation.UpdatedAt(); !ok {
+		v := passwordresettoken.UpdateDefaultUpdatedAt()
+		prtu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prtu *PasswordResetTokenUpdate) check() error {
+	if v, ok := prtu.mutation.TokenHash(); ok {
+		if err := passwordresettoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PasswordResetToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := prtu.mutation.OwnerID(); prtu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PasswordResetToken.owner"`)
+	}
+	return nil
+}
+
+func (prtu *PasswordResetTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := prtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passwordresettoken.Table, passwordresettoken.Columns, sqlgraph.NewFieldSpec(passwordresettoken.FieldID, field.TypeUUID))
+	if ps := prtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := prtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(passwordresettoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := prtu.mutation.TokenHash(); ok {
+		_spec.SetField(passwordresettoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := prtu.mutation.ExpiresAt(); ok {
+		_spec.SetField(passwordresettoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := prtu.mutation.UsedAt(); ok {
+		_spec.SetField(passwordresettoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if prtu.mutation.UsedAtCleared() {
+		_spec.ClearField(passwordresettoken.FieldUsedAt, field.TypeTime)
+	}
+	if prtu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordresettoken.OwnerTable,
+			Columns: []string{passwordresettoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := prtu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordresettoken.OwnerTable,
+			Columns: []string{passwordresettoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, prtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordresettoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	prtu.mutation.done = true
+	return n, nil
+}
+
+// PasswordResetTokenUpdateOne is the builder for updating a single PasswordResetToken entity.
+type PasswordResetTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PasswordResetTokenMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (prtuo *PasswordResetTokenUpdateOne) SetUpdatedAt(t time.Time) *PasswordResetTokenUpdateOne {
+	prtuo.mutation.SetUpdatedAt(t)
+	return prtuo
+}
+
+// SetUserID sets the "user_id" field.
+func (prtuo *PasswordResetTokenUpdateOne) SetUserID(u uuid.UUID) *PasswordResetTokenUpdateOne {
+	prtuo.mutation.SetUserID(u)
+	return prtuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (prtuo *PasswordResetTokenUpdateOne) SetTokenHash(s string) *PasswordResetTokenUpdateOne {
+	prtuo.mutation.SetTokenHash(s)
+	return prtuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (prtuo *PasswordResetTokenUpdateOne) SetExpiresAt(t time.Time) *PasswordResetTokenUpdateOne {
+	prtuo.mutation.SetExpiresAt(t)
+	return prtuo
+}
+
+// SetUsedAt sets the "used_at" field.
+func (prtuo *PasswordResetTokenUpdateOne) SetUsedAt(t time.Time) *PasswordResetTokenUpdateOne {
+	prtuo.mutation.SetUsedAt(t)
+	return prtuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (prtuo *PasswordResetTokenUpdateOne) SetNillableUsedAt(t *time.Time) *PasswordResetTokenUpdateOne {
+	if t != nil {
+		prtuo.SetUsedAt(*t)
+	}
+	return prtuo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (prtuo *PasswordResetTokenUpdateOne) ClearUsedAt() *PasswordResetTokenUpdateOne {
+	prtuo.mutation.ClearUsedAt()
+	return prtuo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (prtuo *PasswordResetTokenUpdateOne) SetOwnerID(id uuid.UUID) *PasswordResetTokenUpdateOne {
+	prtuo.mutation.SetOwnerID(id)
+	return prtuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (prtuo *PasswordResetTokenUpdateOne) SetOwner(u *User) *PasswordResetTokenUpdateOne {
+	return prtuo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the PasswordResetTokenMutation object of the builder.
+func (prtuo *PasswordResetTokenUpdateOne) Mutation() *PasswordResetTokenMutation {
+	return prtuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (prtuo *PasswordResetTokenUpdateOne) ClearOwner() *PasswordResetTokenUpdateOne {
+	prtuo.mutation.ClearOwner()
+	return prtuo
+}
+
+// Where appends a list predicates to the PasswordResetTokenUpdate builder.
+func (prtuo *PasswordResetTokenUpdateOne) Where(ps ...predicate.PasswordResetToken) *PasswordResetTokenUpdateOne {
+	prtuo.mutation.Where(ps...)
+	return prtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (prtuo *PasswordResetTokenUpdateOne) Select(field string, fields ...string) *PasswordResetTokenUpdateOne {
+	prtuo.fields = append([]string{field}, fields...)
+	return prtuo
+}
+
+// Save executes the query and returns the updated PasswordResetToken entity.
+func (prtuo *PasswordResetTokenUpdateOne) Save(ctx context.Context) (*PasswordResetToken, error) {
+	prtuo.defaults()
+	return withHooks[*PasswordResetToken, PasswordResetTokenMutation](ctx, prtuo.sqlSave, prtuo.mutation, prtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prtuo *PasswordResetTokenUpdateOne) SaveX(ctx context.Context) *PasswordResetToken {
+	node, err := prtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (prtuo *PasswordResetTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := prtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prtuo *PasswordResetTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := prtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prtuo *PasswordResetTokenUpdateOne) defaults() {
+	if _, ok := prtuo.mutation.UpdatedAt(); !ok {
+		v := passwordresettoken.UpdateDefaultUpdatedAt()
+		prtuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prtuo *PasswordResetTokenUpdateOne) check() error {
+	if v, ok := prtuo.mutation.TokenHash(); ok {
+		if err := passwordresettoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PasswordResetToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := prtuo.mutation.OwnerID(); prtuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PasswordResetToken.owner"`)
+	}
+	return nil
+}
+
+func (prtuo *PasswordResetTokenUpdateOne) sqlSave(ctx context.Context) (_node *PasswordResetToken, err error) {
+	if err := prtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passwordresettoken.Table, passwordresettoken.Columns, sqlgraph.NewFieldSpec(passwordresettoken.FieldID, field.TypeUUID))
+	id, ok := prtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PasswordResetToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := prtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passwordresettoken.FieldID)
+		for _, f := range fields {
+			if !passwordresettoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passwordresettoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := prtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := prtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(passwordresettoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := prtuo.mutation.TokenHash(); ok {
+		_spec.SetField(passwordresettoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := prtuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(passwordresettoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := prtuo.mutation.UsedAt(); ok {
+		_spec.SetField(passwordresettoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if prtuo.mutation.UsedAtCleared() {
+		_spec.ClearField(passwordresettoken.FieldUsedAt, field.TypeTime)
+	}
+	if prtuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordresettoken.OwnerTable,
+			Columns: []string{passwordresettoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := prtuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordresettoken.OwnerTable,
+			Columns: []string{passwordresettoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PasswordResetToken{config: prtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, prtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordresettoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	prtuo.mutation.done = true
+	return _node, nil
+}
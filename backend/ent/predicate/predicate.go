@@ -6,20 +6,74 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ApiKey is the predicate function for apikey builders.
+type ApiKey func(*sql.Selector)
+
+// Category is the predicate function for category builders.
+type Category func(*sql.Selector)
+
+// Collection is the predicate function for collection builders.
+type Collection func(*sql.Selector)
+
+// EmailVerificationToken is the predicate function for emailverificationtoken builders.
+type EmailVerificationToken func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
 // Imageinfo is the predicate function for imageinfo builders.
 type Imageinfo func(*sql.Selector)
 
+// InventoryMovement is the predicate function for inventorymovement builders.
+type InventoryMovement func(*sql.Selector)
+
+// LoginLockoutEvent is the predicate function for loginlockoutevent builders.
+type LoginLockoutEvent func(*sql.Selector)
+
+// LoginThrottle is the predicate function for loginthrottle builders.
+type LoginThrottle func(*sql.Selector)
+
+// MfaRecoveryCode is the predicate function for mfarecoverycode builders.
+type MfaRecoveryCode func(*sql.Selector)
+
 // Order is the predicate function for order builders.
 type Order func(*sql.Selector)
 
+// OrderEvent is the predicate function for orderevent builders.
+type OrderEvent func(*sql.Selector)
+
 // OrderItem is the predicate function for orderitem builders.
 type OrderItem func(*sql.Selector)
 
+// PasswordResetToken is the predicate function for passwordresettoken builders.
+type PasswordResetToken func(*sql.Selector)
+
 // Product is the predicate function for product builders.
 type Product func(*sql.Selector)
 
+// ProductMedia is the predicate function for productmedia builders.
+type ProductMedia func(*sql.Selector)
+
+// ProductVariant is the predicate function for productvariant builders.
+type ProductVariant func(*sql.Selector)
+
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
+// Shop is the predicate function for shop builders.
+type Shop func(*sql.Selector)
+
+// ShopInvite is the predicate function for shopinvite builders.
+type ShopInvite func(*sql.Selector)
+
+// ShopMember is the predicate function for shopmember builders.
+type ShopMember func(*sql.Selector)
+
 // Siteui is the predicate function for siteui builders.
 type Siteui func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserTotp is the predicate function for usertotp builders.
+type UserTotp func(*sql.Selector)
@@ -3,9 +3,12 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"sthl/ent/product"
+	"sthl/ent/schema"
 	"sthl/ent/user"
+	"sthl/money"
 	"strings"
 	"time"
 
@@ -26,8 +29,12 @@ type Product struct {
 	UserID uuid.UUID `json:"userId"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name"`
+	// Sku holds the value of the "sku" field.
+	Sku *string `json:"sku"`
 	// Price holds the value of the "price" field.
-	Price float64 `json:"price"`
+	Price money.Amount `json:"price"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency"`
 	// Quantity holds the value of the "quantity" field.
 	Quantity int32 `json:"quantity"`
 	// Description holds the value of the "description" field.
@@ -38,6 +45,10 @@ type Product struct {
 	IsArchived bool `json:"isArchived"`
 	// ImgURL holds the value of the "img_url" field.
 	ImgURL string `json:"imgUrl"`
+	// Options holds the value of the "options" field.
+	Options []schema.ProductOption `json:"options"`
+	// Version holds the value of the "version" field.
+	Version int64 `json:"version"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProductQuery when eager-loading is set.
 	Edges ProductEdges `json:"-"`
@@ -47,9 +58,19 @@ type Product struct {
 type ProductEdges struct {
 	// Owner holds the value of the owner edge.
 	Owner *User `json:"owner,omitempty"`
+	// Movements holds the value of the movements edge.
+	Movements []*InventoryMovement `json:"movements,omitempty"`
+	// Variants holds the value of the variants edge.
+	Variants []*ProductVariant `json:"variants,omitempty"`
+	// Media holds the value of the media edge.
+	Media []*ProductMedia `json:"media,omitempty"`
+	// Categories holds the value of the categories edge.
+	Categories []*Category `json:"categories,omitempty"`
+	// Collections holds the value of the collections edge.
+	Collections []*Collection `json:"collections,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [6]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -65,18 +86,63 @@ func (e ProductEdges) OwnerOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "owner"}
 }
 
+// MovementsOrErr returns the Movements value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) MovementsOrErr() ([]*InventoryMovement, error) {
+	if e.loadedTypes[1] {
+		return e.Movements, nil
+	}
+	return nil, &NotLoadedError{edge: "movements"}
+}
+
+// VariantsOrErr returns the Variants value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) VariantsOrErr() ([]*ProductVariant, error) {
+	if e.loadedTypes[2] {
+		return e.Variants, nil
+	}
+	return nil, &NotLoadedError{edge: "variants"}
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) MediaOrErr() ([]*ProductMedia, error) {
+	if e.loadedTypes[3] {
+		return e.Media, nil
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// CategoriesOrErr returns the Categories value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) CategoriesOrErr() ([]*Category, error) {
+	if e.loadedTypes[4] {
+		return e.Categories, nil
+	}
+	return nil, &NotLoadedError{edge: "categories"}
+}
+
+// CollectionsOrErr returns the Collections value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) CollectionsOrErr() ([]*Collection, error) {
+	if e.loadedTypes[5] {
+		return e.Collections, nil
+	}
+	return nil, &NotLoadedError{edge: "collections"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Product) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case product.FieldOptions:
+			values[i] = new([]byte)
 		case product.FieldIsArchived:
 			values[i] = new(sql.NullBool)
-		case product.FieldPrice:
-			values[i] = new(sql.NullFloat64)
-		case product.FieldQuantity:
+		case product.FieldPrice, product.FieldQuantity, product.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case product.FieldName, product.FieldDescription, product.FieldStatus, product.FieldImgURL:
+		case product.FieldName, product.FieldSku, product.FieldCurrency, product.FieldDescription, product.FieldStatus, product.FieldImgURL:
 			values[i] = new(sql.NullString)
 		case product.FieldCreatedAt, product.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -127,11 +193,24 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.Name = value.String
 			}
+		case product.FieldSku:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sku", values[i])
+			} else if value.Valid {
+				pr.Sku = new(string)
+				*pr.Sku = value.String
+			}
 		case product.FieldPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field price", values[i])
 			} else if value.Valid {
-				pr.Price = value.Float64
+				pr.Price = money.Amount(value.Int64)
+			}
+		case product.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				pr.Currency = value.String
 			}
 		case product.FieldQuantity:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -163,6 +242,20 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.ImgURL = value.String
 			}
+		case product.FieldOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.Options); err != nil {
+					return fmt.Errorf("unmarshal field options: %w", err)
+				}
+			}
+		case product.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pr.Version = value.Int64
+			}
 		}
 	}
 	return nil
@@ -173,6 +266,31 @@ func (pr *Product) QueryOwner() *UserQuery {
 	return NewProductClient(pr.config).QueryOwner(pr)
 }
 
+// QueryMovements queries the "movements" edge of the Product entity.
+func (pr *Product) QueryMovements() *InventoryMovementQuery {
+	return NewProductClient(pr.config).QueryMovements(pr)
+}
+
+// QueryVariants queries the "variants" edge of the Product entity.
+func (pr *Product) QueryVariants() *ProductVariantQuery {
+	return NewProductClient(pr.config).QueryVariants(pr)
+}
+
+// QueryMedia queries the "media" edge of the Product entity.
+func (pr *Product) QueryMedia() *ProductMediaQuery {
+	return NewProductClient(pr.config).QueryMedia(pr)
+}
+
+// QueryCategories queries the "categories" edge of the Product entity.
+func (pr *Product) QueryCategories() *CategoryQuery {
+	return NewProductClient(pr.config).QueryCategories(pr)
+}
+
+// QueryCollections queries the "collections" edge of the Product entity.
+func (pr *Product) QueryCollections() *CollectionQuery {
+	return NewProductClient(pr.config).QueryCollections(pr)
+}
+
 // Update returns a builder for updating this Product.
 // Note that you need to call Product.Unwrap() before calling this method if this Product
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -208,9 +326,17 @@ func (pr *Product) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(pr.Name)
 	builder.WriteString(", ")
+	if v := pr.Sku; v != nil {
+		builder.WriteString("sku=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("price=")
 	builder.WriteString(fmt.Sprintf("%v", pr.Price))
 	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(pr.Currency)
+	builder.WriteString(", ")
 	builder.WriteString("quantity=")
 	builder.WriteString(fmt.Sprintf("%v", pr.Quantity))
 	builder.WriteString(", ")
@@ -225,6 +351,12 @@ func (pr *Product) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("img_url=")
 	builder.WriteString(pr.ImgURL)
+	builder.WriteString(", ")
+	builder.WriteString("options=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Options))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package product
 
 import (
+	"sthl/ent/schema"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,8 +22,12 @@ const (
 	FieldUserID = "user_id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldSku holds the string denoting the sku field in the database.
+	FieldSku = "sku"
 	// FieldPrice holds the string denoting the price field in the database.
 	FieldPrice = "price"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
 	// FieldQuantity holds the string denoting the quantity field in the database.
 	FieldQuantity = "quantity"
 	// FieldDescription holds the string denoting the description field in the database.
@@ -33,8 +38,22 @@ const (
 	FieldIsArchived = "is_archived"
 	// FieldImgURL holds the string denoting the img_url field in the database.
 	FieldImgURL = "img_url"
+	// FieldOptions holds the string denoting the options field in the database.
+	FieldOptions = "options"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
+	// EdgeMovements holds the string denoting the movements edge name in mutations.
+	EdgeMovements = "movements"
+	// EdgeVariants holds the string denoting the variants edge name in mutations.
+	EdgeVariants = "variants"
+	// EdgeMedia holds the string denoting the media edge name in mutations.
+	EdgeMedia = "media"
+	// EdgeCategories holds the string denoting the categories edge name in mutations.
+	EdgeCategories = "categories"
+	// EdgeCollections holds the string denoting the collections edge name in mutations.
+	EdgeCollections = "collections"
 	// Table holds the table name of the product in the database.
 	Table = "products"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -44,6 +63,37 @@ const (
 	OwnerInverseTable = "users"
 	// OwnerColumn is the table column denoting the owner relation/edge.
 	OwnerColumn = "user_id"
+	// MovementsTable is the table that holds the movements relation/edge.
+	MovementsTable = "inventory_movements"
+	// MovementsInverseTable is the table name for the InventoryMovement entity.
+	// It exists in this package in order to avoid circular dependency with the "inventorymovement" package.
+	MovementsInverseTable = "inventory_movements"
+	// MovementsColumn is the table column denoting the movements relation/edge.
+	MovementsColumn = "product_id"
+	// VariantsTable is the table that holds the variants relation/edge.
+	VariantsTable = "product_variants"
+	// VariantsInverseTable is the table name for the ProductVariant entity.
+	// It exists in this package in order to avoid circular dependency with the "productvariant" package.
+	VariantsInverseTable = "product_variants"
+	// VariantsColumn is the table column denoting the variants relation/edge.
+	VariantsColumn = "product_id"
+	// MediaTable is the table that holds the media relation/edge.
+	MediaTable = "product_media"
+	// MediaInverseTable is the table name for the ProductMedia entity.
+	// It exists in this package in order to avoid circular dependency with the "productmedia" package.
+	MediaInverseTable = "product_media"
+	// MediaColumn is the table column denoting the media relation/edge.
+	MediaColumn = "product_id"
+	// CategoriesTable is the table that holds the categories relation/edge. The primary key declared below.
+	CategoriesTable = "category_products"
+	// CategoriesInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	CategoriesInverseTable = "categories"
+	// CollectionsTable is the table that holds the collections relation/edge. The primary key declared below.
+	CollectionsTable = "collection_products"
+	// CollectionsInverseTable is the table name for the Collection entity.
+	// It exists in this package in order to avoid circular dependency with the "collection" package.
+	CollectionsInverseTable = "collections"
 )
 
 // Columns holds all SQL columns for product fields.
@@ -53,14 +103,27 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldUserID,
 	FieldName,
+	FieldSku,
 	FieldPrice,
+	FieldCurrency,
 	FieldQuantity,
 	FieldDescription,
 	FieldStatus,
 	FieldIsArchived,
 	FieldImgURL,
+	FieldOptions,
+	FieldVersion,
 }
 
+var (
+	// CategoriesPrimaryKey and CategoriesColumn2 are the table columns denoting the
+	// primary key for the categories relation (M2M).
+	CategoriesPrimaryKey = []string{"category_id", "product_id"}
+	// CollectionsPrimaryKey and CollectionsColumn2 are the table columns denoting the
+	// primary key for the collections relation (M2M).
+	CollectionsPrimaryKey = []string{"collection_id", "product_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -80,8 +143,14 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// SkuValidator is a validator for the "sku" field. It is called by the builders before save.
+	SkuValidator func(string) error
 	// PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	PriceValidator func(float64) error
+	PriceValidator func(int64) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
 	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
 	QuantityValidator func(int32) error
 	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
@@ -94,6 +163,10 @@ var (
 	DefaultImgURL string
 	// ImgURLValidator is a validator for the "img_url" field. It is called by the builders before save.
 	ImgURLValidator func(string) error
+	// DefaultOptions holds the default value on creation for the "options" field.
+	DefaultOptions []schema.ProductOption
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int64
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -6,8 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sthl/ent/category"
+	"sthl/ent/collection"
+	"sthl/ent/inventorymovement"
 	"sthl/ent/product"
+	"sthl/ent/productmedia"
+	"sthl/ent/productvariant"
+	"sthl/ent/schema"
 	"sthl/ent/user"
+	"sthl/money"
 	"time"
 
 	"entgo.io/ent/dialect"
@@ -65,9 +72,37 @@ func (pc *ProductCreate) SetName(s string) *ProductCreate {
 	return pc
 }
 
+// SetSku sets the "sku" field.
+func (pc *ProductCreate) SetSku(s string) *ProductCreate {
+	pc.mutation.SetSku(s)
+	return pc
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableSku(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetSku(*s)
+	}
+	return pc
+}
+
 // SetPrice sets the "price" field.
-func (pc *ProductCreate) SetPrice(f float64) *ProductCreate {
-	pc.mutation.SetPrice(f)
+func (pc *ProductCreate) SetPrice(m money.Amount) *ProductCreate {
+	pc.mutation.SetPrice(m)
+	return pc
+}
+
+// SetCurrency sets the "currency" field.
+func (pc *ProductCreate) SetCurrency(s string) *ProductCreate {
+	pc.mutation.SetCurrency(s)
+	return pc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableCurrency(s *string) *ProductCreate {
+	if s != nil {
+		pc.SetCurrency(*s)
+	}
 	return pc
 }
 
@@ -117,6 +152,26 @@ func (pc *ProductCreate) SetNillableImgURL(s *string) *ProductCreate {
 	return pc
 }
 
+// SetOptions sets the "options" field.
+func (pc *ProductCreate) SetOptions(so []schema.ProductOption) *ProductCreate {
+	pc.mutation.SetOptions(so)
+	return pc
+}
+
+// SetVersion sets the "version" field.
+func (pc *ProductCreate) SetVersion(i int64) *ProductCreate {
+	pc.mutation.SetVersion(i)
+	return pc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableVersion(i *int64) *ProductCreate {
+	if i != nil {
+		pc.SetVersion(*i)
+	}
+	return pc
+}
+
 // SetID sets the "id" field.
 func (pc *ProductCreate) SetID(u uuid.UUID) *ProductCreate {
 	pc.mutation.SetID(u)
@@ -142,6 +197,81 @@ func (pc *ProductCreate) SetOwner(u *User) *ProductCreate {
 	return pc.SetOwnerID(u.ID)
 }
 
+// AddMovementIDs adds the "movements" edge to the InventoryMovement entity by IDs.
+func (pc *ProductCreate) AddMovementIDs(ids ...uuid.UUID) *ProductCreate {
+	pc.mutation.AddMovementIDs(ids...)
+	return pc
+}
+
+// AddMovements adds the "movements" edges to the InventoryMovement entity.
+func (pc *ProductCreate) AddMovements(i ...*InventoryMovement) *ProductCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pc.AddMovementIDs(ids...)
+}
+
+// AddVariantIDs adds the "variants" edge to the ProductVariant entity by IDs.
+func (pc *ProductCreate) AddVariantIDs(ids ...uuid.UUID) *ProductCreate {
+	pc.mutation.AddVariantIDs(ids...)
+	return pc
+}
+
+// AddVariants adds the "variants" edges to the ProductVariant entity.
+func (pc *ProductCreate) AddVariants(p ...*ProductVariant) *ProductCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddVariantIDs(ids...)
+}
+
+// AddMediumIDs adds the "media" edge to the ProductMedia entity by IDs.
+func (pc *ProductCreate) AddMediumIDs(ids ...uuid.UUID) *ProductCreate {
+	pc.mutation.AddMediumIDs(ids...)
+	return pc
+}
+
+// AddMedia adds the "media" edges to the ProductMedia entity.
+func (pc *ProductCreate) AddMedia(p ...*ProductMedia) *ProductCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddMediumIDs(ids...)
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (pc *ProductCreate) AddCategoryIDs(ids ...uuid.UUID) *ProductCreate {
+	pc.mutation.AddCategoryIDs(ids...)
+	return pc
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (pc *ProductCreate) AddCategories(c ...*Category) *ProductCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pc.AddCategoryIDs(ids...)
+}
+
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (pc *ProductCreate) AddCollectionIDs(ids ...uuid.UUID) *ProductCreate {
+	pc.mutation.AddCollectionIDs(ids...)
+	return pc
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (pc *ProductCreate) AddCollections(c ...*Collection) *ProductCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pc.AddCollectionIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (pc *ProductCreate) Mutation() *ProductMutation {
 	return pc.mutation
@@ -185,6 +315,10 @@ func (pc *ProductCreate) defaults() {
 		v := product.DefaultUpdatedAt()
 		pc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := pc.mutation.Currency(); !ok {
+		v := product.DefaultCurrency
+		pc.mutation.SetCurrency(v)
+	}
 	if _, ok := pc.mutation.IsArchived(); !ok {
 		v := product.DefaultIsArchived
 		pc.mutation.SetIsArchived(v)
@@ -193,6 +327,14 @@ func (pc *ProductCreate) defaults() {
 		v := product.DefaultImgURL
 		pc.mutation.SetImgURL(v)
 	}
+	if _, ok := pc.mutation.Options(); !ok {
+		v := product.DefaultOptions
+		pc.mutation.SetOptions(v)
+	}
+	if _, ok := pc.mutation.Version(); !ok {
+		v := product.DefaultVersion
+		pc.mutation.SetVersion(v)
+	}
 	if _, ok := pc.mutation.ID(); !ok {
 		v := product.DefaultID()
 		pc.mutation.SetID(v)
@@ -218,14 +360,27 @@ func (pc *ProductCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
 		}
 	}
+	if v, ok := pc.mutation.Sku(); ok {
+		if err := product.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "Product.sku": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.Price(); !ok {
 		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Product.price"`)}
 	}
 	if v, ok := pc.mutation.Price(); ok {
-		if err := product.PriceValidator(v); err != nil {
+		if err := product.PriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Product.currency"`)}
+	}
+	if v, ok := pc.mutation.Currency(); ok {
+		if err := product.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Product.currency": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.Quantity(); !ok {
 		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "Product.quantity"`)}
 	}
@@ -261,6 +416,12 @@ func (pc *ProductCreate) check() error {
 			return &ValidationError{Name: "img_url", err: fmt.Errorf(`ent: validator failed for field "Product.img_url": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.Options(); !ok {
+		return &ValidationError{Name: "options", err: errors.New(`ent: missing required field "Product.options"`)}
+	}
+	if _, ok := pc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Product.version"`)}
+	}
 	if _, ok := pc.mutation.OwnerID(); !ok {
 		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Product.owner"`)}
 	}
@@ -312,10 +473,18 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := pc.mutation.Sku(); ok {
+		_spec.SetField(product.FieldSku, field.TypeString, value)
+		_node.Sku = &value
+	}
 	if value, ok := pc.mutation.Price(); ok {
-		_spec.SetField(product.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(product.FieldPrice, field.TypeInt64, value)
 		_node.Price = value
 	}
+	if value, ok := pc.mutation.Currency(); ok {
+		_spec.SetField(product.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
 	if value, ok := pc.mutation.Quantity(); ok {
 		_spec.SetField(product.FieldQuantity, field.TypeInt32, value)
 		_node.Quantity = value
@@ -336,6 +505,14 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldImgURL, field.TypeString, value)
 		_node.ImgURL = value
 	}
+	if value, ok := pc.mutation.Options(); ok {
+		_spec.SetField(product.FieldOptions, field.TypeJSON, value)
+		_node.Options = value
+	}
+	if value, ok := pc.mutation.Version(); ok {
+		_spec.SetField(product.FieldVersion, field.TypeInt64, value)
+		_node.Version = value
+	}
 	if nodes := pc.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -356,6 +533,101 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: inventorymovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.VariantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productvariant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CategoriesTable,
+			Columns: product.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: category.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CollectionsTable,
+			Columns: product.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: collection.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -444,8 +716,26 @@ func (u *ProductUpsert) UpdateName() *ProductUpsert {
 	return u
 }
 
+// SetSku sets the "sku" field.
+func (u *ProductUpsert) SetSku(v string) *ProductUpsert {
+	u.Set(product.FieldSku, v)
+	return u
+}
+
+// UpdateSku sets the "sku" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateSku() *ProductUpsert {
+	u.SetExcluded(product.FieldSku)
+	return u
+}
+
+// ClearSku clears the value of the "sku" field.
+func (u *ProductUpsert) ClearSku() *ProductUpsert {
+	u.SetNull(product.FieldSku)
+	return u
+}
+
 // SetPrice sets the "price" field.
-func (u *ProductUpsert) SetPrice(v float64) *ProductUpsert {
+func (u *ProductUpsert) SetPrice(v money.Amount) *ProductUpsert {
 	u.Set(product.FieldPrice, v)
 	return u
 }
@@ -457,11 +747,23 @@ func (u *ProductUpsert) UpdatePrice() *ProductUpsert {
 }
 
 // AddPrice adds v to the "price" field.
-func (u *ProductUpsert) AddPrice(v float64) *ProductUpsert {
+func (u *ProductUpsert) AddPrice(v money.Amount) *ProductUpsert {
 	u.Add(product.FieldPrice, v)
 	return u
 }
 
+// SetCurrency sets the "currency" field.
+func (u *ProductUpsert) SetCurrency(v string) *ProductUpsert {
+	u.Set(product.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateCurrency() *ProductUpsert {
+	u.SetExcluded(product.FieldCurrency)
+	return u
+}
+
 // SetQuantity sets the "quantity" field.
 func (u *ProductUpsert) SetQuantity(v int32) *ProductUpsert {
 	u.Set(product.FieldQuantity, v)
@@ -528,6 +830,36 @@ func (u *ProductUpsert) UpdateImgURL() *ProductUpsert {
 	return u
 }
 
+// SetOptions sets the "options" field.
+func (u *ProductUpsert) SetOptions(v []schema.ProductOption) *ProductUpsert {
+	u.Set(product.FieldOptions, v)
+	return u
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateOptions() *ProductUpsert {
+	u.SetExcluded(product.FieldOptions)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *ProductUpsert) SetVersion(v int64) *ProductUpsert {
+	u.Set(product.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateVersion() *ProductUpsert {
+	u.SetExcluded(product.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ProductUpsert) AddVersion(v int64) *ProductUpsert {
+	u.Add(product.FieldVersion, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -621,15 +953,36 @@ func (u *ProductUpsertOne) UpdateName() *ProductUpsertOne {
 	})
 }
 
+// SetSku sets the "sku" field.
+func (u *ProductUpsertOne) SetSku(v string) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetSku(v)
+	})
+}
+
+// UpdateSku sets the "sku" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateSku() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateSku()
+	})
+}
+
+// ClearSku clears the value of the "sku" field.
+func (u *ProductUpsertOne) ClearSku() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearSku()
+	})
+}
+
 // SetPrice sets the "price" field.
-func (u *ProductUpsertOne) SetPrice(v float64) *ProductUpsertOne {
+func (u *ProductUpsertOne) SetPrice(v money.Amount) *ProductUpsertOne {
 	return u.Update(func(s *ProductUpsert) {
 		s.SetPrice(v)
 	})
 }
 
 // AddPrice adds v to the "price" field.
-func (u *ProductUpsertOne) AddPrice(v float64) *ProductUpsertOne {
+func (u *ProductUpsertOne) AddPrice(v money.Amount) *ProductUpsertOne {
 	return u.Update(func(s *ProductUpsert) {
 		s.AddPrice(v)
 	})
@@ -642,6 +995,20 @@ func (u *ProductUpsertOne) UpdatePrice() *ProductUpsertOne {
 	})
 }
 
+// SetCurrency sets the "currency" field.
+func (u *ProductUpsertOne) SetCurrency(v string) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateCurrency() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
 // SetQuantity sets the "quantity" field.
 func (u *ProductUpsertOne) SetQuantity(v int32) *ProductUpsertOne {
 	return u.Update(func(s *ProductUpsert) {
@@ -719,6 +1086,41 @@ func (u *ProductUpsertOne) UpdateImgURL() *ProductUpsertOne {
 	})
 }
 
+// SetOptions sets the "options" field.
+func (u *ProductUpsertOne) SetOptions(v []schema.ProductOption) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetOptions(v)
+	})
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateOptions() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateOptions()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ProductUpsertOne) SetVersion(v int64) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ProductUpsertOne) AddVersion(v int64) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateVersion() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateVersion()
+	})
+}
+
 // Exec executes the query.
 func (u *ProductUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -975,15 +1377,36 @@ func (u *ProductUpsertBulk) UpdateName() *ProductUpsertBulk {
 	})
 }
 
+// SetSku sets the "sku" field.
+func (u *ProductUpsertBulk) SetSku(v string) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetSku(v)
+	})
+}
+
+// UpdateSku sets the "sku" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateSku() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateSku()
+	})
+}
+
+// ClearSku clears the value of the "sku" field.
+func (u *ProductUpsertBulk) ClearSku() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearSku()
+	})
+}
+
 // SetPrice sets the "price" field.
-func (u *ProductUpsertBulk) SetPrice(v float64) *ProductUpsertBulk {
+func (u *ProductUpsertBulk) SetPrice(v money.Amount) *ProductUpsertBulk {
 	return u.Update(func(s *ProductUpsert) {
 		s.SetPrice(v)
 	})
 }
 
 // AddPrice adds v to the "price" field.
-func (u *ProductUpsertBulk) AddPrice(v float64) *ProductUpsertBulk {
+func (u *ProductUpsertBulk) AddPrice(v money.Amount) *ProductUpsertBulk {
 	return u.Update(func(s *ProductUpsert) {
 		s.AddPrice(v)
 	})
@@ -996,6 +1419,20 @@ func (u *ProductUpsertBulk) UpdatePrice() *ProductUpsertBulk {
 	})
 }
 
+// SetCurrency sets the "currency" field.
+func (u *ProductUpsertBulk) SetCurrency(v string) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateCurrency() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
 // SetQuantity sets the "quantity" field.
 func (u *ProductUpsertBulk) SetQuantity(v int32) *ProductUpsertBulk {
 	return u.Update(func(s *ProductUpsert) {
@@ -1073,6 +1510,41 @@ func (u *ProductUpsertBulk) UpdateImgURL() *ProductUpsertBulk {
 	})
 }
 
+// SetOptions sets the "options" field.
+func (u *ProductUpsertBulk) SetOptions(v []schema.ProductOption) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetOptions(v)
+	})
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateOptions() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateOptions()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ProductUpsertBulk) SetVersion(v int64) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ProductUpsertBulk) AddVersion(v int64) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateVersion() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateVersion()
+	})
+}
+
 // Exec executes the query.
 func (u *ProductUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -6,13 +6,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sthl/ent/category"
+	"sthl/ent/collection"
+	"sthl/ent/inventorymovement"
 	"sthl/ent/predicate"
 	"sthl/ent/product"
+	"sthl/ent/productmedia"
+	"sthl/ent/productvariant"
+	"sthl/ent/schema"
 	"sthl/ent/user"
+	"sthl/money"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
@@ -48,16 +56,50 @@ func (pu *ProductUpdate) SetName(s string) *ProductUpdate {
 	return pu
 }
 
+// SetSku sets the "sku" field.
+func (pu *ProductUpdate) SetSku(s string) *ProductUpdate {
+	pu.mutation.SetSku(s)
+	return pu
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableSku(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetSku(*s)
+	}
+	return pu
+}
+
+// ClearSku clears the value of the "sku" field.
+func (pu *ProductUpdate) ClearSku() *ProductUpdate {
+	pu.mutation.ClearSku()
+	return pu
+}
+
 // SetPrice sets the "price" field.
-func (pu *ProductUpdate) SetPrice(f float64) *ProductUpdate {
+func (pu *ProductUpdate) SetPrice(m money.Amount) *ProductUpdate {
 	pu.mutation.ResetPrice()
-	pu.mutation.SetPrice(f)
+	pu.mutation.SetPrice(m)
+	return pu
+}
+
+// AddPrice adds m to the "price" field.
+func (pu *ProductUpdate) AddPrice(m money.Amount) *ProductUpdate {
+	pu.mutation.AddPrice(m)
+	return pu
+}
+
+// SetCurrency sets the "currency" field.
+func (pu *ProductUpdate) SetCurrency(s string) *ProductUpdate {
+	pu.mutation.SetCurrency(s)
 	return pu
 }
 
-// AddPrice adds f to the "price" field.
-func (pu *ProductUpdate) AddPrice(f float64) *ProductUpdate {
-	pu.mutation.AddPrice(f)
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableCurrency(s *string) *ProductUpdate {
+	if s != nil {
+		pu.SetCurrency(*s)
+	}
 	return pu
 }
 
@@ -114,6 +156,39 @@ func (pu *ProductUpdate) SetNillableImgURL(s *string) *ProductUpdate {
 	return pu
 }
 
+// SetOptions sets the "options" field.
+func (pu *ProductUpdate) SetOptions(so []schema.ProductOption) *ProductUpdate {
+	pu.mutation.SetOptions(so)
+	return pu
+}
+
+// AppendOptions appends so to the "options" field.
+func (pu *ProductUpdate) AppendOptions(so []schema.ProductOption) *ProductUpdate {
+	pu.mutation.AppendOptions(so)
+	return pu
+}
+
+// SetVersion sets the "version" field.
+func (pu *ProductUpdate) SetVersion(i int64) *ProductUpdate {
+	pu.mutation.ResetVersion()
+	pu.mutation.SetVersion(i)
+	return pu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableVersion(i *int64) *ProductUpdate {
+	if i != nil {
+		pu.SetVersion(*i)
+	}
+	return pu
+}
+
+// AddVersion adds i to the "version" field.
+func (pu *ProductUpdate) AddVersion(i int64) *ProductUpdate {
+	pu.mutation.AddVersion(i)
+	return pu
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (pu *ProductUpdate) SetOwnerID(id uuid.UUID) *ProductUpdate {
 	pu.mutation.SetOwnerID(id)
@@ -125,6 +200,81 @@ func (pu *ProductUpdate) SetOwner(u *User) *ProductUpdate {
 	return pu.SetOwnerID(u.ID)
 }
 
+// AddMovementIDs adds the "movements" edge to the InventoryMovement entity by IDs.
+func (pu *ProductUpdate) AddMovementIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.AddMovementIDs(ids...)
+	return pu
+}
+
+// AddMovements adds the "movements" edges to the InventoryMovement entity.
+func (pu *ProductUpdate) AddMovements(i ...*InventoryMovement) *ProductUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pu.AddMovementIDs(ids...)
+}
+
+// AddVariantIDs adds the "variants" edge to the ProductVariant entity by IDs.
+func (pu *ProductUpdate) AddVariantIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.AddVariantIDs(ids...)
+	return pu
+}
+
+// AddVariants adds the "variants" edges to the ProductVariant entity.
+func (pu *ProductUpdate) AddVariants(p ...*ProductVariant) *ProductUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddVariantIDs(ids...)
+}
+
+// AddMediumIDs adds the "media" edge to the ProductMedia entity by IDs.
+func (pu *ProductUpdate) AddMediumIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.AddMediumIDs(ids...)
+	return pu
+}
+
+// AddMedia adds the "media" edges to the ProductMedia entity.
+func (pu *ProductUpdate) AddMedia(p ...*ProductMedia) *ProductUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddMediumIDs(ids...)
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (pu *ProductUpdate) AddCategoryIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.AddCategoryIDs(ids...)
+	return pu
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (pu *ProductUpdate) AddCategories(c ...*Category) *ProductUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.AddCategoryIDs(ids...)
+}
+
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (pu *ProductUpdate) AddCollectionIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.AddCollectionIDs(ids...)
+	return pu
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (pu *ProductUpdate) AddCollections(c ...*Collection) *ProductUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.AddCollectionIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (pu *ProductUpdate) Mutation() *ProductMutation {
 	return pu.mutation
@@ -136,6 +286,111 @@ func (pu *ProductUpdate) ClearOwner() *ProductUpdate {
 	return pu
 }
 
+// ClearMovements clears all "movements" edges to the InventoryMovement entity.
+func (pu *ProductUpdate) ClearMovements() *ProductUpdate {
+	pu.mutation.ClearMovements()
+	return pu
+}
+
+// RemoveMovementIDs removes the "movements" edge to InventoryMovement entities by IDs.
+func (pu *ProductUpdate) RemoveMovementIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.RemoveMovementIDs(ids...)
+	return pu
+}
+
+// RemoveMovements removes "movements" edges to InventoryMovement entities.
+func (pu *ProductUpdate) RemoveMovements(i ...*InventoryMovement) *ProductUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pu.RemoveMovementIDs(ids...)
+}
+
+// ClearVariants clears all "variants" edges to the ProductVariant entity.
+func (pu *ProductUpdate) ClearVariants() *ProductUpdate {
+	pu.mutation.ClearVariants()
+	return pu
+}
+
+// RemoveVariantIDs removes the "variants" edge to ProductVariant entities by IDs.
+func (pu *ProductUpdate) RemoveVariantIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.RemoveVariantIDs(ids...)
+	return pu
+}
+
+// RemoveVariants removes "variants" edges to ProductVariant entities.
+func (pu *ProductUpdate) RemoveVariants(p ...*ProductVariant) *ProductUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveVariantIDs(ids...)
+}
+
+// ClearMedia clears all "media" edges to the ProductMedia entity.
+func (pu *ProductUpdate) ClearMedia() *ProductUpdate {
+	pu.mutation.ClearMedia()
+	return pu
+}
+
+// RemoveMediumIDs removes the "media" edge to ProductMedia entities by IDs.
+func (pu *ProductUpdate) RemoveMediumIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.RemoveMediumIDs(ids...)
+	return pu
+}
+
+// RemoveMedia removes "media" edges to ProductMedia entities.
+func (pu *ProductUpdate) RemoveMedia(p ...*ProductMedia) *ProductUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveMediumIDs(ids...)
+}
+
+// ClearCategories clears all "categories" edges to the Category entity.
+func (pu *ProductUpdate) ClearCategories() *ProductUpdate {
+	pu.mutation.ClearCategories()
+	return pu
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (pu *ProductUpdate) RemoveCategoryIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.RemoveCategoryIDs(ids...)
+	return pu
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (pu *ProductUpdate) RemoveCategories(c ...*Category) *ProductUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.RemoveCategoryIDs(ids...)
+}
+
+// ClearCollections clears all "collections" edges to the Collection entity.
+func (pu *ProductUpdate) ClearCollections() *ProductUpdate {
+	pu.mutation.ClearCollections()
+	return pu
+}
+
+// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
+func (pu *ProductUpdate) RemoveCollectionIDs(ids ...uuid.UUID) *ProductUpdate {
+	pu.mutation.RemoveCollectionIDs(ids...)
+	return pu
+}
+
+// RemoveCollections removes "collections" edges to Collection entities.
+func (pu *ProductUpdate) RemoveCollections(c ...*Collection) *ProductUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.RemoveCollectionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *ProductUpdate) Save(ctx context.Context) (int, error) {
 	pu.defaults()
@@ -179,11 +434,21 @@ func (pu *ProductUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.Sku(); ok {
+		if err := product.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "Product.sku": %w`, err)}
+		}
+	}
 	if v, ok := pu.mutation.Price(); ok {
-		if err := product.PriceValidator(v); err != nil {
+		if err := product.PriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.Currency(); ok {
+		if err := product.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Product.currency": %w`, err)}
+		}
+	}
 	if v, ok := pu.mutation.Quantity(); ok {
 		if err := product.QuantityValidator(v); err != nil {
 			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "Product.quantity": %w`, err)}
@@ -228,11 +493,20 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.Name(); ok {
 		_spec.SetField(product.FieldName, field.TypeString, value)
 	}
+	if value, ok := pu.mutation.Sku(); ok {
+		_spec.SetField(product.FieldSku, field.TypeString, value)
+	}
+	if pu.mutation.SkuCleared() {
+		_spec.ClearField(product.FieldSku, field.TypeString)
+	}
 	if value, ok := pu.mutation.Price(); ok {
-		_spec.SetField(product.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(product.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := pu.mutation.AddedPrice(); ok {
-		_spec.AddField(product.FieldPrice, field.TypeFloat64, value)
+		_spec.AddField(product.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.Currency(); ok {
+		_spec.SetField(product.FieldCurrency, field.TypeString, value)
 	}
 	if value, ok := pu.mutation.Quantity(); ok {
 		_spec.SetField(product.FieldQuantity, field.TypeInt32, value)
@@ -252,6 +526,20 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.ImgURL(); ok {
 		_spec.SetField(product.FieldImgURL, field.TypeString, value)
 	}
+	if value, ok := pu.mutation.Options(); ok {
+		_spec.SetField(product.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, product.FieldOptions, value)
+		})
+	}
+	if value, ok := pu.mutation.Version(); ok {
+		_spec.SetField(product.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.AddedVersion(); ok {
+		_spec.AddField(product.FieldVersion, field.TypeInt64, value)
+	}
 	if pu.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -287,121 +575,533 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{product.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if pu.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: inventorymovement.FieldID,
+				},
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	pu.mutation.done = true
-	return n, nil
-}
-
-// ProductUpdateOne is the builder for updating a single Product entity.
-type ProductUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ProductMutation
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (puo *ProductUpdateOne) SetUpdatedAt(t time.Time) *ProductUpdateOne {
-	puo.mutation.SetUpdatedAt(t)
-	return puo
-}
-
-// SetUserID sets the "user_id" field.
-func (puo *ProductUpdateOne) SetUserID(u uuid.UUID) *ProductUpdateOne {
-	puo.mutation.SetUserID(u)
-	return puo
-}
-
-// SetName sets the "name" field.
-func (puo *ProductUpdateOne) SetName(s string) *ProductUpdateOne {
-	puo.mutation.SetName(s)
-	return puo
-}
-
-// SetPrice sets the "price" field.
-func (puo *ProductUpdateOne) SetPrice(f float64) *ProductUpdateOne {
-	puo.mutation.ResetPrice()
-	puo.mutation.SetPrice(f)
-	return puo
-}
-
-// AddPrice adds f to the "price" field.
-func (puo *ProductUpdateOne) AddPrice(f float64) *ProductUpdateOne {
-	puo.mutation.AddPrice(f)
-	return puo
-}
-
-// SetQuantity sets the "quantity" field.
-func (puo *ProductUpdateOne) SetQuantity(i int32) *ProductUpdateOne {
-	puo.mutation.ResetQuantity()
-	puo.mutation.SetQuantity(i)
-	return puo
-}
-
-// AddQuantity adds i to the "quantity" field.
-func (puo *ProductUpdateOne) AddQuantity(i int32) *ProductUpdateOne {
-	puo.mutation.AddQuantity(i)
-	return puo
-}
-
-// SetDescription sets the "description" field.
-func (puo *ProductUpdateOne) SetDescription(s string) *ProductUpdateOne {
-	puo.mutation.SetDescription(s)
-	return puo
-}
-
-// SetStatus sets the "status" field.
-func (puo *ProductUpdateOne) SetStatus(s string) *ProductUpdateOne {
-	puo.mutation.SetStatus(s)
-	return puo
-}
-
-// SetIsArchived sets the "is_archived" field.
-func (puo *ProductUpdateOne) SetIsArchived(b bool) *ProductUpdateOne {
-	puo.mutation.SetIsArchived(b)
-	return puo
-}
-
-// SetNillableIsArchived sets the "is_archived" field if the given value is not nil.
-func (puo *ProductUpdateOne) SetNillableIsArchived(b *bool) *ProductUpdateOne {
-	if b != nil {
-		puo.SetIsArchived(*b)
+	if nodes := pu.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !pu.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: inventorymovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return puo
-}
-
-// SetImgURL sets the "img_url" field.
-func (puo *ProductUpdateOne) SetImgURL(s string) *ProductUpdateOne {
-	puo.mutation.SetImgURL(s)
-	return puo
-}
-
-// SetNillableImgURL sets the "img_url" field if the given value is not nil.
-func (puo *ProductUpdateOne) SetNillableImgURL(s *string) *ProductUpdateOne {
-	if s != nil {
-		puo.SetImgURL(*s)
+	if nodes := pu.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: inventorymovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return puo
-}
-
-// SetOwnerID sets the "owner" edge to the User entity by ID.
-func (puo *ProductUpdateOne) SetOwnerID(id uuid.UUID) *ProductUpdateOne {
-	puo.mutation.SetOwnerID(id)
-	return puo
-}
-
-// SetOwner sets the "owner" edge to the User entity.
-func (puo *ProductUpdateOne) SetOwner(u *User) *ProductUpdateOne {
+	if pu.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productvariant.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedVariantsIDs(); len(nodes) > 0 && !pu.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productvariant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.VariantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productvariant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedMediaIDs(); len(nodes) > 0 && !pu.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CategoriesTable,
+			Columns: product.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: category.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !pu.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CategoriesTable,
+			Columns: product.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: category.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CategoriesTable,
+			Columns: product.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: category.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CollectionsTable,
+			Columns: product.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: collection.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !pu.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CollectionsTable,
+			Columns: product.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: collection.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CollectionsTable,
+			Columns: product.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: collection.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{product.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// ProductUpdateOne is the builder for updating a single Product entity.
+type ProductUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *ProductUpdateOne) SetUpdatedAt(t time.Time) *ProductUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetUserID sets the "user_id" field.
+func (puo *ProductUpdateOne) SetUserID(u uuid.UUID) *ProductUpdateOne {
+	puo.mutation.SetUserID(u)
+	return puo
+}
+
+// SetName sets the "name" field.
+func (puo *ProductUpdateOne) SetName(s string) *ProductUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetSku sets the "sku" field.
+func (puo *ProductUpdateOne) SetSku(s string) *ProductUpdateOne {
+	puo.mutation.SetSku(s)
+	return puo
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableSku(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetSku(*s)
+	}
+	return puo
+}
+
+// ClearSku clears the value of the "sku" field.
+func (puo *ProductUpdateOne) ClearSku() *ProductUpdateOne {
+	puo.mutation.ClearSku()
+	return puo
+}
+
+// SetPrice sets the "price" field.
+func (puo *ProductUpdateOne) SetPrice(m money.Amount) *ProductUpdateOne {
+	puo.mutation.ResetPrice()
+	puo.mutation.SetPrice(m)
+	return puo
+}
+
+// AddPrice adds m to the "price" field.
+func (puo *ProductUpdateOne) AddPrice(m money.Amount) *ProductUpdateOne {
+	puo.mutation.AddPrice(m)
+	return puo
+}
+
+// SetCurrency sets the "currency" field.
+func (puo *ProductUpdateOne) SetCurrency(s string) *ProductUpdateOne {
+	puo.mutation.SetCurrency(s)
+	return puo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableCurrency(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetCurrency(*s)
+	}
+	return puo
+}
+
+// SetQuantity sets the "quantity" field.
+func (puo *ProductUpdateOne) SetQuantity(i int32) *ProductUpdateOne {
+	puo.mutation.ResetQuantity()
+	puo.mutation.SetQuantity(i)
+	return puo
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (puo *ProductUpdateOne) AddQuantity(i int32) *ProductUpdateOne {
+	puo.mutation.AddQuantity(i)
+	return puo
+}
+
+// SetDescription sets the "description" field.
+func (puo *ProductUpdateOne) SetDescription(s string) *ProductUpdateOne {
+	puo.mutation.SetDescription(s)
+	return puo
+}
+
+// SetStatus sets the "status" field.
+func (puo *ProductUpdateOne) SetStatus(s string) *ProductUpdateOne {
+	puo.mutation.SetStatus(s)
+	return puo
+}
+
+// SetIsArchived sets the "is_archived" field.
+func (puo *ProductUpdateOne) SetIsArchived(b bool) *ProductUpdateOne {
+	puo.mutation.SetIsArchived(b)
+	return puo
+}
+
+// SetNillableIsArchived sets the "is_archived" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableIsArchived(b *bool) *ProductUpdateOne {
+	if b != nil {
+		puo.SetIsArchived(*b)
+	}
+	return puo
+}
+
+// SetImgURL sets the "img_url" field.
+func (puo *ProductUpdateOne) SetImgURL(s string) *ProductUpdateOne {
+	puo.mutation.SetImgURL(s)
+	return puo
+}
+
+// SetNillableImgURL sets the "img_url" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableImgURL(s *string) *ProductUpdateOne {
+	if s != nil {
+		puo.SetImgURL(*s)
+	}
+	return puo
+}
+
+// SetOptions sets the "options" field.
+func (puo *ProductUpdateOne) SetOptions(so []schema.ProductOption) *ProductUpdateOne {
+	puo.mutation.SetOptions(so)
+	return puo
+}
+
+// AppendOptions appends so to the "options" field.
+func (puo *ProductUpdateOne) AppendOptions(so []schema.ProductOption) *ProductUpdateOne {
+	puo.mutation.AppendOptions(so)
+	return puo
+}
+
+// SetVersion sets the "version" field.
+func (puo *ProductUpdateOne) SetVersion(i int64) *ProductUpdateOne {
+	puo.mutation.ResetVersion()
+	puo.mutation.SetVersion(i)
+	return puo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableVersion(i *int64) *ProductUpdateOne {
+	if i != nil {
+		puo.SetVersion(*i)
+	}
+	return puo
+}
+
+// AddVersion adds i to the "version" field.
+func (puo *ProductUpdateOne) AddVersion(i int64) *ProductUpdateOne {
+	puo.mutation.AddVersion(i)
+	return puo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (puo *ProductUpdateOne) SetOwnerID(id uuid.UUID) *ProductUpdateOne {
+	puo.mutation.SetOwnerID(id)
+	return puo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (puo *ProductUpdateOne) SetOwner(u *User) *ProductUpdateOne {
 	return puo.SetOwnerID(u.ID)
 }
 
+// AddMovementIDs adds the "movements" edge to the InventoryMovement entity by IDs.
+func (puo *ProductUpdateOne) AddMovementIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.AddMovementIDs(ids...)
+	return puo
+}
+
+// AddMovements adds the "movements" edges to the InventoryMovement entity.
+func (puo *ProductUpdateOne) AddMovements(i ...*InventoryMovement) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return puo.AddMovementIDs(ids...)
+}
+
+// AddVariantIDs adds the "variants" edge to the ProductVariant entity by IDs.
+func (puo *ProductUpdateOne) AddVariantIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.AddVariantIDs(ids...)
+	return puo
+}
+
+// AddVariants adds the "variants" edges to the ProductVariant entity.
+func (puo *ProductUpdateOne) AddVariants(p ...*ProductVariant) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddVariantIDs(ids...)
+}
+
+// AddMediumIDs adds the "media" edge to the ProductMedia entity by IDs.
+func (puo *ProductUpdateOne) AddMediumIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.AddMediumIDs(ids...)
+	return puo
+}
+
+// AddMedia adds the "media" edges to the ProductMedia entity.
+func (puo *ProductUpdateOne) AddMedia(p ...*ProductMedia) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddMediumIDs(ids...)
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (puo *ProductUpdateOne) AddCategoryIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.AddCategoryIDs(ids...)
+	return puo
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (puo *ProductUpdateOne) AddCategories(c ...*Category) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.AddCategoryIDs(ids...)
+}
+
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (puo *ProductUpdateOne) AddCollectionIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.AddCollectionIDs(ids...)
+	return puo
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (puo *ProductUpdateOne) AddCollections(c ...*Collection) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.AddCollectionIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (puo *ProductUpdateOne) Mutation() *ProductMutation {
 	return puo.mutation
@@ -413,6 +1113,111 @@ func (puo *ProductUpdateOne) ClearOwner() *ProductUpdateOne {
 	return puo
 }
 
+// ClearMovements clears all "movements" edges to the InventoryMovement entity.
+func (puo *ProductUpdateOne) ClearMovements() *ProductUpdateOne {
+	puo.mutation.ClearMovements()
+	return puo
+}
+
+// RemoveMovementIDs removes the "movements" edge to InventoryMovement entities by IDs.
+func (puo *ProductUpdateOne) RemoveMovementIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.RemoveMovementIDs(ids...)
+	return puo
+}
+
+// RemoveMovements removes "movements" edges to InventoryMovement entities.
+func (puo *ProductUpdateOne) RemoveMovements(i ...*InventoryMovement) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return puo.RemoveMovementIDs(ids...)
+}
+
+// ClearVariants clears all "variants" edges to the ProductVariant entity.
+func (puo *ProductUpdateOne) ClearVariants() *ProductUpdateOne {
+	puo.mutation.ClearVariants()
+	return puo
+}
+
+// RemoveVariantIDs removes the "variants" edge to ProductVariant entities by IDs.
+func (puo *ProductUpdateOne) RemoveVariantIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.RemoveVariantIDs(ids...)
+	return puo
+}
+
+// RemoveVariants removes "variants" edges to ProductVariant entities.
+func (puo *ProductUpdateOne) RemoveVariants(p ...*ProductVariant) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveVariantIDs(ids...)
+}
+
+// ClearMedia clears all "media" edges to the ProductMedia entity.
+func (puo *ProductUpdateOne) ClearMedia() *ProductUpdateOne {
+	puo.mutation.ClearMedia()
+	return puo
+}
+
+// RemoveMediumIDs removes the "media" edge to ProductMedia entities by IDs.
+func (puo *ProductUpdateOne) RemoveMediumIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.RemoveMediumIDs(ids...)
+	return puo
+}
+
+// RemoveMedia removes "media" edges to ProductMedia entities.
+func (puo *ProductUpdateOne) RemoveMedia(p ...*ProductMedia) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveMediumIDs(ids...)
+}
+
+// ClearCategories clears all "categories" edges to the Category entity.
+func (puo *ProductUpdateOne) ClearCategories() *ProductUpdateOne {
+	puo.mutation.ClearCategories()
+	return puo
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (puo *ProductUpdateOne) RemoveCategoryIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.RemoveCategoryIDs(ids...)
+	return puo
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (puo *ProductUpdateOne) RemoveCategories(c ...*Category) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.RemoveCategoryIDs(ids...)
+}
+
+// ClearCollections clears all "collections" edges to the Collection entity.
+func (puo *ProductUpdateOne) ClearCollections() *ProductUpdateOne {
+	puo.mutation.ClearCollections()
+	return puo
+}
+
+// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
+func (puo *ProductUpdateOne) RemoveCollectionIDs(ids ...uuid.UUID) *ProductUpdateOne {
+	puo.mutation.RemoveCollectionIDs(ids...)
+	return puo
+}
+
+// RemoveCollections removes "collections" edges to Collection entities.
+func (puo *ProductUpdateOne) RemoveCollections(c ...*Collection) *ProductUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.RemoveCollectionIDs(ids...)
+}
+
 // Where appends a list predicates to the ProductUpdate builder.
 func (puo *ProductUpdateOne) Where(ps ...predicate.Product) *ProductUpdateOne {
 	puo.mutation.Where(ps...)
@@ -469,11 +1274,21 @@ func (puo *ProductUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.Sku(); ok {
+		if err := product.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "Product.sku": %w`, err)}
+		}
+	}
 	if v, ok := puo.mutation.Price(); ok {
-		if err := product.PriceValidator(v); err != nil {
+		if err := product.PriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.Currency(); ok {
+		if err := product.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Product.currency": %w`, err)}
+		}
+	}
 	if v, ok := puo.mutation.Quantity(); ok {
 		if err := product.QuantityValidator(v); err != nil {
 			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "Product.quantity": %w`, err)}
@@ -535,11 +1350,20 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 	if value, ok := puo.mutation.Name(); ok {
 		_spec.SetField(product.FieldName, field.TypeString, value)
 	}
+	if value, ok := puo.mutation.Sku(); ok {
+		_spec.SetField(product.FieldSku, field.TypeString, value)
+	}
+	if puo.mutation.SkuCleared() {
+		_spec.ClearField(product.FieldSku, field.TypeString)
+	}
 	if value, ok := puo.mutation.Price(); ok {
-		_spec.SetField(product.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(product.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := puo.mutation.AddedPrice(); ok {
-		_spec.AddField(product.FieldPrice, field.TypeFloat64, value)
+		_spec.AddField(product.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.Currency(); ok {
+		_spec.SetField(product.FieldCurrency, field.TypeString, value)
 	}
 	if value, ok := puo.mutation.Quantity(); ok {
 		_spec.SetField(product.FieldQuantity, field.TypeInt32, value)
@@ -559,6 +1383,20 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 	if value, ok := puo.mutation.ImgURL(); ok {
 		_spec.SetField(product.FieldImgURL, field.TypeString, value)
 	}
+	if value, ok := puo.mutation.Options(); ok {
+		_spec.SetField(product.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, product.FieldOptions, value)
+		})
+	}
+	if value, ok := puo.mutation.Version(); ok {
+		_spec.SetField(product.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.AddedVersion(); ok {
+		_spec.AddField(product.FieldVersion, field.TypeInt64, value)
+	}
 	if puo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -594,6 +1432,276 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: inventorymovement.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedMovementsIDs(); len(nodes) > 0 && !puo.mutation.MovementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: inventorymovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MovementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MovementsTable,
+			Columns: []string{product.MovementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: inventorymovement.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productvariant.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedVariantsIDs(); len(nodes) > 0 && !puo.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productvariant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.VariantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productvariant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedMediaIDs(); len(nodes) > 0 && !puo.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.MediaTable,
+			Columns: []string{product.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: productmedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CategoriesTable,
+			Columns: product.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: category.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !puo.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CategoriesTable,
+			Columns: product.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: category.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CategoriesTable,
+			Columns: product.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: category.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CollectionsTable,
+			Columns: product.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: collection.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !puo.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CollectionsTable,
+			Columns: product.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: collection.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   product.CollectionsTable,
+			Columns: product.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: collection.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Product{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
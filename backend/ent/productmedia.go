@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sthl/ent/imageinfo"
+	"sthl/ent/product"
+	"sthl/ent/productmedia"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ProductMedia is the model entity for the ProductMedia schema.
+type ProductMedia struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID uuid.UUID `json:"productId"`
+	// ImageinfoID holds the value of the "imageinfo_id" field.
+	ImageinfoID int `json:"imgInfoId"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position"`
+	// AltText holds the value of the "alt_text" field.
+	AltText string `json:"altText"`
+	// IsPrimary holds the value of the "is_primary" field.
+	IsPrimary bool `json:"isPrimary"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProductMediaQuery when eager-loading is set.
+	Edges ProductMediaEdges `json:"-"`
+}
+
+// ProductMediaEdges holds the relations/edges for other nodes in the graph.
+type ProductMediaEdges struct {
+	// Product holds the value of the product edge.
+	Product *Product `json:"product,omitempty"`
+	// Image holds the value of the image edge.
+	Image *Imageinfo `json:"image,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProductMediaEdges) ProductOrErr() (*Product, error) {
+	if e.loadedTypes[0] {
+		if e.Product == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: product.Label}
+		}
+		return e.Product, nil
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// ImageOrErr returns the Image value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProductMediaEdges) ImageOrErr() (*Imageinfo, error) {
+	if e.loadedTypes[1] {
+		if e.Image == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: imageinfo.Label}
+		}
+		return e.Image, nil
+	}
+	return nil, &NotLoadedError{edge: "image"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductMedia) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productmedia.FieldIsPrimary:
+			values[i] = new(sql.NullBool)
+		case productmedia.FieldImageinfoID, productmedia.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case productmedia.FieldAltText:
+			values[i] = new(sql.NullString)
+		case productmedia.FieldCreatedAt, productmedia.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case productmedia.FieldID, productmedia.FieldProductID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ProductMedia", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductMedia fields.
+func (pm *ProductMedia) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productmedia.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pm.ID = *value
+			}
+		case productmedia.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pm.CreatedAt = value.Time
+			}
+		case productmedia.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pm.UpdatedAt = value.Time
+			}
+		case productmedia.FieldProductID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value != nil {
+				pm.ProductID = *value
+			}
+		case productmedia.FieldImageinfoID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field imageinfo_id", values[i])
+			} else if value.Valid {
+				pm.ImageinfoID = int(value.Int64)
+			}
+		case productmedia.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				pm.Position = int(value.Int64)
+			}
+		case productmedia.FieldAltText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alt_text", values[i])
+			} else if value.Valid {
+				pm.AltText = value.String
+			}
+		case productmedia.FieldIsPrimary:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_primary", values[i])
+			} else if value.Valid {
+				pm.IsPrimary = value.Bool
+			}
+		}
+	}
+	return nil
+}
+
+// QueryProduct queries the "product" edge of the ProductMedia entity.
+func (pm *ProductMedia) QueryProduct() *ProductQuery {
+	return NewProductMediaClient(pm.config).QueryProduct(pm)
+}
+
+// QueryImage queries the "image" edge of the ProductMedia entity.
+func (pm *ProductMedia) QueryImage() *ImageinfoQuery {
+	return NewProductMediaClient(pm.config).QueryImage(pm)
+}
+
+// Update returns a builder for updating this ProductMedia.
+// Note that you need to call ProductMedia.Unwrap() before calling this method if this ProductMedia
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pm *ProductMedia) Update() *ProductMediaUpdateOne {
+	return NewProductMediaClient(pm.config).UpdateOne(pm)
+}
+
+// Unwrap unwraps the ProductMedia entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pm *ProductMedia) Unwrap() *ProductMedia {
+	_tx, ok := pm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductMedia is not a transactional entity")
+	}
+	pm.config.driver = _tx.drv
+	return pm
+}
+
+// String implements the fmt.Stringer.
+func (pm *ProductMedia) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductMedia(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pm.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", pm.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("imageinfo_id=")
+	builder.WriteString(fmt.Sprintf("%v", pm.ImageinfoID))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", pm.Position))
+	builder.WriteString(", ")
+	builder.WriteString("alt_text=")
+	builder.WriteString(pm.AltText)
+	builder.WriteString(", ")
+	builder.WriteString("is_primary=")
+	builder.WriteString(fmt.Sprintf("%v", pm.IsPrimary))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductMediaSlice is a parsable slice of ProductMedia.
+type ProductMediaSlice []*ProductMedia
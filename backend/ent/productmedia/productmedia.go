@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productmedia
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the productmedia type in the database.
+	Label = "product_media"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldImageinfoID holds the string denoting the imageinfo_id field in the database.
+	FieldImageinfoID = "imageinfo_id"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldAltText holds the string denoting the alt_text field in the database.
+	FieldAltText = "alt_text"
+	// FieldIsPrimary holds the string denoting the is_primary field in the database.
+	FieldIsPrimary = "is_primary"
+	// EdgeProduct holds the string denoting the product edge name in mutations.
+	EdgeProduct = "product"
+	// EdgeImage holds the string denoting the image edge name in mutations.
+	EdgeImage = "image"
+	// Table holds the table name of the productmedia in the database.
+	Table = "product_media"
+	// ProductTable is the table that holds the product relation/edge.
+	ProductTable = "product_media"
+	// ProductInverseTable is the table name for the Product entity.
+	// It exists in this package in order to avoid circular dependency with the "product" package.
+	ProductInverseTable = "products"
+	// ProductColumn is the table column denoting the product relation/edge.
+	ProductColumn = "product_id"
+	// ImageTable is the table that holds the image relation/edge.
+	ImageTable = "product_media"
+	// ImageInverseTable is the table name for the Imageinfo entity.
+	// It exists in this package in order to avoid circular dependency with the "imageinfo" package.
+	ImageInverseTable = "imageinfos"
+	// ImageColumn is the table column denoting the image relation/edge.
+	ImageColumn = "imageinfo_id"
+)
+
+// Columns holds all SQL columns for productmedia fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldProductID,
+	FieldImageinfoID,
+	FieldPosition,
+	FieldAltText,
+	FieldIsPrimary,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
+	// DefaultAltText holds the default value on creation for the "alt_text" field.
+	DefaultAltText string
+	// AltTextValidator is a validator for the "alt_text" field. It is called by the builders before save.
+	AltTextValidator func(string) error
+	// DefaultIsPrimary holds the default value on creation for the "is_primary" field.
+	DefaultIsPrimary bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
@@ -0,0 +1,413 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productmedia
+
+import (
+	"sthl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldProductID, v))
+}
+
+// ImageinfoID applies equality check predicate on the "imageinfo_id" field. It's identical to ImageinfoIDEQ.
+func ImageinfoID(v int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldImageinfoID, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldPosition, v))
+}
+
+// AltText applies equality check predicate on the "alt_text" field. It's identical to AltTextEQ.
+func AltText(v string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldAltText, v))
+}
+
+// IsPrimary applies equality check predicate on the "is_primary" field. It's identical to IsPrimaryEQ.
+func IsPrimary(v bool) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldIsPrimary, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...uuid.UUID) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// ImageinfoIDEQ applies the EQ predicate on the "imageinfo_id" field.
+func ImageinfoIDEQ(v int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldImageinfoID, v))
+}
+
+// ImageinfoIDNEQ applies the NEQ predicate on the "imageinfo_id" field.
+func ImageinfoIDNEQ(v int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNEQ(FieldImageinfoID, v))
+}
+
+// ImageinfoIDIn applies the In predicate on the "imageinfo_id" field.
+func ImageinfoIDIn(vs ...int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldIn(FieldImageinfoID, vs...))
+}
+
+// ImageinfoIDNotIn applies the NotIn predicate on the "imageinfo_id" field.
+func ImageinfoIDNotIn(vs ...int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNotIn(FieldImageinfoID, vs...))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldLTE(FieldPosition, v))
+}
+
+// AltTextEQ applies the EQ predicate on the "alt_text" field.
+func AltTextEQ(v string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldAltText, v))
+}
+
+// AltTextNEQ applies the NEQ predicate on the "alt_text" field.
+func AltTextNEQ(v string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNEQ(FieldAltText, v))
+}
+
+// AltTextIn applies the In predicate on the "alt_text" field.
+func AltTextIn(vs ...string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldIn(FieldAltText, vs...))
+}
+
+// AltTextNotIn applies the NotIn predicate on the "alt_text" field.
+func AltTextNotIn(vs ...string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNotIn(FieldAltText, vs...))
+}
+
+// AltTextGT applies the GT predicate on the "alt_text" field.
+func AltTextGT(v string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldGT(FieldAltText, v))
+}
+
+// AltTextGTE applies the GTE predicate on the "alt_text" field.
+func AltTextGTE(v string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldGTE(FieldAltText, v))
+}
+
+// AltTextLT applies the LT predicate on the "alt_text" field.
+func AltTextLT(v string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldLT(FieldAltText, v))
+}
+
+// AltTextLTE applies the LTE predicate on the "alt_text" field.
+func AltTextLTE(v string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldLTE(FieldAltText, v))
+}
+
+// AltTextContains applies the Contains predicate on the "alt_text" field.
+func AltTextContains(v string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldContains(FieldAltText, v))
+}
+
+// AltTextHasPrefix applies the HasPrefix predicate on the "alt_text" field.
+func AltTextHasPrefix(v string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldHasPrefix(FieldAltText, v))
+}
+
+// AltTextHasSuffix applies the HasSuffix predicate on the "alt_text" field.
+func AltTextHasSuffix(v string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldHasSuffix(FieldAltText, v))
+}
+
+// AltTextEqualFold applies the EqualFold predicate on the "alt_text" field.
+func AltTextEqualFold(v string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEqualFold(FieldAltText, v))
+}
+
+// AltTextContainsFold applies the ContainsFold predicate on the "alt_text" field.
+func AltTextContainsFold(v string) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldContainsFold(FieldAltText, v))
+}
+
+// IsPrimaryEQ applies the EQ predicate on the "is_primary" field.
+func IsPrimaryEQ(v bool) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldEQ(FieldIsPrimary, v))
+}
+
+// IsPrimaryNEQ applies the NEQ predicate on the "is_primary" field.
+func IsPrimaryNEQ(v bool) predicate.ProductMedia {
+	return predicate.ProductMedia(sql.FieldNEQ(FieldIsPrimary, v))
+}
+
+// HasProduct applies the HasEdge predicate on the "product" edge.
+func HasProduct() predicate.ProductMedia {
+	return predicate.ProductMedia(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductWith applies the HasEdge predicate on the "product" edge with a given conditions (other predicates).
+func HasProductWith(preds ...predicate.Product) predicate.ProductMedia {
+	return predicate.ProductMedia(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ProductInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasImage applies the HasEdge predicate on the "image" edge.
+func HasImage() predicate.ProductMedia {
+	return predicate.ProductMedia(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ImageTable, ImageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasImageWith applies the HasEdge predicate on the "image" edge with a given conditions (other predicates).
+func HasImageWith(preds ...predicate.Imageinfo) predicate.ProductMedia {
+	return predicate.ProductMedia(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ImageInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ImageTable, ImageColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProductMedia) predicate.ProductMedia {
+	return predicate.ProductMedia(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProductMedia) predicate.ProductMedia {
+	return predicate.ProductMedia(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProductMedia) predicate.ProductMedia {
+	return predicate.ProductMedia(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
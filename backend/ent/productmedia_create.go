@@ -0,0 +1,852 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/imageinfo"
+	"sthl/ent/product"
+	"sthl/ent/productmedia"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ProductMediaCreate is the builder for creating a ProductMedia entity.
+type ProductMediaCreate struct {
+	config
+	mutation *ProductMediaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pmc *ProductMediaCreate) SetCreatedAt(t time.Time) *ProductMediaCreate {
+	pmc.mutation.SetCreatedAt(t)
+	return pmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pmc *ProductMediaCreate) SetNillableCreatedAt(t *time.Time) *ProductMediaCreate {
+	if t != nil {
+		pmc.SetCreatedAt(*t)
+	}
+	return pmc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pmc *ProductMediaCreate) SetUpdatedAt(t time.Time) *ProductMediaCreate {
+	pmc.mutation.SetUpdatedAt(t)
+	return pmc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pmc *ProductMediaCreate) SetNillableUpdatedAt(t *time.Time) *ProductMediaCreate {
+	if t != nil {
+		pmc.SetUpdatedAt(*t)
+	}
+	return pmc
+}
+
+// SetProductID sets the "product_id" field.
+func (pmc *ProductMediaCreate) SetProductID(u uuid.UUID) *ProductMediaCreate {
+	pmc.mutation.SetProductID(u)
+	return pmc
+}
+
+// SetImageinfoID sets the "imageinfo_id" field.
+func (pmc *ProductMediaCreate) SetImageinfoID(i int) *ProductMediaCreate {
+	pmc.mutation.SetImageinfoID(i)
+	return pmc
+}
+
+// SetPosition sets the "position" field.
+func (pmc *ProductMediaCreate) SetPosition(i int) *ProductMediaCreate {
+	pmc.mutation.SetPosition(i)
+	return pmc
+}
+
+// SetAltText sets the "alt_text" field.
+func (pmc *ProductMediaCreate) SetAltText(s string) *ProductMediaCreate {
+	pmc.mutation.SetAltText(s)
+	return pmc
+}
+
+// SetNillableAltText sets the "alt_text" field if the given value is not nil.
+func (pmc *ProductMediaCreate) SetNillableAltText(s *string) *ProductMediaCreate {
+	if s != nil {
+		pmc.SetAltText(*s)
+	}
+	return pmc
+}
+
+// SetIsPrimary sets the "is_primary" field.
+func (pmc *ProductMediaCreate) SetIsPrimary(b bool) *ProductMediaCreate {
+	pmc.mutation.SetIsPrimary(b)
+	return pmc
+}
+
+// SetNillableIsPrimary sets the "is_primary" field if the given value is not nil.
+func (pmc *ProductMediaCreate) SetNillableIsPrimary(b *bool) *ProductMediaCreate {
+	if b != nil {
+		pmc.SetIsPrimary(*b)
+	}
+	return pmc
+}
+
+// SetID sets the "id" field.
+func (pmc *ProductMediaCreate) SetID(u uuid.UUID) *ProductMediaCreate {
+	pmc.mutation.SetID(u)
+	return pmc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pmc *ProductMediaCreate) SetNillableID(u *uuid.UUID) *ProductMediaCreate {
+	if u != nil {
+		pmc.SetID(*u)
+	}
+	return pmc
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (pmc *ProductMediaCreate) SetProduct(p *Product) *ProductMediaCreate {
+	return pmc.SetProductID(p.ID)
+}
+
+// SetImageID sets the "image" edge to the Imageinfo entity by ID.
+func (pmc *ProductMediaCreate) SetImageID(id int) *ProductMediaCreate {
+	pmc.mutation.SetImageID(id)
+	return pmc
+}
+
+// SetImage sets the "image" edge to the Imageinfo entity.
+func (pmc *ProductMediaCreate) SetImage(i *Imageinfo) *ProductMediaCreate {
+	return pmc.SetImageID(i.ID)
+}
+
+// Mutation returns the ProductMediaMutation object of the builder.
+func (pmc *ProductMediaCreate) Mutation() *ProductMediaMutation {
+	return pmc.mutation
+}
+
+// Save creates the ProductMedia in the database.
+func (pmc *ProductMediaCreate) Save(ctx context.Context) (*ProductMedia, error) {
+	pmc.defaults()
+	return withHooks[*ProductMedia, ProductMediaMutation](ctx, pmc.sqlSave, pmc.mutation, pmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pmc *ProductMediaCreate) SaveX(ctx context.Context) *ProductMedia {
+	v, err := pmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmc *ProductMediaCreate) Exec(ctx context.Context) error {
+	_, err := pmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmc *ProductMediaCreate) ExecX(ctx context.Context) {
+	if err := pmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pmc *ProductMediaCreate) defaults() {
+	if _, ok := pmc.mutation.CreatedAt(); !ok {
+		v := productmedia.DefaultCreatedAt()
+		pmc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pmc.mutation.UpdatedAt(); !ok {
+		v := productmedia.DefaultUpdatedAt()
+		pmc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pmc.mutation.AltText(); !ok {
+		v := productmedia.DefaultAltText
+		pmc.mutation.SetAltText(v)
+	}
+	if _, ok := pmc.mutation.IsPrimary(); !ok {
+		v := productmedia.DefaultIsPrimary
+		pmc.mutation.SetIsPrimary(v)
+	}
+	if _, ok := pmc.mutation.ID(); !ok {
+		v := productmedia.DefaultID()
+		pmc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmc *ProductMediaCreate) check() error {
+	if _, ok := pmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductMedia.created_at"`)}
+	}
+	if _, ok := pmc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProductMedia.updated_at"`)}
+	}
+	if _, ok := pmc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "ProductMedia.product_id"`)}
+	}
+	if _, ok := pmc.mutation.ImageinfoID(); !ok {
+		return &ValidationError{Name: "imageinfo_id", err: errors.New(`ent: missing required field "ProductMedia.imageinfo_id"`)}
+	}
+	if _, ok := pmc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "ProductMedia.position"`)}
+	}
+	if v, ok := pmc.mutation.Position(); ok {
+		if err := productmedia.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.position": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.AltText(); !ok {
+		return &ValidationError{Name: "alt_text", err: errors.New(`ent: missing required field "ProductMedia.alt_text"`)}
+	}
+	if v, ok := pmc.mutation.AltText(); ok {
+		if err := productmedia.AltTextValidator(v); err != nil {
+			return &ValidationError{Name: "alt_text", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.alt_text": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.IsPrimary(); !ok {
+		return &ValidationError{Name: "is_primary", err: errors.New(`ent: missing required field "ProductMedia.is_primary"`)}
+	}
+	if _, ok := pmc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product", err: errors.New(`ent: missing required edge "ProductMedia.product"`)}
+	}
+	if _, ok := pmc.mutation.ImageID(); !ok {
+		return &ValidationError{Name: "image", err: errors.New(`ent: missing required edge "ProductMedia.image"`)}
+	}
+	return nil
+}
+
+func (pmc *ProductMediaCreate) sqlSave(ctx context.Context) (*ProductMedia, error) {
+	if err := pmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pmc.mutation.id = &_node.ID
+	pmc.mutation.done = true
+	return _node, nil
+}
+
+func (pmc *ProductMediaCreate) createSpec() (*ProductMedia, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductMedia{config: pmc.config}
+		_spec = sqlgraph.NewCreateSpec(productmedia.Table, sqlgraph.NewFieldSpec(productmedia.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = pmc.conflict
+	if id, ok := pmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pmc.mutation.CreatedAt(); ok {
+		_spec.SetField(productmedia.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pmc.mutation.UpdatedAt(); ok {
+		_spec.SetField(productmedia.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pmc.mutation.Position(); ok {
+		_spec.SetField(productmedia.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := pmc.mutation.AltText(); ok {
+		_spec.SetField(productmedia.FieldAltText, field.TypeString, value)
+		_node.AltText = value
+	}
+	if value, ok := pmc.mutation.IsPrimary(); ok {
+		_spec.SetField(productmedia.FieldIsPrimary, field.TypeBool, value)
+		_node.IsPrimary = value
+	}
+	if nodes := pmc.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productmedia.ProductTable,
+			Columns: []string{productmedia.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProductID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pmc.mutation.ImageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productmedia.ImageTable,
+			Columns: []string{productmedia.ImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: imageinfo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ImageinfoID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProductMedia.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProductMediaUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pmc *ProductMediaCreate) OnConflict(opts ...sql.ConflictOption) *ProductMediaUpsertOne {
+	pmc.conflict = opts
+	return &ProductMediaUpsertOne{
+		create: pmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProductMedia.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmc *ProductMediaCreate) OnConflictColumns(columns ...string) *ProductMediaUpsertOne {
+	pmc.conflict = append(pmc.conflict, sql.ConflictColumns(columns...))
+	return &ProductMediaUpsertOne{
+		create: pmc,
+	}
+}
+
+type (
+	// ProductMediaUpsertOne is the builder for "upsert"-ing
+	//  one ProductMedia node.
+	ProductMediaUpsertOne struct {
+		create *ProductMediaCreate
+	}
+
+	// ProductMediaUpsert is the "OnConflict" setter.
+	ProductMediaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProductMediaUpsert) SetUpdatedAt(v time.Time) *ProductMediaUpsert {
+	u.Set(productmedia.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProductMediaUpsert) UpdateUpdatedAt() *ProductMediaUpsert {
+	u.SetExcluded(productmedia.FieldUpdatedAt)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *ProductMediaUpsert) SetPosition(v int) *ProductMediaUpsert {
+	u.Set(productmedia.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *ProductMediaUpsert) UpdatePosition() *ProductMediaUpsert {
+	u.SetExcluded(productmedia.FieldPosition)
+	return u
+}
+
+// AddPosition adds v to the "position" field.
+func (u *ProductMediaUpsert) AddPosition(v int) *ProductMediaUpsert {
+	u.Add(productmedia.FieldPosition, v)
+	return u
+}
+
+// SetAltText sets the "alt_text" field.
+func (u *ProductMediaUpsert) SetAltText(v string) *ProductMediaUpsert {
+	u.Set(productmedia.FieldAltText, v)
+	return u
+}
+
+// UpdateAltText sets the "alt_text" field to the value that was provided on create.
+func (u *ProductMediaUpsert) UpdateAltText() *ProductMediaUpsert {
+	u.SetExcluded(productmedia.FieldAltText)
+	return u
+}
+
+// SetIsPrimary sets the "is_primary" field.
+func (u *ProductMediaUpsert) SetIsPrimary(v bool) *ProductMediaUpsert {
+	u.Set(productmedia.FieldIsPrimary, v)
+	return u
+}
+
+// UpdateIsPrimary sets the "is_primary" field to the value that was provided on create.
+func (u *ProductMediaUpsert) UpdateIsPrimary() *ProductMediaUpsert {
+	u.SetExcluded(productmedia.FieldIsPrimary)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProductMedia.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(productmedia.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProductMediaUpsertOne) UpdateNewValues() *ProductMediaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(productmedia.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(productmedia.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.ProductID(); exists {
+			s.SetIgnore(productmedia.FieldProductID)
+		}
+		if _, exists := u.create.mutation.ImageinfoID(); exists {
+			s.SetIgnore(productmedia.FieldImageinfoID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProductMedia.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProductMediaUpsertOne) Ignore() *ProductMediaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProductMediaUpsertOne) DoNothing() *ProductMediaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProductMediaCreate.OnConflict
+// documentation for more info.
+func (u *ProductMediaUpsertOne) Update(set func(*ProductMediaUpsert)) *ProductMediaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProductMediaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProductMediaUpsertOne) SetUpdatedAt(v time.Time) *ProductMediaUpsertOne {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProductMediaUpsertOne) UpdateUpdatedAt() *ProductMediaUpsertOne {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *ProductMediaUpsertOne) SetPosition(v int) *ProductMediaUpsertOne {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *ProductMediaUpsertOne) AddPosition(v int) *ProductMediaUpsertOne {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *ProductMediaUpsertOne) UpdatePosition() *ProductMediaUpsertOne {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetAltText sets the "alt_text" field.
+func (u *ProductMediaUpsertOne) SetAltText(v string) *ProductMediaUpsertOne {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.SetAltText(v)
+	})
+}
+
+// UpdateAltText sets the "alt_text" field to the value that was provided on create.
+func (u *ProductMediaUpsertOne) UpdateAltText() *ProductMediaUpsertOne {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.UpdateAltText()
+	})
+}
+
+// SetIsPrimary sets the "is_primary" field.
+func (u *ProductMediaUpsertOne) SetIsPrimary(v bool) *ProductMediaUpsertOne {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.SetIsPrimary(v)
+	})
+}
+
+// UpdateIsPrimary sets the "is_primary" field to the value that was provided on create.
+func (u *ProductMediaUpsertOne) UpdateIsPrimary() *ProductMediaUpsertOne {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.UpdateIsPrimary()
+	})
+}
+
+// Exec executes the query.
+func (u *ProductMediaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProductMediaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProductMediaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProductMediaUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProductMediaUpsertOne.ID is not supported by MySQL driver. Use ProductMediaUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProductMediaUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProductMediaCreateBulk is the builder for creating many ProductMedia entities in bulk.
+type ProductMediaCreateBulk struct {
+	config
+	builders []*ProductMediaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProductMedia entities in the database.
+func (pmcb *ProductMediaCreateBulk) Save(ctx context.Context) ([]*ProductMedia, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pmcb.builders))
+	nodes := make([]*ProductMedia, len(pmcb.builders))
+	mutators := make([]Mutator, len(pmcb.builders))
+	for i := range pmcb.builders {
+		func(i int, root context.Context) {
+			builder := pmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductMediaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmcb *ProductMediaCreateBulk) SaveX(ctx context.Context) []*ProductMedia {
+	v, err := pmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmcb *ProductMediaCreateBulk) Exec(ctx context.Context) error {
+	_, err := pmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmcb *ProductMediaCreateBulk) ExecX(ctx context.Context) {
+	if err := pmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProductMedia.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProductMediaUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pmcb *ProductMediaCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProductMediaUpsertBulk {
+	pmcb.conflict = opts
+	return &ProductMediaUpsertBulk{
+		create: pmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProductMedia.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmcb *ProductMediaCreateBulk) OnConflictColumns(columns ...string) *ProductMediaUpsertBulk {
+	pmcb.conflict = append(pmcb.conflict, sql.ConflictColumns(columns...))
+	return &ProductMediaUpsertBulk{
+		create: pmcb,
+	}
+}
+
+// ProductMediaUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProductMedia nodes.
+type ProductMediaUpsertBulk struct {
+	create *ProductMediaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProductMedia.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(productmedia.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProductMediaUpsertBulk) UpdateNewValues() *ProductMediaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(productmedia.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(productmedia.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.ProductID(); exists {
+				s.SetIgnore(productmedia.FieldProductID)
+			}
+			if _, exists := b.mutation.ImageinfoID(); exists {
+				s.SetIgnore(productmedia.FieldImageinfoID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProductMedia.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProductMediaUpsertBulk) Ignore() *ProductMediaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProductMediaUpsertBulk) DoNothing() *ProductMediaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProductMediaCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProductMediaUpsertBulk) Update(set func(*ProductMediaUpsert)) *ProductMediaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProductMediaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProductMediaUpsertBulk) SetUpdatedAt(v time.Time) *ProductMediaUpsertBulk {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProductMediaUpsertBulk) UpdateUpdatedAt() *ProductMediaUpsertBulk {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *ProductMediaUpsertBulk) SetPosition(v int) *ProductMediaUpsertBulk {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *ProductMediaUpsertBulk) AddPosition(v int) *ProductMediaUpsertBulk {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *ProductMediaUpsertBulk) UpdatePosition() *ProductMediaUpsertBulk {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetAltText sets the "alt_text" field.
+func (u *ProductMediaUpsertBulk) SetAltText(v string) *ProductMediaUpsertBulk {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.SetAltText(v)
+	})
+}
+
+// UpdateAltText sets the "alt_text" field to the value that was provided on create.
+func (u *ProductMediaUpsertBulk) UpdateAltText() *ProductMediaUpsertBulk {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.UpdateAltText()
+	})
+}
+
+// SetIsPrimary sets the "is_primary" field.
+func (u *ProductMediaUpsertBulk) SetIsPrimary(v bool) *ProductMediaUpsertBulk {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.SetIsPrimary(v)
+	})
+}
+
+// UpdateIsPrimary sets the "is_primary" field to the value that was provided on create.
+func (u *ProductMediaUpsertBulk) UpdateIsPrimary() *ProductMediaUpsertBulk {
+	return u.Update(func(s *ProductMediaUpsert) {
+		s.UpdateIsPrimary()
+	})
+}
+
+// Exec executes the query.
+func (u *ProductMediaUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProductMediaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProductMediaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProductMediaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
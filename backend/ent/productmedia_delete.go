@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sthl/ent/predicate"
+	"sthl/ent/productmedia"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductMediaDelete is the builder for deleting a ProductMedia entity.
+type ProductMediaDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProductMediaMutation
+}
+
+// Where appends a list predicates to the ProductMediaDelete builder.
+func (pmd *ProductMediaDelete) Where(ps ...predicate.ProductMedia) *ProductMediaDelete {
+	pmd.mutation.Where(ps...)
+	return pmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pmd *ProductMediaDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, ProductMediaMutation](ctx, pmd.sqlExec, pmd.mutation, pmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmd *ProductMediaDelete) ExecX(ctx context.Context) int {
+	n, err := pmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pmd *ProductMediaDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(productmedia.Table, sqlgraph.NewFieldSpec(productmedia.FieldID, field.TypeUUID))
+	if ps := pmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pmd.mutation.done = true
+	return affected, err
+}
+
+// ProductMediaDeleteOne is the builder for deleting a single ProductMedia entity.
+type ProductMediaDeleteOne struct {
+	pmd *ProductMediaDelete
+}
+
+// Where appends a list predicates to the ProductMediaDelete builder.
+func (pmdo *ProductMediaDeleteOne) Where(ps ...predicate.ProductMedia) *ProductMediaDeleteOne {
+	pmdo.pmd.mutation.Where(ps...)
+	return pmdo
+}
+
+// Exec executes the deletion query.
+func (pmdo *ProductMediaDeleteOne) Exec(ctx context.Context) error {
+	n, err := pmdo.pmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{productmedia.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmdo *ProductMediaDeleteOne) ExecX(ctx context.Context) {
+	if err := pmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
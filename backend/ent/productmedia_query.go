@@ -0,0 +1,712 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sthl/ent/imageinfo"
+	"sthl/ent/predicate"
+	"sthl/ent/product"
+	"sthl/ent/productmedia"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ProductMediaQuery is the builder for querying ProductMedia entities.
+type ProductMediaQuery struct {
+	config
+	ctx         *QueryContext
+	order       []OrderFunc
+	inters      []Interceptor
+	predicates  []predicate.ProductMedia
+	withProduct *ProductQuery
+	withImage   *ImageinfoQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductMediaQuery builder.
+func (pmq *ProductMediaQuery) Where(ps ...predicate.ProductMedia) *ProductMediaQuery {
+	pmq.predicates = append(pmq.predicates, ps...)
+	return pmq
+}
+
+// Limit the number of records to be returned by this query.
+func (pmq *ProductMediaQuery) Limit(limit int) *ProductMediaQuery {
+	pmq.ctx.Limit = &limit
+	return pmq
+}
+
+// Offset to start from.
+func (pmq *ProductMediaQuery) Offset(offset int) *ProductMediaQuery {
+	pmq.ctx.Offset = &offset
+	return pmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pmq *ProductMediaQuery) Unique(unique bool) *ProductMediaQuery {
+	pmq.ctx.Unique = &unique
+	return pmq
+}
+
+// Order specifies how the records should be ordered.
+func (pmq *ProductMediaQuery) Order(o ...OrderFunc) *ProductMediaQuery {
+	pmq.order = append(pmq.order, o...)
+	return pmq
+}
+
+// QueryProduct chains the current query on the "product" edge.
+func (pmq *ProductMediaQuery) QueryProduct() *ProductQuery {
+	query := (&ProductClient{config: pmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productmedia.Table, productmedia.FieldID, selector),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productmedia.ProductTable, productmedia.ProductColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryImage chains the current query on the "image" edge.
+func (pmq *ProductMediaQuery) QueryImage() *ImageinfoQuery {
+	query := (&ImageinfoClient{config: pmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productmedia.Table, productmedia.FieldID, selector),
+			sqlgraph.To(imageinfo.Table, imageinfo.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productmedia.ImageTable, productmedia.ImageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProductMedia entity from the query.
+// Returns a *NotFoundError when no ProductMedia was found.
+func (pmq *ProductMediaQuery) First(ctx context.Context) (*ProductMedia, error) {
+	nodes, err := pmq.Limit(1).All(setContextOp(ctx, pmq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productmedia.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pmq *ProductMediaQuery) FirstX(ctx context.Context) *ProductMedia {
+	node, err := pmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductMedia ID from the query.
+// Returns a *NotFoundError when no ProductMedia ID was found.
+func (pmq *ProductMediaQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pmq.Limit(1).IDs(setContextOp(ctx, pmq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productmedia.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pmq *ProductMediaQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := pmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductMedia entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductMedia entity is found.
+// Returns a *NotFoundError when no ProductMedia entities are found.
+func (pmq *ProductMediaQuery) Only(ctx context.Context) (*ProductMedia, error) {
+	nodes, err := pmq.Limit(2).All(setContextOp(ctx, pmq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productmedia.Label}
+	default:
+		return nil, &NotSingularError{productmedia.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pmq *ProductMediaQuery) OnlyX(ctx context.Context) *ProductMedia {
+	node, err := pmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductMedia ID in the query.
+// Returns a *NotSingularError when more than one ProductMedia ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pmq *ProductMediaQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = pmq.Limit(2).IDs(setContextOp(ctx, pmq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productmedia.Label}
+	default:
+		err = &NotSingularError{productmedia.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pmq *ProductMediaQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := pmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductMediaSlice.
+func (pmq *ProductMediaQuery) All(ctx context.Context) ([]*ProductMedia, error) {
+	ctx = setContextOp(ctx, pmq.ctx, "All")
+	if err := pmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProductMedia, *ProductMediaQuery]()
+	return withInterceptors[[]*ProductMedia](ctx, pmq, qr, pmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pmq *ProductMediaQuery) AllX(ctx context.Context) []*ProductMedia {
+	nodes, err := pmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductMedia IDs.
+func (pmq *ProductMediaQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if pmq.ctx.Unique == nil && pmq.path != nil {
+		pmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pmq.ctx, "IDs")
+	if err = pmq.Select(productmedia.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pmq *ProductMediaQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := pmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pmq *ProductMediaQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pmq.ctx, "Count")
+	if err := pmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pmq, querierCount[*ProductMediaQuery](), pmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pmq *ProductMediaQuery) CountX(ctx context.Context) int {
+	count, err := pmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pmq *ProductMediaQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pmq.ctx, "Exist")
+	switch _, err := pmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pmq *ProductMediaQuery) ExistX(ctx context.Context) bool {
+	exist, err := pmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductMediaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pmq *ProductMediaQuery) Clone() *ProductMediaQuery {
+	if pmq == nil {
+		return nil
+	}
+	return &ProductMediaQuery{
+		config:      pmq.config,
+		ctx:         pmq.ctx.Clone(),
+		order:       append([]OrderFunc{}, pmq.order...),
+		inters:      append([]Interceptor{}, pmq.inters...),
+		predicates:  append([]predicate.ProductMedia{}, pmq.predicates...),
+		withProduct: pmq.withProduct.Clone(),
+		withImage:   pmq.withImage.Clone(),
+		// clone intermediate query.
+		sql:  pmq.sql.Clone(),
+		path: pmq.path,
+	}
+}
+
+// WithProduct tells the query-builder to eager-load the nodes that are connected to
+// the "product" edge. The optional arguments are used to configure the query builder of the edge.
+func (pmq *ProductMediaQuery) WithProduct(opts ...func(*ProductQuery)) *ProductMediaQuery {
+	query := (&ProductClient{config: pmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pmq.withProduct = query
+	return pmq
+}
+
+// WithImage tells the query-builder to eager-load the nodes that are connected to
+// the "image" edge. The optional arguments are used to configure the query builder of the edge.
+func (pmq *ProductMediaQuery) WithImage(opts ...func(*ImageinfoQuery)) *ProductMediaQuery {
+	query := (&ImageinfoClient{config: pmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pmq.withImage = query
+	return pmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductMedia.Query().
+//		GroupBy(productmedia.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pmq *ProductMediaQuery) GroupBy(field string, fields ...string) *ProductMediaGroupBy {
+	pmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProductMediaGroupBy{build: pmq}
+	grbuild.flds = &pmq.ctx.Fields
+	grbuild.label = productmedia.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//	}
+//
+//	client.ProductMedia.Query().
+//		Select(productmedia.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (pmq *ProductMediaQuery) Select(fields ...string) *ProductMediaSelect {
+	pmq.ctx.Fields = append(pmq.ctx.Fields, fields...)
+	sbuild := &ProductMediaSelect{ProductMediaQuery: pmq}
+	sbuild.label = productmedia.Label
+	sbuild.flds, sbuild.scan = &pmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProductMediaSelect configured with the given aggregations.
+func (pmq *ProductMediaQuery) Aggregate(fns ...AggregateFunc) *ProductMediaSelect {
+	return pmq.Select().Aggregate(fns...)
+}
+
+func (pmq *ProductMediaQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pmq.ctx.Fields {
+		if !productmedia.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pmq.path != nil {
+		prev, err := pmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pmq.sql = prev
+	}
+	return nil
+}
+
+func (pmq *ProductMediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductMedia, error) {
+	var (
+		nodes       = []*ProductMedia{}
+		_spec       = pmq.querySpec()
+		loadedTypes = [2]bool{
+			pmq.withProduct != nil,
+			pmq.withImage != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProductMedia).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProductMedia{config: pmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(pmq.modifiers) > 0 {
+		_spec.Modifiers = pmq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pmq.withProduct; query != nil {
+		if err := pmq.loadProduct(ctx, query, nodes, nil,
+			func(n *ProductMedia, e *Product) { n.Edges.Product = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pmq.withImage; query != nil {
+		if err := pmq.loadImage(ctx, query, nodes, nil,
+			func(n *ProductMedia, e *Imageinfo) { n.Edges.Image = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pmq *ProductMediaQuery) loadProduct(ctx context.Context, query *ProductQuery, nodes []*ProductMedia, init func(*ProductMedia), assign func(*ProductMedia, *Product)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ProductMedia)
+	for i := range nodes {
+		fk := nodes[i].ProductID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(product.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pmq *ProductMediaQuery) loadImage(ctx context.Context, query *ImageinfoQuery, nodes []*ProductMedia, init func(*ProductMedia), assign func(*ProductMedia, *Imageinfo)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ProductMedia)
+	for i := range nodes {
+		fk := nodes[i].ImageinfoID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(imageinfo.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "imageinfo_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pmq *ProductMediaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pmq.querySpec()
+	if len(pmq.modifiers) > 0 {
+		_spec.Modifiers = pmq.modifiers
+	}
+	_spec.Node.Columns = pmq.ctx.Fields
+	if len(pmq.ctx.Fields) > 0 {
+		_spec.Unique = pmq.ctx.Unique != nil && *pmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pmq.driver, _spec)
+}
+
+func (pmq *ProductMediaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(productmedia.Table, productmedia.Columns, sqlgraph.NewFieldSpec(productmedia.FieldID, field.TypeUUID))
+	_spec.From = pmq.sql
+	if unique := pmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productmedia.FieldID)
+		for i := range fields {
+			if fields[i] != productmedia.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pmq *ProductMediaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pmq.driver.Dialect())
+	t1 := builder.Table(productmedia.Table)
+	columns := pmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = productmedia.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pmq.sql != nil {
+		selector = pmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pmq.ctx.Unique != nil && *pmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range pmq.modifiers {
+		m(selector)
+	}
+	for _, p := range pmq.predicates {
+		p(selector)
+	}
+	for _, p := range pmq.order {
+		p(selector)
+	}
+	if offset := pmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (pmq *ProductMediaQuery) ForUpdate(opts ...sql.LockOption) *ProductMediaQuery {
+	if pmq.driver.Dialect() == dialect.Postgres {
+		pmq.Unique(false)
+	}
+	pmq.modifiers = append(pmq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return pmq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (pmq *ProductMediaQuery) ForShare(opts ...sql.LockOption) *ProductMediaQuery {
+	if pmq.driver.Dialect() == dialect.Postgres {
+		pmq.Unique(false)
+	}
+	pmq.modifiers = append(pmq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return pmq
+}
+
+// ProductMediaGroupBy is the group-by builder for ProductMedia entities.
+type ProductMediaGroupBy struct {
+	selector
+	build *ProductMediaQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pmgb *ProductMediaGroupBy) Aggregate(fns ...AggregateFunc) *ProductMediaGroupBy {
+	pmgb.fns = append(pmgb.fns, fns...)
+	return pmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pmgb *ProductMediaGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pmgb.build.ctx, "GroupBy")
+	if err := pmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProductMediaQuery, *ProductMediaGroupBy](ctx, pmgb.build, pmgb, pmgb.build.inters, v)
+}
+
+func (pmgb *ProductMediaGroupBy) sqlScan(ctx context.Context, root *ProductMediaQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pmgb.fns))
+	for _, fn := range pmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pmgb.flds)+len(pmgb.fns))
+		for _, f := range *pmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProductMediaSelect is the builder for selecting fields of ProductMedia entities.
+type ProductMediaSelect struct {
+	*ProductMediaQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pms *ProductMediaSelect) Aggregate(fns ...AggregateFunc) *ProductMediaSelect {
+	pms.fns = append(pms.fns, fns...)
+	return pms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pms *ProductMediaSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pms.ctx, "Select")
+	if err := pms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProductMediaQuery, *ProductMediaSelect](ctx, pms.ProductMediaQuery, pms, pms.inters, v)
+}
+
+func (pms *ProductMediaSelect) sqlScan(ctx context.Context, root *ProductMediaQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pms.fns))
+	for _, fn := range pms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
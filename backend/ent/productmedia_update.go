@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/predicate"
+	"sthl/ent/productmedia"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductMediaUpdate is the builder for updating ProductMedia entities.
+type ProductMediaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductMediaMutation
+}
+
+// Where appends a list predicates to the ProductMediaUpdate builder.
+func (pmu *ProductMediaUpdate) Where(ps ...predicate.ProductMedia) *ProductMediaUpdate {
+	pmu.mutation.Where(ps...)
+	return pmu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pmu *ProductMediaUpdate) SetUpdatedAt(t time.Time) *ProductMediaUpdate {
+	pmu.mutation.SetUpdatedAt(t)
+	return pmu
+}
+
+// SetPosition sets the "position" field.
+func (pmu *ProductMediaUpdate) SetPosition(i int) *ProductMediaUpdate {
+	pmu.mutation.ResetPosition()
+	pmu.mutation.SetPosition(i)
+	return pmu
+}
+
+// AddPosition adds i to the "position" field.
+func (pmu *ProductMediaUpdate) AddPosition(i int) *ProductMediaUpdate {
+	pmu.mutation.AddPosition(i)
+	return pmu
+}
+
+// SetAltText sets the "alt_text" field.
+func (pmu *ProductMediaUpdate) SetAltText(s string) *ProductMediaUpdate {
+	pmu.mutation.SetAltText(s)
+	return pmu
+}
+
+// SetNillableAltText sets the "alt_text" field if the given value is not nil.
+func (pmu *ProductMediaUpdate) SetNillableAltText(s *string) *ProductMediaUpdate {
+	if s != nil {
+		pmu.SetAltText(*s)
+	}
+	return pmu
+}
+
+// SetIsPrimary sets the "is_primary" field.
+func (pmu *ProductMediaUpdate) SetIsPrimary(b bool) *ProductMediaUpdate {
+	pmu.mutation.SetIsPrimary(b)
+	return pmu
+}
+
+// SetNillableIsPrimary sets the "is_primary" field if the given value is not nil.
+func (pmu *ProductMediaUpdate) SetNillableIsPrimary(b *bool) *ProductMediaUpdate {
+	if b != nil {
+		pmu.SetIsPrimary(*b)
+	}
+	return pmu
+}
+
+// Mutation returns the ProductMediaMutation object of the builder.
+func (pmu *ProductMediaUpdate) Mutation() *ProductMediaMutation {
+	return pmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pmu *ProductMediaUpdate) Save(ctx context.Context) (int, error) {
+	pmu.defaults()
+	return withHooks[int, ProductMediaMutation](ctx, pmu.sqlSave, pmu.mutation, pmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmu *ProductMediaUpdate) SaveX(ctx context.Context) int {
+	affected, err := pmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pmu *ProductMediaUpdate) Exec(ctx context.Context) error {
+	_, err := pmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmu *ProductMediaUpdate) ExecX(ctx context.Context) {
+	if err := pmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pmu *ProductMediaUpdate) defaults() {
+	if _, ok := pmu.mutation.UpdatedAt(); !ok {
+		v := productmedia.UpdateDefaultUpdatedAt()
+		pmu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmu *ProductMediaUpdate) check() error {
+	if v, ok := pmu.mutation.Position(); ok {
+		if err := productmedia.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.position": %w`, err)}
+		}
+	}
+	if v, ok := pmu.mutation.AltText(); ok {
+		if err := productmedia.AltTextValidator(v); err != nil {
+			return &ValidationError{Name: "alt_text", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.alt_text": %w`, err)}
+		}
+	}
+	if _, ok := pmu.mutation.ProductID(); pmu.mutation.ProductCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProductMedia.product"`)
+	}
+	if _, ok := pmu.mutation.ImageID(); pmu.mutation.ImageCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProductMedia.image"`)
+	}
+	return nil
+}
+
+func (pmu *ProductMediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productmedia.Table, productmedia.Columns, sqlgraph.NewFieldSpec(productmedia.FieldID, field.TypeUUID))
+	if ps := pmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmu.mutation.UpdatedAt(); ok {
+		_spec.SetField(productmedia.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pmu.mutation.Position(); ok {
+		_spec.SetField(productmedia.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pmu.mutation.AddedPosition(); ok {
+		_spec.AddField(productmedia.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pmu.mutation.AltText(); ok {
+		_spec.SetField(productmedia.FieldAltText, field.TypeString, value)
+	}
+	if value, ok := pmu.mutation.IsPrimary(); ok {
+		_spec.SetField(productmedia.FieldIsPrimary, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productmedia.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pmu.mutation.done = true
+	return n, nil
+}
+
+// ProductMediaUpdateOne is the builder for updating a single ProductMedia entity.
+type ProductMediaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductMediaMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pmuo *ProductMediaUpdateOne) SetUpdatedAt(t time.Time) *ProductMediaUpdateOne {
+	pmuo.mutation.SetUpdatedAt(t)
+	return pmuo
+}
+
+// SetPosition sets the "position" field.
+func (pmuo *ProductMediaUpdateOne) SetPosition(i int) *ProductMediaUpdateOne {
+	pmuo.mutation.ResetPosition()
+	pmuo.mutation.SetPosition(i)
+	return pmuo
+}
+
+// AddPosition adds i to the "position" field.
+func (pmuo *ProductMediaUpdateOne) AddPosition(i int) *ProductMediaUpdateOne {
+	pmuo.mutation.AddPosition(i)
+	return pmuo
+}
+
+// SetAltText sets the "alt_text" field.
+func (pmuo *ProductMediaUpdateOne) SetAltText(s string) *ProductMediaUpdateOne {
+	pmuo.mutation.SetAltText(s)
+	return pmuo
+}
+
+// SetNillableAltText sets the "alt_text" field if the given value is not nil.
+func (pmuo *ProductMediaUpdateOne) SetNillableAltText(s *string) *ProductMediaUpdateOne {
+	if s != nil {
+		pmuo.SetAltText(*s)
+	}
+	return pmuo
+}
+
+// SetIsPrimary sets the "is_primary" field.
+func (pmuo *ProductMediaUpdateOne) SetIsPrimary(b bool) *ProductMediaUpdateOne {
+	pmuo.mutation.SetIsPrimary(b)
+	return pmuo
+}
+
+// SetNillableIsPrimary sets the "is_primary" field if the given value is not nil.
+func (pmuo *ProductMediaUpdateOne) SetNillableIsPrimary(b *bool) *ProductMediaUpdateOne {
+	if b != nil {
+		pmuo.SetIsPrimary(*b)
+	}
+	return pmuo
+}
+
+// Mutation returns the ProductMediaMutation object of the builder.
+func (pmuo *ProductMediaUpdateOne) Mutation() *ProductMediaMutation {
+	return pmuo.mutation
+}
+
+// Where appends a list predicates to the ProductMediaUpdate builder.
+func (pmuo *ProductMediaUpdateOne) Where(ps ...predicate.ProductMedia) *ProductMediaUpdateOne {
+	pmuo.mutation.Where(ps...)
+	return pmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pmuo *ProductMediaUpdateOne) Select(field string, fields ...string) *ProductMediaUpdateOne {
+	pmuo.fields = append([]string{field}, fields...)
+	return pmuo
+}
+
+// Save executes the query and returns the updated ProductMedia entity.
+func (pmuo *ProductMediaUpdateOne) Save(ctx context.Context) (*ProductMedia, error) {
+	pmuo.defaults()
+	return withHooks[*ProductMedia, ProductMediaMutation](ctx, pmuo.sqlSave, pmuo.mutation, pmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmuo *ProductMediaUpdateOne) SaveX(ctx context.Context) *ProductMedia {
+	node, err := pmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pmuo *ProductMediaUpdateOne) Exec(ctx context.Context) error {
+	_, err := pmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmuo *ProductMediaUpdateOne) ExecX(ctx context.Context) {
+	if err := pmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pmuo *ProductMediaUpdateOne) defaults() {
+	if _, ok := pmuo.mutation.UpdatedAt(); !ok {
+		v := productmedia.UpdateDefaultUpdatedAt()
+		pmuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmuo *ProductMediaUpdateOne) check() error {
+	if v, ok := pmuo.mutation.Position(); ok {
+		if err := productmedia.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.position": %w`, err)}
+		}
+	}
+	if v, ok := pmuo.mutation.AltText(); ok {
+		if err := productmedia.AltTextValidator(v); err != nil {
+			return &ValidationError{Name: "alt_text", err: fmt.Errorf(`ent: validator failed for field "ProductMedia.alt_text": %w`, err)}
+		}
+	}
+	if _, ok := pmuo.mutation.ProductID(); pmuo.mutation.ProductCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProductMedia.product"`)
+	}
+	if _, ok := pmuo.mutation.ImageID(); pmuo.mutation.ImageCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProductMedia.image"`)
+	}
+	return nil
+}
+
+func (pmuo *ProductMediaUpdateOne) sqlSave(ctx context.Context) (_node *ProductMedia, err error) {
+	if err := pmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productmedia.Table, productmedia.Columns, sqlgraph.NewFieldSpec(productmedia.FieldID, field.TypeUUID))
+	id, ok := pmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductMedia.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productmedia.FieldID)
+		for _, f := range fields {
+			if !productmedia.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productmedia.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(productmedia.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pmuo.mutation.Position(); ok {
+		_spec.SetField(productmedia.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pmuo.mutation.AddedPosition(); ok {
+		_spec.AddField(productmedia.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pmuo.mutation.AltText(); ok {
+		_spec.SetField(productmedia.FieldAltText, field.TypeString, value)
+	}
+	if value, ok := pmuo.mutation.IsPrimary(); ok {
+		_spec.SetField(productmedia.FieldIsPrimary, field.TypeBool, value)
+	}
+	_node = &ProductMedia{config: pmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productmedia.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pmuo.mutation.done = true
+	return _node, nil
+}
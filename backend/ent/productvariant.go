@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sthl/ent/product"
+	"sthl/ent/productvariant"
+	"sthl/money"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ProductVariant is the model entity for the ProductVariant schema.
+type ProductVariant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID uuid.UUID `json:"productId"`
+	// Sku holds the value of the "sku" field.
+	Sku string `json:"sku"`
+	// Options holds the value of the "options" field.
+	Options map[string]string `json:"options"`
+	// Price holds the value of the "price" field.
+	Price *money.Amount `json:"price"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int32 `json:"quantity"`
+	// ImgURL holds the value of the "img_url" field.
+	ImgURL string `json:"imgUrl"`
+	// IsArchived holds the value of the "is_archived" field.
+	IsArchived bool `json:"isArchived"`
+	// Version holds the value of the "version" field.
+	Version int64 `json:"version"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProductVariantQuery when eager-loading is set.
+	Edges ProductVariantEdges `json:"-"`
+}
+
+// ProductVariantEdges holds the relations/edges for other nodes in the graph.
+type ProductVariantEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *Product `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProductVariantEdges) OwnerOrErr() (*Product, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: product.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductVariant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productvariant.FieldOptions:
+			values[i] = new([]byte)
+		case productvariant.FieldIsArchived:
+			values[i] = new(sql.NullBool)
+		case productvariant.FieldPrice, productvariant.FieldQuantity, productvariant.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case productvariant.FieldSku, productvariant.FieldImgURL:
+			values[i] = new(sql.NullString)
+		case productvariant.FieldCreatedAt, productvariant.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case productvariant.FieldID, productvariant.FieldProductID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ProductVariant", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductVariant fields.
+func (pv *ProductVariant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productvariant.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pv.ID = *value
+			}
+		case productvariant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pv.CreatedAt = value.Time
+			}
+		case productvariant.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pv.UpdatedAt = value.Time
+			}
+		case productvariant.FieldProductID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value != nil {
+				pv.ProductID = *value
+			}
+		case productvariant.FieldSku:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sku", values[i])
+			} else if value.Valid {
+				pv.Sku = value.String
+			}
+		case productvariant.FieldOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pv.Options); err != nil {
+					return fmt.Errorf("unmarshal field options: %w", err)
+				}
+			}
+		case productvariant.FieldPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				pv.Price = new(money.Amount)
+				*pv.Price = money.Amount(value.Int64)
+			}
+		case productvariant.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				pv.Quantity = int32(value.Int64)
+			}
+		case productvariant.FieldImgURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field img_url", values[i])
+			} else if value.Valid {
+				pv.ImgURL = value.String
+			}
+		case productvariant.FieldIsArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_archived", values[i])
+			} else if value.Valid {
+				pv.IsArchived = value.Bool
+			}
+		case productvariant.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pv.Version = value.Int64
+			}
+		}
+	}
+	return nil
+}
+
+// QueryOwner queries the "owner" edge of the ProductVariant entity.
+func (pv *ProductVariant) QueryOwner() *ProductQuery {
+	return NewProductVariantClient(pv.config).QueryOwner(pv)
+}
+
+// Update returns a builder for updating this ProductVariant.
+// Note that you need to call ProductVariant.Unwrap() before calling this method if this ProductVariant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pv *ProductVariant) Update() *ProductVariantUpdateOne {
+	return NewProductVariantClient(pv.config).UpdateOne(pv)
+}
+
+// Unwrap unwraps the ProductVariant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pv *ProductVariant) Unwrap() *ProductVariant {
+	_tx, ok := pv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductVariant is not a transactional entity")
+	}
+	pv.config.driver = _tx.drv
+	return pv
+}
+
+// String implements the fmt.Stringer.
+func (pv *ProductVariant) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductVariant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pv.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pv.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pv.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(fmt.Sprintf("%v", pv.ProductID))
+	builder.WriteString(", ")
+	builder.WriteString("sku=")
+	builder.WriteString(pv.Sku)
+	builder.WriteString(", ")
+	builder.WriteString("options=")
+	builder.WriteString(fmt.Sprintf("%v", pv.Options))
+	builder.WriteString(", ")
+	if v := pv.Price; v != nil {
+		builder.WriteString("price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", pv.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("img_url=")
+	builder.WriteString(pv.ImgURL)
+	builder.WriteString(", ")
+	builder.WriteString("is_archived=")
+	builder.WriteString(fmt.Sprintf("%v", pv.IsArchived))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", pv.Version))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductVariants is a parsable slice of ProductVariant.
+type ProductVariants []*ProductVariant
@@ -0,0 +1,97 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productvariant
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the productvariant type in the database.
+	Label = "product_variant"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldSku holds the string denoting the sku field in the database.
+	FieldSku = "sku"
+	// FieldOptions holds the string denoting the options field in the database.
+	FieldOptions = "options"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldImgURL holds the string denoting the img_url field in the database.
+	FieldImgURL = "img_url"
+	// FieldIsArchived holds the string denoting the is_archived field in the database.
+	FieldIsArchived = "is_archived"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+	// Table holds the table name of the productvariant in the database.
+	Table = "product_variants"
+	// OwnerTable is the table that holds the owner relation/edge.
+	OwnerTable = "product_variants"
+	// OwnerInverseTable is the table name for the Product entity.
+	// It exists in this package in order to avoid circular dependency with the "product" package.
+	OwnerInverseTable = "products"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "product_id"
+)
+
+// Columns holds all SQL columns for productvariant fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldProductID,
+	FieldSku,
+	FieldOptions,
+	FieldPrice,
+	FieldQuantity,
+	FieldImgURL,
+	FieldIsArchived,
+	FieldVersion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// SkuValidator is a validator for the "sku" field. It is called by the builders before save.
+	SkuValidator func(string) error
+	// PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	PriceValidator func(int64) error
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int32) error
+	// DefaultImgURL holds the default value on creation for the "img_url" field.
+	DefaultImgURL string
+	// ImgURLValidator is a validator for the "img_url" field. It is called by the builders before save.
+	ImgURLValidator func(string) error
+	// DefaultIsArchived holds the default value on creation for the "is_archived" field.
+	DefaultIsArchived bool
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
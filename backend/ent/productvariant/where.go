@@ -0,0 +1,547 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productvariant
+
+import (
+	"sthl/ent/predicate"
+	"sthl/money"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldProductID, v))
+}
+
+// Sku applies equality check predicate on the "sku" field. It's identical to SkuEQ.
+func Sku(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldSku, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v money.Amount) predicate.ProductVariant {
+	vc := int64(v)
+	return predicate.ProductVariant(sql.FieldEQ(FieldPrice, vc))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int32) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldQuantity, v))
+}
+
+// ImgURL applies equality check predicate on the "img_url" field. It's identical to ImgURLEQ.
+func ImgURL(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldImgURL, v))
+}
+
+// IsArchived applies equality check predicate on the "is_archived" field. It's identical to IsArchivedEQ.
+func IsArchived(v bool) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldIsArchived, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int64) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldVersion, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...uuid.UUID) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// SkuEQ applies the EQ predicate on the "sku" field.
+func SkuEQ(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldSku, v))
+}
+
+// SkuNEQ applies the NEQ predicate on the "sku" field.
+func SkuNEQ(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldSku, v))
+}
+
+// SkuIn applies the In predicate on the "sku" field.
+func SkuIn(vs ...string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldSku, vs...))
+}
+
+// SkuNotIn applies the NotIn predicate on the "sku" field.
+func SkuNotIn(vs ...string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldSku, vs...))
+}
+
+// SkuGT applies the GT predicate on the "sku" field.
+func SkuGT(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldSku, v))
+}
+
+// SkuGTE applies the GTE predicate on the "sku" field.
+func SkuGTE(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldSku, v))
+}
+
+// SkuLT applies the LT predicate on the "sku" field.
+func SkuLT(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldSku, v))
+}
+
+// SkuLTE applies the LTE predicate on the "sku" field.
+func SkuLTE(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldSku, v))
+}
+
+// SkuContains applies the Contains predicate on the "sku" field.
+func SkuContains(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldContains(FieldSku, v))
+}
+
+// SkuHasPrefix applies the HasPrefix predicate on the "sku" field.
+func SkuHasPrefix(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldHasPrefix(FieldSku, v))
+}
+
+// SkuHasSuffix applies the HasSuffix predicate on the "sku" field.
+func SkuHasSuffix(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldHasSuffix(FieldSku, v))
+}
+
+// SkuEqualFold applies the EqualFold predicate on the "sku" field.
+func SkuEqualFold(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEqualFold(FieldSku, v))
+}
+
+// SkuContainsFold applies the ContainsFold predicate on the "sku" field.
+func SkuContainsFold(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldContainsFold(FieldSku, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v money.Amount) predicate.ProductVariant {
+	vc := int64(v)
+	return predicate.ProductVariant(sql.FieldEQ(FieldPrice, vc))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v money.Amount) predicate.ProductVariant {
+	vc := int64(v)
+	return predicate.ProductVariant(sql.FieldNEQ(FieldPrice, vc))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...money.Amount) predicate.ProductVariant {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.ProductVariant(sql.FieldIn(FieldPrice, v...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...money.Amount) predicate.ProductVariant {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.ProductVariant(sql.FieldNotIn(FieldPrice, v...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v money.Amount) predicate.ProductVariant {
+	vc := int64(v)
+	return predicate.ProductVariant(sql.FieldGT(FieldPrice, vc))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v money.Amount) predicate.ProductVariant {
+	vc := int64(v)
+	return predicate.ProductVariant(sql.FieldGTE(FieldPrice, vc))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v money.Amount) predicate.ProductVariant {
+	vc := int64(v)
+	return predicate.ProductVariant(sql.FieldLT(FieldPrice, vc))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v money.Amount) predicate.ProductVariant {
+	vc := int64(v)
+	return predicate.ProductVariant(sql.FieldLTE(FieldPrice, vc))
+}
+
+// PriceIsNil applies the IsNil predicate on the "price" field.
+func PriceIsNil() predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIsNull(FieldPrice))
+}
+
+// PriceNotNil applies the NotNil predicate on the "price" field.
+func PriceNotNil() predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotNull(FieldPrice))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int32) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int32) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int32) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int32) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int32) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int32) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int32) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int32) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldQuantity, v))
+}
+
+// ImgURLEQ applies the EQ predicate on the "img_url" field.
+func ImgURLEQ(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldImgURL, v))
+}
+
+// ImgURLNEQ applies the NEQ predicate on the "img_url" field.
+func ImgURLNEQ(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldImgURL, v))
+}
+
+// ImgURLIn applies the In predicate on the "img_url" field.
+func ImgURLIn(vs ...string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldImgURL, vs...))
+}
+
+// ImgURLNotIn applies the NotIn predicate on the "img_url" field.
+func ImgURLNotIn(vs ...string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldImgURL, vs...))
+}
+
+// ImgURLGT applies the GT predicate on the "img_url" field.
+func ImgURLGT(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldImgURL, v))
+}
+
+// ImgURLGTE applies the GTE predicate on the "img_url" field.
+func ImgURLGTE(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldImgURL, v))
+}
+
+// ImgURLLT applies the LT predicate on the "img_url" field.
+func ImgURLLT(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldImgURL, v))
+}
+
+// ImgURLLTE applies the LTE predicate on the "img_url" field.
+func ImgURLLTE(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldImgURL, v))
+}
+
+// ImgURLContains applies the Contains predicate on the "img_url" field.
+func ImgURLContains(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldContains(FieldImgURL, v))
+}
+
+// ImgURLHasPrefix applies the HasPrefix predicate on the "img_url" field.
+func ImgURLHasPrefix(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldHasPrefix(FieldImgURL, v))
+}
+
+// ImgURLHasSuffix applies the HasSuffix predicate on the "img_url" field.
+func ImgURLHasSuffix(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldHasSuffix(FieldImgURL, v))
+}
+
+// ImgURLEqualFold applies the EqualFold predicate on the "img_url" field.
+func ImgURLEqualFold(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEqualFold(FieldImgURL, v))
+}
+
+// ImgURLContainsFold applies the ContainsFold predicate on the "img_url" field.
+func ImgURLContainsFold(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldContainsFold(FieldImgURL, v))
+}
+
+// IsArchivedEQ applies the EQ predicate on the "is_archived" field.
+func IsArchivedEQ(v bool) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldIsArchived, v))
+}
+
+// IsArchivedNEQ applies the NEQ predicate on the "is_archived" field.
+func IsArchivedNEQ(v bool) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldIsArchived, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int64) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int64) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int64) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int64) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int64) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int64) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int64) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int64) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldVersion, v))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.ProductVariant {
+	return predicate.ProductVariant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.Product) predicate.ProductVariant {
+	return predicate.ProductVariant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OwnerInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProductVariant) predicate.ProductVariant {
+	return predicate.ProductVariant(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProductVariant) predicate.ProductVariant {
+	return predicate.ProductVariant(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProductVariant) predicate.ProductVariant {
+	return predicate.ProductVariant(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
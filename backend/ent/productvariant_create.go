@@ -0,0 +1,1108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/product"
+	"sthl/ent/productvariant"
+	"sthl/money"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ProductVariantCreate is the builder for creating a ProductVariant entity.
+type ProductVariantCreate struct {
+	config
+	mutation *ProductVariantMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pvc *ProductVariantCreate) SetCreatedAt(t time.Time) *ProductVariantCreate {
+	pvc.mutation.SetCreatedAt(t)
+	return pvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pvc *ProductVariantCreate) SetNillableCreatedAt(t *time.Time) *ProductVariantCreate {
+	if t != nil {
+		pvc.SetCreatedAt(*t)
+	}
+	return pvc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pvc *ProductVariantCreate) SetUpdatedAt(t time.Time) *ProductVariantCreate {
+	pvc.mutation.SetUpdatedAt(t)
+	return pvc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pvc *ProductVariantCreate) SetNillableUpdatedAt(t *time.Time) *ProductVariantCreate {
+	if t != nil {
+		pvc.SetUpdatedAt(*t)
+	}
+	return pvc
+}
+
+// SetProductID sets the "product_id" field.
+func (pvc *ProductVariantCreate) SetProductID(u uuid.UUID) *ProductVariantCreate {
+	pvc.mutation.SetProductID(u)
+	return pvc
+}
+
+// SetSku sets the "sku" field.
+func (pvc *ProductVariantCreate) SetSku(s string) *ProductVariantCreate {
+	pvc.mutation.SetSku(s)
+	return pvc
+}
+
+// SetOptions sets the "options" field.
+func (pvc *ProductVariantCreate) SetOptions(m map[string]string) *ProductVariantCreate {
+	pvc.mutation.SetOptions(m)
+	return pvc
+}
+
+// SetPrice sets the "price" field.
+func (pvc *ProductVariantCreate) SetPrice(m money.Amount) *ProductVariantCreate {
+	pvc.mutation.SetPrice(m)
+	return pvc
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (pvc *ProductVariantCreate) SetNillablePrice(m *money.Amount) *ProductVariantCreate {
+	if m != nil {
+		pvc.SetPrice(*m)
+	}
+	return pvc
+}
+
+// SetQuantity sets the "quantity" field.
+func (pvc *ProductVariantCreate) SetQuantity(i int32) *ProductVariantCreate {
+	pvc.mutation.SetQuantity(i)
+	return pvc
+}
+
+// SetImgURL sets the "img_url" field.
+func (pvc *ProductVariantCreate) SetImgURL(s string) *ProductVariantCreate {
+	pvc.mutation.SetImgURL(s)
+	return pvc
+}
+
+// SetNillableImgURL sets the "img_url" field if the given value is not nil.
+func (pvc *ProductVariantCreate) SetNillableImgURL(s *string) *ProductVariantCreate {
+	if s != nil {
+		pvc.SetImgURL(*s)
+	}
+	return pvc
+}
+
+// SetIsArchived sets the "is_archived" field.
+func (pvc *ProductVariantCreate) SetIsArchived(b bool) *ProductVariantCreate {
+	pvc.mutation.SetIsArchived(b)
+	return pvc
+}
+
+// SetNillableIsArchived sets the "is_archived" field if the given value is not nil.
+func (pvc *ProductVariantCreate) SetNillableIsArchived(b *bool) *ProductVariantCreate {
+	if b != nil {
+		pvc.SetIsArchived(*b)
+	}
+	return pvc
+}
+
+// SetVersion sets the "version" field.
+func (pvc *ProductVariantCreate) SetVersion(i int64) *ProductVariantCreate {
+	pvc.mutation.SetVersion(i)
+	return pvc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pvc *ProductVariantCreate) SetNillableVersion(i *int64) *ProductVariantCreate {
+	if i != nil {
+		pvc.SetVersion(*i)
+	}
+	return pvc
+}
+
+// SetID sets the "id" field.
+func (pvc *ProductVariantCreate) SetID(u uuid.UUID) *ProductVariantCreate {
+	pvc.mutation.SetID(u)
+	return pvc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pvc *ProductVariantCreate) SetNillableID(u *uuid.UUID) *ProductVariantCreate {
+	if u != nil {
+		pvc.SetID(*u)
+	}
+	return pvc
+}
+
+// SetOwnerID sets the "owner" edge to the Product entity by ID.
+func (pvc *ProductVariantCreate) SetOwnerID(id uuid.UUID) *ProductVariantCreate {
+	pvc.mutation.SetOwnerID(id)
+	return pvc
+}
+
+// SetOwner sets the "owner" edge to the Product entity.
+func (pvc *ProductVariantCreate) SetOwner(p *Product) *ProductVariantCreate {
+	return pvc.SetOwnerID(p.ID)
+}
+
+// Mutation returns the ProductVariantMutation object of the builder.
+func (pvc *ProductVariantCreate) Mutation() *ProductVariantMutation {
+	return pvc.mutation
+}
+
+// Save creates the ProductVariant in the database.
+func (pvc *ProductVariantCreate) Save(ctx context.Context) (*ProductVariant, error) {
+	pvc.defaults()
+	return withHooks[*ProductVariant, ProductVariantMutation](ctx, pvc.sqlSave, pvc.mutation, pvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pvc *ProductVariantCreate) SaveX(ctx context.Context) *ProductVariant {
+	v, err := pvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pvc *ProductVariantCreate) Exec(ctx context.Context) error {
+	_, err := pvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvc *ProductVariantCreate) ExecX(ctx context.Context) {
+	if err := pvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pvc *ProductVariantCreate) defaults() {
+	if _, ok := pvc.mutation.CreatedAt(); !ok {
+		v := productvariant.DefaultCreatedAt()
+		pvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pvc.mutation.UpdatedAt(); !ok {
+		v := productvariant.DefaultUpdatedAt()
+		pvc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pvc.mutation.ImgURL(); !ok {
+		v := productvariant.DefaultImgURL
+		pvc.mutation.SetImgURL(v)
+	}
+	if _, ok := pvc.mutation.IsArchived(); !ok {
+		v := productvariant.DefaultIsArchived
+		pvc.mutation.SetIsArchived(v)
+	}
+	if _, ok := pvc.mutation.Version(); !ok {
+		v := productvariant.DefaultVersion
+		pvc.mutation.SetVersion(v)
+	}
+	if _, ok := pvc.mutation.ID(); !ok {
+		v := productvariant.DefaultID()
+		pvc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pvc *ProductVariantCreate) check() error {
+	if _, ok := pvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductVariant.created_at"`)}
+	}
+	if _, ok := pvc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProductVariant.updated_at"`)}
+	}
+	if _, ok := pvc.mutation.ProductID(); !ok {
+		return &ValidationError{Name: "product_id", err: errors.New(`ent: missing required field "ProductVariant.product_id"`)}
+	}
+	if _, ok := pvc.mutation.Sku(); !ok {
+		return &ValidationError{Name: "sku", err: errors.New(`ent: missing required field "ProductVariant.sku"`)}
+	}
+	if v, ok := pvc.mutation.Sku(); ok {
+		if err := productvariant.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.sku": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.Options(); !ok {
+		return &ValidationError{Name: "options", err: errors.New(`ent: missing required field "ProductVariant.options"`)}
+	}
+	if v, ok := pvc.mutation.Price(); ok {
+		if err := productvariant.PriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.price": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "ProductVariant.quantity"`)}
+	}
+	if v, ok := pvc.mutation.Quantity(); ok {
+		if err := productvariant.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.quantity": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.ImgURL(); !ok {
+		return &ValidationError{Name: "img_url", err: errors.New(`ent: missing required field "ProductVariant.img_url"`)}
+	}
+	if v, ok := pvc.mutation.ImgURL(); ok {
+		if err := productvariant.ImgURLValidator(v); err != nil {
+			return &ValidationError{Name: "img_url", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.img_url": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.IsArchived(); !ok {
+		return &ValidationError{Name: "is_archived", err: errors.New(`ent: missing required field "ProductVariant.is_archived"`)}
+	}
+	if _, ok := pvc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "ProductVariant.version"`)}
+	}
+	if _, ok := pvc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "ProductVariant.owner"`)}
+	}
+	return nil
+}
+
+func (pvc *ProductVariantCreate) sqlSave(ctx context.Context) (*ProductVariant, error) {
+	if err := pvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pvc.mutation.id = &_node.ID
+	pvc.mutation.done = true
+	return _node, nil
+}
+
+func (pvc *ProductVariantCreate) createSpec() (*ProductVariant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductVariant{config: pvc.config}
+		_spec = sqlgraph.NewCreateSpec(productvariant.Table, sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = pvc.conflict
+	if id, ok := pvc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pvc.mutation.CreatedAt(); ok {
+		_spec.SetField(productvariant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pvc.mutation.UpdatedAt(); ok {
+		_spec.SetField(productvariant.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pvc.mutation.Sku(); ok {
+		_spec.SetField(productvariant.FieldSku, field.TypeString, value)
+		_node.Sku = value
+	}
+	if value, ok := pvc.mutation.Options(); ok {
+		_spec.SetField(productvariant.FieldOptions, field.TypeJSON, value)
+		_node.Options = value
+	}
+	if value, ok := pvc.mutation.Price(); ok {
+		_spec.SetField(productvariant.FieldPrice, field.TypeInt64, value)
+		_node.Price = &value
+	}
+	if value, ok := pvc.mutation.Quantity(); ok {
+		_spec.SetField(productvariant.FieldQuantity, field.TypeInt32, value)
+		_node.Quantity = value
+	}
+	if value, ok := pvc.mutation.ImgURL(); ok {
+		_spec.SetField(productvariant.FieldImgURL, field.TypeString, value)
+		_node.ImgURL = value
+	}
+	if value, ok := pvc.mutation.IsArchived(); ok {
+		_spec.SetField(productvariant.FieldIsArchived, field.TypeBool, value)
+		_node.IsArchived = value
+	}
+	if value, ok := pvc.mutation.Version(); ok {
+		_spec.SetField(productvariant.FieldVersion, field.TypeInt64, value)
+		_node.Version = value
+	}
+	if nodes := pvc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productvariant.OwnerTable,
+			Columns: []string{productvariant.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProductID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProductVariant.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProductVariantUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pvc *ProductVariantCreate) OnConflict(opts ...sql.ConflictOption) *ProductVariantUpsertOne {
+	pvc.conflict = opts
+	return &ProductVariantUpsertOne{
+		create: pvc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProductVariant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pvc *ProductVariantCreate) OnConflictColumns(columns ...string) *ProductVariantUpsertOne {
+	pvc.conflict = append(pvc.conflict, sql.ConflictColumns(columns...))
+	return &ProductVariantUpsertOne{
+		create: pvc,
+	}
+}
+
+type (
+	// ProductVariantUpsertOne is the builder for "upsert"-ing
+	//  one ProductVariant node.
+	ProductVariantUpsertOne struct {
+		create *ProductVariantCreate
+	}
+
+	// ProductVariantUpsert is the "OnConflict" setter.
+	ProductVariantUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProductVariantUpsert) SetUpdatedAt(v time.Time) *ProductVariantUpsert {
+	u.Set(productvariant.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProductVariantUpsert) UpdateUpdatedAt() *ProductVariantUpsert {
+	u.SetExcluded(productvariant.FieldUpdatedAt)
+	return u
+}
+
+// SetSku sets the "sku" field.
+func (u *ProductVariantUpsert) SetSku(v string) *ProductVariantUpsert {
+	u.Set(productvariant.FieldSku, v)
+	return u
+}
+
+// UpdateSku sets the "sku" field to the value that was provided on create.
+func (u *ProductVariantUpsert) UpdateSku() *ProductVariantUpsert {
+	u.SetExcluded(productvariant.FieldSku)
+	return u
+}
+
+// SetOptions sets the "options" field.
+func (u *ProductVariantUpsert) SetOptions(v map[string]string) *ProductVariantUpsert {
+	u.Set(productvariant.FieldOptions, v)
+	return u
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *ProductVariantUpsert) UpdateOptions() *ProductVariantUpsert {
+	u.SetExcluded(productvariant.FieldOptions)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *ProductVariantUpsert) SetPrice(v money.Amount) *ProductVariantUpsert {
+	u.Set(productvariant.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ProductVariantUpsert) UpdatePrice() *ProductVariantUpsert {
+	u.SetExcluded(productvariant.FieldPrice)
+	return u
+}
+
+// AddPrice adds v to the "price" field.
+func (u *ProductVariantUpsert) AddPrice(v money.Amount) *ProductVariantUpsert {
+	u.Add(productvariant.FieldPrice, v)
+	return u
+}
+
+// ClearPrice clears the value of the "price" field.
+func (u *ProductVariantUpsert) ClearPrice() *ProductVariantUpsert {
+	u.SetNull(productvariant.FieldPrice)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *ProductVariantUpsert) SetQuantity(v int32) *ProductVariantUpsert {
+	u.Set(productvariant.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *ProductVariantUpsert) UpdateQuantity() *ProductVariantUpsert {
+	u.SetExcluded(productvariant.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *ProductVariantUpsert) AddQuantity(v int32) *ProductVariantUpsert {
+	u.Add(productvariant.FieldQuantity, v)
+	return u
+}
+
+// SetImgURL sets the "img_url" field.
+func (u *ProductVariantUpsert) SetImgURL(v string) *ProductVariantUpsert {
+	u.Set(productvariant.FieldImgURL, v)
+	return u
+}
+
+// UpdateImgURL sets the "img_url" field to the value that was provided on create.
+func (u *ProductVariantUpsert) UpdateImgURL() *ProductVariantUpsert {
+	u.SetExcluded(productvariant.FieldImgURL)
+	return u
+}
+
+// SetIsArchived sets the "is_archived" field.
+func (u *ProductVariantUpsert) SetIsArchived(v bool) *ProductVariantUpsert {
+	u.Set(productvariant.FieldIsArchived, v)
+	return u
+}
+
+// UpdateIsArchived sets the "is_archived" field to the value that was provided on create.
+func (u *ProductVariantUpsert) UpdateIsArchived() *ProductVariantUpsert {
+	u.SetExcluded(productvariant.FieldIsArchived)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *ProductVariantUpsert) SetVersion(v int64) *ProductVariantUpsert {
+	u.Set(productvariant.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ProductVariantUpsert) UpdateVersion() *ProductVariantUpsert {
+	u.SetExcluded(productvariant.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ProductVariantUpsert) AddVersion(v int64) *ProductVariantUpsert {
+	u.Add(productvariant.FieldVersion, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProductVariant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(productvariant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProductVariantUpsertOne) UpdateNewValues() *ProductVariantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(productvariant.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(productvariant.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.ProductID(); exists {
+			s.SetIgnore(productvariant.FieldProductID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProductVariant.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProductVariantUpsertOne) Ignore() *ProductVariantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProductVariantUpsertOne) DoNothing() *ProductVariantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProductVariantCreate.OnConflict
+// documentation for more info.
+func (u *ProductVariantUpsertOne) Update(set func(*ProductVariantUpsert)) *ProductVariantUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProductVariantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProductVariantUpsertOne) SetUpdatedAt(v time.Time) *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProductVariantUpsertOne) UpdateUpdatedAt() *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSku sets the "sku" field.
+func (u *ProductVariantUpsertOne) SetSku(v string) *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetSku(v)
+	})
+}
+
+// UpdateSku sets the "sku" field to the value that was provided on create.
+func (u *ProductVariantUpsertOne) UpdateSku() *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateSku()
+	})
+}
+
+// SetOptions sets the "options" field.
+func (u *ProductVariantUpsertOne) SetOptions(v map[string]string) *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetOptions(v)
+	})
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *ProductVariantUpsertOne) UpdateOptions() *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateOptions()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *ProductVariantUpsertOne) SetPrice(v money.Amount) *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *ProductVariantUpsertOne) AddPrice(v money.Amount) *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ProductVariantUpsertOne) UpdatePrice() *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// ClearPrice clears the value of the "price" field.
+func (u *ProductVariantUpsertOne) ClearPrice() *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.ClearPrice()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *ProductVariantUpsertOne) SetQuantity(v int32) *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *ProductVariantUpsertOne) AddQuantity(v int32) *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *ProductVariantUpsertOne) UpdateQuantity() *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetImgURL sets the "img_url" field.
+func (u *ProductVariantUpsertOne) SetImgURL(v string) *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetImgURL(v)
+	})
+}
+
+// UpdateImgURL sets the "img_url" field to the value that was provided on create.
+func (u *ProductVariantUpsertOne) UpdateImgURL() *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateImgURL()
+	})
+}
+
+// SetIsArchived sets the "is_archived" field.
+func (u *ProductVariantUpsertOne) SetIsArchived(v bool) *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetIsArchived(v)
+	})
+}
+
+// UpdateIsArchived sets the "is_archived" field to the value that was provided on create.
+func (u *ProductVariantUpsertOne) UpdateIsArchived() *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateIsArchived()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ProductVariantUpsertOne) SetVersion(v int64) *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ProductVariantUpsertOne) AddVersion(v int64) *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ProductVariantUpsertOne) UpdateVersion() *ProductVariantUpsertOne {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *ProductVariantUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProductVariantCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProductVariantUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProductVariantUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProductVariantUpsertOne.ID is not supported by MySQL driver. Use ProductVariantUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProductVariantUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProductVariantCreateBulk is the builder for creating many ProductVariant entities in bulk.
+type ProductVariantCreateBulk struct {
+	config
+	builders []*ProductVariantCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProductVariant entities in the database.
+func (pvcb *ProductVariantCreateBulk) Save(ctx context.Context) ([]*ProductVariant, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pvcb.builders))
+	nodes := make([]*ProductVariant, len(pvcb.builders))
+	mutators := make([]Mutator, len(pvcb.builders))
+	for i := range pvcb.builders {
+		func(i int, root context.Context) {
+			builder := pvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductVariantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pvcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvcb *ProductVariantCreateBulk) SaveX(ctx context.Context) []*ProductVariant {
+	v, err := pvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pvcb *ProductVariantCreateBulk) Exec(ctx context.Context) error {
+	_, err := pvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvcb *ProductVariantCreateBulk) ExecX(ctx context.Context) {
+	if err := pvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProductVariant.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProductVariantUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (pvcb *ProductVariantCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProductVariantUpsertBulk {
+	pvcb.conflict = opts
+	return &ProductVariantUpsertBulk{
+		create: pvcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProductVariant.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pvcb *ProductVariantCreateBulk) OnConflictColumns(columns ...string) *ProductVariantUpsertBulk {
+	pvcb.conflict = append(pvcb.conflict, sql.ConflictColumns(columns...))
+	return &ProductVariantUpsertBulk{
+		create: pvcb,
+	}
+}
+
+// ProductVariantUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProductVariant nodes.
+type ProductVariantUpsertBulk struct {
+	create *ProductVariantCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProductVariant.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(productvariant.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProductVariantUpsertBulk) UpdateNewValues() *ProductVariantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(productvariant.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(productvariant.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.ProductID(); exists {
+				s.SetIgnore(productvariant.FieldProductID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProductVariant.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProductVariantUpsertBulk) Ignore() *ProductVariantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProductVariantUpsertBulk) DoNothing() *ProductVariantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProductVariantCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProductVariantUpsertBulk) Update(set func(*ProductVariantUpsert)) *ProductVariantUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProductVariantUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ProductVariantUpsertBulk) SetUpdatedAt(v time.Time) *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ProductVariantUpsertBulk) UpdateUpdatedAt() *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSku sets the "sku" field.
+func (u *ProductVariantUpsertBulk) SetSku(v string) *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetSku(v)
+	})
+}
+
+// UpdateSku sets the "sku" field to the value that was provided on create.
+func (u *ProductVariantUpsertBulk) UpdateSku() *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateSku()
+	})
+}
+
+// SetOptions sets the "options" field.
+func (u *ProductVariantUpsertBulk) SetOptions(v map[string]string) *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetOptions(v)
+	})
+}
+
+// UpdateOptions sets the "options" field to the value that was provided on create.
+func (u *ProductVariantUpsertBulk) UpdateOptions() *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateOptions()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *ProductVariantUpsertBulk) SetPrice(v money.Amount) *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *ProductVariantUpsertBulk) AddPrice(v money.Amount) *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ProductVariantUpsertBulk) UpdatePrice() *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// ClearPrice clears the value of the "price" field.
+func (u *ProductVariantUpsertBulk) ClearPrice() *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.ClearPrice()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *ProductVariantUpsertBulk) SetQuantity(v int32) *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *ProductVariantUpsertBulk) AddQuantity(v int32) *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *ProductVariantUpsertBulk) UpdateQuantity() *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetImgURL sets the "img_url" field.
+func (u *ProductVariantUpsertBulk) SetImgURL(v string) *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetImgURL(v)
+	})
+}
+
+// UpdateImgURL sets the "img_url" field to the value that was provided on create.
+func (u *ProductVariantUpsertBulk) UpdateImgURL() *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateImgURL()
+	})
+}
+
+// SetIsArchived sets the "is_archived" field.
+func (u *ProductVariantUpsertBulk) SetIsArchived(v bool) *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetIsArchived(v)
+	})
+}
+
+// UpdateIsArchived sets the "is_archived" field to the value that was provided on create.
+func (u *ProductVariantUpsertBulk) UpdateIsArchived() *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateIsArchived()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ProductVariantUpsertBulk) SetVersion(v int64) *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ProductVariantUpsertBulk) AddVersion(v int64) *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ProductVariantUpsertBulk) UpdateVersion() *ProductVariantUpsertBulk {
+	return u.Update(func(s *ProductVariantUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *ProductVariantUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProductVariantCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProductVariantCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProductVariantUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,543 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/predicate"
+	"sthl/ent/productvariant"
+	"sthl/money"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductVariantUpdate is the builder for updating ProductVariant entities.
+type ProductVariantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductVariantMutation
+}
+
+// Where appends a list predicates to the ProductVariantUpdate builder.
+func (pvu *ProductVariantUpdate) Where(ps ...predicate.ProductVariant) *ProductVariantUpdate {
+	pvu.mutation.Where(ps...)
+	return pvu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pvu *ProductVariantUpdate) SetUpdatedAt(t time.Time) *ProductVariantUpdate {
+	pvu.mutation.SetUpdatedAt(t)
+	return pvu
+}
+
+// SetSku sets the "sku" field.
+func (pvu *ProductVariantUpdate) SetSku(s string) *ProductVariantUpdate {
+	pvu.mutation.SetSku(s)
+	return pvu
+}
+
+// SetOptions sets the "options" field.
+func (pvu *ProductVariantUpdate) SetOptions(m map[string]string) *ProductVariantUpdate {
+	pvu.mutation.SetOptions(m)
+	return pvu
+}
+
+// SetPrice sets the "price" field.
+func (pvu *ProductVariantUpdate) SetPrice(m money.Amount) *ProductVariantUpdate {
+	pvu.mutation.ResetPrice()
+	pvu.mutation.SetPrice(m)
+	return pvu
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (pvu *ProductVariantUpdate) SetNillablePrice(m *money.Amount) *ProductVariantUpdate {
+	if m != nil {
+		pvu.SetPrice(*m)
+	}
+	return pvu
+}
+
+// AddPrice adds m to the "price" field.
+func (pvu *ProductVariantUpdate) AddPrice(m money.Amount) *ProductVariantUpdate {
+	pvu.mutation.AddPrice(m)
+	return pvu
+}
+
+// ClearPrice clears the value of the "price" field.
+func (pvu *ProductVariantUpdate) ClearPrice() *ProductVariantUpdate {
+	pvu.mutation.ClearPrice()
+	return pvu
+}
+
+// SetQuantity sets the "quantity" field.
+func (pvu *ProductVariantUpdate) SetQuantity(i int32) *ProductVariantUpdate {
+	pvu.mutation.ResetQuantity()
+	pvu.mutation.SetQuantity(i)
+	return pvu
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (pvu *ProductVariantUpdate) AddQuantity(i int32) *ProductVariantUpdate {
+	pvu.mutation.AddQuantity(i)
+	return pvu
+}
+
+// SetImgURL sets the "img_url" field.
+func (pvu *ProductVariantUpdate) SetImgURL(s string) *ProductVariantUpdate {
+	pvu.mutation.SetImgURL(s)
+	return pvu
+}
+
+// SetNillableImgURL sets the "img_url" field if the given value is not nil.
+func (pvu *ProductVariantUpdate) SetNillableImgURL(s *string) *ProductVariantUpdate {
+	if s != nil {
+		pvu.SetImgURL(*s)
+	}
+	return pvu
+}
+
+// SetIsArchived sets the "is_archived" field.
+func (pvu *ProductVariantUpdate) SetIsArchived(b bool) *ProductVariantUpdate {
+	pvu.mutation.SetIsArchived(b)
+	return pvu
+}
+
+// SetNillableIsArchived sets the "is_archived" field if the given value is not nil.
+func (pvu *ProductVariantUpdate) SetNillableIsArchived(b *bool) *ProductVariantUpdate {
+	if b != nil {
+		pvu.SetIsArchived(*b)
+	}
+	return pvu
+}
+
+// SetVersion sets the "version" field.
+func (pvu *ProductVariantUpdate) SetVersion(i int64) *ProductVariantUpdate {
+	pvu.mutation.ResetVersion()
+	pvu.mutation.SetVersion(i)
+	return pvu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pvu *ProductVariantUpdate) SetNillableVersion(i *int64) *ProductVariantUpdate {
+	if i != nil {
+		pvu.SetVersion(*i)
+	}
+	return pvu
+}
+
+// AddVersion adds i to the "version" field.
+func (pvu *ProductVariantUpdate) AddVersion(i int64) *ProductVariantUpdate {
+	pvu.mutation.AddVersion(i)
+	return pvu
+}
+
+// Mutation returns the ProductVariantMutation object of the builder.
+func (pvu *ProductVariantUpdate) Mutation() *ProductVariantMutation {
+	return pvu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pvu *ProductVariantUpdate) Save(ctx context.Context) (int, error) {
+	pvu.defaults()
+	return withHooks[int, ProductVariantMutation](ctx, pvu.sqlSave, pvu.mutation, pvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvu *ProductVariantUpdate) SaveX(ctx context.Context) int {
+	affected, err := pvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pvu *ProductVariantUpdate) Exec(ctx context.Context) error {
+	_, err := pvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvu *ProductVariantUpdate) ExecX(ctx context.Context) {
+	if err := pvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pvu *ProductVariantUpdate) defaults() {
+	if _, ok := pvu.mutation.UpdatedAt(); !ok {
+		v := productvariant.UpdateDefaultUpdatedAt()
+		pvu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pvu *ProductVariantUpdate) check() error {
+	if v, ok := pvu.mutation.Sku(); ok {
+		if err := productvariant.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.sku": %w`, err)}
+		}
+	}
+	if v, ok := pvu.mutation.Price(); ok {
+		if err := productvariant.PriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.price": %w`, err)}
+		}
+	}
+	if v, ok := pvu.mutation.Quantity(); ok {
+		if err := productvariant.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.quantity": %w`, err)}
+		}
+	}
+	if v, ok := pvu.mutation.ImgURL(); ok {
+		if err := productvariant.ImgURLValidator(v); err != nil {
+			return &ValidationError{Name: "img_url", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.img_url": %w`, err)}
+		}
+	}
+	if _, ok := pvu.mutation.OwnerID(); pvu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProductVariant.owner"`)
+	}
+	return nil
+}
+
+func (pvu *ProductVariantUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productvariant.Table, productvariant.Columns, sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeUUID))
+	if ps := pvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pvu.mutation.UpdatedAt(); ok {
+		_spec.SetField(productvariant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pvu.mutation.Sku(); ok {
+		_spec.SetField(productvariant.FieldSku, field.TypeString, value)
+	}
+	if value, ok := pvu.mutation.Options(); ok {
+		_spec.SetField(productvariant.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := pvu.mutation.Price(); ok {
+		_spec.SetField(productvariant.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := pvu.mutation.AddedPrice(); ok {
+		_spec.AddField(productvariant.FieldPrice, field.TypeInt64, value)
+	}
+	if pvu.mutation.PriceCleared() {
+		_spec.ClearField(productvariant.FieldPrice, field.TypeInt64)
+	}
+	if value, ok := pvu.mutation.Quantity(); ok {
+		_spec.SetField(productvariant.FieldQuantity, field.TypeInt32, value)
+	}
+	if value, ok := pvu.mutation.AddedQuantity(); ok {
+		_spec.AddField(productvariant.FieldQuantity, field.TypeInt32, value)
+	}
+	if value, ok := pvu.mutation.ImgURL(); ok {
+		_spec.SetField(productvariant.FieldImgURL, field.TypeString, value)
+	}
+	if value, ok := pvu.mutation.IsArchived(); ok {
+		_spec.SetField(productvariant.FieldIsArchived, field.TypeBool, value)
+	}
+	if value, ok := pvu.mutation.Version(); ok {
+		_spec.SetField(productvariant.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := pvu.mutation.AddedVersion(); ok {
+		_spec.AddField(productvariant.FieldVersion, field.TypeInt64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productvariant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pvu.mutation.done = true
+	return n, nil
+}
+
+// ProductVariantUpdateOne is the builder for updating a single ProductVariant entity.
+type ProductVariantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductVariantMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pvuo *ProductVariantUpdateOne) SetUpdatedAt(t time.Time) *ProductVariantUpdateOne {
+	pvuo.mutation.SetUpdatedAt(t)
+	return pvuo
+}
+
+// SetSku sets the "sku" field.
+func (pvuo *ProductVariantUpdateOne) SetSku(s string) *ProductVariantUpdateOne {
+	pvuo.mutation.SetSku(s)
+	return pvuo
+}
+
+// SetOptions sets the "options" field.
+func (pvuo *ProductVariantUpdateOne) SetOptions(m map[string]string) *ProductVariantUpdateOne {
+	pvuo.mutation.SetOptions(m)
+	return pvuo
+}
+
+// SetPrice sets the "price" field.
+func (pvuo *ProductVariantUpdateOne) SetPrice(m money.Amount) *ProductVariantUpdateOne {
+	pvuo.mutation.ResetPrice()
+	pvuo.mutation.SetPrice(m)
+	return pvuo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (pvuo *ProductVariantUpdateOne) SetNillablePrice(m *money.Amount) *ProductVariantUpdateOne {
+	if m != nil {
+		pvuo.SetPrice(*m)
+	}
+	return pvuo
+}
+
+// AddPrice adds m to the "price" field.
+func (pvuo *ProductVariantUpdateOne) AddPrice(m money.Amount) *ProductVariantUpdateOne {
+	pvuo.mutation.AddPrice(m)
+	return pvuo
+}
+
+// ClearPrice clears the value of the "price" field.
+func (pvuo *ProductVariantUpdateOne) ClearPrice() *ProductVariantUpdateOne {
+	pvuo.mutation.ClearPrice()
+	return pvuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (pvuo *ProductVariantUpdateOne) SetQuantity(i int32) *ProductVariantUpdateOne {
+	pvuo.mutation.ResetQuantity()
+	pvuo.mutation.SetQuantity(i)
+	return pvuo
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (pvuo *ProductVariantUpdateOne) AddQuantity(i int32) *ProductVariantUpdateOne {
+	pvuo.mutation.AddQuantity(i)
+	return pvuo
+}
+
+// SetImgURL sets the "img_url" field.
+func (pvuo *ProductVariantUpdateOne) SetImgURL(s string) *ProductVariantUpdateOne {
+	pvuo.mutation.SetImgURL(s)
+	return pvuo
+}
+
+// SetNillableImgURL sets the "img_url" field if the given value is not nil.
+func (pvuo *ProductVariantUpdateOne) SetNillableImgURL(s *string) *ProductVariantUpdateOne {
+	if s != nil {
+		pvuo.SetImgURL(*s)
+	}
+	return pvuo
+}
+
+// SetIsArchived sets the "is_archived" field.
+func (pvuo *ProductVariantUpdateOne) SetIsArchived(b bool) *ProductVariantUpdateOne {
+	pvuo.mutation.SetIsArchived(b)
+	return pvuo
+}
+
+// SetNillableIsArchived sets the "is_archived" field if the given value is not nil.
+func (pvuo *ProductVariantUpdateOne) SetNillableIsArchived(b *bool) *ProductVariantUpdateOne {
+	if b != nil {
+		pvuo.SetIsArchived(*b)
+	}
+	return pvuo
+}
+
+// SetVersion sets the "version" field.
+func (pvuo *ProductVariantUpdateOne) SetVersion(i int64) *ProductVariantUpdateOne {
+	pvuo.mutation.ResetVersion()
+	pvuo.mutation.SetVersion(i)
+	return pvuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pvuo *ProductVariantUpdateOne) SetNillableVersion(i *int64) *ProductVariantUpdateOne {
+	if i != nil {
+		pvuo.SetVersion(*i)
+	}
+	return pvuo
+}
+
+// AddVersion adds i to the "version" field.
+func (pvuo *ProductVariantUpdateOne) AddVersion(i int64) *ProductVariantUpdateOne {
+	pvuo.mutation.AddVersion(i)
+	return pvuo
+}
+
+// Mutation returns the ProductVariantMutation object of the builder.
+func (pvuo *ProductVariantUpdateOne) Mutation() *ProductVariantMutation {
+	return pvuo.mutation
+}
+
+// Where appends a list predicates to the ProductVariantUpdate builder.
+func (pvuo *ProductVariantUpdateOne) Where(ps ...predicate.ProductVariant) *ProductVariantUpdateOne {
+	pvuo.mutation.Where(ps...)
+	return pvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pvuo *ProductVariantUpdateOne) Select(field string, fields ...string) *ProductVariantUpdateOne {
+	pvuo.fields = append([]string{field}, fields...)
+	return pvuo
+}
+
+// Save executes the query and returns the updated ProductVariant entity.
+func (pvuo *ProductVariantUpdateOne) Save(ctx context.Context) (*ProductVariant, error) {
+	pvuo.defaults()
+	return withHooks[*ProductVariant, ProductVariantMutation](ctx, pvuo.sqlSave, pvuo.mutation, pvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvuo *ProductVariantUpdateOne) SaveX(ctx context.Context) *ProductVariant {
+	node, err := pvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pvuo *ProductVariantUpdateOne) Exec(ctx context.Context) error {
+	_, err := pvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvuo *ProductVariantUpdateOne) ExecX(ctx context.Context) {
+	if err := pvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pvuo *ProductVariantUpdateOne) defaults() {
+	if _, ok := pvuo.mutation.UpdatedAt(); !ok {
+		v := productvariant.UpdateDefaultUpdatedAt()
+		pvuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pvuo *ProductVariantUpdateOne) check() error {
+	if v, ok := pvuo.mutation.Sku(); ok {
+		if err := productvariant.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.sku": %w`, err)}
+		}
+	}
+	if v, ok := pvuo.mutation.Price(); ok {
+		if err := productvariant.PriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.price": %w`, err)}
+		}
+	}
+	if v, ok := pvuo.mutation.Quantity(); ok {
+		if err := productvariant.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.quantity": %w`, err)}
+		}
+	}
+	if v, ok := pvuo.mutation.ImgURL(); ok {
+		if err := productvariant.ImgURLValidator(v); err != nil {
+			return &ValidationError{Name: "img_url", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.img_url": %w`, err)}
+		}
+	}
+	if _, ok := pvuo.mutation.OwnerID(); pvuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ProductVariant.owner"`)
+	}
+	return nil
+}
+
+func (pvuo *ProductVariantUpdateOne) sqlSave(ctx context.Context) (_node *ProductVariant, err error) {
+	if err := pvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productvariant.Table, productvariant.Columns, sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeUUID))
+	id, ok := pvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductVariant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productvariant.FieldID)
+		for _, f := range fields {
+			if !productvariant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productvariant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pvuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(productvariant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pvuo.mutation.Sku(); ok {
+		_spec.SetField(productvariant.FieldSku, field.TypeString, value)
+	}
+	if value, ok := pvuo.mutation.Options(); ok {
+		_spec.SetField(productvariant.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := pvuo.mutation.Price(); ok {
+		_spec.SetField(productvariant.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := pvuo.mutation.AddedPrice(); ok {
+		_spec.AddField(productvariant.FieldPrice, field.TypeInt64, value)
+	}
+	if pvuo.mutation.PriceCleared() {
+		_spec.ClearField(productvariant.FieldPrice, field.TypeInt64)
+	}
+	if value, ok := pvuo.mutation.Quantity(); ok {
+		_spec.SetField(productvariant.FieldQuantity, field.TypeInt32, value)
+	}
+	if value, ok := pvuo.mutation.AddedQuantity(); ok {
+		_spec.AddField(productvariant.FieldQuantity, field.TypeInt32, value)
+	}
+	if value, ok := pvuo.mutation.ImgURL(); ok {
+		_spec.SetField(productvariant.FieldImgURL, field.TypeString, value)
+	}
+	if value, ok := pvuo.mutation.IsArchived(); ok {
+		_spec.SetField(productvariant.FieldIsArchived, field.TypeBool, value)
+	}
+	if value, ok := pvuo.mutation.Version(); ok {
+		_spec.SetField(productvariant.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := pvuo.mutation.AddedVersion(); ok {
+		_spec.AddField(productvariant.FieldVersion, field.TypeInt64, value)
+	}
+	_node = &ProductVariant{config: pvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productvariant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pvuo.mutation.done = true
+	return _node, nil
+}
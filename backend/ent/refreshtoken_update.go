@@ -0,0 +1,570 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/predicate"
+	"sthl/ent/refreshtoken"
+	"sthl/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RefreshTokenUpdate is the builder for updating RefreshToken entities.
+type RefreshTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RefreshTokenMutation
+}
+
+// Where appends a list predicates to the RefreshTokenUpdate builder.
+func (rtu *RefreshTokenUpdate) Where(ps ...predicate.RefreshToken) *RefreshTokenUpdate {
+	rtu.mutation.Where(ps...)
+	return rtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rtu *RefreshTokenUpdate) SetUpdatedAt(t time.Time) *RefreshTokenUpdate {
+	rtu.mutation.SetUpdatedAt(t)
+	return rtu
+}
+
+// SetUserID sets the "user_id" field.
+func (rtu *RefreshTokenUpdate) SetUserID(u uuid.UUID) *RefreshTokenUpdate {
+	rtu.mutation.SetUserID(u)
+	return rtu
+}
+
+// SetFamilyID sets the "family_id" field.
+func (rtu *RefreshTokenUpdate) SetFamilyID(u uuid.UUID) *RefreshTokenUpdate {
+	rtu.mutation.SetFamilyID(u)
+	return rtu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rtu *RefreshTokenUpdate) SetExpiresAt(t time.Time) *RefreshTokenUpdate {
+	rtu.mutation.SetExpiresAt(t)
+	return rtu
+}
+
+// SetRevoked sets the "revoked" field.
+func (rtu *RefreshTokenUpdate) SetRevoked(b bool) *RefreshTokenUpdate {
+	rtu.mutation.SetRevoked(b)
+	return rtu
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableRevoked(b *bool) *RefreshTokenUpdate {
+	if b != nil {
+		rtu.SetRevoked(*b)
+	}
+	return rtu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (rtu *RefreshTokenUpdate) SetRevokedAt(t time.Time) *RefreshTokenUpdate {
+	rtu.mutation.SetRevokedAt(t)
+	return rtu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableRevokedAt(t *time.Time) *RefreshTokenUpdate {
+	if t != nil {
+		rtu.SetRevokedAt(*t)
+	}
+	return rtu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (rtu *RefreshTokenUpdate) ClearRevokedAt() *RefreshTokenUpdate {
+	rtu.mutation.ClearRevokedAt()
+	return rtu
+}
+
+// SetRevokedReason sets the "revoked_reason" field.
+func (rtu *RefreshTokenUpdate) SetRevokedReason(s string) *RefreshTokenUpdate {
+	rtu.mutation.SetRevokedReason(s)
+	return rtu
+}
+
+// SetNillableRevokedReason sets the "revoked_reason" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableRevokedReason(s *string) *RefreshTokenUpdate {
+	if s != nil {
+		rtu.SetRevokedReason(*s)
+	}
+	return rtu
+}
+
+// SetReplacedBy sets the "replaced_by" field.
+func (rtu *RefreshTokenUpdate) SetReplacedBy(u uuid.UUID) *RefreshTokenUpdate {
+	rtu.mutation.SetReplacedBy(u)
+	return rtu
+}
+
+// SetNillableReplacedBy sets the "replaced_by" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableReplacedBy(u *uuid.UUID) *RefreshTokenUpdate {
+	if u != nil {
+		rtu.SetReplacedBy(*u)
+	}
+	return rtu
+}
+
+// ClearReplacedBy clears the value of the "replaced_by" field.
+func (rtu *RefreshTokenUpdate) ClearReplacedBy() *RefreshTokenUpdate {
+	rtu.mutation.ClearReplacedBy()
+	return rtu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (rtu *RefreshTokenUpdate) SetOwnerID(id uuid.UUID) *RefreshTokenUpdate {
+	rtu.mutation.SetOwnerID(id)
+	return rtu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (rtu *RefreshTokenUpdate) SetOwner(u *User) *RefreshTokenUpdate {
+	return rtu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the RefreshTokenMutation object of the builder.
+func (rtu *RefreshTokenUpdate) Mutation() *RefreshTokenMutation {
+	return rtu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (rtu *RefreshTokenUpdate) ClearOwner() *RefreshTokenUpdate {
+	rtu.mutation.ClearOwner()
+	return rtu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rtu *RefreshTokenUpdate) Save(ctx context.Context) (int, error) {
+	rtu.defaults()
+	return withHooks[int, RefreshTokenMutation](ctx, rtu.sqlSave, rtu.mutation, rtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtu *RefreshTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := rtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rtu *RefreshTokenUpdate) Exec(ctx context.Context) error {
+	_, err := rtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtu *RefreshTokenUpdate) ExecX(ctx context.Context) {
+	if err := rtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtu *RefreshTokenUpdate) defaults() {
+	if _, ok := rtu.mutation.UpdatedAt(); !ok {
+		v := refreshtoken.UpdateDefaultUpdatedAt()
+		rtu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtu *RefreshTokenUpdate) check() error {
+	if v, ok := rtu.mutation.RevokedReason(); ok {
+		if err := refreshtoken.RevokedReasonValidator(v); err != nil {
+			return &ValidationError{Name: "revoked_reason", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.revoked_reason": %w`, err)}
+		}
+	}
+	if _, ok := rtu.mutation.OwnerID(); rtu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RefreshToken.owner"`)
+	}
+	return nil
+}
+
+func (rtu *RefreshTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(refreshtoken.Table, refreshtoken.Columns, sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID))
+	if ps := rtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(refreshtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rtu.mutation.FamilyID(); ok {
+		_spec.SetField(refreshtoken.FieldFamilyID, field.TypeUUID, value)
+	}
+	if value, ok := rtu.mutation.ExpiresAt(); ok {
+		_spec.SetField(refreshtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := rtu.mutation.Revoked(); ok {
+		_spec.SetField(refreshtoken.FieldRevoked, field.TypeBool, value)
+	}
+	if value, ok := rtu.mutation.RevokedAt(); ok {
+		_spec.SetField(refreshtoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if rtu.mutation.RevokedAtCleared() {
+		_spec.ClearField(refreshtoken.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := rtu.mutation.RevokedReason(); ok {
+		_spec.SetField(refreshtoken.FieldRevokedReason, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.ReplacedBy(); ok {
+		_spec.SetField(refreshtoken.FieldReplacedBy, field.TypeUUID, value)
+	}
+	if rtu.mutation.ReplacedByCleared() {
+		_spec.ClearField(refreshtoken.FieldReplacedBy, field.TypeUUID)
+	}
+	if rtu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refreshtoken.OwnerTable,
+			Columns: []string{refreshtoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refreshtoken.OwnerTable,
+			Columns: []string{refreshtoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refreshtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rtu.mutation.done = true
+	return n, nil
+}
+
+// RefreshTokenUpdateOne is the builder for updating a single RefreshToken entity.
+type RefreshTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RefreshTokenMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rtuo *RefreshTokenUpdateOne) SetUpdatedAt(t time.Time) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetUpdatedAt(t)
+	return rtuo
+}
+
+// SetUserID sets the "user_id" field.
+func (rtuo *RefreshTokenUpdateOne) SetUserID(u uuid.UUID) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetUserID(u)
+	return rtuo
+}
+
+// SetFamilyID sets the "family_id" field.
+func (rtuo *RefreshTokenUpdateOne) SetFamilyID(u uuid.UUID) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetFamilyID(u)
+	return rtuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rtuo *RefreshTokenUpdateOne) SetExpiresAt(t time.Time) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetExpiresAt(t)
+	return rtuo
+}
+
+// SetRevoked sets the "revoked" field.
+func (rtuo *RefreshTokenUpdateOne) SetRevoked(b bool) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetRevoked(b)
+	return rtuo
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableRevoked(b *bool) *RefreshTokenUpdateOne {
+	if b != nil {
+		rtuo.SetRevoked(*b)
+	}
+	return rtuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (rtuo *RefreshTokenUpdateOne) SetRevokedAt(t time.Time) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetRevokedAt(t)
+	return rtuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableRevokedAt(t *time.Time) *RefreshTokenUpdateOne {
+	if t != nil {
+		rtuo.SetRevokedAt(*t)
+	}
+	return rtuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (rtuo *RefreshTokenUpdateOne) ClearRevokedAt() *RefreshTokenUpdateOne {
+	rtuo.mutation.ClearRevokedAt()
+	return rtuo
+}
+
+// SetRevokedReason sets the "revoked_reason" field.
+func (rtuo *RefreshTokenUpdateOne) SetRevokedReason(s string) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetRevokedReason(s)
+	return rtuo
+}
+
+// SetNillableRevokedReason sets the "revoked_reason" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableRevokedReason(s *string) *RefreshTokenUpdateOne {
+	if s != nil {
+		rtuo.SetRevokedReason(*s)
+	}
+	return rtuo
+}
+
+// SetReplacedBy sets the "replaced_by" field.
+func (rtuo *RefreshTokenUpdateOne) SetReplacedBy(u uuid.UUID) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetReplacedBy(u)
+	return rtuo
+}
+
+// SetNillableReplacedBy sets the "replaced_by" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableReplacedBy(u *uuid.UUID) *RefreshTokenUpdateOne {
+	if u != nil {
+		rtuo.SetReplacedBy(*u)
+	}
+	return rtuo
+}
+
+// ClearReplacedBy clears the value of the "replaced_by" field.
+func (rtuo *RefreshTokenUpdateOne) ClearReplacedBy() *RefreshTokenUpdateOne {
+	rtuo.mutation.ClearReplacedBy()
+	return rtuo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (rtuo *RefreshTokenUpdateOne) SetOwnerID(id uuid.UUID) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetOwnerID(id)
+	return rtuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (rtuo *RefreshTokenUpdateOne) SetOwner(u *User) *RefreshTokenUpdateOne {
+	return rtuo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the RefreshTokenMutation object of the builder.
+func (rtuo *RefreshTokenUpdateOne) Mutation() *RefreshTokenMutation {
+	return rtuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (rtuo *RefreshTokenUpdateOne) ClearOwner() *RefreshTokenUpdateOne {
+	rtuo.mutation.ClearOwner()
+	return rtuo
+}
+
+// Where appends a list predicates to the RefreshTokenUpdate builder.
+func (rtuo *RefreshTokenUpdateOne) Where(ps ...predicate.RefreshToken) *RefreshTokenUpdateOne {
+	rtuo.mutation.Where(ps...)
+	return rtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rtuo *RefreshTokenUpdateOne) Select(field string, fields ...string) *RefreshTokenUpdateOne {
+	rtuo.fields = append([]string{field}, fields...)
+	return rtuo
+}
+
+// Save executes the query and returns the updated RefreshToken entity.
+func (rtuo *RefreshTokenUpdateOne) Save(ctx context.Context) (*RefreshToken, error) {
+	rtuo.defaults()
+	return withHooks[*RefreshToken, RefreshTokenMutation](ctx, rtuo.sqlSave, rtuo.mutation, rtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtuo *RefreshTokenUpdateOne) SaveX(ctx context.Context) *RefreshToken {
+	node, err := rtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rtuo *RefreshTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := rtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtuo *RefreshTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := rtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtuo *RefreshTokenUpdateOne) defaults() {
+	if _, ok := rtuo.mutation.UpdatedAt(); !ok {
+		v := refreshtoken.UpdateDefaultUpdatedAt()
+		rtuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtuo *RefreshTokenUpdateOne) check() error {
+	if v, ok := rtuo.mutation.RevokedReason(); ok {
+		if err := refreshtoken.RevokedReasonValidator(v); err != nil {
+			return &ValidationError{Name: "revoked_reason", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.revoked_reason": %w`, err)}
+		}
+	}
+	if _, ok := rtuo.mutation.OwnerID(); rtuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RefreshToken.owner"`)
+	}
+	return nil
+}
+
+func (rtuo *RefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *RefreshToken, err error) {
+	if err := rtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(refreshtoken.Table, refreshtoken.Columns, sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeUUID))
+	id, ok := rtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RefreshToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, refreshtoken.FieldID)
+		for _, f := range fields {
+			if !refreshtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != refreshtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(refreshtoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rtuo.mutation.FamilyID(); ok {
+		_spec.SetField(refreshtoken.FieldFamilyID, field.TypeUUID, value)
+	}
+	if value, ok := rtuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(refreshtoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := rtuo.mutation.Revoked(); ok {
+		_spec.SetField(refreshtoken.FieldRevoked, field.TypeBool, value)
+	}
+	if value, ok := rtuo.mutation.RevokedAt(); ok {
+		_spec.SetField(refreshtoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if rtuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(refreshtoken.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := rtuo.mutation.RevokedReason(); ok {
+		_spec.SetField(refreshtoken.FieldRevokedReason, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.ReplacedBy(); ok {
+		_spec.SetField(refreshtoken.FieldReplacedBy, field.TypeUUID, value)
+	}
+	if rtuo.mutation.ReplacedByCleared() {
+		_spec.ClearField(refreshtoken.FieldReplacedBy, field.TypeUUID)
+	}
+	if rtuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refreshtoken.OwnerTable,
+			Columns: []string{refreshtoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rtuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refreshtoken.OwnerTable,
+			Columns: []string{refreshtoken.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RefreshToken{config: rtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refreshtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rtuo.mutation.done = true
+	return _node, nil
+}
@@ -3,13 +3,32 @@
 package ent
 
 import (
+	"sthl/ent/apikey"
+	"sthl/ent/category"
+	"sthl/ent/collection"
+	"sthl/ent/emailverificationtoken"
+	"sthl/ent/idempotencykey"
 	"sthl/ent/imageinfo"
+	"sthl/ent/inventorymovement"
+	"sthl/ent/loginlockoutevent"
+	"sthl/ent/loginthrottle"
+	"sthl/ent/mfarecoverycode"
 	"sthl/ent/order"
+	"sthl/ent/orderevent"
 	"sthl/ent/orderitem"
+	"sthl/ent/passwordresettoken"
 	"sthl/ent/product"
+	"sthl/ent/productmedia"
+	"sthl/ent/productvariant"
+	"sthl/ent/refreshtoken"
 	"sthl/ent/schema"
+	"sthl/ent/shop"
+	"sthl/ent/shopinvite"
+	"sthl/ent/shopmember"
 	"sthl/ent/siteui"
 	"sthl/ent/user"
+	"sthl/ent/usertotp"
+	"sthl/money"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,6 +38,189 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyMixin := schema.ApiKey{}.Mixin()
+	apikeyMixinFields0 := apikeyMixin[0].Fields()
+	_ = apikeyMixinFields0
+	apikeyFields := schema.ApiKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyMixinFields0[0].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescUpdatedAt is the schema descriptor for updated_at field.
+	apikeyDescUpdatedAt := apikeyMixinFields0[1].Descriptor()
+	// apikey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apikey.DefaultUpdatedAt = apikeyDescUpdatedAt.Default.(func() time.Time)
+	// apikey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apikey.UpdateDefaultUpdatedAt = apikeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[3].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[4].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = apikeyDescPrefix.Validators[0].(func(string) error)
+	// apikeyDescKeyHash is the schema descriptor for key_hash field.
+	apikeyDescKeyHash := apikeyFields[5].Descriptor()
+	// apikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikey.KeyHashValidator = apikeyDescKeyHash.Validators[0].(func(string) error)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
+	categoryMixin := schema.Category{}.Mixin()
+	categoryMixinFields0 := categoryMixin[0].Fields()
+	_ = categoryMixinFields0
+	categoryFields := schema.Category{}.Fields()
+	_ = categoryFields
+	// categoryDescCreatedAt is the schema descriptor for created_at field.
+	categoryDescCreatedAt := categoryMixinFields0[0].Descriptor()
+	// category.DefaultCreatedAt holds the default value on creation for the created_at field.
+	category.DefaultCreatedAt = categoryDescCreatedAt.Default.(func() time.Time)
+	// categoryDescUpdatedAt is the schema descriptor for updated_at field.
+	categoryDescUpdatedAt := categoryMixinFields0[1].Descriptor()
+	// category.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	category.DefaultUpdatedAt = categoryDescUpdatedAt.Default.(func() time.Time)
+	// category.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	category.UpdateDefaultUpdatedAt = categoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// categoryDescName is the schema descriptor for name field.
+	categoryDescName := categoryFields[3].Descriptor()
+	// category.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	category.NameValidator = categoryDescName.Validators[0].(func(string) error)
+	// categoryDescSlug is the schema descriptor for slug field.
+	categoryDescSlug := categoryFields[4].Descriptor()
+	// category.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	category.SlugValidator = categoryDescSlug.Validators[0].(func(string) error)
+	// categoryDescDescription is the schema descriptor for description field.
+	categoryDescDescription := categoryFields[5].Descriptor()
+	// category.DefaultDescription holds the default value on creation for the description field.
+	category.DefaultDescription = categoryDescDescription.Default.(string)
+	// category.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	category.DescriptionValidator = categoryDescDescription.Validators[0].(func(string) error)
+	// categoryDescPosition is the schema descriptor for position field.
+	categoryDescPosition := categoryFields[6].Descriptor()
+	// category.DefaultPosition holds the default value on creation for the position field.
+	category.DefaultPosition = categoryDescPosition.Default.(int32)
+	// categoryDescVersion is the schema descriptor for version field.
+	categoryDescVersion := categoryFields[7].Descriptor()
+	// category.DefaultVersion holds the default value on creation for the version field.
+	category.DefaultVersion = categoryDescVersion.Default.(int64)
+	// categoryDescID is the schema descriptor for id field.
+	categoryDescID := categoryFields[0].Descriptor()
+	// category.DefaultID holds the default value on creation for the id field.
+	category.DefaultID = categoryDescID.Default.(func() uuid.UUID)
+	collectionMixin := schema.Collection{}.Mixin()
+	collectionMixinFields0 := collectionMixin[0].Fields()
+	_ = collectionMixinFields0
+	collectionFields := schema.Collection{}.Fields()
+	_ = collectionFields
+	// collectionDescCreatedAt is the schema descriptor for created_at field.
+	collectionDescCreatedAt := collectionMixinFields0[0].Descriptor()
+	// collection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	collection.DefaultCreatedAt = collectionDescCreatedAt.Default.(func() time.Time)
+	// collectionDescUpdatedAt is the schema descriptor for updated_at field.
+	collectionDescUpdatedAt := collectionMixinFields0[1].Descriptor()
+	// collection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	collection.DefaultUpdatedAt = collectionDescUpdatedAt.Default.(func() time.Time)
+	// collection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	collection.UpdateDefaultUpdatedAt = collectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// collectionDescName is the schema descriptor for name field.
+	collectionDescName := collectionFields[2].Descriptor()
+	// collection.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	collection.NameValidator = collectionDescName.Validators[0].(func(string) error)
+	// collectionDescSlug is the schema descriptor for slug field.
+	collectionDescSlug := collectionFields[3].Descriptor()
+	// collection.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	collection.SlugValidator = collectionDescSlug.Validators[0].(func(string) error)
+	// collectionDescDescription is the schema descriptor for description field.
+	collectionDescDescription := collectionFields[4].Descriptor()
+	// collection.DefaultDescription holds the default value on creation for the description field.
+	collection.DefaultDescription = collectionDescDescription.Default.(string)
+	// collection.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	collection.DescriptionValidator = collectionDescDescription.Validators[0].(func(string) error)
+	// collectionDescKind is the schema descriptor for kind field.
+	collectionDescKind := collectionFields[5].Descriptor()
+	// collection.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	collection.KindValidator = collectionDescKind.Validators[0].(func(string) error)
+	// collectionDescRules is the schema descriptor for rules field.
+	collectionDescRules := collectionFields[6].Descriptor()
+	// collection.DefaultRules holds the default value on creation for the rules field.
+	collection.DefaultRules = collectionDescRules.Default.([]schema.CollectionRule)
+	// collectionDescVersion is the schema descriptor for version field.
+	collectionDescVersion := collectionFields[7].Descriptor()
+	// collection.DefaultVersion holds the default value on creation for the version field.
+	collection.DefaultVersion = collectionDescVersion.Default.(int64)
+	// collectionDescID is the schema descriptor for id field.
+	collectionDescID := collectionFields[0].Descriptor()
+	// collection.DefaultID holds the default value on creation for the id field.
+	collection.DefaultID = collectionDescID.Default.(func() uuid.UUID)
+	emailverificationtokenMixin := schema.EmailVerificationToken{}.Mixin()
+	emailverificationtokenMixinFields0 := emailverificationtokenMixin[0].Fields()
+	_ = emailverificationtokenMixinFields0
+	emailverificationtokenFields := schema.EmailVerificationToken{}.Fields()
+	_ = emailverificationtokenFields
+	// emailverificationtokenDescCreatedAt is the schema descriptor for created_at field.
+	emailverificationtokenDescCreatedAt := emailverificationtokenMixinFields0[0].Descriptor()
+	// emailverificationtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailverificationtoken.DefaultCreatedAt = emailverificationtokenDescCreatedAt.Default.(func() time.Time)
+	// emailverificationtokenDescUpdatedAt is the schema descriptor for updated_at field.
+	emailverificationtokenDescUpdatedAt := emailverificationtokenMixinFields0[1].Descriptor()
+	// emailverificationtoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	emailverificationtoken.DefaultUpdatedAt = emailverificationtokenDescUpdatedAt.Default.(func() time.Time)
+	// emailverificationtoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	emailverificationtoken.UpdateDefaultUpdatedAt = emailverificationtokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// emailverificationtokenDescTokenHash is the schema descriptor for token_hash field.
+	emailverificationtokenDescTokenHash := emailverificationtokenFields[2].Descriptor()
+	// emailverificationtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	emailverificationtoken.TokenHashValidator = emailverificationtokenDescTokenHash.Validators[0].(func(string) error)
+	// emailverificationtokenDescID is the schema descriptor for id field.
+	emailverificationtokenDescID := emailverificationtokenFields[0].Descriptor()
+	// emailverificationtoken.DefaultID holds the default value on creation for the id field.
+	emailverificationtoken.DefaultID = emailverificationtokenDescID.Default.(func() uuid.UUID)
+	idempotencykeyMixin := schema.IdempotencyKey{}.Mixin()
+	idempotencykeyMixinFields0 := idempotencykeyMixin[0].Fields()
+	_ = idempotencykeyMixinFields0
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyMixinFields0[0].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescUpdatedAt is the schema descriptor for updated_at field.
+	idempotencykeyDescUpdatedAt := idempotencykeyMixinFields0[1].Descriptor()
+	// idempotencykey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	idempotencykey.DefaultUpdatedAt = idempotencykeyDescUpdatedAt.Default.(func() time.Time)
+	// idempotencykey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	idempotencykey.UpdateDefaultUpdatedAt = idempotencykeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// idempotencykeyDescScope is the schema descriptor for scope field.
+	idempotencykeyDescScope := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	idempotencykey.ScopeValidator = idempotencykeyDescScope.Validators[0].(func(string) error)
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = idempotencykeyDescKey.Validators[0].(func(string) error)
+	// idempotencykeyDescFingerprint is the schema descriptor for fingerprint field.
+	idempotencykeyDescFingerprint := idempotencykeyFields[3].Descriptor()
+	// idempotencykey.FingerprintValidator is a validator for the "fingerprint" field. It is called by the builders before save.
+	idempotencykey.FingerprintValidator = idempotencykeyDescFingerprint.Validators[0].(func(string) error)
+	// idempotencykeyDescStatusCode is the schema descriptor for status_code field.
+	idempotencykeyDescStatusCode := idempotencykeyFields[4].Descriptor()
+	// idempotencykey.DefaultStatusCode holds the default value on creation for the status_code field.
+	idempotencykey.DefaultStatusCode = idempotencykeyDescStatusCode.Default.(int)
+	// idempotencykeyDescEtag is the schema descriptor for etag field.
+	idempotencykeyDescEtag := idempotencykeyFields[6].Descriptor()
+	// idempotencykey.EtagValidator is a validator for the "etag" field. It is called by the builders before save.
+	idempotencykey.EtagValidator = idempotencykeyDescEtag.Validators[0].(func(string) error)
+	// idempotencykeyDescClaimToken is the schema descriptor for claim_token field.
+	idempotencykeyDescClaimToken := idempotencykeyFields[9].Descriptor()
+	// idempotencykey.DefaultClaimToken holds the default value on creation for the claim_token field.
+	idempotencykey.DefaultClaimToken = idempotencykeyDescClaimToken.Default.(func() uuid.UUID)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() uuid.UUID)
 	imageinfoMixin := schema.Imageinfo{}.Mixin()
 	imageinfoMixinFields0 := imageinfoMixin[0].Fields()
 	_ = imageinfoMixinFields0
@@ -50,6 +252,126 @@ func init() {
 	imageinfoDescImgS3IDKey := imageinfoFields[4].Descriptor()
 	// imageinfo.ImgS3IDKeyValidator is a validator for the "img_s3_id_key" field. It is called by the builders before save.
 	imageinfo.ImgS3IDKeyValidator = imageinfoDescImgS3IDKey.Validators[0].(func(string) error)
+	inventorymovementMixin := schema.InventoryMovement{}.Mixin()
+	inventorymovementMixinFields0 := inventorymovementMixin[0].Fields()
+	_ = inventorymovementMixinFields0
+	inventorymovementFields := schema.InventoryMovement{}.Fields()
+	_ = inventorymovementFields
+	// inventorymovementDescCreatedAt is the schema descriptor for created_at field.
+	inventorymovementDescCreatedAt := inventorymovementMixinFields0[0].Descriptor()
+	// inventorymovement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	inventorymovement.DefaultCreatedAt = inventorymovementDescCreatedAt.Default.(func() time.Time)
+	// inventorymovementDescUpdatedAt is the schema descriptor for updated_at field.
+	inventorymovementDescUpdatedAt := inventorymovementMixinFields0[1].Descriptor()
+	// inventorymovement.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	inventorymovement.DefaultUpdatedAt = inventorymovementDescUpdatedAt.Default.(func() time.Time)
+	// inventorymovement.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	inventorymovement.UpdateDefaultUpdatedAt = inventorymovementDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// inventorymovementDescReason is the schema descriptor for reason field.
+	inventorymovementDescReason := inventorymovementFields[3].Descriptor()
+	// inventorymovement.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	inventorymovement.ReasonValidator = inventorymovementDescReason.Validators[0].(func(string) error)
+	// inventorymovementDescReferenceID is the schema descriptor for reference_id field.
+	inventorymovementDescReferenceID := inventorymovementFields[6].Descriptor()
+	// inventorymovement.DefaultReferenceID holds the default value on creation for the reference_id field.
+	inventorymovement.DefaultReferenceID = inventorymovementDescReferenceID.Default.(string)
+	// inventorymovement.ReferenceIDValidator is a validator for the "reference_id" field. It is called by the builders before save.
+	inventorymovement.ReferenceIDValidator = inventorymovementDescReferenceID.Validators[0].(func(string) error)
+	// inventorymovementDescNote is the schema descriptor for note field.
+	inventorymovementDescNote := inventorymovementFields[8].Descriptor()
+	// inventorymovement.DefaultNote holds the default value on creation for the note field.
+	inventorymovement.DefaultNote = inventorymovementDescNote.Default.(string)
+	// inventorymovement.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	inventorymovement.NoteValidator = inventorymovementDescNote.Validators[0].(func(string) error)
+	// inventorymovementDescID is the schema descriptor for id field.
+	inventorymovementDescID := inventorymovementFields[0].Descriptor()
+	// inventorymovement.DefaultID holds the default value on creation for the id field.
+	inventorymovement.DefaultID = inventorymovementDescID.Default.(func() uuid.UUID)
+	loginlockouteventMixin := schema.LoginLockoutEvent{}.Mixin()
+	loginlockouteventMixinFields0 := loginlockouteventMixin[0].Fields()
+	_ = loginlockouteventMixinFields0
+	loginlockouteventFields := schema.LoginLockoutEvent{}.Fields()
+	_ = loginlockouteventFields
+	// loginlockouteventDescCreatedAt is the schema descriptor for created_at field.
+	loginlockouteventDescCreatedAt := loginlockouteventMixinFields0[0].Descriptor()
+	// loginlockoutevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginlockoutevent.DefaultCreatedAt = loginlockouteventDescCreatedAt.Default.(func() time.Time)
+	// loginlockouteventDescUpdatedAt is the schema descriptor for updated_at field.
+	loginlockouteventDescUpdatedAt := loginlockouteventMixinFields0[1].Descriptor()
+	// loginlockoutevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	loginlockoutevent.DefaultUpdatedAt = loginlockouteventDescUpdatedAt.Default.(func() time.Time)
+	// loginlockoutevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	loginlockoutevent.UpdateDefaultUpdatedAt = loginlockouteventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// loginlockouteventDescScope is the schema descriptor for scope field.
+	loginlockouteventDescScope := loginlockouteventFields[2].Descriptor()
+	// loginlockoutevent.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	loginlockoutevent.ScopeValidator = loginlockouteventDescScope.Validators[0].(func(string) error)
+	// loginlockouteventDescIP is the schema descriptor for ip field.
+	loginlockouteventDescIP := loginlockouteventFields[3].Descriptor()
+	// loginlockoutevent.DefaultIP holds the default value on creation for the ip field.
+	loginlockoutevent.DefaultIP = loginlockouteventDescIP.Default.(string)
+	// loginlockoutevent.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	loginlockoutevent.IPValidator = loginlockouteventDescIP.Validators[0].(func(string) error)
+	// loginlockouteventDescFailures is the schema descriptor for failures field.
+	loginlockouteventDescFailures := loginlockouteventFields[4].Descriptor()
+	// loginlockoutevent.FailuresValidator is a validator for the "failures" field. It is called by the builders before save.
+	loginlockoutevent.FailuresValidator = loginlockouteventDescFailures.Validators[0].(func(int) error)
+	// loginlockouteventDescID is the schema descriptor for id field.
+	loginlockouteventDescID := loginlockouteventFields[0].Descriptor()
+	// loginlockoutevent.DefaultID holds the default value on creation for the id field.
+	loginlockoutevent.DefaultID = loginlockouteventDescID.Default.(func() uuid.UUID)
+	loginthrottleMixin := schema.LoginThrottle{}.Mixin()
+	loginthrottleMixinFields0 := loginthrottleMixin[0].Fields()
+	_ = loginthrottleMixinFields0
+	loginthrottleFields := schema.LoginThrottle{}.Fields()
+	_ = loginthrottleFields
+	// loginthrottleDescCreatedAt is the schema descriptor for created_at field.
+	loginthrottleDescCreatedAt := loginthrottleMixinFields0[0].Descriptor()
+	// loginthrottle.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginthrottle.DefaultCreatedAt = loginthrottleDescCreatedAt.Default.(func() time.Time)
+	// loginthrottleDescUpdatedAt is the schema descriptor for updated_at field.
+	loginthrottleDescUpdatedAt := loginthrottleMixinFields0[1].Descriptor()
+	// loginthrottle.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	loginthrottle.DefaultUpdatedAt = loginthrottleDescUpdatedAt.Default.(func() time.Time)
+	// loginthrottle.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	loginthrottle.UpdateDefaultUpdatedAt = loginthrottleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// loginthrottleDescKey is the schema descriptor for key field.
+	loginthrottleDescKey := loginthrottleFields[1].Descriptor()
+	// loginthrottle.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	loginthrottle.KeyValidator = loginthrottleDescKey.Validators[0].(func(string) error)
+	// loginthrottleDescFailures is the schema descriptor for failures field.
+	loginthrottleDescFailures := loginthrottleFields[2].Descriptor()
+	// loginthrottle.DefaultFailures holds the default value on creation for the failures field.
+	loginthrottle.DefaultFailures = loginthrottleDescFailures.Default.(int)
+	// loginthrottle.FailuresValidator is a validator for the "failures" field. It is called by the builders before save.
+	loginthrottle.FailuresValidator = loginthrottleDescFailures.Validators[0].(func(int) error)
+	// loginthrottleDescID is the schema descriptor for id field.
+	loginthrottleDescID := loginthrottleFields[0].Descriptor()
+	// loginthrottle.DefaultID holds the default value on creation for the id field.
+	loginthrottle.DefaultID = loginthrottleDescID.Default.(func() uuid.UUID)
+	mfarecoverycodeMixin := schema.MfaRecoveryCode{}.Mixin()
+	mfarecoverycodeMixinFields0 := mfarecoverycodeMixin[0].Fields()
+	_ = mfarecoverycodeMixinFields0
+	mfarecoverycodeFields := schema.MfaRecoveryCode{}.Fields()
+	_ = mfarecoverycodeFields
+	// mfarecoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	mfarecoverycodeDescCreatedAt := mfarecoverycodeMixinFields0[0].Descriptor()
+	// mfarecoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mfarecoverycode.DefaultCreatedAt = mfarecoverycodeDescCreatedAt.Default.(func() time.Time)
+	// mfarecoverycodeDescUpdatedAt is the schema descriptor for updated_at field.
+	mfarecoverycodeDescUpdatedAt := mfarecoverycodeMixinFields0[1].Descriptor()
+	// mfarecoverycode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	mfarecoverycode.DefaultUpdatedAt = mfarecoverycodeDescUpdatedAt.Default.(func() time.Time)
+	// mfarecoverycode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	mfarecoverycode.UpdateDefaultUpdatedAt = mfarecoverycodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// mfarecoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	mfarecoverycodeDescCodeHash := mfarecoverycodeFields[2].Descriptor()
+	// mfarecoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	mfarecoverycode.CodeHashValidator = mfarecoverycodeDescCodeHash.Validators[0].(func(string) error)
+	// mfarecoverycodeDescID is the schema descriptor for id field.
+	mfarecoverycodeDescID := mfarecoverycodeFields[0].Descriptor()
+	// mfarecoverycode.DefaultID holds the default value on creation for the id field.
+	mfarecoverycode.DefaultID = mfarecoverycodeDescID.Default.(func() uuid.UUID)
 	orderMixin := schema.Order{}.Mixin()
 	orderMixinFields0 := orderMixin[0].Fields()
 	_ = orderMixinFields0
@@ -65,16 +387,22 @@ func init() {
 	order.DefaultUpdatedAt = orderDescUpdatedAt.Default.(func() time.Time)
 	// order.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	order.UpdateDefaultUpdatedAt = orderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// orderDescCurrency is the schema descriptor for currency field.
+	orderDescCurrency := orderFields[2].Descriptor()
+	// order.DefaultCurrency holds the default value on creation for the currency field.
+	order.DefaultCurrency = orderDescCurrency.Default.(string)
+	// order.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	order.CurrencyValidator = orderDescCurrency.Validators[0].(func(string) error)
 	// orderDescDiscount is the schema descriptor for discount field.
-	orderDescDiscount := orderFields[2].Descriptor()
+	orderDescDiscount := orderFields[3].Descriptor()
 	// order.DiscountValidator is a validator for the "discount" field. It is called by the builders before save.
-	order.DiscountValidator = func() func(float64) error {
+	order.DiscountValidator = func() func(int64) error {
 		validators := orderDescDiscount.Validators
-		fns := [...]func(float64) error{
-			validators[0].(func(float64) error),
-			validators[1].(func(float64) error),
+		fns := [...]func(int64) error{
+			validators[0].(func(int64) error),
+			validators[1].(func(int64) error),
 		}
-		return func(discount float64) error {
+		return func(discount int64) error {
 			for _, fn := range fns {
 				if err := fn(discount); err != nil {
 					return err
@@ -84,63 +412,161 @@ func init() {
 		}
 	}()
 	// orderDescTotalAmount is the schema descriptor for total_amount field.
-	orderDescTotalAmount := orderFields[3].Descriptor()
+	orderDescTotalAmount := orderFields[4].Descriptor()
 	// order.TotalAmountValidator is a validator for the "total_amount" field. It is called by the builders before save.
-	order.TotalAmountValidator = orderDescTotalAmount.Validators[0].(func(float64) error)
+	order.TotalAmountValidator = orderDescTotalAmount.Validators[0].(func(int64) error)
+	// orderDescSubtotal is the schema descriptor for subtotal field.
+	orderDescSubtotal := orderFields[5].Descriptor()
+	// order.DefaultSubtotal holds the default value on creation for the subtotal field.
+	order.DefaultSubtotal = money.Amount(orderDescSubtotal.Default.(int64))
+	// order.SubtotalValidator is a validator for the "subtotal" field. It is called by the builders before save.
+	order.SubtotalValidator = orderDescSubtotal.Validators[0].(func(int64) error)
+	// orderDescDiscountAmount is the schema descriptor for discount_amount field.
+	orderDescDiscountAmount := orderFields[6].Descriptor()
+	// order.DefaultDiscountAmount holds the default value on creation for the discount_amount field.
+	order.DefaultDiscountAmount = money.Amount(orderDescDiscountAmount.Default.(int64))
+	// order.DiscountAmountValidator is a validator for the "discount_amount" field. It is called by the builders before save.
+	order.DiscountAmountValidator = orderDescDiscountAmount.Validators[0].(func(int64) error)
+	// orderDescTaxRate is the schema descriptor for tax_rate field.
+	orderDescTaxRate := orderFields[7].Descriptor()
+	// order.DefaultTaxRate holds the default value on creation for the tax_rate field.
+	order.DefaultTaxRate = money.Rate(orderDescTaxRate.Default.(int64))
+	// order.TaxRateValidator is a validator for the "tax_rate" field. It is called by the builders before save.
+	order.TaxRateValidator = func() func(int64) error {
+		validators := orderDescTaxRate.Validators
+		fns := [...]func(int64) error{
+			validators[0].(func(int64) error),
+			validators[1].(func(int64) error),
+		}
+		return func(tax_rate int64) error {
+			for _, fn := range fns {
+				if err := fn(tax_rate); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// orderDescTaxAmount is the schema descriptor for tax_amount field.
+	orderDescTaxAmount := orderFields[8].Descriptor()
+	// order.DefaultTaxAmount holds the default value on creation for the tax_amount field.
+	order.DefaultTaxAmount = money.Amount(orderDescTaxAmount.Default.(int64))
+	// order.TaxAmountValidator is a validator for the "tax_amount" field. It is called by the builders before save.
+	order.TaxAmountValidator = orderDescTaxAmount.Validators[0].(func(int64) error)
+	// orderDescShippingFee is the schema descriptor for shipping_fee field.
+	orderDescShippingFee := orderFields[9].Descriptor()
+	// order.DefaultShippingFee holds the default value on creation for the shipping_fee field.
+	order.DefaultShippingFee = money.Amount(orderDescShippingFee.Default.(int64))
+	// order.ShippingFeeValidator is a validator for the "shipping_fee" field. It is called by the builders before save.
+	order.ShippingFeeValidator = orderDescShippingFee.Validators[0].(func(int64) error)
 	// orderDescRemark is the schema descriptor for remark field.
-	orderDescRemark := orderFields[4].Descriptor()
+	orderDescRemark := orderFields[10].Descriptor()
 	// order.RemarkValidator is a validator for the "remark" field. It is called by the builders before save.
 	order.RemarkValidator = orderDescRemark.Validators[0].(func(string) error)
 	// orderDescStatus is the schema descriptor for status field.
-	orderDescStatus := orderFields[5].Descriptor()
+	orderDescStatus := orderFields[11].Descriptor()
 	// order.StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	order.StatusValidator = orderDescStatus.Validators[0].(func(string) error)
 	// orderDescPaymentStatus is the schema descriptor for payment_status field.
-	orderDescPaymentStatus := orderFields[6].Descriptor()
+	orderDescPaymentStatus := orderFields[12].Descriptor()
 	// order.PaymentStatusValidator is a validator for the "payment_status" field. It is called by the builders before save.
 	order.PaymentStatusValidator = orderDescPaymentStatus.Validators[0].(func(string) error)
 	// orderDescPaymentMethod is the schema descriptor for payment_method field.
-	orderDescPaymentMethod := orderFields[7].Descriptor()
+	orderDescPaymentMethod := orderFields[13].Descriptor()
 	// order.PaymentMethodValidator is a validator for the "payment_method" field. It is called by the builders before save.
 	order.PaymentMethodValidator = orderDescPaymentMethod.Validators[0].(func(string) error)
 	// orderDescDeliveryStatus is the schema descriptor for delivery_status field.
-	orderDescDeliveryStatus := orderFields[8].Descriptor()
+	orderDescDeliveryStatus := orderFields[14].Descriptor()
 	// order.DeliveryStatusValidator is a validator for the "delivery_status" field. It is called by the builders before save.
 	order.DeliveryStatusValidator = orderDescDeliveryStatus.Validators[0].(func(string) error)
 	// orderDescShippingAddress is the schema descriptor for shipping_address field.
-	orderDescShippingAddress := orderFields[9].Descriptor()
+	orderDescShippingAddress := orderFields[15].Descriptor()
 	// order.ShippingAddressValidator is a validator for the "shipping_address" field. It is called by the builders before save.
 	order.ShippingAddressValidator = orderDescShippingAddress.Validators[0].(func(string) error)
 	// orderDescTrackingNumber is the schema descriptor for tracking_number field.
-	orderDescTrackingNumber := orderFields[10].Descriptor()
+	orderDescTrackingNumber := orderFields[16].Descriptor()
 	// order.TrackingNumberValidator is a validator for the "tracking_number" field. It is called by the builders before save.
 	order.TrackingNumberValidator = orderDescTrackingNumber.Validators[0].(func(string) error)
 	// orderDescIsArchived is the schema descriptor for is_archived field.
-	orderDescIsArchived := orderFields[11].Descriptor()
+	orderDescIsArchived := orderFields[17].Descriptor()
 	// order.DefaultIsArchived holds the default value on creation for the is_archived field.
 	order.DefaultIsArchived = orderDescIsArchived.Default.(bool)
+	// orderDescVersion is the schema descriptor for version field.
+	orderDescVersion := orderFields[19].Descriptor()
+	// order.DefaultVersion holds the default value on creation for the version field.
+	order.DefaultVersion = orderDescVersion.Default.(int64)
 	// orderDescID is the schema descriptor for id field.
 	orderDescID := orderFields[0].Descriptor()
 	// order.DefaultID holds the default value on creation for the id field.
 	order.DefaultID = orderDescID.Default.(func() uuid.UUID)
+	ordereventMixin := schema.OrderEvent{}.Mixin()
+	ordereventMixinFields0 := ordereventMixin[0].Fields()
+	_ = ordereventMixinFields0
+	ordereventFields := schema.OrderEvent{}.Fields()
+	_ = ordereventFields
+	// ordereventDescCreatedAt is the schema descriptor for created_at field.
+	ordereventDescCreatedAt := ordereventMixinFields0[0].Descriptor()
+	// orderevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	orderevent.DefaultCreatedAt = ordereventDescCreatedAt.Default.(func() time.Time)
+	// ordereventDescUpdatedAt is the schema descriptor for updated_at field.
+	ordereventDescUpdatedAt := ordereventMixinFields0[1].Descriptor()
+	// orderevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	orderevent.DefaultUpdatedAt = ordereventDescUpdatedAt.Default.(func() time.Time)
+	// orderevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	orderevent.UpdateDefaultUpdatedAt = ordereventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ordereventDescType is the schema descriptor for type field.
+	ordereventDescType := ordereventFields[2].Descriptor()
+	// orderevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	orderevent.TypeValidator = ordereventDescType.Validators[0].(func(string) error)
+	// ordereventDescActorType is the schema descriptor for actor_type field.
+	ordereventDescActorType := ordereventFields[3].Descriptor()
+	// orderevent.ActorTypeValidator is a validator for the "actor_type" field. It is called by the builders before save.
+	orderevent.ActorTypeValidator = ordereventDescActorType.Validators[0].(func(string) error)
+	// ordereventDescID is the schema descriptor for id field.
+	ordereventDescID := ordereventFields[0].Descriptor()
+	// orderevent.DefaultID holds the default value on creation for the id field.
+	orderevent.DefaultID = ordereventDescID.Default.(func() uuid.UUID)
 	orderitemFields := schema.OrderItem{}.Fields()
 	_ = orderitemFields
 	// orderitemDescPurchasedName is the schema descriptor for purchased_name field.
-	orderitemDescPurchasedName := orderitemFields[3].Descriptor()
+	orderitemDescPurchasedName := orderitemFields[4].Descriptor()
 	// orderitem.PurchasedNameValidator is a validator for the "purchased_name" field. It is called by the builders before save.
 	orderitem.PurchasedNameValidator = orderitemDescPurchasedName.Validators[0].(func(string) error)
 	// orderitemDescPurchasedPrice is the schema descriptor for purchased_price field.
-	orderitemDescPurchasedPrice := orderitemFields[4].Descriptor()
+	orderitemDescPurchasedPrice := orderitemFields[5].Descriptor()
 	// orderitem.PurchasedPriceValidator is a validator for the "purchased_price" field. It is called by the builders before save.
-	orderitem.PurchasedPriceValidator = orderitemDescPurchasedPrice.Validators[0].(func(float64) error)
+	orderitem.PurchasedPriceValidator = orderitemDescPurchasedPrice.Validators[0].(func(int64) error)
 	// orderitemDescQuantity is the schema descriptor for quantity field.
-	orderitemDescQuantity := orderitemFields[5].Descriptor()
+	orderitemDescQuantity := orderitemFields[6].Descriptor()
 	// orderitem.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
 	orderitem.QuantityValidator = orderitemDescQuantity.Validators[0].(func(int) error)
 	// orderitemDescID is the schema descriptor for id field.
 	orderitemDescID := orderitemFields[0].Descriptor()
 	// orderitem.DefaultID holds the default value on creation for the id field.
 	orderitem.DefaultID = orderitemDescID.Default.(func() uuid.UUID)
+	passwordresettokenMixin := schema.PasswordResetToken{}.Mixin()
+	passwordresettokenMixinFields0 := passwordresettokenMixin[0].Fields()
+	_ = passwordresettokenMixinFields0
+	passwordresettokenFields := schema.PasswordResetToken{}.Fields()
+	_ = passwordresettokenFields
+	// passwordresettokenDescCreatedAt is the schema descriptor for created_at field.
+	passwordresettokenDescCreatedAt := passwordresettokenMixinFields0[0].Descriptor()
+	// passwordresettoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordresettoken.DefaultCreatedAt = passwordresettokenDescCreatedAt.Default.(func() time.Time)
+	// passwordresettokenDescUpdatedAt is the schema descriptor for updated_at field.
+	passwordresettokenDescUpdatedAt := passwordresettokenMixinFields0[1].Descriptor()
+	// passwordresettoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	passwordresettoken.DefaultUpdatedAt = passwordresettokenDescUpdatedAt.Default.(func() time.Time)
+	// passwordresettoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	passwordresettoken.UpdateDefaultUpdatedAt = passwordresettokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// passwordresettokenDescTokenHash is the schema descriptor for token_hash field.
+	passwordresettokenDescTokenHash := passwordresettokenFields[2].Descriptor()
+	// passwordresettoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	passwordresettoken.TokenHashValidator = passwordresettokenDescTokenHash.Validators[0].(func(string) error)
+	// passwordresettokenDescID is the schema descriptor for id field.
+	passwordresettokenDescID := passwordresettokenFields[0].Descriptor()
+	// passwordresettoken.DefaultID holds the default value on creation for the id field.
+	passwordresettoken.DefaultID = passwordresettokenDescID.Default.(func() uuid.UUID)
 	productMixin := schema.Product{}.Mixin()
 	productMixinFields0 := productMixin[0].Fields()
 	_ = productMixinFields0
@@ -160,16 +586,20 @@ func init() {
 	productDescName := productFields[2].Descriptor()
 	// product.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	product.NameValidator = productDescName.Validators[0].(func(string) error)
+	// productDescSku is the schema descriptor for sku field.
+	productDescSku := productFields[3].Descriptor()
+	// product.SkuValidator is a validator for the "sku" field. It is called by the builders before save.
+	product.SkuValidator = productDescSku.Validators[0].(func(string) error)
 	// productDescPrice is the schema descriptor for price field.
-	productDescPrice := productFields[3].Descriptor()
+	productDescPrice := productFields[4].Descriptor()
 	// product.PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	product.PriceValidator = func() func(float64) error {
+	product.PriceValidator = func() func(int64) error {
 		validators := productDescPrice.Validators
-		fns := [...]func(float64) error{
-			validators[0].(func(float64) error),
-			validators[1].(func(float64) error),
+		fns := [...]func(int64) error{
+			validators[0].(func(int64) error),
+			validators[1].(func(int64) error),
 		}
-		return func(price float64) error {
+		return func(price int64) error {
 			for _, fn := range fns {
 				if err := fn(price); err != nil {
 					return err
@@ -178,8 +608,14 @@ func init() {
 			return nil
 		}
 	}()
+	// productDescCurrency is the schema descriptor for currency field.
+	productDescCurrency := productFields[5].Descriptor()
+	// product.DefaultCurrency holds the default value on creation for the currency field.
+	product.DefaultCurrency = productDescCurrency.Default.(string)
+	// product.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	product.CurrencyValidator = productDescCurrency.Validators[0].(func(string) error)
 	// productDescQuantity is the schema descriptor for quantity field.
-	productDescQuantity := productFields[4].Descriptor()
+	productDescQuantity := productFields[6].Descriptor()
 	// product.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
 	product.QuantityValidator = func() func(int32) error {
 		validators := productDescQuantity.Validators
@@ -197,27 +633,269 @@ func init() {
 		}
 	}()
 	// productDescDescription is the schema descriptor for description field.
-	productDescDescription := productFields[5].Descriptor()
+	productDescDescription := productFields[7].Descriptor()
 	// product.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	product.DescriptionValidator = productDescDescription.Validators[0].(func(string) error)
 	// productDescStatus is the schema descriptor for status field.
-	productDescStatus := productFields[6].Descriptor()
+	productDescStatus := productFields[8].Descriptor()
 	// product.StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	product.StatusValidator = productDescStatus.Validators[0].(func(string) error)
 	// productDescIsArchived is the schema descriptor for is_archived field.
-	productDescIsArchived := productFields[7].Descriptor()
+	productDescIsArchived := productFields[9].Descriptor()
 	// product.DefaultIsArchived holds the default value on creation for the is_archived field.
 	product.DefaultIsArchived = productDescIsArchived.Default.(bool)
 	// productDescImgURL is the schema descriptor for img_url field.
-	productDescImgURL := productFields[8].Descriptor()
+	productDescImgURL := productFields[10].Descriptor()
 	// product.DefaultImgURL holds the default value on creation for the img_url field.
 	product.DefaultImgURL = productDescImgURL.Default.(string)
 	// product.ImgURLValidator is a validator for the "img_url" field. It is called by the builders before save.
 	product.ImgURLValidator = productDescImgURL.Validators[0].(func(string) error)
+	// productDescOptions is the schema descriptor for options field.
+	productDescOptions := productFields[11].Descriptor()
+	// product.DefaultOptions holds the default value on creation for the options field.
+	product.DefaultOptions = productDescOptions.Default.([]schema.ProductOption)
+	// productDescVersion is the schema descriptor for version field.
+	productDescVersion := productFields[12].Descriptor()
+	// product.DefaultVersion holds the default value on creation for the version field.
+	product.DefaultVersion = productDescVersion.Default.(int64)
 	// productDescID is the schema descriptor for id field.
 	productDescID := productFields[0].Descriptor()
 	// product.DefaultID holds the default value on creation for the id field.
 	product.DefaultID = productDescID.Default.(func() uuid.UUID)
+	productmediaMixin := schema.ProductMedia{}.Mixin()
+	productmediaMixinFields0 := productmediaMixin[0].Fields()
+	_ = productmediaMixinFields0
+	productmediaFields := schema.ProductMedia{}.Fields()
+	_ = productmediaFields
+	// productmediaDescCreatedAt is the schema descriptor for created_at field.
+	productmediaDescCreatedAt := productmediaMixinFields0[0].Descriptor()
+	// productmedia.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productmedia.DefaultCreatedAt = productmediaDescCreatedAt.Default.(func() time.Time)
+	// productmediaDescUpdatedAt is the schema descriptor for updated_at field.
+	productmediaDescUpdatedAt := productmediaMixinFields0[1].Descriptor()
+	// productmedia.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	productmedia.DefaultUpdatedAt = productmediaDescUpdatedAt.Default.(func() time.Time)
+	// productmedia.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	productmedia.UpdateDefaultUpdatedAt = productmediaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// productmediaDescPosition is the schema descriptor for position field.
+	productmediaDescPosition := productmediaFields[3].Descriptor()
+	// productmedia.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	productmedia.PositionValidator = productmediaDescPosition.Validators[0].(func(int) error)
+	// productmediaDescAltText is the schema descriptor for alt_text field.
+	productmediaDescAltText := productmediaFields[4].Descriptor()
+	// productmedia.DefaultAltText holds the default value on creation for the alt_text field.
+	productmedia.DefaultAltText = productmediaDescAltText.Default.(string)
+	// productmedia.AltTextValidator is a validator for the "alt_text" field. It is called by the builders before save.
+	productmedia.AltTextValidator = productmediaDescAltText.Validators[0].(func(string) error)
+	// productmediaDescIsPrimary is the schema descriptor for is_primary field.
+	productmediaDescIsPrimary := productmediaFields[5].Descriptor()
+	// productmedia.DefaultIsPrimary holds the default value on creation for the is_primary field.
+	productmedia.DefaultIsPrimary = productmediaDescIsPrimary.Default.(bool)
+	// productmediaDescID is the schema descriptor for id field.
+	productmediaDescID := productmediaFields[0].Descriptor()
+	// productmedia.DefaultID holds the default value on creation for the id field.
+	productmedia.DefaultID = productmediaDescID.Default.(func() uuid.UUID)
+	productvariantMixin := schema.ProductVariant{}.Mixin()
+	productvariantMixinFields0 := productvariantMixin[0].Fields()
+	_ = productvariantMixinFields0
+	productvariantFields := schema.ProductVariant{}.Fields()
+	_ = productvariantFields
+	// productvariantDescCreatedAt is the schema descriptor for created_at field.
+	productvariantDescCreatedAt := productvariantMixinFields0[0].Descriptor()
+	// productvariant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productvariant.DefaultCreatedAt = productvariantDescCreatedAt.Default.(func() time.Time)
+	// productvariantDescUpdatedAt is the schema descriptor for updated_at field.
+	productvariantDescUpdatedAt := productvariantMixinFields0[1].Descriptor()
+	// productvariant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	productvariant.DefaultUpdatedAt = productvariantDescUpdatedAt.Default.(func() time.Time)
+	// productvariant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	productvariant.UpdateDefaultUpdatedAt = productvariantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// productvariantDescSku is the schema descriptor for sku field.
+	productvariantDescSku := productvariantFields[2].Descriptor()
+	// productvariant.SkuValidator is a validator for the "sku" field. It is called by the builders before save.
+	productvariant.SkuValidator = productvariantDescSku.Validators[0].(func(string) error)
+	// productvariantDescPrice is the schema descriptor for price field.
+	productvariantDescPrice := productvariantFields[4].Descriptor()
+	// productvariant.PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	productvariant.PriceValidator = func() func(int64) error {
+		validators := productvariantDescPrice.Validators
+		fns := [...]func(int64) error{
+			validators[0].(func(int64) error),
+			validators[1].(func(int64) error),
+		}
+		return func(price int64) error {
+			for _, fn := range fns {
+				if err := fn(price); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// productvariantDescQuantity is the schema descriptor for quantity field.
+	productvariantDescQuantity := productvariantFields[5].Descriptor()
+	// productvariant.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	productvariant.QuantityValidator = func() func(int32) error {
+		validators := productvariantDescQuantity.Validators
+		fns := [...]func(int32) error{
+			validators[0].(func(int32) error),
+			validators[1].(func(int32) error),
+		}
+		return func(quantity int32) error {
+			for _, fn := range fns {
+				if err := fn(quantity); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// productvariantDescImgURL is the schema descriptor for img_url field.
+	productvariantDescImgURL := productvariantFields[6].Descriptor()
+	// productvariant.DefaultImgURL holds the default value on creation for the img_url field.
+	productvariant.DefaultImgURL = productvariantDescImgURL.Default.(string)
+	// productvariant.ImgURLValidator is a validator for the "img_url" field. It is called by the builders before save.
+	productvariant.ImgURLValidator = productvariantDescImgURL.Validators[0].(func(string) error)
+	// productvariantDescIsArchived is the schema descriptor for is_archived field.
+	productvariantDescIsArchived := productvariantFields[7].Descriptor()
+	// productvariant.DefaultIsArchived holds the default value on creation for the is_archived field.
+	productvariant.DefaultIsArchived = productvariantDescIsArchived.Default.(bool)
+	// productvariantDescVersion is the schema descriptor for version field.
+	productvariantDescVersion := productvariantFields[8].Descriptor()
+	// productvariant.DefaultVersion holds the default value on creation for the version field.
+	productvariant.DefaultVersion = productvariantDescVersion.Default.(int64)
+	// productvariantDescID is the schema descriptor for id field.
+	productvariantDescID := productvariantFields[0].Descriptor()
+	// productvariant.DefaultID holds the default value on creation for the id field.
+	productvariant.DefaultID = productvariantDescID.Default.(func() uuid.UUID)
+	refreshtokenMixin := schema.RefreshToken{}.Mixin()
+	refreshtokenMixinFields0 := refreshtokenMixin[0].Fields()
+	_ = refreshtokenMixinFields0
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenMixinFields0[0].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	// refreshtokenDescUpdatedAt is the schema descriptor for updated_at field.
+	refreshtokenDescUpdatedAt := refreshtokenMixinFields0[1].Descriptor()
+	// refreshtoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	refreshtoken.DefaultUpdatedAt = refreshtokenDescUpdatedAt.Default.(func() time.Time)
+	// refreshtoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	refreshtoken.UpdateDefaultUpdatedAt = refreshtokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// refreshtokenDescRevoked is the schema descriptor for revoked field.
+	refreshtokenDescRevoked := refreshtokenFields[4].Descriptor()
+	// refreshtoken.DefaultRevoked holds the default value on creation for the revoked field.
+	refreshtoken.DefaultRevoked = refreshtokenDescRevoked.Default.(bool)
+	// refreshtokenDescRevokedReason is the schema descriptor for revoked_reason field.
+	refreshtokenDescRevokedReason := refreshtokenFields[6].Descriptor()
+	// refreshtoken.DefaultRevokedReason holds the default value on creation for the revoked_reason field.
+	refreshtoken.DefaultRevokedReason = refreshtokenDescRevokedReason.Default.(string)
+	// refreshtoken.RevokedReasonValidator is a validator for the "revoked_reason" field. It is called by the builders before save.
+	refreshtoken.RevokedReasonValidator = refreshtokenDescRevokedReason.Validators[0].(func(string) error)
+	shopMixin := schema.Shop{}.Mixin()
+	shopMixinFields0 := shopMixin[0].Fields()
+	_ = shopMixinFields0
+	shopFields := schema.Shop{}.Fields()
+	_ = shopFields
+	// shopDescCreatedAt is the schema descriptor for created_at field.
+	shopDescCreatedAt := shopMixinFields0[0].Descriptor()
+	// shop.DefaultCreatedAt holds the default value on creation for the created_at field.
+	shop.DefaultCreatedAt = shopDescCreatedAt.Default.(func() time.Time)
+	// shopDescUpdatedAt is the schema descriptor for updated_at field.
+	shopDescUpdatedAt := shopMixinFields0[1].Descriptor()
+	// shop.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	shop.DefaultUpdatedAt = shopDescUpdatedAt.Default.(func() time.Time)
+	// shop.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	shop.UpdateDefaultUpdatedAt = shopDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// shopDescName is the schema descriptor for name field.
+	shopDescName := shopFields[2].Descriptor()
+	// shop.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	shop.NameValidator = shopDescName.Validators[0].(func(string) error)
+	// shopDescTaxRate is the schema descriptor for tax_rate field.
+	shopDescTaxRate := shopFields[3].Descriptor()
+	// shop.DefaultTaxRate holds the default value on creation for the tax_rate field.
+	shop.DefaultTaxRate = money.Rate(shopDescTaxRate.Default.(int64))
+	// shop.TaxRateValidator is a validator for the "tax_rate" field. It is called by the builders before save.
+	shop.TaxRateValidator = func() func(int64) error {
+		validators := shopDescTaxRate.Validators
+		fns := [...]func(int64) error{
+			validators[0].(func(int64) error),
+			validators[1].(func(int64) error),
+		}
+		return func(tax_rate int64) error {
+			for _, fn := range fns {
+				if err := fn(tax_rate); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// shopDescShippingFee is the schema descriptor for shipping_fee field.
+	shopDescShippingFee := shopFields[4].Descriptor()
+	// shop.DefaultShippingFee holds the default value on creation for the shipping_fee field.
+	shop.DefaultShippingFee = money.Amount(shopDescShippingFee.Default.(int64))
+	// shop.ShippingFeeValidator is a validator for the "shipping_fee" field. It is called by the builders before save.
+	shop.ShippingFeeValidator = shopDescShippingFee.Validators[0].(func(int64) error)
+	// shopDescID is the schema descriptor for id field.
+	shopDescID := shopFields[0].Descriptor()
+	// shop.DefaultID holds the default value on creation for the id field.
+	shop.DefaultID = shopDescID.Default.(func() uuid.UUID)
+	shopinviteMixin := schema.ShopInvite{}.Mixin()
+	shopinviteMixinFields0 := shopinviteMixin[0].Fields()
+	_ = shopinviteMixinFields0
+	shopinviteFields := schema.ShopInvite{}.Fields()
+	_ = shopinviteFields
+	// shopinviteDescCreatedAt is the schema descriptor for created_at field.
+	shopinviteDescCreatedAt := shopinviteMixinFields0[0].Descriptor()
+	// shopinvite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	shopinvite.DefaultCreatedAt = shopinviteDescCreatedAt.Default.(func() time.Time)
+	// shopinviteDescUpdatedAt is the schema descriptor for updated_at field.
+	shopinviteDescUpdatedAt := shopinviteMixinFields0[1].Descriptor()
+	// shopinvite.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	shopinvite.DefaultUpdatedAt = shopinviteDescUpdatedAt.Default.(func() time.Time)
+	// shopinvite.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	shopinvite.UpdateDefaultUpdatedAt = shopinviteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// shopinviteDescEmail is the schema descriptor for email field.
+	shopinviteDescEmail := shopinviteFields[3].Descriptor()
+	// shopinvite.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	shopinvite.EmailValidator = shopinviteDescEmail.Validators[0].(func(string) error)
+	// shopinviteDescRole is the schema descriptor for role field.
+	shopinviteDescRole := shopinviteFields[4].Descriptor()
+	// shopinvite.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	shopinvite.RoleValidator = shopinviteDescRole.Validators[0].(func(string) error)
+	// shopinviteDescTokenHash is the schema descriptor for token_hash field.
+	shopinviteDescTokenHash := shopinviteFields[5].Descriptor()
+	// shopinvite.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	shopinvite.TokenHashValidator = shopinviteDescTokenHash.Validators[0].(func(string) error)
+	// shopinviteDescID is the schema descriptor for id field.
+	shopinviteDescID := shopinviteFields[0].Descriptor()
+	// shopinvite.DefaultID holds the default value on creation for the id field.
+	shopinvite.DefaultID = shopinviteDescID.Default.(func() uuid.UUID)
+	shopmemberMixin := schema.ShopMember{}.Mixin()
+	shopmemberMixinFields0 := shopmemberMixin[0].Fields()
+	_ = shopmemberMixinFields0
+	shopmemberFields := schema.ShopMember{}.Fields()
+	_ = shopmemberFields
+	// shopmemberDescCreatedAt is the schema descriptor for created_at field.
+	shopmemberDescCreatedAt := shopmemberMixinFields0[0].Descriptor()
+	// shopmember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	shopmember.DefaultCreatedAt = shopmemberDescCreatedAt.Default.(func() time.Time)
+	// shopmemberDescUpdatedAt is the schema descriptor for updated_at field.
+	shopmemberDescUpdatedAt := shopmemberMixinFields0[1].Descriptor()
+	// shopmember.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	shopmember.DefaultUpdatedAt = shopmemberDescUpdatedAt.Default.(func() time.Time)
+	// shopmember.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	shopmember.UpdateDefaultUpdatedAt = shopmemberDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// shopmemberDescRole is the schema descriptor for role field.
+	shopmemberDescRole := shopmemberFields[3].Descriptor()
+	// shopmember.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	shopmember.RoleValidator = shopmemberDescRole.Validators[0].(func(string) error)
+	// shopmemberDescID is the schema descriptor for id field.
+	shopmemberDescID := shopmemberFields[0].Descriptor()
+	// shopmember.DefaultID holds the default value on creation for the id field.
+	shopmember.DefaultID = shopmemberDescID.Default.(func() uuid.UUID)
 	siteuiMixin := schema.Siteui{}.Mixin()
 	siteuiMixinFields0 := siteuiMixin[0].Fields()
 	_ = siteuiMixinFields0
@@ -251,6 +929,10 @@ func init() {
 	siteuiDescHomepageTextColor := siteuiFields[5].Descriptor()
 	// siteui.HomepageTextColorValidator is a validator for the "homepageTextColor" field. It is called by the builders before save.
 	siteui.HomepageTextColorValidator = siteuiDescHomepageTextColor.Validators[0].(func(string) error)
+	// siteuiDescVersion is the schema descriptor for version field.
+	siteuiDescVersion := siteuiFields[6].Descriptor()
+	// siteui.DefaultVersion holds the default value on creation for the version field.
+	siteui.DefaultVersion = siteuiDescVersion.Default.(int64)
 	// siteuiDescID is the schema descriptor for id field.
 	siteuiDescID := siteuiFields[0].Descriptor()
 	// siteui.DefaultID holds the default value on creation for the id field.
@@ -286,8 +968,41 @@ func init() {
 	userDescIsArchived := userFields[4].Descriptor()
 	// user.DefaultIsArchived holds the default value on creation for the is_archived field.
 	user.DefaultIsArchived = userDescIsArchived.Default.(bool)
+	// userDescPlan is the schema descriptor for plan field.
+	userDescPlan := userFields[5].Descriptor()
+	// user.DefaultPlan holds the default value on creation for the plan field.
+	user.DefaultPlan = userDescPlan.Default.(string)
+	// user.PlanValidator is a validator for the "plan" field. It is called by the builders before save.
+	user.PlanValidator = userDescPlan.Validators[0].(func(string) error)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	usertotpMixin := schema.UserTotp{}.Mixin()
+	usertotpMixinFields0 := usertotpMixin[0].Fields()
+	_ = usertotpMixinFields0
+	usertotpFields := schema.UserTotp{}.Fields()
+	_ = usertotpFields
+	// usertotpDescCreatedAt is the schema descriptor for created_at field.
+	usertotpDescCreatedAt := usertotpMixinFields0[0].Descriptor()
+	// usertotp.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usertotp.DefaultCreatedAt = usertotpDescCreatedAt.Default.(func() time.Time)
+	// usertotpDescUpdatedAt is the schema descriptor for updated_at field.
+	usertotpDescUpdatedAt := usertotpMixinFields0[1].Descriptor()
+	// usertotp.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usertotp.DefaultUpdatedAt = usertotpDescUpdatedAt.Default.(func() time.Time)
+	// usertotp.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usertotp.UpdateDefaultUpdatedAt = usertotpDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// usertotpDescSecret is the schema descriptor for secret field.
+	usertotpDescSecret := usertotpFields[2].Descriptor()
+	// usertotp.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	usertotp.SecretValidator = usertotpDescSecret.Validators[0].(func(string) error)
+	// usertotpDescLastUsedStep is the schema descriptor for last_used_step field.
+	usertotpDescLastUsedStep := usertotpFields[4].Descriptor()
+	// usertotp.DefaultLastUsedStep holds the default value on creation for the last_used_step field.
+	usertotp.DefaultLastUsedStep = usertotpDescLastUsedStep.Default.(int64)
+	// usertotpDescID is the schema descriptor for id field.
+	usertotpDescID := usertotpFields[0].Descriptor()
+	// usertotp.DefaultID holds the default value on creation for the id field.
+	usertotp.DefaultID = usertotpDescID.Default.(func() uuid.UUID)
 }
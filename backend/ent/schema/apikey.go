@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ApiKey holds the schema definition for the ApiKey entity.
+// only sha256 of the key is stored, prefix is shown to identify the key,
+// shop_id is the active shop when the key was created.
+type ApiKey struct {
+	ent.Schema
+}
+
+// Indexes of the ApiKey.
+func (ApiKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+// Mixin of the ApiKey.
+func (ApiKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ApiKey.
+func (ApiKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("user_id", uuid.UUID{}).StructTag(`json:"userId"`),
+		field.UUID("shop_id", uuid.UUID{}).Optional().Nillable().StructTag(`json:"shopId"`),
+		field.String("name").MaxLen(64).StructTag(`json:"name"`),
+		field.String("prefix").Unique().MaxLen(16).StructTag(`json:"prefix"`),
+		field.String("key_hash").Unique().MaxLen(64).Sensitive(),
+		field.Strings("scopes").StructTag(`json:"scopes"`),
+		field.Time("expires_at").StructTag(`json:"expiresAt"`),
+		field.Time("last_used_at").Optional().Nillable().StructTag(`json:"lastUsedAt"`),
+		field.Time("revoked_at").Optional().Nillable().StructTag(`json:"revokedAt"`),
+	}
+}
+
+// Edges of the ApiKey.
+func (ApiKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("apikeys").
+			Unique().
+			Field("user_id").
+			Required(),
+	}
+}
+
+// Annotations of the ApiKey.
+func (ApiKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
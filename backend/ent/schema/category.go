@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Category holds the schema definition for the Category entity.
+// categories of a shop form a tree by parent, products can be assigned to many categories.
+type Category struct {
+	ent.Schema
+}
+
+// Indexes of the Category.
+func (Category) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "slug").Unique(),
+	}
+}
+
+// Mixin of the Category.
+func (Category) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Category.
+func (Category) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("user_id", uuid.UUID{}).StructTag(`json:"userId"`),
+		// parent_id: nil for top level category
+		field.UUID("parent_id", uuid.UUID{}).Optional().Nillable().StructTag(`json:"parentId"`),
+		field.String("name").MaxLen(64).StructTag(`json:"name"`),
+		// slug: url name of category, unique in shop
+		field.String("slug").MaxLen(64).StructTag(`json:"slug"`),
+		field.String("description").MaxLen(255).Default("").StructTag(`json:"description"`),
+		// position: display order among siblings, ascending
+		field.Int32("position").Default(0).StructTag(`json:"position"`),
+		// version: bumped on every write, exposed as ETag for optimistic concurrency
+		field.Int64("version").Default(1).StructTag(`json:"version"`),
+	}
+}
+
+// Edges of the Category.
+func (Category) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("children", Category.Type).
+			From("parent").
+			Unique().
+			Field("parent_id"),
+		edge.To("products", Product.Type),
+	}
+}
+
+// Annotations of the Category.
+func (Category) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
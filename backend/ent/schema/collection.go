@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Collection holds the schema definition for the Collection entity.
+// manual collection lists its products, rule collection contains every product of shop matching all rules.
+type Collection struct {
+	ent.Schema
+}
+
+// CollectionRule: condition on product field, eg price gte 10.5, price in major units as product json
+type CollectionRule struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// Indexes of the Collection.
+func (Collection) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "slug").Unique(),
+	}
+}
+
+// Mixin of the Collection.
+func (Collection) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Collection.
+func (Collection) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("user_id", uuid.UUID{}).StructTag(`json:"userId"`),
+		field.String("name").MaxLen(64).StructTag(`json:"name"`),
+		// slug: url name of collection, unique in shop
+		field.String("slug").MaxLen(64).StructTag(`json:"slug"`),
+		field.String("description").MaxLen(255).Default("").StructTag(`json:"description"`),
+		// kind: constants.CollectionType, manual or rule
+		field.String("kind").MaxLen(16).StructTag(`json:"kind"`),
+		// rules: used by rule collection only, empty for manual
+		field.JSON("rules", []CollectionRule{}).Default([]CollectionRule{}).StructTag(`json:"rules"`),
+		// version: bumped on every write, exposed as ETag for optimistic concurrency
+		field.Int64("version").Default(1).StructTag(`json:"version"`),
+	}
+}
+
+// Edges of the Collection.
+func (Collection) Edges() []ent.Edge {
+	return []ent.Edge{
+		// products: members of manual collection
+		edge.To("products", Product.Type),
+	}
+}
+
+// Annotations of the Collection.
+func (Collection) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EmailVerificationToken holds the schema definition for the EmailVerificationToken entity.
+// only sha256 of the token sent by mail is stored, used_at is set once consumed.
+type EmailVerificationToken struct {
+	ent.Schema
+}
+
+// Indexes of the EmailVerificationToken.
+func (EmailVerificationToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+// Mixin of the EmailVerificationToken.
+func (EmailVerificationToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the EmailVerificationToken.
+func (EmailVerificationToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("user_id", uuid.UUID{}).StructTag(`json:"userId"`),
+		field.String("token_hash").Unique().MaxLen(64).Sensitive(),
+		field.Time("expires_at").StructTag(`json:"expiresAt"`),
+		field.Time("used_at").Optional().Nillable().StructTag(`json:"usedAt"`),
+	}
+}
+
+// Edges of the EmailVerificationToken.
+func (EmailVerificationToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("emailverificationtokens").
+			Unique().
+			Field("user_id").
+			Required(),
+	}
+}
+
+// Annotations of the EmailVerificationToken.
+func (EmailVerificationToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
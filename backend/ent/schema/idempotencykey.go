@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+// key is unique within scope (method, path and caller), fingerprint is sha256 of request body,
+// status_code is 0 while the first request is in progress, etag of response replayed with body,
+// lease_expires_at bounds an in progress claim so a key left by a crashed request can be claimed again,
+// claim_token is renewed on every claim so a request outliving its lease cannot settle the claim of its retry.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scope", "key").Unique(),
+		index.Fields("expires_at"),
+	}
+}
+
+// Mixin of the IdempotencyKey.
+func (IdempotencyKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.String("scope").MaxLen(512).StructTag(`json:"scope"`),
+		field.String("key").MaxLen(255).StructTag(`json:"key"`),
+		field.String("fingerprint").MaxLen(64).StructTag(`json:"fingerprint"`),
+		field.Int("status_code").Default(0).StructTag(`json:"statusCode"`),
+		field.Bytes("response_body").Optional().StructTag(`json:"-"`),
+		field.String("etag").MaxLen(128).Optional().StructTag(`json:"-"`),
+		field.Time("expires_at").StructTag(`json:"expiresAt"`),
+		field.Time("lease_expires_at").Optional().Nillable().StructTag(`json:"leaseExpiresAt"`),
+		field.UUID("claim_token", uuid.UUID{}).Default(uuid.New).Optional().StructTag(`json:"-"`),
+	}
+}
@@ -47,6 +47,7 @@ func (Imageinfo) Edges() []ent.Edge {
 			Unique().
 			Field("user_id").
 			Required(),
+		edge.To("productmedia", ProductMedia.Type),
 	}
 }
 
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// InventoryMovement holds the schema definition for the InventoryMovement entity.
+// append only stock ledger of product, sum of delta reconciles product quantity,
+// or variant quantity for movements of a variant.
+type InventoryMovement struct {
+	ent.Schema
+}
+
+// Indexes of the InventoryMovement.
+func (InventoryMovement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "created_at"),
+	}
+}
+
+// Mixin of the InventoryMovement.
+func (InventoryMovement) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the InventoryMovement.
+func (InventoryMovement) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("product_id", uuid.UUID{}).Immutable().StructTag(`json:"productId"`),
+		// variant_id: movement of variant stock, nil for product stock
+		field.UUID("variant_id", uuid.UUID{}).Optional().Nillable().Immutable().StructTag(`json:"variantId"`),
+		field.String("reason").MaxLen(32).Immutable().StructTag(`json:"reason"`),
+		field.Int32("delta").Immutable().StructTag(`json:"delta"`),
+		field.Int32("quantity_after").Immutable().StructTag(`json:"quantityAfter"`),
+		// reference_id: source of movement, eg order id, empty for manual adjustment
+		field.String("reference_id").MaxLen(64).Default("").Immutable().StructTag(`json:"referenceId"`),
+		field.UUID("actor_id", uuid.UUID{}).Optional().Nillable().Immutable().StructTag(`json:"actorId"`),
+		field.String("note").MaxLen(255).Default("").Immutable().StructTag(`json:"note"`),
+	}
+}
+
+// Edges of the InventoryMovement.
+func (InventoryMovement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", Product.Type).
+			Ref("movements").
+			Unique().
+			Field("product_id").
+			Immutable().
+			Required(),
+	}
+}
+
+// Annotations of the InventoryMovement.
+func (InventoryMovement) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LoginLockoutEvent holds the schema definition for the LoginLockoutEvent entity.
+// recorded when an account, or an ip attempting the account, gets locked out.
+type LoginLockoutEvent struct {
+	ent.Schema
+}
+
+// Indexes of the LoginLockoutEvent.
+func (LoginLockoutEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
+
+// Mixin of the LoginLockoutEvent.
+func (LoginLockoutEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the LoginLockoutEvent.
+func (LoginLockoutEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("user_id", uuid.UUID{}).StructTag(`json:"userId"`),
+		field.String("scope").MaxLen(16).StructTag(`json:"scope"`),
+		field.String("ip").MaxLen(64).Default("").StructTag(`json:"ip"`),
+		field.Int("failures").NonNegative().StructTag(`json:"failures"`),
+		field.Time("locked_until").StructTag(`json:"lockedUntil"`),
+	}
+}
+
+// Edges of the LoginLockoutEvent.
+func (LoginLockoutEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("loginlockoutevents").
+			Unique().
+			Field("user_id").
+			Required(),
+	}
+}
+
+// Annotations of the LoginLockoutEvent.
+func (LoginLockoutEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LoginThrottle holds the schema definition for the LoginThrottle entity.
+// one row per throttled key, e.g. account email or client ip, shared by all replicas.
+type LoginThrottle struct {
+	ent.Schema
+}
+
+// Mixin of the LoginThrottle.
+func (LoginThrottle) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the LoginThrottle.
+func (LoginThrottle) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.String("key").Unique().MaxLen(320).StructTag(`json:"key"`),
+		field.Int("failures").Default(0).NonNegative().StructTag(`json:"failures"`),
+		field.Time("last_failed_at").StructTag(`json:"lastFailedAt"`),
+		field.Time("locked_until").Optional().Nillable().StructTag(`json:"lockedUntil"`),
+	}
+}
+
+// Edges of the LoginThrottle.
+func (LoginThrottle) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MfaRecoveryCode holds the schema definition for the MfaRecoveryCode entity.
+// only sha256 of the code shown once to user is stored, used_at is set once consumed.
+type MfaRecoveryCode struct {
+	ent.Schema
+}
+
+// Indexes of the MfaRecoveryCode.
+func (MfaRecoveryCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "code_hash").Unique(),
+	}
+}
+
+// Mixin of the MfaRecoveryCode.
+func (MfaRecoveryCode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the MfaRecoveryCode.
+func (MfaRecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("user_id", uuid.UUID{}).StructTag(`json:"userId"`),
+		field.String("code_hash").MaxLen(64).Sensitive(),
+		field.Time("used_at").Optional().Nillable().StructTag(`json:"usedAt"`),
+	}
+}
+
+// Edges of the MfaRecoveryCode.
+func (MfaRecoveryCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("mfarecoverycodes").
+			Unique().
+			Field("user_id").
+			Required(),
+	}
+}
+
+// Annotations of the MfaRecoveryCode.
+func (MfaRecoveryCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
@@ -1,18 +1,30 @@
 package schema
 
 import (
+	"sthl/constants"
+	"sthl/money"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
 // Order holds the schema definition for the Order entity.
+// amounts are computed by server, total_amount = subtotal - discount_amount + tax_amount + shipping_fee.
 type Order struct {
 	ent.Schema
 }
 
+// Indexes of the Order.
+func (Order) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("reserved_until"),
+	}
+}
+
 // Mixin of the Order.
 func (Order) Mixin() []ent.Mixin {
 	return []ent.Mixin{
@@ -25,8 +37,15 @@ func (Order) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
 		field.UUID("user_id", uuid.UUID{}).StructTag(`json:"userId"`),
-		field.Float("discount").Min(0.0).Max(1.0).StructTag(`json:"discount"`),
-		field.Float("total_amount").Min(0.0).StructTag(`json:"totalAmount"`),
+		// amounts in minor units of currency, rates in parts per million
+		field.String("currency").MaxLen(3).Default(constants.DefaultCurrency).StructTag(`json:"currency"`),
+		field.Int64("discount").GoType(money.Rate(0)).Min(0).Max(money.RateScale).StructTag(`json:"discount"`),
+		field.Int64("total_amount").GoType(money.Amount(0)).Min(0).StructTag(`json:"totalAmount"`),
+		field.Int64("subtotal").GoType(money.Amount(0)).Min(0).Default(0).StructTag(`json:"-"`),
+		field.Int64("discount_amount").GoType(money.Amount(0)).Min(0).Default(0).StructTag(`json:"-"`),
+		field.Int64("tax_rate").GoType(money.Rate(0)).Min(0).Max(money.RateScale).Default(0).StructTag(`json:"-"`),
+		field.Int64("tax_amount").GoType(money.Amount(0)).Min(0).Default(0).StructTag(`json:"-"`),
+		field.Int64("shipping_fee").GoType(money.Amount(0)).Min(0).Default(0).StructTag(`json:"-"`),
 		field.String("remark").MaxLen(255).StructTag(`json:"remark"`),
 		field.String("status").MaxLen(255).StructTag(`json:"status"`),
 		field.String("payment_status").MaxLen(255).StructTag(`json:"paymentStatus"`),
@@ -35,6 +54,10 @@ func (Order) Fields() []ent.Field {
 		field.String("shipping_address").MaxLen(512).StructTag(`json:"shippingAddress"`),
 		field.String("tracking_number").MaxLen(255).StructTag(`json:"trackingNumber"`),
 		field.Bool("is_archived").Default(false).StructTag(`json:"isArchived"`),
+		// reserved_until: stock held for pending payment until, nil once committed by payment or released
+		field.Time("reserved_until").Optional().Nillable().StructTag(`json:"reservedUntil"`),
+		// version: bumped on every write, exposed as ETag for optimistic concurrency
+		field.Int64("version").Default(1).StructTag(`json:"version"`),
 	}
 }
 
@@ -47,6 +70,7 @@ func (Order) Edges() []ent.Edge {
 			Field("user_id").
 			Required(),
 		edge.To("orderitems", OrderItem.Type),
+		edge.To("events", OrderEvent.Type),
 	}
 }
 
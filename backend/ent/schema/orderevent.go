@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OrderEvent holds the schema definition for the OrderEvent entity.
+// append only timeline of order changes, actor_id is empty for storefront customer.
+type OrderEvent struct {
+	ent.Schema
+}
+
+// Indexes of the OrderEvent.
+func (OrderEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id", "created_at"),
+	}
+}
+
+// Mixin of the OrderEvent.
+func (OrderEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the OrderEvent.
+func (OrderEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("order_id", uuid.UUID{}).Immutable().StructTag(`json:"orderId"`),
+		field.String("type").MaxLen(64).Immutable().StructTag(`json:"type"`),
+		field.String("actor_type").MaxLen(32).Immutable().StructTag(`json:"actorType"`),
+		field.UUID("actor_id", uuid.UUID{}).Optional().Nillable().Immutable().StructTag(`json:"actorId"`),
+		field.JSON("before", map[string]any{}).Optional().Immutable().StructTag(`json:"before"`),
+		field.JSON("after", map[string]any{}).Optional().Immutable().StructTag(`json:"after"`),
+	}
+}
+
+// Edges of the OrderEvent.
+func (OrderEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", Order.Type).
+			Ref("events").
+			Unique().
+			Field("order_id").
+			Immutable().
+			Required(),
+	}
+}
+
+// Annotations of the OrderEvent.
+func (OrderEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
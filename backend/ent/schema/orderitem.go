@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"sthl/money"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
@@ -19,8 +21,11 @@ func (OrderItem) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
 		field.UUID("order_id", uuid.UUID{}).StructTag(`json:"orderId"`),
 		field.UUID("product_id", uuid.UUID{}).StructTag(`json:"productId"`),
+		// variant_id: set when product sold by variant
+		field.UUID("variant_id", uuid.UUID{}).Optional().Nillable().StructTag(`json:"variantId"`),
 		field.String("purchased_name").MaxLen(255).StructTag(`json:"purchasedName"`),
-		field.Float("purchased_price").Min(0.0).StructTag(`json:"purchasedPrice"`),
+		// purchased price in minor units of order currency
+		field.Int64("purchased_price").GoType(money.Amount(0)).Min(0).StructTag(`json:"purchasedPrice"`),
 		field.Int("quantity").Min(1).StructTag(`json:"quantity"`),
 	}
 }
@@ -1,18 +1,37 @@
 package schema
 
 import (
+	"sthl/constants"
+	"sthl/money"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
 // Product holds the schema definition for the Product entity.
+// product with variants keeps stock on its variants, quantity is used by products without variant only.
 type Product struct {
 	ent.Schema
 }
 
+// ProductOption: option definition of product, eg Size with values S, M, L
+type ProductOption struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// Indexes of the Product.
+func (Product) Indexes() []ent.Index {
+	return []ent.Index{
+		// null sku not unique
+		index.Fields("user_id", "sku").Unique(),
+	}
+}
+
 // Mixin of the Product.
 func (Product) Mixin() []ent.Mixin {
 	return []ent.Mixin{
@@ -26,12 +45,21 @@ func (Product) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
 		field.UUID("user_id", uuid.UUID{}).StructTag(`json:"userId"`),
 		field.String("name").MaxLen(255).StructTag(`json:"name"`),
-		field.Float("price").Min(1).Max(10000000.0).StructTag(`json:"price"`),
+		// optional, unique in shop, key of csv import upsert
+		field.String("sku").MaxLen(64).Optional().Nillable().StructTag(`json:"sku"`),
+		// price in minor units of currency
+		field.Int64("price").GoType(money.Amount(0)).Min(100).Max(1000000000).StructTag(`json:"price"`),
+		field.String("currency").MaxLen(3).Default(constants.DefaultCurrency).StructTag(`json:"currency"`),
 		field.Int32("quantity").Min(0).Max(10000000).StructTag(`json:"quantity"`),
 		field.String("description").MaxLen(255).StructTag(`json:"description"`),
 		field.String("status").MaxLen(255).StructTag(`json:"status"`),
 		field.Bool("is_archived").Default(false).StructTag(`json:"isArchived"`),
+		// img_url: cover image, url of primary image once gallery set
 		field.String("img_url").MaxLen(512).Default("").StructTag(`json:"imgUrl"`),
+		// options: in display order, values of variants must come from here
+		field.JSON("options", []ProductOption{}).Default([]ProductOption{}).StructTag(`json:"options"`),
+		// version: bumped on every write, exposed as ETag for optimistic concurrency
+		field.Int64("version").Default(1).StructTag(`json:"version"`),
 	}
 }
 
@@ -43,6 +71,13 @@ func (Product) Edges() []ent.Edge {
 			Unique().
 			Field("user_id").
 			Required(),
+		edge.To("movements", InventoryMovement.Type),
+		edge.To("variants", ProductVariant.Type),
+		edge.To("media", ProductMedia.Type),
+		edge.From("categories", Category.Type).
+			Ref("products"),
+		edge.From("collections", Collection.Type).
+			Ref("products"),
 	}
 }
 
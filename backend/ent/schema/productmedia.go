@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ProductMedia holds the schema definition for the ProductMedia entity.
+// album image in gallery of product, ordered by position with one primary per product,
+// image in a gallery can not be deleted from album.
+type ProductMedia struct {
+	ent.Schema
+}
+
+// Indexes of the ProductMedia.
+func (ProductMedia) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "imageinfo_id").Unique(),
+	}
+}
+
+// Mixin of the ProductMedia.
+func (ProductMedia) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ProductMedia.
+func (ProductMedia) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("product_id", uuid.UUID{}).Immutable().StructTag(`json:"productId"`),
+		field.Int("imageinfo_id").Immutable().StructTag(`json:"imgInfoId"`),
+		// position: display order in gallery from 0
+		field.Int("position").NonNegative().StructTag(`json:"position"`),
+		field.String("alt_text").MaxLen(255).Default("").StructTag(`json:"altText"`),
+		field.Bool("is_primary").Default(false).StructTag(`json:"isPrimary"`),
+	}
+}
+
+// Edges of the ProductMedia.
+func (ProductMedia) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("media").
+			Unique().
+			Field("product_id").
+			Immutable().
+			Required(),
+		edge.From("image", Imageinfo.Type).
+			Ref("productmedia").
+			Unique().
+			Field("imageinfo_id").
+			Immutable().
+			Required(),
+	}
+}
+
+// Annotations of the ProductMedia.
+func (ProductMedia) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"sthl/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ProductVariant holds the schema definition for the ProductVariant entity.
+// one combination of product options with its own sku, price and stock.
+type ProductVariant struct {
+	ent.Schema
+}
+
+// Indexes of the ProductVariant.
+func (ProductVariant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "sku").Unique(),
+	}
+}
+
+// Mixin of the ProductVariant.
+func (ProductVariant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ProductVariant.
+func (ProductVariant) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("product_id", uuid.UUID{}).Immutable().StructTag(`json:"productId"`),
+		field.String("sku").MaxLen(64).StructTag(`json:"sku"`),
+		// options: option name to value, one value for every option of product
+		field.JSON("options", map[string]string{}).StructTag(`json:"options"`),
+		// price: override in minor units of product currency, nil for product price
+		field.Int64("price").GoType(money.Amount(0)).Min(100).Max(1000000000).Optional().Nillable().StructTag(`json:"price"`),
+		field.Int32("quantity").Min(0).Max(10000000).StructTag(`json:"quantity"`),
+		field.String("img_url").MaxLen(512).Default("").StructTag(`json:"imgUrl"`),
+		field.Bool("is_archived").Default(false).StructTag(`json:"isArchived"`),
+		// version: bumped on every write, exposed as ETag for optimistic concurrency
+		field.Int64("version").Default(1).StructTag(`json:"version"`),
+	}
+}
+
+// Edges of the ProductVariant.
+func (ProductVariant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", Product.Type).
+			Ref("variants").
+			Unique().
+			Field("product_id").
+			Immutable().
+			Required(),
+	}
+}
+
+// Annotations of the ProductVariant.
+func (ProductVariant) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
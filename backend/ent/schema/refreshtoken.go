@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RefreshToken holds the schema definition for the RefreshToken entity.
+// id is the jti of the refresh token, tokens rotated from the same login share family_id.
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Indexes of the RefreshToken.
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("family_id"),
+		index.Fields("user_id"),
+	}
+}
+
+// Mixin of the RefreshToken.
+func (RefreshToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the RefreshToken.
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).StructTag(`json:"id"`),
+		field.UUID("user_id", uuid.UUID{}).StructTag(`json:"userId"`),
+		field.UUID("family_id", uuid.UUID{}).StructTag(`json:"familyId"`),
+		field.Time("expires_at").StructTag(`json:"expiresAt"`),
+		field.Bool("revoked").Default(false).StructTag(`json:"revoked"`),
+		field.Time("revoked_at").Optional().Nillable().StructTag(`json:"revokedAt"`),
+		field.String("revoked_reason").MaxLen(64).Default("").StructTag(`json:"revokedReason"`),
+		field.UUID("replaced_by", uuid.UUID{}).Optional().Nillable().StructTag(`json:"replacedBy"`),
+	}
+}
+
+// Edges of the RefreshToken.
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("refreshtokens").
+			Unique().
+			Field("user_id").
+			Required(),
+	}
+}
+
+// Annotations of the RefreshToken.
+func (RefreshToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"sthl/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Shop holds the schema definition for the Shop entity.
+// products, orders, siteui and album stay keyed by the owner user_id,
+// staff reach them through ShopMember.
+type Shop struct {
+	ent.Schema
+}
+
+// Mixin of the Shop.
+func (Shop) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Shop.
+func (Shop) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("user_id", uuid.UUID{}).Unique().StructTag(`json:"userId"`),
+		field.String("name").MaxLen(255).StructTag(`json:"name"`),
+		field.Int64("tax_rate").GoType(money.Rate(0)).Min(0).Max(money.RateScale).Default(0).StructTag(`json:"taxRate"`),
+		field.Int64("shipping_fee").GoType(money.Amount(0)).Min(0).Default(0).StructTag(`json:"shippingFee"`),
+	}
+}
+
+// Edges of the Shop.
+func (Shop) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("shop").
+			Unique().
+			Field("user_id").
+			Required(),
+		edge.To("members", ShopMember.Type),
+		edge.To("invites", ShopInvite.Type),
+	}
+}
+
+// Annotations of the Shop.
+func (Shop) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ShopInvite holds the schema definition for the ShopInvite entity.
+// only sha256 of the token sent by mail is stored, accepted_at is set once accepted.
+type ShopInvite struct {
+	ent.Schema
+}
+
+// Indexes of the ShopInvite.
+func (ShopInvite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("shop_id"),
+	}
+}
+
+// Mixin of the ShopInvite.
+func (ShopInvite) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ShopInvite.
+func (ShopInvite) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("shop_id", uuid.UUID{}).StructTag(`json:"shopId"`),
+		field.UUID("invited_by", uuid.UUID{}).StructTag(`json:"invitedBy"`),
+		field.String("email").MaxLen(255).StructTag(`json:"email"`),
+		field.String("role").MaxLen(16).StructTag(`json:"role"`),
+		field.String("token_hash").Unique().MaxLen(64).Sensitive(),
+		field.Time("expires_at").StructTag(`json:"expiresAt"`),
+		field.Time("accepted_at").Optional().Nillable().StructTag(`json:"acceptedAt"`),
+	}
+}
+
+// Edges of the ShopInvite.
+func (ShopInvite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("shop", Shop.Type).
+			Ref("invites").
+			Unique().
+			Field("shop_id").
+			Required(),
+	}
+}
+
+// Annotations of the ShopInvite.
+func (ShopInvite) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
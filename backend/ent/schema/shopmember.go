@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ShopMember holds the schema definition for the ShopMember entity.
+// role is one of constants.ShopRole, the owner also has a member row.
+type ShopMember struct {
+	ent.Schema
+}
+
+// Indexes of the ShopMember.
+func (ShopMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("shop_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
+
+// Mixin of the ShopMember.
+func (ShopMember) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ShopMember.
+func (ShopMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("shop_id", uuid.UUID{}).StructTag(`json:"shopId"`),
+		field.UUID("user_id", uuid.UUID{}).StructTag(`json:"userId"`),
+		field.String("role").MaxLen(16).StructTag(`json:"role"`),
+	}
+}
+
+// Edges of the ShopMember.
+func (ShopMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("shop", Shop.Type).
+			Ref("members").
+			Unique().
+			Field("shop_id").
+			Required(),
+		edge.From("user", User.Type).
+			Ref("shopmemberships").
+			Unique().
+			Field("user_id").
+			Required(),
+	}
+}
+
+// Annotations of the ShopMember.
+func (ShopMember) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
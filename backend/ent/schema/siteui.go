@@ -37,6 +37,8 @@ func (Siteui) Fields() []ent.Field {
 		field.String("homepageImgUrl").MaxLen(512).Default("").StructTag(`json:"homepageImgUrl"`),
 		field.String("homepageText").MaxLen(255).StructTag(`json:"homepageText"`),
 		field.String("homepageTextColor").MaxLen(16).StructTag(`json:"homepageTextColor"`),
+		// version: bumped on every write, exposed as ETag for optimistic concurrency
+		field.Int64("version").Default(1).StructTag(`json:"version"`),
 	}
 }
 
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"sthl/constants"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
@@ -36,6 +38,8 @@ func (User) Fields() []ent.Field {
 		field.String("hashed_pw").MaxLen(255).Sensitive(),
 		field.Bool("email_verified").Default(false).StructTag(`json:"emailVerified"`),
 		field.Bool("is_archived").Default(false).StructTag(`json:"isArchived"`),
+		// plan: constants.Plan, limits of products, album and orders
+		field.String("plan").MaxLen(16).Default(constants.Plan.Free).StructTag(`json:"plan"`),
 	}
 }
 
@@ -46,6 +50,15 @@ func (User) Edges() []ent.Edge {
 		edge.To("orders", Order.Type),
 		edge.To("siteui", Siteui.Type).Unique(),
 		edge.To("imagesinfo", Imageinfo.Type),
+		edge.To("refreshtokens", RefreshToken.Type),
+		edge.To("emailverificationtokens", EmailVerificationToken.Type),
+		edge.To("passwordresettokens", PasswordResetToken.Type),
+		edge.To("loginlockoutevents", LoginLockoutEvent.Type),
+		edge.To("shop", Shop.Type).Unique(),
+		edge.To("shopmemberships", ShopMember.Type),
+		edge.To("apikeys", ApiKey.Type),
+		edge.To("totp", UserTotp.Type).Unique(),
+		edge.To("mfarecoverycodes", MfaRecoveryCode.Type),
 	}
 }
 
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserTotp holds the schema definition for the UserTotp entity.
+// secret is pending until confirmed_at is set by a valid code,
+// last_used_step blocks replay of a code within its window.
+type UserTotp struct {
+	ent.Schema
+}
+
+// Mixin of the UserTotp.
+func (UserTotp) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the UserTotp.
+func (UserTotp) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).StructTag(`json:"id"`),
+		field.UUID("user_id", uuid.UUID{}).Unique().StructTag(`json:"userId"`),
+		field.String("secret").MaxLen(64).Sensitive(),
+		field.Time("confirmed_at").Optional().Nillable().StructTag(`json:"confirmedAt"`),
+		field.Int64("last_used_step").Default(0).StructTag(`json:"-"`),
+	}
+}
+
+// Edges of the UserTotp.
+func (UserTotp) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("totp").
+			Unique().
+			Field("user_id").
+			Required(),
+	}
+}
+
+// Annotations of the UserTotp.
+func (UserTotp) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// not marshal edges
+		edge.Annotation{
+			StructTag: `json:"-"`,
+		},
+	}
+}
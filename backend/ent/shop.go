@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sthl/ent/shop"
+	"sthl/ent/user"
+	"sthl/money"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Shop is the model entity for the Shop schema.
+type Shop struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"userId"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// TaxRate holds the value of the "tax_rate" field.
+	TaxRate money.Rate `json:"taxRate"`
+	// ShippingFee holds the value of the "shipping_fee" field.
+	ShippingFee money.Amount `json:"shippingFee"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShopQuery when eager-loading is set.
+	Edges ShopEdges `json:"-"`
+}
+
+// ShopEdges holds the relations/edges for other nodes in the graph.
+type ShopEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*ShopMember `json:"members,omitempty"`
+	// Invites holds the value of the invites edge.
+	Invites []*ShopInvite `json:"invites,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShopEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e ShopEdges) MembersOrErr() ([]*ShopMember, error) {
+	if e.loadedTypes[1] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// InvitesOrErr returns the Invites value or an error if the edge
+// was not loaded in eager-loading.
+func (e ShopEdges) InvitesOrErr() ([]*ShopInvite, error) {
+	if e.loadedTypes[2] {
+		return e.Invites, nil
+	}
+	return nil, &NotLoadedError{edge: "invites"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Shop) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shop.FieldTaxRate, shop.FieldShippingFee:
+			values[i] = new(sql.NullInt64)
+		case shop.FieldName:
+			values[i] = new(sql.NullString)
+		case shop.FieldCreatedAt, shop.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case shop.FieldID, shop.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Shop", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Shop fields.
+func (s *Shop) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shop.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				s.ID = *value
+			}
+		case shop.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case shop.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case shop.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				s.UserID = *value
+			}
+		case shop.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case shop.FieldTaxRate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate", values[i])
+			} else if value.Valid {
+				s.TaxRate = money.Rate(value.Int64)
+			}
+		case shop.FieldShippingFee:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field shipping_fee", values[i])
+			} else if value.Valid {
+				s.ShippingFee = money.Amount(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryOwner queries the "owner" edge of the Shop entity.
+func (s *Shop) QueryOwner() *UserQuery {
+	return NewShopClient(s.config).QueryOwner(s)
+}
+
+// QueryMembers queries the "members" edge of the Shop entity.
+func (s *Shop) QueryMembers() *ShopMemberQuery {
+	return NewShopClient(s.config).QueryMembers(s)
+}
+
+// QueryInvites queries the "invites" edge of the Shop entity.
+func (s *Shop) QueryInvites() *ShopInviteQuery {
+	return NewShopClient(s.config).QueryInvites(s)
+}
+
+// Update returns a builder for updating this Shop.
+// Note that you need to call Shop.Unwrap() before calling this method if this Shop
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Shop) Update() *ShopUpdateOne {
+	return NewShopClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Shop entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Shop) Unwrap() *Shop {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Shop is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Shop) String() string {
+	var builder strings.Builder
+	builder.WriteString("Shop(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("tax_rate=")
+	builder.WriteString(fmt.Sprintf("%v", s.TaxRate))
+	builder.WriteString(", ")
+	builder.WriteString("shipping_fee=")
+	builder.WriteString(fmt.Sprintf("%v", s.ShippingFee))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Shops is a parsable slice of Shop.
+type Shops []*Shop
@@ -0,0 +1,100 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shop
+
+import (
+	"sthl/money"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the shop type in the database.
+	Label = "shop"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldTaxRate holds the string denoting the tax_rate field in the database.
+	FieldTaxRate = "tax_rate"
+	// FieldShippingFee holds the string denoting the shipping_fee field in the database.
+	FieldShippingFee = "shipping_fee"
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+	// EdgeInvites holds the string denoting the invites edge name in mutations.
+	EdgeInvites = "invites"
+	// Table holds the table name of the shop in the database.
+	Table = "shops"
+	// OwnerTable is the table that holds the owner relation/edge.
+	OwnerTable = "shops"
+	// OwnerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	OwnerInverseTable = "users"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "user_id"
+	// MembersTable is the table that holds the members relation/edge.
+	MembersTable = "shop_members"
+	// MembersInverseTable is the table name for the ShopMember entity.
+	// It exists in this package in order to avoid circular dependency with the "shopmember" package.
+	MembersInverseTable = "shop_members"
+	// MembersColumn is the table column denoting the members relation/edge.
+	MembersColumn = "shop_id"
+	// InvitesTable is the table that holds the invites relation/edge.
+	InvitesTable = "shop_invites"
+	// InvitesInverseTable is the table name for the ShopInvite entity.
+	// It exists in this package in order to avoid circular dependency with the "shopinvite" package.
+	InvitesInverseTable = "shop_invites"
+	// InvitesColumn is the table column denoting the invites relation/edge.
+	InvitesColumn = "shop_id"
+)
+
+// Columns holds all SQL columns for shop fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldUserID,
+	FieldName,
+	FieldTaxRate,
+	FieldShippingFee,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultTaxRate holds the default value on creation for the "tax_rate" field.
+	DefaultTaxRate money.Rate
+	// TaxRateValidator is a validator for the "tax_rate" field. It is called by the builders before save.
+	TaxRateValidator func(int64) error
+	// DefaultShippingFee holds the default value on creation for the "shipping_fee" field.
+	DefaultShippingFee money.Amount
+	// ShippingFeeValidator is a validator for the "shipping_fee" field. It is called by the builders before save.
+	ShippingFeeValidator func(int64) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
@@ -0,0 +1,938 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/shop"
+	"sthl/ent/shopinvite"
+	"sthl/ent/shopmember"
+	"sthl/ent/user"
+	"sthl/money"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShopCreate is the builder for creating a Shop entity.
+type ShopCreate struct {
+	config
+	mutation *ShopMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *ShopCreate) SetCreatedAt(t time.Time) *ShopCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *ShopCreate) SetNillableCreatedAt(t *time.Time) *ShopCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *ShopCreate) SetUpdatedAt(t time.Time) *ShopCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *ShopCreate) SetNillableUpdatedAt(t *time.Time) *ShopCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetUserID sets the "user_id" field.
+func (sc *ShopCreate) SetUserID(u uuid.UUID) *ShopCreate {
+	sc.mutation.SetUserID(u)
+	return sc
+}
+
+// SetName sets the "name" field.
+func (sc *ShopCreate) SetName(s string) *ShopCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (sc *ShopCreate) SetTaxRate(m money.Rate) *ShopCreate {
+	sc.mutation.SetTaxRate(m)
+	return sc
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (sc *ShopCreate) SetNillableTaxRate(m *money.Rate) *ShopCreate {
+	if m != nil {
+		sc.SetTaxRate(*m)
+	}
+	return sc
+}
+
+// SetShippingFee sets the "shipping_fee" field.
+func (sc *ShopCreate) SetShippingFee(m money.Amount) *ShopCreate {
+	sc.mutation.SetShippingFee(m)
+	return sc
+}
+
+// SetNillableShippingFee sets the "shipping_fee" field if the given value is not nil.
+func (sc *ShopCreate) SetNillableShippingFee(m *money.Amount) *ShopCreate {
+	if m != nil {
+		sc.SetShippingFee(*m)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *ShopCreate) SetID(u uuid.UUID) *ShopCreate {
+	sc.mutation.SetID(u)
+	return sc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sc *ShopCreate) SetNillableID(u *uuid.UUID) *ShopCreate {
+	if u != nil {
+		sc.SetID(*u)
+	}
+	return sc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (sc *ShopCreate) SetOwnerID(id uuid.UUID) *ShopCreate {
+	sc.mutation.SetOwnerID(id)
+	return sc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (sc *ShopCreate) SetOwner(u *User) *ShopCreate {
+	return sc.SetOwnerID(u.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the ShopMember entity by IDs.
+func (sc *ShopCreate) AddMemberIDs(ids ...uuid.UUID) *ShopCreate {
+	sc.mutation.AddMemberIDs(ids...)
+	return sc
+}
+
+// AddMembers adds the "members" edges to the ShopMember entity.
+func (sc *ShopCreate) AddMembers(s ...*ShopMember) *ShopCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddMemberIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the ShopInvite entity by IDs.
+func (sc *ShopCreate) AddInviteIDs(ids ...uuid.UUID) *ShopCreate {
+	sc.mutation.AddInviteIDs(ids...)
+	return sc
+}
+
+// AddInvites adds the "invites" edges to the ShopInvite entity.
+func (sc *ShopCreate) AddInvites(s ...*ShopInvite) *ShopCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddInviteIDs(ids...)
+}
+
+// Mutation returns the ShopMutation object of the builder.
+func (sc *ShopCreate) Mutation() *ShopMutation {
+	return sc.mutation
+}
+
+// Save creates the Shop in the database.
+func (sc *ShopCreate) Save(ctx context.Context) (*Shop, error) {
+	sc.defaults()
+	return withHooks[*Shop, ShopMutation](ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ShopCreate) SaveX(ctx context.Context) *Shop {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *ShopCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *ShopCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *ShopCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := shop.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := shop.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sc.mutation.TaxRate(); !ok {
+		v := shop.DefaultTaxRate
+		sc.mutation.SetTaxRate(v)
+	}
+	if _, ok := sc.mutation.ShippingFee(); !ok {
+		v := shop.DefaultShippingFee
+		sc.mutation.SetShippingFee(v)
+	}
+	if _, ok := sc.mutation.ID(); !ok {
+		v := shop.DefaultID()
+		sc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *ShopCreate) check() error {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Shop.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Shop.updated_at"`)}
+	}
+	if _, ok := sc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Shop.user_id"`)}
+	}
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Shop.name"`)}
+	}
+	if v, ok := sc.mutation.Name(); ok {
+		if err := shop.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Shop.name": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.TaxRate(); !ok {
+		return &ValidationError{Name: "tax_rate", err: errors.New(`ent: missing required field "Shop.tax_rate"`)}
+	}
+	if v, ok := sc.mutation.TaxRate(); ok {
+		if err := shop.TaxRateValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "tax_rate", err: fmt.Errorf(`ent: validator failed for field "Shop.tax_rate": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.ShippingFee(); !ok {
+		return &ValidationError{Name: "shipping_fee", err: errors.New(`ent: missing required field "Shop.shipping_fee"`)}
+	}
+	if v, ok := sc.mutation.ShippingFee(); ok {
+		if err := shop.ShippingFeeValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "shipping_fee", err: fmt.Errorf(`ent: validator failed for field "Shop.shipping_fee": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Shop.owner"`)}
+	}
+	return nil
+}
+
+func (sc *ShopCreate) sqlSave(ctx context.Context) (*Shop, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *ShopCreate) createSpec() (*Shop, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Shop{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(shop.Table, sqlgraph.NewFieldSpec(shop.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = sc.conflict
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(shop.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(shop.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(shop.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.TaxRate(); ok {
+		_spec.SetField(shop.FieldTaxRate, field.TypeInt64, value)
+		_node.TaxRate = value
+	}
+	if value, ok := sc.mutation.ShippingFee(); ok {
+		_spec.SetField(shop.FieldShippingFee, field.TypeInt64, value)
+		_node.ShippingFee = value
+	}
+	if nodes := sc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   shop.OwnerTable,
+			Columns: []string{shop.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.MembersTable,
+			Columns: []string{shop.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopmember.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.InvitesTable,
+			Columns: []string{shop.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopinvite.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Shop.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShopUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *ShopCreate) OnConflict(opts ...sql.ConflictOption) *ShopUpsertOne {
+	sc.conflict = opts
+	return &ShopUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Shop.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *ShopCreate) OnConflictColumns(columns ...string) *ShopUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &ShopUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// ShopUpsertOne is the builder for "upsert"-ing
+	//  one Shop node.
+	ShopUpsertOne struct {
+		create *ShopCreate
+	}
+
+	// ShopUpsert is the "OnConflict" setter.
+	ShopUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShopUpsert) SetUpdatedAt(v time.Time) *ShopUpsert {
+	u.Set(shop.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShopUpsert) UpdateUpdatedAt() *ShopUpsert {
+	u.SetExcluded(shop.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ShopUpsert) SetUserID(v uuid.UUID) *ShopUpsert {
+	u.Set(shop.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ShopUpsert) UpdateUserID() *ShopUpsert {
+	u.SetExcluded(shop.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ShopUpsert) SetName(v string) *ShopUpsert {
+	u.Set(shop.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ShopUpsert) UpdateName() *ShopUpsert {
+	u.SetExcluded(shop.FieldName)
+	return u
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *ShopUpsert) SetTaxRate(v money.Rate) *ShopUpsert {
+	u.Set(shop.FieldTaxRate, v)
+	return u
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *ShopUpsert) UpdateTaxRate() *ShopUpsert {
+	u.SetExcluded(shop.FieldTaxRate)
+	return u
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *ShopUpsert) AddTaxRate(v money.Rate) *ShopUpsert {
+	u.Add(shop.FieldTaxRate, v)
+	return u
+}
+
+// SetShippingFee sets the "shipping_fee" field.
+func (u *ShopUpsert) SetShippingFee(v money.Amount) *ShopUpsert {
+	u.Set(shop.FieldShippingFee, v)
+	return u
+}
+
+// UpdateShippingFee sets the "shipping_fee" field to the value that was provided on create.
+func (u *ShopUpsert) UpdateShippingFee() *ShopUpsert {
+	u.SetExcluded(shop.FieldShippingFee)
+	return u
+}
+
+// AddShippingFee adds v to the "shipping_fee" field.
+func (u *ShopUpsert) AddShippingFee(v money.Amount) *ShopUpsert {
+	u.Add(shop.FieldShippingFee, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Shop.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(shop.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ShopUpsertOne) UpdateNewValues() *ShopUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(shop.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(shop.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Shop.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ShopUpsertOne) Ignore() *ShopUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShopUpsertOne) DoNothing() *ShopUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShopCreate.OnConflict
+// documentation for more info.
+func (u *ShopUpsertOne) Update(set func(*ShopUpsert)) *ShopUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShopUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShopUpsertOne) SetUpdatedAt(v time.Time) *ShopUpsertOne {
+	return u.Update(func(s *ShopUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShopUpsertOne) UpdateUpdatedAt() *ShopUpsertOne {
+	return u.Update(func(s *ShopUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ShopUpsertOne) SetUserID(v uuid.UUID) *ShopUpsertOne {
+	return u.Update(func(s *ShopUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ShopUpsertOne) UpdateUserID() *ShopUpsertOne {
+	return u.Update(func(s *ShopUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ShopUpsertOne) SetName(v string) *ShopUpsertOne {
+	return u.Update(func(s *ShopUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ShopUpsertOne) UpdateName() *ShopUpsertOne {
+	return u.Update(func(s *ShopUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *ShopUpsertOne) SetTaxRate(v money.Rate) *ShopUpsertOne {
+	return u.Update(func(s *ShopUpsert) {
+		s.SetTaxRate(v)
+	})
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *ShopUpsertOne) AddTaxRate(v money.Rate) *ShopUpsertOne {
+	return u.Update(func(s *ShopUpsert) {
+		s.AddTaxRate(v)
+	})
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *ShopUpsertOne) UpdateTaxRate() *ShopUpsertOne {
+	return u.Update(func(s *ShopUpsert) {
+		s.UpdateTaxRate()
+	})
+}
+
+// SetShippingFee sets the "shipping_fee" field.
+func (u *ShopUpsertOne) SetShippingFee(v money.Amount) *ShopUpsertOne {
+	return u.Update(func(s *ShopUpsert) {
+		s.SetShippingFee(v)
+	})
+}
+
+// AddShippingFee adds v to the "shipping_fee" field.
+func (u *ShopUpsertOne) AddShippingFee(v money.Amount) *ShopUpsertOne {
+	return u.Update(func(s *ShopUpsert) {
+		s.AddShippingFee(v)
+	})
+}
+
+// UpdateShippingFee sets the "shipping_fee" field to the value that was provided on create.
+func (u *ShopUpsertOne) UpdateShippingFee() *ShopUpsertOne {
+	return u.Update(func(s *ShopUpsert) {
+		s.UpdateShippingFee()
+	})
+}
+
+// Exec executes the query.
+func (u *ShopUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShopCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShopUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ShopUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ShopUpsertOne.ID is not supported by MySQL driver. Use ShopUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ShopUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ShopCreateBulk is the builder for creating many Shop entities in bulk.
+type ShopCreateBulk struct {
+	config
+	builders []*ShopCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Shop entities in the database.
+func (scb *ShopCreateBulk) Save(ctx context.Context) ([]*Shop, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Shop, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShopMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *ShopCreateBulk) SaveX(ctx context.Context) []*Shop {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *ShopCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *ShopCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Shop.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShopUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *ShopCreateBulk) OnConflict(opts ...sql.ConflictOption) *ShopUpsertBulk {
+	scb.conflict = opts
+	return &ShopUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Shop.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *ShopCreateBulk) OnConflictColumns(columns ...string) *ShopUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &ShopUpsertBulk{
+		create: scb,
+	}
+}
+
+// ShopUpsertBulk is the builder for "upsert"-ing
+// a bulk of Shop nodes.
+type ShopUpsertBulk struct {
+	create *ShopCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Shop.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(shop.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ShopUpsertBulk) UpdateNewValues() *ShopUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(shop.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(shop.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Shop.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ShopUpsertBulk) Ignore() *ShopUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShopUpsertBulk) DoNothing() *ShopUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShopCreateBulk.OnConflict
+// documentation for more info.
+func (u *ShopUpsertBulk) Update(set func(*ShopUpsert)) *ShopUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShopUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShopUpsertBulk) SetUpdatedAt(v time.Time) *ShopUpsertBulk {
+	return u.Update(func(s *ShopUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShopUpsertBulk) UpdateUpdatedAt() *ShopUpsertBulk {
+	return u.Update(func(s *ShopUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ShopUpsertBulk) SetUserID(v uuid.UUID) *ShopUpsertBulk {
+	return u.Update(func(s *ShopUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ShopUpsertBulk) UpdateUserID() *ShopUpsertBulk {
+	return u.Update(func(s *ShopUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ShopUpsertBulk) SetName(v string) *ShopUpsertBulk {
+	return u.Update(func(s *ShopUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ShopUpsertBulk) UpdateName() *ShopUpsertBulk {
+	return u.Update(func(s *ShopUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (u *ShopUpsertBulk) SetTaxRate(v money.Rate) *ShopUpsertBulk {
+	return u.Update(func(s *ShopUpsert) {
+		s.SetTaxRate(v)
+	})
+}
+
+// AddTaxRate adds v to the "tax_rate" field.
+func (u *ShopUpsertBulk) AddTaxRate(v money.Rate) *ShopUpsertBulk {
+	return u.Update(func(s *ShopUpsert) {
+		s.AddTaxRate(v)
+	})
+}
+
+// UpdateTaxRate sets the "tax_rate" field to the value that was provided on create.
+func (u *ShopUpsertBulk) UpdateTaxRate() *ShopUpsertBulk {
+	return u.Update(func(s *ShopUpsert) {
+		s.UpdateTaxRate()
+	})
+}
+
+// SetShippingFee sets the "shipping_fee" field.
+func (u *ShopUpsertBulk) SetShippingFee(v money.Amount) *ShopUpsertBulk {
+	return u.Update(func(s *ShopUpsert) {
+		s.SetShippingFee(v)
+	})
+}
+
+// AddShippingFee adds v to the "shipping_fee" field.
+func (u *ShopUpsertBulk) AddShippingFee(v money.Amount) *ShopUpsertBulk {
+	return u.Update(func(s *ShopUpsert) {
+		s.AddShippingFee(v)
+	})
+}
+
+// UpdateShippingFee sets the "shipping_fee" field to the value that was provided on create.
+func (u *ShopUpsertBulk) UpdateShippingFee() *ShopUpsertBulk {
+	return u.Update(func(s *ShopUpsert) {
+		s.UpdateShippingFee()
+	})
+}
+
+// Exec executes the query.
+func (u *ShopUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ShopCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShopCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShopUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
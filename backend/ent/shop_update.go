@@ -0,0 +1,871 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/predicate"
+	"sthl/ent/shop"
+	"sthl/ent/shopinvite"
+	"sthl/ent/shopmember"
+	"sthl/ent/user"
+	"sthl/money"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShopUpdate is the builder for updating Shop entities.
+type ShopUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShopMutation
+}
+
+// Where appends a list predicates to the ShopUpdate builder.
+func (su *ShopUpdate) Where(ps ...predicate.Shop) *ShopUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *ShopUpdate) SetUpdatedAt(t time.Time) *ShopUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetUserID sets the "user_id" field.
+func (su *ShopUpdate) SetUserID(u uuid.UUID) *ShopUpdate {
+	su.mutation.SetUserID(u)
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *ShopUpdate) SetName(s string) *ShopUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (su *ShopUpdate) SetTaxRate(m money.Rate) *ShopUpdate {
+	su.mutation.ResetTaxRate()
+	su.mutation.SetTaxRate(m)
+	return su
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (su *ShopUpdate) SetNillableTaxRate(m *money.Rate) *ShopUpdate {
+	if m != nil {
+		su.SetTaxRate(*m)
+	}
+	return su
+}
+
+// AddTaxRate adds m to the "tax_rate" field.
+func (su *ShopUpdate) AddTaxRate(m money.Rate) *ShopUpdate {
+	su.mutation.AddTaxRate(m)
+	return su
+}
+
+// SetShippingFee sets the "shipping_fee" field.
+func (su *ShopUpdate) SetShippingFee(m money.Amount) *ShopUpdate {
+	su.mutation.ResetShippingFee()
+	su.mutation.SetShippingFee(m)
+	return su
+}
+
+// SetNillableShippingFee sets the "shipping_fee" field if the given value is not nil.
+func (su *ShopUpdate) SetNillableShippingFee(m *money.Amount) *ShopUpdate {
+	if m != nil {
+		su.SetShippingFee(*m)
+	}
+	return su
+}
+
+// AddShippingFee adds m to the "shipping_fee" field.
+func (su *ShopUpdate) AddShippingFee(m money.Amount) *ShopUpdate {
+	su.mutation.AddShippingFee(m)
+	return su
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (su *ShopUpdate) SetOwnerID(id uuid.UUID) *ShopUpdate {
+	su.mutation.SetOwnerID(id)
+	return su
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (su *ShopUpdate) SetOwner(u *User) *ShopUpdate {
+	return su.SetOwnerID(u.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the ShopMember entity by IDs.
+func (su *ShopUpdate) AddMemberIDs(ids ...uuid.UUID) *ShopUpdate {
+	su.mutation.AddMemberIDs(ids...)
+	return su
+}
+
+// AddMembers adds the "members" edges to the ShopMember entity.
+func (su *ShopUpdate) AddMembers(s ...*ShopMember) *ShopUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddMemberIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the ShopInvite entity by IDs.
+func (su *ShopUpdate) AddInviteIDs(ids ...uuid.UUID) *ShopUpdate {
+	su.mutation.AddInviteIDs(ids...)
+	return su
+}
+
+// AddInvites adds the "invites" edges to the ShopInvite entity.
+func (su *ShopUpdate) AddInvites(s ...*ShopInvite) *ShopUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddInviteIDs(ids...)
+}
+
+// Mutation returns the ShopMutation object of the builder.
+func (su *ShopUpdate) Mutation() *ShopMutation {
+	return su.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (su *ShopUpdate) ClearOwner() *ShopUpdate {
+	su.mutation.ClearOwner()
+	return su
+}
+
+// ClearMembers clears all "members" edges to the ShopMember entity.
+func (su *ShopUpdate) ClearMembers() *ShopUpdate {
+	su.mutation.ClearMembers()
+	return su
+}
+
+// RemoveMemberIDs removes the "members" edge to ShopMember entities by IDs.
+func (su *ShopUpdate) RemoveMemberIDs(ids ...uuid.UUID) *ShopUpdate {
+	su.mutation.RemoveMemberIDs(ids...)
+	return su
+}
+
+// RemoveMembers removes "members" edges to ShopMember entities.
+func (su *ShopUpdate) RemoveMembers(s ...*ShopMember) *ShopUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveMemberIDs(ids...)
+}
+
+// ClearInvites clears all "invites" edges to the ShopInvite entity.
+func (su *ShopUpdate) ClearInvites() *ShopUpdate {
+	su.mutation.ClearInvites()
+	return su
+}
+
+// RemoveInviteIDs removes the "invites" edge to ShopInvite entities by IDs.
+func (su *ShopUpdate) RemoveInviteIDs(ids ...uuid.UUID) *ShopUpdate {
+	su.mutation.RemoveInviteIDs(ids...)
+	return su
+}
+
+// RemoveInvites removes "invites" edges to ShopInvite entities.
+func (su *ShopUpdate) RemoveInvites(s ...*ShopInvite) *ShopUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveInviteIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ShopUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks[int, ShopMutation](ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ShopUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ShopUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ShopUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *ShopUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := shop.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *ShopUpdate) check() error {
+	if v, ok := su.mutation.Name(); ok {
+		if err := shop.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Shop.name": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.TaxRate(); ok {
+		if err := shop.TaxRateValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "tax_rate", err: fmt.Errorf(`ent: validator failed for field "Shop.tax_rate": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.ShippingFee(); ok {
+		if err := shop.ShippingFeeValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "shipping_fee", err: fmt.Errorf(`ent: validator failed for field "Shop.shipping_fee": %w`, err)}
+		}
+	}
+	if _, ok := su.mutation.OwnerID(); su.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Shop.owner"`)
+	}
+	return nil
+}
+
+func (su *ShopUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shop.Table, shop.Columns, sqlgraph.NewFieldSpec(shop.FieldID, field.TypeUUID))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(shop.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(shop.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.TaxRate(); ok {
+		_spec.SetField(shop.FieldTaxRate, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.AddedTaxRate(); ok {
+		_spec.AddField(shop.FieldTaxRate, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.ShippingFee(); ok {
+		_spec.SetField(shop.FieldShippingFee, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.AddedShippingFee(); ok {
+		_spec.AddField(shop.FieldShippingFee, field.TypeInt64, value)
+	}
+	if su.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   shop.OwnerTable,
+			Columns: []string{shop.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   shop.OwnerTable,
+			Columns: []string{shop.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.MembersTable,
+			Columns: []string{shop.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopmember.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedMembersIDs(); len(nodes) > 0 && !su.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.MembersTable,
+			Columns: []string{shop.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopmember.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.MembersTable,
+			Columns: []string{shop.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopmember.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.InvitesTable,
+			Columns: []string{shop.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopinvite.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !su.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.InvitesTable,
+			Columns: []string{shop.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopinvite.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.InvitesTable,
+			Columns: []string{shop.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopinvite.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shop.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// ShopUpdateOne is the builder for updating a single Shop entity.
+type ShopUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShopMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *ShopUpdateOne) SetUpdatedAt(t time.Time) *ShopUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetUserID sets the "user_id" field.
+func (suo *ShopUpdateOne) SetUserID(u uuid.UUID) *ShopUpdateOne {
+	suo.mutation.SetUserID(u)
+	return suo
+}
+
+// SetName sets the "name" field.
+func (suo *ShopUpdateOne) SetName(s string) *ShopUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (suo *ShopUpdateOne) SetTaxRate(m money.Rate) *ShopUpdateOne {
+	suo.mutation.ResetTaxRate()
+	suo.mutation.SetTaxRate(m)
+	return suo
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (suo *ShopUpdateOne) SetNillableTaxRate(m *money.Rate) *ShopUpdateOne {
+	if m != nil {
+		suo.SetTaxRate(*m)
+	}
+	return suo
+}
+
+// AddTaxRate adds m to the "tax_rate" field.
+func (suo *ShopUpdateOne) AddTaxRate(m money.Rate) *ShopUpdateOne {
+	suo.mutation.AddTaxRate(m)
+	return suo
+}
+
+// SetShippingFee sets the "shipping_fee" field.
+func (suo *ShopUpdateOne) SetShippingFee(m money.Amount) *ShopUpdateOne {
+	suo.mutation.ResetShippingFee()
+	suo.mutation.SetShippingFee(m)
+	return suo
+}
+
+// SetNillableShippingFee sets the "shipping_fee" field if the given value is not nil.
+func (suo *ShopUpdateOne) SetNillableShippingFee(m *money.Amount) *ShopUpdateOne {
+	if m != nil {
+		suo.SetShippingFee(*m)
+	}
+	return suo
+}
+
+// AddShippingFee adds m to the "shipping_fee" field.
+func (suo *ShopUpdateOne) AddShippingFee(m money.Amount) *ShopUpdateOne {
+	suo.mutation.AddShippingFee(m)
+	return suo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (suo *ShopUpdateOne) SetOwnerID(id uuid.UUID) *ShopUpdateOne {
+	suo.mutation.SetOwnerID(id)
+	return suo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (suo *ShopUpdateOne) SetOwner(u *User) *ShopUpdateOne {
+	return suo.SetOwnerID(u.ID)
+}
+
+// AddMemberIDs adds the "members" edge to the ShopMember entity by IDs.
+func (suo *ShopUpdateOne) AddMemberIDs(ids ...uuid.UUID) *ShopUpdateOne {
+	suo.mutation.AddMemberIDs(ids...)
+	return suo
+}
+
+// AddMembers adds the "members" edges to the ShopMember entity.
+func (suo *ShopUpdateOne) AddMembers(s ...*ShopMember) *ShopUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddMemberIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the ShopInvite entity by IDs.
+func (suo *ShopUpdateOne) AddInviteIDs(ids ...uuid.UUID) *ShopUpdateOne {
+	suo.mutation.AddInviteIDs(ids...)
+	return suo
+}
+
+// AddInvites adds the "invites" edges to the ShopInvite entity.
+func (suo *ShopUpdateOne) AddInvites(s ...*ShopInvite) *ShopUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddInviteIDs(ids...)
+}
+
+// Mutation returns the ShopMutation object of the builder.
+func (suo *ShopUpdateOne) Mutation() *ShopMutation {
+	return suo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (suo *ShopUpdateOne) ClearOwner() *ShopUpdateOne {
+	suo.mutation.ClearOwner()
+	return suo
+}
+
+// ClearMembers clears all "members" edges to the ShopMember entity.
+func (suo *ShopUpdateOne) ClearMembers() *ShopUpdateOne {
+	suo.mutation.ClearMembers()
+	return suo
+}
+
+// RemoveMemberIDs removes the "members" edge to ShopMember entities by IDs.
+func (suo *ShopUpdateOne) RemoveMemberIDs(ids ...uuid.UUID) *ShopUpdateOne {
+	suo.mutation.RemoveMemberIDs(ids...)
+	return suo
+}
+
+// RemoveMembers removes "members" edges to ShopMember entities.
+func (suo *ShopUpdateOne) RemoveMembers(s ...*ShopMember) *ShopUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveMemberIDs(ids...)
+}
+
+// ClearInvites clears all "invites" edges to the ShopInvite entity.
+func (suo *ShopUpdateOne) ClearInvites() *ShopUpdateOne {
+	suo.mutation.ClearInvites()
+	return suo
+}
+
+// RemoveInviteIDs removes the "invites" edge to ShopInvite entities by IDs.
+func (suo *ShopUpdateOne) RemoveInviteIDs(ids ...uuid.UUID) *ShopUpdateOne {
+	suo.mutation.RemoveInviteIDs(ids...)
+	return suo
+}
+
+// RemoveInvites removes "invites" edges to ShopInvite entities.
+func (suo *ShopUpdateOne) RemoveInvites(s ...*ShopInvite) *ShopUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveInviteIDs(ids...)
+}
+
+// Where appends a list predicates to the ShopUpdate builder.
+func (suo *ShopUpdateOne) Where(ps ...predicate.Shop) *ShopUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ShopUpdateOne) Select(field string, fields ...string) *ShopUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Shop entity.
+func (suo *ShopUpdateOne) Save(ctx context.Context) (*Shop, error) {
+	suo.defaults()
+	return withHooks[*Shop, ShopMutation](ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ShopUpdateOne) SaveX(ctx context.Context) *Shop {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ShopUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ShopUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *ShopUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := shop.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *ShopUpdateOne) check() error {
+	if v, ok := suo.mutation.Name(); ok {
+		if err := shop.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Shop.name": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.TaxRate(); ok {
+		if err := shop.TaxRateValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "tax_rate", err: fmt.Errorf(`ent: validator failed for field "Shop.tax_rate": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.ShippingFee(); ok {
+		if err := shop.ShippingFeeValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "shipping_fee", err: fmt.Errorf(`ent: validator failed for field "Shop.shipping_fee": %w`, err)}
+		}
+	}
+	if _, ok := suo.mutation.OwnerID(); suo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Shop.owner"`)
+	}
+	return nil
+}
+
+func (suo *ShopUpdateOne) sqlSave(ctx context.Context) (_node *Shop, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shop.Table, shop.Columns, sqlgraph.NewFieldSpec(shop.FieldID, field.TypeUUID))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Shop.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shop.FieldID)
+		for _, f := range fields {
+			if !shop.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shop.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(shop.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(shop.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.TaxRate(); ok {
+		_spec.SetField(shop.FieldTaxRate, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.AddedTaxRate(); ok {
+		_spec.AddField(shop.FieldTaxRate, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.ShippingFee(); ok {
+		_spec.SetField(shop.FieldShippingFee, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.AddedShippingFee(); ok {
+		_spec.AddField(shop.FieldShippingFee, field.TypeInt64, value)
+	}
+	if suo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   shop.OwnerTable,
+			Columns: []string{shop.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   shop.OwnerTable,
+			Columns: []string{shop.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.MembersTable,
+			Columns: []string{shop.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopmember.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !suo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.MembersTable,
+			Columns: []string{shop.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopmember.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.MembersTable,
+			Columns: []string{shop.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopmember.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.InvitesTable,
+			Columns: []string{shop.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopinvite.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !suo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.InvitesTable,
+			Columns: []string{shop.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopinvite.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shop.InvitesTable,
+			Columns: []string{shop.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopinvite.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Shop{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shop.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sthl/ent/shop"
+	"sthl/ent/shopinvite"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ShopInvite is the model entity for the ShopInvite schema.
+type ShopInvite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// ShopID holds the value of the "shop_id" field.
+	ShopID uuid.UUID `json:"shopId"`
+	// InvitedBy holds the value of the "invited_by" field.
+	InvitedBy uuid.UUID `json:"invitedBy"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email"`
+	// Role holds the value of the "role" field.
+	Role string `json:"role"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expiresAt"`
+	// AcceptedAt holds the value of the "accepted_at" field.
+	AcceptedAt *time.Time `json:"acceptedAt"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShopInviteQuery when eager-loading is set.
+	Edges ShopInviteEdges `json:"-"`
+}
+
+// ShopInviteEdges holds the relations/edges for other nodes in the graph.
+type ShopInviteEdges struct {
+	// Shop holds the value of the shop edge.
+	Shop *Shop `json:"shop,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ShopOrErr returns the Shop value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShopInviteEdges) ShopOrErr() (*Shop, error) {
+	if e.loadedTypes[0] {
+		if e.Shop == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: shop.Label}
+		}
+		return e.Shop, nil
+	}
+	return nil, &NotLoadedError{edge: "shop"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShopInvite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shopinvite.FieldEmail, shopinvite.FieldRole, shopinvite.FieldTokenHash:
+			values[i] = new(sql.NullString)
+		case shopinvite.FieldCreatedAt, shopinvite.FieldUpdatedAt, shopinvite.FieldExpiresAt, shopinvite.FieldAcceptedAt:
+			values[i] = new(sql.NullTime)
+		case shopinvite.FieldID, shopinvite.FieldShopID, shopinvite.FieldInvitedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ShopInvite", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShopInvite fields.
+func (si *ShopInvite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shopinvite.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				si.ID = *value
+			}
+		case shopinvite.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				si.CreatedAt = value.Time
+			}
+		case shopinvite.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				si.UpdatedAt = value.Time
+			}
+		case shopinvite.FieldShopID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field shop_id", values[i])
+			} else if value != nil {
+				si.ShopID = *value
+			}
+		case shopinvite.FieldInvitedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field invited_by", values[i])
+			} else if value != nil {
+				si.InvitedBy = *value
+			}
+		case shopinvite.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				si.Email = value.String
+			}
+		case shopinvite.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				si.Role = value.String
+			}
+		case shopinvite.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				si.TokenHash = value.String
+			}
+		case shopinvite.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				si.ExpiresAt = value.Time
+			}
+		case shopinvite.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				si.AcceptedAt = new(time.Time)
+				*si.AcceptedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryShop queries the "shop" edge of the ShopInvite entity.
+func (si *ShopInvite) QueryShop() *ShopQuery {
+	return NewShopInviteClient(si.config).QueryShop(si)
+}
+
+// Update returns a builder for updating this ShopInvite.
+// Note that you need to call ShopInvite.Unwrap() before calling this method if this ShopInvite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (si *ShopInvite) Update() *ShopInviteUpdateOne {
+	return NewShopInviteClient(si.config).UpdateOne(si)
+}
+
+// Unwrap unwraps the ShopInvite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (si *ShopInvite) Unwrap() *ShopInvite {
+	_tx, ok := si.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShopInvite is not a transactional entity")
+	}
+	si.config.driver = _tx.drv
+	return si
+}
+
+// String implements the fmt.Stringer.
+func (si *ShopInvite) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShopInvite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", si.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(si.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(si.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("shop_id=")
+	builder.WriteString(fmt.Sprintf("%v", si.ShopID))
+	builder.WriteString(", ")
+	builder.WriteString("invited_by=")
+	builder.WriteString(fmt.Sprintf("%v", si.InvitedBy))
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(si.Email)
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(si.Role)
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(si.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := si.AcceptedAt; v != nil {
+		builder.WriteString("accepted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShopInvites is a parsable slice of ShopInvite.
+type ShopInvites []*ShopInvite
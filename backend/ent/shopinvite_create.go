@@ -0,0 +1,981 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/shop"
+	"sthl/ent/shopinvite"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShopInviteCreate is the builder for creating a ShopInvite entity.
+type ShopInviteCreate struct {
+	config
+	mutation *ShopInviteMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sic *ShopInviteCreate) SetCreatedAt(t time.Time) *ShopInviteCreate {
+	sic.mutation.SetCreatedAt(t)
+	return sic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sic *ShopInviteCreate) SetNillableCreatedAt(t *time.Time) *ShopInviteCreate {
+	if t != nil {
+		sic.SetCreatedAt(*t)
+	}
+	return sic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sic *ShopInviteCreate) SetUpdatedAt(t time.Time) *ShopInviteCreate {
+	sic.mutation.SetUpdatedAt(t)
+	return sic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sic *ShopInviteCreate) SetNillableUpdatedAt(t *time.Time) *ShopInviteCreate {
+	if t != nil {
+		sic.SetUpdatedAt(*t)
+	}
+	return sic
+}
+
+// SetShopID sets the "shop_id" field.
+func (sic *ShopInviteCreate) SetShopID(u uuid.UUID) *ShopInviteCreate {
+	sic.mutation.SetShopID(u)
+	return sic
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (sic *ShopInviteCreate) SetInvitedBy(u uuid.UUID) *ShopInviteCreate {
+	sic.mutation.SetInvitedBy(u)
+	return sic
+}
+
+// SetEmail sets the "email" field.
+func (sic *ShopInviteCreate) SetEmail(s string) *ShopInviteCreate {
+	sic.mutation.SetEmail(s)
+	return sic
+}
+
+// SetRole sets the "role" field.
+func (sic *ShopInviteCreate) SetRole(s string) *ShopInviteCreate {
+	sic.mutation.SetRole(s)
+	return sic
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (sic *ShopInviteCreate) SetTokenHash(s string) *ShopInviteCreate {
+	sic.mutation.SetTokenHash(s)
+	return sic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sic *ShopInviteCreate) SetExpiresAt(t time.Time) *ShopInviteCreate {
+	sic.mutation.SetExpiresAt(t)
+	return sic
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (sic *ShopInviteCreate) SetAcceptedAt(t time.Time) *ShopInviteCreate {
+	sic.mutation.SetAcceptedAt(t)
+	return sic
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (sic *ShopInviteCreate) SetNillableAcceptedAt(t *time.Time) *ShopInviteCreate {
+	if t != nil {
+		sic.SetAcceptedAt(*t)
+	}
+	return sic
+}
+
+// SetID sets the "id" field.
+func (sic *ShopInviteCreate) SetID(u uuid.UUID) *ShopInviteCreate {
+	sic.mutation.SetID(u)
+	return sic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sic *ShopInviteCreate) SetNillableID(u *uuid.UUID) *ShopInviteCreate {
+	if u != nil {
+		sic.SetID(*u)
+	}
+	return sic
+}
+
+// SetShop sets the "shop" edge to the Shop entity.
+func (sic *ShopInviteCreate) SetShop(s *Shop) *ShopInviteCreate {
+	return sic.SetShopID(s.ID)
+}
+
+// Mutation returns the ShopInviteMutation object of the builder.
+func (sic *ShopInviteCreate) Mutation() *ShopInviteMutation {
+	return sic.mutation
+}
+
+// Save creates the ShopInvite in the database.
+func (sic *ShopInviteCreate) Save(ctx context.Context) (*ShopInvite, error) {
+	sic.defaults()
+	return withHooks[*ShopInvite, ShopInviteMutation](ctx, sic.sqlSave, sic.mutation, sic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sic *ShopInviteCreate) SaveX(ctx context.Context) *ShopInvite {
+	v, err := sic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sic *ShopInviteCreate) Exec(ctx context.Context) error {
+	_, err := sic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sic *ShopInviteCreate) ExecX(ctx context.Context) {
+	if err := sic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sic *ShopInviteCreate) defaults() {
+	if _, ok := sic.mutation.CreatedAt(); !ok {
+		v := shopinvite.DefaultCreatedAt()
+		sic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sic.mutation.UpdatedAt(); !ok {
+		v := shopinvite.DefaultUpdatedAt()
+		sic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sic.mutation.ID(); !ok {
+		v := shopinvite.DefaultID()
+		sic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sic *ShopInviteCreate) check() error {
+	if _, ok := sic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShopInvite.created_at"`)}
+	}
+	if _, ok := sic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ShopInvite.updated_at"`)}
+	}
+	if _, ok := sic.mutation.ShopID(); !ok {
+		return &ValidationError{Name: "shop_id", err: errors.New(`ent: missing required field "ShopInvite.shop_id"`)}
+	}
+	if _, ok := sic.mutation.InvitedBy(); !ok {
+		return &ValidationError{Name: "invited_by", err: errors.New(`ent: missing required field "ShopInvite.invited_by"`)}
+	}
+	if _, ok := sic.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "ShopInvite.email"`)}
+	}
+	if v, ok := sic.mutation.Email(); ok {
+		if err := shopinvite.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "ShopInvite.email": %w`, err)}
+		}
+	}
+	if _, ok := sic.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "ShopInvite.role"`)}
+	}
+	if v, ok := sic.mutation.Role(); ok {
+		if err := shopinvite.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ShopInvite.role": %w`, err)}
+		}
+	}
+	if _, ok := sic.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "ShopInvite.token_hash"`)}
+	}
+	if v, ok := sic.mutation.TokenHash(); ok {
+		if err := shopinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ShopInvite.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := sic.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ShopInvite.expires_at"`)}
+	}
+	if _, ok := sic.mutation.ShopID(); !ok {
+		return &ValidationError{Name: "shop", err: errors.New(`ent: missing required edge "ShopInvite.shop"`)}
+	}
+	return nil
+}
+
+func (sic *ShopInviteCreate) sqlSave(ctx context.Context) (*ShopInvite, error) {
+	if err := sic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sic.mutation.id = &_node.ID
+	sic.mutation.done = true
+	return _node, nil
+}
+
+func (sic *ShopInviteCreate) createSpec() (*ShopInvite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShopInvite{config: sic.config}
+		_spec = sqlgraph.NewCreateSpec(shopinvite.Table, sqlgraph.NewFieldSpec(shopinvite.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = sic.conflict
+	if id, ok := sic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sic.mutation.CreatedAt(); ok {
+		_spec.SetField(shopinvite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sic.mutation.UpdatedAt(); ok {
+		_spec.SetField(shopinvite.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sic.mutation.InvitedBy(); ok {
+		_spec.SetField(shopinvite.FieldInvitedBy, field.TypeUUID, value)
+		_node.InvitedBy = value
+	}
+	if value, ok := sic.mutation.Email(); ok {
+		_spec.SetField(shopinvite.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := sic.mutation.Role(); ok {
+		_spec.SetField(shopinvite.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := sic.mutation.TokenHash(); ok {
+		_spec.SetField(shopinvite.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := sic.mutation.ExpiresAt(); ok {
+		_spec.SetField(shopinvite.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := sic.mutation.AcceptedAt(); ok {
+		_spec.SetField(shopinvite.FieldAcceptedAt, field.TypeTime, value)
+		_node.AcceptedAt = &value
+	}
+	if nodes := sic.mutation.ShopIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopinvite.ShopTable,
+			Columns: []string{shopinvite.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ShopID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ShopInvite.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShopInviteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sic *ShopInviteCreate) OnConflict(opts ...sql.ConflictOption) *ShopInviteUpsertOne {
+	sic.conflict = opts
+	return &ShopInviteUpsertOne{
+		create: sic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ShopInvite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sic *ShopInviteCreate) OnConflictColumns(columns ...string) *ShopInviteUpsertOne {
+	sic.conflict = append(sic.conflict, sql.ConflictColumns(columns...))
+	return &ShopInviteUpsertOne{
+		create: sic,
+	}
+}
+
+type (
+	// ShopInviteUpsertOne is the builder for "upsert"-ing
+	//  one ShopInvite node.
+	ShopInviteUpsertOne struct {
+		create *ShopInviteCreate
+	}
+
+	// ShopInviteUpsert is the "OnConflict" setter.
+	ShopInviteUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShopInviteUpsert) SetUpdatedAt(v time.Time) *ShopInviteUpsert {
+	u.Set(shopinvite.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShopInviteUpsert) UpdateUpdatedAt() *ShopInviteUpsert {
+	u.SetExcluded(shopinvite.FieldUpdatedAt)
+	return u
+}
+
+// SetShopID sets the "shop_id" field.
+func (u *ShopInviteUpsert) SetShopID(v uuid.UUID) *ShopInviteUpsert {
+	u.Set(shopinvite.FieldShopID, v)
+	return u
+}
+
+// UpdateShopID sets the "shop_id" field to the value that was provided on create.
+func (u *ShopInviteUpsert) UpdateShopID() *ShopInviteUpsert {
+	u.SetExcluded(shopinvite.FieldShopID)
+	return u
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (u *ShopInviteUpsert) SetInvitedBy(v uuid.UUID) *ShopInviteUpsert {
+	u.Set(shopinvite.FieldInvitedBy, v)
+	return u
+}
+
+// UpdateInvitedBy sets the "invited_by" field to the value that was provided on create.
+func (u *ShopInviteUpsert) UpdateInvitedBy() *ShopInviteUpsert {
+	u.SetExcluded(shopinvite.FieldInvitedBy)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *ShopInviteUpsert) SetEmail(v string) *ShopInviteUpsert {
+	u.Set(shopinvite.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *ShopInviteUpsert) UpdateEmail() *ShopInviteUpsert {
+	u.SetExcluded(shopinvite.FieldEmail)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *ShopInviteUpsert) SetRole(v string) *ShopInviteUpsert {
+	u.Set(shopinvite.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ShopInviteUpsert) UpdateRole() *ShopInviteUpsert {
+	u.SetExcluded(shopinvite.FieldRole)
+	return u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *ShopInviteUpsert) SetTokenHash(v string) *ShopInviteUpsert {
+	u.Set(shopinvite.FieldTokenHash, v)
+	return u
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *ShopInviteUpsert) UpdateTokenHash() *ShopInviteUpsert {
+	u.SetExcluded(shopinvite.FieldTokenHash)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ShopInviteUpsert) SetExpiresAt(v time.Time) *ShopInviteUpsert {
+	u.Set(shopinvite.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ShopInviteUpsert) UpdateExpiresAt() *ShopInviteUpsert {
+	u.SetExcluded(shopinvite.FieldExpiresAt)
+	return u
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *ShopInviteUpsert) SetAcceptedAt(v time.Time) *ShopInviteUpsert {
+	u.Set(shopinvite.FieldAcceptedAt, v)
+	return u
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *ShopInviteUpsert) UpdateAcceptedAt() *ShopInviteUpsert {
+	u.SetExcluded(shopinvite.FieldAcceptedAt)
+	return u
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *ShopInviteUpsert) ClearAcceptedAt() *ShopInviteUpsert {
+	u.SetNull(shopinvite.FieldAcceptedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ShopInvite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(shopinvite.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ShopInviteUpsertOne) UpdateNewValues() *ShopInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(shopinvite.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(shopinvite.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ShopInvite.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ShopInviteUpsertOne) Ignore() *ShopInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShopInviteUpsertOne) DoNothing() *ShopInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShopInviteCreate.OnConflict
+// documentation for more info.
+func (u *ShopInviteUpsertOne) Update(set func(*ShopInviteUpsert)) *ShopInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShopInviteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShopInviteUpsertOne) SetUpdatedAt(v time.Time) *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShopInviteUpsertOne) UpdateUpdatedAt() *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetShopID sets the "shop_id" field.
+func (u *ShopInviteUpsertOne) SetShopID(v uuid.UUID) *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetShopID(v)
+	})
+}
+
+// UpdateShopID sets the "shop_id" field to the value that was provided on create.
+func (u *ShopInviteUpsertOne) UpdateShopID() *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateShopID()
+	})
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (u *ShopInviteUpsertOne) SetInvitedBy(v uuid.UUID) *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetInvitedBy(v)
+	})
+}
+
+// UpdateInvitedBy sets the "invited_by" field to the value that was provided on create.
+func (u *ShopInviteUpsertOne) UpdateInvitedBy() *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateInvitedBy()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *ShopInviteUpsertOne) SetEmail(v string) *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *ShopInviteUpsertOne) UpdateEmail() *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *ShopInviteUpsertOne) SetRole(v string) *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ShopInviteUpsertOne) UpdateRole() *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *ShopInviteUpsertOne) SetTokenHash(v string) *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *ShopInviteUpsertOne) UpdateTokenHash() *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ShopInviteUpsertOne) SetExpiresAt(v time.Time) *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ShopInviteUpsertOne) UpdateExpiresAt() *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *ShopInviteUpsertOne) SetAcceptedAt(v time.Time) *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *ShopInviteUpsertOne) UpdateAcceptedAt() *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *ShopInviteUpsertOne) ClearAcceptedAt() *ShopInviteUpsertOne {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ShopInviteUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShopInviteCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShopInviteUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ShopInviteUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ShopInviteUpsertOne.ID is not supported by MySQL driver. Use ShopInviteUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ShopInviteUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ShopInviteCreateBulk is the builder for creating many ShopInvite entities in bulk.
+type ShopInviteCreateBulk struct {
+	config
+	builders []*ShopInviteCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ShopInvite entities in the database.
+func (sicb *ShopInviteCreateBulk) Save(ctx context.Context) ([]*ShopInvite, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(sicb.builders))
+	nodes := make([]*ShopInvite, len(sicb.builders))
+	mutators := make([]Mutator, len(sicb.builders))
+	for i := range sicb.builders {
+		func(i int, root context.Context) {
+			builder := sicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShopInviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sicb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sicb *ShopInviteCreateBulk) SaveX(ctx context.Context) []*ShopInvite {
+	v, err := sicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sicb *ShopInviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := sicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sicb *ShopInviteCreateBulk) ExecX(ctx context.Context) {
+	if err := sicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ShopInvite.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShopInviteUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sicb *ShopInviteCreateBulk) OnConflict(opts ...sql.ConflictOption) *ShopInviteUpsertBulk {
+	sicb.conflict = opts
+	return &ShopInviteUpsertBulk{
+		create: sicb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ShopInvite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sicb *ShopInviteCreateBulk) OnConflictColumns(columns ...string) *ShopInviteUpsertBulk {
+	sicb.conflict = append(sicb.conflict, sql.ConflictColumns(columns...))
+	return &ShopInviteUpsertBulk{
+		create: sicb,
+	}
+}
+
+// ShopInviteUpsertBulk is the builder for "upsert"-ing
+// a bulk of ShopInvite nodes.
+type ShopInviteUpsertBulk struct {
+	create *ShopInviteCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ShopInvite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(shopinvite.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ShopInviteUpsertBulk) UpdateNewValues() *ShopInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(shopinvite.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(shopinvite.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ShopInvite.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ShopInviteUpsertBulk) Ignore() *ShopInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShopInviteUpsertBulk) DoNothing() *ShopInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShopInviteCreateBulk.OnConflict
+// documentation for more info.
+func (u *ShopInviteUpsertBulk) Update(set func(*ShopInviteUpsert)) *ShopInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShopInviteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShopInviteUpsertBulk) SetUpdatedAt(v time.Time) *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShopInviteUpsertBulk) UpdateUpdatedAt() *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetShopID sets the "shop_id" field.
+func (u *ShopInviteUpsertBulk) SetShopID(v uuid.UUID) *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetShopID(v)
+	})
+}
+
+// UpdateShopID sets the "shop_id" field to the value that was provided on create.
+func (u *ShopInviteUpsertBulk) UpdateShopID() *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateShopID()
+	})
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (u *ShopInviteUpsertBulk) SetInvitedBy(v uuid.UUID) *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetInvitedBy(v)
+	})
+}
+
+// UpdateInvitedBy sets the "invited_by" field to the value that was provided on create.
+func (u *ShopInviteUpsertBulk) UpdateInvitedBy() *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateInvitedBy()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *ShopInviteUpsertBulk) SetEmail(v string) *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *ShopInviteUpsertBulk) UpdateEmail() *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *ShopInviteUpsertBulk) SetRole(v string) *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ShopInviteUpsertBulk) UpdateRole() *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *ShopInviteUpsertBulk) SetTokenHash(v string) *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *ShopInviteUpsertBulk) UpdateTokenHash() *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ShopInviteUpsertBulk) SetExpiresAt(v time.Time) *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ShopInviteUpsertBulk) UpdateExpiresAt() *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *ShopInviteUpsertBulk) SetAcceptedAt(v time.Time) *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *ShopInviteUpsertBulk) UpdateAcceptedAt() *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *ShopInviteUpsertBulk) ClearAcceptedAt() *ShopInviteUpsertBulk {
+	return u.Update(func(s *ShopInviteUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ShopInviteUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ShopInviteCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShopInviteCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShopInviteUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sthl/ent/predicate"
+	"sthl/ent/shopinvite"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShopInviteDelete is the builder for deleting a ShopInvite entity.
+type ShopInviteDelete struct {
+	config
+	hooks    []Hook
+	mutation *ShopInviteMutation
+}
+
+// Where appends a list predicates to the ShopInviteDelete builder.
+func (sid *ShopInviteDelete) Where(ps ...predicate.ShopInvite) *ShopInviteDelete {
+	sid.mutation.Where(ps...)
+	return sid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sid *ShopInviteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, ShopInviteMutation](ctx, sid.sqlExec, sid.mutation, sid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sid *ShopInviteDelete) ExecX(ctx context.Context) int {
+	n, err := sid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sid *ShopInviteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(shopinvite.Table, sqlgraph.NewFieldSpec(shopinvite.FieldID, field.TypeUUID))
+	if ps := sid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sid.mutation.done = true
+	return affected, err
+}
+
+// ShopInviteDeleteOne is the builder for deleting a single ShopInvite entity.
+type ShopInviteDeleteOne struct {
+	sid *ShopInviteDelete
+}
+
+// Where appends a list predicates to the ShopInviteDelete builder.
+func (sido *ShopInviteDeleteOne) Where(ps ...predicate.ShopInvite) *ShopInviteDeleteOne {
+	sido.sid.mutation.Where(ps...)
+	return sido
+}
+
+// Exec executes the deletion query.
+func (sido *ShopInviteDeleteOne) Exec(ctx context.Context) error {
+	n, err := sido.sid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{shopinvite.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sido *ShopInviteDeleteOne) ExecX(ctx context.Context) {
+	if err := sido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sthl/ent/predicate"
+	"sthl/ent/shop"
+	"sthl/ent/shopinvite"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShopInviteQuery is the builder for querying ShopInvite entities.
+type ShopInviteQuery struct {
+	config
+	ctx        *QueryContext
+	order      []OrderFunc
+	inters     []Interceptor
+	predicates []predicate.ShopInvite
+	withShop   *ShopQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ShopInviteQuery builder.
+func (siq *ShopInviteQuery) Where(ps ...predicate.ShopInvite) *ShopInviteQuery {
+	siq.predicates = append(siq.predicates, ps...)
+	return siq
+}
+
+// Limit the number of records to be returned by this query.
+func (siq *ShopInviteQuery) Limit(limit int) *ShopInviteQuery {
+	siq.ctx.Limit = &limit
+	return siq
+}
+
+// Offset to start from.
+func (siq *ShopInviteQuery) Offset(offset int) *ShopInviteQuery {
+	siq.ctx.Offset = &offset
+	return siq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (siq *ShopInviteQuery) Unique(unique bool) *ShopInviteQuery {
+	siq.ctx.Unique = &unique
+	return siq
+}
+
+// Order specifies how the records should be ordered.
+func (siq *ShopInviteQuery) Order(o ...OrderFunc) *ShopInviteQuery {
+	siq.order = append(siq.order, o...)
+	return siq
+}
+
+// QueryShop chains the current query on the "shop" edge.
+func (siq *ShopInviteQuery) QueryShop() *ShopQuery {
+	query := (&ShopClient{config: siq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := siq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := siq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shopinvite.Table, shopinvite.FieldID, selector),
+			sqlgraph.To(shop.Table, shop.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shopinvite.ShopTable, shopinvite.ShopColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(siq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ShopInvite entity from the query.
+// Returns a *NotFoundError when no ShopInvite was found.
+func (siq *ShopInviteQuery) First(ctx context.Context) (*ShopInvite, error) {
+	nodes, err := siq.Limit(1).All(setContextOp(ctx, siq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{shopinvite.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (siq *ShopInviteQuery) FirstX(ctx context.Context) *ShopInvite {
+	node, err := siq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ShopInvite ID from the query.
+// Returns a *NotFoundError when no ShopInvite ID was found.
+func (siq *ShopInviteQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = siq.Limit(1).IDs(setContextOp(ctx, siq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{shopinvite.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (siq *ShopInviteQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := siq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ShopInvite entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ShopInvite entity is found.
+// Returns a *NotFoundError when no ShopInvite entities are found.
+func (siq *ShopInviteQuery) Only(ctx context.Context) (*ShopInvite, error) {
+	nodes, err := siq.Limit(2).All(setContextOp(ctx, siq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{shopinvite.Label}
+	default:
+		return nil, &NotSingularError{shopinvite.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (siq *ShopInviteQuery) OnlyX(ctx context.Context) *ShopInvite {
+	node, err := siq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ShopInvite ID in the query.
+// Returns a *NotSingularError when more than one ShopInvite ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (siq *ShopInviteQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = siq.Limit(2).IDs(setContextOp(ctx, siq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{shopinvite.Label}
+	default:
+		err = &NotSingularError{shopinvite.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (siq *ShopInviteQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := siq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ShopInvites.
+func (siq *ShopInviteQuery) All(ctx context.Context) ([]*ShopInvite, error) {
+	ctx = setContextOp(ctx, siq.ctx, "All")
+	if err := siq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ShopInvite, *ShopInviteQuery]()
+	return withInterceptors[[]*ShopInvite](ctx, siq, qr, siq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (siq *ShopInviteQuery) AllX(ctx context.Context) []*ShopInvite {
+	nodes, err := siq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ShopInvite IDs.
+func (siq *ShopInviteQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if siq.ctx.Unique == nil && siq.path != nil {
+		siq.Unique(true)
+	}
+	ctx = setContextOp(ctx, siq.ctx, "IDs")
+	if err = siq.Select(shopinvite.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (siq *ShopInviteQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := siq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (siq *ShopInviteQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, siq.ctx, "Count")
+	if err := siq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, siq, querierCount[*ShopInviteQuery](), siq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (siq *ShopInviteQuery) CountX(ctx context.Context) int {
+	count, err := siq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (siq *ShopInviteQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, siq.ctx, "Exist")
+	switch _, err := siq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (siq *ShopInviteQuery) ExistX(ctx context.Context) bool {
+	exist, err := siq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ShopInviteQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (siq *ShopInviteQuery) Clone() *ShopInviteQuery {
+	if siq == nil {
+		return nil
+	}
+	return &ShopInviteQuery{
+		config:     siq.config,
+		ctx:        siq.ctx.Clone(),
+		order:      append([]OrderFunc{}, siq.order...),
+		inters:     append([]Interceptor{}, siq.inters...),
+		predicates: append([]predicate.ShopInvite{}, siq.predicates...),
+		withShop:   siq.withShop.Clone(),
+		// clone intermediate query.
+		sql:  siq.sql.Clone(),
+		path: siq.path,
+	}
+}
+
+// WithShop tells the query-builder to eager-load the nodes that are connected to
+// the "shop" edge. The optional arguments are used to configure the query builder of the edge.
+func (siq *ShopInviteQuery) WithShop(opts ...func(*ShopQuery)) *ShopInviteQuery {
+	query := (&ShopClient{config: siq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	siq.withShop = query
+	return siq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ShopInvite.Query().
+//		GroupBy(shopinvite.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (siq *ShopInviteQuery) GroupBy(field string, fields ...string) *ShopInviteGroupBy {
+	siq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ShopInviteGroupBy{build: siq}
+	grbuild.flds = &siq.ctx.Fields
+	grbuild.label = shopinvite.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//	}
+//
+//	client.ShopInvite.Query().
+//		Select(shopinvite.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (siq *ShopInviteQuery) Select(fields ...string) *ShopInviteSelect {
+	siq.ctx.Fields = append(siq.ctx.Fields, fields...)
+	sbuild := &ShopInviteSelect{ShopInviteQuery: siq}
+	sbuild.label = shopinvite.Label
+	sbuild.flds, sbuild.scan = &siq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ShopInviteSelect configured with the given aggregations.
+func (siq *ShopInviteQuery) Aggregate(fns ...AggregateFunc) *ShopInviteSelect {
+	return siq.Select().Aggregate(fns...)
+}
+
+func (siq *ShopInviteQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range siq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, siq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range siq.ctx.Fields {
+		if !shopinvite.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if siq.path != nil {
+		prev, err := siq.path(ctx)
+		if err != nil {
+			return err
+		}
+		siq.sql = prev
+	}
+	return nil
+}
+
+func (siq *ShopInviteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ShopInvite, error) {
+	var (
+		nodes       = []*ShopInvite{}
+		_spec       = siq.querySpec()
+		loadedTypes = [1]bool{
+			siq.withShop != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ShopInvite).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ShopInvite{config: siq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(siq.modifiers) > 0 {
+		_spec.Modifiers = siq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, siq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := siq.withShop; query != nil {
+		if err := siq.loadShop(ctx, query, nodes, nil,
+			func(n *ShopInvite, e *Shop) { n.Edges.Shop = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (siq *ShopInviteQuery) loadShop(ctx context.Context, query *ShopQuery, nodes []*ShopInvite, init func(*ShopInvite), assign func(*ShopInvite, *Shop)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ShopInvite)
+	for i := range nodes {
+		fk := nodes[i].ShopID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(shop.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "shop_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (siq *ShopInviteQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := siq.querySpec()
+	if len(siq.modifiers) > 0 {
+		_spec.Modifiers = siq.modifiers
+	}
+	_spec.Node.Columns = siq.ctx.Fields
+	if len(siq.ctx.Fields) > 0 {
+		_spec.Unique = siq.ctx.Unique != nil && *siq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, siq.driver, _spec)
+}
+
+func (siq *ShopInviteQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(shopinvite.Table, shopinvite.Columns, sqlgraph.NewFieldSpec(shopinvite.FieldID, field.TypeUUID))
+	_spec.From = siq.sql
+	if unique := siq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if siq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := siq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shopinvite.FieldID)
+		for i := range fields {
+			if fields[i] != shopinvite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := siq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := siq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := siq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := siq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (siq *ShopInviteQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(siq.driver.Dialect())
+	t1 := builder.Table(shopinvite.Table)
+	columns := siq.ctx.Fields
+	if len(columns) == 0 {
+		columns = shopinvite.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if siq.sql != nil {
+		selector = siq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if siq.ctx.Unique != nil && *siq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range siq.modifiers {
+		m(selector)
+	}
+	for _, p := range siq.predicates {
+		p(selector)
+	}
+	for _, p := range siq.order {
+		p(selector)
+	}
+	if offset := siq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := siq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (siq *ShopInviteQuery) ForUpdate(opts ...sql.LockOption) *ShopInviteQuery {
+	if siq.driver.Dialect() == dialect.Postgres {
+		siq.Unique(false)
+	}
+	siq.modifiers = append(siq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return siq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (siq *ShopInviteQuery) ForShare(opts ...sql.LockOption) *ShopInviteQuery {
+	if siq.driver.Dialect() == dialect.Postgres {
+		siq.Unique(false)
+	}
+	siq.modifiers = append(siq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return siq
+}
+
+// ShopInviteGroupBy is the group-by builder for ShopInvite entities.
+type ShopInviteGroupBy struct {
+	selector
+	build *ShopInviteQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sigb *ShopInviteGroupBy) Aggregate(fns ...AggregateFunc) *ShopInviteGroupBy {
+	sigb.fns = append(sigb.fns, fns...)
+	return sigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sigb *ShopInviteGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sigb.build.ctx, "GroupBy")
+	if err := sigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShopInviteQuery, *ShopInviteGroupBy](ctx, sigb.build, sigb, sigb.build.inters, v)
+}
+
+func (sigb *ShopInviteGroupBy) sqlScan(ctx context.Context, root *ShopInviteQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sigb.fns))
+	for _, fn := range sigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sigb.flds)+len(sigb.fns))
+		for _, f := range *sigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ShopInviteSelect is the builder for selecting fields of ShopInvite entities.
+type ShopInviteSelect struct {
+	*ShopInviteQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sis *ShopInviteSelect) Aggregate(fns ...AggregateFunc) *ShopInviteSelect {
+	sis.fns = append(sis.fns, fns...)
+	return sis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sis *ShopInviteSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sis.ctx, "Select")
+	if err := sis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShopInviteQuery, *ShopInviteSelect](ctx, sis.ShopInviteQuery, sis, sis.inters, v)
+}
+
+func (sis *ShopInviteSelect) sqlScan(ctx context.Context, root *ShopInviteQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sis.fns))
+	for _, fn := range sis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
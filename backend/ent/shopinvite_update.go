@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/predicate"
+	"sthl/ent/shop"
+	"sthl/ent/shopinvite"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShopInviteUpdate is the builder for updating ShopInvite entities.
+type ShopInviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShopInviteMutation
+}
+
+// Where appends a list predicates to the ShopInviteUpdate builder.
+func (siu *ShopInviteUpdate) Where(ps ...predicate.ShopInvite) *ShopInviteUpdate {
+	siu.mutation.Where(ps...)
+	return siu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (siu *ShopInviteUpdate) SetUpdatedAt(t time.Time) *ShopInviteUpdate {
+	siu.mutation.SetUpdatedAt(t)
+	return siu
+}
+
+// SetShopID sets the "shop_id" field.
+func (siu *ShopInviteUpdate) SetShopID(u uuid.UUID) *ShopInviteUpdate {
+	siu.mutation.SetShopID(u)
+	return siu
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (siu *ShopInviteUpdate) SetInvitedBy(u uuid.UUID) *ShopInviteUpdate {
+	siu.mutation.SetInvitedBy(u)
+	return siu
+}
+
+// SetEmail sets the "email" field.
+func (siu *ShopInviteUpdate) SetEmail(s string) *ShopInviteUpdate {
+	siu.mutation.SetEmail(s)
+	return siu
+}
+
+// SetRole sets the "role" field.
+func (siu *ShopInviteUpdate) SetRole(s string) *ShopInviteUpdate {
+	siu.mutation.SetRole(s)
+	return siu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (siu *ShopInviteUpdate) SetTokenHash(s string) *ShopInviteUpdate {
+	siu.mutation.SetTokenHash(s)
+	return siu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (siu *ShopInviteUpdate) SetExpiresAt(t time.Time) *ShopInviteUpdate {
+	siu.mutation.SetExpiresAt(t)
+	return siu
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (siu *ShopInviteUpdate) SetAcceptedAt(t time.Time) *ShopInviteUpdate {
+	siu.mutation.SetAcceptedAt(t)
+	return siu
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (siu *ShopInviteUpdate) SetNillableAcceptedAt(t *time.Time) *ShopInviteUpdate {
+	if t != nil {
+		siu.SetAcceptedAt(*t)
+	}
+	return siu
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (siu *ShopInviteUpdate) ClearAcceptedAt() *ShopInviteUpdate {
+	siu.mutation.ClearAcceptedAt()
+	return siu
+}
+
+// SetShop sets the "shop" edge to the Shop entity.
+func (siu *ShopInviteUpdate) SetShop(s *Shop) *ShopInviteUpdate {
+	return siu.SetShopID(s.ID)
+}
+
+// Mutation returns the ShopInviteMutation object of the builder.
+func (siu *ShopInviteUpdate) Mutation() *ShopInviteMutation {
+	return siu.mutation
+}
+
+// ClearShop clears the "shop" edge to the Shop entity.
+func (siu *ShopInviteUpdate) ClearShop() *ShopInviteUpdate {
+	siu.mutation.ClearShop()
+	return siu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (siu *ShopInviteUpdate) Save(ctx context.Context) (int, error) {
+	siu.defaults()
+	return withHooks[int, ShopInviteMutation](ctx, siu.sqlSave, siu.mutation, siu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siu *ShopInviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := siu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (siu *ShopInviteUpdate) Exec(ctx context.Context) error {
+	_, err := siu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siu *ShopInviteUpdate) ExecX(ctx context.Context) {
+	if err := siu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (siu *ShopInviteUpdate) defaults() {
+	if _, ok := siu.mutation.UpdatedAt(); !ok {
+		v := shopinvite.UpdateDefaultUpdatedAt()
+		siu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (siu *ShopInviteUpdate) check() error {
+	if v, ok := siu.mutation.Email(); ok {
+		if err := shopinvite.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "ShopInvite.email": %w`, err)}
+		}
+	}
+	if v, ok := siu.mutation.Role(); ok {
+		if err := shopinvite.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ShopInvite.role": %w`, err)}
+		}
+	}
+	if v, ok := siu.mutation.TokenHash(); ok {
+		if err := shopinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ShopInvite.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := siu.mutation.ShopID(); siu.mutation.ShopCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShopInvite.shop"`)
+	}
+	return nil
+}
+
+func (siu *ShopInviteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := siu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shopinvite.Table, shopinvite.Columns, sqlgraph.NewFieldSpec(shopinvite.FieldID, field.TypeUUID))
+	if ps := siu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siu.mutation.UpdatedAt(); ok {
+		_spec.SetField(shopinvite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := siu.mutation.InvitedBy(); ok {
+		_spec.SetField(shopinvite.FieldInvitedBy, field.TypeUUID, value)
+	}
+	if value, ok := siu.mutation.Email(); ok {
+		_spec.SetField(shopinvite.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := siu.mutation.Role(); ok {
+		_spec.SetField(shopinvite.FieldRole, field.TypeString, value)
+	}
+	if value, ok := siu.mutation.TokenHash(); ok {
+		_spec.SetField(shopinvite.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := siu.mutation.ExpiresAt(); ok {
+		_spec.SetField(shopinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := siu.mutation.AcceptedAt(); ok {
+		_spec.SetField(shopinvite.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if siu.mutation.AcceptedAtCleared() {
+		_spec.ClearField(shopinvite.FieldAcceptedAt, field.TypeTime)
+	}
+	if siu.mutation.ShopCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopinvite.ShopTable,
+			Columns: []string{shopinvite.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siu.mutation.ShopIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopinvite.ShopTable,
+			Columns: []string{shopinvite.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, siu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shopinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	siu.mutation.done = true
+	return n, nil
+}
+
+// ShopInviteUpdateOne is the builder for updating a single ShopInvite entity.
+type ShopInviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShopInviteMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (siuo *ShopInviteUpdateOne) SetUpdatedAt(t time.Time) *ShopInviteUpdateOne {
+	siuo.mutation.SetUpdatedAt(t)
+	return siuo
+}
+
+// SetShopID sets the "shop_id" field.
+func (siuo *ShopInviteUpdateOne) SetShopID(u uuid.UUID) *ShopInviteUpdateOne {
+	siuo.mutation.SetShopID(u)
+	return siuo
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (siuo *ShopInviteUpdateOne) SetInvitedBy(u uuid.UUID) *ShopInviteUpdateOne {
+	siuo.mutation.SetInvitedBy(u)
+	return siuo
+}
+
+// SetEmail sets the "email" field.
+func (siuo *ShopInviteUpdateOne) SetEmail(s string) *ShopInviteUpdateOne {
+	siuo.mutation.SetEmail(s)
+	return siuo
+}
+
+// SetRole sets the "role" field.
+func (siuo *ShopInviteUpdateOne) SetRole(s string) *ShopInviteUpdateOne {
+	siuo.mutation.SetRole(s)
+	return siuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (siuo *ShopInviteUpdateOne) SetTokenHash(s string) *ShopInviteUpdateOne {
+	siuo.mutation.SetTokenHash(s)
+	return siuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (siuo *ShopInviteUpdateOne) SetExpiresAt(t time.Time) *ShopInviteUpdateOne {
+	siuo.mutation.SetExpiresAt(t)
+	return siuo
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (siuo *ShopInviteUpdateOne) SetAcceptedAt(t time.Time) *ShopInviteUpdateOne {
+	siuo.mutation.SetAcceptedAt(t)
+	return siuo
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (siuo *ShopInviteUpdateOne) SetNillableAcceptedAt(t *time.Time) *ShopInviteUpdateOne {
+	if t != nil {
+		siuo.SetAcceptedAt(*t)
+	}
+	return siuo
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (siuo *ShopInviteUpdateOne) ClearAcceptedAt() *ShopInviteUpdateOne {
+	siuo.mutation.ClearAcceptedAt()
+	return siuo
+}
+
+// SetShop sets the "shop" edge to the Shop entity.
+func (siuo *ShopInviteUpdateOne) SetShop(s *Shop) *ShopInviteUpdateOne {
+	return siuo.SetShopID(s.ID)
+}
+
+// Mutation returns the ShopInviteMutation object of the builder.
+func (siuo *ShopInviteUpdateOne) Mutation() *ShopInviteMutation {
+	return siuo.mutation
+}
+
+// ClearShop clears the "shop" edge to the Shop entity.
+func (siuo *ShopInviteUpdateOne) ClearShop() *ShopInviteUpdateOne {
+	siuo.mutation.ClearShop()
+	return siuo
+}
+
+// Where appends a list predicates to the ShopInviteUpdate builder.
+func (siuo *ShopInviteUpdateOne) Where(ps ...predicate.ShopInvite) *ShopInviteUpdateOne {
+	siuo.mutation.Where(ps...)
+	return siuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (siuo *ShopInviteUpdateOne) Select(field string, fields ...string) *ShopInviteUpdateOne {
+	siuo.fields = append([]string{field}, fields...)
+	return siuo
+}
+
+// Save executes the query and returns the updated ShopInvite entity.
+func (siuo *ShopInviteUpdateOne) Save(ctx context.Context) (*ShopInvite, error) {
+	siuo.defaults()
+	return withHooks[*ShopInvite, ShopInviteMutation](ctx, siuo.sqlSave, siuo.mutation, siuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (siuo *ShopInviteUpdateOne) SaveX(ctx context.Context) *ShopInvite {
+	node, err := siuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (siuo *ShopInviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := siuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (siuo *ShopInviteUpdateOne) ExecX(ctx context.Context) {
+	if err := siuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (siuo *ShopInviteUpdateOne) defaults() {
+	if _, ok := siuo.mutation.UpdatedAt(); !ok {
+		v := shopinvite.UpdateDefaultUpdatedAt()
+		siuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (siuo *ShopInviteUpdateOne) check() error {
+	if v, ok := siuo.mutation.Email(); ok {
+		if err := shopinvite.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "ShopInvite.email": %w`, err)}
+		}
+	}
+	if v, ok := siuo.mutation.Role(); ok {
+		if err := shopinvite.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ShopInvite.role": %w`, err)}
+		}
+	}
+	if v, ok := siuo.mutation.TokenHash(); ok {
+		if err := shopinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ShopInvite.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := siuo.mutation.ShopID(); siuo.mutation.ShopCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShopInvite.shop"`)
+	}
+	return nil
+}
+
+func (siuo *ShopInviteUpdateOne) sqlSave(ctx context.Context) (_node *ShopInvite, err error) {
+	if err := siuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shopinvite.Table, shopinvite.Columns, sqlgraph.NewFieldSpec(shopinvite.FieldID, field.TypeUUID))
+	id, ok := siuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShopInvite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := siuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shopinvite.FieldID)
+		for _, f := range fields {
+			if !shopinvite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shopinvite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := siuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := siuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(shopinvite.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := siuo.mutation.InvitedBy(); ok {
+		_spec.SetField(shopinvite.FieldInvitedBy, field.TypeUUID, value)
+	}
+	if value, ok := siuo.mutation.Email(); ok {
+		_spec.SetField(shopinvite.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := siuo.mutation.Role(); ok {
+		_spec.SetField(shopinvite.FieldRole, field.TypeString, value)
+	}
+	if value, ok := siuo.mutation.TokenHash(); ok {
+		_spec.SetField(shopinvite.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := siuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(shopinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := siuo.mutation.AcceptedAt(); ok {
+		_spec.SetField(shopinvite.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if siuo.mutation.AcceptedAtCleared() {
+		_spec.ClearField(shopinvite.FieldAcceptedAt, field.TypeTime)
+	}
+	if siuo.mutation.ShopCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopinvite.ShopTable,
+			Columns: []string{shopinvite.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := siuo.mutation.ShopIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopinvite.ShopTable,
+			Columns: []string{shopinvite.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShopInvite{config: siuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, siuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shopinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	siuo.mutation.done = true
+	return _node, nil
+}
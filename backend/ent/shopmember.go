@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sthl/ent/shop"
+	"sthl/ent/shopmember"
+	"sthl/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ShopMember is the model entity for the ShopMember schema.
+type ShopMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// ShopID holds the value of the "shop_id" field.
+	ShopID uuid.UUID `json:"shopId"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"userId"`
+	// Role holds the value of the "role" field.
+	Role string `json:"role"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShopMemberQuery when eager-loading is set.
+	Edges ShopMemberEdges `json:"-"`
+}
+
+// ShopMemberEdges holds the relations/edges for other nodes in the graph.
+type ShopMemberEdges struct {
+	// Shop holds the value of the shop edge.
+	Shop *Shop `json:"shop,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ShopOrErr returns the Shop value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShopMemberEdges) ShopOrErr() (*Shop, error) {
+	if e.loadedTypes[0] {
+		if e.Shop == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: shop.Label}
+		}
+		return e.Shop, nil
+	}
+	return nil, &NotLoadedError{edge: "shop"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShopMemberEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[1] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShopMember) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shopmember.FieldRole:
+			values[i] = new(sql.NullString)
+		case shopmember.FieldCreatedAt, shopmember.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case shopmember.FieldID, shopmember.FieldShopID, shopmember.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ShopMember", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShopMember fields.
+func (sm *ShopMember) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shopmember.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sm.ID = *value
+			}
+		case shopmember.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sm.CreatedAt = value.Time
+			}
+		case shopmember.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sm.UpdatedAt = value.Time
+			}
+		case shopmember.FieldShopID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field shop_id", values[i])
+			} else if value != nil {
+				sm.ShopID = *value
+			}
+		case shopmember.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				sm.UserID = *value
+			}
+		case shopmember.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				sm.Role = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryShop queries the "shop" edge of the ShopMember entity.
+func (sm *ShopMember) QueryShop() *ShopQuery {
+	return NewShopMemberClient(sm.config).QueryShop(sm)
+}
+
+// QueryUser queries the "user" edge of the ShopMember entity.
+func (sm *ShopMember) QueryUser() *UserQuery {
+	return NewShopMemberClient(sm.config).QueryUser(sm)
+}
+
+// Update returns a builder for updating this ShopMember.
+// Note that you need to call ShopMember.Unwrap() before calling this method if this ShopMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sm *ShopMember) Update() *ShopMemberUpdateOne {
+	return NewShopMemberClient(sm.config).UpdateOne(sm)
+}
+
+// Unwrap unwraps the ShopMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sm *ShopMember) Unwrap() *ShopMember {
+	_tx, ok := sm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShopMember is not a transactional entity")
+	}
+	sm.config.driver = _tx.drv
+	return sm
+}
+
+// String implements the fmt.Stringer.
+func (sm *ShopMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShopMember(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sm.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("shop_id=")
+	builder.WriteString(fmt.Sprintf("%v", sm.ShopID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", sm.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(sm.Role)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShopMembers is a parsable slice of ShopMember.
+type ShopMembers []*ShopMember
@@ -0,0 +1,759 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/shop"
+	"sthl/ent/shopmember"
+	"sthl/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShopMemberCreate is the builder for creating a ShopMember entity.
+type ShopMemberCreate struct {
+	config
+	mutation *ShopMemberMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (smc *ShopMemberCreate) SetCreatedAt(t time.Time) *ShopMemberCreate {
+	smc.mutation.SetCreatedAt(t)
+	return smc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (smc *ShopMemberCreate) SetNillableCreatedAt(t *time.Time) *ShopMemberCreate {
+	if t != nil {
+		smc.SetCreatedAt(*t)
+	}
+	return smc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (smc *ShopMemberCreate) SetUpdatedAt(t time.Time) *ShopMemberCreate {
+	smc.mutation.SetUpdatedAt(t)
+	return smc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (smc *ShopMemberCreate) SetNillableUpdatedAt(t *time.Time) *ShopMemberCreate {
+	if t != nil {
+		smc.SetUpdatedAt(*t)
+	}
+	return smc
+}
+
+// SetShopID sets the "shop_id" field.
+func (smc *ShopMemberCreate) SetShopID(u uuid.UUID) *ShopMemberCreate {
+	smc.mutation.SetShopID(u)
+	return smc
+}
+
+// SetUserID sets the "user_id" field.
+func (smc *ShopMemberCreate) SetUserID(u uuid.UUID) *ShopMemberCreate {
+	smc.mutation.SetUserID(u)
+	return smc
+}
+
+// SetRole sets the "role" field.
+func (smc *ShopMemberCreate) SetRole(s string) *ShopMemberCreate {
+	smc.mutation.SetRole(s)
+	return smc
+}
+
+// SetID sets the "id" field.
+func (smc *ShopMemberCreate) SetID(u uuid.UUID) *ShopMemberCreate {
+	smc.mutation.SetID(u)
+	return smc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (smc *ShopMemberCreate) SetNillableID(u *uuid.UUID) *ShopMemberCreate {
+	if u != nil {
+		smc.SetID(*u)
+	}
+	return smc
+}
+
+// SetShop sets the "shop" edge to the Shop entity.
+func (smc *ShopMemberCreate) SetShop(s *Shop) *ShopMemberCreate {
+	return smc.SetShopID(s.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (smc *ShopMemberCreate) SetUser(u *User) *ShopMemberCreate {
+	return smc.SetUserID(u.ID)
+}
+
+// Mutation returns the ShopMemberMutation object of the builder.
+func (smc *ShopMemberCreate) Mutation() *ShopMemberMutation {
+	return smc.mutation
+}
+
+// Save creates the ShopMember in the database.
+func (smc *ShopMemberCreate) Save(ctx context.Context) (*ShopMember, error) {
+	smc.defaults()
+	return withHooks[*ShopMember, ShopMemberMutation](ctx, smc.sqlSave, smc.mutation, smc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (smc *ShopMemberCreate) SaveX(ctx context.Context) *ShopMember {
+	v, err := smc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smc *ShopMemberCreate) Exec(ctx context.Context) error {
+	_, err := smc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smc *ShopMemberCreate) ExecX(ctx context.Context) {
+	if err := smc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smc *ShopMemberCreate) defaults() {
+	if _, ok := smc.mutation.CreatedAt(); !ok {
+		v := shopmember.DefaultCreatedAt()
+		smc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := smc.mutation.UpdatedAt(); !ok {
+		v := shopmember.DefaultUpdatedAt()
+		smc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := smc.mutation.ID(); !ok {
+		v := shopmember.DefaultID()
+		smc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smc *ShopMemberCreate) check() error {
+	if _, ok := smc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ShopMember.created_at"`)}
+	}
+	if _, ok := smc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ShopMember.updated_at"`)}
+	}
+	if _, ok := smc.mutation.ShopID(); !ok {
+		return &ValidationError{Name: "shop_id", err: errors.New(`ent: missing required field "ShopMember.shop_id"`)}
+	}
+	if _, ok := smc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ShopMember.user_id"`)}
+	}
+	if _, ok := smc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "ShopMember.role"`)}
+	}
+	if v, ok := smc.mutation.Role(); ok {
+		if err := shopmember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ShopMember.role": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.ShopID(); !ok {
+		return &ValidationError{Name: "shop", err: errors.New(`ent: missing required edge "ShopMember.shop"`)}
+	}
+	if _, ok := smc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ShopMember.user"`)}
+	}
+	return nil
+}
+
+func (smc *ShopMemberCreate) sqlSave(ctx context.Context) (*ShopMember, error) {
+	if err := smc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := smc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, smc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	smc.mutation.id = &_node.ID
+	smc.mutation.done = true
+	return _node, nil
+}
+
+func (smc *ShopMemberCreate) createSpec() (*ShopMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShopMember{config: smc.config}
+		_spec = sqlgraph.NewCreateSpec(shopmember.Table, sqlgraph.NewFieldSpec(shopmember.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = smc.conflict
+	if id, ok := smc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := smc.mutation.CreatedAt(); ok {
+		_spec.SetField(shopmember.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := smc.mutation.UpdatedAt(); ok {
+		_spec.SetField(shopmember.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := smc.mutation.Role(); ok {
+		_spec.SetField(shopmember.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if nodes := smc.mutation.ShopIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopmember.ShopTable,
+			Columns: []string{shopmember.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ShopID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := smc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopmember.UserTable,
+			Columns: []string{shopmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ShopMember.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShopMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (smc *ShopMemberCreate) OnConflict(opts ...sql.ConflictOption) *ShopMemberUpsertOne {
+	smc.conflict = opts
+	return &ShopMemberUpsertOne{
+		create: smc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ShopMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (smc *ShopMemberCreate) OnConflictColumns(columns ...string) *ShopMemberUpsertOne {
+	smc.conflict = append(smc.conflict, sql.ConflictColumns(columns...))
+	return &ShopMemberUpsertOne{
+		create: smc,
+	}
+}
+
+type (
+	// ShopMemberUpsertOne is the builder for "upsert"-ing
+	//  one ShopMember node.
+	ShopMemberUpsertOne struct {
+		create *ShopMemberCreate
+	}
+
+	// ShopMemberUpsert is the "OnConflict" setter.
+	ShopMemberUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShopMemberUpsert) SetUpdatedAt(v time.Time) *ShopMemberUpsert {
+	u.Set(shopmember.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShopMemberUpsert) UpdateUpdatedAt() *ShopMemberUpsert {
+	u.SetExcluded(shopmember.FieldUpdatedAt)
+	return u
+}
+
+// SetShopID sets the "shop_id" field.
+func (u *ShopMemberUpsert) SetShopID(v uuid.UUID) *ShopMemberUpsert {
+	u.Set(shopmember.FieldShopID, v)
+	return u
+}
+
+// UpdateShopID sets the "shop_id" field to the value that was provided on create.
+func (u *ShopMemberUpsert) UpdateShopID() *ShopMemberUpsert {
+	u.SetExcluded(shopmember.FieldShopID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ShopMemberUpsert) SetUserID(v uuid.UUID) *ShopMemberUpsert {
+	u.Set(shopmember.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ShopMemberUpsert) UpdateUserID() *ShopMemberUpsert {
+	u.SetExcluded(shopmember.FieldUserID)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *ShopMemberUpsert) SetRole(v string) *ShopMemberUpsert {
+	u.Set(shopmember.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ShopMemberUpsert) UpdateRole() *ShopMemberUpsert {
+	u.SetExcluded(shopmember.FieldRole)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ShopMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(shopmember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ShopMemberUpsertOne) UpdateNewValues() *ShopMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(shopmember.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(shopmember.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ShopMember.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ShopMemberUpsertOne) Ignore() *ShopMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShopMemberUpsertOne) DoNothing() *ShopMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShopMemberCreate.OnConflict
+// documentation for more info.
+func (u *ShopMemberUpsertOne) Update(set func(*ShopMemberUpsert)) *ShopMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShopMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShopMemberUpsertOne) SetUpdatedAt(v time.Time) *ShopMemberUpsertOne {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShopMemberUpsertOne) UpdateUpdatedAt() *ShopMemberUpsertOne {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetShopID sets the "shop_id" field.
+func (u *ShopMemberUpsertOne) SetShopID(v uuid.UUID) *ShopMemberUpsertOne {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.SetShopID(v)
+	})
+}
+
+// UpdateShopID sets the "shop_id" field to the value that was provided on create.
+func (u *ShopMemberUpsertOne) UpdateShopID() *ShopMemberUpsertOne {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.UpdateShopID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ShopMemberUpsertOne) SetUserID(v uuid.UUID) *ShopMemberUpsertOne {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ShopMemberUpsertOne) UpdateUserID() *ShopMemberUpsertOne {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *ShopMemberUpsertOne) SetRole(v string) *ShopMemberUpsertOne {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ShopMemberUpsertOne) UpdateRole() *ShopMemberUpsertOne {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// Exec executes the query.
+func (u *ShopMemberUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShopMemberCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShopMemberUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ShopMemberUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ShopMemberUpsertOne.ID is not supported by MySQL driver. Use ShopMemberUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ShopMemberUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ShopMemberCreateBulk is the builder for creating many ShopMember entities in bulk.
+type ShopMemberCreateBulk struct {
+	config
+	builders []*ShopMemberCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ShopMember entities in the database.
+func (smcb *ShopMemberCreateBulk) Save(ctx context.Context) ([]*ShopMember, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(smcb.builders))
+	nodes := make([]*ShopMember, len(smcb.builders))
+	mutators := make([]Mutator, len(smcb.builders))
+	for i := range smcb.builders {
+		func(i int, root context.Context) {
+			builder := smcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShopMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, smcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = smcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, smcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, smcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smcb *ShopMemberCreateBulk) SaveX(ctx context.Context) []*ShopMember {
+	v, err := smcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smcb *ShopMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := smcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smcb *ShopMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := smcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ShopMember.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShopMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (smcb *ShopMemberCreateBulk) OnConflict(opts ...sql.ConflictOption) *ShopMemberUpsertBulk {
+	smcb.conflict = opts
+	return &ShopMemberUpsertBulk{
+		create: smcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ShopMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (smcb *ShopMemberCreateBulk) OnConflictColumns(columns ...string) *ShopMemberUpsertBulk {
+	smcb.conflict = append(smcb.conflict, sql.ConflictColumns(columns...))
+	return &ShopMemberUpsertBulk{
+		create: smcb,
+	}
+}
+
+// ShopMemberUpsertBulk is the builder for "upsert"-ing
+// a bulk of ShopMember nodes.
+type ShopMemberUpsertBulk struct {
+	create *ShopMemberCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ShopMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(shopmember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ShopMemberUpsertBulk) UpdateNewValues() *ShopMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(shopmember.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(shopmember.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ShopMember.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ShopMemberUpsertBulk) Ignore() *ShopMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShopMemberUpsertBulk) DoNothing() *ShopMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShopMemberCreateBulk.OnConflict
+// documentation for more info.
+func (u *ShopMemberUpsertBulk) Update(set func(*ShopMemberUpsert)) *ShopMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShopMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShopMemberUpsertBulk) SetUpdatedAt(v time.Time) *ShopMemberUpsertBulk {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShopMemberUpsertBulk) UpdateUpdatedAt() *ShopMemberUpsertBulk {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetShopID sets the "shop_id" field.
+func (u *ShopMemberUpsertBulk) SetShopID(v uuid.UUID) *ShopMemberUpsertBulk {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.SetShopID(v)
+	})
+}
+
+// UpdateShopID sets the "shop_id" field to the value that was provided on create.
+func (u *ShopMemberUpsertBulk) UpdateShopID() *ShopMemberUpsertBulk {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.UpdateShopID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ShopMemberUpsertBulk) SetUserID(v uuid.UUID) *ShopMemberUpsertBulk {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ShopMemberUpsertBulk) UpdateUserID() *ShopMemberUpsertBulk {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *ShopMemberUpsertBulk) SetRole(v string) *ShopMemberUpsertBulk {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ShopMemberUpsertBulk) UpdateRole() *ShopMemberUpsertBulk {
+	return u.Update(func(s *ShopMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// Exec executes the query.
+func (u *ShopMemberUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ShopMemberCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShopMemberCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShopMemberUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sthl/ent/predicate"
+	"sthl/ent/shopmember"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ShopMemberDelete is the builder for deleting a ShopMember entity.
+type ShopMemberDelete struct {
+	config
+	hooks    []Hook
+	mutation *ShopMemberMutation
+}
+
+// Where appends a list predicates to the ShopMemberDelete builder.
+func (smd *ShopMemberDelete) Where(ps ...predicate.ShopMember) *ShopMemberDelete {
+	smd.mutation.Where(ps...)
+	return smd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (smd *ShopMemberDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, ShopMemberMutation](ctx, smd.sqlExec, smd.mutation, smd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smd *ShopMemberDelete) ExecX(ctx context.Context) int {
+	n, err := smd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (smd *ShopMemberDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(shopmember.Table, sqlgraph.NewFieldSpec(shopmember.FieldID, field.TypeUUID))
+	if ps := smd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, smd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	smd.mutation.done = true
+	return affected, err
+}
+
+// ShopMemberDeleteOne is the builder for deleting a single ShopMember entity.
+type ShopMemberDeleteOne struct {
+	smd *ShopMemberDelete
+}
+
+// Where appends a list predicates to the ShopMemberDelete builder.
+func (smdo *ShopMemberDeleteOne) Where(ps ...predicate.ShopMember) *ShopMemberDeleteOne {
+	smdo.smd.mutation.Where(ps...)
+	return smdo
+}
+
+// Exec executes the deletion query.
+func (smdo *ShopMemberDeleteOne) Exec(ctx context.Context) error {
+	n, err := smdo.smd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{shopmember.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smdo *ShopMemberDeleteOne) ExecX(ctx context.Context) {
+	if err := smdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
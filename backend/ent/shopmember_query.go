@@ -0,0 +1,712 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sthl/ent/predicate"
+	"sthl/ent/shop"
+	"sthl/ent/shopmember"
+	"sthl/ent/user"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShopMemberQuery is the builder for querying ShopMember entities.
+type ShopMemberQuery struct {
+	config
+	ctx        *QueryContext
+	order      []OrderFunc
+	inters     []Interceptor
+	predicates []predicate.ShopMember
+	withShop   *ShopQuery
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ShopMemberQuery builder.
+func (smq *ShopMemberQuery) Where(ps ...predicate.ShopMember) *ShopMemberQuery {
+	smq.predicates = append(smq.predicates, ps...)
+	return smq
+}
+
+// Limit the number of records to be returned by this query.
+func (smq *ShopMemberQuery) Limit(limit int) *ShopMemberQuery {
+	smq.ctx.Limit = &limit
+	return smq
+}
+
+// Offset to start from.
+func (smq *ShopMemberQuery) Offset(offset int) *ShopMemberQuery {
+	smq.ctx.Offset = &offset
+	return smq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (smq *ShopMemberQuery) Unique(unique bool) *ShopMemberQuery {
+	smq.ctx.Unique = &unique
+	return smq
+}
+
+// Order specifies how the records should be ordered.
+func (smq *ShopMemberQuery) Order(o ...OrderFunc) *ShopMemberQuery {
+	smq.order = append(smq.order, o...)
+	return smq
+}
+
+// QueryShop chains the current query on the "shop" edge.
+func (smq *ShopMemberQuery) QueryShop() *ShopQuery {
+	query := (&ShopClient{config: smq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := smq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := smq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shopmember.Table, shopmember.FieldID, selector),
+			sqlgraph.To(shop.Table, shop.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shopmember.ShopTable, shopmember.ShopColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(smq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (smq *ShopMemberQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: smq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := smq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := smq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shopmember.Table, shopmember.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shopmember.UserTable, shopmember.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(smq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ShopMember entity from the query.
+// Returns a *NotFoundError when no ShopMember was found.
+func (smq *ShopMemberQuery) First(ctx context.Context) (*ShopMember, error) {
+	nodes, err := smq.Limit(1).All(setContextOp(ctx, smq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{shopmember.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (smq *ShopMemberQuery) FirstX(ctx context.Context) *ShopMember {
+	node, err := smq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ShopMember ID from the query.
+// Returns a *NotFoundError when no ShopMember ID was found.
+func (smq *ShopMemberQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = smq.Limit(1).IDs(setContextOp(ctx, smq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{shopmember.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (smq *ShopMemberQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := smq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ShopMember entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ShopMember entity is found.
+// Returns a *NotFoundError when no ShopMember entities are found.
+func (smq *ShopMemberQuery) Only(ctx context.Context) (*ShopMember, error) {
+	nodes, err := smq.Limit(2).All(setContextOp(ctx, smq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{shopmember.Label}
+	default:
+		return nil, &NotSingularError{shopmember.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (smq *ShopMemberQuery) OnlyX(ctx context.Context) *ShopMember {
+	node, err := smq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ShopMember ID in the query.
+// Returns a *NotSingularError when more than one ShopMember ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (smq *ShopMemberQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = smq.Limit(2).IDs(setContextOp(ctx, smq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{shopmember.Label}
+	default:
+		err = &NotSingularError{shopmember.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (smq *ShopMemberQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := smq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ShopMembers.
+func (smq *ShopMemberQuery) All(ctx context.Context) ([]*ShopMember, error) {
+	ctx = setContextOp(ctx, smq.ctx, "All")
+	if err := smq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ShopMember, *ShopMemberQuery]()
+	return withInterceptors[[]*ShopMember](ctx, smq, qr, smq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (smq *ShopMemberQuery) AllX(ctx context.Context) []*ShopMember {
+	nodes, err := smq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ShopMember IDs.
+func (smq *ShopMemberQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if smq.ctx.Unique == nil && smq.path != nil {
+		smq.Unique(true)
+	}
+	ctx = setContextOp(ctx, smq.ctx, "IDs")
+	if err = smq.Select(shopmember.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (smq *ShopMemberQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := smq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (smq *ShopMemberQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, smq.ctx, "Count")
+	if err := smq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, smq, querierCount[*ShopMemberQuery](), smq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (smq *ShopMemberQuery) CountX(ctx context.Context) int {
+	count, err := smq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (smq *ShopMemberQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, smq.ctx, "Exist")
+	switch _, err := smq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (smq *ShopMemberQuery) ExistX(ctx context.Context) bool {
+	exist, err := smq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ShopMemberQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (smq *ShopMemberQuery) Clone() *ShopMemberQuery {
+	if smq == nil {
+		return nil
+	}
+	return &ShopMemberQuery{
+		config:     smq.config,
+		ctx:        smq.ctx.Clone(),
+		order:      append([]OrderFunc{}, smq.order...),
+		inters:     append([]Interceptor{}, smq.inters...),
+		predicates: append([]predicate.ShopMember{}, smq.predicates...),
+		withShop:   smq.withShop.Clone(),
+		withUser:   smq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  smq.sql.Clone(),
+		path: smq.path,
+	}
+}
+
+// WithShop tells the query-builder to eager-load the nodes that are connected to
+// the "shop" edge. The optional arguments are used to configure the query builder of the edge.
+func (smq *ShopMemberQuery) WithShop(opts ...func(*ShopQuery)) *ShopMemberQuery {
+	query := (&ShopClient{config: smq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	smq.withShop = query
+	return smq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (smq *ShopMemberQuery) WithUser(opts ...func(*UserQuery)) *ShopMemberQuery {
+	query := (&UserClient{config: smq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	smq.withUser = query
+	return smq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ShopMember.Query().
+//		GroupBy(shopmember.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (smq *ShopMemberQuery) GroupBy(field string, fields ...string) *ShopMemberGroupBy {
+	smq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ShopMemberGroupBy{build: smq}
+	grbuild.flds = &smq.ctx.Fields
+	grbuild.label = shopmember.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//	}
+//
+//	client.ShopMember.Query().
+//		Select(shopmember.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (smq *ShopMemberQuery) Select(fields ...string) *ShopMemberSelect {
+	smq.ctx.Fields = append(smq.ctx.Fields, fields...)
+	sbuild := &ShopMemberSelect{ShopMemberQuery: smq}
+	sbuild.label = shopmember.Label
+	sbuild.flds, sbuild.scan = &smq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ShopMemberSelect configured with the given aggregations.
+func (smq *ShopMemberQuery) Aggregate(fns ...AggregateFunc) *ShopMemberSelect {
+	return smq.Select().Aggregate(fns...)
+}
+
+func (smq *ShopMemberQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range smq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, smq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range smq.ctx.Fields {
+		if !shopmember.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if smq.path != nil {
+		prev, err := smq.path(ctx)
+		if err != nil {
+			return err
+		}
+		smq.sql = prev
+	}
+	return nil
+}
+
+func (smq *ShopMemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ShopMember, error) {
+	var (
+		nodes       = []*ShopMember{}
+		_spec       = smq.querySpec()
+		loadedTypes = [2]bool{
+			smq.withShop != nil,
+			smq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ShopMember).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ShopMember{config: smq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(smq.modifiers) > 0 {
+		_spec.Modifiers = smq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, smq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := smq.withShop; query != nil {
+		if err := smq.loadShop(ctx, query, nodes, nil,
+			func(n *ShopMember, e *Shop) { n.Edges.Shop = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := smq.withUser; query != nil {
+		if err := smq.loadUser(ctx, query, nodes, nil,
+			func(n *ShopMember, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (smq *ShopMemberQuery) loadShop(ctx context.Context, query *ShopQuery, nodes []*ShopMember, init func(*ShopMember), assign func(*ShopMember, *Shop)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ShopMember)
+	for i := range nodes {
+		fk := nodes[i].ShopID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(shop.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "shop_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (smq *ShopMemberQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ShopMember, init func(*ShopMember), assign func(*ShopMember, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ShopMember)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (smq *ShopMemberQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := smq.querySpec()
+	if len(smq.modifiers) > 0 {
+		_spec.Modifiers = smq.modifiers
+	}
+	_spec.Node.Columns = smq.ctx.Fields
+	if len(smq.ctx.Fields) > 0 {
+		_spec.Unique = smq.ctx.Unique != nil && *smq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, smq.driver, _spec)
+}
+
+func (smq *ShopMemberQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(shopmember.Table, shopmember.Columns, sqlgraph.NewFieldSpec(shopmember.FieldID, field.TypeUUID))
+	_spec.From = smq.sql
+	if unique := smq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if smq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := smq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shopmember.FieldID)
+		for i := range fields {
+			if fields[i] != shopmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := smq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := smq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := smq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := smq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (smq *ShopMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(smq.driver.Dialect())
+	t1 := builder.Table(shopmember.Table)
+	columns := smq.ctx.Fields
+	if len(columns) == 0 {
+		columns = shopmember.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if smq.sql != nil {
+		selector = smq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if smq.ctx.Unique != nil && *smq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range smq.modifiers {
+		m(selector)
+	}
+	for _, p := range smq.predicates {
+		p(selector)
+	}
+	for _, p := range smq.order {
+		p(selector)
+	}
+	if offset := smq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := smq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (smq *ShopMemberQuery) ForUpdate(opts ...sql.LockOption) *ShopMemberQuery {
+	if smq.driver.Dialect() == dialect.Postgres {
+		smq.Unique(false)
+	}
+	smq.modifiers = append(smq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return smq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (smq *ShopMemberQuery) ForShare(opts ...sql.LockOption) *ShopMemberQuery {
+	if smq.driver.Dialect() == dialect.Postgres {
+		smq.Unique(false)
+	}
+	smq.modifiers = append(smq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return smq
+}
+
+// ShopMemberGroupBy is the group-by builder for ShopMember entities.
+type ShopMemberGroupBy struct {
+	selector
+	build *ShopMemberQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (smgb *ShopMemberGroupBy) Aggregate(fns ...AggregateFunc) *ShopMemberGroupBy {
+	smgb.fns = append(smgb.fns, fns...)
+	return smgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (smgb *ShopMemberGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, smgb.build.ctx, "GroupBy")
+	if err := smgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShopMemberQuery, *ShopMemberGroupBy](ctx, smgb.build, smgb, smgb.build.inters, v)
+}
+
+func (smgb *ShopMemberGroupBy) sqlScan(ctx context.Context, root *ShopMemberQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(smgb.fns))
+	for _, fn := range smgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*smgb.flds)+len(smgb.fns))
+		for _, f := range *smgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*smgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := smgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ShopMemberSelect is the builder for selecting fields of ShopMember entities.
+type ShopMemberSelect struct {
+	*ShopMemberQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sms *ShopMemberSelect) Aggregate(fns ...AggregateFunc) *ShopMemberSelect {
+	sms.fns = append(sms.fns, fns...)
+	return sms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sms *ShopMemberSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sms.ctx, "Select")
+	if err := sms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShopMemberQuery, *ShopMemberSelect](ctx, sms.ShopMemberQuery, sms, sms.inters, v)
+}
+
+func (sms *ShopMemberSelect) sqlScan(ctx context.Context, root *ShopMemberQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sms.fns))
+	for _, fn := range sms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
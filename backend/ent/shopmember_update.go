@@ -0,0 +1,479 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/predicate"
+	"sthl/ent/shop"
+	"sthl/ent/shopmember"
+	"sthl/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShopMemberUpdate is the builder for updating ShopMember entities.
+type ShopMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShopMemberMutation
+}
+
+// Where appends a list predicates to the ShopMemberUpdate builder.
+func (smu *ShopMemberUpdate) Where(ps ...predicate.ShopMember) *ShopMemberUpdate {
+	smu.mutation.Where(ps...)
+	return smu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (smu *ShopMemberUpdate) SetUpdatedAt(t time.Time) *ShopMemberUpdate {
+	smu.mutation.SetUpdatedAt(t)
+	return smu
+}
+
+// SetShopID sets the "shop_id" field.
+func (smu *ShopMemberUpdate) SetShopID(u uuid.UUID) *ShopMemberUpdate {
+	smu.mutation.SetShopID(u)
+	return smu
+}
+
+// SetUserID sets the "user_id" field.
+func (smu *ShopMemberUpdate) SetUserID(u uuid.UUID) *ShopMemberUpdate {
+	smu.mutation.SetUserID(u)
+	return smu
+}
+
+// SetRole sets the "role" field.
+func (smu *ShopMemberUpdate) SetRole(s string) *ShopMemberUpdate {
+	smu.mutation.SetRole(s)
+	return smu
+}
+
+// SetShop sets the "shop" edge to the Shop entity.
+func (smu *ShopMemberUpdate) SetShop(s *Shop) *ShopMemberUpdate {
+	return smu.SetShopID(s.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (smu *ShopMemberUpdate) SetUser(u *User) *ShopMemberUpdate {
+	return smu.SetUserID(u.ID)
+}
+
+// Mutation returns the ShopMemberMutation object of the builder.
+func (smu *ShopMemberUpdate) Mutation() *ShopMemberMutation {
+	return smu.mutation
+}
+
+// ClearShop clears the "shop" edge to the Shop entity.
+func (smu *ShopMemberUpdate) ClearShop() *ShopMemberUpdate {
+	smu.mutation.ClearShop()
+	return smu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (smu *ShopMemberUpdate) ClearUser() *ShopMemberUpdate {
+	smu.mutation.ClearUser()
+	return smu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (smu *ShopMemberUpdate) Save(ctx context.Context) (int, error) {
+	smu.defaults()
+	return withHooks[int, ShopMemberMutation](ctx, smu.sqlSave, smu.mutation, smu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smu *ShopMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := smu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (smu *ShopMemberUpdate) Exec(ctx context.Context) error {
+	_, err := smu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smu *ShopMemberUpdate) ExecX(ctx context.Context) {
+	if err := smu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smu *ShopMemberUpdate) defaults() {
+	if _, ok := smu.mutation.UpdatedAt(); !ok {
+		v := shopmember.UpdateDefaultUpdatedAt()
+		smu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smu *ShopMemberUpdate) check() error {
+	if v, ok := smu.mutation.Role(); ok {
+		if err := shopmember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ShopMember.role": %w`, err)}
+		}
+	}
+	if _, ok := smu.mutation.ShopID(); smu.mutation.ShopCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShopMember.shop"`)
+	}
+	if _, ok := smu.mutation.UserID(); smu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShopMember.user"`)
+	}
+	return nil
+}
+
+func (smu *ShopMemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := smu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shopmember.Table, shopmember.Columns, sqlgraph.NewFieldSpec(shopmember.FieldID, field.TypeUUID))
+	if ps := smu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smu.mutation.UpdatedAt(); ok {
+		_spec.SetField(shopmember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := smu.mutation.Role(); ok {
+		_spec.SetField(shopmember.FieldRole, field.TypeString, value)
+	}
+	if smu.mutation.ShopCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopmember.ShopTable,
+			Columns: []string{shopmember.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smu.mutation.ShopIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopmember.ShopTable,
+			Columns: []string{shopmember.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if smu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopmember.UserTable,
+			Columns: []string{shopmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopmember.UserTable,
+			Columns: []string{shopmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, smu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shopmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	smu.mutation.done = true
+	return n, nil
+}
+
+// ShopMemberUpdateOne is the builder for updating a single ShopMember entity.
+type ShopMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShopMemberMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (smuo *ShopMemberUpdateOne) SetUpdatedAt(t time.Time) *ShopMemberUpdateOne {
+	smuo.mutation.SetUpdatedAt(t)
+	return smuo
+}
+
+// SetShopID sets the "shop_id" field.
+func (smuo *ShopMemberUpdateOne) SetShopID(u uuid.UUID) *ShopMemberUpdateOne {
+	smuo.mutation.SetShopID(u)
+	return smuo
+}
+
+// SetUserID sets the "user_id" field.
+func (smuo *ShopMemberUpdateOne) SetUserID(u uuid.UUID) *ShopMemberUpdateOne {
+	smuo.mutation.SetUserID(u)
+	return smuo
+}
+
+// SetRole sets the "role" field.
+func (smuo *ShopMemberUpdateOne) SetRole(s string) *ShopMemberUpdateOne {
+	smuo.mutation.SetRole(s)
+	return smuo
+}
+
+// SetShop sets the "shop" edge to the Shop entity.
+func (smuo *ShopMemberUpdateOne) SetShop(s *Shop) *ShopMemberUpdateOne {
+	return smuo.SetShopID(s.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (smuo *ShopMemberUpdateOne) SetUser(u *User) *ShopMemberUpdateOne {
+	return smuo.SetUserID(u.ID)
+}
+
+// Mutation returns the ShopMemberMutation object of the builder.
+func (smuo *ShopMemberUpdateOne) Mutation() *ShopMemberMutation {
+	return smuo.mutation
+}
+
+// ClearShop clears the "shop" edge to the Shop entity.
+func (smuo *ShopMemberUpdateOne) ClearShop() *ShopMemberUpdateOne {
+	smuo.mutation.ClearShop()
+	return smuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (smuo *ShopMemberUpdateOne) ClearUser() *ShopMemberUpdateOne {
+	smuo.mutation.ClearUser()
+	return smuo
+}
+
+// Where appends a list predicates to the ShopMemberUpdate builder.
+func (smuo *ShopMemberUpdateOne) Where(ps ...predicate.ShopMember) *ShopMemberUpdateOne {
+	smuo.mutation.Where(ps...)
+	return smuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (smuo *ShopMemberUpdateOne) Select(field string, fields ...string) *ShopMemberUpdateOne {
+	smuo.fields = append([]string{field}, fields...)
+	return smuo
+}
+
+// Save executes the query and returns the updated ShopMember entity.
+func (smuo *ShopMemberUpdateOne) Save(ctx context.Context) (*ShopMember, error) {
+	smuo.defaults()
+	return withHooks[*ShopMember, ShopMemberMutation](ctx, smuo.sqlSave, smuo.mutation, smuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smuo *ShopMemberUpdateOne) SaveX(ctx context.Context) *ShopMember {
+	node, err := smuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (smuo *ShopMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := smuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smuo *ShopMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := smuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smuo *ShopMemberUpdateOne) defaults() {
+	if _, ok := smuo.mutation.UpdatedAt(); !ok {
+		v := shopmember.UpdateDefaultUpdatedAt()
+		smuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smuo *ShopMemberUpdateOne) check() error {
+	if v, ok := smuo.mutation.Role(); ok {
+		if err := shopmember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ShopMember.role": %w`, err)}
+		}
+	}
+	if _, ok := smuo.mutation.ShopID(); smuo.mutation.ShopCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShopMember.shop"`)
+	}
+	if _, ok := smuo.mutation.UserID(); smuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ShopMember.user"`)
+	}
+	return nil
+}
+
+func (smuo *ShopMemberUpdateOne) sqlSave(ctx context.Context) (_node *ShopMember, err error) {
+	if err := smuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shopmember.Table, shopmember.Columns, sqlgraph.NewFieldSpec(shopmember.FieldID, field.TypeUUID))
+	id, ok := smuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ShopMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := smuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shopmember.FieldID)
+		for _, f := range fields {
+			if !shopmember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shopmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := smuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := smuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(shopmember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := smuo.mutation.Role(); ok {
+		_spec.SetField(shopmember.FieldRole, field.TypeString, value)
+	}
+	if smuo.mutation.ShopCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopmember.ShopTable,
+			Columns: []string{shopmember.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smuo.mutation.ShopIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopmember.ShopTable,
+			Columns: []string{shopmember.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if smuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopmember.UserTable,
+			Columns: []string{shopmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := smuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shopmember.UserTable,
+			Columns: []string{shopmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShopMember{config: smuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, smuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shopmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	smuo.mutation.done = true
+	return _node, nil
+}
@@ -32,6 +32,8 @@ type Siteui struct {
 	HomepageText string `json:"homepageText"`
 	// HomepageTextColor holds the value of the "homepageTextColor" field.
 	HomepageTextColor string `json:"homepageTextColor"`
+	// Version holds the value of the "version" field.
+	Version int64 `json:"version"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SiteuiQuery when eager-loading is set.
 	Edges SiteuiEdges `json:"-"`
@@ -64,6 +66,8 @@ func (*Siteui) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case siteui.FieldVersion:
+			values[i] = new(sql.NullInt64)
 		case siteui.FieldSitename, siteui.FieldHomepageImgUrl, siteui.FieldHomepageText, siteui.FieldHomepageTextColor:
 			values[i] = new(sql.NullString)
 		case siteui.FieldCreatedAt, siteui.FieldUpdatedAt:
@@ -133,6 +137,12 @@ func (s *Siteui) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.HomepageTextColor = value.String
 			}
+		case siteui.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				s.Version = value.Int64
+			}
 		}
 	}
 	return nil
@@ -186,6 +196,9 @@ func (s *Siteui) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("homepageTextColor=")
 	builder.WriteString(s.HomepageTextColor)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", s.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
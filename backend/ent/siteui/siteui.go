@@ -27,6 +27,8 @@ const (
 	FieldHomepageText = "homepage_text"
 	// FieldHomepageTextColor holds the string denoting the homepagetextcolor field in the database.
 	FieldHomepageTextColor = "homepage_text_color"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// Table holds the table name of the siteui in the database.
@@ -50,6 +52,7 @@ var Columns = []string{
 	FieldHomepageImgUrl,
 	FieldHomepageText,
 	FieldHomepageTextColor,
+	FieldVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -79,6 +82,8 @@ var (
 	HomepageTextValidator func(string) error
 	// HomepageTextColorValidator is a validator for the "homepageTextColor" field. It is called by the builders before save.
 	HomepageTextColorValidator func(string) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int64
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
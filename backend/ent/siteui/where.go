@@ -91,6 +91,11 @@ func HomepageTextColor(v string) predicate.Siteui {
 	return predicate.Siteui(sql.FieldEQ(FieldHomepageTextColor, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int64) predicate.Siteui {
+	return predicate.Siteui(sql.FieldEQ(FieldVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Siteui {
 	return predicate.Siteui(sql.FieldEQ(FieldCreatedAt, v))
@@ -451,6 +456,46 @@ func HomepageTextColorContainsFold(v string) predicate.Siteui {
 	return predicate.Siteui(sql.FieldContainsFold(FieldHomepageTextColor, v))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int64) predicate.Siteui {
+	return predicate.Siteui(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int64) predicate.Siteui {
+	return predicate.Siteui(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int64) predicate.Siteui {
+	return predicate.Siteui(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int64) predicate.Siteui {
+	return predicate.Siteui(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int64) predicate.Siteui {
+	return predicate.Siteui(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int64) predicate.Siteui {
+	return predicate.Siteui(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int64) predicate.Siteui {
+	return predicate.Siteui(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int64) predicate.Siteui {
+	return predicate.Siteui(sql.FieldLTE(FieldVersion, v))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Siteui {
 	return predicate.Siteui(func(s *sql.Selector) {
@@ -91,6 +91,20 @@ func (sc *SiteuiCreate) SetHomepageTextColor(s string) *SiteuiCreate {
 	return sc
 }
 
+// SetVersion sets the "version" field.
+func (sc *SiteuiCreate) SetVersion(i int64) *SiteuiCreate {
+	sc.mutation.SetVersion(i)
+	return sc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (sc *SiteuiCreate) SetNillableVersion(i *int64) *SiteuiCreate {
+	if i != nil {
+		sc.SetVersion(*i)
+	}
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SiteuiCreate) SetID(u uuid.UUID) *SiteuiCreate {
 	sc.mutation.SetID(u)
@@ -163,6 +177,10 @@ func (sc *SiteuiCreate) defaults() {
 		v := siteui.DefaultHomepageImgUrl
 		sc.mutation.SetHomepageImgUrl(v)
 	}
+	if _, ok := sc.mutation.Version(); !ok {
+		v := siteui.DefaultVersion
+		sc.mutation.SetVersion(v)
+	}
 	if _, ok := sc.mutation.ID(); !ok {
 		v := siteui.DefaultID()
 		sc.mutation.SetID(v)
@@ -212,6 +230,9 @@ func (sc *SiteuiCreate) check() error {
 			return &ValidationError{Name: "homepageTextColor", err: fmt.Errorf(`ent: validator failed for field "Siteui.homepageTextColor": %w`, err)}
 		}
 	}
+	if _, ok := sc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Siteui.version"`)}
+	}
 	if _, ok := sc.mutation.OwnerID(); !ok {
 		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Siteui.owner"`)}
 	}
@@ -275,6 +296,10 @@ func (sc *SiteuiCreate) createSpec() (*Siteui, *sqlgraph.CreateSpec) {
 		_spec.SetField(siteui.FieldHomepageTextColor, field.TypeString, value)
 		_node.HomepageTextColor = value
 	}
+	if value, ok := sc.mutation.Version(); ok {
+		_spec.SetField(siteui.FieldVersion, field.TypeInt64, value)
+		_node.Version = value
+	}
 	if nodes := sc.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -419,6 +444,24 @@ func (u *SiteuiUpsert) UpdateHomepageTextColor() *SiteuiUpsert {
 	return u
 }
 
+// SetVersion sets the "version" field.
+func (u *SiteuiUpsert) SetVersion(v int64) *SiteuiUpsert {
+	u.Set(siteui.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *SiteuiUpsert) UpdateVersion() *SiteuiUpsert {
+	u.SetExcluded(siteui.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *SiteuiUpsert) AddVersion(v int64) *SiteuiUpsert {
+	u.Add(siteui.FieldVersion, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -554,6 +597,27 @@ func (u *SiteuiUpsertOne) UpdateHomepageTextColor() *SiteuiUpsertOne {
 	})
 }
 
+// SetVersion sets the "version" field.
+func (u *SiteuiUpsertOne) SetVersion(v int64) *SiteuiUpsertOne {
+	return u.Update(func(s *SiteuiUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *SiteuiUpsertOne) AddVersion(v int64) *SiteuiUpsertOne {
+	return u.Update(func(s *SiteuiUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *SiteuiUpsertOne) UpdateVersion() *SiteuiUpsertOne {
+	return u.Update(func(s *SiteuiUpsert) {
+		s.UpdateVersion()
+	})
+}
+
 // Exec executes the query.
 func (u *SiteuiUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -852,6 +916,27 @@ func (u *SiteuiUpsertBulk) UpdateHomepageTextColor() *SiteuiUpsertBulk {
 	})
 }
 
+// SetVersion sets the "version" field.
+func (u *SiteuiUpsertBulk) SetVersion(v int64) *SiteuiUpsertBulk {
+	return u.Update(func(s *SiteuiUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *SiteuiUpsertBulk) AddVersion(v int64) *SiteuiUpsertBulk {
+	return u.Update(func(s *SiteuiUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *SiteuiUpsertBulk) UpdateVersion() *SiteuiUpsertBulk {
+	return u.Update(func(s *SiteuiUpsert) {
+		s.UpdateVersion()
+	})
+}
+
 // Exec executes the query.
 func (u *SiteuiUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
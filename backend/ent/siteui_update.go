@@ -74,6 +74,27 @@ func (su *SiteuiUpdate) SetHomepageTextColor(s string) *SiteuiUpdate {
 	return su
 }
 
+// SetVersion sets the "version" field.
+func (su *SiteuiUpdate) SetVersion(i int64) *SiteuiUpdate {
+	su.mutation.ResetVersion()
+	su.mutation.SetVersion(i)
+	return su
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (su *SiteuiUpdate) SetNillableVersion(i *int64) *SiteuiUpdate {
+	if i != nil {
+		su.SetVersion(*i)
+	}
+	return su
+}
+
+// AddVersion adds i to the "version" field.
+func (su *SiteuiUpdate) AddVersion(i int64) *SiteuiUpdate {
+	su.mutation.AddVersion(i)
+	return su
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (su *SiteuiUpdate) SetOwnerID(id uuid.UUID) *SiteuiUpdate {
 	su.mutation.SetOwnerID(id)
@@ -187,6 +208,12 @@ func (su *SiteuiUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.HomepageTextColor(); ok {
 		_spec.SetField(siteui.FieldHomepageTextColor, field.TypeString, value)
 	}
+	if value, ok := su.mutation.Version(); ok {
+		_spec.SetField(siteui.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.AddedVersion(); ok {
+		_spec.AddField(siteui.FieldVersion, field.TypeInt64, value)
+	}
 	if su.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -286,6 +313,27 @@ func (suo *SiteuiUpdateOne) SetHomepageTextColor(s string) *SiteuiUpdateOne {
 	return suo
 }
 
+// SetVersion sets the "version" field.
+func (suo *SiteuiUpdateOne) SetVersion(i int64) *SiteuiUpdateOne {
+	suo.mutation.ResetVersion()
+	suo.mutation.SetVersion(i)
+	return suo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (suo *SiteuiUpdateOne) SetNillableVersion(i *int64) *SiteuiUpdateOne {
+	if i != nil {
+		suo.SetVersion(*i)
+	}
+	return suo
+}
+
+// AddVersion adds i to the "version" field.
+func (suo *SiteuiUpdateOne) AddVersion(i int64) *SiteuiUpdateOne {
+	suo.mutation.AddVersion(i)
+	return suo
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (suo *SiteuiUpdateOne) SetOwnerID(id uuid.UUID) *SiteuiUpdateOne {
 	suo.mutation.SetOwnerID(id)
@@ -429,6 +477,12 @@ func (suo *SiteuiUpdateOne) sqlSave(ctx context.Context) (_node *Siteui, err err
 	if value, ok := suo.mutation.HomepageTextColor(); ok {
 		_spec.SetField(siteui.FieldHomepageTextColor, field.TypeString, value)
 	}
+	if value, ok := suo.mutation.Version(); ok {
+		_spec.SetField(siteui.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.AddedVersion(); ok {
+		_spec.AddField(siteui.FieldVersion, field.TypeInt64, value)
+	}
 	if suo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -12,18 +12,54 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
+	// Category is the client for interacting with the Category builders.
+	Category *CategoryClient
+	// Collection is the client for interacting with the Collection builders.
+	Collection *CollectionClient
+	// EmailVerificationToken is the client for interacting with the EmailVerificationToken builders.
+	EmailVerificationToken *EmailVerificationTokenClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
 	// Imageinfo is the client for interacting with the Imageinfo builders.
 	Imageinfo *ImageinfoClient
+	// InventoryMovement is the client for interacting with the InventoryMovement builders.
+	InventoryMovement *InventoryMovementClient
+	// LoginLockoutEvent is the client for interacting with the LoginLockoutEvent builders.
+	LoginLockoutEvent *LoginLockoutEventClient
+	// LoginThrottle is the client for interacting with the LoginThrottle builders.
+	LoginThrottle *LoginThrottleClient
+	// MfaRecoveryCode is the client for interacting with the MfaRecoveryCode builders.
+	MfaRecoveryCode *MfaRecoveryCodeClient
 	// Order is the client for interacting with the Order builders.
 	Order *OrderClient
+	// OrderEvent is the client for interacting with the OrderEvent builders.
+	OrderEvent *OrderEventClient
 	// OrderItem is the client for interacting with the OrderItem builders.
 	OrderItem *OrderItemClient
+	// PasswordResetToken is the client for interacting with the PasswordResetToken builders.
+	PasswordResetToken *PasswordResetTokenClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
+	// ProductMedia is the client for interacting with the ProductMedia builders.
+	ProductMedia *ProductMediaClient
+	// ProductVariant is the client for interacting with the ProductVariant builders.
+	ProductVariant *ProductVariantClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// Shop is the client for interacting with the Shop builders.
+	Shop *ShopClient
+	// ShopInvite is the client for interacting with the ShopInvite builders.
+	ShopInvite *ShopInviteClient
+	// ShopMember is the client for interacting with the ShopMember builders.
+	ShopMember *ShopMemberClient
 	// Siteui is the client for interacting with the Siteui builders.
 	Siteui *SiteuiClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserTotp is the client for interacting with the UserTotp builders.
+	UserTotp *UserTotpClient
 
 	// lazily loaded.
 	client     *Client
@@ -155,12 +191,30 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ApiKey = NewApiKeyClient(tx.config)
+	tx.Category = NewCategoryClient(tx.config)
+	tx.Collection = NewCollectionClient(tx.config)
+	tx.EmailVerificationToken = NewEmailVerificationTokenClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
 	tx.Imageinfo = NewImageinfoClient(tx.config)
+	tx.InventoryMovement = NewInventoryMovementClient(tx.config)
+	tx.LoginLockoutEvent = NewLoginLockoutEventClient(tx.config)
+	tx.LoginThrottle = NewLoginThrottleClient(tx.config)
+	tx.MfaRecoveryCode = NewMfaRecoveryCodeClient(tx.config)
 	tx.Order = NewOrderClient(tx.config)
+	tx.OrderEvent = NewOrderEventClient(tx.config)
 	tx.OrderItem = NewOrderItemClient(tx.config)
+	tx.PasswordResetToken = NewPasswordResetTokenClient(tx.config)
 	tx.Product = NewProductClient(tx.config)
+	tx.ProductMedia = NewProductMediaClient(tx.config)
+	tx.ProductVariant = NewProductVariantClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.Shop = NewShopClient(tx.config)
+	tx.ShopInvite = NewShopInviteClient(tx.config)
+	tx.ShopMember = NewShopMemberClient(tx.config)
 	tx.Siteui = NewSiteuiClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserTotp = NewUserTotpClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -170,7 +224,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Imageinfo.QueryXXX(), the query will be executed
+// applies a query, for example: ApiKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -4,8 +4,10 @@ package ent
 
 import (
 	"fmt"
+	"sthl/ent/shop"
 	"sthl/ent/siteui"
 	"sthl/ent/user"
+	"sthl/ent/usertotp"
 	"strings"
 	"time"
 
@@ -30,6 +32,8 @@ type User struct {
 	EmailVerified bool `json:"emailVerified"`
 	// IsArchived holds the value of the "is_archived" field.
 	IsArchived bool `json:"isArchived"`
+	// Plan holds the value of the "plan" field.
+	Plan string `json:"plan"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges UserEdges `json:"-"`
@@ -45,9 +49,27 @@ type UserEdges struct {
 	Siteui *Siteui `json:"siteui,omitempty"`
 	// Imagesinfo holds the value of the imagesinfo edge.
 	Imagesinfo []*Imageinfo `json:"imagesinfo,omitempty"`
+	// Refreshtokens holds the value of the refreshtokens edge.
+	Refreshtokens []*RefreshToken `json:"refreshtokens,omitempty"`
+	// Emailverificationtokens holds the value of the emailverificationtokens edge.
+	Emailverificationtokens []*EmailVerificationToken `json:"emailverificationtokens,omitempty"`
+	// Passwordresettokens holds the value of the passwordresettokens edge.
+	Passwordresettokens []*PasswordResetToken `json:"passwordresettokens,omitempty"`
+	// Loginlockoutevents holds the value of the loginlockoutevents edge.
+	Loginlockoutevents []*LoginLockoutEvent `json:"loginlockoutevents,omitempty"`
+	// Shop holds the value of the shop edge.
+	Shop *Shop `json:"shop,omitempty"`
+	// Shopmemberships holds the value of the shopmemberships edge.
+	Shopmemberships []*ShopMember `json:"shopmemberships,omitempty"`
+	// Apikeys holds the value of the apikeys edge.
+	Apikeys []*ApiKey `json:"apikeys,omitempty"`
+	// Totp holds the value of the totp edge.
+	Totp *UserTotp `json:"totp,omitempty"`
+	// Mfarecoverycodes holds the value of the mfarecoverycodes edge.
+	Mfarecoverycodes []*MfaRecoveryCode `json:"mfarecoverycodes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [13]bool
 }
 
 // ProductsOrErr returns the Products value or an error if the edge
@@ -90,6 +112,95 @@ func (e UserEdges) ImagesinfoOrErr() ([]*Imageinfo, error) {
 	return nil, &NotLoadedError{edge: "imagesinfo"}
 }
 
+// RefreshtokensOrErr returns the Refreshtokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RefreshtokensOrErr() ([]*RefreshToken, error) {
+	if e.loadedTypes[4] {
+		return e.Refreshtokens, nil
+	}
+	return nil, &NotLoadedError{edge: "refreshtokens"}
+}
+
+// EmailverificationtokensOrErr returns the Emailverificationtokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EmailverificationtokensOrErr() ([]*EmailVerificationToken, error) {
+	if e.loadedTypes[5] {
+		return e.Emailverificationtokens, nil
+	}
+	return nil, &NotLoadedError{edge: "emailverificationtokens"}
+}
+
+// PasswordresettokensOrErr returns the Passwordresettokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasswordresettokensOrErr() ([]*PasswordResetToken, error) {
+	if e.loadedTypes[6] {
+		return e.Passwordresettokens, nil
+	}
+	return nil, &NotLoadedError{edge: "passwordresettokens"}
+}
+
+// LoginlockouteventsOrErr returns the Loginlockoutevents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LoginlockouteventsOrErr() ([]*LoginLockoutEvent, error) {
+	if e.loadedTypes[7] {
+		return e.Loginlockoutevents, nil
+	}
+	return nil, &NotLoadedError{edge: "loginlockoutevents"}
+}
+
+// ShopOrErr returns the Shop value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) ShopOrErr() (*Shop, error) {
+	if e.loadedTypes[8] {
+		if e.Shop == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: shop.Label}
+		}
+		return e.Shop, nil
+	}
+	return nil, &NotLoadedError{edge: "shop"}
+}
+
+// ShopmembershipsOrErr returns the Shopmemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ShopmembershipsOrErr() ([]*ShopMember, error) {
+	if e.loadedTypes[9] {
+		return e.Shopmemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "shopmemberships"}
+}
+
+// ApikeysOrErr returns the Apikeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ApikeysOrErr() ([]*ApiKey, error) {
+	if e.loadedTypes[10] {
+		return e.Apikeys, nil
+	}
+	return nil, &NotLoadedError{edge: "apikeys"}
+}
+
+// TotpOrErr returns the Totp value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) TotpOrErr() (*UserTotp, error) {
+	if e.loadedTypes[11] {
+		if e.Totp == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: usertotp.Label}
+		}
+		return e.Totp, nil
+	}
+	return nil, &NotLoadedError{edge: "totp"}
+}
+
+// MfarecoverycodesOrErr returns the Mfarecoverycodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MfarecoverycodesOrErr() ([]*MfaRecoveryCode, error) {
+	if e.loadedTypes[12] {
+		return e.Mfarecoverycodes, nil
+	}
+	return nil, &NotLoadedError{edge: "mfarecoverycodes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -97,7 +208,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldEmailVerified, user.FieldIsArchived:
 			values[i] = new(sql.NullBool)
-		case user.FieldEmail, user.FieldHashedPw:
+		case user.FieldEmail, user.FieldHashedPw, user.FieldPlan:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -160,6 +271,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.IsArchived = value.Bool
 			}
+		case user.FieldPlan:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan", values[i])
+			} else if value.Valid {
+				u.Plan = value.String
+			}
 		}
 	}
 	return nil
@@ -185,6 +302,51 @@ func (u *User) QueryImagesinfo() *ImageinfoQuery {
 	return NewUserClient(u.config).QueryImagesinfo(u)
 }
 
+// QueryRefreshtokens queries the "refreshtokens" edge of the User entity.
+func (u *User) QueryRefreshtokens() *RefreshTokenQuery {
+	return NewUserClient(u.config).QueryRefreshtokens(u)
+}
+
+// QueryEmailverificationtokens queries the "emailverificationtokens" edge of the User entity.
+func (u *User) QueryEmailverificationtokens() *EmailVerificationTokenQuery {
+	return NewUserClient(u.config).QueryEmailverificationtokens(u)
+}
+
+// QueryPasswordresettokens queries the "passwordresettokens" edge of the User entity.
+func (u *User) QueryPasswordresettokens() *PasswordResetTokenQuery {
+	return NewUserClient(u.config).QueryPasswordresettokens(u)
+}
+
+// QueryLoginlockoutevents queries the "loginlockoutevents" edge of the User entity.
+func (u *User) QueryLoginlockoutevents() *LoginLockoutEventQuery {
+	return NewUserClient(u.config).QueryLoginlockoutevents(u)
+}
+
+// QueryShop queries the "shop" edge of the User entity.
+func (u *User) QueryShop() *ShopQuery {
+	return NewUserClient(u.config).QueryShop(u)
+}
+
+// QueryShopmemberships queries the "shopmemberships" edge of the User entity.
+func (u *User) QueryShopmemberships() *ShopMemberQuery {
+	return NewUserClient(u.config).QueryShopmemberships(u)
+}
+
+// QueryApikeys queries the "apikeys" edge of the User entity.
+func (u *User) QueryApikeys() *ApiKeyQuery {
+	return NewUserClient(u.config).QueryApikeys(u)
+}
+
+// QueryTotp queries the "totp" edge of the User entity.
+func (u *User) QueryTotp() *UserTotpQuery {
+	return NewUserClient(u.config).QueryTotp(u)
+}
+
+// QueryMfarecoverycodes queries the "mfarecoverycodes" edge of the User entity.
+func (u *User) QueryMfarecoverycodes() *MfaRecoveryCodeQuery {
+	return NewUserClient(u.config).QueryMfarecoverycodes(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -224,6 +386,9 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_archived=")
 	builder.WriteString(fmt.Sprintf("%v", u.IsArchived))
+	builder.WriteString(", ")
+	builder.WriteString("plan=")
+	builder.WriteString(u.Plan)
 	builder.WriteByte(')')
 	return builder.String()
 }
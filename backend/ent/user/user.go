@@ -25,6 +25,8 @@ const (
 	FieldEmailVerified = "email_verified"
 	// FieldIsArchived holds the string denoting the is_archived field in the database.
 	FieldIsArchived = "is_archived"
+	// FieldPlan holds the string denoting the plan field in the database.
+	FieldPlan = "plan"
 	// EdgeProducts holds the string denoting the products edge name in mutations.
 	EdgeProducts = "products"
 	// EdgeOrders holds the string denoting the orders edge name in mutations.
@@ -33,6 +35,24 @@ const (
 	EdgeSiteui = "siteui"
 	// EdgeImagesinfo holds the string denoting the imagesinfo edge name in mutations.
 	EdgeImagesinfo = "imagesinfo"
+	// EdgeRefreshtokens holds the string denoting the refreshtokens edge name in mutations.
+	EdgeRefreshtokens = "refreshtokens"
+	// EdgeEmailverificationtokens holds the string denoting the emailverificationtokens edge name in mutations.
+	EdgeEmailverificationtokens = "emailverificationtokens"
+	// EdgePasswordresettokens holds the string denoting the passwordresettokens edge name in mutations.
+	EdgePasswordresettokens = "passwordresettokens"
+	// EdgeLoginlockoutevents holds the string denoting the loginlockoutevents edge name in mutations.
+	EdgeLoginlockoutevents = "loginlockoutevents"
+	// EdgeShop holds the string denoting the shop edge name in mutations.
+	EdgeShop = "shop"
+	// EdgeShopmemberships holds the string denoting the shopmemberships edge name in mutations.
+	EdgeShopmemberships = "shopmemberships"
+	// EdgeApikeys holds the string denoting the apikeys edge name in mutations.
+	EdgeApikeys = "apikeys"
+	// EdgeTotp holds the string denoting the totp edge name in mutations.
+	EdgeTotp = "totp"
+	// EdgeMfarecoverycodes holds the string denoting the mfarecoverycodes edge name in mutations.
+	EdgeMfarecoverycodes = "mfarecoverycodes"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// ProductsTable is the table that holds the products relation/edge.
@@ -63,6 +83,69 @@ const (
 	ImagesinfoInverseTable = "imageinfos"
 	// ImagesinfoColumn is the table column denoting the imagesinfo relation/edge.
 	ImagesinfoColumn = "user_id"
+	// RefreshtokensTable is the table that holds the refreshtokens relation/edge.
+	RefreshtokensTable = "refresh_tokens"
+	// RefreshtokensInverseTable is the table name for the RefreshToken entity.
+	// It exists in this package in order to avoid circular dependency with the "refreshtoken" package.
+	RefreshtokensInverseTable = "refresh_tokens"
+	// RefreshtokensColumn is the table column denoting the refreshtokens relation/edge.
+	RefreshtokensColumn = "user_id"
+	// EmailverificationtokensTable is the table that holds the emailverificationtokens relation/edge.
+	EmailverificationtokensTable = "email_verification_tokens"
+	// EmailverificationtokensInverseTable is the table name for the EmailVerificationToken entity.
+	// It exists in this package in order to avoid circular dependency with the "emailverificationtoken" package.
+	EmailverificationtokensInverseTable = "email_verification_tokens"
+	// EmailverificationtokensColumn is the table column denoting the emailverificationtokens relation/edge.
+	EmailverificationtokensColumn = "user_id"
+	// PasswordresettokensTable is the table that holds the passwordresettokens relation/edge.
+	PasswordresettokensTable = "password_reset_tokens"
+	// PasswordresettokensInverseTable is the table name for the PasswordResetToken entity.
+	// It exists in this package in order to avoid circular dependency with the "passwordresettoken" package.
+	PasswordresettokensInverseTable = "password_reset_tokens"
+	// PasswordresettokensColumn is the table column denoting the passwordresettokens relation/edge.
+	PasswordresettokensColumn = "user_id"
+	// LoginlockouteventsTable is the table that holds the loginlockoutevents relation/edge.
+	LoginlockouteventsTable = "login_lockout_events"
+	// LoginlockouteventsInverseTable is the table name for the LoginLockoutEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "loginlockoutevent" package.
+	LoginlockouteventsInverseTable = "login_lockout_events"
+	// LoginlockouteventsColumn is the table column denoting the loginlockoutevents relation/edge.
+	LoginlockouteventsColumn = "user_id"
+	// ShopTable is the table that holds the shop relation/edge.
+	ShopTable = "shops"
+	// ShopInverseTable is the table name for the Shop entity.
+	// It exists in this package in order to avoid circular dependency with the "shop" package.
+	ShopInverseTable = "shops"
+	// ShopColumn is the table column denoting the shop relation/edge.
+	ShopColumn = "user_id"
+	// ShopmembershipsTable is the table that holds the shopmemberships relation/edge.
+	ShopmembershipsTable = "shop_members"
+	// ShopmembershipsInverseTable is the table name for the ShopMember entity.
+	// It exists in this package in order to avoid circular dependency with the "shopmember" package.
+	ShopmembershipsInverseTable = "shop_members"
+	// ShopmembershipsColumn is the table column denoting the shopmemberships relation/edge.
+	ShopmembershipsColumn = "user_id"
+	// ApikeysTable is the table that holds the apikeys relation/edge.
+	ApikeysTable = "api_keys"
+	// ApikeysInverseTable is the table name for the ApiKey entity.
+	// It exists in this package in order to avoid circular dependency with the "apikey" package.
+	ApikeysInverseTable = "api_keys"
+	// ApikeysColumn is the table column denoting the apikeys relation/edge.
+	ApikeysColumn = "user_id"
+	// TotpTable is the table that holds the totp relation/edge.
+	TotpTable = "user_totps"
+	// TotpInverseTable is the table name for the UserTotp entity.
+	// It exists in this package in order to avoid circular dependency with the "usertotp" package.
+	TotpInverseTable = "user_totps"
+	// TotpColumn is the table column denoting the totp relation/edge.
+	TotpColumn = "user_id"
+	// MfarecoverycodesTable is the table that holds the mfarecoverycodes relation/edge.
+	MfarecoverycodesTable = "mfa_recovery_codes"
+	// MfarecoverycodesInverseTable is the table name for the MfaRecoveryCode entity.
+	// It exists in this package in order to avoid circular dependency with the "mfarecoverycode" package.
+	MfarecoverycodesInverseTable = "mfa_recovery_codes"
+	// MfarecoverycodesColumn is the table column denoting the mfarecoverycodes relation/edge.
+	MfarecoverycodesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -74,6 +157,7 @@ var Columns = []string{
 	FieldHashedPw,
 	FieldEmailVerified,
 	FieldIsArchived,
+	FieldPlan,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -101,6 +185,10 @@ var (
 	DefaultEmailVerified bool
 	// DefaultIsArchived holds the default value on creation for the "is_archived" field.
 	DefaultIsArchived bool
+	// DefaultPlan holds the default value on creation for the "plan" field.
+	DefaultPlan string
+	// PlanValidator is a validator for the "plan" field. It is called by the builders before save.
+	PlanValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
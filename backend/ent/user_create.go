@@ -6,11 +6,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sthl/ent/apikey"
+	"sthl/ent/emailverificationtoken"
 	"sthl/ent/imageinfo"
+	"sthl/ent/loginlockoutevent"
+	"sthl/ent/mfarecoverycode"
 	"sthl/ent/order"
+	"sthl/ent/passwordresettoken"
 	"sthl/ent/product"
+	"sthl/ent/refreshtoken"
+	"sthl/ent/shop"
+	"sthl/ent/shopmember"
 	"sthl/ent/siteui"
 	"sthl/ent/user"
+	"sthl/ent/usertotp"
 	"time"
 
 	"entgo.io/ent/dialect"
@@ -96,6 +105,20 @@ func (uc *UserCreate) SetNillableIsArchived(b *bool) *UserCreate {
 	return uc
 }
 
+// SetPlan sets the "plan" field.
+func (uc *UserCreate) SetPlan(s string) *UserCreate {
+	uc.mutation.SetPlan(s)
+	return uc
+}
+
+// SetNillablePlan sets the "plan" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePlan(s *string) *UserCreate {
+	if s != nil {
+		uc.SetPlan(*s)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(u uuid.UUID) *UserCreate {
 	uc.mutation.SetID(u)
@@ -174,6 +197,149 @@ func (uc *UserCreate) AddImagesinfo(i ...*Imageinfo) *UserCreate {
 	return uc.AddImagesinfoIDs(ids...)
 }
 
+// AddRefreshtokenIDs adds the "refreshtokens" edge to the RefreshToken entity by IDs.
+func (uc *UserCreate) AddRefreshtokenIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddRefreshtokenIDs(ids...)
+	return uc
+}
+
+// AddRefreshtokens adds the "refreshtokens" edges to the RefreshToken entity.
+func (uc *UserCreate) AddRefreshtokens(r ...*RefreshToken) *UserCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRefreshtokenIDs(ids...)
+}
+
+// AddEmailverificationtokenIDs adds the "emailverificationtokens" edge to the EmailVerificationToken entity by IDs.
+func (uc *UserCreate) AddEmailverificationtokenIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddEmailverificationtokenIDs(ids...)
+	return uc
+}
+
+// AddEmailverificationtokens adds the "emailverificationtokens" edges to the EmailVerificationToken entity.
+func (uc *UserCreate) AddEmailverificationtokens(e ...*EmailVerificationToken) *UserCreate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uc.AddEmailverificationtokenIDs(ids...)
+}
+
+// AddPasswordresettokenIDs adds the "passwordresettokens" edge to the PasswordResetToken entity by IDs.
+func (uc *UserCreate) AddPasswordresettokenIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddPasswordresettokenIDs(ids...)
+	return uc
+}
+
+// AddPasswordresettokens adds the "passwordresettokens" edges to the PasswordResetToken entity.
+func (uc *UserCreate) AddPasswordresettokens(p ...*PasswordResetToken) *UserCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPasswordresettokenIDs(ids...)
+}
+
+// AddLoginlockouteventIDs adds the "loginlockoutevents" edge to the LoginLockoutEvent entity by IDs.
+func (uc *UserCreate) AddLoginlockouteventIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddLoginlockouteventIDs(ids...)
+	return uc
+}
+
+// AddLoginlockoutevents adds the "loginlockoutevents" edges to the LoginLockoutEvent entity.
+func (uc *UserCreate) AddLoginlockoutevents(l ...*LoginLockoutEvent) *UserCreate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uc.AddLoginlockouteventIDs(ids...)
+}
+
+// SetShopID sets the "shop" edge to the Shop entity by ID.
+func (uc *UserCreate) SetShopID(id uuid.UUID) *UserCreate {
+	uc.mutation.SetShopID(id)
+	return uc
+}
+
+// SetNillableShopID sets the "shop" edge to the Shop entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableShopID(id *uuid.UUID) *UserCreate {
+	if id != nil {
+		uc = uc.SetShopID(*id)
+	}
+	return uc
+}
+
+// SetShop sets the "shop" edge to the Shop entity.
+func (uc *UserCreate) SetShop(s *Shop) *UserCreate {
+	return uc.SetShopID(s.ID)
+}
+
+// AddShopmembershipIDs adds the "shopmemberships" edge to the ShopMember entity by IDs.
+func (uc *UserCreate) AddShopmembershipIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddShopmembershipIDs(ids...)
+	return uc
+}
+
+// AddShopmemberships adds the "shopmemberships" edges to the ShopMember entity.
+func (uc *UserCreate) AddShopmemberships(s ...*ShopMember) *UserCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddShopmembershipIDs(ids...)
+}
+
+// AddApikeyIDs adds the "apikeys" edge to the ApiKey entity by IDs.
+func (uc *UserCreate) AddApikeyIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddApikeyIDs(ids...)
+	return uc
+}
+
+// AddApikeys adds the "apikeys" edges to the ApiKey entity.
+func (uc *UserCreate) AddApikeys(a ...*ApiKey) *UserCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddApikeyIDs(ids...)
+}
+
+// SetTotpID sets the "totp" edge to the UserTotp entity by ID.
+func (uc *UserCreate) SetTotpID(id uuid.UUID) *UserCreate {
+	uc.mutation.SetTotpID(id)
+	return uc
+}
+
+// SetNillableTotpID sets the "totp" edge to the UserTotp entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpID(id *uuid.UUID) *UserCreate {
+	if id != nil {
+		uc = uc.SetTotpID(*id)
+	}
+	return uc
+}
+
+// SetTotp sets the "totp" edge to the UserTotp entity.
+func (uc *UserCreate) SetTotp(u *UserTotp) *UserCreate {
+	return uc.SetTotpID(u.ID)
+}
+
+// AddMfarecoverycodeIDs adds the "mfarecoverycodes" edge to the MfaRecoveryCode entity by IDs.
+func (uc *UserCreate) AddMfarecoverycodeIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddMfarecoverycodeIDs(ids...)
+	return uc
+}
+
+// AddMfarecoverycodes adds the "mfarecoverycodes" edges to the MfaRecoveryCode entity.
+func (uc *UserCreate) AddMfarecoverycodes(m ...*MfaRecoveryCode) *UserCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddMfarecoverycodeIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -225,6 +391,10 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultIsArchived
 		uc.mutation.SetIsArchived(v)
 	}
+	if _, ok := uc.mutation.Plan(); !ok {
+		v := user.DefaultPlan
+		uc.mutation.SetPlan(v)
+	}
 	if _, ok := uc.mutation.ID(); !ok {
 		v := user.DefaultID()
 		uc.mutation.SetID(v)
@@ -261,6 +431,14 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.IsArchived(); !ok {
 		return &ValidationError{Name: "is_archived", err: errors.New(`ent: missing required field "User.is_archived"`)}
 	}
+	if _, ok := uc.mutation.Plan(); !ok {
+		return &ValidationError{Name: "plan", err: errors.New(`ent: missing required field "User.plan"`)}
+	}
+	if v, ok := uc.mutation.Plan(); ok {
+		if err := user.PlanValidator(v); err != nil {
+			return &ValidationError{Name: "plan", err: fmt.Errorf(`ent: validator failed for field "User.plan": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -321,6 +499,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldIsArchived, field.TypeBool, value)
 		_node.IsArchived = value
 	}
+	if value, ok := uc.mutation.Plan(); ok {
+		_spec.SetField(user.FieldPlan, field.TypeString, value)
+		_node.Plan = value
+	}
 	if nodes := uc.mutation.ProductsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -397,6 +579,177 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.RefreshtokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshtokensTable,
+			Columns: []string{user.RefreshtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: refreshtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.EmailverificationtokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailverificationtokensTable,
+			Columns: []string{user.EmailverificationtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: emailverificationtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PasswordresettokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordresettokensTable,
+			Columns: []string{user.PasswordresettokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: passwordresettoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.LoginlockouteventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginlockouteventsTable,
+			Columns: []string{user.LoginlockouteventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: loginlockoutevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ShopIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ShopTable,
+			Columns: []string{user.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ShopmembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShopmembershipsTable,
+			Columns: []string{user.ShopmembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopmember.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ApikeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ApikeysTable,
+			Columns: []string{user.ApikeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: apikey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.TotpIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.TotpTable,
+			Columns: []string{user.TotpColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: usertotp.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.MfarecoverycodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MfarecoverycodesTable,
+			Columns: []string{user.MfarecoverycodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: mfarecoverycode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -509,6 +862,18 @@ func (u *UserUpsert) UpdateIsArchived() *UserUpsert {
 	return u
 }
 
+// SetPlan sets the "plan" field.
+func (u *UserUpsert) SetPlan(v string) *UserUpsert {
+	u.Set(user.FieldPlan, v)
+	return u
+}
+
+// UpdatePlan sets the "plan" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePlan() *UserUpsert {
+	u.SetExcluded(user.FieldPlan)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -630,6 +995,20 @@ func (u *UserUpsertOne) UpdateIsArchived() *UserUpsertOne {
 	})
 }
 
+// SetPlan sets the "plan" field.
+func (u *UserUpsertOne) SetPlan(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPlan(v)
+	})
+}
+
+// UpdatePlan sets the "plan" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePlan() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePlan()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -914,6 +1293,20 @@ func (u *UserUpsertBulk) UpdateIsArchived() *UserUpsertBulk {
 	})
 }
 
+// SetPlan sets the "plan" field.
+func (u *UserUpsertBulk) SetPlan(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPlan(v)
+	})
+}
+
+// UpdatePlan sets the "plan" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePlan() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePlan()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -7,13 +7,23 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"sthl/ent/apikey"
+	"sthl/ent/emailverificationtoken"
 	"sthl/ent/imageinfo"
+	"sthl/ent/loginlockoutevent"
+	"sthl/ent/mfarecoverycode"
 	"sthl/ent/order"
+	"sthl/ent/passwordresettoken"
 	"sthl/ent/predicate"
 	"sthl/ent/product"
+	"sthl/ent/refreshtoken"
+	"sthl/ent/shop"
+	"sthl/ent/shopmember"
 	"sthl/ent/siteui"
 	"sthl/ent/user"
+	"sthl/ent/usertotp"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -23,14 +33,24 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx            *QueryContext
-	order          []OrderFunc
-	inters         []Interceptor
-	predicates     []predicate.User
-	withProducts   *ProductQuery
-	withOrders     *OrderQuery
-	withSiteui     *SiteuiQuery
-	withImagesinfo *ImageinfoQuery
+	ctx                         *QueryContext
+	order                       []OrderFunc
+	inters                      []Interceptor
+	predicates                  []predicate.User
+	withProducts                *ProductQuery
+	withOrders                  *OrderQuery
+	withSiteui                  *SiteuiQuery
+	withImagesinfo              *ImageinfoQuery
+	withRefreshtokens           *RefreshTokenQuery
+	withEmailverificationtokens *EmailVerificationTokenQuery
+	withPasswordresettokens     *PasswordResetTokenQuery
+	withLoginlockoutevents      *LoginLockoutEventQuery
+	withShop                    *ShopQuery
+	withShopmemberships         *ShopMemberQuery
+	withApikeys                 *ApiKeyQuery
+	withTotp                    *UserTotpQuery
+	withMfarecoverycodes        *MfaRecoveryCodeQuery
+	modifiers                   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -155,6 +175,204 @@ func (uq *UserQuery) QueryImagesinfo() *ImageinfoQuery {
 	return query
 }
 
+// QueryRefreshtokens chains the current query on the "refreshtokens" edge.
+func (uq *UserQuery) QueryRefreshtokens() *RefreshTokenQuery {
+	query := (&RefreshTokenClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RefreshtokensTable, user.RefreshtokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEmailverificationtokens chains the current query on the "emailverificationtokens" edge.
+func (uq *UserQuery) QueryEmailverificationtokens() *EmailVerificationTokenQuery {
+	query := (&EmailVerificationTokenClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(emailverificationtoken.Table, emailverificationtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.EmailverificationtokensTable, user.EmailverificationtokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPasswordresettokens chains the current query on the "passwordresettokens" edge.
+func (uq *UserQuery) QueryPasswordresettokens() *PasswordResetTokenQuery {
+	query := (&PasswordResetTokenClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(passwordresettoken.Table, passwordresettoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PasswordresettokensTable, user.PasswordresettokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLoginlockoutevents chains the current query on the "loginlockoutevents" edge.
+func (uq *UserQuery) QueryLoginlockoutevents() *LoginLockoutEventQuery {
+	query := (&LoginLockoutEventClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(loginlockoutevent.Table, loginlockoutevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LoginlockouteventsTable, user.LoginlockouteventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShop chains the current query on the "shop" edge.
+func (uq *UserQuery) QueryShop() *ShopQuery {
+	query := (&ShopClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(shop.Table, shop.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.ShopTable, user.ShopColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShopmemberships chains the current query on the "shopmemberships" edge.
+func (uq *UserQuery) QueryShopmemberships() *ShopMemberQuery {
+	query := (&ShopMemberClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(shopmember.Table, shopmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ShopmembershipsTable, user.ShopmembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryApikeys chains the current query on the "apikeys" edge.
+func (uq *UserQuery) QueryApikeys() *ApiKeyQuery {
+	query := (&ApiKeyClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ApikeysTable, user.ApikeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTotp chains the current query on the "totp" edge.
+func (uq *UserQuery) QueryTotp() *UserTotpQuery {
+	query := (&UserTotpClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(usertotp.Table, usertotp.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.TotpTable, user.TotpColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMfarecoverycodes chains the current query on the "mfarecoverycodes" edge.
+func (uq *UserQuery) QueryMfarecoverycodes() *MfaRecoveryCodeQuery {
+	query := (&MfaRecoveryCodeClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(mfarecoverycode.Table, mfarecoverycode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MfarecoverycodesTable, user.MfarecoverycodesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -342,15 +560,24 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:         uq.config,
-		ctx:            uq.ctx.Clone(),
-		order:          append([]OrderFunc{}, uq.order...),
-		inters:         append([]Interceptor{}, uq.inters...),
-		predicates:     append([]predicate.User{}, uq.predicates...),
-		withProducts:   uq.withProducts.Clone(),
-		withOrders:     uq.withOrders.Clone(),
-		withSiteui:     uq.withSiteui.Clone(),
-		withImagesinfo: uq.withImagesinfo.Clone(),
+		config:                      uq.config,
+		ctx:                         uq.ctx.Clone(),
+		order:                       append([]OrderFunc{}, uq.order...),
+		inters:                      append([]Interceptor{}, uq.inters...),
+		predicates:                  append([]predicate.User{}, uq.predicates...),
+		withProducts:                uq.withProducts.Clone(),
+		withOrders:                  uq.withOrders.Clone(),
+		withSiteui:                  uq.withSiteui.Clone(),
+		withImagesinfo:              uq.withImagesinfo.Clone(),
+		withRefreshtokens:           uq.withRefreshtokens.Clone(),
+		withEmailverificationtokens: uq.withEmailverificationtokens.Clone(),
+		withPasswordresettokens:     uq.withPasswordresettokens.Clone(),
+		withLoginlockoutevents:      uq.withLoginlockoutevents.Clone(),
+		withShop:                    uq.withShop.Clone(),
+		withShopmemberships:         uq.withShopmemberships.Clone(),
+		withApikeys:                 uq.withApikeys.Clone(),
+		withTotp:                    uq.withTotp.Clone(),
+		withMfarecoverycodes:        uq.withMfarecoverycodes.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
@@ -401,6 +628,105 @@ func (uq *UserQuery) WithImagesinfo(opts ...func(*ImageinfoQuery)) *UserQuery {
 	return uq
 }
 
+// WithRefreshtokens tells the query-builder to eager-load the nodes that are connected to
+// the "refreshtokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithRefreshtokens(opts ...func(*RefreshTokenQuery)) *UserQuery {
+	query := (&RefreshTokenClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withRefreshtokens = query
+	return uq
+}
+
+// WithEmailverificationtokens tells the query-builder to eager-load the nodes that are connected to
+// the "emailverificationtokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithEmailverificationtokens(opts ...func(*EmailVerificationTokenQuery)) *UserQuery {
+	query := (&EmailVerificationTokenClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withEmailverificationtokens = query
+	return uq
+}
+
+// WithPasswordresettokens tells the query-builder to eager-load the nodes that are connected to
+// the "passwordresettokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithPasswordresettokens(opts ...func(*PasswordResetTokenQuery)) *UserQuery {
+	query := (&PasswordResetTokenClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withPasswordresettokens = query
+	return uq
+}
+
+// WithLoginlockoutevents tells the query-builder to eager-load the nodes that are connected to
+// the "loginlockoutevents" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithLoginlockoutevents(opts ...func(*LoginLockoutEventQuery)) *UserQuery {
+	query := (&LoginLockoutEventClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withLoginlockoutevents = query
+	return uq
+}
+
+// WithShop tells the query-builder to eager-load the nodes that are connected to
+// the "shop" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithShop(opts ...func(*ShopQuery)) *UserQuery {
+	query := (&ShopClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withShop = query
+	return uq
+}
+
+// WithShopmemberships tells the query-builder to eager-load the nodes that are connected to
+// the "shopmemberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithShopmemberships(opts ...func(*ShopMemberQuery)) *UserQuery {
+	query := (&ShopMemberClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withShopmemberships = query
+	return uq
+}
+
+// WithApikeys tells the query-builder to eager-load the nodes that are connected to
+// the "apikeys" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithApikeys(opts ...func(*ApiKeyQuery)) *UserQuery {
+	query := (&ApiKeyClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withApikeys = query
+	return uq
+}
+
+// WithTotp tells the query-builder to eager-load the nodes that are connected to
+// the "totp" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithTotp(opts ...func(*UserTotpQuery)) *UserQuery {
+	query := (&UserTotpClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withTotp = query
+	return uq
+}
+
+// WithMfarecoverycodes tells the query-builder to eager-load the nodes that are connected to
+// the "mfarecoverycodes" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithMfarecoverycodes(opts ...func(*MfaRecoveryCodeQuery)) *UserQuery {
+	query := (&MfaRecoveryCodeClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withMfarecoverycodes = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -479,11 +805,20 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [13]bool{
 			uq.withProducts != nil,
 			uq.withOrders != nil,
 			uq.withSiteui != nil,
 			uq.withImagesinfo != nil,
+			uq.withRefreshtokens != nil,
+			uq.withEmailverificationtokens != nil,
+			uq.withPasswordresettokens != nil,
+			uq.withLoginlockoutevents != nil,
+			uq.withShop != nil,
+			uq.withShopmemberships != nil,
+			uq.withApikeys != nil,
+			uq.withTotp != nil,
+			uq.withMfarecoverycodes != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -495,6 +830,9 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -531,6 +869,73 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withRefreshtokens; query != nil {
+		if err := uq.loadRefreshtokens(ctx, query, nodes,
+			func(n *User) { n.Edges.Refreshtokens = []*RefreshToken{} },
+			func(n *User, e *RefreshToken) { n.Edges.Refreshtokens = append(n.Edges.Refreshtokens, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withEmailverificationtokens; query != nil {
+		if err := uq.loadEmailverificationtokens(ctx, query, nodes,
+			func(n *User) { n.Edges.Emailverificationtokens = []*EmailVerificationToken{} },
+			func(n *User, e *EmailVerificationToken) {
+				n.Edges.Emailverificationtokens = append(n.Edges.Emailverificationtokens, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withPasswordresettokens; query != nil {
+		if err := uq.loadPasswordresettokens(ctx, query, nodes,
+			func(n *User) { n.Edges.Passwordresettokens = []*PasswordResetToken{} },
+			func(n *User, e *PasswordResetToken) {
+				n.Edges.Passwordresettokens = append(n.Edges.Passwordresettokens, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withLoginlockoutevents; query != nil {
+		if err := uq.loadLoginlockoutevents(ctx, query, nodes,
+			func(n *User) { n.Edges.Loginlockoutevents = []*LoginLockoutEvent{} },
+			func(n *User, e *LoginLockoutEvent) {
+				n.Edges.Loginlockoutevents = append(n.Edges.Loginlockoutevents, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withShop; query != nil {
+		if err := uq.loadShop(ctx, query, nodes, nil,
+			func(n *User, e *Shop) { n.Edges.Shop = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withShopmemberships; query != nil {
+		if err := uq.loadShopmemberships(ctx, query, nodes,
+			func(n *User) { n.Edges.Shopmemberships = []*ShopMember{} },
+			func(n *User, e *ShopMember) { n.Edges.Shopmemberships = append(n.Edges.Shopmemberships, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withApikeys; query != nil {
+		if err := uq.loadApikeys(ctx, query, nodes,
+			func(n *User) { n.Edges.Apikeys = []*ApiKey{} },
+			func(n *User, e *ApiKey) { n.Edges.Apikeys = append(n.Edges.Apikeys, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withTotp; query != nil {
+		if err := uq.loadTotp(ctx, query, nodes, nil,
+			func(n *User, e *UserTotp) { n.Edges.Totp = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withMfarecoverycodes; query != nil {
+		if err := uq.loadMfarecoverycodes(ctx, query, nodes,
+			func(n *User) { n.Edges.Mfarecoverycodes = []*MfaRecoveryCode{} },
+			func(n *User, e *MfaRecoveryCode) { n.Edges.Mfarecoverycodes = append(n.Edges.Mfarecoverycodes, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -639,9 +1044,249 @@ func (uq *UserQuery) loadImagesinfo(ctx context.Context, query *ImageinfoQuery,
 	}
 	return nil
 }
+func (uq *UserQuery) loadRefreshtokens(ctx context.Context, query *RefreshTokenQuery, nodes []*User, init func(*User), assign func(*User, *RefreshToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(user.RefreshtokensColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadEmailverificationtokens(ctx context.Context, query *EmailVerificationTokenQuery, nodes []*User, init func(*User), assign func(*User, *EmailVerificationToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.EmailVerificationToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(user.EmailverificationtokensColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadPasswordresettokens(ctx context.Context, query *PasswordResetTokenQuery, nodes []*User, init func(*User), assign func(*User, *PasswordResetToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.PasswordResetToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(user.PasswordresettokensColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadLoginlockoutevents(ctx context.Context, query *LoginLockoutEventQuery, nodes []*User, init func(*User), assign func(*User, *LoginLockoutEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.LoginLockoutEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(user.LoginlockouteventsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadShop(ctx context.Context, query *ShopQuery, nodes []*User, init func(*User), assign func(*User, *Shop)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.Where(predicate.Shop(func(s *sql.Selector) {
+		s.Where(sql.InValues(user.ShopColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadShopmemberships(ctx context.Context, query *ShopMemberQuery, nodes []*User, init func(*User), assign func(*User, *ShopMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.ShopMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(user.ShopmembershipsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadApikeys(ctx context.Context, query *ApiKeyQuery, nodes []*User, init func(*User), assign func(*User, *ApiKey)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.InValues(user.ApikeysColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadTotp(ctx context.Context, query *UserTotpQuery, nodes []*User, init func(*User), assign func(*User, *UserTotp)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.Where(predicate.UserTotp(func(s *sql.Selector) {
+		s.Where(sql.InValues(user.TotpColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadMfarecoverycodes(ctx context.Context, query *MfaRecoveryCodeQuery, nodes []*User, init func(*User), assign func(*User, *MfaRecoveryCode)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.MfaRecoveryCode(func(s *sql.Selector) {
+		s.Where(sql.InValues(user.MfarecoverycodesColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	_spec.Node.Columns = uq.ctx.Fields
 	if len(uq.ctx.Fields) > 0 {
 		_spec.Unique = uq.ctx.Unique != nil && *uq.ctx.Unique
@@ -704,6 +1349,9 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if uq.ctx.Unique != nil && *uq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range uq.modifiers {
+		m(selector)
+	}
 	for _, p := range uq.predicates {
 		p(selector)
 	}
@@ -721,6 +1369,32 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (uq *UserQuery) ForUpdate(opts ...sql.LockOption) *UserQuery {
+	if uq.driver.Dialect() == dialect.Postgres {
+		uq.Unique(false)
+	}
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return uq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (uq *UserQuery) ForShare(opts ...sql.LockOption) *UserQuery {
+	if uq.driver.Dialect() == dialect.Postgres {
+		uq.Unique(false)
+	}
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return uq
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
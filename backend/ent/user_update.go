@@ -6,12 +6,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sthl/ent/apikey"
+	"sthl/ent/emailverificationtoken"
 	"sthl/ent/imageinfo"
+	"sthl/ent/loginlockoutevent"
+	"sthl/ent/mfarecoverycode"
 	"sthl/ent/order"
+	"sthl/ent/passwordresettoken"
 	"sthl/ent/predicate"
 	"sthl/ent/product"
+	"sthl/ent/refreshtoken"
+	"sthl/ent/shop"
+	"sthl/ent/shopmember"
 	"sthl/ent/siteui"
 	"sthl/ent/user"
+	"sthl/ent/usertotp"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -79,6 +88,20 @@ func (uu *UserUpdate) SetNillableIsArchived(b *bool) *UserUpdate {
 	return uu
 }
 
+// SetPlan sets the "plan" field.
+func (uu *UserUpdate) SetPlan(s string) *UserUpdate {
+	uu.mutation.SetPlan(s)
+	return uu
+}
+
+// SetNillablePlan sets the "plan" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePlan(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetPlan(*s)
+	}
+	return uu
+}
+
 // AddProductIDs adds the "products" edge to the Product entity by IDs.
 func (uu *UserUpdate) AddProductIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.AddProductIDs(ids...)
@@ -143,6 +166,149 @@ func (uu *UserUpdate) AddImagesinfo(i ...*Imageinfo) *UserUpdate {
 	return uu.AddImagesinfoIDs(ids...)
 }
 
+// AddRefreshtokenIDs adds the "refreshtokens" edge to the RefreshToken entity by IDs.
+func (uu *UserUpdate) AddRefreshtokenIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddRefreshtokenIDs(ids...)
+	return uu
+}
+
+// AddRefreshtokens adds the "refreshtokens" edges to the RefreshToken entity.
+func (uu *UserUpdate) AddRefreshtokens(r ...*RefreshToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRefreshtokenIDs(ids...)
+}
+
+// AddEmailverificationtokenIDs adds the "emailverificationtokens" edge to the EmailVerificationToken entity by IDs.
+func (uu *UserUpdate) AddEmailverificationtokenIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddEmailverificationtokenIDs(ids...)
+	return uu
+}
+
+// AddEmailverificationtokens adds the "emailverificationtokens" edges to the EmailVerificationToken entity.
+func (uu *UserUpdate) AddEmailverificationtokens(e ...*EmailVerificationToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uu.AddEmailverificationtokenIDs(ids...)
+}
+
+// AddPasswordresettokenIDs adds the "passwordresettokens" edge to the PasswordResetToken entity by IDs.
+func (uu *UserUpdate) AddPasswordresettokenIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddPasswordresettokenIDs(ids...)
+	return uu
+}
+
+// AddPasswordresettokens adds the "passwordresettokens" edges to the PasswordResetToken entity.
+func (uu *UserUpdate) AddPasswordresettokens(p ...*PasswordResetToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddPasswordresettokenIDs(ids...)
+}
+
+// AddLoginlockouteventIDs adds the "loginlockoutevents" edge to the LoginLockoutEvent entity by IDs.
+func (uu *UserUpdate) AddLoginlockouteventIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddLoginlockouteventIDs(ids...)
+	return uu
+}
+
+// AddLoginlockoutevents adds the "loginlockoutevents" edges to the LoginLockoutEvent entity.
+func (uu *UserUpdate) AddLoginlockoutevents(l ...*LoginLockoutEvent) *UserUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uu.AddLoginlockouteventIDs(ids...)
+}
+
+// SetShopID sets the "shop" edge to the Shop entity by ID.
+func (uu *UserUpdate) SetShopID(id uuid.UUID) *UserUpdate {
+	uu.mutation.SetShopID(id)
+	return uu
+}
+
+// SetNillableShopID sets the "shop" edge to the Shop entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableShopID(id *uuid.UUID) *UserUpdate {
+	if id != nil {
+		uu = uu.SetShopID(*id)
+	}
+	return uu
+}
+
+// SetShop sets the "shop" edge to the Shop entity.
+func (uu *UserUpdate) SetShop(s *Shop) *UserUpdate {
+	return uu.SetShopID(s.ID)
+}
+
+// AddShopmembershipIDs adds the "shopmemberships" edge to the ShopMember entity by IDs.
+func (uu *UserUpdate) AddShopmembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddShopmembershipIDs(ids...)
+	return uu
+}
+
+// AddShopmemberships adds the "shopmemberships" edges to the ShopMember entity.
+func (uu *UserUpdate) AddShopmemberships(s ...*ShopMember) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddShopmembershipIDs(ids...)
+}
+
+// AddApikeyIDs adds the "apikeys" edge to the ApiKey entity by IDs.
+func (uu *UserUpdate) AddApikeyIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddApikeyIDs(ids...)
+	return uu
+}
+
+// AddApikeys adds the "apikeys" edges to the ApiKey entity.
+func (uu *UserUpdate) AddApikeys(a ...*ApiKey) *UserUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddApikeyIDs(ids...)
+}
+
+// SetTotpID sets the "totp" edge to the UserTotp entity by ID.
+func (uu *UserUpdate) SetTotpID(id uuid.UUID) *UserUpdate {
+	uu.mutation.SetTotpID(id)
+	return uu
+}
+
+// SetNillableTotpID sets the "totp" edge to the UserTotp entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpID(id *uuid.UUID) *UserUpdate {
+	if id != nil {
+		uu = uu.SetTotpID(*id)
+	}
+	return uu
+}
+
+// SetTotp sets the "totp" edge to the UserTotp entity.
+func (uu *UserUpdate) SetTotp(u *UserTotp) *UserUpdate {
+	return uu.SetTotpID(u.ID)
+}
+
+// AddMfarecoverycodeIDs adds the "mfarecoverycodes" edge to the MfaRecoveryCode entity by IDs.
+func (uu *UserUpdate) AddMfarecoverycodeIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddMfarecoverycodeIDs(ids...)
+	return uu
+}
+
+// AddMfarecoverycodes adds the "mfarecoverycodes" edges to the MfaRecoveryCode entity.
+func (uu *UserUpdate) AddMfarecoverycodes(m ...*MfaRecoveryCode) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddMfarecoverycodeIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -217,6 +383,165 @@ func (uu *UserUpdate) RemoveImagesinfo(i ...*Imageinfo) *UserUpdate {
 	return uu.RemoveImagesinfoIDs(ids...)
 }
 
+// ClearRefreshtokens clears all "refreshtokens" edges to the RefreshToken entity.
+func (uu *UserUpdate) ClearRefreshtokens() *UserUpdate {
+	uu.mutation.ClearRefreshtokens()
+	return uu
+}
+
+// RemoveRefreshtokenIDs removes the "refreshtokens" edge to RefreshToken entities by IDs.
+func (uu *UserUpdate) RemoveRefreshtokenIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveRefreshtokenIDs(ids...)
+	return uu
+}
+
+// RemoveRefreshtokens removes "refreshtokens" edges to RefreshToken entities.
+func (uu *UserUpdate) RemoveRefreshtokens(r ...*RefreshToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveRefreshtokenIDs(ids...)
+}
+
+// ClearEmailverificationtokens clears all "emailverificationtokens" edges to the EmailVerificationToken entity.
+func (uu *UserUpdate) ClearEmailverificationtokens() *UserUpdate {
+	uu.mutation.ClearEmailverificationtokens()
+	return uu
+}
+
+// RemoveEmailverificationtokenIDs removes the "emailverificationtokens" edge to EmailVerificationToken entities by IDs.
+func (uu *UserUpdate) RemoveEmailverificationtokenIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveEmailverificationtokenIDs(ids...)
+	return uu
+}
+
+// RemoveEmailverificationtokens removes "emailverificationtokens" edges to EmailVerificationToken entities.
+func (uu *UserUpdate) RemoveEmailverificationtokens(e ...*EmailVerificationToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uu.RemoveEmailverificationtokenIDs(ids...)
+}
+
+// ClearPasswordresettokens clears all "passwordresettokens" edges to the PasswordResetToken entity.
+func (uu *UserUpdate) ClearPasswordresettokens() *UserUpdate {
+	uu.mutation.ClearPasswordresettokens()
+	return uu
+}
+
+// RemovePasswordresettokenIDs removes the "passwordresettokens" edge to PasswordResetToken entities by IDs.
+func (uu *UserUpdate) RemovePasswordresettokenIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemovePasswordresettokenIDs(ids...)
+	return uu
+}
+
+// RemovePasswordresettokens removes "passwordresettokens" edges to PasswordResetToken entities.
+func (uu *UserUpdate) RemovePasswordresettokens(p ...*PasswordResetToken) *UserUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.RemovePasswordresettokenIDs(ids...)
+}
+
+// ClearLoginlockoutevents clears all "loginlockoutevents" edges to the LoginLockoutEvent entity.
+func (uu *UserUpdate) ClearLoginlockoutevents() *UserUpdate {
+	uu.mutation.ClearLoginlockoutevents()
+	return uu
+}
+
+// RemoveLoginlockouteventIDs removes the "loginlockoutevents" edge to LoginLockoutEvent entities by IDs.
+func (uu *UserUpdate) RemoveLoginlockouteventIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveLoginlockouteventIDs(ids...)
+	return uu
+}
+
+// RemoveLoginlockoutevents removes "loginlockoutevents" edges to LoginLockoutEvent entities.
+func (uu *UserUpdate) RemoveLoginlockoutevents(l ...*LoginLockoutEvent) *UserUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uu.RemoveLoginlockouteventIDs(ids...)
+}
+
+// ClearShop clears the "shop" edge to the Shop entity.
+func (uu *UserUpdate) ClearShop() *UserUpdate {
+	uu.mutation.ClearShop()
+	return uu
+}
+
+// ClearShopmemberships clears all "shopmemberships" edges to the ShopMember entity.
+func (uu *UserUpdate) ClearShopmemberships() *UserUpdate {
+	uu.mutation.ClearShopmemberships()
+	return uu
+}
+
+// RemoveShopmembershipIDs removes the "shopmemberships" edge to ShopMember entities by IDs.
+func (uu *UserUpdate) RemoveShopmembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveShopmembershipIDs(ids...)
+	return uu
+}
+
+// RemoveShopmemberships removes "shopmemberships" edges to ShopMember entities.
+func (uu *UserUpdate) RemoveShopmemberships(s ...*ShopMember) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveShopmembershipIDs(ids...)
+}
+
+// ClearApikeys clears all "apikeys" edges to the ApiKey entity.
+func (uu *UserUpdate) ClearApikeys() *UserUpdate {
+	uu.mutation.ClearApikeys()
+	return uu
+}
+
+// RemoveApikeyIDs removes the "apikeys" edge to ApiKey entities by IDs.
+func (uu *UserUpdate) RemoveApikeyIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveApikeyIDs(ids...)
+	return uu
+}
+
+// RemoveApikeys removes "apikeys" edges to ApiKey entities.
+func (uu *UserUpdate) RemoveApikeys(a ...*ApiKey) *UserUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveApikeyIDs(ids...)
+}
+
+// ClearTotp clears the "totp" edge to the UserTotp entity.
+func (uu *UserUpdate) ClearTotp() *UserUpdate {
+	uu.mutation.ClearTotp()
+	return uu
+}
+
+// ClearMfarecoverycodes clears all "mfarecoverycodes" edges to the MfaRecoveryCode entity.
+func (uu *UserUpdate) ClearMfarecoverycodes() *UserUpdate {
+	uu.mutation.ClearMfarecoverycodes()
+	return uu
+}
+
+// RemoveMfarecoverycodeIDs removes the "mfarecoverycodes" edge to MfaRecoveryCode entities by IDs.
+func (uu *UserUpdate) RemoveMfarecoverycodeIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveMfarecoverycodeIDs(ids...)
+	return uu
+}
+
+// RemoveMfarecoverycodes removes "mfarecoverycodes" edges to MfaRecoveryCode entities.
+func (uu *UserUpdate) RemoveMfarecoverycodes(m ...*MfaRecoveryCode) *UserUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveMfarecoverycodeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	uu.defaults()
@@ -265,6 +590,11 @@ func (uu *UserUpdate) check() error {
 			return &ValidationError{Name: "hashed_pw", err: fmt.Errorf(`ent: validator failed for field "User.hashed_pw": %w`, err)}
 		}
 	}
+	if v, ok := uu.mutation.Plan(); ok {
+		if err := user.PlanValidator(v); err != nil {
+			return &ValidationError{Name: "plan", err: fmt.Errorf(`ent: validator failed for field "User.plan": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -295,6 +625,9 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.IsArchived(); ok {
 		_spec.SetField(user.FieldIsArchived, field.TypeBool, value)
 	}
+	if value, ok := uu.mutation.Plan(); ok {
+		_spec.SetField(user.FieldPlan, field.TypeString, value)
+	}
 	if uu.mutation.ProductsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -492,345 +825,1565 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.RefreshtokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshtokensTable,
+			Columns: []string{user.RefreshtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: refreshtoken.FieldID,
+				},
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetUpdatedAt(t)
-	return uuo
-}
-
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
-}
-
-// SetHashedPw sets the "hashed_pw" field.
-func (uuo *UserUpdateOne) SetHashedPw(s string) *UserUpdateOne {
-	uuo.mutation.SetHashedPw(s)
-	return uuo
-}
-
-// SetEmailVerified sets the "email_verified" field.
-func (uuo *UserUpdateOne) SetEmailVerified(b bool) *UserUpdateOne {
-	uuo.mutation.SetEmailVerified(b)
-	return uuo
-}
-
-// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmailVerified(b *bool) *UserUpdateOne {
-	if b != nil {
-		uuo.SetEmailVerified(*b)
+	if nodes := uu.mutation.RemovedRefreshtokensIDs(); len(nodes) > 0 && !uu.mutation.RefreshtokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshtokensTable,
+			Columns: []string{user.RefreshtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: refreshtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetIsArchived sets the "is_archived" field.
-func (uuo *UserUpdateOne) SetIsArchived(b bool) *UserUpdateOne {
-	uuo.mutation.SetIsArchived(b)
-	return uuo
-}
-
-// SetNillableIsArchived sets the "is_archived" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableIsArchived(b *bool) *UserUpdateOne {
-	if b != nil {
-		uuo.SetIsArchived(*b)
+	if nodes := uu.mutation.RefreshtokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshtokensTable,
+			Columns: []string{user.RefreshtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: refreshtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// AddProductIDs adds the "products" edge to the Product entity by IDs.
-func (uuo *UserUpdateOne) AddProductIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddProductIDs(ids...)
-	return uuo
-}
-
-// AddProducts adds the "products" edges to the Product entity.
-func (uuo *UserUpdateOne) AddProducts(p ...*Product) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if uu.mutation.EmailverificationtokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailverificationtokensTable,
+			Columns: []string{user.EmailverificationtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: emailverificationtoken.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddProductIDs(ids...)
-}
-
-// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
-func (uuo *UserUpdateOne) AddOrderIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.AddOrderIDs(ids...)
-	return uuo
-}
-
-// AddOrders adds the "orders" edges to the Order entity.
-func (uuo *UserUpdateOne) AddOrders(o ...*Order) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(o))
-	for i := range o {
-		ids[i] = o[i].ID
+	if nodes := uu.mutation.RemovedEmailverificationtokensIDs(); len(nodes) > 0 && !uu.mutation.EmailverificationtokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailverificationtokensTable,
+			Columns: []string{user.EmailverificationtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: emailverificationtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddOrderIDs(ids...)
-}
-
-// SetSiteuiID sets the "siteui" edge to the Siteui entity by ID.
-func (uuo *UserUpdateOne) SetSiteuiID(id uuid.UUID) *UserUpdateOne {
-	uuo.mutation.SetSiteuiID(id)
-	return uuo
-}
-
-// SetNillableSiteuiID sets the "siteui" edge to the Siteui entity by ID if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableSiteuiID(id *uuid.UUID) *UserUpdateOne {
-	if id != nil {
-		uuo = uuo.SetSiteuiID(*id)
+	if nodes := uu.mutation.EmailverificationtokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailverificationtokensTable,
+			Columns: []string{user.EmailverificationtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: emailverificationtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetSiteui sets the "siteui" edge to the Siteui entity.
-func (uuo *UserUpdateOne) SetSiteui(s *Siteui) *UserUpdateOne {
-	return uuo.SetSiteuiID(s.ID)
-}
-
-// AddImagesinfoIDs adds the "imagesinfo" edge to the Imageinfo entity by IDs.
-func (uuo *UserUpdateOne) AddImagesinfoIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddImagesinfoIDs(ids...)
-	return uuo
-}
-
-// AddImagesinfo adds the "imagesinfo" edges to the Imageinfo entity.
-func (uuo *UserUpdateOne) AddImagesinfo(i ...*Imageinfo) *UserUpdateOne {
-	ids := make([]int, len(i))
-	for j := range i {
-		ids[j] = i[j].ID
+	if uu.mutation.PasswordresettokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordresettokensTable,
+			Columns: []string{user.PasswordresettokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: passwordresettoken.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddImagesinfoIDs(ids...)
-}
-
-// Mutation returns the UserMutation object of the builder.
-func (uuo *UserUpdateOne) Mutation() *UserMutation {
-	return uuo.mutation
-}
-
-// ClearProducts clears all "products" edges to the Product entity.
-func (uuo *UserUpdateOne) ClearProducts() *UserUpdateOne {
-	uuo.mutation.ClearProducts()
-	return uuo
-}
-
-// RemoveProductIDs removes the "products" edge to Product entities by IDs.
-func (uuo *UserUpdateOne) RemoveProductIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveProductIDs(ids...)
-	return uuo
-}
-
-// RemoveProducts removes "products" edges to Product entities.
-func (uuo *UserUpdateOne) RemoveProducts(p ...*Product) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if nodes := uu.mutation.RemovedPasswordresettokensIDs(); len(nodes) > 0 && !uu.mutation.PasswordresettokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordresettokensTable,
+			Columns: []string{user.PasswordresettokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: passwordresettoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveProductIDs(ids...)
-}
-
-// ClearOrders clears all "orders" edges to the Order entity.
-func (uuo *UserUpdateOne) ClearOrders() *UserUpdateOne {
-	uuo.mutation.ClearOrders()
-	return uuo
-}
-
-// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
-func (uuo *UserUpdateOne) RemoveOrderIDs(ids ...uuid.UUID) *UserUpdateOne {
-	uuo.mutation.RemoveOrderIDs(ids...)
-	return uuo
-}
-
-// RemoveOrders removes "orders" edges to Order entities.
-func (uuo *UserUpdateOne) RemoveOrders(o ...*Order) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(o))
-	for i := range o {
-		ids[i] = o[i].ID
+	if nodes := uu.mutation.PasswordresettokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordresettokensTable,
+			Columns: []string{user.PasswordresettokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: passwordresettoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveOrderIDs(ids...)
-}
-
-// ClearSiteui clears the "siteui" edge to the Siteui entity.
-func (uuo *UserUpdateOne) ClearSiteui() *UserUpdateOne {
-	uuo.mutation.ClearSiteui()
-	return uuo
-}
-
-// ClearImagesinfo clears all "imagesinfo" edges to the Imageinfo entity.
-func (uuo *UserUpdateOne) ClearImagesinfo() *UserUpdateOne {
-	uuo.mutation.ClearImagesinfo()
-	return uuo
-}
-
-// RemoveImagesinfoIDs removes the "imagesinfo" edge to Imageinfo entities by IDs.
-func (uuo *UserUpdateOne) RemoveImagesinfoIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveImagesinfoIDs(ids...)
-	return uuo
-}
-
-// RemoveImagesinfo removes "imagesinfo" edges to Imageinfo entities.
-func (uuo *UserUpdateOne) RemoveImagesinfo(i ...*Imageinfo) *UserUpdateOne {
-	ids := make([]int, len(i))
-	for j := range i {
-		ids[j] = i[j].ID
+	if uu.mutation.LoginlockouteventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginlockouteventsTable,
+			Columns: []string{user.LoginlockouteventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: loginlockoutevent.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveImagesinfoIDs(ids...)
-}
-
-// Where appends a list predicates to the UserUpdate builder.
-func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
-	uuo.mutation.Where(ps...)
-	return uuo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
-	uuo.fields = append([]string{field}, fields...)
-	return uuo
-}
-
-// Save executes the query and returns the updated User entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	uuo.defaults()
-	return withHooks[*User, UserMutation](ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	node, err := uuo.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := uu.mutation.RemovedLoginlockouteventsIDs(); len(nodes) > 0 && !uu.mutation.LoginlockouteventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginlockouteventsTable,
+			Columns: []string{user.LoginlockouteventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: loginlockoutevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
-	return err
+	if nodes := uu.mutation.LoginlockouteventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginlockouteventsTable,
+			Columns: []string{user.LoginlockouteventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: loginlockoutevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ShopCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ShopTable,
+			Columns: []string{user.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ShopIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ShopTable,
+			Columns: []string{user.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ShopmembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShopmembershipsTable,
+			Columns: []string{user.ShopmembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopmember.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedShopmembershipsIDs(); len(nodes) > 0 && !uu.mutation.ShopmembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShopmembershipsTable,
+			Columns: []string{user.ShopmembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopmember.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ShopmembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShopmembershipsTable,
+			Columns: []string{user.ShopmembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shopmember.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ApikeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ApikeysTable,
+			Columns: []string{user.ApikeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: apikey.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedApikeysIDs(); len(nodes) > 0 && !uu.mutation.ApikeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ApikeysTable,
+			Columns: []string{user.ApikeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: apikey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ApikeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ApikeysTable,
+			Columns: []string{user.ApikeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: apikey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.TotpCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.TotpTable,
+			Columns: []string{user.TotpColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: usertotp.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.TotpIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.TotpTable,
+			Columns: []string{user.TotpColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: usertotp.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.MfarecoverycodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MfarecoverycodesTable,
+			Columns: []string{user.MfarecoverycodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: mfarecoverycode.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedMfarecoverycodesIDs(); len(nodes) > 0 && !uu.mutation.MfarecoverycodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MfarecoverycodesTable,
+			Columns: []string{user.MfarecoverycodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: mfarecoverycode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.MfarecoverycodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MfarecoverycodesTable,
+			Columns: []string{user.MfarecoverycodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: mfarecoverycode.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
-		panic(err)
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetUpdatedAt(t)
+	return uuo
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetHashedPw sets the "hashed_pw" field.
+func (uuo *UserUpdateOne) SetHashedPw(s string) *UserUpdateOne {
+	uuo.mutation.SetHashedPw(s)
+	return uuo
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (uuo *UserUpdateOne) SetEmailVerified(b bool) *UserUpdateOne {
+	uuo.mutation.SetEmailVerified(b)
+	return uuo
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmailVerified(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetEmailVerified(*b)
+	}
+	return uuo
+}
+
+// SetIsArchived sets the "is_archived" field.
+func (uuo *UserUpdateOne) SetIsArchived(b bool) *UserUpdateOne {
+	uuo.mutation.SetIsArchived(b)
+	return uuo
+}
+
+// SetNillableIsArchived sets the "is_archived" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIsArchived(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetIsArchived(*b)
+	}
+	return uuo
+}
+
+// SetPlan sets the "plan" field.
+func (uuo *UserUpdateOne) SetPlan(s string) *UserUpdateOne {
+	uuo.mutation.SetPlan(s)
+	return uuo
+}
+
+// SetNillablePlan sets the "plan" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePlan(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPlan(*s)
+	}
+	return uuo
+}
+
+// AddProductIDs adds the "products" edge to the Product entity by IDs.
+func (uuo *UserUpdateOne) AddProductIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddProductIDs(ids...)
+	return uuo
+}
+
+// AddProducts adds the "products" edges to the Product entity.
+func (uuo *UserUpdateOne) AddProducts(p ...*Product) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddProductIDs(ids...)
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (uuo *UserUpdateOne) AddOrderIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddOrderIDs(ids...)
+	return uuo
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (uuo *UserUpdateOne) AddOrders(o ...*Order) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.AddOrderIDs(ids...)
+}
+
+// SetSiteuiID sets the "siteui" edge to the Siteui entity by ID.
+func (uuo *UserUpdateOne) SetSiteuiID(id uuid.UUID) *UserUpdateOne {
+	uuo.mutation.SetSiteuiID(id)
+	return uuo
+}
+
+// SetNillableSiteuiID sets the "siteui" edge to the Siteui entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSiteuiID(id *uuid.UUID) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetSiteuiID(*id)
+	}
+	return uuo
+}
+
+// SetSiteui sets the "siteui" edge to the Siteui entity.
+func (uuo *UserUpdateOne) SetSiteui(s *Siteui) *UserUpdateOne {
+	return uuo.SetSiteuiID(s.ID)
+}
+
+// AddImagesinfoIDs adds the "imagesinfo" edge to the Imageinfo entity by IDs.
+func (uuo *UserUpdateOne) AddImagesinfoIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddImagesinfoIDs(ids...)
+	return uuo
+}
+
+// AddImagesinfo adds the "imagesinfo" edges to the Imageinfo entity.
+func (uuo *UserUpdateOne) AddImagesinfo(i ...*Imageinfo) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddImagesinfoIDs(ids...)
+}
+
+// AddRefreshtokenIDs adds the "refreshtokens" edge to the RefreshToken entity by IDs.
+func (uuo *UserUpdateOne) AddRefreshtokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddRefreshtokenIDs(ids...)
+	return uuo
+}
+
+// AddRefreshtokens adds the "refreshtokens" edges to the RefreshToken entity.
+func (uuo *UserUpdateOne) AddRefreshtokens(r ...*RefreshToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRefreshtokenIDs(ids...)
+}
+
+// AddEmailverificationtokenIDs adds the "emailverificationtokens" edge to the EmailVerificationToken entity by IDs.
+func (uuo *UserUpdateOne) AddEmailverificationtokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddEmailverificationtokenIDs(ids...)
+	return uuo
+}
+
+// AddEmailverificationtokens adds the "emailverificationtokens" edges to the EmailVerificationToken entity.
+func (uuo *UserUpdateOne) AddEmailverificationtokens(e ...*EmailVerificationToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.AddEmailverificationtokenIDs(ids...)
+}
+
+// AddPasswordresettokenIDs adds the "passwordresettokens" edge to the PasswordResetToken entity by IDs.
+func (uuo *UserUpdateOne) AddPasswordresettokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddPasswordresettokenIDs(ids...)
+	return uuo
+}
+
+// AddPasswordresettokens adds the "passwordresettokens" edges to the PasswordResetToken entity.
+func (uuo *UserUpdateOne) AddPasswordresettokens(p ...*PasswordResetToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPasswordresettokenIDs(ids...)
+}
+
+// AddLoginlockouteventIDs adds the "loginlockoutevents" edge to the LoginLockoutEvent entity by IDs.
+func (uuo *UserUpdateOne) AddLoginlockouteventIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddLoginlockouteventIDs(ids...)
+	return uuo
+}
+
+// AddLoginlockoutevents adds the "loginlockoutevents" edges to the LoginLockoutEvent entity.
+func (uuo *UserUpdateOne) AddLoginlockoutevents(l ...*LoginLockoutEvent) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uuo.AddLoginlockouteventIDs(ids...)
+}
+
+// SetShopID sets the "shop" edge to the Shop entity by ID.
+func (uuo *UserUpdateOne) SetShopID(id uuid.UUID) *UserUpdateOne {
+	uuo.mutation.SetShopID(id)
+	return uuo
+}
+
+// SetNillableShopID sets the "shop" edge to the Shop entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableShopID(id *uuid.UUID) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetShopID(*id)
+	}
+	return uuo
+}
+
+// SetShop sets the "shop" edge to the Shop entity.
+func (uuo *UserUpdateOne) SetShop(s *Shop) *UserUpdateOne {
+	return uuo.SetShopID(s.ID)
+}
+
+// AddShopmembershipIDs adds the "shopmemberships" edge to the ShopMember entity by IDs.
+func (uuo *UserUpdateOne) AddShopmembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddShopmembershipIDs(ids...)
+	return uuo
+}
+
+// AddShopmemberships adds the "shopmemberships" edges to the ShopMember entity.
+func (uuo *UserUpdateOne) AddShopmemberships(s ...*ShopMember) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddShopmembershipIDs(ids...)
+}
+
+// AddApikeyIDs adds the "apikeys" edge to the ApiKey entity by IDs.
+func (uuo *UserUpdateOne) AddApikeyIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddApikeyIDs(ids...)
+	return uuo
+}
+
+// AddApikeys adds the "apikeys" edges to the ApiKey entity.
+func (uuo *UserUpdateOne) AddApikeys(a ...*ApiKey) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddApikeyIDs(ids...)
+}
+
+// SetTotpID sets the "totp" edge to the UserTotp entity by ID.
+func (uuo *UserUpdateOne) SetTotpID(id uuid.UUID) *UserUpdateOne {
+	uuo.mutation.SetTotpID(id)
+	return uuo
+}
+
+// SetNillableTotpID sets the "totp" edge to the UserTotp entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpID(id *uuid.UUID) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetTotpID(*id)
+	}
+	return uuo
+}
+
+// SetTotp sets the "totp" edge to the UserTotp entity.
+func (uuo *UserUpdateOne) SetTotp(u *UserTotp) *UserUpdateOne {
+	return uuo.SetTotpID(u.ID)
+}
+
+// AddMfarecoverycodeIDs adds the "mfarecoverycodes" edge to the MfaRecoveryCode entity by IDs.
+func (uuo *UserUpdateOne) AddMfarecoverycodeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddMfarecoverycodeIDs(ids...)
+	return uuo
+}
+
+// AddMfarecoverycodes adds the "mfarecoverycodes" edges to the MfaRecoveryCode entity.
+func (uuo *UserUpdateOne) AddMfarecoverycodes(m ...*MfaRecoveryCode) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddMfarecoverycodeIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// ClearProducts clears all "products" edges to the Product entity.
+func (uuo *UserUpdateOne) ClearProducts() *UserUpdateOne {
+	uuo.mutation.ClearProducts()
+	return uuo
+}
+
+// RemoveProductIDs removes the "products" edge to Product entities by IDs.
+func (uuo *UserUpdateOne) RemoveProductIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveProductIDs(ids...)
+	return uuo
+}
+
+// RemoveProducts removes "products" edges to Product entities.
+func (uuo *UserUpdateOne) RemoveProducts(p ...*Product) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemoveProductIDs(ids...)
+}
+
+// ClearOrders clears all "orders" edges to the Order entity.
+func (uuo *UserUpdateOne) ClearOrders() *UserUpdateOne {
+	uuo.mutation.ClearOrders()
+	return uuo
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (uuo *UserUpdateOne) RemoveOrderIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveOrderIDs(ids...)
+	return uuo
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (uuo *UserUpdateOne) RemoveOrders(o ...*Order) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.RemoveOrderIDs(ids...)
+}
+
+// ClearSiteui clears the "siteui" edge to the Siteui entity.
+func (uuo *UserUpdateOne) ClearSiteui() *UserUpdateOne {
+	uuo.mutation.ClearSiteui()
+	return uuo
+}
+
+// ClearImagesinfo clears all "imagesinfo" edges to the Imageinfo entity.
+func (uuo *UserUpdateOne) ClearImagesinfo() *UserUpdateOne {
+	uuo.mutation.ClearImagesinfo()
+	return uuo
+}
+
+// RemoveImagesinfoIDs removes the "imagesinfo" edge to Imageinfo entities by IDs.
+func (uuo *UserUpdateOne) RemoveImagesinfoIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveImagesinfoIDs(ids...)
+	return uuo
+}
+
+// RemoveImagesinfo removes "imagesinfo" edges to Imageinfo entities.
+func (uuo *UserUpdateOne) RemoveImagesinfo(i ...*Imageinfo) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveImagesinfoIDs(ids...)
+}
+
+// ClearRefreshtokens clears all "refreshtokens" edges to the RefreshToken entity.
+func (uuo *UserUpdateOne) ClearRefreshtokens() *UserUpdateOne {
+	uuo.mutation.ClearRefreshtokens()
+	return uuo
+}
+
+// RemoveRefreshtokenIDs removes the "refreshtokens" edge to RefreshToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveRefreshtokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveRefreshtokenIDs(ids...)
+	return uuo
+}
+
+// RemoveRefreshtokens removes "refreshtokens" edges to RefreshToken entities.
+func (uuo *UserUpdateOne) RemoveRefreshtokens(r ...*RefreshToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRefreshtokenIDs(ids...)
+}
+
+// ClearEmailverificationtokens clears all "emailverificationtokens" edges to the EmailVerificationToken entity.
+func (uuo *UserUpdateOne) ClearEmailverificationtokens() *UserUpdateOne {
+	uuo.mutation.ClearEmailverificationtokens()
+	return uuo
+}
+
+// RemoveEmailverificationtokenIDs removes the "emailverificationtokens" edge to EmailVerificationToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveEmailverificationtokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveEmailverificationtokenIDs(ids...)
+	return uuo
+}
+
+// RemoveEmailverificationtokens removes "emailverificationtokens" edges to EmailVerificationToken entities.
+func (uuo *UserUpdateOne) RemoveEmailverificationtokens(e ...*EmailVerificationToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.RemoveEmailverificationtokenIDs(ids...)
+}
+
+// ClearPasswordresettokens clears all "passwordresettokens" edges to the PasswordResetToken entity.
+func (uuo *UserUpdateOne) ClearPasswordresettokens() *UserUpdateOne {
+	uuo.mutation.ClearPasswordresettokens()
+	return uuo
+}
+
+// RemovePasswordresettokenIDs removes the "passwordresettokens" edge to PasswordResetToken entities by IDs.
+func (uuo *UserUpdateOne) RemovePasswordresettokenIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemovePasswordresettokenIDs(ids...)
+	return uuo
+}
+
+// RemovePasswordresettokens removes "passwordresettokens" edges to PasswordResetToken entities.
+func (uuo *UserUpdateOne) RemovePasswordresettokens(p ...*PasswordResetToken) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemovePasswordresettokenIDs(ids...)
+}
+
+// ClearLoginlockoutevents clears all "loginlockoutevents" edges to the LoginLockoutEvent entity.
+func (uuo *UserUpdateOne) ClearLoginlockoutevents() *UserUpdateOne {
+	uuo.mutation.ClearLoginlockoutevents()
+	return uuo
+}
+
+// RemoveLoginlockouteventIDs removes the "loginlockoutevents" edge to LoginLockoutEvent entities by IDs.
+func (uuo *UserUpdateOne) RemoveLoginlockouteventIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveLoginlockouteventIDs(ids...)
+	return uuo
+}
+
+// RemoveLoginlockoutevents removes "loginlockoutevents" edges to LoginLockoutEvent entities.
+func (uuo *UserUpdateOne) RemoveLoginlockoutevents(l ...*LoginLockoutEvent) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return uuo.RemoveLoginlockouteventIDs(ids...)
+}
+
+// ClearShop clears the "shop" edge to the Shop entity.
+func (uuo *UserUpdateOne) ClearShop() *UserUpdateOne {
+	uuo.mutation.ClearShop()
+	return uuo
+}
+
+// ClearShopmemberships clears all "shopmemberships" edges to the ShopMember entity.
+func (uuo *UserUpdateOne) ClearShopmemberships() *UserUpdateOne {
+	uuo.mutation.ClearShopmemberships()
+	return uuo
+}
+
+// RemoveShopmembershipIDs removes the "shopmemberships" edge to ShopMember entities by IDs.
+func (uuo *UserUpdateOne) RemoveShopmembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveShopmembershipIDs(ids...)
+	return uuo
+}
+
+// RemoveShopmemberships removes "shopmemberships" edges to ShopMember entities.
+func (uuo *UserUpdateOne) RemoveShopmemberships(s ...*ShopMember) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveShopmembershipIDs(ids...)
+}
+
+// ClearApikeys clears all "apikeys" edges to the ApiKey entity.
+func (uuo *UserUpdateOne) ClearApikeys() *UserUpdateOne {
+	uuo.mutation.ClearApikeys()
+	return uuo
+}
+
+// RemoveApikeyIDs removes the "apikeys" edge to ApiKey entities by IDs.
+func (uuo *UserUpdateOne) RemoveApikeyIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveApikeyIDs(ids...)
+	return uuo
+}
+
+// RemoveApikeys removes "apikeys" edges to ApiKey entities.
+func (uuo *UserUpdateOne) RemoveApikeys(a ...*ApiKey) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveApikeyIDs(ids...)
+}
+
+// ClearTotp clears the "totp" edge to the UserTotp entity.
+func (uuo *UserUpdateOne) ClearTotp() *UserUpdateOne {
+	uuo.mutation.ClearTotp()
+	return uuo
+}
+
+// ClearMfarecoverycodes clears all "mfarecoverycodes" edges to the MfaRecoveryCode entity.
+func (uuo *UserUpdateOne) ClearMfarecoverycodes() *UserUpdateOne {
+	uuo.mutation.ClearMfarecoverycodes()
+	return uuo
+}
+
+// RemoveMfarecoverycodeIDs removes the "mfarecoverycodes" edge to MfaRecoveryCode entities by IDs.
+func (uuo *UserUpdateOne) RemoveMfarecoverycodeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveMfarecoverycodeIDs(ids...)
+	return uuo
+}
+
+// RemoveMfarecoverycodes removes "mfarecoverycodes" edges to MfaRecoveryCode entities.
+func (uuo *UserUpdateOne) RemoveMfarecoverycodes(m ...*MfaRecoveryCode) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveMfarecoverycodeIDs(ids...)
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.mutation.Where(ps...)
+	return uuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	uuo.fields = append([]string{field}, fields...)
+	return uuo
+}
+
+// Save executes the query and returns the updated User entity.
+func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	uuo.defaults()
+	return withHooks[*User, UserMutation](ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uuo *UserUpdateOne) defaults() {
+	if _, ok := uuo.mutation.UpdatedAt(); !ok {
+		v := user.UpdateDefaultUpdatedAt()
+		uuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.HashedPw(); ok {
+		if err := user.HashedPwValidator(v); err != nil {
+			return &ValidationError{Name: "hashed_pw", err: fmt.Errorf(`ent: validator failed for field "User.hashed_pw": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Plan(); ok {
+		if err := user.PlanValidator(v); err != nil {
+			return &ValidationError{Name: "plan", err: fmt.Errorf(`ent: validator failed for field "User.plan": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.HashedPw(); ok {
+		_spec.SetField(user.FieldHashedPw, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.EmailVerified(); ok {
+		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.IsArchived(); ok {
+		_spec.SetField(user.FieldIsArchived, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.Plan(); ok {
+		_spec.SetField(user.FieldPlan, field.TypeString, value)
+	}
+	if uuo.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ProductsTable,
+			Columns: []string{user.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: product.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedProductsIDs(); len(nodes) > 0 && !uuo.mutation.ProductsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ProductsTable,
+			Columns: []string{user.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ProductsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ProductsTable,
+			Columns: []string{user.ProductsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: product.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrdersTable,
+			Columns: []string{user.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: order.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !uuo.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrdersTable,
+			Columns: []string{user.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: order.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OrdersTable,
+			Columns: []string{user.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: order.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.SiteuiCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.SiteuiTable,
+			Columns: []string{user.SiteuiColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: siteui.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SiteuiIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.SiteuiTable,
+			Columns: []string{user.SiteuiColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: siteui.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.ImagesinfoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImagesinfoTable,
+			Columns: []string{user.ImagesinfoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: imageinfo.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedImagesinfoIDs(); len(nodes) > 0 && !uuo.mutation.ImagesinfoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImagesinfoTable,
+			Columns: []string{user.ImagesinfoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: imageinfo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.ImagesinfoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ImagesinfoTable,
+			Columns: []string{user.ImagesinfoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: imageinfo.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.RefreshtokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshtokensTable,
+			Columns: []string{user.RefreshtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: refreshtoken.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedRefreshtokensIDs(); len(nodes) > 0 && !uuo.mutation.RefreshtokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshtokensTable,
+			Columns: []string{user.RefreshtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: refreshtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (uuo *UserUpdateOne) defaults() {
-	if _, ok := uuo.mutation.UpdatedAt(); !ok {
-		v := user.UpdateDefaultUpdatedAt()
-		uuo.mutation.SetUpdatedAt(v)
+	if nodes := uuo.mutation.RefreshtokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshtokensTable,
+			Columns: []string{user.RefreshtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: refreshtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (uuo *UserUpdateOne) check() error {
-	if v, ok := uuo.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+	if uuo.mutation.EmailverificationtokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailverificationtokensTable,
+			Columns: []string{user.EmailverificationtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: emailverificationtoken.FieldID,
+				},
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.HashedPw(); ok {
-		if err := user.HashedPwValidator(v); err != nil {
-			return &ValidationError{Name: "hashed_pw", err: fmt.Errorf(`ent: validator failed for field "User.hashed_pw": %w`, err)}
+	if nodes := uuo.mutation.RemovedEmailverificationtokensIDs(); len(nodes) > 0 && !uuo.mutation.EmailverificationtokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailverificationtokensTable,
+			Columns: []string{user.EmailverificationtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: emailverificationtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := uuo.check(); err != nil {
-		return _node, err
+	if nodes := uuo.mutation.EmailverificationtokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailverificationtokensTable,
+			Columns: []string{user.EmailverificationtokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: emailverificationtoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
-	id, ok := uuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	if uuo.mutation.PasswordresettokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordresettokensTable,
+			Columns: []string{user.PasswordresettokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: passwordresettoken.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := uuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := uuo.mutation.RemovedPasswordresettokensIDs(); len(nodes) > 0 && !uuo.mutation.PasswordresettokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordresettokensTable,
+			Columns: []string{user.PasswordresettokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: passwordresettoken.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := uuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := uuo.mutation.PasswordresettokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordresettokensTable,
+			Columns: []string{user.PasswordresettokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: passwordresettoken.FieldID,
+				},
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.UpdatedAt(); ok {
-		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	if uuo.mutation.LoginlockouteventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginlockouteventsTable,
+			Columns: []string{user.LoginlockouteventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: loginlockoutevent.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	if nodes := uuo.mutation.RemovedLoginlockouteventsIDs(); len(nodes) > 0 && !uuo.mutation.LoginlockouteventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginlockouteventsTable,
+			Columns: []string{user.LoginlockouteventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: loginlockoutevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.HashedPw(); ok {
-		_spec.SetField(user.FieldHashedPw, field.TypeString, value)
+	if nodes := uuo.mutation.LoginlockouteventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoginlockouteventsTable,
+			Columns: []string{user.LoginlockouteventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: loginlockoutevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.EmailVerified(); ok {
-		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
+	if uuo.mutation.ShopCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ShopTable,
+			Columns: []string{user.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.IsArchived(); ok {
-		_spec.SetField(user.FieldIsArchived, field.TypeBool, value)
+	if nodes := uuo.mutation.ShopIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.ShopTable,
+			Columns: []string{user.ShopColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: shop.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.ProductsCleared() {
+	if uuo.mutation.ShopmembershipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ProductsTable,
-			Columns: []string{user.ProductsColumn},
+			Table:   user.ShopmembershipsTable,
+			Columns: []string{user.ShopmembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: product.FieldID,
+					Column: shopmember.FieldID,
 				},
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedProductsIDs(); len(nodes) > 0 && !uuo.mutation.ProductsCleared() {
+	if nodes := uuo.mutation.RemovedShopmembershipsIDs(); len(nodes) > 0 && !uuo.mutation.ShopmembershipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ProductsTable,
-			Columns: []string{user.ProductsColumn},
+			Table:   user.ShopmembershipsTable,
+			Columns: []string{user.ShopmembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: product.FieldID,
+					Column: shopmember.FieldID,
 				},
 			},
 		}
@@ -839,17 +2392,17 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.ProductsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.ShopmembershipsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ProductsTable,
-			Columns: []string{user.ProductsColumn},
+			Table:   user.ShopmembershipsTable,
+			Columns: []string{user.ShopmembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: product.FieldID,
+					Column: shopmember.FieldID,
 				},
 			},
 		}
@@ -858,33 +2411,33 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.OrdersCleared() {
+	if uuo.mutation.ApikeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OrdersTable,
-			Columns: []string{user.OrdersColumn},
+			Table:   user.ApikeysTable,
+			Columns: []string{user.ApikeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: order.FieldID,
+					Column: apikey.FieldID,
 				},
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !uuo.mutation.OrdersCleared() {
+	if nodes := uuo.mutation.RemovedApikeysIDs(); len(nodes) > 0 && !uuo.mutation.ApikeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OrdersTable,
-			Columns: []string{user.OrdersColumn},
+			Table:   user.ApikeysTable,
+			Columns: []string{user.ApikeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: order.FieldID,
+					Column: apikey.FieldID,
 				},
 			},
 		}
@@ -893,17 +2446,17 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.OrdersIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.ApikeysIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OrdersTable,
-			Columns: []string{user.OrdersColumn},
+			Table:   user.ApikeysTable,
+			Columns: []string{user.ApikeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: order.FieldID,
+					Column: apikey.FieldID,
 				},
 			},
 		}
@@ -912,33 +2465,33 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.SiteuiCleared() {
+	if uuo.mutation.TotpCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
 			Inverse: false,
-			Table:   user.SiteuiTable,
-			Columns: []string{user.SiteuiColumn},
+			Table:   user.TotpTable,
+			Columns: []string{user.TotpColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: siteui.FieldID,
+					Column: usertotp.FieldID,
 				},
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.SiteuiIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.TotpIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
 			Inverse: false,
-			Table:   user.SiteuiTable,
-			Columns: []string{user.SiteuiColumn},
+			Table:   user.TotpTable,
+			Columns: []string{user.TotpColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: siteui.FieldID,
+					Column: usertotp.FieldID,
 				},
 			},
 		}
@@ -947,33 +2500,33 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.ImagesinfoCleared() {
+	if uuo.mutation.MfarecoverycodesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ImagesinfoTable,
-			Columns: []string{user.ImagesinfoColumn},
+			Table:   user.MfarecoverycodesTable,
+			Columns: []string{user.MfarecoverycodesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
-					Column: imageinfo.FieldID,
+					Type:   field.TypeUUID,
+					Column: mfarecoverycode.FieldID,
 				},
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedImagesinfoIDs(); len(nodes) > 0 && !uuo.mutation.ImagesinfoCleared() {
+	if nodes := uuo.mutation.RemovedMfarecoverycodesIDs(); len(nodes) > 0 && !uuo.mutation.MfarecoverycodesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ImagesinfoTable,
-			Columns: []string{user.ImagesinfoColumn},
+			Table:   user.MfarecoverycodesTable,
+			Columns: []string{user.MfarecoverycodesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
-					Column: imageinfo.FieldID,
+					Type:   field.TypeUUID,
+					Column: mfarecoverycode.FieldID,
 				},
 			},
 		}
@@ -982,17 +2535,17 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.ImagesinfoIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.MfarecoverycodesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ImagesinfoTable,
-			Columns: []string{user.ImagesinfoColumn},
+			Table:   user.MfarecoverycodesTable,
+			Columns: []string{user.MfarecoverycodesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeInt,
-					Column: imageinfo.FieldID,
+					Type:   field.TypeUUID,
+					Column: mfarecoverycode.FieldID,
 				},
 			},
 		}
@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sthl/ent/user"
+	"sthl/ent/usertotp"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// UserTotp is the model entity for the UserTotp schema.
+type UserTotp struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"userId"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// ConfirmedAt holds the value of the "confirmed_at" field.
+	ConfirmedAt *time.Time `json:"confirmedAt"`
+	// LastUsedStep holds the value of the "last_used_step" field.
+	LastUsedStep int64 `json:"-"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserTotpQuery when eager-loading is set.
+	Edges UserTotpEdges `json:"-"`
+}
+
+// UserTotpEdges holds the relations/edges for other nodes in the graph.
+type UserTotpEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserTotpEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserTotp) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usertotp.FieldLastUsedStep:
+			values[i] = new(sql.NullInt64)
+		case usertotp.FieldSecret:
+			values[i] = new(sql.NullString)
+		case usertotp.FieldCreatedAt, usertotp.FieldUpdatedAt, usertotp.FieldConfirmedAt:
+			values[i] = new(sql.NullTime)
+		case usertotp.FieldID, usertotp.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type UserTotp", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserTotp fields.
+func (ut *UserTotp) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usertotp.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ut.ID = *value
+			}
+		case usertotp.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ut.CreatedAt = value.Time
+			}
+		case usertotp.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ut.UpdatedAt = value.Time
+			}
+		case usertotp.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ut.UserID = *value
+			}
+		case usertotp.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				ut.Secret = value.String
+			}
+		case usertotp.FieldConfirmedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field confirmed_at", values[i])
+			} else if value.Valid {
+				ut.ConfirmedAt = new(time.Time)
+				*ut.ConfirmedAt = value.Time
+			}
+		case usertotp.FieldLastUsedStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_step", values[i])
+			} else if value.Valid {
+				ut.LastUsedStep = value.Int64
+			}
+		}
+	}
+	return nil
+}
+
+// QueryOwner queries the "owner" edge of the UserTotp entity.
+func (ut *UserTotp) QueryOwner() *UserQuery {
+	return NewUserTotpClient(ut.config).QueryOwner(ut)
+}
+
+// Update returns a builder for updating this UserTotp.
+// Note that you need to call UserTotp.Unwrap() before calling this method if this UserTotp
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ut *UserTotp) Update() *UserTotpUpdateOne {
+	return NewUserTotpClient(ut.config).UpdateOne(ut)
+}
+
+// Unwrap unwraps the UserTotp entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ut *UserTotp) Unwrap() *UserTotp {
+	_tx, ok := ut.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserTotp is not a transactional entity")
+	}
+	ut.config.driver = _tx.drv
+	return ut
+}
+
+// String implements the fmt.Stringer.
+func (ut *UserTotp) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserTotp(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ut.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ut.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ut.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ut.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := ut.ConfirmedAt; v != nil {
+		builder.WriteString("confirmed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_used_step=")
+	builder.WriteString(fmt.Sprintf("%v", ut.LastUsedStep))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserTotps is a parsable slice of UserTotp.
+type UserTotps []*UserTotp
@@ -0,0 +1,850 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/user"
+	"sthl/ent/usertotp"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserTotpCreate is the builder for creating a UserTotp entity.
+type UserTotpCreate struct {
+	config
+	mutation *UserTotpMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (utc *UserTotpCreate) SetCreatedAt(t time.Time) *UserTotpCreate {
+	utc.mutation.SetCreatedAt(t)
+	return utc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (utc *UserTotpCreate) SetNillableCreatedAt(t *time.Time) *UserTotpCreate {
+	if t != nil {
+		utc.SetCreatedAt(*t)
+	}
+	return utc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (utc *UserTotpCreate) SetUpdatedAt(t time.Time) *UserTotpCreate {
+	utc.mutation.SetUpdatedAt(t)
+	return utc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (utc *UserTotpCreate) SetNillableUpdatedAt(t *time.Time) *UserTotpCreate {
+	if t != nil {
+		utc.SetUpdatedAt(*t)
+	}
+	return utc
+}
+
+// SetUserID sets the "user_id" field.
+func (utc *UserTotpCreate) SetUserID(u uuid.UUID) *UserTotpCreate {
+	utc.mutation.SetUserID(u)
+	return utc
+}
+
+// SetSecret sets the "secret" field.
+func (utc *UserTotpCreate) SetSecret(s string) *UserTotpCreate {
+	utc.mutation.SetSecret(s)
+	return utc
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (utc *UserTotpCreate) SetConfirmedAt(t time.Time) *UserTotpCreate {
+	utc.mutation.SetConfirmedAt(t)
+	return utc
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (utc *UserTotpCreate) SetNillableConfirmedAt(t *time.Time) *UserTotpCreate {
+	if t != nil {
+		utc.SetConfirmedAt(*t)
+	}
+	return utc
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (utc *UserTotpCreate) SetLastUsedStep(i int64) *UserTotpCreate {
+	utc.mutation.SetLastUsedStep(i)
+	return utc
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (utc *UserTotpCreate) SetNillableLastUsedStep(i *int64) *UserTotpCreate {
+	if i != nil {
+		utc.SetLastUsedStep(*i)
+	}
+	return utc
+}
+
+// SetID sets the "id" field.
+func (utc *UserTotpCreate) SetID(u uuid.UUID) *UserTotpCreate {
+	utc.mutation.SetID(u)
+	return utc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (utc *UserTotpCreate) SetNillableID(u *uuid.UUID) *UserTotpCreate {
+	if u != nil {
+		utc.SetID(*u)
+	}
+	return utc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (utc *UserTotpCreate) SetOwnerID(id uuid.UUID) *UserTotpCreate {
+	utc.mutation.SetOwnerID(id)
+	return utc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (utc *UserTotpCreate) SetOwner(u *User) *UserTotpCreate {
+	return utc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the UserTotpMutation object of the builder.
+func (utc *UserTotpCreate) Mutation() *UserTotpMutation {
+	return utc.mutation
+}
+
+// Save creates the UserTotp in the database.
+func (utc *UserTotpCreate) Save(ctx context.Context) (*UserTotp, error) {
+	utc.defaults()
+	return withHooks[*UserTotp, UserTotpMutation](ctx, utc.sqlSave, utc.mutation, utc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (utc *UserTotpCreate) SaveX(ctx context.Context) *UserTotp {
+	v, err := utc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (utc *UserTotpCreate) Exec(ctx context.Context) error {
+	_, err := utc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utc *UserTotpCreate) ExecX(ctx context.Context) {
+	if err := utc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (utc *UserTotpCreate) defaults() {
+	if _, ok := utc.mutation.CreatedAt(); !ok {
+		v := usertotp.DefaultCreatedAt()
+		utc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := utc.mutation.UpdatedAt(); !ok {
+		v := usertotp.DefaultUpdatedAt()
+		utc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := utc.mutation.LastUsedStep(); !ok {
+		v := usertotp.DefaultLastUsedStep
+		utc.mutation.SetLastUsedStep(v)
+	}
+	if _, ok := utc.mutation.ID(); !ok {
+		v := usertotp.DefaultID()
+		utc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (utc *UserTotpCreate) check() error {
+	if _, ok := utc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserTotp.created_at"`)}
+	}
+	if _, ok := utc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserTotp.updated_at"`)}
+	}
+	if _, ok := utc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserTotp.user_id"`)}
+	}
+	if _, ok := utc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "UserTotp.secret"`)}
+	}
+	if v, ok := utc.mutation.Secret(); ok {
+		if err := usertotp.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "UserTotp.secret": %w`, err)}
+		}
+	}
+	if _, ok := utc.mutation.LastUsedStep(); !ok {
+		return &ValidationError{Name: "last_used_step", err: errors.New(`ent: missing required field "UserTotp.last_used_step"`)}
+	}
+	if _, ok := utc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "UserTotp.owner"`)}
+	}
+	return nil
+}
+
+func (utc *UserTotpCreate) sqlSave(ctx context.Context) (*UserTotp, error) {
+	if err := utc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := utc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, utc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	utc.mutation.id = &_node.ID
+	utc.mutation.done = true
+	return _node, nil
+}
+
+func (utc *UserTotpCreate) createSpec() (*UserTotp, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserTotp{config: utc.config}
+		_spec = sqlgraph.NewCreateSpec(usertotp.Table, sqlgraph.NewFieldSpec(usertotp.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = utc.conflict
+	if id, ok := utc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := utc.mutation.CreatedAt(); ok {
+		_spec.SetField(usertotp.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := utc.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertotp.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := utc.mutation.Secret(); ok {
+		_spec.SetField(usertotp.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := utc.mutation.ConfirmedAt(); ok {
+		_spec.SetField(usertotp.FieldConfirmedAt, field.TypeTime, value)
+		_node.ConfirmedAt = &value
+	}
+	if value, ok := utc.mutation.LastUsedStep(); ok {
+		_spec.SetField(usertotp.FieldLastUsedStep, field.TypeInt64, value)
+		_node.LastUsedStep = value
+	}
+	if nodes := utc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   usertotp.OwnerTable,
+			Columns: []string{usertotp.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserTotp.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserTotpUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (utc *UserTotpCreate) OnConflict(opts ...sql.ConflictOption) *UserTotpUpsertOne {
+	utc.conflict = opts
+	return &UserTotpUpsertOne{
+		create: utc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserTotp.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (utc *UserTotpCreate) OnConflictColumns(columns ...string) *UserTotpUpsertOne {
+	utc.conflict = append(utc.conflict, sql.ConflictColumns(columns...))
+	return &UserTotpUpsertOne{
+		create: utc,
+	}
+}
+
+type (
+	// UserTotpUpsertOne is the builder for "upsert"-ing
+	//  one UserTotp node.
+	UserTotpUpsertOne struct {
+		create *UserTotpCreate
+	}
+
+	// UserTotpUpsert is the "OnConflict" setter.
+	UserTotpUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserTotpUpsert) SetUpdatedAt(v time.Time) *UserTotpUpsert {
+	u.Set(usertotp.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserTotpUpsert) UpdateUpdatedAt() *UserTotpUpsert {
+	u.SetExcluded(usertotp.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserTotpUpsert) SetUserID(v uuid.UUID) *UserTotpUpsert {
+	u.Set(usertotp.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserTotpUpsert) UpdateUserID() *UserTotpUpsert {
+	u.SetExcluded(usertotp.FieldUserID)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *UserTotpUpsert) SetSecret(v string) *UserTotpUpsert {
+	u.Set(usertotp.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *UserTotpUpsert) UpdateSecret() *UserTotpUpsert {
+	u.SetExcluded(usertotp.FieldSecret)
+	return u
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (u *UserTotpUpsert) SetConfirmedAt(v time.Time) *UserTotpUpsert {
+	u.Set(usertotp.FieldConfirmedAt, v)
+	return u
+}
+
+// UpdateConfirmedAt sets the "confirmed_at" field to the value that was provided on create.
+func (u *UserTotpUpsert) UpdateConfirmedAt() *UserTotpUpsert {
+	u.SetExcluded(usertotp.FieldConfirmedAt)
+	return u
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (u *UserTotpUpsert) ClearConfirmedAt() *UserTotpUpsert {
+	u.SetNull(usertotp.FieldConfirmedAt)
+	return u
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (u *UserTotpUpsert) SetLastUsedStep(v int64) *UserTotpUpsert {
+	u.Set(usertotp.FieldLastUsedStep, v)
+	return u
+}
+
+// UpdateLastUsedStep sets the "last_used_step" field to the value that was provided on create.
+func (u *UserTotpUpsert) UpdateLastUsedStep() *UserTotpUpsert {
+	u.SetExcluded(usertotp.FieldLastUsedStep)
+	return u
+}
+
+// AddLastUsedStep adds v to the "last_used_step" field.
+func (u *UserTotpUpsert) AddLastUsedStep(v int64) *UserTotpUpsert {
+	u.Add(usertotp.FieldLastUsedStep, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserTotp.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usertotp.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserTotpUpsertOne) UpdateNewValues() *UserTotpUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(usertotp.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(usertotp.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserTotp.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserTotpUpsertOne) Ignore() *UserTotpUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserTotpUpsertOne) DoNothing() *UserTotpUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserTotpCreate.OnConflict
+// documentation for more info.
+func (u *UserTotpUpsertOne) Update(set func(*UserTotpUpsert)) *UserTotpUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserTotpUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserTotpUpsertOne) SetUpdatedAt(v time.Time) *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserTotpUpsertOne) UpdateUpdatedAt() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserTotpUpsertOne) SetUserID(v uuid.UUID) *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserTotpUpsertOne) UpdateUserID() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *UserTotpUpsertOne) SetSecret(v string) *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *UserTotpUpsertOne) UpdateSecret() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (u *UserTotpUpsertOne) SetConfirmedAt(v time.Time) *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetConfirmedAt(v)
+	})
+}
+
+// UpdateConfirmedAt sets the "confirmed_at" field to the value that was provided on create.
+func (u *UserTotpUpsertOne) UpdateConfirmedAt() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateConfirmedAt()
+	})
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (u *UserTotpUpsertOne) ClearConfirmedAt() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.ClearConfirmedAt()
+	})
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (u *UserTotpUpsertOne) SetLastUsedStep(v int64) *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetLastUsedStep(v)
+	})
+}
+
+// AddLastUsedStep adds v to the "last_used_step" field.
+func (u *UserTotpUpsertOne) AddLastUsedStep(v int64) *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.AddLastUsedStep(v)
+	})
+}
+
+// UpdateLastUsedStep sets the "last_used_step" field to the value that was provided on create.
+func (u *UserTotpUpsertOne) UpdateLastUsedStep() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateLastUsedStep()
+	})
+}
+
+// Exec executes the query.
+func (u *UserTotpUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserTotpCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserTotpUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserTotpUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UserTotpUpsertOne.ID is not supported by MySQL driver. Use UserTotpUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserTotpUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserTotpCreateBulk is the builder for creating many UserTotp entities in bulk.
+type UserTotpCreateBulk struct {
+	config
+	builders []*UserTotpCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserTotp entities in the database.
+func (utcb *UserTotpCreateBulk) Save(ctx context.Context) ([]*UserTotp, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(utcb.builders))
+	nodes := make([]*UserTotp, len(utcb.builders))
+	mutators := make([]Mutator, len(utcb.builders))
+	for i := range utcb.builders {
+		func(i int, root context.Context) {
+			builder := utcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserTotpMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, utcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = utcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, utcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, utcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utcb *UserTotpCreateBulk) SaveX(ctx context.Context) []*UserTotp {
+	v, err := utcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (utcb *UserTotpCreateBulk) Exec(ctx context.Context) error {
+	_, err := utcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utcb *UserTotpCreateBulk) ExecX(ctx context.Context) {
+	if err := utcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserTotp.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserTotpUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (utcb *UserTotpCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserTotpUpsertBulk {
+	utcb.conflict = opts
+	return &UserTotpUpsertBulk{
+		create: utcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserTotp.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (utcb *UserTotpCreateBulk) OnConflictColumns(columns ...string) *UserTotpUpsertBulk {
+	utcb.conflict = append(utcb.conflict, sql.ConflictColumns(columns...))
+	return &UserTotpUpsertBulk{
+		create: utcb,
+	}
+}
+
+// UserTotpUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserTotp nodes.
+type UserTotpUpsertBulk struct {
+	create *UserTotpCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserTotp.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usertotp.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserTotpUpsertBulk) UpdateNewValues() *UserTotpUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(usertotp.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(usertotp.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserTotp.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserTotpUpsertBulk) Ignore() *UserTotpUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserTotpUpsertBulk) DoNothing() *UserTotpUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserTotpCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserTotpUpsertBulk) Update(set func(*UserTotpUpsert)) *UserTotpUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserTotpUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserTotpUpsertBulk) SetUpdatedAt(v time.Time) *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserTotpUpsertBulk) UpdateUpdatedAt() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserTotpUpsertBulk) SetUserID(v uuid.UUID) *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserTotpUpsertBulk) UpdateUserID() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *UserTotpUpsertBulk) SetSecret(v string) *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *UserTotpUpsertBulk) UpdateSecret() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (u *UserTotpUpsertBulk) SetConfirmedAt(v time.Time) *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetConfirmedAt(v)
+	})
+}
+
+// UpdateConfirmedAt sets the "confirmed_at" field to the value that was provided on create.
+func (u *UserTotpUpsertBulk) UpdateConfirmedAt() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateConfirmedAt()
+	})
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (u *UserTotpUpsertBulk) ClearConfirmedAt() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.ClearConfirmedAt()
+	})
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (u *UserTotpUpsertBulk) SetLastUsedStep(v int64) *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetLastUsedStep(v)
+	})
+}
+
+// AddLastUsedStep adds v to the "last_used_step" field.
+func (u *UserTotpUpsertBulk) AddLastUsedStep(v int64) *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.AddLastUsedStep(v)
+	})
+}
+
+// UpdateLastUsedStep sets the "last_used_step" field to the value that was provided on create.
+func (u *UserTotpUpsertBulk) UpdateLastUsedStep() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateLastUsedStep()
+	})
+}
+
+// Exec executes the query.
+func (u *UserTotpUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserTotpCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserTotpCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserTotpUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
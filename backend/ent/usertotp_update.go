@@ -0,0 +1,486 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/ent/predicate"
+	"sthl/ent/user"
+	"sthl/ent/usertotp"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserTotpUpdate is the builder for updating UserTotp entities.
+type UserTotpUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserTotpMutation
+}
+
+// Where appends a list predicates to the UserTotpUpdate builder.
+func (utu *UserTotpUpdate) Where(ps ...predicate.UserTotp) *UserTotpUpdate {
+	utu.mutation.Where(ps...)
+	return utu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (utu *UserTotpUpdate) SetUpdatedAt(t time.Time) *UserTotpUpdate {
+	utu.mutation.SetUpdatedAt(t)
+	return utu
+}
+
+// SetUserID sets the "user_id" field.
+func (utu *UserTotpUpdate) SetUserID(u uuid.UUID) *UserTotpUpdate {
+	utu.mutation.SetUserID(u)
+	return utu
+}
+
+// SetSecret sets the "secret" field.
+func (utu *UserTotpUpdate) SetSecret(s string) *UserTotpUpdate {
+	utu.mutation.SetSecret(s)
+	return utu
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (utu *UserTotpUpdate) SetConfirmedAt(t time.Time) *UserTotpUpdate {
+	utu.mutation.SetConfirmedAt(t)
+	return utu
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (utu *UserTotpUpdate) SetNillableConfirmedAt(t *time.Time) *UserTotpUpdate {
+	if t != nil {
+		utu.SetConfirmedAt(*t)
+	}
+	return utu
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (utu *UserTotpUpdate) ClearConfirmedAt() *UserTotpUpdate {
+	utu.mutation.ClearConfirmedAt()
+	return utu
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (utu *UserTotpUpdate) SetLastUsedStep(i int64) *UserTotpUpdate {
+	utu.mutation.ResetLastUsedStep()
+	utu.mutation.SetLastUsedStep(i)
+	return utu
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (utu *UserTotpUpdate) SetNillableLastUsedStep(i *int64) *UserTotpUpdate {
+	if i != nil {
+		utu.SetLastUsedStep(*i)
+	}
+	return utu
+}
+
+// AddLastUsedStep adds i to the "last_used_step" field.
+func (utu *UserTotpUpdate) AddLastUsedStep(i int64) *UserTotpUpdate {
+	utu.mutation.AddLastUsedStep(i)
+	return utu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (utu *UserTotpUpdate) SetOwnerID(id uuid.UUID) *UserTotpUpdate {
+	utu.mutation.SetOwnerID(id)
+	return utu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (utu *UserTotpUpdate) SetOwner(u *User) *UserTotpUpdate {
+	return utu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the UserTotpMutation object of the builder.
+func (utu *UserTotpUpdate) Mutation() *UserTotpMutation {
+	return utu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (utu *UserTotpUpdate) ClearOwner() *UserTotpUpdate {
+	utu.mutation.ClearOwner()
+	return utu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (utu *UserTotpUpdate) Save(ctx context.Context) (int, error) {
+	utu.defaults()
+	return withHooks[int, UserTotpMutation](ctx, utu.sqlSave, utu.mutation, utu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utu *UserTotpUpdate) SaveX(ctx context.Context) int {
+	affected, err := utu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (utu *UserTotpUpdate) Exec(ctx context.Context) error {
+	_, err := utu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utu *UserTotpUpdate) ExecX(ctx context.Context) {
+	if err := utu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (utu *UserTotpUpdate) defaults() {
+	if _, ok := utu.mutation.UpdatedAt(); !ok {
+		v := usertotp.UpdateDefaultUpdatedAt()
+		utu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (utu *UserTotpUpdate) check() error {
+	if v, ok := utu.mutation.Secret(); ok {
+		if err := usertotp.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "UserTotp.secret": %w`, err)}
+		}
+	}
+	if _, ok := utu.mutation.OwnerID(); utu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserTotp.owner"`)
+	}
+	return nil
+}
+
+func (utu *UserTotpUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := utu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usertotp.Table, usertotp.Columns, sqlgraph.NewFieldSpec(usertotp.FieldID, field.TypeUUID))
+	if ps := utu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := utu.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertotp.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := utu.mutation.Secret(); ok {
+		_spec.SetField(usertotp.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := utu.mutation.ConfirmedAt(); ok {
+		_spec.SetField(usertotp.FieldConfirmedAt, field.TypeTime, value)
+	}
+	if utu.mutation.ConfirmedAtCleared() {
+		_spec.ClearField(usertotp.FieldConfirmedAt, field.TypeTime)
+	}
+	if value, ok := utu.mutation.LastUsedStep(); ok {
+		_spec.SetField(usertotp.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := utu.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(usertotp.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if utu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   usertotp.OwnerTable,
+			Columns: []string{usertotp.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := utu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   usertotp.OwnerTable,
+			Columns: []string{usertotp.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, utu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertotp.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	utu.mutation.done = true
+	return n, nil
+}
+
+// UserTotpUpdateOne is the builder for updating a single UserTotp entity.
+type UserTotpUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserTotpMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (utuo *UserTotpUpdateOne) SetUpdatedAt(t time.Time) *UserTotpUpdateOne {
+	utuo.mutation.SetUpdatedAt(t)
+	return utuo
+}
+
+// SetUserID sets the "user_id" field.
+func (utuo *UserTotpUpdateOne) SetUserID(u uuid.UUID) *UserTotpUpdateOne {
+	utuo.mutation.SetUserID(u)
+	return utuo
+}
+
+// SetSecret sets the "secret" field.
+func (utuo *UserTotpUpdateOne) SetSecret(s string) *UserTotpUpdateOne {
+	utuo.mutation.SetSecret(s)
+	return utuo
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (utuo *UserTotpUpdateOne) SetConfirmedAt(t time.Time) *UserTotpUpdateOne {
+	utuo.mutation.SetConfirmedAt(t)
+	return utuo
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (utuo *UserTotpUpdateOne) SetNillableConfirmedAt(t *time.Time) *UserTotpUpdateOne {
+	if t != nil {
+		utuo.SetConfirmedAt(*t)
+	}
+	return utuo
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (utuo *UserTotpUpdateOne) ClearConfirmedAt() *UserTotpUpdateOne {
+	utuo.mutation.ClearConfirmedAt()
+	return utuo
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (utuo *UserTotpUpdateOne) SetLastUsedStep(i int64) *UserTotpUpdateOne {
+	utuo.mutation.ResetLastUsedStep()
+	utuo.mutation.SetLastUsedStep(i)
+	return utuo
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (utuo *UserTotpUpdateOne) SetNillableLastUsedStep(i *int64) *UserTotpUpdateOne {
+	if i != nil {
+		utuo.SetLastUsedStep(*i)
+	}
+	return utuo
+}
+
+// AddLastUsedStep adds i to the "last_used_step" field.
+func (utuo *UserTotpUpdateOne) AddLastUsedStep(i int64) *UserTotpUpdateOne {
+	utuo.mutation.AddLastUsedStep(i)
+	return utuo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (utuo *UserTotpUpdateOne) SetOwnerID(id uuid.UUID) *UserTotpUpdateOne {
+	utuo.mutation.SetOwnerID(id)
+	return utuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (utuo *UserTotpUpdateOne) SetOwner(u *User) *UserTotpUpdateOne {
+	return utuo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the UserTotpMutation object of the builder.
+func (utuo *UserTotpUpdateOne) Mutation() *UserTotpMutation {
+	return utuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (utuo *UserTotpUpdateOne) ClearOwner() *UserTotpUpdateOne {
+	utuo.mutation.ClearOwner()
+	return utuo
+}
+
+// Where appends a list predicates to the UserTotpUpdate builder.
+func (utuo *UserTotpUpdateOne) Where(ps ...predicate.UserTotp) *UserTotpUpdateOne {
+	utuo.mutation.Where(ps...)
+	return utuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (utuo *UserTotpUpdateOne) Select(field string, fields ...string) *UserTotpUpdateOne {
+	utuo.fields = append([]string{field}, fields...)
+	return utuo
+}
+
+// Save executes the query and returns the updated UserTotp entity.
+func (utuo *UserTotpUpdateOne) Save(ctx context.Context) (*UserTotp, error) {
+	utuo.defaults()
+	return withHooks[*UserTotp, UserTotpMutation](ctx, utuo.sqlSave, utuo.mutation, utuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utuo *UserTotpUpdateOne) SaveX(ctx context.Context) *UserTotp {
+	node, err := utuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (utuo *UserTotpUpdateOne) Exec(ctx context.Context) error {
+	_, err := utuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utuo *UserTotpUpdateOne) ExecX(ctx context.Context) {
+	if err := utuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (utuo *UserTotpUpdateOne) defaults() {
+	if _, ok := utuo.mutation.UpdatedAt(); !ok {
+		v := usertotp.UpdateDefaultUpdatedAt()
+		utuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (utuo *UserTotpUpdateOne) check() error {
+	if v, ok := utuo.mutation.Secret(); ok {
+		if err := usertotp.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "UserTotp.secret": %w`, err)}
+		}
+	}
+	if _, ok := utuo.mutation.OwnerID(); utuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserTotp.owner"`)
+	}
+	return nil
+}
+
+func (utuo *UserTotpUpdateOne) sqlSave(ctx context.Context) (_node *UserTotp, err error) {
+	if err := utuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usertotp.Table, usertotp.Columns, sqlgraph.NewFieldSpec(usertotp.FieldID, field.TypeUUID))
+	id, ok := utuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserTotp.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := utuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usertotp.FieldID)
+		for _, f := range fields {
+			if !usertotp.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usertotp.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := utuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := utuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertotp.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := utuo.mutation.Secret(); ok {
+		_spec.SetField(usertotp.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := utuo.mutation.ConfirmedAt(); ok {
+		_spec.SetField(usertotp.FieldConfirmedAt, field.TypeTime, value)
+	}
+	if utuo.mutation.ConfirmedAtCleared() {
+		_spec.ClearField(usertotp.FieldConfirmedAt, field.TypeTime)
+	}
+	if value, ok := utuo.mutation.LastUsedStep(); ok {
+		_spec.SetField(usertotp.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := utuo.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(usertotp.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if utuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   usertotp.OwnerTable,
+			Columns: []string{usertotp.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := utuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   usertotp.OwnerTable,
+			Columns: []string{usertotp.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UserTotp{config: utuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, utuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertotp.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	utuo.mutation.done = true
+	return _node, nil
+}
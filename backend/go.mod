@@ -3,6 +3,7 @@ module sthl
 go 1.20
 
 require (
+	ariga.io/atlas v0.9.1-0.20230119145809-92243f7c55cb
 	entgo.io/ent v0.11.8
 	github.com/aws/aws-sdk-go v1.44.248
 	github.com/brianvoe/gofakeit/v6 v6.20.1
@@ -25,7 +26,6 @@ require (
 )
 
 require (
-	ariga.io/atlas v0.9.1-0.20230119145809-92243f7c55cb // indirect
 	github.com/Azure/go-ansiterm v0.0.0-20230124172434-306776ec8161 // indirect
 	github.com/KyleBanks/depth v1.2.1 // indirect
 	github.com/Microsoft/go-winio v0.6.0 // indirect
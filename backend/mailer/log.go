@@ -0,0 +1,84 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// LogMailer: for local dev and tests, never deliver mail,
+// append to file if set, otherwise write to log; sent messages are kept in memory
+type LogMailer struct {
+	base
+	logger *zap.Logger
+	file   string
+	sent   []Message
+	mu     sync.Mutex
+}
+
+func NewLogMailer(logger *zap.Logger, from string, linkBaseUrl string, file string) *LogMailer {
+	return &LogMailer{
+		base:   base{from: from, linkBaseUrl: linkBaseUrl},
+		logger: logger,
+		file:   file,
+		sent:   []Message{},
+	}
+}
+
+// Send
+func (m *LogMailer) Send(ctx context.Context, msg *Message) error {
+	if msg == nil || msg.To == "" {
+		return fmt.Errorf("message or recipient is empty")
+	}
+	filled := m.withFrom(msg)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.file != "" {
+		f, err := os.OpenFile(m.file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			m.logger.Info("fail to open mail log file", zap.Error(err))
+			return err
+		}
+		defer f.Close()
+		_, err = fmt.Fprintf(f, "Date: %s\n%s\n----\n", time.Now().Format(time.RFC3339), buildMime(&filled))
+		if err != nil {
+			m.logger.Info("fail to write mail log file", zap.Error(err))
+			return err
+		}
+	} else {
+		m.logger.Info("mail",
+			zap.String("from", filled.From),
+			zap.String("to", filled.To),
+			zap.String("subject", filled.Subject),
+			zap.String("body", filled.Body))
+	}
+	m.sent = append(m.sent, filled)
+	return nil
+}
+
+// Sent: copy of sent messages
+func (m *LogMailer) Sent() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make([]Message, len(m.sent))
+	copy(result, m.sent)
+	return result
+}
+
+// LastSentTo: latest message sent to recipient
+func (m *LogMailer) LastSentTo(to string) (*Message, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := len(m.sent) - 1; i >= 0; i-- {
+		if m.sent[i].To == to {
+			msg := m.sent[i]
+			return &msg, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,98 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sthl/config"
+	"sthl/constants"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Message: plain text mail
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer: pluggable mail delivery,
+// Link builds absolute links to the frontend for use in mail body
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+	Link(path string, query url.Values) string
+}
+
+// NewMailerFromConfig: build mailer from config for fx
+func NewMailerFromConfig(l *zap.Logger, cfg *config.Config) (Mailer, error) {
+	switch cfg.GetMailer() {
+	case constants.MailerType.Smtp:
+		return NewSmtpMailer(l, cfg.GetMailFrom(), cfg.GetMailLinkBaseUrl(),
+			cfg.GetSmtpHost(), cfg.GetSmtpPort(), cfg.GetSmtpUsername(), cfg.GetSmtpPassword()), nil
+	case constants.MailerType.Log:
+		return NewLogMailer(l, cfg.GetMailFrom(), cfg.GetMailLinkBaseUrl(), cfg.GetMailLogFile()), nil
+	default:
+		return nil, fmt.Errorf("unsupported mailer: %s", cfg.GetMailer())
+	}
+}
+
+// NewEmailVerificationMessage
+func NewEmailVerificationMessage(m Mailer, to string, token string) *Message {
+	link := m.Link(constants.EmailVerificationPath, url.Values{"token": []string{token}})
+	return &Message{
+		To:      to,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Please verify your email by opening the link below, it expires in %s.\n\n%s\n",
+			constants.EmailVerificationTokenDuration, link),
+	}
+}
+
+// NewPasswordResetMessage
+func NewPasswordResetMessage(m Mailer, to string, token string) *Message {
+	link := m.Link(constants.PasswordResetPath, url.Values{"token": []string{token}})
+	return &Message{
+		To:      to,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("A password reset was requested for your account, open the link below to set a new password, it expires in %s.\n"+
+			"If you did not request it, ignore this mail.\n\n%s\n",
+			constants.PasswordResetTokenDuration, link),
+	}
+}
+
+// NewShopInviteMessage
+func NewShopInviteMessage(m Mailer, to string, shopName string, role string, token string) *Message {
+	link := m.Link(constants.ShopInvitePath, url.Values{"token": []string{token}})
+	return &Message{
+		To:      to,
+		Subject: "You are invited to join " + shopName,
+		Body: fmt.Sprintf("You are invited to join %s as %s, sign in with this email and open the link below to accept, it expires in %s.\n\n%s\n",
+			shopName, role, constants.ShopInviteDuration, link),
+	}
+}
+
+// base: shared sender and link base url
+type base struct {
+	from        string
+	linkBaseUrl string
+}
+
+// Link
+func (b *base) Link(path string, query url.Values) string {
+	link := strings.TrimRight(b.linkBaseUrl, "/") + path
+	if len(query) > 0 {
+		link += "?" + query.Encode()
+	}
+	return link
+}
+
+// withFrom: fill default sender
+func (b *base) withFrom(msg *Message) Message {
+	result := *msg
+	if result.From == "" {
+		result.From = b.from
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// SmtpMailer: deliver mail through smtp server,
+// auth is skipped if username is empty
+type SmtpMailer struct {
+	base
+	logger   *zap.Logger
+	addr     string
+	host     string
+	username string
+	password string
+}
+
+func NewSmtpMailer(logger *zap.Logger, from string, linkBaseUrl string,
+	host string, port int, username string, password string) *SmtpMailer {
+	return &SmtpMailer{
+		base:     base{from: from, linkBaseUrl: linkBaseUrl},
+		logger:   logger,
+		addr:     fmt.Sprintf("%s:%d", host, port),
+		host:     host,
+		username: username,
+		password: password,
+	}
+}
+
+// Send
+func (m *SmtpMailer) Send(ctx context.Context, msg *Message) error {
+	if msg == nil || msg.To == "" {
+		return fmt.Errorf("message or recipient is empty")
+	}
+	filled := m.withFrom(msg)
+
+	var auth smtp.Auth
+	if m.username != "" {
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	}
+	err := smtp.SendMail(m.addr, auth, filled.From, []string{filled.To}, buildMime(&filled))
+	if err != nil {
+		m.logger.Info("fail to smtp.SendMail", zap.String("to", filled.To), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// buildMime: headers and plain text body
+func buildMime(msg *Message) []byte {
+	var sb strings.Builder
+	sb.WriteString("From: " + msg.From + "\r\n")
+	sb.WriteString("To: " + msg.To + "\r\n")
+	sb.WriteString("Subject: " + msg.Subject + "\r\n")
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	sb.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	sb.WriteString("\r\n")
+	sb.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return []byte(sb.String())
+}
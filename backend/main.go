@@ -3,9 +3,11 @@ package main
 import (
 	"net/http"
 	"sthl/api"
+	"sthl/authentication"
 	"sthl/config"
 	_ "sthl/docs"
 	"sthl/logger"
+	"sthl/mailer"
 	"sthl/repository"
 	"sthl/server"
 	"sthl/service"
@@ -35,16 +37,27 @@ func main() {
 		fx.Provide(
 			logger.NewDevInfoZapLogger,
 			config.NewConfig,
+			authentication.NewKeySetFromConfig,
+			mailer.NewMailerFromConfig,
 
 			// db client
 			storage.NewPostgresDb,
-			storage.NewS3Client,
+			storage.NewBlobStoreFromConfig,
 			// repos
 			repository.NewUserRepository,
+			repository.NewRefreshTokenRepository,
+			repository.NewEmailVerificationRepository,
+			repository.NewPasswordResetRepository,
+			repository.NewLoginAttemptRepositoryFromConfig,
 			repository.NewProductRepository,
 			repository.NewOrderRepository,
 			repository.NewSiteUiRepository,
 			repository.NewImgInfoRepository,
+			repository.NewShopRepository,
+			repository.NewApiKeyRepository,
+			repository.NewMfaRepository,
+			repository.NewIdempotencyRepository,
+			repository.NewCategoryRepository,
 
 			// services
 			service.NewUserService,
@@ -52,14 +65,21 @@ func main() {
 			service.NewOrderService,
 			service.NewSiteUiService,
 			service.NewAlbumService,
+			service.NewShopService,
+			service.NewIdempotencyService,
+			service.NewCategoryService,
+			service.NewUsageService,
 
 			// http
 			api.NewHandler,
 			api.NewChiRouter,
 			server.NewHttpServer,
+			// background
+			server.NewReservationSweeper,
+			server.NewIdempotencyKeySweeper,
 		),
 		fx.Invoke(
-			func(*http.Server) {
+			func(*http.Server, *server.ReservationSweeper, *server.IdempotencyKeySweeper) {
 			},
 		),
 	).Run()
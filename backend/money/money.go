@@ -0,0 +1,163 @@
+package money
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+)
+
+const (
+	// AmountScale: minor units per major unit, currencies with AmountDigits minor digits only
+	AmountScale int64 = 100
+	// AmountDigits: minor digits of AmountScale
+	AmountDigits int = 2
+	// RateScale: rate stored as parts per million
+	RateScale int64 = 1000000
+	// RateDigits: fraction digits of RateScale
+	RateDigits int = 6
+	// RateOne: rate of 1, i.e. no discount
+	RateOne Rate = Rate(RateScale)
+)
+
+var (
+	ErrInvalidNumber = errors.New("invalid decimal number")
+	// plainDecimal: optional sign, digits and optional fraction, no ratio or exponent forms
+	plainDecimal = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+)
+
+// Amount: money in integer minor units (cents),
+// json encoded as decimal number in major units for frontend compatibility
+type Amount int64
+
+// Rate: ratio in parts per million, used for discount multiplier and tax rate,
+// json encoded as decimal number
+type Rate int64
+
+// ParseAmount: plain decimal string with at most AmountDigits fraction digits to amount
+func ParseAmount(s string) (Amount, error) {
+	v, err := parseScaled(s, AmountScale, AmountDigits)
+	return Amount(v), err
+}
+
+// ParseRate: plain decimal string with at most RateDigits fraction digits to rate
+func ParseRate(s string) (Rate, error) {
+	v, err := parseScaled(s, RateScale, RateDigits)
+	return Rate(v), err
+}
+
+// Mul: amount times quantity
+func (a Amount) Mul(quantity int) Amount {
+	return a * Amount(quantity)
+}
+
+// ApplyRate: amount times rate, rounding half away from zero to minor units
+func (a Amount) ApplyRate(r Rate) Amount {
+	return Amount(divRound(int64(a)*int64(r), RateScale))
+}
+
+// Float64: major units, for logging only
+func (a Amount) Float64() float64 {
+	return float64(a) / float64(AmountScale)
+}
+
+func (a Amount) String() string {
+	return formatScaled(int64(a), AmountScale, AmountDigits, false)
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	// null is no-op by convention
+	if string(data) == "null" {
+		return nil
+	}
+	v, err := ParseAmount(string(bytes.Trim(data, `"`)))
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+func (r Rate) String() string {
+	return formatScaled(int64(r), RateScale, RateDigits, true)
+}
+
+func (r Rate) MarshalJSON() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Rate) UnmarshalJSON(data []byte) error {
+	// null is no-op by convention
+	if string(data) == "null" {
+		return nil
+	}
+	v, err := ParseRate(string(bytes.Trim(data, `"`)))
+	if err != nil {
+		return err
+	}
+	*r = v
+	return nil
+}
+
+// parseScaled: plain decimal string times scale as integer, exact without float,
+// ErrInvalidNumber for other forms or more than digits fraction digits
+func parseScaled(s string, scale int64, digits int) (int64, error) {
+	if !plainDecimal.MatchString(s) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidNumber, s)
+	}
+	dot := bytes.IndexByte([]byte(s), '.')
+	if dot >= 0 && len(s)-dot-1 > digits {
+		return 0, fmt.Errorf("%w: %q has more than %d fraction digits", ErrInvalidNumber, s, digits)
+	}
+	rat, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidNumber, s)
+	}
+	rat.Mul(rat, new(big.Rat).SetInt64(scale))
+	q := rat.Num()
+	if !rat.IsInt() || !q.IsInt64() {
+		return 0, fmt.Errorf("%w: %q out of range", ErrInvalidNumber, s)
+	}
+	return q.Int64(), nil
+}
+
+// divRound: a / b rounding half away from zero, b > 0
+func divRound(a int64, b int64) int64 {
+	q, r := a/b, a%b
+	if r < 0 {
+		r = -r
+	}
+	if r*2 >= b {
+		if a < 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q
+}
+
+// formatScaled: integer to decimal string with digits fraction digits
+func formatScaled(v int64, scale int64, digits int, trimZeros bool) string {
+	sign := ""
+	u := uint64(v)
+	if v < 0 {
+		sign = "-"
+		u = uint64(-v)
+	}
+	whole := strconv.FormatUint(u/uint64(scale), 10)
+	frac := fmt.Sprintf("%0*d", digits, u%uint64(scale))
+	if trimZeros {
+		frac = string(bytes.TrimRight([]byte(frac), "0"))
+		if frac == "" {
+			return sign + whole
+		}
+	}
+	return sign + whole + "." + frac
+}
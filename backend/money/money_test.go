@@ -0,0 +1,108 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ****Test_ParseAmount
+type parseAmountTestCase struct {
+	input    string
+	expected Amount
+	isErr    bool
+}
+
+func Test_ParseAmount(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []parseAmountTestCase{
+		{"12.34", 1234, false},
+		{"12", 1200, false},
+		{"0.1", 10, false},
+		{"19.99", 1999, false},
+		{"-0.05", -5, false},
+		{"007.10", 710, false},
+		{"59.970000000000006", 0, true},
+		{"0.005", 0, true},
+		{"1e2", 0, true},
+		{"1E2", 0, true},
+		{"1/3", 0, true},
+		{"+1", 0, true},
+		{".5", 0, true},
+		{"1.", 0, true},
+		{" 1", 0, true},
+		{"0x10", 0, true},
+		{"abc", 0, true},
+		{"", 0, true},
+		{"100000000000000000000", 0, true},
+	}
+	for _, test := range testCases {
+		t.Run(test.input, func(t *testing.T) {
+			result, err := ParseAmount(test.input)
+			if test.isErr {
+				assert.ErrorIs(err, ErrInvalidNumber)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(test.expected, result)
+		})
+	}
+}
+
+// ****Test_ParseRate
+func Test_ParseRate(t *testing.T) {
+	assert := assert.New(t)
+	rate, err := ParseRate("0.0825")
+	assert.NoError(err)
+	assert.Equal(Rate(82500), rate)
+	rate, err = ParseRate("1")
+	assert.NoError(err)
+	assert.Equal(RateOne, rate)
+	for _, input := range []string{"0.0000001", "1/2", "5e-1", "-1e0"} {
+		_, err = ParseRate(input)
+		assert.ErrorIs(err, ErrInvalidNumber, input)
+	}
+}
+
+// ****Test_ApplyRate
+func Test_ApplyRate(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(Amount(1000), Amount(1000).ApplyRate(RateOne))
+	assert.Equal(Amount(900), Amount(1000).ApplyRate(Rate(900000)))
+	// 50.97 * 8.25% = 4.205025
+	assert.Equal(Amount(421), Amount(5097).ApplyRate(Rate(82500)))
+	// 0.05 * 50% = 0.025
+	assert.Equal(Amount(3), Amount(5).ApplyRate(Rate(500000)))
+	assert.Equal(Amount(-3), Amount(-5).ApplyRate(Rate(500000)))
+	assert.Equal(Amount(0), Amount(1000).ApplyRate(Rate(0)))
+}
+
+// ****Test_MoneyJson, decimal numbers as before integer minor units
+type moneyJsonTestStruct struct {
+	Price    Amount  `json:"price"`
+	Discount Rate    `json:"discount"`
+	Total    *Amount `json:"total"`
+}
+
+func Test_MoneyJson(t *testing.T) {
+	assert := assert.New(t)
+
+	data, err := json.Marshal(moneyJsonTestStruct{Price: 1234, Discount: 850000, Total: nil})
+	assert.NoError(err)
+	assert.JSONEq(`{"price":12.34,"discount":0.85,"total":null}`, string(data))
+
+	data, err = json.Marshal(moneyJsonTestStruct{Price: -5, Discount: RateOne})
+	assert.NoError(err)
+	assert.JSONEq(`{"price":-0.05,"discount":1,"total":null}`, string(data))
+
+	var result moneyJsonTestStruct
+	err = json.Unmarshal([]byte(`{"price":12.3,"discount":0.0825,"total":"7.5"}`), &result)
+	assert.NoError(err)
+	assert.Equal(Amount(1230), result.Price)
+	assert.Equal(Rate(82500), result.Discount)
+	assert.Equal(Amount(750), *result.Total)
+
+	err = json.Unmarshal([]byte(`{"price":true}`), &result)
+	assert.Error(err)
+}
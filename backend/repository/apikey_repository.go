@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/ent/apikey"
+	"sthl/storage"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type IApiKeyRepository interface {
+	WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error
+	CreateApiKey(ctx context.Context, client *ent.Client, userId string, shopId *string,
+		name string, prefix string, keyHash string, scopes []string, expiresAt time.Time) (*ent.ApiKey, error)
+	GetApiKeyByHash(ctx context.Context, client *ent.Client, keyHash string) (*ent.ApiKey, error)
+	GetApiKeysByUserId(ctx context.Context, client *ent.Client, userId string) ([]*ent.ApiKey, error)
+	RevokeApiKeyById(ctx context.Context, client *ent.Client, userId string, apiKeyId string) (bool, error)
+	TouchApiKeyById(ctx context.Context, client *ent.Client, apiKeyId string, usedAt time.Time) error
+}
+
+type ApiKeyRepository struct {
+	logger *zap.Logger
+}
+
+func NewApiKeyRepository(logger *zap.Logger) IApiKeyRepository {
+	return &ApiKeyRepository{
+		logger: logger,
+	}
+}
+func (akRepo *ApiKeyRepository) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTx(ctx, akRepo.logger, client, fn)
+}
+
+// CreateApiKey
+func (akRepo *ApiKeyRepository) CreateApiKey(ctx context.Context, client *ent.Client, userId string, shopId *string,
+	name string, prefix string, keyHash string, scopes []string, expiresAt time.Time) (*ent.ApiKey, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		akRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	create := client.ApiKey.Create().
+		SetUserID(userUuid).
+		SetName(name).
+		SetPrefix(prefix).
+		SetKeyHash(keyHash).
+		SetScopes(scopes).
+		SetExpiresAt(expiresAt)
+	if shopId != nil {
+		shopUuid, err := uuid.Parse(*shopId)
+		if err != nil {
+			akRepo.logger.Info("fail to parse shopId to uuid", zap.Error(err))
+			return nil, constants.ErrBadRequest
+		}
+		create = create.SetShopID(shopUuid)
+	}
+	result, err := create.Save(ctx)
+	if err != nil {
+		akRepo.logger.Info("fail to client.ApiKey.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetApiKeyByHash
+func (akRepo *ApiKeyRepository) GetApiKeyByHash(ctx context.Context, client *ent.Client, keyHash string) (*ent.ApiKey, error) {
+	result, err := client.ApiKey.Query().
+		Where(apikey.KeyHash(keyHash)).
+		Only(ctx)
+	if err != nil {
+		akRepo.logger.Info("fail to client.ApiKey.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetApiKeysByUserId: newest first
+func (akRepo *ApiKeyRepository) GetApiKeysByUserId(ctx context.Context, client *ent.Client, userId string) ([]*ent.ApiKey, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		akRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.ApiKey.Query().
+		Where(apikey.UserID(userUuid)).
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		akRepo.logger.Info("fail to client.ApiKey.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// RevokeApiKeyById: conditional revoke of user's own key,
+// return false if key not found or already revoked
+func (akRepo *ApiKeyRepository) RevokeApiKeyById(ctx context.Context, client *ent.Client, userId string, apiKeyId string) (bool, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		akRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+	apiKeyUuid, err := uuid.Parse(apiKeyId)
+	if err != nil {
+		akRepo.logger.Info("fail to parse apiKeyId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	affected, err := client.ApiKey.Update().
+		Where(apikey.ID(apiKeyUuid), apikey.UserID(userUuid), apikey.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		akRepo.logger.Info("fail to client.ApiKey.Update", zap.Error(err))
+		return false, handleEntRepoErr(err)
+	}
+	return affected == 1, nil
+}
+
+// TouchApiKeyById: update last_used_at at most once per ApiKeyLastUsedInterval
+func (akRepo *ApiKeyRepository) TouchApiKeyById(ctx context.Context, client *ent.Client, apiKeyId string, usedAt time.Time) error {
+	apiKeyUuid, err := uuid.Parse(apiKeyId)
+	if err != nil {
+		akRepo.logger.Info("fail to parse apiKeyId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+
+	_, err = client.ApiKey.Update().
+		Where(
+			apikey.ID(apiKeyUuid),
+			apikey.Or(
+				apikey.LastUsedAtIsNil(),
+				apikey.LastUsedAtLT(usedAt.Add(-constants.ApiKeyLastUsedInterval)),
+			),
+		).
+		SetLastUsedAt(usedAt).
+		Save(ctx)
+	if err != nil {
+		akRepo.logger.Info("fail to client.ApiKey.Update", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/storage"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ApiKeyRepositoryMock struct {
+	mockData map[string]ent.ApiKey
+	mu       sync.Mutex
+}
+
+func NewApiKeyRepositoryMock() IApiKeyRepository {
+	return &ApiKeyRepositoryMock{
+		mockData: map[string]ent.ApiKey{},
+	}
+}
+
+func (m *ApiKeyRepositoryMock) Lock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+}
+func (m *ApiKeyRepositoryMock) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTxTest(ctx, nil, client, fn)
+}
+
+// ****
+
+// CreateApiKey
+func (m *ApiKeyRepositoryMock) CreateApiKey(ctx context.Context, client *ent.Client, userId string, shopId *string,
+	name string, prefix string, keyHash string, scopes []string, expiresAt time.Time) (*ent.ApiKey, error) {
+	m.Lock()
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	var shopUuid *uuid.UUID
+	if shopId != nil {
+		parsed, err := uuid.Parse(*shopId)
+		if err != nil {
+			return nil, constants.ErrBadRequest
+		}
+		shopUuid = &parsed
+	}
+	for _, data := range m.mockData {
+		if data.Prefix == prefix || data.KeyHash == keyHash {
+			return nil, constants.ErrExisted
+		}
+	}
+	t := time.Now()
+	result := &ent.ApiKey{
+		ID:        uuid.New(),
+		CreatedAt: t,
+		UpdatedAt: t,
+		UserID:    userUuid,
+		ShopID:    shopUuid,
+		Name:      name,
+		Prefix:    prefix,
+		KeyHash:   keyHash,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	}
+	m.mockData[result.ID.String()] = *result
+	return result, nil
+}
+
+// GetApiKeyByHash
+func (m *ApiKeyRepositoryMock) GetApiKeyByHash(ctx context.Context, client *ent.Client, keyHash string) (*ent.ApiKey, error) {
+	m.Lock()
+	for _, data := range m.mockData {
+		if data.KeyHash == keyHash {
+			d := data
+			return &d, nil
+		}
+	}
+	return nil, constants.ErrNotFound
+}
+
+// GetApiKeysByUserId
+func (m *ApiKeyRepositoryMock) GetApiKeysByUserId(ctx context.Context, client *ent.Client, userId string) ([]*ent.ApiKey, error) {
+	m.Lock()
+	result := []*ent.ApiKey{}
+	for _, data := range m.mockData {
+		if data.UserID.String() == userId {
+			d := data
+			result = append(result, &d)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+// RevokeApiKeyById
+func (m *ApiKeyRepositoryMock) RevokeApiKeyById(ctx context.Context, client *ent.Client, userId string, apiKeyId string) (bool, error) {
+	m.Lock()
+	value, exist := m.mockData[apiKeyId]
+	if !exist || value.UserID.String() != userId || value.RevokedAt != nil {
+		return false, nil
+	}
+	t := time.Now()
+	value.RevokedAt = &t
+	m.mockData[apiKeyId] = value
+	return true, nil
+}
+
+// TouchApiKeyById
+func (m *ApiKeyRepositoryMock) TouchApiKeyById(ctx context.Context, client *ent.Client, apiKeyId string, usedAt time.Time) error {
+	m.Lock()
+	value, exist := m.mockData[apiKeyId]
+	if !exist {
+		return nil
+	}
+	if value.LastUsedAt == nil || value.LastUsedAt.Before(usedAt.Add(-constants.ApiKeyLastUsedInterval)) {
+		value.LastUsedAt = &usedAt
+		m.mockData[apiKeyId] = value
+	}
+	return nil
+}
@@ -0,0 +1,325 @@
+package repository
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/ent/category"
+	"sthl/ent/collection"
+	"sthl/ent/product"
+	"sthl/storage"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+type ICategoryRepository interface {
+	WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error
+	CreateCategory(ctx context.Context, client *ent.Client, userId string, payload *dto.CreateCategoryDto) (*ent.Category, error)
+	GetCategoriesByUserId(ctx context.Context, client *ent.Client, userId string) ([]*ent.Category, error)
+	LockCategoriesByUserId(ctx context.Context, client *ent.Client, userId string) ([]*ent.Category, error)
+	UpdateCategoryById(ctx context.Context, client *ent.Client, categoryId string, version int64, payload *dto.UpdateCategoryDto) (*ent.Category, error)
+	DeleteCategoryById(ctx context.Context, client *ent.Client, categoryId string, version int64) error
+	CreateCollection(ctx context.Context, client *ent.Client, userId string, payload *dto.CreateCollectionDto) (*ent.Collection, error)
+	GetCollectionsByUserId(ctx context.Context, client *ent.Client, userId string) ([]*ent.Collection, error)
+	GetCollectionById(ctx context.Context, client *ent.Client, collectionId string) (*ent.Collection, error)
+	UpdateCollectionById(ctx context.Context, client *ent.Client, collectionId string, version int64, payload *dto.UpdateCollectionDto) (*ent.Collection, error)
+	DeleteCollectionById(ctx context.Context, client *ent.Client, collectionId string, version int64) error
+	GetCollectionProductIdsById(ctx context.Context, client *ent.Client, collectionId string) ([]string, error)
+	SetCollectionProductsById(ctx context.Context, client *ent.Client, collectionId string, productIds []string) error
+}
+
+type CategoryRepository struct {
+	logger *zap.Logger
+}
+
+func NewCategoryRepository(logger *zap.Logger) ICategoryRepository {
+	return &CategoryRepository{
+		logger: logger,
+	}
+}
+func (categoryRepo *CategoryRepository) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTx(ctx, categoryRepo.logger, client, fn)
+}
+
+// CreateCategory
+func (categoryRepo *CategoryRepository) CreateCategory(
+	ctx context.Context, client *ent.Client, userId string, payload *dto.CreateCategoryDto) (*ent.Category, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		categoryRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	create := client.Category.Create().
+		SetUserID(userUuid).
+		SetName(*payload.Name).
+		SetSlug(*payload.Slug).
+		SetDescription(*payload.Description).
+		SetPosition(*payload.Position)
+	if payload.ParentId != nil {
+		parentUuid, err := uuid.Parse(*payload.ParentId)
+		if err != nil {
+			categoryRepo.logger.Info("fail to parse parentId to uuid", zap.Error(err))
+			return nil, constants.ErrBadRequest
+		}
+		create.SetParentID(parentUuid)
+	}
+	result, err := create.Save(ctx)
+	if err != nil {
+		categoryRepo.logger.Info("fail to client.Category.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetCategoriesByUserId: all categories of shop in display order, tree built by parentId
+func (categoryRepo *CategoryRepository) GetCategoriesByUserId(
+	ctx context.Context, client *ent.Client, userId string) ([]*ent.Category, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		categoryRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	result, err := client.Category.Query().
+		Where(category.UserID(userUuid)).
+		Order(ent.Asc(category.FieldPosition), ent.Asc(category.FieldName)).
+		All(ctx)
+	if err != nil {
+		categoryRepo.logger.Info("fail to client.Category.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// LockCategoriesByUserId: select for update all categories of shop in id order,
+// category writes of one shop are serialized so the tree stays acyclic, must be called with a tx client
+func (categoryRepo *CategoryRepository) LockCategoriesByUserId(
+	ctx context.Context, client *ent.Client, userId string) ([]*ent.Category, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		categoryRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	result, err := client.Category.Query().
+		Where(category.UserID(userUuid)).
+		Order(ent.Asc(category.FieldID)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		categoryRepo.logger.Info("fail to client.Category.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// UpdateCategoryById: conditional on version, ErrPreconditionFailed if version changed
+func (categoryRepo *CategoryRepository) UpdateCategoryById(
+	ctx context.Context, client *ent.Client, categoryId string, version int64, payload *dto.UpdateCategoryDto) (*ent.Category, error) {
+	categoryUuid, err := uuid.Parse(categoryId)
+	if err != nil {
+		categoryRepo.logger.Info("fail to parse categoryId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	update := client.Category.UpdateOneID(categoryUuid).
+		Where(category.Version(version)).
+		SetName(*payload.Name).
+		SetSlug(*payload.Slug).
+		SetDescription(*payload.Description).
+		SetPosition(*payload.Position).
+		AddVersion(1)
+	if payload.ParentId != nil {
+		parentUuid, err := uuid.Parse(*payload.ParentId)
+		if err != nil {
+			categoryRepo.logger.Info("fail to parse parentId to uuid", zap.Error(err))
+			return nil, constants.ErrBadRequest
+		}
+		update.SetParentID(parentUuid)
+	} else {
+		update.ClearParentID()
+	}
+	result, err := update.Save(ctx)
+	if err != nil {
+		categoryRepo.logger.Info("fail to client.Category.UpdateOneID", zap.Error(err))
+		return nil, handleEntVersionErr(ctx, err, client.Category.Query().Where(category.ID(categoryUuid)).Exist)
+	}
+	return result, nil
+}
+
+// DeleteCategoryById: conditional on version, product assignments removed with category
+func (categoryRepo *CategoryRepository) DeleteCategoryById(
+	ctx context.Context, client *ent.Client, categoryId string, version int64) error {
+	categoryUuid, err := uuid.Parse(categoryId)
+	if err != nil {
+		categoryRepo.logger.Info("fail to parse categoryId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+	affected, err := client.Category.Delete().
+		Where(category.ID(categoryUuid), category.Version(version)).
+		Exec(ctx)
+	if err != nil {
+		categoryRepo.logger.Info("fail to client.Category.Delete", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+	// stale version if row still exists
+	if affected == 0 {
+		ok, err := client.Category.Query().Where(category.ID(categoryUuid)).Exist(ctx)
+		if err == nil && ok {
+			return constants.ErrPreconditionFailed
+		}
+		return constants.ErrNotFound
+	}
+	return nil
+}
+
+// CreateCollection
+func (categoryRepo *CategoryRepository) CreateCollection(
+	ctx context.Context, client *ent.Client, userId string, payload *dto.CreateCollectionDto) (*ent.Collection, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		categoryRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	result, err := client.Collection.Create().
+		SetUserID(userUuid).
+		SetName(*payload.Name).
+		SetSlug(*payload.Slug).
+		SetDescription(*payload.Description).
+		SetKind(*payload.Kind).
+		SetRules(payload.Rules).
+		Save(ctx)
+	if err != nil {
+		categoryRepo.logger.Info("fail to client.Collection.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetCollectionsByUserId
+func (categoryRepo *CategoryRepository) GetCollectionsByUserId(
+	ctx context.Context, client *ent.Client, userId string) ([]*ent.Collection, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		categoryRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	result, err := client.Collection.Query().
+		Where(collection.UserID(userUuid)).
+		Order(ent.Asc(collection.FieldName)).
+		All(ctx)
+	if err != nil {
+		categoryRepo.logger.Info("fail to client.Collection.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetCollectionById
+func (categoryRepo *CategoryRepository) GetCollectionById(
+	ctx context.Context, client *ent.Client, collectionId string) (*ent.Collection, error) {
+	collectionUuid, err := uuid.Parse(collectionId)
+	if err != nil {
+		categoryRepo.logger.Info("fail to parse collectionId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	result, err := client.Collection.Get(ctx, collectionUuid)
+	if err != nil {
+		categoryRepo.logger.Info("fail to client.Collection.Get", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// UpdateCollectionById: conditional on version, ErrPreconditionFailed if version changed
+func (categoryRepo *CategoryRepository) UpdateCollectionById(
+	ctx context.Context, client *ent.Client, collectionId string, version int64, payload *dto.UpdateCollectionDto) (*ent.Collection, error) {
+	collectionUuid, err := uuid.Parse(collectionId)
+	if err != nil {
+		categoryRepo.logger.Info("fail to parse collectionId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	result, err := client.Collection.UpdateOneID(collectionUuid).
+		Where(collection.Version(version)).
+		SetName(*payload.Name).
+		SetSlug(*payload.Slug).
+		SetDescription(*payload.Description).
+		SetRules(payload.Rules).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		categoryRepo.logger.Info("fail to client.Collection.UpdateOneID", zap.Error(err))
+		return nil, handleEntVersionErr(ctx, err, client.Collection.Query().Where(collection.ID(collectionUuid)).Exist)
+	}
+	return result, nil
+}
+
+// DeleteCollectionById: conditional on version, product memberships removed with collection
+func (categoryRepo *CategoryRepository) DeleteCollectionById(
+	ctx context.Context, client *ent.Client, collectionId string, version int64) error {
+	collectionUuid, err := uuid.Parse(collectionId)
+	if err != nil {
+		categoryRepo.logger.Info("fail to parse collectionId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+	affected, err := client.Collection.Delete().
+		Where(collection.ID(collectionUuid), collection.Version(version)).
+		Exec(ctx)
+	if err != nil {
+		categoryRepo.logger.Info("fail to client.Collection.Delete", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+	// stale version if row still exists
+	if affected == 0 {
+		ok, err := client.Collection.Query().Where(collection.ID(collectionUuid)).Exist(ctx)
+		if err == nil && ok {
+			return constants.ErrPreconditionFailed
+		}
+		return constants.ErrNotFound
+	}
+	return nil
+}
+
+// GetCollectionProductIdsById: products of manual collection
+func (categoryRepo *CategoryRepository) GetCollectionProductIdsById(
+	ctx context.Context, client *ent.Client, collectionId string) ([]string, error) {
+	collectionUuid, err := uuid.Parse(collectionId)
+	if err != nil {
+		categoryRepo.logger.Info("fail to parse collectionId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	result, err := client.Collection.Query().
+		Where(collection.ID(collectionUuid)).
+		QueryProducts().
+		Order(ent.Asc(product.FieldID)).
+		IDs(ctx)
+	if err != nil {
+		categoryRepo.logger.Info("fail to client.Collection.QueryProducts", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return lo.Map(result, func(id uuid.UUID, _ int) string { return id.String() }), nil
+}
+
+// SetCollectionProductsById: replace products of manual collection
+func (categoryRepo *CategoryRepository) SetCollectionProductsById(
+	ctx context.Context, client *ent.Client, collectionId string, productIds []string) error {
+	collectionUuid, err := uuid.Parse(collectionId)
+	if err != nil {
+		categoryRepo.logger.Info("fail to parse collectionId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+	productUuids, err := parseUuids(productIds)
+	if err != nil {
+		categoryRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+	err = client.Collection.UpdateOneID(collectionUuid).
+		ClearProducts().
+		AddProductIDs(productUuids...).
+		Exec(ctx)
+	if err != nil {
+		categoryRepo.logger.Info("fail to client.Collection.UpdateOneID", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/storage"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CategoryRepositoryMock struct {
+	mockDataCategory   map[string]ent.Category
+	mockDataCollection map[string]ent.Collection
+	// collection id to product ids
+	mockDataCollectionProduct map[string][]string
+	mu                        sync.Mutex
+}
+
+func NewCategoryRepositoryMock() ICategoryRepository {
+	return &CategoryRepositoryMock{
+		mockDataCategory:          map[string]ent.Category{},
+		mockDataCollection:        map[string]ent.Collection{},
+		mockDataCollectionProduct: map[string][]string{},
+	}
+}
+
+func (m *CategoryRepositoryMock) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTxTest(ctx, nil, client, fn)
+}
+
+// ****
+
+// CreateCategory
+func (m *CategoryRepositoryMock) CreateCategory(
+	ctx context.Context, client *ent.Client, userId string, payload *dto.CreateCategoryDto) (*ent.Category, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	for _, data := range m.mockDataCategory {
+		if data.UserID == userUuid && data.Slug == *payload.Slug {
+			return nil, constants.ErrBadRequest
+		}
+	}
+	t := time.Now()
+	result := &ent.Category{
+		ID:          uuid.New(),
+		CreatedAt:   t,
+		UpdatedAt:   t,
+		UserID:      userUuid,
+		Name:        *payload.Name,
+		Slug:        *payload.Slug,
+		Description: *payload.Description,
+		Position:    *payload.Position,
+		Version:     1,
+	}
+	if payload.ParentId != nil {
+		parentUuid, err := uuid.Parse(*payload.ParentId)
+		if err != nil {
+			return nil, constants.ErrBadRequest
+		}
+		result.ParentID = &parentUuid
+	}
+	m.mockDataCategory[result.ID.String()] = *result
+	return result, nil
+}
+
+// GetCategoriesByUserId
+func (m *CategoryRepositoryMock) GetCategoriesByUserId(
+	ctx context.Context, client *ent.Client, userId string) ([]*ent.Category, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := []*ent.Category{}
+	for _, data := range m.mockDataCategory {
+		c := data
+		if c.UserID.String() == userId {
+			result = append(result, &c)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Position != result[j].Position {
+			return result[i].Position < result[j].Position
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// LockCategoriesByUserId
+func (m *CategoryRepositoryMock) LockCategoriesByUserId(
+	ctx context.Context, client *ent.Client, userId string) ([]*ent.Category, error) {
+	return m.GetCategoriesByUserId(ctx, client, userId)
+}
+
+// UpdateCategoryById
+func (m *CategoryRepositoryMock) UpdateCategoryById(
+	ctx context.Context, client *ent.Client, categoryId string, version int64, payload *dto.UpdateCategoryDto) (*ent.Category, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, ok := m.mockDataCategory[categoryId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	if value.Version != version {
+		return nil, constants.ErrPreconditionFailed
+	}
+	value.Name = *payload.Name
+	value.Slug = *payload.Slug
+	value.Description = *payload.Description
+	value.Position = *payload.Position
+	value.ParentID = nil
+	if payload.ParentId != nil {
+		parentUuid, err := uuid.Parse(*payload.ParentId)
+		if err != nil {
+			return nil, constants.ErrBadRequest
+		}
+		value.ParentID = &parentUuid
+	}
+	value.Version++
+	value.UpdatedAt = time.Now()
+	m.mockDataCategory[categoryId] = value
+	return &value, nil
+}
+
+// DeleteCategoryById
+func (m *CategoryRepositoryMock) DeleteCategoryById(
+	ctx context.Context, client *ent.Client, categoryId string, version int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, ok := m.mockDataCategory[categoryId]
+	if !ok {
+		return constants.ErrNotFound
+	}
+	if value.Version != version {
+		return constants.ErrPreconditionFailed
+	}
+	delete(m.mockDataCategory, categoryId)
+	return nil
+}
+
+// CreateCollection
+func (m *CategoryRepositoryMock) CreateCollection(
+	ctx context.Context, client *ent.Client, userId string, payload *dto.CreateCollectionDto) (*ent.Collection, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	for _, data := range m.mockDataCollection {
+		if data.UserID == userUuid && data.Slug == *payload.Slug {
+			return nil, constants.ErrBadRequest
+		}
+	}
+	t := time.Now()
+	result := &ent.Collection{
+		ID:          uuid.New(),
+		CreatedAt:   t,
+		UpdatedAt:   t,
+		UserID:      userUuid,
+		Name:        *payload.Name,
+		Slug:        *payload.Slug,
+		Description: *payload.Description,
+		Kind:        *payload.Kind,
+		Rules:       payload.Rules,
+		Version:     1,
+	}
+	m.mockDataCollection[result.ID.String()] = *result
+	return result, nil
+}
+
+// GetCollectionsByUserId
+func (m *CategoryRepositoryMock) GetCollectionsByUserId(
+	ctx context.Context, client *ent.Client, userId string) ([]*ent.Collection, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := []*ent.Collection{}
+	for _, data := range m.mockDataCollection {
+		c := data
+		if c.UserID.String() == userId {
+			result = append(result, &c)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// GetCollectionById
+func (m *CategoryRepositoryMock) GetCollectionById(
+	ctx context.Context, client *ent.Client, collectionId string) (*ent.Collection, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, ok := m.mockDataCollection[collectionId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	return &value, nil
+}
+
+// UpdateCollectionById
+func (m *CategoryRepositoryMock) UpdateCollectionById(
+	ctx context.Context, client *ent.Client, collectionId string, version int64, payload *dto.UpdateCollectionDto) (*ent.Collection, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, ok := m.mockDataCollection[collectionId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	if value.Version != version {
+		return nil, constants.ErrPreconditionFailed
+	}
+	value.Name = *payload.Name
+	value.Slug = *payload.Slug
+	value.Description = *payload.Description
+	value.Rules = payload.Rules
+	value.Version++
+	value.UpdatedAt = time.Now()
+	m.mockDataCollection[collectionId] = value
+	return &value, nil
+}
+
+// DeleteCollectionById
+func (m *CategoryRepositoryMock) DeleteCollectionById(
+	ctx context.Context, client *ent.Client, collectionId string, version int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, ok := m.mockDataCollection[collectionId]
+	if !ok {
+		return constants.ErrNotFound
+	}
+	if value.Version != version {
+		return constants.ErrPreconditionFailed
+	}
+	delete(m.mockDataCollection, collectionId)
+	delete(m.mockDataCollectionProduct, collectionId)
+	return nil
+}
+
+// GetCollectionProductIdsById
+func (m *CategoryRepositoryMock) GetCollectionProductIdsById(
+	ctx context.Context, client *ent.Client, collectionId string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := append([]string{}, m.mockDataCollectionProduct[collectionId]...)
+	sort.Strings(result)
+	return result, nil
+}
+
+// SetCollectionProductsById
+func (m *CategoryRepositoryMock) SetCollectionProductsById(
+	ctx context.Context, client *ent.Client, collectionId string, productIds []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.mockDataCollection[collectionId]
+	if !ok {
+		return constants.ErrNotFound
+	}
+	m.mockDataCollectionProduct[collectionId] = append([]string{}, productIds...)
+	return nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/ent/emailverificationtoken"
+	"sthl/storage"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type IEmailVerificationRepository interface {
+	WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error
+	CreateEmailVerificationToken(ctx context.Context, client *ent.Client, userId string, tokenHash string, expiresAt time.Time) (*ent.EmailVerificationToken, error)
+	GetEmailVerificationTokenByHash(ctx context.Context, client *ent.Client, tokenHash string) (*ent.EmailVerificationToken, error)
+	UseEmailVerificationTokenById(ctx context.Context, client *ent.Client, tokenId string) (bool, error)
+	CountEmailVerificationTokensSince(ctx context.Context, client *ent.Client, userId string, since time.Time) (int, error)
+}
+
+type EmailVerificationRepository struct {
+	logger *zap.Logger
+}
+
+func NewEmailVerificationRepository(logger *zap.Logger) IEmailVerificationRepository {
+	return &EmailVerificationRepository{
+		logger: logger,
+	}
+}
+func (evRepo *EmailVerificationRepository) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTx(ctx, evRepo.logger, client, fn)
+}
+
+// CreateEmailVerificationToken
+func (evRepo *EmailVerificationRepository) CreateEmailVerificationToken(ctx context.Context, client *ent.Client,
+	userId string, tokenHash string, expiresAt time.Time) (*ent.EmailVerificationToken, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		evRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.EmailVerificationToken.Create().
+		SetUserID(userUuid).
+		SetTokenHash(tokenHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		evRepo.logger.Info("fail to client.EmailVerificationToken.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetEmailVerificationTokenByHash
+func (evRepo *EmailVerificationRepository) GetEmailVerificationTokenByHash(ctx context.Context, client *ent.Client,
+	tokenHash string) (*ent.EmailVerificationToken, error) {
+	result, err := client.EmailVerificationToken.Query().
+		Where(emailverificationtoken.TokenHash(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		evRepo.logger.Info("fail to client.EmailVerificationToken.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// UseEmailVerificationTokenById: conditional consume,
+// return false if token was already used or expired
+func (evRepo *EmailVerificationRepository) UseEmailVerificationTokenById(ctx context.Context, client *ent.Client,
+	tokenId string) (bool, error) {
+	tokenUuid, err := uuid.Parse(tokenId)
+	if err != nil {
+		evRepo.logger.Info("fail to parse tokenId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	now := time.Now()
+	affected, err := client.EmailVerificationToken.Update().
+		Where(
+			emailverificationtoken.ID(tokenUuid),
+			emailverificationtoken.UsedAtIsNil(),
+			emailverificationtoken.ExpiresAtGT(now),
+		).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		evRepo.logger.Info("fail to client.EmailVerificationToken.Update", zap.Error(err))
+		return false, handleEntRepoErr(err)
+	}
+	return affected == 1, nil
+}
+
+// CountEmailVerificationTokensSince: tokens issued to user since, for resend throttling
+func (evRepo *EmailVerificationRepository) CountEmailVerificationTokensSince(ctx context.Context, client *ent.Client,
+	userId string, since time.Time) (int, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		evRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return 0, constants.ErrBadRequest
+	}
+
+	result, err := client.EmailVerificationToken.Query().
+		Where(
+			emailverificationtoken.UserID(userUuid),
+			emailverificationtoken.CreatedAtGTE(since),
+		).
+		Count(ctx)
+	if err != nil {
+		evRepo.logger.Info("fail to client.EmailVerificationToken.Query", zap.Error(err))
+		return 0, handleEntRepoErr(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/storage"
+	"sthl/utils"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EmailVerificationRepositoryMock struct {
+	mockData map[string]ent.EmailVerificationToken
+	mu       sync.Mutex
+}
+
+func NewEmailVerificationRepositoryMock() IEmailVerificationRepository {
+	return &EmailVerificationRepositoryMock{
+		mockData: map[string]ent.EmailVerificationToken{},
+	}
+}
+
+func (m *EmailVerificationRepositoryMock) Lock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+}
+func (m *EmailVerificationRepositoryMock) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTxTest(ctx, nil, client, fn)
+}
+
+// ****
+
+// CreateEmailVerificationToken
+func (m *EmailVerificationRepositoryMock) CreateEmailVerificationToken(ctx context.Context, client *ent.Client,
+	userId string, tokenHash string, expiresAt time.Time) (*ent.EmailVerificationToken, error) {
+	m.Lock()
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	for _, data := range m.mockData {
+		if data.TokenHash == tokenHash {
+			return nil, constants.ErrBadRequest
+		}
+	}
+	t := time.Now()
+	result := &ent.EmailVerificationToken{
+		ID:        uuid.New(),
+		CreatedAt: t,
+		UpdatedAt: t,
+		UserID:    userUuid,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+	}
+	m.mockData[result.ID.String()] = *result
+	return result, nil
+}
+
+// GetEmailVerificationTokenByHash
+func (m *EmailVerificationRepositoryMock) GetEmailVerificationTokenByHash(ctx context.Context, client *ent.Client,
+	tokenHash string) (*ent.EmailVerificationToken, error) {
+	m.Lock()
+	for _, data := range m.mockData {
+		if data.TokenHash == tokenHash {
+			return &data, nil
+		}
+	}
+	return nil, constants.ErrNotFound
+}
+
+// UseEmailVerificationTokenById
+func (m *EmailVerificationRepositoryMock) UseEmailVerificationTokenById(ctx context.Context, client *ent.Client,
+	tokenId string) (bool, error) {
+	m.Lock()
+	now := time.Now()
+	value, exist := m.mockData[tokenId]
+	if !exist || value.UsedAt != nil || !value.ExpiresAt.After(now) {
+		return false, nil
+	}
+	value.UsedAt = utils.PtrOf(now)
+	m.mockData[tokenId] = value
+	return true, nil
+}
+
+// CountEmailVerificationTokensSince
+func (m *EmailVerificationRepositoryMock) CountEmailVerificationTokensSince(ctx context.Context, client *ent.Client,
+	userId string, since time.Time) (int, error) {
+	m.Lock()
+	count := 0
+	for _, data := range m.mockData {
+		if data.UserID.String() == userId && !data.CreatedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"sthl/constants"
 	"sthl/ent"
 )
@@ -23,3 +24,15 @@ func handleEntRepoErr(err error) error {
 	}
 	return rerr
 }
+
+// handleEntVersionErr: conditional update on version matched no row,
+// stale version if row still exists
+func handleEntVersionErr(ctx context.Context, err error, exist func(ctx context.Context) (bool, error)) error {
+	if ent.IsNotFound(err) {
+		ok, existErr := exist(ctx)
+		if existErr == nil && ok {
+			return constants.ErrPreconditionFailed
+		}
+	}
+	return handleEntRepoErr(err)
+}
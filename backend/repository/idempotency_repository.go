@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/ent/idempotencykey"
+	"sthl/storage"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type IIdempotencyRepository interface {
+	WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error
+	CreateIdempotencyKey(ctx context.Context, client *ent.Client, scope string, key string, fingerprint string, leaseExpiresAt time.Time, expiresAt time.Time) (*ent.IdempotencyKey, error)
+	ReclaimIdempotencyKeyById(ctx context.Context, client *ent.Client, idempotencyKeyId string, fingerprint string, now time.Time, leaseExpiresAt time.Time, expiresAt time.Time) (*ent.IdempotencyKey, error)
+	GetIdempotencyKey(ctx context.Context, client *ent.Client, scope string, key string) (*ent.IdempotencyKey, error)
+	CompleteIdempotencyKeyById(ctx context.Context, client *ent.Client, idempotencyKeyId string, claimToken string, statusCode int, responseBody []byte, etag string) (bool, error)
+	DeleteIdempotencyKeyById(ctx context.Context, client *ent.Client, idempotencyKeyId string, claimToken string) (bool, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context, client *ent.Client, now time.Time) (int, error)
+}
+
+type IdempotencyRepository struct {
+	logger *zap.Logger
+}
+
+func NewIdempotencyRepository(logger *zap.Logger) IIdempotencyRepository {
+	return &IdempotencyRepository{
+		logger: logger,
+	}
+}
+func (idempotencyRepo *IdempotencyRepository) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTx(ctx, idempotencyRepo.logger, client, fn)
+}
+
+// CreateIdempotencyKey: in progress record, ErrExisted if key already used in scope
+func (idempotencyRepo *IdempotencyRepository) CreateIdempotencyKey(ctx context.Context, client *ent.Client,
+	scope string, key string, fingerprint string, leaseExpiresAt time.Time, expiresAt time.Time) (*ent.IdempotencyKey, error) {
+	result, err := client.IdempotencyKey.Create().
+		SetScope(scope).
+		SetKey(key).
+		SetFingerprint(fingerprint).
+		SetLeaseExpiresAt(leaseExpiresAt).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, constants.ErrExisted
+	}
+	if err != nil {
+		idempotencyRepo.logger.Info("fail to client.IdempotencyKey.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// ReclaimIdempotencyKeyById: claim again a record past retention window, or in progress past its lease,
+// as a new in progress record with a new claim token; ErrNotFound if record is no longer reclaimable, e.g. reclaimed concurrently
+func (idempotencyRepo *IdempotencyRepository) ReclaimIdempotencyKeyById(ctx context.Context, client *ent.Client,
+	idempotencyKeyId string, fingerprint string, now time.Time, leaseExpiresAt time.Time, expiresAt time.Time) (*ent.IdempotencyKey, error) {
+	idempotencyKeyUuid, err := uuid.Parse(idempotencyKeyId)
+	if err != nil {
+		idempotencyRepo.logger.Info("fail to parse idempotencyKeyId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.IdempotencyKey.UpdateOneID(idempotencyKeyUuid).
+		Where(idempotencykey.Or(
+			idempotencykey.ExpiresAtLTE(now),
+			idempotencykey.And(
+				idempotencykey.StatusCode(0),
+				idempotencykey.Or(idempotencykey.LeaseExpiresAtIsNil(), idempotencykey.LeaseExpiresAtLTE(now)),
+			),
+		)).
+		SetFingerprint(fingerprint).
+		SetClaimToken(uuid.New()).
+		SetStatusCode(0).
+		ClearResponseBody().
+		ClearEtag().
+		SetLeaseExpiresAt(leaseExpiresAt).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		idempotencyRepo.logger.Info("fail to client.IdempotencyKey.UpdateOneID", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetIdempotencyKey
+func (idempotencyRepo *IdempotencyRepository) GetIdempotencyKey(ctx context.Context, client *ent.Client,
+	scope string, key string) (*ent.IdempotencyKey, error) {
+	result, err := client.IdempotencyKey.Query().
+		Where(idempotencykey.Scope(scope), idempotencykey.Key(key)).
+		Only(ctx)
+	if err != nil {
+		idempotencyRepo.logger.Info("fail to client.IdempotencyKey.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// CompleteIdempotencyKeyById: store response of in progress record held by claim token,
+// return false if record was already completed, removed or claimed again
+func (idempotencyRepo *IdempotencyRepository) CompleteIdempotencyKeyById(ctx context.Context, client *ent.Client,
+	idempotencyKeyId string, claimToken string, statusCode int, responseBody []byte, etag string) (bool, error) {
+	idempotencyKeyUuid, err := uuid.Parse(idempotencyKeyId)
+	if err != nil {
+		idempotencyRepo.logger.Info("fail to parse idempotencyKeyId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+	claimTokenUuid, err := uuid.Parse(claimToken)
+	if err != nil {
+		idempotencyRepo.logger.Info("fail to parse claimToken to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	affected, err := client.IdempotencyKey.Update().
+		Where(idempotencykey.ID(idempotencyKeyUuid), idempotencykey.ClaimToken(claimTokenUuid), idempotencykey.StatusCode(0)).
+		SetStatusCode(statusCode).
+		SetResponseBody(responseBody).
+		SetEtag(etag).
+		Save(ctx)
+	if err != nil {
+		idempotencyRepo.logger.Info("fail to client.IdempotencyKey.Update", zap.Error(err))
+		return false, handleEntRepoErr(err)
+	}
+	return affected == 1, nil
+}
+
+// DeleteIdempotencyKeyById: remove record still held by claim token
+func (idempotencyRepo *IdempotencyRepository) DeleteIdempotencyKeyById(ctx context.Context, client *ent.Client,
+	idempotencyKeyId string, claimToken string) (bool, error) {
+	idempotencyKeyUuid, err := uuid.Parse(idempotencyKeyId)
+	if err != nil {
+		idempotencyRepo.logger.Info("fail to parse idempotencyKeyId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+	claimTokenUuid, err := uuid.Parse(claimToken)
+	if err != nil {
+		idempotencyRepo.logger.Info("fail to parse claimToken to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	affected, err := client.IdempotencyKey.Delete().
+		Where(idempotencykey.ID(idempotencyKeyUuid), idempotencykey.ClaimToken(claimTokenUuid)).
+		Exec(ctx)
+	if err != nil {
+		idempotencyRepo.logger.Info("fail to client.IdempotencyKey.Delete", zap.Error(err))
+		return false, handleEntRepoErr(err)
+	}
+	return affected == 1, nil
+}
+
+// DeleteExpiredIdempotencyKeys: records past retention window
+func (idempotencyRepo *IdempotencyRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, client *ent.Client,
+	now time.Time) (int, error) {
+	affected, err := client.IdempotencyKey.Delete().
+		Where(idempotencykey.ExpiresAtLTE(now)).
+		Exec(ctx)
+	if err != nil {
+		idempotencyRepo.logger.Info("fail to client.IdempotencyKey.Delete", zap.Error(err))
+		return 0, handleEntRepoErr(err)
+	}
+	return affected, nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/storage"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type IdempotencyRepositoryMock struct {
+	mockData map[string]ent.IdempotencyKey
+	mu       sync.Mutex
+}
+
+func NewIdempotencyRepositoryMock() IIdempotencyRepository {
+	return &IdempotencyRepositoryMock{
+		mockData: map[string]ent.IdempotencyKey{},
+	}
+}
+
+func (m *IdempotencyRepositoryMock) Lock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+}
+func (m *IdempotencyRepositoryMock) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTxTest(ctx, nil, client, fn)
+}
+
+// ****
+
+// CreateIdempotencyKey
+func (m *IdempotencyRepositoryMock) CreateIdempotencyKey(ctx context.Context, client *ent.Client,
+	scope string, key string, fingerprint string, leaseExpiresAt time.Time, expiresAt time.Time) (*ent.IdempotencyKey, error) {
+	m.Lock()
+	for _, data := range m.mockData {
+		if data.Scope == scope && data.Key == key {
+			return nil, constants.ErrExisted
+		}
+	}
+	t := time.Now()
+	result := &ent.IdempotencyKey{
+		ID:             uuid.New(),
+		CreatedAt:      t,
+		UpdatedAt:      t,
+		Scope:          scope,
+		Key:            key,
+		Fingerprint:    fingerprint,
+		ClaimToken:     uuid.New(),
+		LeaseExpiresAt: &leaseExpiresAt,
+		ExpiresAt:      expiresAt,
+	}
+	m.mockData[result.ID.String()] = *result
+	return result, nil
+}
+
+// ReclaimIdempotencyKeyById
+func (m *IdempotencyRepositoryMock) ReclaimIdempotencyKeyById(ctx context.Context, client *ent.Client,
+	idempotencyKeyId string, fingerprint string, now time.Time, leaseExpiresAt time.Time, expiresAt time.Time) (*ent.IdempotencyKey, error) {
+	m.Lock()
+	value, exist := m.mockData[idempotencyKeyId]
+	if !exist {
+		return nil, constants.ErrNotFound
+	}
+	expired := !value.ExpiresAt.After(now)
+	leaseExpired := value.StatusCode == 0 && (value.LeaseExpiresAt == nil || !value.LeaseExpiresAt.After(now))
+	if !expired && !leaseExpired {
+		return nil, constants.ErrNotFound
+	}
+	value.UpdatedAt = time.Now()
+	value.Fingerprint = fingerprint
+	value.ClaimToken = uuid.New()
+	value.StatusCode = 0
+	value.ResponseBody = nil
+	value.Etag = ""
+	value.LeaseExpiresAt = &leaseExpiresAt
+	value.ExpiresAt = expiresAt
+	m.mockData[idempotencyKeyId] = value
+	return &value, nil
+}
+
+// GetIdempotencyKey
+func (m *IdempotencyRepositoryMock) GetIdempotencyKey(ctx context.Context, client *ent.Client,
+	scope string, key string) (*ent.IdempotencyKey, error) {
+	m.Lock()
+	for _, data := range m.mockData {
+		if data.Scope == scope && data.Key == key {
+			return &data, nil
+		}
+	}
+	return nil, constants.ErrNotFound
+}
+
+// CompleteIdempotencyKeyById
+func (m *IdempotencyRepositoryMock) CompleteIdempotencyKeyById(ctx context.Context, client *ent.Client,
+	idempotencyKeyId string, claimToken string, statusCode int, responseBody []byte, etag string) (bool, error) {
+	m.Lock()
+	value, exist := m.mockData[idempotencyKeyId]
+	if !exist || value.ClaimToken.String() != claimToken || value.StatusCode != 0 {
+		return false, nil
+	}
+	value.StatusCode = statusCode
+	value.ResponseBody = responseBody
+	value.Etag = etag
+	m.mockData[idempotencyKeyId] = value
+	return true, nil
+}
+
+// DeleteIdempotencyKeyById
+func (m *IdempotencyRepositoryMock) DeleteIdempotencyKeyById(ctx context.Context, client *ent.Client,
+	idempotencyKeyId string, claimToken string) (bool, error) {
+	m.Lock()
+	value, exist := m.mockData[idempotencyKeyId]
+	if !exist || value.ClaimToken.String() != claimToken {
+		return false, nil
+	}
+	delete(m.mockData, idempotencyKeyId)
+	return true, nil
+}
+
+// DeleteExpiredIdempotencyKeys
+func (m *IdempotencyRepositoryMock) DeleteExpiredIdempotencyKeys(ctx context.Context, client *ent.Client,
+	now time.Time) (int, error) {
+	m.Lock()
+	count := 0
+	for key, data := range m.mockData {
+		if !data.ExpiresAt.After(now) {
+			delete(m.mockData, key)
+			count++
+		}
+	}
+	return count, nil
+}
@@ -2,12 +2,14 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"sthl/constants"
 	"sthl/dto"
 	"sthl/ent"
 	"sthl/ent/imageinfo"
 	"sthl/storage"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
@@ -17,6 +19,9 @@ type IImgInfoRepository interface {
 	CreateImg(ctx context.Context, client *ent.Client, userId string, payload *dto.CreateImgDto) (*ent.Imageinfo, error)
 	CheckImgNameExist(ctx context.Context, client *ent.Client, userId string, imgName string) (bool, error)
 	GetImgByUserId(ctx context.Context, client *ent.Client, userId string, imgInfoId int) (*ent.Imageinfo, error)
+	GetImgsByIds(ctx context.Context, client *ent.Client, imgInfoIds []int) ([]*ent.Imageinfo, error)
+	GetImgsTotalByUserId(ctx context.Context, client *ent.Client, userId string) (int, error)
+	GetImgsSizeByUserId(ctx context.Context, client *ent.Client, userId string) (int64, error)
 	GetImgsByUserId(ctx context.Context, client *ent.Client, userId string, payload *dto.QueryImgsInfoDto) (*dto.QueryImgsInfoResponseDto, error)
 	UpdateImgInfoById(ctx context.Context, client *ent.Client, imgInfoId int, payload *dto.UpdateImgInfoDto) (*ent.Imageinfo, error)
 }
@@ -91,6 +96,18 @@ func (imginfoRepo *ImgInfoRepository) GetImgByUserId(
 	return result, nil
 }
 
+// GetImgsByIds: ids not found left out
+func (imginfoRepo *ImgInfoRepository) GetImgsByIds(ctx context.Context, client *ent.Client, imgInfoIds []int) ([]*ent.Imageinfo, error) {
+	result, err := client.Imageinfo.Query().
+		Where(imageinfo.IDIn(imgInfoIds...)).
+		All(ctx)
+	if err != nil {
+		imginfoRepo.logger.Info("fail to client.Imageinfo.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
 func (imginfoRepo *ImgInfoRepository) GetImgsTotalByUserId(ctx context.Context, client *ent.Client, userId string) (int, error) {
 	userUuid, err := uuid.Parse(userId)
 	if err != nil {
@@ -106,6 +123,27 @@ func (imginfoRepo *ImgInfoRepository) GetImgsTotalByUserId(ctx context.Context,
 	return total, nil
 }
 
+// GetImgsSizeByUserId: total bytes of images of user
+func (imginfoRepo *ImgInfoRepository) GetImgsSizeByUserId(ctx context.Context, client *ent.Client, userId string) (int64, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		imginfoRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return 0, constants.ErrBadRequest
+	}
+
+	size, err := client.Imageinfo.Query().
+		Where(imageinfo.UserID(userUuid)).
+		Aggregate(func(s *sql.Selector) string {
+			return fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(imageinfo.FieldImgSize))
+		}).
+		Int(ctx)
+	if err != nil {
+		imginfoRepo.logger.Info("fail to client.Imageinfo.Query", zap.Error(err))
+		return 0, handleEntRepoErr(err)
+	}
+	return int64(size), nil
+}
+
 // GetImgsByUserId
 func (imginfoRepo *ImgInfoRepository) GetImgsByUserId(
 	ctx context.Context, client *ent.Client, userId string, payload *dto.QueryImgsInfoDto) (*dto.QueryImgsInfoResponseDto, error) {
@@ -143,11 +181,13 @@ func (imginfoRepo *ImgInfoRepository) GetImgsByUserId(
 	return data, nil
 }
 
-// UpdateImgInfoById
+// UpdateImgInfoById: replaced object, url and key change with it
 func (imginfoRepo *ImgInfoRepository) UpdateImgInfoById(
 	ctx context.Context, client *ent.Client, imgInfoId int, payload *dto.UpdateImgInfoDto) (*ent.Imageinfo, error) {
 	result, err := client.Imageinfo.UpdateOneID(imgInfoId).
+		SetImgURL(*payload.ImgURL).
 		SetImgSize(*payload.ImgSize).
+		SetImgS3IDKey(*payload.ImgS3IdKey).
 		Save(ctx)
 
 	if err != nil {
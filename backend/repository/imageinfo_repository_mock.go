@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/storage"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+type ImgInfoRepositoryMock struct {
+	mockData map[int]ent.Imageinfo
+	nextId   int
+	mu       sync.Mutex
+}
+
+func NewImgInfoRepositoryMock() IImgInfoRepository {
+	return &ImgInfoRepositoryMock{
+		mockData: map[int]ent.Imageinfo{},
+		nextId:   1,
+	}
+}
+
+func (m *ImgInfoRepositoryMock) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTxTest(ctx, nil, client, fn)
+}
+
+// CreateImg
+func (m *ImgInfoRepositoryMock) CreateImg(ctx context.Context, client *ent.Client,
+	userId string, payload *dto.CreateImgDto) (*ent.Imageinfo, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, img := range m.mockData {
+		if img.ImgS3IDKey == *payload.ImgS3IdKey {
+			return nil, constants.ErrBadRequest
+		}
+	}
+	t := time.Now()
+	result := ent.Imageinfo{
+		ID:         m.nextId,
+		CreatedAt:  t,
+		UpdatedAt:  t,
+		UserID:     userUuid,
+		ImgURL:     *payload.ImgURL,
+		ImgName:    *payload.ImgName,
+		ImgSize:    *payload.ImgSize,
+		ImgS3IDKey: *payload.ImgS3IdKey,
+	}
+	m.mockData[result.ID] = result
+	m.nextId++
+	return &result, nil
+}
+
+// CheckImgNameExist
+func (m *ImgInfoRepositoryMock) CheckImgNameExist(ctx context.Context, client *ent.Client, userId string, imgName string) (bool, error) {
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		return false, constants.ErrBadRequest
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, img := range m.mockData {
+		if img.UserID.String() == userId && img.ImgName == imgName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetImgByUserId
+func (m *ImgInfoRepositoryMock) GetImgByUserId(ctx context.Context, client *ent.Client, userId string, imgInfoId int) (*ent.Imageinfo, error) {
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	img, ok := m.mockData[imgInfoId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	return &img, nil
+}
+
+// GetImgsByIds
+func (m *ImgInfoRepositoryMock) GetImgsByIds(ctx context.Context, client *ent.Client, imgInfoIds []int) ([]*ent.Imageinfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := []*ent.Imageinfo{}
+	for _, id := range imgInfoIds {
+		img, ok := m.mockData[id]
+		if ok {
+			result = append(result, &img)
+		}
+	}
+	return result, nil
+}
+
+// GetImgsTotalByUserId
+func (m *ImgInfoRepositoryMock) GetImgsTotalByUserId(ctx context.Context, client *ent.Client, userId string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	total := 0
+	for _, img := range m.mockData {
+		if img.UserID.String() == userId {
+			total++
+		}
+	}
+	return total, nil
+}
+
+// GetImgsSizeByUserId
+func (m *ImgInfoRepositoryMock) GetImgsSizeByUserId(ctx context.Context, client *ent.Client, userId string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var size int64 = 0
+	for _, img := range m.mockData {
+		if img.UserID.String() == userId {
+			size += img.ImgSize
+		}
+	}
+	return size, nil
+}
+
+// GetImgsByUserId
+func (m *ImgInfoRepositoryMock) GetImgsByUserId(
+	ctx context.Context, client *ent.Client, userId string, payload *dto.QueryImgsInfoDto) (*dto.QueryImgsInfoResponseDto, error) {
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	total := 0
+	imgSlice := []*ent.Imageinfo{}
+	for _, u := range m.mockData {
+		img := u
+		if img.UserID.String() != userId {
+			continue
+		}
+		total++
+		if strings.Contains(img.ImgName, payload.Query) {
+			imgSlice = append(imgSlice, &img)
+		}
+	}
+	sort.Slice(imgSlice, func(i, j int) bool {
+		return imgSlice[i].ID > imgSlice[j].ID
+	})
+	offset := (payload.Page - 1) * payload.Limit
+	subSlice := imgSlice[lo.Min([]int{offset, len(imgSlice)}):lo.Min([]int{offset + payload.Limit, len(imgSlice)})]
+	pagingResp := dto.NewPagingResponse(payload.Page, payload.Limit, total)
+	return dto.NewQueryImgsInfoResponseDto(subSlice, *pagingResp), nil
+}
+
+// UpdateImgInfoById
+func (m *ImgInfoRepositoryMock) UpdateImgInfoById(
+	ctx context.Context, client *ent.Client, imgInfoId int, payload *dto.UpdateImgInfoDto) (*ent.Imageinfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	img, ok := m.mockData[imgInfoId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	img.ImgURL = *payload.ImgURL
+	img.ImgSize = *payload.ImgSize
+	img.ImgS3IDKey = *payload.ImgS3IdKey
+	img.UpdatedAt = time.Now()
+	m.mockData[imgInfoId] = img
+	return &img, nil
+}
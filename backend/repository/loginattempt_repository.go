@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"sthl/config"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/ent/loginlockoutevent"
+	"sthl/ent/loginthrottle"
+	"sthl/storage"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ILoginAttemptRepository: failed login counters keyed by scope and value,
+// plus lockout events shown to the merchant
+type ILoginAttemptRepository interface {
+	WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error
+	GetLoginThrottle(ctx context.Context, client *ent.Client, key string) (*ent.LoginThrottle, error)
+	AddLoginFailure(ctx context.Context, client *ent.Client, key string, failedAt time.Time) (*ent.LoginThrottle, error)
+	LockLoginThrottle(ctx context.Context, client *ent.Client, key string, lockedUntil time.Time) error
+	DeleteLoginThrottle(ctx context.Context, client *ent.Client, key string) error
+	CreateLoginLockoutEvent(ctx context.Context, client *ent.Client, userId string, scope string, ip string, failures int, lockedUntil time.Time) (*ent.LoginLockoutEvent, error)
+	GetLoginLockoutEventsByUserId(ctx context.Context, client *ent.Client, userId string, limit int) ([]*ent.LoginLockoutEvent, error)
+}
+
+// NewLoginAttemptRepositoryFromConfig: postgres to share counters between replicas,
+// memory for single instance
+func NewLoginAttemptRepositoryFromConfig(logger *zap.Logger, cfg *config.Config) ILoginAttemptRepository {
+	if cfg.GetLoginAttemptStore() == constants.LoginAttemptStore.Memory {
+		return NewLoginAttemptRepositoryMemory()
+	}
+	return NewLoginAttemptRepository(logger)
+}
+
+type LoginAttemptRepository struct {
+	logger *zap.Logger
+}
+
+func NewLoginAttemptRepository(logger *zap.Logger) ILoginAttemptRepository {
+	return &LoginAttemptRepository{
+		logger: logger,
+	}
+}
+func (laRepo *LoginAttemptRepository) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTx(ctx, laRepo.logger, client, fn)
+}
+
+// GetLoginThrottle
+func (laRepo *LoginAttemptRepository) GetLoginThrottle(ctx context.Context, client *ent.Client, key string) (*ent.LoginThrottle, error) {
+	result, err := client.LoginThrottle.Query().
+		Where(loginthrottle.Key(key)).
+		Only(ctx)
+	if err != nil {
+		laRepo.logger.Info("fail to client.LoginThrottle.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// AddLoginFailure: atomic increment by upsert, safe across replicas
+func (laRepo *LoginAttemptRepository) AddLoginFailure(ctx context.Context, client *ent.Client,
+	key string, failedAt time.Time) (*ent.LoginThrottle, error) {
+	err := client.LoginThrottle.Create().
+		SetKey(key).
+		SetFailures(1).
+		SetLastFailedAt(failedAt).
+		OnConflict(
+			sql.ConflictColumns(loginthrottle.FieldKey),
+		).
+		Update(func(u *ent.LoginThrottleUpsert) {
+			u.AddFailures(1)
+			u.SetLastFailedAt(failedAt)
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+	if err != nil {
+		laRepo.logger.Info("fail to client.LoginThrottle.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return laRepo.GetLoginThrottle(ctx, client, key)
+}
+
+// LockLoginThrottle: lock until, failures start over once unlocked
+func (laRepo *LoginAttemptRepository) LockLoginThrottle(ctx context.Context, client *ent.Client,
+	key string, lockedUntil time.Time) error {
+	_, err := client.LoginThrottle.Update().
+		Where(loginthrottle.Key(key)).
+		SetFailures(0).
+		SetLockedUntil(lockedUntil).
+		Save(ctx)
+	if err != nil {
+		laRepo.logger.Info("fail to client.LoginThrottle.Update", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+	return nil
+}
+
+// DeleteLoginThrottle
+func (laRepo *LoginAttemptRepository) DeleteLoginThrottle(ctx context.Context, client *ent.Client, key string) error {
+	_, err := client.LoginThrottle.Delete().
+		Where(loginthrottle.Key(key)).
+		Exec(ctx)
+	if err != nil {
+		laRepo.logger.Info("fail to client.LoginThrottle.Delete", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+	return nil
+}
+
+// CreateLoginLockoutEvent
+func (laRepo *LoginAttemptRepository) CreateLoginLockoutEvent(ctx context.Context, client *ent.Client,
+	userId string, scope string, ip string, failures int, lockedUntil time.Time) (*ent.LoginLockoutEvent, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		laRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.LoginLockoutEvent.Create().
+		SetUserID(userUuid).
+		SetScope(scope).
+		SetIP(ip).
+		SetFailures(failures).
+		SetLockedUntil(lockedUntil).
+		Save(ctx)
+	if err != nil {
+		laRepo.logger.Info("fail to client.LoginLockoutEvent.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetLoginLockoutEventsByUserId: latest first
+func (laRepo *LoginAttemptRepository) GetLoginLockoutEventsByUserId(ctx context.Context, client *ent.Client,
+	userId string, limit int) ([]*ent.LoginLockoutEvent, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		laRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.LoginLockoutEvent.Query().
+		Where(loginlockoutevent.UserID(userUuid)).
+		Order(ent.Desc(loginlockoutevent.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		laRepo.logger.Info("fail to client.LoginLockoutEvent.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/storage"
+	"sthl/utils"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoginAttemptRepositoryMemory: in process counters for single instance and tests,
+// state is lost on restart and not shared between replicas
+type LoginAttemptRepositoryMemory struct {
+	throttles map[string]ent.LoginThrottle
+	events    []ent.LoginLockoutEvent
+	mu        sync.Mutex
+}
+
+func NewLoginAttemptRepositoryMemory() ILoginAttemptRepository {
+	return &LoginAttemptRepositoryMemory{
+		throttles: map[string]ent.LoginThrottle{},
+		events:    []ent.LoginLockoutEvent{},
+	}
+}
+
+func (m *LoginAttemptRepositoryMemory) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTxTest(ctx, nil, client, fn)
+}
+
+// ****
+
+// GetLoginThrottle
+func (m *LoginAttemptRepositoryMemory) GetLoginThrottle(ctx context.Context, client *ent.Client, key string) (*ent.LoginThrottle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, exist := m.throttles[key]
+	if !exist {
+		return nil, constants.ErrNotFound
+	}
+	return &value, nil
+}
+
+// AddLoginFailure
+func (m *LoginAttemptRepositoryMemory) AddLoginFailure(ctx context.Context, client *ent.Client,
+	key string, failedAt time.Time) (*ent.LoginThrottle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, exist := m.throttles[key]
+	if !exist {
+		value = ent.LoginThrottle{
+			ID:        uuid.New(),
+			CreatedAt: failedAt,
+			Key:       key,
+		}
+	}
+	value.Failures++
+	value.LastFailedAt = failedAt
+	value.UpdatedAt = failedAt
+	m.throttles[key] = value
+	return &value, nil
+}
+
+// LockLoginThrottle
+func (m *LoginAttemptRepositoryMemory) LockLoginThrottle(ctx context.Context, client *ent.Client,
+	key string, lockedUntil time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, exist := m.throttles[key]
+	if !exist {
+		return nil
+	}
+	value.Failures = 0
+	value.LockedUntil = utils.PtrOf(lockedUntil)
+	m.throttles[key] = value
+	return nil
+}
+
+// DeleteLoginThrottle
+func (m *LoginAttemptRepositoryMemory) DeleteLoginThrottle(ctx context.Context, client *ent.Client, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.throttles, key)
+	return nil
+}
+
+// CreateLoginLockoutEvent
+func (m *LoginAttemptRepositoryMemory) CreateLoginLockoutEvent(ctx context.Context, client *ent.Client,
+	userId string, scope string, ip string, failures int, lockedUntil time.Time) (*ent.LoginLockoutEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	t := time.Now()
+	result := ent.LoginLockoutEvent{
+		ID:          uuid.New(),
+		CreatedAt:   t,
+		UpdatedAt:   t,
+		UserID:      userUuid,
+		Scope:       scope,
+		IP:          ip,
+		Failures:    failures,
+		LockedUntil: lockedUntil,
+	}
+	m.events = append(m.events, result)
+	return &result, nil
+}
+
+// GetLoginLockoutEventsByUserId
+func (m *LoginAttemptRepositoryMemory) GetLoginLockoutEventsByUserId(ctx context.Context, client *ent.Client,
+	userId string, limit int) ([]*ent.LoginLockoutEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := []*ent.LoginLockoutEvent{}
+	for i := range m.events {
+		if m.events[i].UserID.String() == userId {
+			e := m.events[i]
+			result = append(result, &e)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
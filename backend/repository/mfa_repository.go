@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/ent/mfarecoverycode"
+	"sthl/ent/usertotp"
+	"sthl/storage"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type IMfaRepository interface {
+	WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error
+	UpsertUserTotp(ctx context.Context, client *ent.Client, userId string, secret string) (*ent.UserTotp, error)
+	GetUserTotpByUserId(ctx context.Context, client *ent.Client, userId string) (*ent.UserTotp, error)
+	ConfirmUserTotpByUserId(ctx context.Context, client *ent.Client, userId string, step int64) (bool, error)
+	UseUserTotpStepByUserId(ctx context.Context, client *ent.Client, userId string, step int64) (bool, error)
+	DeleteUserTotpByUserId(ctx context.Context, client *ent.Client, userId string) (bool, error)
+	ReplaceMfaRecoveryCodes(ctx context.Context, client *ent.Client, userId string, codeHashes []string) error
+	UseMfaRecoveryCode(ctx context.Context, client *ent.Client, userId string, codeHash string) (bool, error)
+	DeleteMfaRecoveryCodesByUserId(ctx context.Context, client *ent.Client, userId string) (int, error)
+}
+
+type MfaRepository struct {
+	logger *zap.Logger
+}
+
+func NewMfaRepository(logger *zap.Logger) IMfaRepository {
+	return &MfaRepository{
+		logger: logger,
+	}
+}
+func (mfaRepo *MfaRepository) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTx(ctx, mfaRepo.logger, client, fn)
+}
+
+// UpsertUserTotp: new pending secret, replaces unconfirmed enrolment
+func (mfaRepo *MfaRepository) UpsertUserTotp(ctx context.Context, client *ent.Client, userId string, secret string) (*ent.UserTotp, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		mfaRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	err = client.UserTotp.Create().
+		SetUserID(userUuid).
+		SetSecret(secret).
+		OnConflict(sql.ConflictColumns(usertotp.FieldUserID)).
+		Update(func(u *ent.UserTotpUpsert) {
+			u.UpdateSecret()
+			u.ClearConfirmedAt()
+			u.SetLastUsedStep(0)
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+	if err != nil {
+		mfaRepo.logger.Info("fail to client.UserTotp.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return mfaRepo.GetUserTotpByUserId(ctx, client, userId)
+}
+
+// GetUserTotpByUserId
+func (mfaRepo *MfaRepository) GetUserTotpByUserId(ctx context.Context, client *ent.Client, userId string) (*ent.UserTotp, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		mfaRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.UserTotp.Query().
+		Where(usertotp.UserID(userUuid)).
+		Only(ctx)
+	if err != nil {
+		mfaRepo.logger.Info("fail to client.UserTotp.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// ConfirmUserTotpByUserId: conditional confirm of pending secret,
+// return false if already confirmed or code step was used
+func (mfaRepo *MfaRepository) ConfirmUserTotpByUserId(ctx context.Context, client *ent.Client, userId string, step int64) (bool, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		mfaRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	affected, err := client.UserTotp.Update().
+		Where(usertotp.UserID(userUuid), usertotp.ConfirmedAtIsNil(), usertotp.LastUsedStepLT(step)).
+		SetConfirmedAt(time.Now()).
+		SetLastUsedStep(step).
+		Save(ctx)
+	if err != nil {
+		mfaRepo.logger.Info("fail to client.UserTotp.Update", zap.Error(err))
+		return false, handleEntRepoErr(err)
+	}
+	return affected == 1, nil
+}
+
+// UseUserTotpStepByUserId: conditional advance of last used step,
+// return false if code of this or later step was already used
+func (mfaRepo *MfaRepository) UseUserTotpStepByUserId(ctx context.Context, client *ent.Client, userId string, step int64) (bool, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		mfaRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	affected, err := client.UserTotp.Update().
+		Where(usertotp.UserID(userUuid), usertotp.ConfirmedAtNotNil(), usertotp.LastUsedStepLT(step)).
+		SetLastUsedStep(step).
+		Save(ctx)
+	if err != nil {
+		mfaRepo.logger.Info("fail to client.UserTotp.Update", zap.Error(err))
+		return false, handleEntRepoErr(err)
+	}
+	return affected == 1, nil
+}
+
+// DeleteUserTotpByUserId
+func (mfaRepo *MfaRepository) DeleteUserTotpByUserId(ctx context.Context, client *ent.Client, userId string) (bool, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		mfaRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	affected, err := client.UserTotp.Delete().
+		Where(usertotp.UserID(userUuid)).
+		Exec(ctx)
+	if err != nil {
+		mfaRepo.logger.Info("fail to client.UserTotp.Delete", zap.Error(err))
+		return false, handleEntRepoErr(err)
+	}
+	return affected == 1, nil
+}
+
+// ReplaceMfaRecoveryCodes: drop previous codes of user, store new hashes
+func (mfaRepo *MfaRepository) ReplaceMfaRecoveryCodes(ctx context.Context, client *ent.Client, userId string, codeHashes []string) error {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		mfaRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+
+	_, err = client.MfaRecoveryCode.Delete().
+		Where(mfarecoverycode.UserID(userUuid)).
+		Exec(ctx)
+	if err != nil {
+		mfaRepo.logger.Info("fail to client.MfaRecoveryCode.Delete", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+	bulk := make([]*ent.MfaRecoveryCodeCreate, 0, len(codeHashes))
+	for _, codeHash := range codeHashes {
+		bulk = append(bulk, client.MfaRecoveryCode.Create().
+			SetUserID(userUuid).
+			SetCodeHash(codeHash))
+	}
+	_, err = client.MfaRecoveryCode.CreateBulk(bulk...).Save(ctx)
+	if err != nil {
+		mfaRepo.logger.Info("fail to client.MfaRecoveryCode.CreateBulk", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+	return nil
+}
+
+// UseMfaRecoveryCode: conditional consume,
+// return false if code not found or already used
+func (mfaRepo *MfaRepository) UseMfaRecoveryCode(ctx context.Context, client *ent.Client, userId string, codeHash string) (bool, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		mfaRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	affected, err := client.MfaRecoveryCode.Update().
+		Where(
+			mfarecoverycode.UserID(userUuid),
+			mfarecoverycode.CodeHash(codeHash),
+			mfarecoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		mfaRepo.logger.Info("fail to client.MfaRecoveryCode.Update", zap.Error(err))
+		return false, handleEntRepoErr(err)
+	}
+	return affected == 1, nil
+}
+
+// DeleteMfaRecoveryCodesByUserId
+func (mfaRepo *MfaRepository) DeleteMfaRecoveryCodesByUserId(ctx context.Context, client *ent.Client, userId string) (int, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		mfaRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return 0, constants.ErrBadRequest
+	}
+
+	affected, err := client.MfaRecoveryCode.Delete().
+		Where(mfarecoverycode.UserID(userUuid)).
+		Exec(ctx)
+	if err != nil {
+		mfaRepo.logger.Info("fail to client.MfaRecoveryCode.Delete", zap.Error(err))
+		return 0, handleEntRepoErr(err)
+	}
+	return affected, nil
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/storage"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MfaRepositoryMock struct {
+	totpData map[string]ent.UserTotp
+	codeData map[string]ent.MfaRecoveryCode
+	mu       sync.Mutex
+}
+
+func NewMfaRepositoryMock() IMfaRepository {
+	return &MfaRepositoryMock{
+		totpData: map[string]ent.UserTotp{},
+		codeData: map[string]ent.MfaRecoveryCode{},
+	}
+}
+
+func (m *MfaRepositoryMock) Lock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+}
+func (m *MfaRepositoryMock) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTxTest(ctx, nil, client, fn)
+}
+
+// ****
+
+// UpsertUserTotp
+func (m *MfaRepositoryMock) UpsertUserTotp(ctx context.Context, client *ent.Client, userId string, secret string) (*ent.UserTotp, error) {
+	m.Lock()
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	t := time.Now()
+	value, exist := m.totpData[userId]
+	if !exist {
+		value = ent.UserTotp{
+			ID:        uuid.New(),
+			CreatedAt: t,
+			UserID:    userUuid,
+		}
+	}
+	value.UpdatedAt = t
+	value.Secret = secret
+	value.ConfirmedAt = nil
+	value.LastUsedStep = 0
+	m.totpData[userId] = value
+	return &value, nil
+}
+
+// GetUserTotpByUserId
+func (m *MfaRepositoryMock) GetUserTotpByUserId(ctx context.Context, client *ent.Client, userId string) (*ent.UserTotp, error) {
+	m.Lock()
+	value, exist := m.totpData[userId]
+	if !exist {
+		return nil, constants.ErrNotFound
+	}
+	return &value, nil
+}
+
+// ConfirmUserTotpByUserId
+func (m *MfaRepositoryMock) ConfirmUserTotpByUserId(ctx context.Context, client *ent.Client, userId string, step int64) (bool, error) {
+	m.Lock()
+	value, exist := m.totpData[userId]
+	if !exist || value.ConfirmedAt != nil || value.LastUsedStep >= step {
+		return false, nil
+	}
+	t := time.Now()
+	value.ConfirmedAt = &t
+	value.LastUsedStep = step
+	m.totpData[userId] = value
+	return true, nil
+}
+
+// UseUserTotpStepByUserId
+func (m *MfaRepositoryMock) UseUserTotpStepByUserId(ctx context.Context, client *ent.Client, userId string, step int64) (bool, error) {
+	m.Lock()
+	value, exist := m.totpData[userId]
+	if !exist || value.ConfirmedAt == nil || value.LastUsedStep >= step {
+		return false, nil
+	}
+	value.LastUsedStep = step
+	m.totpData[userId] = value
+	return true, nil
+}
+
+// DeleteUserTotpByUserId
+func (m *MfaRepositoryMock) DeleteUserTotpByUserId(ctx context.Context, client *ent.Client, userId string) (bool, error) {
+	m.Lock()
+	_, exist := m.totpData[userId]
+	delete(m.totpData, userId)
+	return exist, nil
+}
+
+// ReplaceMfaRecoveryCodes
+func (m *MfaRepositoryMock) ReplaceMfaRecoveryCodes(ctx context.Context, client *ent.Client, userId string, codeHashes []string) error {
+	m.Lock()
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		return constants.ErrBadRequest
+	}
+	for key, data := range m.codeData {
+		if data.UserID.String() == userId {
+			delete(m.codeData, key)
+		}
+	}
+	t := time.Now()
+	for _, codeHash := range codeHashes {
+		value := ent.MfaRecoveryCode{
+			ID:        uuid.New(),
+			CreatedAt: t,
+			UpdatedAt: t,
+			UserID:    userUuid,
+			CodeHash:  codeHash,
+		}
+		m.codeData[value.ID.String()] = value
+	}
+	return nil
+}
+
+// UseMfaRecoveryCode
+func (m *MfaRepositoryMock) UseMfaRecoveryCode(ctx context.Context, client *ent.Client, userId string, codeHash string) (bool, error) {
+	m.Lock()
+	for key, data := range m.codeData {
+		if data.UserID.String() == userId && data.CodeHash == codeHash && data.UsedAt == nil {
+			t := time.Now()
+			data.UsedAt = &t
+			m.codeData[key] = data
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// DeleteMfaRecoveryCodesByUserId
+func (m *MfaRepositoryMock) DeleteMfaRecoveryCodesByUserId(ctx context.Context, client *ent.Client, userId string) (int, error) {
+	m.Lock()
+	count := 0
+	for key, data := range m.codeData {
+		if data.UserID.String() == userId {
+			delete(m.codeData, key)
+			count++
+		}
+	}
+	return count, nil
+}
@@ -6,8 +6,10 @@ import (
 	"sthl/dto"
 	"sthl/ent"
 	"sthl/ent/order"
+	"sthl/ent/orderevent"
 	"sthl/ent/orderitem"
 	"sthl/storage"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -19,11 +21,18 @@ type IOrderRepository interface {
 	CreateOrderItems(ctx context.Context, client *ent.Client, orderId string, payload []*dto.OrderItem) ([]*ent.OrderItem, error)
 	getOrderItemsByOrderId(ctx context.Context, client *ent.Client, orderId string) ([]*ent.OrderItem, error)
 	GetOrders(ctx context.Context, client *ent.Client, userId string, payload *dto.QueryOrdersDto) (*dto.QueryOrdersResponseDto, error)
+	GetOrdersTotalByUserIdSince(ctx context.Context, client *ent.Client, userId string, since time.Time) (int, error)
 	GetOrderById(ctx context.Context, client *ent.Client, orderId string) (*dto.OrderResponseDto, error)
-	UpdateOrderById(ctx context.Context, client *ent.Client, orderId string, payload *dto.UpdateOrderDto) (*ent.Order, error)
+	UpdateOrderById(ctx context.Context, client *ent.Client, orderId string, version int64, payload *dto.UpdateOrderDto) (*ent.Order, error)
+	UpdateOrderTotalsById(ctx context.Context, client *ent.Client, orderId string, payload *dto.OrderBreakdownDto) (*ent.Order, error)
 	UpdateOrderItemById(ctx context.Context, client *ent.Client, orderItemId string, payload *dto.OrderItem) (*ent.OrderItem, error)
-	SoftDeleteOrderById(ctx context.Context, client *ent.Client, orderId string) (*ent.Order, error)
+	SoftDeleteOrderById(ctx context.Context, client *ent.Client, orderId string, version int64) (*ent.Order, error)
 	DeleteOrderItemById(ctx context.Context, client *ent.Client, orderItemId string) (bool, error)
+	CreateOrderEvents(ctx context.Context, client *ent.Client, orderId string, payload []*dto.CreateOrderEventDto) ([]*ent.OrderEvent, error)
+	GetOrderEventsByOrderId(ctx context.Context, client *ent.Client, orderId string) ([]*ent.OrderEvent, error)
+	UpdateOrderReservedUntilById(ctx context.Context, client *ent.Client, orderId string, reservedUntil *time.Time) (*ent.Order, error)
+	GetReservationExpiredOrders(ctx context.Context, client *ent.Client, now time.Time, limit int) ([]*ent.Order, error)
+	ReleaseOrderReservationById(ctx context.Context, client *ent.Client, orderId string, now time.Time) (*ent.Order, error)
 }
 
 type OrderRepository struct {
@@ -50,8 +59,14 @@ func (orderRepo *OrderRepository) CreateOrder(
 
 	result, err := client.Order.Create().
 		SetUserID(userUuid).
-		SetDiscount(*payload.Discount).
-		SetTotalAmount(*payload.TotalAmount).
+		SetCurrency(payload.Breakdown.Currency).
+		SetSubtotal(payload.Breakdown.Subtotal).
+		SetDiscount(payload.Breakdown.Discount).
+		SetDiscountAmount(payload.Breakdown.DiscountAmount).
+		SetTaxRate(payload.Breakdown.TaxRate).
+		SetTaxAmount(payload.Breakdown.TaxAmount).
+		SetShippingFee(payload.Breakdown.ShippingFee).
+		SetTotalAmount(payload.Breakdown.TotalAmount).
 		SetRemark(*payload.Remark).
 		SetStatus(*payload.Status).
 		SetPaymentStatus(*payload.PaymentStatus).
@@ -59,6 +74,7 @@ func (orderRepo *OrderRepository) CreateOrder(
 		SetDeliveryStatus(*payload.DeliveryStatus).
 		SetShippingAddress(*payload.ShippingAddress).
 		SetTrackingNumber(*payload.TrackingNumber).
+		SetNillableReservedUntil(payload.ReservedUntil).
 		Save(ctx)
 	if err != nil {
 		orderRepo.logger.Info("fail to client.Order.Create", zap.Error(err))
@@ -90,6 +106,14 @@ func (orderRepo *OrderRepository) CreateOrderItems(
 			SetPurchasedName(*item.PurchasedName).
 			SetPurchasedPrice(*item.PurchasedPrice).
 			SetQuantity(*item.Quantity)
+		if item.VariantId != nil {
+			variantUuid, err := uuid.Parse(*item.VariantId)
+			if err != nil {
+				orderRepo.logger.Info("fail to parse item.VariantId to uuid", zap.Error(err))
+				return nil, constants.ErrBadRequest
+			}
+			bulk[i].SetVariantID(variantUuid)
+		}
 	}
 	result, err := client.OrderItem.CreateBulk(bulk...).Save(ctx)
 	if err != nil {
@@ -118,6 +142,24 @@ func (orderRepo *OrderRepository) getOrderItemsByOrderId(
 	return result, nil
 }
 
+// GetOrdersTotalByUserIdSince: orders created at or after since, archived included
+func (orderRepo *OrderRepository) GetOrdersTotalByUserIdSince(
+	ctx context.Context, client *ent.Client, userId string, since time.Time) (int, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		orderRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return 0, constants.ErrBadRequest
+	}
+
+	total, err := client.Order.Query().
+		Where(order.UserID(userUuid), order.CreatedAtGTE(since)).Count(ctx)
+	if err != nil {
+		orderRepo.logger.Info("fail to count total", zap.Error(err))
+		return 0, handleEntRepoErr(err)
+	}
+	return total, nil
+}
+
 // GetOrders
 func (orderRepo *OrderRepository) GetOrders(
 	ctx context.Context, client *ent.Client, userId string, payload *dto.QueryOrdersDto) (*dto.QueryOrdersResponseDto, error) {
@@ -190,9 +232,9 @@ func (orderRepo *OrderRepository) GetOrderById(
 	return result, nil
 }
 
-// UpdateOrderById
+// UpdateOrderById: conditional on version, ErrPreconditionFailed if version changed
 func (orderRepo *OrderRepository) UpdateOrderById(
-	ctx context.Context, client *ent.Client, orderId string, payload *dto.UpdateOrderDto) (*ent.Order, error) {
+	ctx context.Context, client *ent.Client, orderId string, version int64, payload *dto.UpdateOrderDto) (*ent.Order, error) {
 	orderUUid, err := uuid.Parse(orderId)
 	if err != nil {
 		orderRepo.logger.Info("fail to parse orderId to uuid", zap.Error(err))
@@ -200,9 +242,7 @@ func (orderRepo *OrderRepository) UpdateOrderById(
 	}
 
 	result, err := client.Order.UpdateOneID(orderUUid).
-		SetRemark(*payload.Remark).
-		SetDiscount(*payload.Discount).
-		SetTotalAmount(*payload.TotalAmount).
+		Where(order.Version(version)).
 		SetRemark(*payload.Remark).
 		SetStatus(*payload.Status).
 		SetPaymentStatus(*payload.PaymentStatus).
@@ -210,6 +250,32 @@ func (orderRepo *OrderRepository) UpdateOrderById(
 		SetDeliveryStatus(*payload.DeliveryStatus).
 		SetShippingAddress(*payload.ShippingAddress).
 		SetTrackingNumber(*payload.TrackingNumber).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		orderRepo.logger.Info("fail to client.Order.UpdateOneID", zap.Error(err))
+		return nil, handleEntVersionErr(ctx, err, client.Order.Query().Where(order.ID(orderUUid)).Exist)
+	}
+	return result, nil
+}
+
+// UpdateOrderTotalsById: not versioned, called after UpdateOrderById in same tx
+func (orderRepo *OrderRepository) UpdateOrderTotalsById(
+	ctx context.Context, client *ent.Client, orderId string, payload *dto.OrderBreakdownDto) (*ent.Order, error) {
+	orderUUid, err := uuid.Parse(orderId)
+	if err != nil {
+		orderRepo.logger.Info("fail to parse orderId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.Order.UpdateOneID(orderUUid).
+		SetSubtotal(payload.Subtotal).
+		SetDiscount(payload.Discount).
+		SetDiscountAmount(payload.DiscountAmount).
+		SetTaxRate(payload.TaxRate).
+		SetTaxAmount(payload.TaxAmount).
+		SetShippingFee(payload.ShippingFee).
+		SetTotalAmount(payload.TotalAmount).
 		Save(ctx)
 	if err != nil {
 		orderRepo.logger.Info("fail to client.Order.UpdateOneID", zap.Error(err))
@@ -238,9 +304,9 @@ func (orderRepo *OrderRepository) UpdateOrderItemById(
 	return result, nil
 }
 
-// SoftDeleteOrderById
+// SoftDeleteOrderById: conditional on version, ErrPreconditionFailed if version changed
 func (orderRepo *OrderRepository) SoftDeleteOrderById(
-	ctx context.Context, client *ent.Client, orderId string) (*ent.Order, error) {
+	ctx context.Context, client *ent.Client, orderId string, version int64) (*ent.Order, error) {
 	orderUUid, err := uuid.Parse(orderId)
 	if err != nil {
 		orderRepo.logger.Info("fail to parse orderId to uuid", zap.Error(err))
@@ -248,11 +314,13 @@ func (orderRepo *OrderRepository) SoftDeleteOrderById(
 	}
 
 	result, err := client.Order.UpdateOneID(orderUUid).
+		Where(order.Version(version)).
 		SetIsArchived(true).
+		AddVersion(1).
 		Save(ctx)
 	if err != nil {
 		orderRepo.logger.Info("fail to client.OrderItem.UpdateOneID", zap.Error(err))
-		return nil, handleEntRepoErr(err)
+		return nil, handleEntVersionErr(ctx, err, client.Order.Query().Where(order.ID(orderUUid)).Exist)
 	}
 	return result, nil
 }
@@ -273,3 +341,123 @@ func (orderRepo *OrderRepository) DeleteOrderItemById(
 	}
 	return true, nil
 }
+
+// CreateOrderEvents
+func (orderRepo *OrderRepository) CreateOrderEvents(
+	ctx context.Context, client *ent.Client, orderId string, payload []*dto.CreateOrderEventDto) ([]*ent.OrderEvent, error) {
+	orderUuid, err := uuid.Parse(orderId)
+	if err != nil {
+		orderRepo.logger.Info("fail to parse orderId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	bulk := make([]*ent.OrderEventCreate, len(payload))
+	for i, event := range payload {
+		bulk[i] = client.OrderEvent.Create().
+			SetOrderID(orderUuid).
+			SetType(event.Type).
+			SetActorType(event.ActorType).
+			SetBefore(event.Before).
+			SetAfter(event.After)
+		if event.ActorId != nil {
+			actorUuid, err := uuid.Parse(*event.ActorId)
+			if err != nil {
+				orderRepo.logger.Info("fail to parse event.ActorId to uuid", zap.Error(err))
+				return nil, constants.ErrBadRequest
+			}
+			bulk[i].SetActorID(actorUuid)
+		}
+	}
+	result, err := client.OrderEvent.CreateBulk(bulk...).Save(ctx)
+	if err != nil {
+		orderRepo.logger.Info("fail to client.OrderEvent.CreateBulk", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetOrderEventsByOrderId: oldest first
+func (orderRepo *OrderRepository) GetOrderEventsByOrderId(
+	ctx context.Context, client *ent.Client, orderId string) ([]*ent.OrderEvent, error) {
+	orderUuid, err := uuid.Parse(orderId)
+	if err != nil {
+		orderRepo.logger.Info("fail to parse orderId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.OrderEvent.Query().
+		Where(orderevent.OrderID(orderUuid)).
+		Order(ent.Asc(orderevent.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		orderRepo.logger.Info("fail to client.OrderEvent.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// UpdateOrderReservedUntilById: not versioned, nil clears reservation, called in same tx of order write
+func (orderRepo *OrderRepository) UpdateOrderReservedUntilById(
+	ctx context.Context, client *ent.Client, orderId string, reservedUntil *time.Time) (*ent.Order, error) {
+	orderUuid, err := uuid.Parse(orderId)
+	if err != nil {
+		orderRepo.logger.Info("fail to parse orderId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	update := client.Order.UpdateOneID(orderUuid)
+	if reservedUntil == nil {
+		update.ClearReservedUntil()
+	} else {
+		update.SetReservedUntil(*reservedUntil)
+	}
+	result, err := update.Save(ctx)
+	if err != nil {
+		orderRepo.logger.Info("fail to client.Order.UpdateOneID", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetReservationExpiredOrders: releasable orders only, unpaid with editable items, oldest expiry first
+func (orderRepo *OrderRepository) GetReservationExpiredOrders(
+	ctx context.Context, client *ent.Client, now time.Time, limit int) ([]*ent.Order, error) {
+	result, err := client.Order.Query().
+		Where(
+			order.ReservedUntilLT(now),
+			order.PaymentStatusIn(constants.PaymentStatus.GetUnpaidList()...),
+			order.StatusIn(constants.OrderStatus.GetItemsEditableList()...),
+		).
+		Order(ent.Asc(order.FieldReservedUntil)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		orderRepo.logger.Info("fail to client.Order.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// ReleaseOrderReservationById: cancel order and void payment if reservation still expired and unpaid,
+// ErrNotFound if committed or released meanwhile
+func (orderRepo *OrderRepository) ReleaseOrderReservationById(
+	ctx context.Context, client *ent.Client, orderId string, now time.Time) (*ent.Order, error) {
+	orderUuid, err := uuid.Parse(orderId)
+	if err != nil {
+		orderRepo.logger.Info("fail to parse orderId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.Order.UpdateOneID(orderUuid).
+		Where(order.ReservedUntilLT(now), order.PaymentStatusIn(constants.PaymentStatus.GetUnpaidList()...)).
+		SetStatus(constants.OrderStatus.Canceled).
+		SetPaymentStatus(constants.PaymentStatus.Voided).
+		ClearReservedUntil().
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		orderRepo.logger.Info("fail to client.Order.UpdateOneID", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
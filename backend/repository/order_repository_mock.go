@@ -12,12 +12,15 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 type OrderRepositoryMock struct {
 	mockDataOrder     map[string]ent.Order
 	mockDataOrderItem map[string]ent.OrderItem
-	mu                sync.Mutex
+	// append only, insertion order is timeline order
+	mockDataOrderEvent []ent.OrderEvent
+	mu                 sync.Mutex
 }
 
 func NewOrderRepositoryMock() IOrderRepository {
@@ -35,8 +38,14 @@ func newOrderSchemaMock(userId uuid.UUID, payload *dto.CreateOrderDtoMappedDto)
 		CreatedAt:       t,
 		UpdatedAt:       t,
 		UserID:          userId,
-		Discount:        *payload.Discount,
-		TotalAmount:     *payload.TotalAmount,
+		Currency:        payload.Breakdown.Currency,
+		Subtotal:        payload.Breakdown.Subtotal,
+		Discount:        payload.Breakdown.Discount,
+		DiscountAmount:  payload.Breakdown.DiscountAmount,
+		TaxRate:         payload.Breakdown.TaxRate,
+		TaxAmount:       payload.Breakdown.TaxAmount,
+		ShippingFee:     payload.Breakdown.ShippingFee,
+		TotalAmount:     payload.Breakdown.TotalAmount,
 		Remark:          *payload.Remark,
 		Status:          *payload.Status,
 		PaymentStatus:   *payload.PaymentStatus,
@@ -44,12 +53,14 @@ func newOrderSchemaMock(userId uuid.UUID, payload *dto.CreateOrderDtoMappedDto)
 		DeliveryStatus:  *payload.DeliveryStatus,
 		ShippingAddress: *payload.ShippingAddress,
 		TrackingNumber:  *payload.TrackingNumber,
+		ReservedUntil:   payload.ReservedUntil,
 		IsArchived:      false,
+		Version:         1,
 	}
 }
 func newOrderItemSchemaMock(orderId uuid.UUID, payload *dto.OrderItem) *ent.OrderItem {
 	productUuid, _ := uuid.Parse(*payload.ProductId)
-	return &ent.OrderItem{
+	result := &ent.OrderItem{
 		ID:             uuid.New(),
 		OrderID:        orderId,
 		ProductID:      productUuid,
@@ -57,12 +68,13 @@ func newOrderItemSchemaMock(orderId uuid.UUID, payload *dto.OrderItem) *ent.Orde
 		PurchasedPrice: *payload.PurchasedPrice,
 		Quantity:       *payload.Quantity,
 	}
+	if payload.VariantId != nil {
+		variantUuid, _ := uuid.Parse(*payload.VariantId)
+		result.VariantID = &variantUuid
+	}
+	return result
 }
 
-func (m *OrderRepositoryMock) Lock() {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-}
 func (m *OrderRepositoryMock) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
 	return storage.WithTxTest(ctx, nil, client, fn)
 }
@@ -70,7 +82,8 @@ func (m *OrderRepositoryMock) WithTx(ctx context.Context, client *ent.Client, fn
 // ****
 // CreateOrder
 func (m *OrderRepositoryMock) CreateOrder(ctx context.Context, client *ent.Client, userId string, payload *dto.CreateOrderDtoMappedDto) (*ent.Order, error) {
-	m.Lock()
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	userUuid, err := uuid.Parse(userId)
 	if err != nil {
 		return nil, constants.ErrBadRequest
@@ -84,7 +97,8 @@ func (m *OrderRepositoryMock) CreateOrder(ctx context.Context, client *ent.Clien
 // CreateOrderItems
 func (m *OrderRepositoryMock) CreateOrderItems(ctx context.Context, client *ent.Client, orderId string, payload []*dto.OrderItem) ([]*ent.OrderItem, error) {
 	// return nil, constants.ErrInternalServer
-	m.Lock()
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	orderUuid, err := uuid.Parse(orderId)
 	if err != nil {
 		return nil, constants.ErrBadRequest
@@ -100,9 +114,8 @@ func (m *OrderRepositoryMock) CreateOrderItems(ctx context.Context, client *ent.
 
 }
 
-// getOrderItemsByOrderId
+// getOrderItemsByOrderId: caller holds m.mu
 func (m *OrderRepositoryMock) getOrderItemsByOrderId(ctx context.Context, client *ent.Client, orderId string) ([]*ent.OrderItem, error) {
-	m.Lock()
 	_, err := uuid.Parse(orderId)
 	if err != nil {
 		return nil, constants.ErrBadRequest
@@ -111,16 +124,31 @@ func (m *OrderRepositoryMock) getOrderItemsByOrderId(ctx context.Context, client
 	var result []*ent.OrderItem
 	for _, item := range m.mockDataOrderItem {
 		if item.OrderID.String() == orderId {
-			result = append(result, &item)
+			orderItem := item
+			result = append(result, &orderItem)
 		}
 	}
 	return result, nil
 }
 
+// GetOrdersTotalByUserIdSince
+func (m *OrderRepositoryMock) GetOrdersTotalByUserIdSince(ctx context.Context, client *ent.Client, userId string, since time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	total := 0
+	for _, u := range m.mockDataOrder {
+		if u.UserID.String() == userId && !u.CreatedAt.Before(since) {
+			total++
+		}
+	}
+	return total, nil
+}
+
 // GetOrders
 func (m *OrderRepositoryMock) GetOrders(ctx context.Context, client *ent.Client, userId string, payload *dto.QueryOrdersDto) (*dto.QueryOrdersResponseDto, error) {
 	// return nil, constants.ErrInternalServer
-	m.Lock()
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	var orderSlice, subSlice []*ent.Order
 	for _, u := range m.mockDataOrder {
 		if u.UserID.String() == userId {
@@ -156,7 +184,8 @@ func (m *OrderRepositoryMock) GetOrders(ctx context.Context, client *ent.Client,
 // GetOrderById
 func (m *OrderRepositoryMock) GetOrderById(ctx context.Context, client *ent.Client, orderId string) (*dto.OrderResponseDto, error) {
 	// return nil, constants.ErrInternalServer
-	m.Lock()
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
 	_, err := uuid.Parse(orderId)
 	if err != nil {
@@ -178,8 +207,9 @@ func (m *OrderRepositoryMock) GetOrderById(ctx context.Context, client *ent.Clie
 }
 
 // UpdateOrderById
-func (m *OrderRepositoryMock) UpdateOrderById(ctx context.Context, client *ent.Client, orderId string, payload *dto.UpdateOrderDto) (*ent.Order, error) {
-	m.Lock()
+func (m *OrderRepositoryMock) UpdateOrderById(ctx context.Context, client *ent.Client, orderId string, version int64, payload *dto.UpdateOrderDto) (*ent.Order, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
 	_, err := uuid.Parse(orderId)
 	if err != nil {
@@ -189,15 +219,45 @@ func (m *OrderRepositoryMock) UpdateOrderById(ctx context.Context, client *ent.C
 	for key, data := range m.mockDataOrder {
 		if key == orderId {
 			u := data
+			if u.Version != version {
+				return nil, constants.ErrPreconditionFailed
+			}
 			u.Remark = *payload.Remark
-			u.Discount = *payload.Discount
-			u.TotalAmount = *payload.TotalAmount
 			u.Status = *payload.Status
 			u.PaymentStatus = *payload.PaymentStatus
 			u.PaymentMethod = *payload.PaymentMethod
 			u.DeliveryStatus = *payload.DeliveryStatus
 			u.ShippingAddress = *payload.ShippingAddress
 			u.TrackingNumber = *payload.TrackingNumber
+			u.Version++
+
+			m.mockDataOrder[key] = u
+			return &u, nil
+		}
+	}
+	return nil, constants.ErrNotFound
+}
+
+// UpdateOrderTotalsById
+func (m *OrderRepositoryMock) UpdateOrderTotalsById(ctx context.Context, client *ent.Client, orderId string, payload *dto.OrderBreakdownDto) (*ent.Order, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, err := uuid.Parse(orderId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+
+	for key, data := range m.mockDataOrder {
+		if key == orderId {
+			u := data
+			u.Subtotal = payload.Subtotal
+			u.Discount = payload.Discount
+			u.DiscountAmount = payload.DiscountAmount
+			u.TaxRate = payload.TaxRate
+			u.TaxAmount = payload.TaxAmount
+			u.ShippingFee = payload.ShippingFee
+			u.TotalAmount = payload.TotalAmount
 
 			m.mockDataOrder[key] = u
 			return &u, nil
@@ -208,7 +268,8 @@ func (m *OrderRepositoryMock) UpdateOrderById(ctx context.Context, client *ent.C
 
 // UpdateOrderItemById
 func (m *OrderRepositoryMock) UpdateOrderItemById(ctx context.Context, client *ent.Client, orderItemId string, payload *dto.OrderItem) (*ent.OrderItem, error) {
-	m.Lock()
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
 	_, err := uuid.Parse(orderItemId)
 	if err != nil {
@@ -230,8 +291,9 @@ func (m *OrderRepositoryMock) UpdateOrderItemById(ctx context.Context, client *e
 }
 
 // SoftDeleteOrderById
-func (m *OrderRepositoryMock) SoftDeleteOrderById(ctx context.Context, client *ent.Client, orderId string) (*ent.Order, error) {
-	m.Lock()
+func (m *OrderRepositoryMock) SoftDeleteOrderById(ctx context.Context, client *ent.Client, orderId string, version int64) (*ent.Order, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
 	_, err := uuid.Parse(orderId)
 	if err != nil {
@@ -244,7 +306,11 @@ func (m *OrderRepositoryMock) SoftDeleteOrderById(ctx context.Context, client *e
 			if u.IsArchived {
 				return nil, constants.ErrBadRequest
 			}
+			if u.Version != version {
+				return nil, constants.ErrPreconditionFailed
+			}
 			u.IsArchived = true
+			u.Version++
 
 			m.mockDataOrder[key] = u
 			return &u, nil
@@ -255,7 +321,8 @@ func (m *OrderRepositoryMock) SoftDeleteOrderById(ctx context.Context, client *e
 
 // DeleteOrderItemById
 func (m *OrderRepositoryMock) DeleteOrderItemById(ctx context.Context, client *ent.Client, orderItemId string) (bool, error) {
-	m.Lock()
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
 	_, err := uuid.Parse(orderItemId)
 	if err != nil {
@@ -265,3 +332,108 @@ func (m *OrderRepositoryMock) DeleteOrderItemById(ctx context.Context, client *e
 	delete(m.mockDataOrderItem, orderItemId)
 	return true, nil
 }
+
+// CreateOrderEvents
+func (m *OrderRepositoryMock) CreateOrderEvents(ctx context.Context, client *ent.Client, orderId string, payload []*dto.CreateOrderEventDto) ([]*ent.OrderEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	orderUuid, err := uuid.Parse(orderId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+
+	var result []*ent.OrderEvent
+	t := time.Now()
+	for _, event := range payload {
+		oe := ent.OrderEvent{
+			ID:        uuid.New(),
+			CreatedAt: t,
+			UpdatedAt: t,
+			OrderID:   orderUuid,
+			Type:      event.Type,
+			ActorType: event.ActorType,
+			Before:    event.Before,
+			After:     event.After,
+		}
+		if event.ActorId != nil {
+			actorUuid, err := uuid.Parse(*event.ActorId)
+			if err != nil {
+				return nil, constants.ErrBadRequest
+			}
+			oe.ActorID = &actorUuid
+		}
+		m.mockDataOrderEvent = append(m.mockDataOrderEvent, oe)
+		result = append(result, &oe)
+	}
+	return result, nil
+}
+
+// GetOrderEventsByOrderId
+func (m *OrderRepositoryMock) GetOrderEventsByOrderId(ctx context.Context, client *ent.Client, orderId string) ([]*ent.OrderEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, err := uuid.Parse(orderId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+
+	result := []*ent.OrderEvent{}
+	for _, data := range m.mockDataOrderEvent {
+		if data.OrderID.String() == orderId {
+			oe := data
+			result = append(result, &oe)
+		}
+	}
+	return result, nil
+}
+
+// UpdateOrderReservedUntilById
+func (m *OrderRepositoryMock) UpdateOrderReservedUntilById(ctx context.Context, client *ent.Client, orderId string, reservedUntil *time.Time) (*ent.Order, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.mockDataOrder[orderId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	u.ReservedUntil = reservedUntil
+	m.mockDataOrder[orderId] = u
+	return &u, nil
+}
+
+// GetReservationExpiredOrders
+func (m *OrderRepositoryMock) GetReservationExpiredOrders(ctx context.Context, client *ent.Client, now time.Time, limit int) ([]*ent.Order, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []*ent.Order
+	for _, u := range m.mockDataOrder {
+		if u.ReservedUntil != nil && u.ReservedUntil.Before(now) &&
+			lo.Contains(constants.PaymentStatus.GetUnpaidList(), u.PaymentStatus) &&
+			lo.Contains(constants.OrderStatus.GetItemsEditableList(), u.Status) {
+			u := u
+			result = append(result, &u)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ReservedUntil.Before(*result[j].ReservedUntil)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// ReleaseOrderReservationById
+func (m *OrderRepositoryMock) ReleaseOrderReservationById(ctx context.Context, client *ent.Client, orderId string, now time.Time) (*ent.Order, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.mockDataOrder[orderId]
+	if !ok || u.ReservedUntil == nil || !u.ReservedUntil.Before(now) || !lo.Contains(constants.PaymentStatus.GetUnpaidList(), u.PaymentStatus) {
+		return nil, constants.ErrNotFound
+	}
+	u.Status = constants.OrderStatus.Canceled
+	u.PaymentStatus = constants.PaymentStatus.Voided
+	u.ReservedUntil = nil
+	u.Version++
+	m.mockDataOrder[orderId] = u
+	return &u, nil
+}
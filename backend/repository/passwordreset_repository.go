@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/ent/passwordresettoken"
+	"sthl/storage"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type IPasswordResetRepository interface {
+	WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error
+	CreatePasswordResetToken(ctx context.Context, client *ent.Client, userId string, tokenHash string, expiresAt time.Time) (*ent.PasswordResetToken, error)
+	GetPasswordResetTokenByHash(ctx context.Context, client *ent.Client, tokenHash string) (*ent.PasswordResetToken, error)
+	UsePasswordResetTokenById(ctx context.Context, client *ent.Client, tokenId string) (bool, error)
+	CountPasswordResetTokensSince(ctx context.Context, client *ent.Client, userId string, since time.Time) (int, error)
+	InvalidatePasswordResetTokensByUserId(ctx context.Context, client *ent.Client, userId string) (int, error)
+}
+
+type PasswordResetRepository struct {
+	logger *zap.Logger
+}
+
+func NewPasswordResetRepository(logger *zap.Logger) IPasswordResetRepository {
+	return &PasswordResetRepository{
+		logger: logger,
+	}
+}
+func (prRepo *PasswordResetRepository) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTx(ctx, prRepo.logger, client, fn)
+}
+
+// CreatePasswordResetToken
+func (prRepo *PasswordResetRepository) CreatePasswordResetToken(ctx context.Context, client *ent.Client,
+	userId string, tokenHash string, expiresAt time.Time) (*ent.PasswordResetToken, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		prRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.PasswordResetToken.Create().
+		SetUserID(userUuid).
+		SetTokenHash(tokenHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		prRepo.logger.Info("fail to client.PasswordResetToken.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetPasswordResetTokenByHash
+func (prRepo *PasswordResetRepository) GetPasswordResetTokenByHash(ctx context.Context, client *ent.Client,
+	tokenHash string) (*ent.PasswordResetToken, error) {
+	result, err := client.PasswordResetToken.Query().
+		Where(passwordresettoken.TokenHash(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		prRepo.logger.Info("fail to client.PasswordResetToken.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// UsePasswordResetTokenById: conditional consume,
+// return false if token was already used or expired
+func (prRepo *PasswordResetRepository) UsePasswordResetTokenById(ctx context.Context, client *ent.Client,
+	tokenId string) (bool, error) {
+	tokenUuid, err := uuid.Parse(tokenId)
+	if err != nil {
+		prRepo.logger.Info("fail to parse tokenId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	now := time.Now()
+	affected, err := client.PasswordResetToken.Update().
+		Where(
+			passwordresettoken.ID(tokenUuid),
+			passwordresettoken.UsedAtIsNil(),
+			passwordresettoken.ExpiresAtGT(now),
+		).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		prRepo.logger.Info("fail to client.PasswordResetToken.Update", zap.Error(err))
+		return false, handleEntRepoErr(err)
+	}
+	return affected == 1, nil
+}
+
+// CountPasswordResetTokensSince: tokens issued to user since, for forgot throttling
+func (prRepo *PasswordResetRepository) CountPasswordResetTokensSince(ctx context.Context, client *ent.Client,
+	userId string, since time.Time) (int, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		prRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return 0, constants.ErrBadRequest
+	}
+
+	result, err := client.PasswordResetToken.Query().
+		Where(
+			passwordresettoken.UserID(userUuid),
+			passwordresettoken.CreatedAtGTE(since),
+		).
+		Count(ctx)
+	if err != nil {
+		prRepo.logger.Info("fail to client.PasswordResetToken.Query", zap.Error(err))
+		return 0, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// InvalidatePasswordResetTokensByUserId: mark all not yet used tokens of user as used
+func (prRepo *PasswordResetRepository) InvalidatePasswordResetTokensByUserId(ctx context.Context, client *ent.Client,
+	userId string) (int, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		prRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return 0, constants.ErrBadRequest
+	}
+
+	affected, err := client.PasswordResetToken.Update().
+		Where(
+			passwordresettoken.UserID(userUuid),
+			passwordresettoken.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		prRepo.logger.Info("fail to client.PasswordResetToken.Update", zap.Error(err))
+		return 0, handleEntRepoErr(err)
+	}
+	return affected, nil
+}
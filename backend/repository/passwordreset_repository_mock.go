@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/storage"
+	"sthl/utils"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PasswordResetRepositoryMock struct {
+	mockData map[string]ent.PasswordResetToken
+	mu       sync.Mutex
+}
+
+func NewPasswordResetRepositoryMock() IPasswordResetRepository {
+	return &PasswordResetRepositoryMock{
+		mockData: map[string]ent.PasswordResetToken{},
+	}
+}
+
+func (m *PasswordResetRepositoryMock) Lock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+}
+func (m *PasswordResetRepositoryMock) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTxTest(ctx, nil, client, fn)
+}
+
+// ****
+
+// CreatePasswordResetToken
+func (m *PasswordResetRepositoryMock) CreatePasswordResetToken(ctx context.Context, client *ent.Client,
+	userId string, tokenHash string, expiresAt time.Time) (*ent.PasswordResetToken, error) {
+	m.Lock()
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	for _, data := range m.mockData {
+		if data.TokenHash == tokenHash {
+			return nil, constants.ErrBadRequest
+		}
+	}
+	t := time.Now()
+	result := &ent.PasswordResetToken{
+		ID:        uuid.New(),
+		CreatedAt: t,
+		UpdatedAt: t,
+		UserID:    userUuid,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+	}
+	m.mockData[result.ID.String()] = *result
+	return result, nil
+}
+
+// GetPasswordResetTokenByHash
+func (m *PasswordResetRepositoryMock) GetPasswordResetTokenByHash(ctx context.Context, client *ent.Client,
+	tokenHash string) (*ent.PasswordResetToken, error) {
+	m.Lock()
+	for _, data := range m.mockData {
+		if data.TokenHash == tokenHash {
+			return &data, nil
+		}
+	}
+	return nil, constants.ErrNotFound
+}
+
+// UsePasswordResetTokenById
+func (m *PasswordResetRepositoryMock) UsePasswordResetTokenById(ctx context.Context, client *ent.Client,
+	tokenId string) (bool, error) {
+	m.Lock()
+	now := time.Now()
+	value, exist := m.mockData[tokenId]
+	if !exist || value.UsedAt != nil || !value.ExpiresAt.After(now) {
+		return false, nil
+	}
+	value.UsedAt = utils.PtrOf(now)
+	m.mockData[tokenId] = value
+	return true, nil
+}
+
+// CountPasswordResetTokensSince
+func (m *PasswordResetRepositoryMock) CountPasswordResetTokensSince(ctx context.Context, client *ent.Client,
+	userId string, since time.Time) (int, error) {
+	m.Lock()
+	count := 0
+	for _, data := range m.mockData {
+		if data.UserID.String() == userId && !data.CreatedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// InvalidatePasswordResetTokensByUserId
+func (m *PasswordResetRepositoryMock) InvalidatePasswordResetTokensByUserId(ctx context.Context, client *ent.Client,
+	userId string) (int, error) {
+	m.Lock()
+	count := 0
+	for key, data := range m.mockData {
+		if data.UserID.String() == userId && data.UsedAt == nil {
+			data.UsedAt = utils.PtrOf(time.Now())
+			m.mockData[key] = data
+			count++
+		}
+	}
+	return count, nil
+}
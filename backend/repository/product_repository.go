@@ -2,13 +2,25 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"sthl/constants"
 	"sthl/dto"
 	"sthl/ent"
+	"sthl/ent/category"
+	"sthl/ent/inventorymovement"
+	"sthl/ent/predicate"
 	"sthl/ent/product"
+	"sthl/ent/productmedia"
+	"sthl/ent/productvariant"
+	"sthl/ent/schema"
+	"sthl/money"
 	"sthl/storage"
+	"strconv"
+	"strings"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
 
@@ -16,10 +28,30 @@ type IProductRepository interface {
 	WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error
 	CreateProduct(ctx context.Context, client *ent.Client, userId string, payload *dto.CreateProductDtoMappedDto) (*ent.Product, error)
 	GetProductsTotalByUserId(ctx context.Context, client *ent.Client, userId string) (int, error)
-	GetProducts(ctx context.Context, client *ent.Client, userId string, payload *dto.QueryProductsDto) (*dto.QueryProductsResponseDto, error)
+	GetProducts(ctx context.Context, client *ent.Client, userId string, payload *dto.QueryProductsDto, filter *dto.ProductsFilterDto) (*dto.QueryProductsResponseDto, error)
 	GetProductById(ctx context.Context, client *ent.Client, productId string) (*ent.Product, error)
-	UpdateProductById(ctx context.Context, client *ent.Client, productId string, payload *dto.UpdateProductDto) (*ent.Product, error)
-	SoftDeleteProductById(ctx context.Context, client *ent.Client, productId string) (*ent.Product, error)
+	GetProductsByIds(ctx context.Context, client *ent.Client, productIds []string) ([]*ent.Product, error)
+	UpdateProductById(ctx context.Context, client *ent.Client, productId string, version int64, payload *dto.UpdateProductDto) (*ent.Product, error)
+	SoftDeleteProductById(ctx context.Context, client *ent.Client, productId string, version int64) (*ent.Product, error)
+	LockProductsByIds(ctx context.Context, client *ent.Client, productIds []string) ([]*ent.Product, error)
+	LockProductsBySkus(ctx context.Context, client *ent.Client, userId string, skus []string) ([]*ent.Product, error)
+	AdjustProductQuantityById(ctx context.Context, client *ent.Client, productId string, payload *dto.CreateInventoryMovementDto) (*ent.Product, error)
+	CreateInventoryMovement(ctx context.Context, client *ent.Client, productId string, quantityAfter int32, payload *dto.CreateInventoryMovementDto) (*ent.InventoryMovement, error)
+	GetInventoryMovementsByProductId(ctx context.Context, client *ent.Client, productId string, payload *dto.QueryInventoryMovementsDto) ([]*ent.InventoryMovement, int, error)
+	GetInventoryLedgerQuantityByProductId(ctx context.Context, client *ent.Client, productId string, variantId *string) (int32, error)
+	CreateProductVariant(ctx context.Context, client *ent.Client, productId string, payload *dto.CreateProductVariantDto) (*ent.ProductVariant, error)
+	GetProductVariantsByProductId(ctx context.Context, client *ent.Client, productId string) ([]*ent.ProductVariant, error)
+	GetProductVariantById(ctx context.Context, client *ent.Client, variantId string) (*ent.ProductVariant, error)
+	UpdateProductVariantById(ctx context.Context, client *ent.Client, variantId string, version int64, payload *dto.UpdateProductVariantDto) (*ent.ProductVariant, error)
+	SoftDeleteProductVariantById(ctx context.Context, client *ent.Client, variantId string, version int64) (*ent.ProductVariant, error)
+	LockProductVariantsByProductIds(ctx context.Context, client *ent.Client, productIds []string) ([]*ent.ProductVariant, error)
+	AdjustProductVariantQuantityById(ctx context.Context, client *ent.Client, variantId string, payload *dto.CreateInventoryMovementDto) (*ent.ProductVariant, error)
+	GetProductCategoryIdsById(ctx context.Context, client *ent.Client, productId string) ([]string, error)
+	SetProductCategoriesById(ctx context.Context, client *ent.Client, productId string, categoryIds []string) error
+	GetProductMediaByProductId(ctx context.Context, client *ent.Client, productId string) ([]*ent.ProductMedia, error)
+	SetProductMediaById(ctx context.Context, client *ent.Client, productId string, payload *dto.SetProductMediaDto) error
+	UpdateProductImgUrlById(ctx context.Context, client *ent.Client, productId string, imgUrl string) (*ent.Product, error)
+	ReplaceImgUrlByUserId(ctx context.Context, client *ent.Client, userId string, oldImgUrl string, newImgUrl string) error
 }
 
 type ProductRepository struct {
@@ -45,12 +77,15 @@ func (productRepo *ProductRepository) CreateProduct(
 	}
 	result, err := client.Product.Create().
 		SetUserID(userUuid).
+		SetNillableSku(payload.Sku).
 		SetName(*payload.Name).
 		SetPrice(*payload.Price).
 		SetQuantity(*payload.Quantity).
 		SetDescription(*payload.Description).
 		SetStatus(*payload.Status).
 		SetImgURL(*payload.ImgUrl).
+		SetCurrency(*payload.Currency).
+		SetOptions(payload.Options).
 		Save(ctx)
 	if err != nil {
 		productRepo.logger.Info("fail to client.Product.Create", zap.Error(err))
@@ -59,7 +94,7 @@ func (productRepo *ProductRepository) CreateProduct(
 	return result, nil
 }
 
-// GetProductsTotal
+// GetProductsTotalByUserId: archived products left out, they are deleted for the shop
 func (productRepo *ProductRepository) GetProductsTotalByUserId(ctx context.Context, client *ent.Client, userId string) (int, error) {
 	userUuid, err := uuid.Parse(userId)
 	if err != nil {
@@ -67,7 +102,7 @@ func (productRepo *ProductRepository) GetProductsTotalByUserId(ctx context.Conte
 		return 0, constants.ErrBadRequest
 	}
 
-	total, err := client.Product.Query().Where(product.UserID(userUuid)).Count(ctx)
+	total, err := client.Product.Query().Where(product.UserID(userUuid), product.IsArchived(false)).Count(ctx)
 	if err != nil {
 		productRepo.logger.Info("fail to count total", zap.Error(err))
 		return 0, handleEntRepoErr(err)
@@ -75,9 +110,10 @@ func (productRepo *ProductRepository) GetProductsTotalByUserId(ctx context.Conte
 	return total, nil
 }
 
-// GetProducts
+// GetProducts: full-text search on query, filter on price, status, stock, categories, ids and collection rules,
+// total counts filtered products, ties in sort broken by id for stable paging
 func (productRepo *ProductRepository) GetProducts(
-	ctx context.Context, client *ent.Client, userId string, payload *dto.QueryProductsDto) (*dto.QueryProductsResponseDto, error) {
+	ctx context.Context, client *ent.Client, userId string, payload *dto.QueryProductsDto, filter *dto.ProductsFilterDto) (*dto.QueryProductsResponseDto, error) {
 
 	userUuid, err := uuid.Parse(userId)
 	if err != nil {
@@ -89,16 +125,79 @@ func (productRepo *ProductRepository) GetProducts(
 	limit := payload.Limit
 	offset := (page - 1) * limit
 
-	total, err := client.Product.Query().Where(product.UserID(userUuid)).Count(ctx)
+	predicates := []predicate.Product{product.UserID(userUuid)}
+	if payload.MinPrice != nil {
+		predicates = append(predicates, product.PriceGTE(*payload.MinPrice))
+	}
+	if payload.MaxPrice != nil {
+		predicates = append(predicates, product.PriceLTE(*payload.MaxPrice))
+	}
+	if len(payload.Statuses) > 0 {
+		predicates = append(predicates, product.StatusIn(payload.Statuses...))
+	}
+	if payload.InStock {
+		predicates = append(predicates, product.Or(
+			product.QuantityGT(0),
+			product.HasVariantsWith(productvariant.IsArchived(false), productvariant.QuantityGT(0)),
+		))
+	}
+	if filter.Storefront {
+		predicates = append(predicates, product.IsArchived(false), product.StatusNEQ(constants.ProductStatus.Inactive))
+	}
+	if filter.CategoryIds != nil {
+		categoryUuids, err := parseUuids(filter.CategoryIds)
+		if err != nil {
+			productRepo.logger.Info("fail to parse categoryIds to uuid", zap.Error(err))
+			return nil, constants.ErrBadRequest
+		}
+		predicates = append(predicates, product.HasCategoriesWith(category.IDIn(categoryUuids...)))
+	}
+	if filter.ProductIds != nil {
+		productUuids, err := parseUuids(filter.ProductIds)
+		if err != nil {
+			productRepo.logger.Info("fail to parse productIds to uuid", zap.Error(err))
+			return nil, constants.ErrBadRequest
+		}
+		predicates = append(predicates, product.IDIn(productUuids...))
+	}
+	for _, rule := range filter.Rules {
+		rulePredicate, err := collectionRulePredicate(rule)
+		if err != nil {
+			productRepo.logger.Info("fail to collectionRulePredicate", zap.Error(err))
+			return nil, constants.ErrBadRequest
+		}
+		predicates = append(predicates, rulePredicate)
+	}
+
+	// full-text search, trigram similarity of name as fallback for typos if nothing matched
+	terms := payload.SearchTerms()
+	similar := false
+	if len(terms) > 0 {
+		matched, err := client.Product.Query().Where(append(predicates, productSearchPredicate(terms, false))...).Exist(ctx)
+		if err != nil {
+			productRepo.logger.Info("fail to search", zap.Error(err))
+			return nil, handleEntRepoErr(err)
+		}
+		similar = !matched
+		predicates = append(predicates, productSearchPredicate(terms, similar))
+	}
+
+	total, err := client.Product.Query().Where(predicates...).Count(ctx)
 	if err != nil {
 		productRepo.logger.Info("fail to count total", zap.Error(err))
 		return nil, handleEntRepoErr(err)
 	}
 
 	// call ent client to Query
+	sort, order := payload.SortBy()
+	orderFunc := lo.Ternary(order == constants.SortOrder.Asc, ent.Asc, ent.Desc)
+	sortFunc := orderFunc(productSortFields[sort])
+	if sort == constants.ProductSort.Relevance {
+		sortFunc = productSearchRankOrder(terms, similar, order == constants.SortOrder.Desc)
+	}
 	result, err := client.Product.Query().
-		Where(product.UserID(userUuid), product.NameContains(payload.Query)).
-		Order(ent.Desc(product.FieldCreatedAt)).
+		Where(predicates...).
+		Order(sortFunc, orderFunc(product.FieldID)).
 		Offset(offset).
 		Limit(limit).
 		All(ctx)
@@ -112,6 +211,102 @@ func (productRepo *ProductRepository) GetProducts(
 	return data, nil
 }
 
+// productSortFields: column of constants.ProductSort, stock of product itself
+var productSortFields = map[string]string{
+	constants.ProductSort.Price:   product.FieldPrice,
+	constants.ProductSort.Name:    product.FieldName,
+	constants.ProductSort.Created: product.FieldCreatedAt,
+	constants.ProductSort.Updated: product.FieldUpdatedAt,
+	constants.ProductSort.Stock:   product.FieldQuantity,
+}
+
+// productSearchTsQuery: prefix match of all terms, e.g. to_tsquery('english', 'red:* & shirt:*')
+func productSearchTsQuery(b *sql.Builder, terms []string) {
+	b.WriteString(fmt.Sprintf("to_tsquery('%s', ", constants.ProductSearchConfig)).
+		Arg(strings.Join(terms, ":* & ") + ":*").
+		WriteString(")")
+}
+
+// productSearchSimilarity: trigram word similarity of query to name, case insensitive
+func productSearchSimilarity(s *sql.Selector, b *sql.Builder, terms []string) {
+	b.WriteString("word_similarity(").
+		Arg(strings.Join(terms, " ")).
+		WriteString(", ").
+		WriteString(s.C(product.FieldName)).
+		WriteString(")")
+}
+
+// productSearchPredicate: full-text match on name and description of generated column,
+// or name similar to query if similar
+func productSearchPredicate(terms []string, similar bool) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			if similar {
+				productSearchSimilarity(s, b, terms)
+				b.WriteString(" >= ").Arg(constants.ProductSearchSimilarity)
+				return
+			}
+			b.WriteString(s.C(constants.ProductSearchColumn)).WriteString(" @@ ")
+			productSearchTsQuery(b, terms)
+		}))
+	})
+}
+
+// productSearchRankOrder: text rank with name weighted over description, or name similarity if similar
+func productSearchRankOrder(terms []string, similar bool, desc bool) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		rank := sql.ExprFunc(func(b *sql.Builder) {
+			if similar {
+				productSearchSimilarity(s, b, terms)
+				return
+			}
+			b.WriteString("ts_rank_cd(").WriteString(s.C(constants.ProductSearchColumn)).WriteString(", ")
+			productSearchTsQuery(b, terms)
+			b.WriteString(")")
+		})
+		s.OrderExpr(lo.Ternary(desc, sql.DescExpr(rank), rank))
+	}
+}
+
+// collectionRulePredicate: product predicate of one rule, rule validated by dto
+func collectionRulePredicate(rule schema.CollectionRule) (predicate.Product, error) {
+	switch rule.Field {
+	case constants.CollectionRuleField.Name:
+		if rule.Operator == constants.CollectionRuleOperator.Contains {
+			return product.NameContainsFold(rule.Value), nil
+		}
+		return product.Name(rule.Value), nil
+	case constants.CollectionRuleField.Price:
+		// major units as listing filter and product json
+		n, err := money.ParseAmount(rule.Value)
+		if err != nil {
+			return nil, err
+		}
+		switch rule.Operator {
+		case constants.CollectionRuleOperator.Gte:
+			return product.PriceGTE(n), nil
+		case constants.CollectionRuleOperator.Lte:
+			return product.PriceLTE(n), nil
+		}
+		return product.Price(n), nil
+	case constants.CollectionRuleField.Quantity:
+		n, err := strconv.ParseInt(rule.Value, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		switch rule.Operator {
+		case constants.CollectionRuleOperator.Gte:
+			return product.QuantityGTE(int32(n)), nil
+		case constants.CollectionRuleOperator.Lte:
+			return product.QuantityLTE(int32(n)), nil
+		}
+		return product.Quantity(int32(n)), nil
+	case constants.CollectionRuleField.Status:
+		return product.Status(rule.Value), nil
+	}
+	return nil, fmt.Errorf("collection rule field %s not supported", rule.Field)
+}
+
 // GetProductById
 func (productRepo *ProductRepository) GetProductById(
 	ctx context.Context, client *ent.Client, productId string) (*ent.Product, error) {
@@ -130,33 +325,117 @@ func (productRepo *ProductRepository) GetProductById(
 	return result, nil
 }
 
-// UpdateProductById
+// UpdateProductById: conditional on version, ErrPreconditionFailed if version changed
 func (productRepo *ProductRepository) UpdateProductById(
-	ctx context.Context, client *ent.Client, productId string, payload *dto.UpdateProductDto) (*ent.Product, error) {
+	ctx context.Context, client *ent.Client, productId string, version int64, payload *dto.UpdateProductDto) (*ent.Product, error) {
 	productUuid, err := uuid.Parse(productId)
 	if err != nil {
 		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
 		return nil, constants.ErrBadRequest
 	}
-	result, err := client.Product.UpdateOneID(productUuid).
+	update := client.Product.UpdateOneID(productUuid).
+		Where(product.Version(version)).
 		SetName(*payload.Name).
 		SetPrice(*payload.Price).
-		SetQuantity(*payload.Quantity).
 		SetDescription(*payload.Description).
 		SetStatus(*payload.Status).
 		SetImgURL(*payload.ImgUrl).
+		AddVersion(1)
+	// nil quantity, options and sku keep current
+	if payload.Quantity != nil {
+		update.SetQuantity(*payload.Quantity)
+	}
+	if payload.Options != nil {
+		update.SetOptions(payload.Options)
+	}
+	if payload.Sku != nil {
+		update.SetSku(*payload.Sku)
+	}
+	result, err := update.Save(ctx)
+
+	if err != nil {
+		productRepo.logger.Info("fail to client.Product.UpdateOneID", zap.Error(err))
+		return nil, handleEntVersionErr(ctx, err, client.Product.Query().Where(product.ID(productUuid)).Exist)
+	}
+	return result, nil
+}
+
+// SoftDeleteProductById: conditional on version, ErrPreconditionFailed if version changed
+func (productRepo *ProductRepository) SoftDeleteProductById(
+	ctx context.Context, client *ent.Client, productId string, version int64) (*ent.Product, error) {
+	productUuid, err := uuid.Parse(productId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.Product.UpdateOneID(productUuid).
+		Where(product.Version(version)).
+		SetIsArchived(true).
+		AddVersion(1).
 		Save(ctx)
 
 	if err != nil {
 		productRepo.logger.Info("fail to client.Product.UpdateOneID", zap.Error(err))
+		return nil, handleEntVersionErr(ctx, err, client.Product.Query().Where(product.ID(productUuid)).Exist)
+	}
+	return result, nil
+}
+
+// LockProductsByIds: select for update in id order, rows stay locked until the tx ends,
+// must be called with a tx client
+func (productRepo *ProductRepository) LockProductsByIds(
+	ctx context.Context, client *ent.Client, productIds []string) ([]*ent.Product, error) {
+	productUuids := make([]uuid.UUID, 0, len(productIds))
+	for _, productId := range productIds {
+		productUuid, err := uuid.Parse(productId)
+		if err != nil {
+			productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+			return nil, constants.ErrBadRequest
+		}
+		productUuids = append(productUuids, productUuid)
+	}
+
+	// same lock order for every tx, so concurrent checkouts cannot deadlock
+	result, err := client.Product.Query().
+		Where(product.IDIn(productUuids...)).
+		Order(ent.Asc(product.FieldID)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.Product.Query", zap.Error(err))
 		return nil, handleEntRepoErr(err)
 	}
 	return result, nil
 }
 
-// SoftDeleteProductById
-func (productRepo *ProductRepository) SoftDeleteProductById(
-	ctx context.Context, client *ent.Client, productId string) (*ent.Product, error) {
+// LockProductsBySkus: select for update in id order, archived included as sku stays unique,
+// products not found are left out, must be called with a tx client
+func (productRepo *ProductRepository) LockProductsBySkus(
+	ctx context.Context, client *ent.Client, userId string, skus []string) ([]*ent.Product, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.Product.Query().
+		Where(product.UserID(userUuid), product.SkuIn(skus...)).
+		Order(ent.Asc(product.FieldID)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.Product.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// AdjustProductQuantityById: add delta to quantity and record the movement in ledger,
+// ErrBadRequest if quantity would drop below 0, must be called with a tx client,
+// version kept, stock movement is no edit of product so If-Match of staff stays valid
+func (productRepo *ProductRepository) AdjustProductQuantityById(
+	ctx context.Context, client *ent.Client, productId string, payload *dto.CreateInventoryMovementDto) (*ent.Product, error) {
 	productUuid, err := uuid.Parse(productId)
 	if err != nil {
 		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
@@ -164,12 +443,468 @@ func (productRepo *ProductRepository) SoftDeleteProductById(
 	}
 
 	result, err := client.Product.UpdateOneID(productUuid).
+		Where(product.QuantityGTE(-payload.Delta)).
+		AddQuantity(payload.Delta).
+		Save(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.Product.UpdateOneID", zap.Error(err))
+		if ent.IsNotFound(err) {
+			ok, existErr := client.Product.Query().Where(product.ID(productUuid)).Exist(ctx)
+			if existErr == nil && ok {
+				return nil, constants.ErrBadRequest
+			}
+		}
+		return nil, handleEntRepoErr(err)
+	}
+
+	_, err = productRepo.CreateInventoryMovement(ctx, client, productId, result.Quantity, payload)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateInventoryMovement: append to ledger, quantityAfter is product or variant quantity after the movement
+func (productRepo *ProductRepository) CreateInventoryMovement(
+	ctx context.Context, client *ent.Client, productId string, quantityAfter int32, payload *dto.CreateInventoryMovementDto) (*ent.InventoryMovement, error) {
+	productUuid, err := uuid.Parse(productId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	create := client.InventoryMovement.Create().
+		SetProductID(productUuid).
+		SetReason(payload.Reason).
+		SetDelta(payload.Delta).
+		SetQuantityAfter(quantityAfter).
+		SetReferenceID(payload.ReferenceId).
+		SetNote(payload.Note)
+	if payload.VariantId != nil {
+		variantUuid, err := uuid.Parse(*payload.VariantId)
+		if err != nil {
+			productRepo.logger.Info("fail to parse payload.VariantId to uuid", zap.Error(err))
+			return nil, constants.ErrBadRequest
+		}
+		create.SetVariantID(variantUuid)
+	}
+	if payload.ActorId != nil {
+		actorUuid, err := uuid.Parse(*payload.ActorId)
+		if err != nil {
+			productRepo.logger.Info("fail to parse payload.ActorId to uuid", zap.Error(err))
+			return nil, constants.ErrBadRequest
+		}
+		create.SetActorID(actorUuid)
+	}
+	result, err := create.Save(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.InventoryMovement.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetInventoryMovementsByProductId: newest first, with total count
+func (productRepo *ProductRepository) GetInventoryMovementsByProductId(
+	ctx context.Context, client *ent.Client, productId string, payload *dto.QueryInventoryMovementsDto) ([]*ent.InventoryMovement, int, error) {
+	productUuid, err := uuid.Parse(productId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, 0, constants.ErrBadRequest
+	}
+
+	page := payload.Page
+	limit := payload.Limit
+	offset := (page - 1) * limit
+
+	total, err := client.InventoryMovement.Query().Where(inventorymovement.ProductID(productUuid)).Count(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to count total", zap.Error(err))
+		return nil, 0, handleEntRepoErr(err)
+	}
+
+	result, err := client.InventoryMovement.Query().
+		Where(inventorymovement.ProductID(productUuid)).
+		Order(ent.Desc(inventorymovement.FieldCreatedAt)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.InventoryMovement.Query", zap.Error(err))
+		return nil, 0, handleEntRepoErr(err)
+	}
+	return result, total, nil
+}
+
+// GetInventoryLedgerQuantityByProductId: sum of movement delta of product stock, or of variant stock if variantId given,
+// 0 without movement
+func (productRepo *ProductRepository) GetInventoryLedgerQuantityByProductId(
+	ctx context.Context, client *ent.Client, productId string, variantId *string) (int32, error) {
+	productUuid, err := uuid.Parse(productId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return 0, constants.ErrBadRequest
+	}
+	predicate := inventorymovement.VariantIDIsNil()
+	if variantId != nil {
+		variantUuid, err := uuid.Parse(*variantId)
+		if err != nil {
+			productRepo.logger.Info("fail to parse variantId to uuid", zap.Error(err))
+			return 0, constants.ErrBadRequest
+		}
+		predicate = inventorymovement.VariantID(variantUuid)
+	}
+
+	sum, err := client.InventoryMovement.Query().
+		Where(inventorymovement.ProductID(productUuid), predicate).
+		Aggregate(func(s *sql.Selector) string {
+			return fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(inventorymovement.FieldDelta))
+		}).
+		Int(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.InventoryMovement.Query", zap.Error(err))
+		return 0, handleEntRepoErr(err)
+	}
+	return int32(sum), nil
+}
+
+// ****ProductVariant
+
+// CreateProductVariant
+func (productRepo *ProductRepository) CreateProductVariant(
+	ctx context.Context, client *ent.Client, productId string, payload *dto.CreateProductVariantDto) (*ent.ProductVariant, error) {
+	productUuid, err := uuid.Parse(productId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	result, err := client.ProductVariant.Create().
+		SetProductID(productUuid).
+		SetSku(*payload.Sku).
+		SetOptions(payload.Options).
+		SetNillablePrice(payload.Price).
+		SetQuantity(*payload.Quantity).
+		SetImgURL(*payload.ImgUrl).
+		Save(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.ProductVariant.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetProductVariantsByProductId: oldest first, archived included
+func (productRepo *ProductRepository) GetProductVariantsByProductId(
+	ctx context.Context, client *ent.Client, productId string) ([]*ent.ProductVariant, error) {
+	productUuid, err := uuid.Parse(productId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	result, err := client.ProductVariant.Query().
+		Where(productvariant.ProductID(productUuid)).
+		Order(ent.Asc(productvariant.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.ProductVariant.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetProductVariantById
+func (productRepo *ProductRepository) GetProductVariantById(
+	ctx context.Context, client *ent.Client, variantId string) (*ent.ProductVariant, error) {
+	variantUuid, err := uuid.Parse(variantId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse variantId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	result, err := client.ProductVariant.Get(ctx, variantUuid)
+	if err != nil {
+		productRepo.logger.Info("fail to client.ProductVariant.Get", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// UpdateProductVariantById: conditional on version, ErrPreconditionFailed if version changed
+func (productRepo *ProductRepository) UpdateProductVariantById(
+	ctx context.Context, client *ent.Client, variantId string, version int64, payload *dto.UpdateProductVariantDto) (*ent.ProductVariant, error) {
+	variantUuid, err := uuid.Parse(variantId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse variantId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	update := client.ProductVariant.UpdateOneID(variantUuid).
+		Where(productvariant.Version(version)).
+		SetSku(*payload.Sku).
+		SetOptions(payload.Options).
+		SetImgURL(*payload.ImgUrl).
+		AddVersion(1)
+	// nil quantity keeps current
+	if payload.Quantity != nil {
+		update.SetQuantity(*payload.Quantity)
+	}
+	if payload.Price != nil {
+		update.SetPrice(*payload.Price)
+	} else {
+		update.ClearPrice()
+	}
+	result, err := update.Save(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.ProductVariant.UpdateOneID", zap.Error(err))
+		return nil, handleEntVersionErr(ctx, err, client.ProductVariant.Query().Where(productvariant.ID(variantUuid)).Exist)
+	}
+	return result, nil
+}
+
+// SoftDeleteProductVariantById: conditional on version, ErrPreconditionFailed if version changed
+func (productRepo *ProductRepository) SoftDeleteProductVariantById(
+	ctx context.Context, client *ent.Client, variantId string, version int64) (*ent.ProductVariant, error) {
+	variantUuid, err := uuid.Parse(variantId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse variantId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	result, err := client.ProductVariant.UpdateOneID(variantUuid).
+		Where(productvariant.Version(version)).
 		SetIsArchived(true).
+		AddVersion(1).
 		Save(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.ProductVariant.UpdateOneID", zap.Error(err))
+		return nil, handleEntVersionErr(ctx, err, client.ProductVariant.Query().Where(productvariant.ID(variantUuid)).Exist)
+	}
+	return result, nil
+}
+
+// LockProductVariantsByProductIds: select for update all variants of products in id order,
+// archived included, must be called with a tx client after products locked
+func (productRepo *ProductRepository) LockProductVariantsByProductIds(
+	ctx context.Context, client *ent.Client, productIds []string) ([]*ent.ProductVariant, error) {
+	productUuids := make([]uuid.UUID, 0, len(productIds))
+	for _, productId := range productIds {
+		productUuid, err := uuid.Parse(productId)
+		if err != nil {
+			productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+			return nil, constants.ErrBadRequest
+		}
+		productUuids = append(productUuids, productUuid)
+	}
+
+	result, err := client.ProductVariant.Query().
+		Where(productvariant.ProductIDIn(productUuids...)).
+		Order(ent.Asc(productvariant.FieldID)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.ProductVariant.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// AdjustProductVariantQuantityById: add delta to variant quantity and record the movement in ledger of its product,
+// ErrBadRequest if quantity would drop below 0, must be called with a tx client, version kept as for product
+func (productRepo *ProductRepository) AdjustProductVariantQuantityById(
+	ctx context.Context, client *ent.Client, variantId string, payload *dto.CreateInventoryMovementDto) (*ent.ProductVariant, error) {
+	variantUuid, err := uuid.Parse(variantId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse variantId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.ProductVariant.UpdateOneID(variantUuid).
+		Where(productvariant.QuantityGTE(-payload.Delta)).
+		AddQuantity(payload.Delta).
+		Save(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.ProductVariant.UpdateOneID", zap.Error(err))
+		if ent.IsNotFound(err) {
+			ok, existErr := client.ProductVariant.Query().Where(productvariant.ID(variantUuid)).Exist(ctx)
+			if existErr == nil && ok {
+				return nil, constants.ErrBadRequest
+			}
+		}
+		return nil, handleEntRepoErr(err)
+	}
+
+	movement := *payload
+	movement.VariantId = &variantId
+	_, err = productRepo.CreateInventoryMovement(ctx, client, result.ProductID.String(), result.Quantity, &movement)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetProductsByIds: products not found are left out
+func (productRepo *ProductRepository) GetProductsByIds(
+	ctx context.Context, client *ent.Client, productIds []string) ([]*ent.Product, error) {
+	productUuids, err := parseUuids(productIds)
+	if err != nil {
+		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	result, err := client.Product.Query().
+		Where(product.IDIn(productUuids...)).
+		All(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.Product.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
 
+// GetProductCategoryIdsById
+func (productRepo *ProductRepository) GetProductCategoryIdsById(
+	ctx context.Context, client *ent.Client, productId string) ([]string, error) {
+	productUuid, err := uuid.Parse(productId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	result, err := client.Product.Query().
+		Where(product.ID(productUuid)).
+		QueryCategories().
+		Order(ent.Asc(category.FieldID)).
+		IDs(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.Product.QueryCategories", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return lo.Map(result, func(id uuid.UUID, _ int) string { return id.String() }), nil
+}
+
+// SetProductCategoriesById: replace categories of product
+func (productRepo *ProductRepository) SetProductCategoriesById(
+	ctx context.Context, client *ent.Client, productId string, categoryIds []string) error {
+	productUuid, err := uuid.Parse(productId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+	categoryUuids, err := parseUuids(categoryIds)
+	if err != nil {
+		productRepo.logger.Info("fail to parse categoryId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+	err = client.Product.UpdateOneID(productUuid).
+		ClearCategories().
+		AddCategoryIDs(categoryUuids...).
+		Exec(ctx)
 	if err != nil {
 		productRepo.logger.Info("fail to client.Product.UpdateOneID", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+	return nil
+}
+
+// ****ProductMedia
+
+// GetProductMediaByProductId: gallery of product in display order
+func (productRepo *ProductRepository) GetProductMediaByProductId(
+	ctx context.Context, client *ent.Client, productId string) ([]*ent.ProductMedia, error) {
+	productUuid, err := uuid.Parse(productId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.ProductMedia.Query().
+		Where(productmedia.ProductID(productUuid)).
+		Order(ent.Asc(productmedia.FieldPosition)).
+		All(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.ProductMedia.Query", zap.Error(err))
 		return nil, handleEntRepoErr(err)
 	}
 	return result, nil
 }
+
+// SetProductMediaById: replace gallery of product, position by index in payload
+func (productRepo *ProductRepository) SetProductMediaById(
+	ctx context.Context, client *ent.Client, productId string, payload *dto.SetProductMediaDto) error {
+	productUuid, err := uuid.Parse(productId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+
+	_, err = client.ProductMedia.Delete().
+		Where(productmedia.ProductID(productUuid)).
+		Exec(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.ProductMedia.Delete", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+
+	primary := payload.PrimaryIndex()
+	bulk := make([]*ent.ProductMediaCreate, len(payload.Media))
+	for i, item := range payload.Media {
+		bulk[i] = client.ProductMedia.Create().
+			SetProductID(productUuid).
+			SetImageinfoID(*item.ImgInfoId).
+			SetPosition(i).
+			SetAltText(lo.FromPtr(item.AltText)).
+			SetIsPrimary(i == primary)
+	}
+	_, err = client.ProductMedia.CreateBulk(bulk...).Save(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.ProductMedia.CreateBulk", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+	return nil
+}
+
+// UpdateProductImgUrlById: cover image of product, version bumped
+func (productRepo *ProductRepository) UpdateProductImgUrlById(
+	ctx context.Context, client *ent.Client, productId string, imgUrl string) (*ent.Product, error) {
+	productUuid, err := uuid.Parse(productId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.Product.UpdateOneID(productUuid).
+		SetImgURL(imgUrl).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.Product.UpdateOneID", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// ReplaceImgUrlByUserId: products and variants of user showing old image url repointed to new one, versions bumped
+func (productRepo *ProductRepository) ReplaceImgUrlByUserId(
+	ctx context.Context, client *ent.Client, userId string, oldImgUrl string, newImgUrl string) error {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		productRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+
+	_, err = client.Product.Update().
+		Where(product.UserID(userUuid), product.ImgURL(oldImgUrl)).
+		SetImgURL(newImgUrl).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.Product.Update", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+
+	_, err = client.ProductVariant.Update().
+		Where(productvariant.ImgURL(oldImgUrl), productvariant.HasOwnerWith(product.UserID(userUuid))).
+		SetImgURL(newImgUrl).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		productRepo.logger.Info("fail to client.ProductVariant.Update", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+	return nil
+}
@@ -6,22 +6,37 @@ import (
 	"sthl/constants"
 	"sthl/dto"
 	"sthl/ent"
+	"sthl/ent/schema"
+	"sthl/money"
 	"sthl/storage"
 	"sthl/utils"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 type ProductRepositoryMock struct {
-	mockData map[string]ent.Product
-	mu       sync.Mutex
+	mockData         map[string]ent.Product
+	mockDataMovement []ent.InventoryMovement
+	mockDataVariant  map[string]ent.ProductVariant
+	// product id to category ids
+	mockDataCategory map[string][]string
+	// product id to gallery in display order
+	mockDataMedia map[string][]ent.ProductMedia
+	mu            sync.Mutex
 }
 
 func NewProductRepositoryMock() IProductRepository {
 	return &ProductRepositoryMock{
-		mockData: map[string]ent.Product{},
+		mockData:         map[string]ent.Product{},
+		mockDataMovement: []ent.InventoryMovement{},
+		mockDataVariant:  map[string]ent.ProductVariant{},
+		mockDataCategory: map[string][]string{},
+		mockDataMedia:    map[string][]ent.ProductMedia{},
 	}
 }
 
@@ -32,19 +47,19 @@ func newProductSchemaMock(userId uuid.UUID, payload *dto.CreateProductDtoMappedD
 		CreatedAt:   t,
 		UpdatedAt:   t,
 		UserID:      userId,
+		Sku:         payload.Sku,
 		Name:        *payload.Name,
 		Price:       *payload.Price,
 		Quantity:    *payload.Quantity,
 		Description: *payload.Description,
 		Status:      *payload.Status,
+		Currency:    *payload.Currency,
+		Options:     payload.Options,
 		IsArchived:  false,
+		Version:     1,
 	}
 }
 
-func (m *ProductRepositoryMock) Lock() {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-}
 func (m *ProductRepositoryMock) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
 	return storage.WithTxTest(ctx, nil, client, fn)
 }
@@ -54,7 +69,8 @@ func (m *ProductRepositoryMock) WithTx(ctx context.Context, client *ent.Client,
 // CreateProduct
 func (m *ProductRepositoryMock) CreateProduct(
 	ctx context.Context, client *ent.Client, userId string, payload *dto.CreateProductDtoMappedDto) (*ent.Product, error) {
-	m.Lock()
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	for _, data := range m.mockData {
 		if data.Name == *payload.Name {
 			return nil, constants.ErrExisted
@@ -64,16 +80,31 @@ func (m *ProductRepositoryMock) CreateProduct(
 	if err != nil {
 		return nil, constants.ErrBadRequest
 	}
+	if m.skuUsedMock(userUuid, payload.Sku, "") {
+		return nil, constants.ErrBadRequest
+	}
 	result := newProductSchemaMock(userUuid, payload)
 	m.mockData[result.ID.String()] = *result
 	return result, nil
 }
 
+// skuUsedMock: unique sku in shop, as unique index of ProductRepository
+func (m *ProductRepositoryMock) skuUsedMock(userId uuid.UUID, sku *string, exceptProductId string) bool {
+	if sku == nil {
+		return false
+	}
+	return lo.SomeBy(lo.Values(m.mockData), func(p ent.Product) bool {
+		return p.ID.String() != exceptProductId && p.UserID == userId && p.Sku != nil && *p.Sku == *sku
+	})
+}
+
 // GetProductsTotalByUserId
 func (m *ProductRepositoryMock) GetProductsTotalByUserId(ctx context.Context, client *ent.Client, userId string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	var count int = 0
 	for _, u := range m.mockData {
-		if u.UserID.String() == userId {
+		if u.UserID.String() == userId && !u.IsArchived {
 			count++
 		}
 	}
@@ -82,35 +113,187 @@ func (m *ProductRepositoryMock) GetProductsTotalByUserId(ctx context.Context, cl
 
 // GetProducts
 func (m *ProductRepositoryMock) GetProducts(
-	ctx context.Context, client *ent.Client, userId string, payload *dto.QueryProductsDto) (*dto.QueryProductsResponseDto, error) {
-	m.Lock()
+	ctx context.Context, client *ent.Client, userId string, payload *dto.QueryProductsDto, filter *dto.ProductsFilterDto) (*dto.QueryProductsResponseDto, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	var productSlice, subSlice []*ent.Product
 	for _, u := range m.mockData {
-		if u.UserID.String() == userId {
-			productSlice = append(productSlice, &u)
+		p := u
+		if p.UserID.String() == userId && m.matchProductsQuery(&p, payload) && m.matchProductsFilter(&p, filter) {
+			productSlice = append(productSlice, &p)
+		}
+	}
+	// search, name similarity as fallback if nothing matched
+	terms := payload.SearchTerms()
+	ranks := map[uuid.UUID]float64{}
+	if len(terms) > 0 {
+		matched := lo.Filter(productSlice, func(p *ent.Product, _ int) bool {
+			ranks[p.ID] = matchProductSearchMock(p, terms)
+			return ranks[p.ID] > 0
+		})
+		if len(matched) == 0 {
+			matched = lo.Filter(productSlice, func(p *ent.Product, _ int) bool {
+				ranks[p.ID] = trigramWordSimilarityMock(strings.Join(terms, " "), p.Name)
+				return ranks[p.ID] >= constants.ProductSearchSimilarity
+			})
 		}
+		productSlice = matched
 	}
-	sort.Slice(productSlice, func(i, j int) bool {
-		return productSlice[i].CreatedAt.Before(productSlice[j].CreatedAt)
+	sortBy, order := payload.SortBy()
+	sort.SliceStable(productSlice, func(i, j int) bool {
+		a, b := productSlice[i], productSlice[j]
+		if order == constants.SortOrder.Desc {
+			a, b = b, a
+		}
+		switch sortBy {
+		case constants.ProductSort.Relevance:
+			return ranks[a.ID] < ranks[b.ID]
+		case constants.ProductSort.Price:
+			return a.Price < b.Price
+		case constants.ProductSort.Name:
+			return a.Name < b.Name
+		case constants.ProductSort.Updated:
+			return a.UpdatedAt.Before(b.UpdatedAt)
+		case constants.ProductSort.Stock:
+			return a.Quantity < b.Quantity
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
 	})
 
 	page := payload.Page
 	limit := payload.Limit
 	offset := (page - 1) * limit
-	subSlice = productSlice[offset:]
-	if len(productSlice) > offset+limit-1 {
-		subSlice = productSlice[offset : offset+limit-1]
-	}
+	subSlice = productSlice[lo.Min([]int{offset, len(productSlice)}):lo.Min([]int{offset + limit, len(productSlice)})]
 
 	pagingResp := dto.NewPagingResponse(payload.Page, payload.Limit, len(productSlice))
 	result := dto.NewQueryProductsResponseDto(subSlice, *pagingResp)
 	return result, nil
 }
 
+// matchProductSearchMock: approximation of full-text rank of ProductRepository, stemming ignored,
+// every term prefix of a word of name or description, name hits ranked higher, zero if not matched
+func matchProductSearchMock(p *ent.Product, terms []string) float64 {
+	nameWords := searchWordsMock(p.Name)
+	descriptionWords := searchWordsMock(p.Description)
+	hasPrefix := func(words []string, term string) bool {
+		return lo.SomeBy(words, func(w string) bool { return strings.HasPrefix(w, term) })
+	}
+	rank := 0.0
+	for _, term := range terms {
+		switch {
+		case hasPrefix(nameWords, term):
+			rank += 1
+		case hasPrefix(descriptionWords, term):
+			rank += 0.4
+		default:
+			return 0
+		}
+	}
+	return rank
+}
+
+// searchWordsMock: words of text split as search terms of query
+func searchWordsMock(text string) []string {
+	return dto.QueryProductsDto{Paging: dto.Paging{Query: text}}.SearchTerms()
+}
+
+// trigramWordSimilarityMock: share of query trigrams found in text, lower cased words padded as pg_trgm
+func trigramWordSimilarityMock(query string, text string) float64 {
+	trigrams := func(s string) map[string]bool {
+		result := map[string]bool{}
+		for _, w := range searchWordsMock(s) {
+			padded := []rune("  " + w + " ")
+			for i := 0; i+3 <= len(padded); i++ {
+				result[string(padded[i:i+3])] = true
+			}
+		}
+		return result
+	}
+	queryTrigrams := trigrams(query)
+	if len(queryTrigrams) == 0 {
+		return 0
+	}
+	textTrigrams := trigrams(text)
+	count := lo.CountBy(lo.Keys(queryTrigrams), func(t string) bool { return textTrigrams[t] })
+	return float64(count) / float64(len(queryTrigrams))
+}
+
+// matchProductsQuery: same query as GetProducts of ProductRepository
+func (m *ProductRepositoryMock) matchProductsQuery(p *ent.Product, payload *dto.QueryProductsDto) bool {
+	if payload.MinPrice != nil && p.Price < *payload.MinPrice {
+		return false
+	}
+	if payload.MaxPrice != nil && p.Price > *payload.MaxPrice {
+		return false
+	}
+	if len(payload.Statuses) > 0 && !lo.Contains(payload.Statuses, p.Status) {
+		return false
+	}
+	if payload.InStock && p.Quantity <= 0 && !lo.SomeBy(lo.Values(m.mockDataVariant), func(v ent.ProductVariant) bool {
+		return v.ProductID == p.ID && !v.IsArchived && v.Quantity > 0
+	}) {
+		return false
+	}
+	return true
+}
+
+// matchProductsFilter: same filter as GetProducts of ProductRepository
+func (m *ProductRepositoryMock) matchProductsFilter(p *ent.Product, filter *dto.ProductsFilterDto) bool {
+	if filter.Storefront && (p.IsArchived || p.Status == constants.ProductStatus.Inactive) {
+		return false
+	}
+	if filter.CategoryIds != nil && len(lo.Intersect(m.mockDataCategory[p.ID.String()], filter.CategoryIds)) == 0 {
+		return false
+	}
+	if filter.ProductIds != nil && !lo.Contains(filter.ProductIds, p.ID.String()) {
+		return false
+	}
+	for _, rule := range filter.Rules {
+		if !matchCollectionRuleMock(p, rule) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchCollectionRuleMock(p *ent.Product, rule schema.CollectionRule) bool {
+	var value int64
+	switch rule.Field {
+	case constants.CollectionRuleField.Name:
+		if rule.Operator == constants.CollectionRuleOperator.Contains {
+			return strings.Contains(strings.ToLower(p.Name), strings.ToLower(rule.Value))
+		}
+		return p.Name == rule.Value
+	case constants.CollectionRuleField.Status:
+		return p.Status == rule.Value
+	case constants.CollectionRuleField.Price:
+		value = int64(p.Price)
+	case constants.CollectionRuleField.Quantity:
+		value = int64(p.Quantity)
+	}
+	n, err := strconv.ParseInt(rule.Value, 10, 64)
+	if rule.Field == constants.CollectionRuleField.Price {
+		var amount money.Amount
+		amount, err = money.ParseAmount(rule.Value)
+		n = int64(amount)
+	}
+	if err != nil {
+		return false
+	}
+	switch rule.Operator {
+	case constants.CollectionRuleOperator.Gte:
+		return value >= n
+	case constants.CollectionRuleOperator.Lte:
+		return value <= n
+	}
+	return value == n
+}
+
 // GetProductById
 func (m *ProductRepositoryMock) GetProductById(
 	ctx context.Context, client *ent.Client, productId string) (*ent.Product, error) {
-	m.Lock()
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
 	value := m.mockData[productId]
 	if utils.IsEmpty(value) {
@@ -121,17 +304,33 @@ func (m *ProductRepositoryMock) GetProductById(
 
 // UpdateProductById
 func (m *ProductRepositoryMock) UpdateProductById(
-	ctx context.Context, client *ent.Client, productId string, payload *dto.UpdateProductDto) (*ent.Product, error) {
-	m.Lock()
+	ctx context.Context, client *ent.Client, productId string, version int64, payload *dto.UpdateProductDto) (*ent.Product, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	for key, data := range m.mockData {
 		if key == productId {
 			u := data
+			if u.Version != version {
+				return nil, constants.ErrPreconditionFailed
+			}
 
 			u.Name = *payload.Name
 			u.Price = *payload.Price
-			u.Quantity = *payload.Quantity
+			if payload.Quantity != nil {
+				u.Quantity = *payload.Quantity
+			}
 			u.Description = *payload.Description
 			u.Status = *payload.Status
+			if payload.Options != nil {
+				u.Options = payload.Options
+			}
+			if payload.Sku != nil {
+				if m.skuUsedMock(u.UserID, payload.Sku, key) {
+					return nil, constants.ErrBadRequest
+				}
+				u.Sku = payload.Sku
+			}
+			u.Version++
 
 			m.mockData[key] = u
 			return &u, nil
@@ -142,8 +341,9 @@ func (m *ProductRepositoryMock) UpdateProductById(
 
 // SoftDeleteProductById
 func (m *ProductRepositoryMock) SoftDeleteProductById(
-	ctx context.Context, client *ent.Client, productId string) (*ent.Product, error) {
-	m.Lock()
+	ctx context.Context, client *ent.Client, productId string, version int64) (*ent.Product, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
 	for key, data := range m.mockData {
 		if key == productId {
@@ -151,7 +351,11 @@ func (m *ProductRepositoryMock) SoftDeleteProductById(
 			if u.IsArchived {
 				return nil, constants.ErrBadRequest
 			}
+			if u.Version != version {
+				return nil, constants.ErrPreconditionFailed
+			}
 			u.IsArchived = true
+			u.Version++
 
 			m.mockData[key] = u
 			return &u, nil
@@ -159,3 +363,452 @@ func (m *ProductRepositoryMock) SoftDeleteProductById(
 	}
 	return nil, constants.ErrBadRequest
 }
+
+// LockProductsByIds: no row lock in mock, m.mu guards each call
+func (m *ProductRepositoryMock) LockProductsByIds(
+	ctx context.Context, client *ent.Client, productIds []string) ([]*ent.Product, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var result []*ent.Product
+	for _, productId := range productIds {
+		_, err := uuid.Parse(productId)
+		if err != nil {
+			return nil, constants.ErrBadRequest
+		}
+		value, ok := m.mockData[productId]
+		if ok {
+			result = append(result, &value)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID.String() < result[j].ID.String()
+	})
+	return result, nil
+}
+
+// LockProductsBySkus
+func (m *ProductRepositoryMock) LockProductsBySkus(
+	ctx context.Context, client *ent.Client, userId string, skus []string) ([]*ent.Product, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var result []*ent.Product
+	for _, u := range m.mockData {
+		p := u
+		if p.UserID.String() == userId && p.Sku != nil && lo.Contains(skus, *p.Sku) {
+			result = append(result, &p)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID.String() < result[j].ID.String()
+	})
+	return result, nil
+}
+
+// AdjustProductQuantityById
+func (m *ProductRepositoryMock) AdjustProductQuantityById(
+	ctx context.Context, client *ent.Client, productId string, payload *dto.CreateInventoryMovementDto) (*ent.Product, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	u, ok := m.mockData[productId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	if u.Quantity+payload.Delta < 0 {
+		return nil, constants.ErrBadRequest
+	}
+	u.Quantity += payload.Delta
+	m.mockData[productId] = u
+
+	_, err := m.createInventoryMovement(productId, u.Quantity, payload)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// createInventoryMovement: caller holds m.mu
+func (m *ProductRepositoryMock) createInventoryMovement(
+	productId string, quantityAfter int32, payload *dto.CreateInventoryMovementDto) (*ent.InventoryMovement, error) {
+	productUuid, err := uuid.Parse(productId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	result := ent.InventoryMovement{
+		ID:            uuid.New(),
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+		ProductID:     productUuid,
+		Reason:        payload.Reason,
+		Delta:         payload.Delta,
+		QuantityAfter: quantityAfter,
+		ReferenceID:   payload.ReferenceId,
+		Note:          payload.Note,
+	}
+	if payload.VariantId != nil {
+		variantUuid, err := uuid.Parse(*payload.VariantId)
+		if err != nil {
+			return nil, constants.ErrBadRequest
+		}
+		result.VariantID = &variantUuid
+	}
+	if payload.ActorId != nil {
+		actorUuid, err := uuid.Parse(*payload.ActorId)
+		if err != nil {
+			return nil, constants.ErrBadRequest
+		}
+		result.ActorID = &actorUuid
+	}
+	m.mockDataMovement = append(m.mockDataMovement, result)
+	return &result, nil
+}
+
+// CreateInventoryMovement
+func (m *ProductRepositoryMock) CreateInventoryMovement(
+	ctx context.Context, client *ent.Client, productId string, quantityAfter int32, payload *dto.CreateInventoryMovementDto) (*ent.InventoryMovement, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.createInventoryMovement(productId, quantityAfter, payload)
+}
+
+// GetInventoryMovementsByProductId: newest first
+func (m *ProductRepositoryMock) GetInventoryMovementsByProductId(
+	ctx context.Context, client *ent.Client, productId string, payload *dto.QueryInventoryMovementsDto) ([]*ent.InventoryMovement, int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var movements []*ent.InventoryMovement
+	for i := len(m.mockDataMovement) - 1; i >= 0; i-- {
+		if m.mockDataMovement[i].ProductID.String() == productId {
+			movement := m.mockDataMovement[i]
+			movements = append(movements, &movement)
+		}
+	}
+	offset := (payload.Page - 1) * payload.Limit
+	if offset > len(movements) {
+		offset = len(movements)
+	}
+	end := offset + payload.Limit
+	if end > len(movements) {
+		end = len(movements)
+	}
+	return movements[offset:end], len(movements), nil
+}
+
+// GetInventoryLedgerQuantityByProductId
+func (m *ProductRepositoryMock) GetInventoryLedgerQuantityByProductId(
+	ctx context.Context, client *ent.Client, productId string, variantId *string) (int32, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var sum int32 = 0
+	for _, movement := range m.mockDataMovement {
+		if movement.ProductID.String() != productId {
+			continue
+		}
+		isVariantMovement := movement.VariantID != nil
+		if isVariantMovement != (variantId != nil) {
+			continue
+		}
+		if variantId != nil && movement.VariantID.String() != *variantId {
+			continue
+		}
+		sum += movement.Delta
+	}
+	return sum, nil
+}
+
+// ****ProductVariant
+
+// CreateProductVariant
+func (m *ProductRepositoryMock) CreateProductVariant(
+	ctx context.Context, client *ent.Client, productId string, payload *dto.CreateProductVariantDto) (*ent.ProductVariant, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	productUuid, err := uuid.Parse(productId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	// unique sku per product
+	for _, data := range m.mockDataVariant {
+		if data.ProductID == productUuid && data.Sku == *payload.Sku {
+			return nil, constants.ErrBadRequest
+		}
+	}
+	t := time.Now()
+	result := ent.ProductVariant{
+		ID:        uuid.New(),
+		CreatedAt: t,
+		UpdatedAt: t,
+		ProductID: productUuid,
+		Sku:       *payload.Sku,
+		Options:   payload.Options,
+		Price:     payload.Price,
+		Quantity:  *payload.Quantity,
+		ImgURL:    *payload.ImgUrl,
+		Version:   1,
+	}
+	m.mockDataVariant[result.ID.String()] = result
+	return &result, nil
+}
+
+// GetProductVariantsByProductId: oldest first, archived included
+func (m *ProductRepositoryMock) GetProductVariantsByProductId(
+	ctx context.Context, client *ent.Client, productId string) ([]*ent.ProductVariant, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, err := uuid.Parse(productId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	result := []*ent.ProductVariant{}
+	for _, data := range m.mockDataVariant {
+		if data.ProductID.String() == productId {
+			variant := data
+			result = append(result, &variant)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+// GetProductVariantById
+func (m *ProductRepositoryMock) GetProductVariantById(
+	ctx context.Context, client *ent.Client, variantId string) (*ent.ProductVariant, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, err := uuid.Parse(variantId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	value, ok := m.mockDataVariant[variantId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	return &value, nil
+}
+
+// UpdateProductVariantById
+func (m *ProductRepositoryMock) UpdateProductVariantById(
+	ctx context.Context, client *ent.Client, variantId string, version int64, payload *dto.UpdateProductVariantDto) (*ent.ProductVariant, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.mockDataVariant[variantId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	if u.Version != version {
+		return nil, constants.ErrPreconditionFailed
+	}
+	for key, data := range m.mockDataVariant {
+		if key != variantId && data.ProductID == u.ProductID && data.Sku == *payload.Sku {
+			return nil, constants.ErrBadRequest
+		}
+	}
+	u.Sku = *payload.Sku
+	u.Options = payload.Options
+	u.Price = payload.Price
+	if payload.Quantity != nil {
+		u.Quantity = *payload.Quantity
+	}
+	u.ImgURL = *payload.ImgUrl
+	u.Version++
+	m.mockDataVariant[variantId] = u
+	return &u, nil
+}
+
+// SoftDeleteProductVariantById
+func (m *ProductRepositoryMock) SoftDeleteProductVariantById(
+	ctx context.Context, client *ent.Client, variantId string, version int64) (*ent.ProductVariant, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.mockDataVariant[variantId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	if u.Version != version {
+		return nil, constants.ErrPreconditionFailed
+	}
+	u.IsArchived = true
+	u.Version++
+	m.mockDataVariant[variantId] = u
+	return &u, nil
+}
+
+// LockProductVariantsByProductIds: no row lock in mock, m.mu guards each call
+func (m *ProductRepositoryMock) LockProductVariantsByProductIds(
+	ctx context.Context, client *ent.Client, productIds []string) ([]*ent.ProductVariant, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, productId := range productIds {
+		_, err := uuid.Parse(productId)
+		if err != nil {
+			return nil, constants.ErrBadRequest
+		}
+	}
+	var result []*ent.ProductVariant
+	for _, data := range m.mockDataVariant {
+		if lo.Contains(productIds, data.ProductID.String()) {
+			variant := data
+			result = append(result, &variant)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID.String() < result[j].ID.String()
+	})
+	return result, nil
+}
+
+// AdjustProductVariantQuantityById
+func (m *ProductRepositoryMock) AdjustProductVariantQuantityById(
+	ctx context.Context, client *ent.Client, variantId string, payload *dto.CreateInventoryMovementDto) (*ent.ProductVariant, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	u, ok := m.mockDataVariant[variantId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	if u.Quantity+payload.Delta < 0 {
+		return nil, constants.ErrBadRequest
+	}
+	u.Quantity += payload.Delta
+	m.mockDataVariant[variantId] = u
+
+	movement := *payload
+	movement.VariantId = &variantId
+	_, err := m.createInventoryMovement(u.ProductID.String(), u.Quantity, &movement)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// GetProductsByIds
+func (m *ProductRepositoryMock) GetProductsByIds(
+	ctx context.Context, client *ent.Client, productIds []string) ([]*ent.Product, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := []*ent.Product{}
+	for _, productId := range productIds {
+		value, ok := m.mockData[productId]
+		if ok {
+			result = append(result, &value)
+		}
+	}
+	return result, nil
+}
+
+// GetProductCategoryIdsById
+func (m *ProductRepositoryMock) GetProductCategoryIdsById(
+	ctx context.Context, client *ent.Client, productId string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := append([]string{}, m.mockDataCategory[productId]...)
+	sort.Strings(result)
+	return result, nil
+}
+
+// SetProductCategoriesById
+func (m *ProductRepositoryMock) SetProductCategoriesById(
+	ctx context.Context, client *ent.Client, productId string, categoryIds []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.mockData[productId]
+	if !ok {
+		return constants.ErrNotFound
+	}
+	m.mockDataCategory[productId] = append([]string{}, categoryIds...)
+	return nil
+}
+
+// ****ProductMedia
+
+// GetProductMediaByProductId
+func (m *ProductRepositoryMock) GetProductMediaByProductId(
+	ctx context.Context, client *ent.Client, productId string) ([]*ent.ProductMedia, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := []*ent.ProductMedia{}
+	for _, u := range m.mockDataMedia[productId] {
+		media := u
+		result = append(result, &media)
+	}
+	return result, nil
+}
+
+// SetProductMediaById
+func (m *ProductRepositoryMock) SetProductMediaById(
+	ctx context.Context, client *ent.Client, productId string, payload *dto.SetProductMediaDto) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	p, ok := m.mockData[productId]
+	if !ok {
+		return constants.ErrNotFound
+	}
+	t := time.Now()
+	primary := payload.PrimaryIndex()
+	m.mockDataMedia[productId] = lo.Map(payload.Media, func(item *dto.ProductMediaItem, i int) ent.ProductMedia {
+		return ent.ProductMedia{
+			ID:          uuid.New(),
+			CreatedAt:   t,
+			UpdatedAt:   t,
+			ProductID:   p.ID,
+			ImageinfoID: *item.ImgInfoId,
+			Position:    i,
+			AltText:     lo.FromPtr(item.AltText),
+			IsPrimary:   i == primary,
+		}
+	})
+	return nil
+}
+
+// UpdateProductImgUrlById
+func (m *ProductRepositoryMock) UpdateProductImgUrlById(
+	ctx context.Context, client *ent.Client, productId string, imgUrl string) (*ent.Product, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	p, ok := m.mockData[productId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	p.ImgURL = imgUrl
+	p.Version++
+	p.UpdatedAt = time.Now()
+	m.mockData[productId] = p
+	return &p, nil
+}
+
+// ReplaceImgUrlByUserId
+func (m *ProductRepositoryMock) ReplaceImgUrlByUserId(
+	ctx context.Context, client *ent.Client, userId string, oldImgUrl string, newImgUrl string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t := time.Now()
+	for id, p := range m.mockData {
+		if p.UserID.String() != userId {
+			continue
+		}
+		if p.ImgURL == oldImgUrl {
+			p.ImgURL = newImgUrl
+			p.Version++
+			p.UpdatedAt = t
+			m.mockData[id] = p
+		}
+		for variantId, v := range m.mockDataVariant {
+			if v.ProductID != p.ID || v.ImgURL != oldImgUrl {
+				continue
+			}
+			v.ImgURL = newImgUrl
+			v.Version++
+			v.UpdatedAt = t
+			m.mockDataVariant[variantId] = v
+		}
+	}
+	return nil
+}
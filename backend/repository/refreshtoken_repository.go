@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/ent/refreshtoken"
+	"sthl/storage"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type IRefreshTokenRepository interface {
+	WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error
+	CreateRefreshToken(ctx context.Context, client *ent.Client, userId string, jti string, familyId string, expiresAt time.Time) (*ent.RefreshToken, error)
+	GetRefreshTokenById(ctx context.Context, client *ent.Client, jti string) (*ent.RefreshToken, error)
+	RevokeRefreshTokenById(ctx context.Context, client *ent.Client, jti string, reason string, replacedBy *string) (bool, error)
+	RevokeRefreshTokensByFamilyId(ctx context.Context, client *ent.Client, familyId string, reason string) (int, error)
+	RevokeRefreshTokensByUserId(ctx context.Context, client *ent.Client, userId string, reason string) (int, error)
+}
+
+type RefreshTokenRepository struct {
+	logger *zap.Logger
+}
+
+func NewRefreshTokenRepository(logger *zap.Logger) IRefreshTokenRepository {
+	return &RefreshTokenRepository{
+		logger: logger,
+	}
+}
+func (rtRepo *RefreshTokenRepository) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTx(ctx, rtRepo.logger, client, fn)
+}
+
+// CreateRefreshToken
+func (rtRepo *RefreshTokenRepository) CreateRefreshToken(ctx context.Context, client *ent.Client,
+	userId string, jti string, familyId string, expiresAt time.Time) (*ent.RefreshToken, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		rtRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	jtiUuid, err := uuid.Parse(jti)
+	if err != nil {
+		rtRepo.logger.Info("fail to parse jti to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	familyUuid, err := uuid.Parse(familyId)
+	if err != nil {
+		rtRepo.logger.Info("fail to parse familyId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.RefreshToken.Create().
+		SetID(jtiUuid).
+		SetUserID(userUuid).
+		SetFamilyID(familyUuid).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		rtRepo.logger.Info("fail to client.RefreshToken.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetRefreshTokenById
+func (rtRepo *RefreshTokenRepository) GetRefreshTokenById(ctx context.Context, client *ent.Client, jti string) (*ent.RefreshToken, error) {
+	jtiUuid, err := uuid.Parse(jti)
+	if err != nil {
+		rtRepo.logger.Info("fail to parse jti to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.RefreshToken.Get(ctx, jtiUuid)
+	if err != nil {
+		rtRepo.logger.Info("fail to client.RefreshToken.Get", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// RevokeRefreshTokenById: conditional revoke,
+// return false if token was already revoked by someone else
+func (rtRepo *RefreshTokenRepository) RevokeRefreshTokenById(ctx context.Context, client *ent.Client,
+	jti string, reason string, replacedBy *string) (bool, error) {
+	jtiUuid, err := uuid.Parse(jti)
+	if err != nil {
+		rtRepo.logger.Info("fail to parse jti to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	update := client.RefreshToken.Update().
+		Where(refreshtoken.ID(jtiUuid), refreshtoken.Revoked(false)).
+		SetRevoked(true).
+		SetRevokedAt(time.Now()).
+		SetRevokedReason(reason)
+	if replacedBy != nil {
+		replacedByUuid, err := uuid.Parse(*replacedBy)
+		if err != nil {
+			rtRepo.logger.Info("fail to parse replacedBy to uuid", zap.Error(err))
+			return false, constants.ErrBadRequest
+		}
+		update = update.SetReplacedBy(replacedByUuid)
+	}
+	affected, err := update.Save(ctx)
+	if err != nil {
+		rtRepo.logger.Info("fail to client.RefreshToken.Update", zap.Error(err))
+		return false, handleEntRepoErr(err)
+	}
+	return affected == 1, nil
+}
+
+// RevokeRefreshTokensByFamilyId: revoke all not yet revoked tokens of family
+func (rtRepo *RefreshTokenRepository) RevokeRefreshTokensByFamilyId(ctx context.Context, client *ent.Client,
+	familyId string, reason string) (int, error) {
+	familyUuid, err := uuid.Parse(familyId)
+	if err != nil {
+		rtRepo.logger.Info("fail to parse familyId to uuid", zap.Error(err))
+		return 0, constants.ErrBadRequest
+	}
+
+	affected, err := client.RefreshToken.Update().
+		Where(refreshtoken.FamilyID(familyUuid), refreshtoken.Revoked(false)).
+		SetRevoked(true).
+		SetRevokedAt(time.Now()).
+		SetRevokedReason(reason).
+		Save(ctx)
+	if err != nil {
+		rtRepo.logger.Info("fail to client.RefreshToken.Update", zap.Error(err))
+		return 0, handleEntRepoErr(err)
+	}
+	return affected, nil
+}
+
+// RevokeRefreshTokensByUserId: revoke all not yet revoked tokens of user
+func (rtRepo *RefreshTokenRepository) RevokeRefreshTokensByUserId(ctx context.Context, client *ent.Client,
+	userId string, reason string) (int, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		rtRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return 0, constants.ErrBadRequest
+	}
+
+	affected, err := client.RefreshToken.Update().
+		Where(refreshtoken.UserID(userUuid), refreshtoken.Revoked(false)).
+		SetRevoked(true).
+		SetRevokedAt(time.Now()).
+		SetRevokedReason(reason).
+		Save(ctx)
+	if err != nil {
+		rtRepo.logger.Info("fail to client.RefreshToken.Update", zap.Error(err))
+		return 0, handleEntRepoErr(err)
+	}
+	return affected, nil
+}
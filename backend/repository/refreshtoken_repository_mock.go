@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/storage"
+	"sthl/utils"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RefreshTokenRepositoryMock struct {
+	mockData map[string]ent.RefreshToken
+	mu       sync.Mutex
+}
+
+func NewRefreshTokenRepositoryMock() IRefreshTokenRepository {
+	return &RefreshTokenRepositoryMock{
+		mockData: map[string]ent.RefreshToken{},
+	}
+}
+
+func (m *RefreshTokenRepositoryMock) Lock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+}
+func (m *RefreshTokenRepositoryMock) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTxTest(ctx, nil, client, fn)
+}
+
+// ****
+
+// CreateRefreshToken
+func (m *RefreshTokenRepositoryMock) CreateRefreshToken(ctx context.Context, client *ent.Client,
+	userId string, jti string, familyId string, expiresAt time.Time) (*ent.RefreshToken, error) {
+	m.Lock()
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	jtiUuid, err := uuid.Parse(jti)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	familyUuid, err := uuid.Parse(familyId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	if _, exist := m.mockData[jti]; exist {
+		return nil, constants.ErrExisted
+	}
+	t := time.Now()
+	result := &ent.RefreshToken{
+		ID:        jtiUuid,
+		CreatedAt: t,
+		UpdatedAt: t,
+		UserID:    userUuid,
+		FamilyID:  familyUuid,
+		ExpiresAt: expiresAt,
+	}
+	m.mockData[jti] = *result
+	return result, nil
+}
+
+// GetRefreshTokenById
+func (m *RefreshTokenRepositoryMock) GetRefreshTokenById(ctx context.Context, client *ent.Client, jti string) (*ent.RefreshToken, error) {
+	m.Lock()
+	value := m.mockData[jti]
+	if utils.IsEmpty(value) {
+		return nil, constants.ErrNotFound
+	}
+	return &value, nil
+}
+
+// RevokeRefreshTokenById
+func (m *RefreshTokenRepositoryMock) RevokeRefreshTokenById(ctx context.Context, client *ent.Client,
+	jti string, reason string, replacedBy *string) (bool, error) {
+	m.Lock()
+	value, exist := m.mockData[jti]
+	if !exist || value.Revoked {
+		return false, nil
+	}
+	value.Revoked = true
+	value.RevokedAt = utils.PtrOf(time.Now())
+	value.RevokedReason = reason
+	if replacedBy != nil {
+		replacedByUuid, err := uuid.Parse(*replacedBy)
+		if err != nil {
+			return false, constants.ErrBadRequest
+		}
+		value.ReplacedBy = &replacedByUuid
+	}
+	m.mockData[jti] = value
+	return true, nil
+}
+
+// RevokeRefreshTokensByFamilyId
+func (m *RefreshTokenRepositoryMock) RevokeRefreshTokensByFamilyId(ctx context.Context, client *ent.Client,
+	familyId string, reason string) (int, error) {
+	m.Lock()
+	count := 0
+	for key, data := range m.mockData {
+		if data.FamilyID.String() == familyId && !data.Revoked {
+			data.Revoked = true
+			data.RevokedAt = utils.PtrOf(time.Now())
+			data.RevokedReason = reason
+			m.mockData[key] = data
+			count++
+		}
+	}
+	return count, nil
+}
+
+// RevokeRefreshTokensByUserId
+func (m *RefreshTokenRepositoryMock) RevokeRefreshTokensByUserId(ctx context.Context, client *ent.Client,
+	userId string, reason string) (int, error) {
+	m.Lock()
+	count := 0
+	for key, data := range m.mockData {
+		if data.UserID.String() == userId && !data.Revoked {
+			data.Revoked = true
+			data.RevokedAt = utils.PtrOf(time.Now())
+			data.RevokedReason = reason
+			m.mockData[key] = data
+			count++
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,315 @@
+package repository
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/ent/shop"
+	"sthl/ent/shopinvite"
+	"sthl/ent/shopmember"
+	"sthl/storage"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type IShopRepository interface {
+	WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error
+	CreateShop(ctx context.Context, client *ent.Client, userId string, name string) (*ent.Shop, error)
+	GetShopById(ctx context.Context, client *ent.Client, shopId string) (*ent.Shop, error)
+	GetShopByUserId(ctx context.Context, client *ent.Client, userId string) (*ent.Shop, error)
+	UpdateShopPricingById(ctx context.Context, client *ent.Client, shopId string, payload *dto.UpdateShopPricingDto) (*ent.Shop, error)
+	UpsertShopMember(ctx context.Context, client *ent.Client, shopId string, userId string, role string) (*ent.ShopMember, error)
+	GetShopMember(ctx context.Context, client *ent.Client, shopId string, userId string) (*ent.ShopMember, error)
+	GetShopMembersByShopId(ctx context.Context, client *ent.Client, shopId string) ([]*ent.ShopMember, error)
+	GetShopMembershipsByUserId(ctx context.Context, client *ent.Client, userId string) ([]*ent.ShopMember, error)
+	DeleteShopMember(ctx context.Context, client *ent.Client, shopId string, userId string) (bool, error)
+	CreateShopInvite(ctx context.Context, client *ent.Client,
+		shopId string, invitedBy string, email string, role string, tokenHash string, expiresAt time.Time) (*ent.ShopInvite, error)
+	GetShopInviteByHash(ctx context.Context, client *ent.Client, tokenHash string) (*ent.ShopInvite, error)
+	AcceptShopInviteById(ctx context.Context, client *ent.Client, inviteId string) (bool, error)
+}
+
+type ShopRepository struct {
+	logger *zap.Logger
+}
+
+func NewShopRepository(logger *zap.Logger) IShopRepository {
+	return &ShopRepository{
+		logger: logger,
+	}
+}
+func (shopRepo *ShopRepository) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTx(ctx, shopRepo.logger, client, fn)
+}
+
+// CreateShop
+func (shopRepo *ShopRepository) CreateShop(ctx context.Context, client *ent.Client,
+	userId string, name string) (*ent.Shop, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.Shop.Create().
+		SetUserID(userUuid).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		shopRepo.logger.Info("fail to client.Shop.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetShopById
+func (shopRepo *ShopRepository) GetShopById(ctx context.Context, client *ent.Client, shopId string) (*ent.Shop, error) {
+	shopUuid, err := uuid.Parse(shopId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse shopId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.Shop.Get(ctx, shopUuid)
+	if err != nil {
+		shopRepo.logger.Info("fail to client.Shop.Get", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetShopByUserId: shop owned by user
+func (shopRepo *ShopRepository) GetShopByUserId(ctx context.Context, client *ent.Client, userId string) (*ent.Shop, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.Shop.Query().
+		Where(shop.UserID(userUuid)).
+		Only(ctx)
+	if err != nil {
+		shopRepo.logger.Info("fail to client.Shop.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// UpdateShopPricingById
+func (shopRepo *ShopRepository) UpdateShopPricingById(ctx context.Context, client *ent.Client,
+	shopId string, payload *dto.UpdateShopPricingDto) (*ent.Shop, error) {
+	shopUuid, err := uuid.Parse(shopId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse shopId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.Shop.UpdateOneID(shopUuid).
+		SetTaxRate(*payload.TaxRate).
+		SetShippingFee(*payload.ShippingFee).
+		Save(ctx)
+	if err != nil {
+		shopRepo.logger.Info("fail to client.Shop.UpdateOneID", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// UpsertShopMember: existing member gets the new role
+func (shopRepo *ShopRepository) UpsertShopMember(ctx context.Context, client *ent.Client,
+	shopId string, userId string, role string) (*ent.ShopMember, error) {
+	shopUuid, err := uuid.Parse(shopId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse shopId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	err = client.ShopMember.Create().
+		SetShopID(shopUuid).
+		SetUserID(userUuid).
+		SetRole(role).
+		OnConflict(sql.ConflictColumns(shopmember.FieldShopID, shopmember.FieldUserID)).
+		Update(func(u *ent.ShopMemberUpsert) {
+			u.UpdateRole()
+			u.UpdateUpdatedAt()
+		}).
+		Exec(ctx)
+	if err != nil {
+		shopRepo.logger.Info("fail to client.ShopMember.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return shopRepo.GetShopMember(ctx, client, shopId, userId)
+}
+
+// GetShopMember
+func (shopRepo *ShopRepository) GetShopMember(ctx context.Context, client *ent.Client,
+	shopId string, userId string) (*ent.ShopMember, error) {
+	shopUuid, err := uuid.Parse(shopId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse shopId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.ShopMember.Query().
+		Where(
+			shopmember.ShopID(shopUuid),
+			shopmember.UserID(userUuid),
+		).
+		Only(ctx)
+	if err != nil {
+		shopRepo.logger.Info("fail to client.ShopMember.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetShopMembersByShopId
+func (shopRepo *ShopRepository) GetShopMembersByShopId(ctx context.Context, client *ent.Client,
+	shopId string) ([]*ent.ShopMember, error) {
+	shopUuid, err := uuid.Parse(shopId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse shopId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.ShopMember.Query().
+		Where(shopmember.ShopID(shopUuid)).
+		Order(ent.Asc(shopmember.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		shopRepo.logger.Info("fail to client.ShopMember.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetShopMembershipsByUserId: memberships of user with shop loaded
+func (shopRepo *ShopRepository) GetShopMembershipsByUserId(ctx context.Context, client *ent.Client,
+	userId string) ([]*ent.ShopMember, error) {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.ShopMember.Query().
+		Where(shopmember.UserID(userUuid)).
+		WithShop().
+		Order(ent.Asc(shopmember.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		shopRepo.logger.Info("fail to client.ShopMember.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// DeleteShopMember: return false if user is not a member
+func (shopRepo *ShopRepository) DeleteShopMember(ctx context.Context, client *ent.Client,
+	shopId string, userId string) (bool, error) {
+	shopUuid, err := uuid.Parse(shopId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse shopId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	affected, err := client.ShopMember.Delete().
+		Where(
+			shopmember.ShopID(shopUuid),
+			shopmember.UserID(userUuid),
+		).
+		Exec(ctx)
+	if err != nil {
+		shopRepo.logger.Info("fail to client.ShopMember.Delete", zap.Error(err))
+		return false, handleEntRepoErr(err)
+	}
+	return affected == 1, nil
+}
+
+// CreateShopInvite
+func (shopRepo *ShopRepository) CreateShopInvite(ctx context.Context, client *ent.Client,
+	shopId string, invitedBy string, email string, role string, tokenHash string, expiresAt time.Time) (*ent.ShopInvite, error) {
+	shopUuid, err := uuid.Parse(shopId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse shopId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	invitedByUuid, err := uuid.Parse(invitedBy)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse invitedBy to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	result, err := client.ShopInvite.Create().
+		SetShopID(shopUuid).
+		SetInvitedBy(invitedByUuid).
+		SetEmail(email).
+		SetRole(role).
+		SetTokenHash(tokenHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		shopRepo.logger.Info("fail to client.ShopInvite.Create", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// GetShopInviteByHash
+func (shopRepo *ShopRepository) GetShopInviteByHash(ctx context.Context, client *ent.Client,
+	tokenHash string) (*ent.ShopInvite, error) {
+	result, err := client.ShopInvite.Query().
+		Where(shopinvite.TokenHash(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		shopRepo.logger.Info("fail to client.ShopInvite.Query", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// AcceptShopInviteById: conditional accept,
+// return false if invite was already accepted or expired
+func (shopRepo *ShopRepository) AcceptShopInviteById(ctx context.Context, client *ent.Client,
+	inviteId string) (bool, error) {
+	inviteUuid, err := uuid.Parse(inviteId)
+	if err != nil {
+		shopRepo.logger.Info("fail to parse inviteId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	now := time.Now()
+	affected, err := client.ShopInvite.Update().
+		Where(
+			shopinvite.ID(inviteUuid),
+			shopinvite.AcceptedAtIsNil(),
+			shopinvite.ExpiresAtGT(now),
+		).
+		SetAcceptedAt(now).
+		Save(ctx)
+	if err != nil {
+		shopRepo.logger.Info("fail to client.ShopInvite.Update", zap.Error(err))
+		return false, handleEntRepoErr(err)
+	}
+	return affected == 1, nil
+}
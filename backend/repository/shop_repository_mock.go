@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/storage"
+	"sthl/utils"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ShopRepositoryMock struct {
+	shopData   map[string]ent.Shop
+	memberData map[string]ent.ShopMember
+	inviteData map[string]ent.ShopInvite
+	mu         sync.Mutex
+}
+
+func NewShopRepositoryMock() IShopRepository {
+	return &ShopRepositoryMock{
+		shopData:   map[string]ent.Shop{},
+		memberData: map[string]ent.ShopMember{},
+		inviteData: map[string]ent.ShopInvite{},
+	}
+}
+
+func (m *ShopRepositoryMock) Lock() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+}
+func (m *ShopRepositoryMock) WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	return storage.WithTxTest(ctx, nil, client, fn)
+}
+
+// ****
+
+// CreateShop
+func (m *ShopRepositoryMock) CreateShop(ctx context.Context, client *ent.Client,
+	userId string, name string) (*ent.Shop, error) {
+	m.Lock()
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	for _, data := range m.shopData {
+		if data.UserID == userUuid {
+			return nil, constants.ErrBadRequest
+		}
+	}
+	t := time.Now()
+	result := &ent.Shop{
+		ID:        uuid.New(),
+		CreatedAt: t,
+		UpdatedAt: t,
+		UserID:    userUuid,
+		Name:      name,
+	}
+	m.shopData[result.ID.String()] = *result
+	return result, nil
+}
+
+// GetShopById
+func (m *ShopRepositoryMock) GetShopById(ctx context.Context, client *ent.Client, shopId string) (*ent.Shop, error) {
+	m.Lock()
+	_, err := uuid.Parse(shopId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	value, exist := m.shopData[shopId]
+	if !exist {
+		return nil, constants.ErrNotFound
+	}
+	return &value, nil
+}
+
+// GetShopByUserId
+func (m *ShopRepositoryMock) GetShopByUserId(ctx context.Context, client *ent.Client, userId string) (*ent.Shop, error) {
+	m.Lock()
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	for _, data := range m.shopData {
+		if data.UserID.String() == userId {
+			return &data, nil
+		}
+	}
+	return nil, constants.ErrNotFound
+}
+
+// UpdateShopPricingById
+func (m *ShopRepositoryMock) UpdateShopPricingById(ctx context.Context, client *ent.Client,
+	shopId string, payload *dto.UpdateShopPricingDto) (*ent.Shop, error) {
+	m.Lock()
+	value, exist := m.shopData[shopId]
+	if !exist {
+		return nil, constants.ErrNotFound
+	}
+	value.TaxRate = *payload.TaxRate
+	value.ShippingFee = *payload.ShippingFee
+	m.shopData[shopId] = value
+	return &value, nil
+}
+
+// UpsertShopMember
+func (m *ShopRepositoryMock) UpsertShopMember(ctx context.Context, client *ent.Client,
+	shopId string, userId string, role string) (*ent.ShopMember, error) {
+	m.Lock()
+	shopUuid, err := uuid.Parse(shopId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	if _, exist := m.shopData[shopId]; !exist {
+		return nil, constants.ErrBadRequest
+	}
+	t := time.Now()
+	for key, data := range m.memberData {
+		if data.ShopID == shopUuid && data.UserID == userUuid {
+			data.Role = role
+			data.UpdatedAt = t
+			m.memberData[key] = data
+			return &data, nil
+		}
+	}
+	result := &ent.ShopMember{
+		ID:        uuid.New(),
+		CreatedAt: t,
+		UpdatedAt: t,
+		ShopID:    shopUuid,
+		UserID:    userUuid,
+		Role:      role,
+	}
+	m.memberData[result.ID.String()] = *result
+	return result, nil
+}
+
+// GetShopMember
+func (m *ShopRepositoryMock) GetShopMember(ctx context.Context, client *ent.Client,
+	shopId string, userId string) (*ent.ShopMember, error) {
+	m.Lock()
+	for _, data := range m.memberData {
+		if data.ShopID.String() == shopId && data.UserID.String() == userId {
+			return &data, nil
+		}
+	}
+	return nil, constants.ErrNotFound
+}
+
+// GetShopMembersByShopId
+func (m *ShopRepositoryMock) GetShopMembersByShopId(ctx context.Context, client *ent.Client,
+	shopId string) ([]*ent.ShopMember, error) {
+	m.Lock()
+	result := []*ent.ShopMember{}
+	for _, data := range m.memberData {
+		if data.ShopID.String() == shopId {
+			d := data
+			result = append(result, &d)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+// GetShopMembershipsByUserId
+func (m *ShopRepositoryMock) GetShopMembershipsByUserId(ctx context.Context, client *ent.Client,
+	userId string) ([]*ent.ShopMember, error) {
+	m.Lock()
+	result := []*ent.ShopMember{}
+	for _, data := range m.memberData {
+		if data.UserID.String() == userId {
+			d := data
+			if s, exist := m.shopData[d.ShopID.String()]; exist {
+				d.Edges.Shop = &s
+			}
+			result = append(result, &d)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+// DeleteShopMember
+func (m *ShopRepositoryMock) DeleteShopMember(ctx context.Context, client *ent.Client,
+	shopId string, userId string) (bool, error) {
+	m.Lock()
+	for key, data := range m.memberData {
+		if data.ShopID.String() == shopId && data.UserID.String() == userId {
+			delete(m.memberData, key)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// CreateShopInvite
+func (m *ShopRepositoryMock) CreateShopInvite(ctx context.Context, client *ent.Client,
+	shopId string, invitedBy string, email string, role string, tokenHash string, expiresAt time.Time) (*ent.ShopInvite, error) {
+	m.Lock()
+	shopUuid, err := uuid.Parse(shopId)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	invitedByUuid, err := uuid.Parse(invitedBy)
+	if err != nil {
+		return nil, constants.ErrBadRequest
+	}
+	for _, data := range m.inviteData {
+		if data.TokenHash == tokenHash {
+			return nil, constants.ErrBadRequest
+		}
+	}
+	t := time.Now()
+	result := &ent.ShopInvite{
+		ID:        uuid.New(),
+		CreatedAt: t,
+		UpdatedAt: t,
+		ShopID:    shopUuid,
+		InvitedBy: invitedByUuid,
+		Email:     email,
+		Role:      role,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+	}
+	m.inviteData[result.ID.String()] = *result
+	return result, nil
+}
+
+// GetShopInviteByHash
+func (m *ShopRepositoryMock) GetShopInviteByHash(ctx context.Context, client *ent.Client,
+	tokenHash string) (*ent.ShopInvite, error) {
+	m.Lock()
+	for _, data := range m.inviteData {
+		if data.TokenHash == tokenHash {
+			return &data, nil
+		}
+	}
+	return nil, constants.ErrNotFound
+}
+
+// AcceptShopInviteById
+func (m *ShopRepositoryMock) AcceptShopInviteById(ctx context.Context, client *ent.Client,
+	inviteId string) (bool, error) {
+	m.Lock()
+	now := time.Now()
+	value, exist := m.inviteData[inviteId]
+	if !exist || value.AcceptedAt != nil || !value.ExpiresAt.After(now) {
+		return false, nil
+	}
+	value.AcceptedAt = utils.PtrOf(now)
+	m.inviteData[inviteId] = value
+	return true, nil
+}
@@ -8,7 +8,6 @@ import (
 	"sthl/ent/siteui"
 	"sthl/storage"
 
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
@@ -16,7 +15,8 @@ import (
 type ISiteUiRepository interface {
 	WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error
 	GetSiteUiByUserId(ctx context.Context, client *ent.Client, userId string) (*ent.Siteui, error)
-	UpsertSiteUiByUserId(ctx context.Context, client *ent.Client, userId string, payload *dto.UpsertSiteUiDto) (bool, error)
+	UpsertSiteUiByUserId(ctx context.Context, client *ent.Client, userId string, version int64, payload *dto.UpsertSiteUiDto) (bool, error)
+	ReplaceHomepageImgUrlByUserId(ctx context.Context, client *ent.Client, userId string, oldImgUrl string, newImgUrl string) error
 }
 
 type SiteUiRepository struct {
@@ -52,8 +52,10 @@ func (siteuiRepo *SiteUiRepository) GetSiteUiByUserId(
 	return result, nil
 }
 
+// UpsertSiteUiByUserId: version 0 creates only, otherwise conditional update on version,
+// ErrPreconditionFailed if siteui already exists or version changed
 func (siteuiRepo *SiteUiRepository) UpsertSiteUiByUserId(
-	ctx context.Context, client *ent.Client, userId string,
+	ctx context.Context, client *ent.Client, userId string, version int64,
 	payload *dto.UpsertSiteUiDto,
 ) (bool, error) {
 
@@ -63,20 +65,69 @@ func (siteuiRepo *SiteUiRepository) UpsertSiteUiByUserId(
 		return false, constants.ErrBadRequest
 	}
 
-	err = client.Siteui.Create().
-		SetUserID(userUuid).
+	if version == 0 {
+		err = client.Siteui.Create().
+			SetUserID(userUuid).
+			SetSitename(*payload.Sitename).
+			SetHomepageImgUrl(*payload.HomepageImgUrl).
+			SetHomepageText(*payload.HomepageText).
+			SetHomepageTextColor(*payload.HomepageTextColor).
+			Exec(ctx)
+		if ent.IsConstraintError(err) {
+			siteuiRepo.logger.Info("siteui already exists", zap.Error(err))
+			return false, constants.ErrPreconditionFailed
+		}
+		if err != nil {
+			siteuiRepo.logger.Info("fail to client.Siteui.Create()", zap.Error(err))
+			return false, handleEntRepoErr(err)
+		}
+		return true, nil
+	}
+
+	affected, err := client.Siteui.Update().
+		Where(siteui.UserID(userUuid), siteui.Version(version)).
 		SetSitename(*payload.Sitename).
 		SetHomepageImgUrl(*payload.HomepageImgUrl).
 		SetHomepageText(*payload.HomepageText).
 		SetHomepageTextColor(*payload.HomepageTextColor).
-		OnConflict(
-			sql.ConflictColumns(siteui.FieldUserID),
-		).
-		UpdateNewValues().
-		Exec(ctx)
+		AddVersion(1).
+		Save(ctx)
 	if err != nil {
-		siteuiRepo.logger.Info("fail to client.Siteui.Query()", zap.Error(err))
+		siteuiRepo.logger.Info("fail to client.Siteui.Update()", zap.Error(err))
 		return false, handleEntRepoErr(err)
 	}
+	if affected == 0 {
+		exist, err := client.Siteui.Query().Where(siteui.UserID(userUuid)).Exist(ctx)
+		if err != nil {
+			siteuiRepo.logger.Info("fail to client.Siteui.Query()", zap.Error(err))
+			return false, handleEntRepoErr(err)
+		}
+		if exist {
+			return false, constants.ErrPreconditionFailed
+		}
+		return false, constants.ErrNotFound
+	}
 	return true, nil
 }
+
+// ReplaceHomepageImgUrlByUserId: homepage image of user repointed if it shows old image url, version bumped
+func (siteuiRepo *SiteUiRepository) ReplaceHomepageImgUrlByUserId(
+	ctx context.Context, client *ent.Client, userId string, oldImgUrl string, newImgUrl string) error {
+
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		siteuiRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+
+	_, err = client.Siteui.Update().
+		Where(siteui.UserID(userUuid), siteui.HomepageImgUrl(oldImgUrl)).
+		SetHomepageImgUrl(newImgUrl).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		siteuiRepo.logger.Info("fail to client.Siteui.Update()", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"sthl/ent/user"
 	"sthl/storage"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
@@ -19,6 +20,9 @@ type IUserRepository interface {
 	GetUserById(ctx context.Context, client *ent.Client, userId string) (*ent.User, error)
 	GetUserByEmail(ctx context.Context, client *ent.Client, email string) (*ent.User, error)
 	UpdateUserPasswordById(ctx context.Context, client *ent.Client, userId string, hashedPw string) (*ent.User, error)
+	UpdateUserEmailVerifiedById(ctx context.Context, client *ent.Client, userId string, verified bool) (*ent.User, error)
+	UpdateUserPlanById(ctx context.Context, client *ent.Client, userId string, plan string) (*ent.User, error)
+	LockUserById(ctx context.Context, client *ent.Client, userId string) error
 	// for test
 	GetUsers(ctx context.Context, client *ent.Client, payload *dto.QueryUsersDto) (*dto.QueryUsersResponseDto, error)
 }
@@ -108,6 +112,66 @@ func (userRepo *UserRepository) UpdateUserPasswordById(ctx context.Context, clie
 	return result, nil
 }
 
+// UpdateUserEmailVerifiedById
+func (userRepo *UserRepository) UpdateUserEmailVerifiedById(ctx context.Context, client *ent.Client, userId string, verified bool) (*ent.User, error) {
+	// parse userId to uuid
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		userRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call ent client to updateOneID
+	result, err := client.User.UpdateOneID(userUuid).
+		SetEmailVerified(verified).
+		Save(ctx)
+	if err != nil {
+		userRepo.logger.Info("fail to client.User.UpdateOneID", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// UpdateUserPlanById
+func (userRepo *UserRepository) UpdateUserPlanById(ctx context.Context, client *ent.Client, userId string, plan string) (*ent.User, error) {
+	// parse userId to uuid
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		userRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call ent client to updateOneID
+	result, err := client.User.UpdateOneID(userUuid).
+		SetPlan(plan).
+		Save(ctx)
+	if err != nil {
+		userRepo.logger.Info("fail to client.User.UpdateOneID", zap.Error(err))
+		return nil, handleEntRepoErr(err)
+	}
+	return result, nil
+}
+
+// LockUserById: select for no key update, serializes quota checks of owner, user not found locks nothing,
+// must be called with a tx client. no key update still lets other txs insert rows referencing user,
+// their foreign key check takes key share, which for update would block until this tx ends
+func (userRepo *UserRepository) LockUserById(ctx context.Context, client *ent.Client, userId string) error {
+	userUuid, err := uuid.Parse(userId)
+	if err != nil {
+		userRepo.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+
+	_, err = client.User.Query().Where(user.ID(userUuid)).
+		ForUpdate(func(o *sql.LockOptions) { o.Strength = sql.LockNoKeyUpdate }).
+		IDs(ctx)
+	if err != nil {
+		userRepo.logger.Info("fail to client.User.Query", zap.Error(err))
+		return handleEntRepoErr(err)
+	}
+	return nil
+}
+
 // GetUsers
 func (userRepo *UserRepository) GetUsers(ctx context.Context, client *ent.Client, payload *dto.QueryUsersDto) (*dto.QueryUsersResponseDto, error) {
 	page := payload.Page
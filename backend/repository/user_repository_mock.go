@@ -34,6 +34,7 @@ func newUserSchemaMock(payload *dto.CreateUserMappedDto) *ent.User {
 		Email:         *payload.Email,
 		HashedPw:      *payload.HashedPw,
 		EmailVerified: false,
+		Plan:          constants.Plan.Free,
 	}
 }
 
@@ -96,6 +97,43 @@ func (m *UserRepositoryMock) UpdateUserPasswordById(ctx context.Context, client
 	return nil, constants.ErrNotFound
 }
 
+// UpdateUserEmailVerifiedById
+func (m *UserRepositoryMock) UpdateUserEmailVerifiedById(ctx context.Context, client *ent.Client, userId string, verified bool) (*ent.User, error) {
+	m.Lock()
+	for key, data := range m.mockData {
+		if key == userId {
+			u := data
+			u.EmailVerified = verified
+			m.mockData[key] = u
+			return &u, nil
+		}
+	}
+	return nil, constants.ErrNotFound
+}
+
+// UpdateUserPlanById
+func (m *UserRepositoryMock) UpdateUserPlanById(ctx context.Context, client *ent.Client, userId string, plan string) (*ent.User, error) {
+	m.Lock()
+	for key, data := range m.mockData {
+		if key == userId {
+			u := data
+			u.Plan = plan
+			m.mockData[key] = u
+			return &u, nil
+		}
+	}
+	return nil, constants.ErrNotFound
+}
+
+// LockUserById
+func (m *UserRepositoryMock) LockUserById(ctx context.Context, client *ent.Client, userId string) error {
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		return constants.ErrBadRequest
+	}
+	return nil
+}
+
 // for test
 
 // GetUsers
@@ -0,0 +1,16 @@
+package repository
+
+import "github.com/google/uuid"
+
+// parseUuids: parse ids in order, fail on first invalid id
+func parseUuids(ids []string) ([]uuid.UUID, error) {
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, parsed)
+	}
+	return result, nil
+}
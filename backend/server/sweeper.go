@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/service"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// ReservationSweeper: release expired stock reservations in background
+type ReservationSweeper struct {
+	logger   *zap.Logger
+	orderSvc service.IOrderService
+	interval time.Duration
+}
+
+func NewReservationSweeper(lc fx.Lifecycle, lg *zap.Logger, orderSvc service.IOrderService) *ReservationSweeper {
+	sweeper := &ReservationSweeper{
+		logger:   lg,
+		orderSvc: orderSvc,
+		interval: constants.StockReservationSweepInterval,
+	}
+	runInBackground(lc, lg, "reservation sweeper", sweeper.interval, sweeper.Sweep)
+	return sweeper
+}
+
+// Sweep: release one batch of expired reservations
+func (s *ReservationSweeper) Sweep(ctx context.Context) {
+	released, err := s.orderSvc.ReleaseExpiredReservations(ctx)
+	if err != nil {
+		s.logger.Info("fail to orderSvc.ReleaseExpiredReservations", zap.Error(err))
+		return
+	}
+	if released > 0 {
+		s.logger.Info("released expired reservations", zap.Int("released", released))
+	}
+}
+
+// IdempotencyKeySweeper: purge idempotency keys past retention window in background
+type IdempotencyKeySweeper struct {
+	logger         *zap.Logger
+	idempotencySvc service.IIdempotencyService
+	interval       time.Duration
+}
+
+func NewIdempotencyKeySweeper(lc fx.Lifecycle, lg *zap.Logger, idempotencySvc service.IIdempotencyService) *IdempotencyKeySweeper {
+	sweeper := &IdempotencyKeySweeper{
+		logger:         lg,
+		idempotencySvc: idempotencySvc,
+		interval:       constants.IdempotencyKeySweepInterval,
+	}
+	runInBackground(lc, lg, "idempotency key sweeper", sweeper.interval, sweeper.Sweep)
+	return sweeper
+}
+
+// Sweep: delete expired idempotency keys
+func (s *IdempotencyKeySweeper) Sweep(ctx context.Context) {
+	deleted, err := s.idempotencySvc.DeleteExpiredIdempotentRequests(ctx)
+	if err != nil {
+		s.logger.Info("fail to idempotencySvc.DeleteExpiredIdempotentRequests", zap.Error(err))
+		return
+	}
+	if deleted > 0 {
+		s.logger.Info("deleted expired idempotency keys", zap.Int("deleted", deleted))
+	}
+}
+
+// runInBackground: call sweep every interval from app start until app stop
+func runInBackground(lc fx.Lifecycle, lg *zap.Logger, name string, interval time.Duration, sweep func(context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			lg.Info("starting "+name, zap.Duration("interval", interval))
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(interval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						sweep(ctx)
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
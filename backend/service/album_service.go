@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"errors"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"sthl/constants"
 	"sthl/dto"
@@ -11,11 +13,9 @@ import (
 	"sthl/storage"
 	"strconv"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
 
@@ -28,17 +28,27 @@ type IAlbumService interface {
 type AlbumService struct {
 	logger      *zap.Logger
 	entClient   *ent.Client
-	s3Client    *storage.S3Client
+	blobStore   storage.BlobStore
+	userRepo    repository.IUserRepository
 	imginfoRepo repository.IImgInfoRepository
+	shopRepo    repository.IShopRepository
+	productRepo repository.IProductRepository
+	siteuiRepo  repository.ISiteUiRepository
 }
 
 func NewAlbumService(logger *zap.Logger, entClient *ent.Client,
-	s3Client *storage.S3Client, imginfoRepo repository.IImgInfoRepository) IAlbumService {
+	blobStore storage.BlobStore, userRepo repository.IUserRepository,
+	imginfoRepo repository.IImgInfoRepository, shopRepo repository.IShopRepository,
+	productRepo repository.IProductRepository, siteuiRepo repository.ISiteUiRepository) IAlbumService {
 	return &AlbumService{
 		logger:      logger,
 		entClient:   entClient,
-		s3Client:    s3Client,
+		blobStore:   blobStore,
+		userRepo:    userRepo,
 		imginfoRepo: imginfoRepo,
+		shopRepo:    shopRepo,
+		productRepo: productRepo,
+		siteuiRepo:  siteuiRepo,
 	}
 }
 
@@ -54,7 +64,14 @@ func (gallerySvc *AlbumService) UploadFile(r *http.Request) (*ent.Imageinfo, err
 		return nil, constants.ErrInternalServer
 	}
 
-	err := r.ParseMultipartForm(constants.MaxFileSize)
+	// check permission in active shop, album belongs to shop owner
+	ownerId, err := authorizeShop(ctx, gallerySvc.logger, gallerySvc.entClient, gallerySvc.shopRepo,
+		authenticatedUserInfo, constants.ShopPermission.AlbumWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.ParseMultipartForm(constants.MaxFileSize)
 	if err != nil {
 		gallerySvc.logger.Info("ParseMultipartForm exceeded", zap.Error(err))
 		return nil, constants.ErrBadRequest
@@ -71,7 +88,7 @@ func (gallerySvc *AlbumService) UploadFile(r *http.Request) (*ent.Imageinfo, err
 	if header.Size > constants.MaxFileSize {
 		return nil, constants.ErrBadRequest
 	}
-	nameExist, err := gallerySvc.imginfoRepo.CheckImgNameExist(ctx, gallerySvc.entClient, authenticatedUserInfo, header.Filename)
+	nameExist, err := gallerySvc.imginfoRepo.CheckImgNameExist(ctx, gallerySvc.entClient, ownerId, header.Filename)
 	if err != nil {
 		return nil, err
 	}
@@ -80,29 +97,35 @@ func (gallerySvc *AlbumService) UploadFile(r *http.Request) (*ent.Imageinfo, err
 		return nil, constants.ErrBadRequest
 	}
 
-	// s3 upload
-	idKey := authenticatedUserInfo + "/" + uuid.NewString()
-	uploadInput := &s3manager.UploadInput{
-		Bucket:      aws.String(constants.S3BucketName),
-		Key:         aws.String(idKey),
-		ACL:         aws.String(s3.ObjectCannedACLPublicRead),
-		ContentType: aws.String(header.Header.Get("content-type")),
-		Body:        file,
-	}
-	s3result, err := gallerySvc.s3Client.GetUploader().Upload(uploadInput)
+	contentType, err := gallerySvc.sniffAlbumImg(file)
 	if err != nil {
 		return nil, err
 	}
 
 	// create with transaction
+	idKey := ownerId + "/" + uuid.NewString()
+	uploaded := false
 	var result *ent.Imageinfo
 	txFunc := func(tx *ent.Tx) error {
 		// extract tx client as ent.cient
 		txc := tx.Client()
 
+		// check images and storage of owner within plan, held until image created
+		err := gallerySvc.ensureAlbumQuota(ctx, txc, ownerId, 1, header.Size)
+		if err != nil {
+			return err
+		}
+
+		// blob upload, album images are public
+		imgUrl, err := gallerySvc.blobStore.Put(ctx, idKey, file, contentType, true)
+		if err != nil {
+			return err
+		}
+		uploaded = true
+
 		// call repo to CreateImg
-		data := dto.NewCreateImgDto(&header.Filename, &s3result.Location, &header.Size, &idKey)
-		createResult, err := gallerySvc.imginfoRepo.CreateImg(ctx, txc, authenticatedUserInfo, data)
+		data := dto.NewCreateImgDto(&header.Filename, &imgUrl, &header.Size, &idKey)
+		createResult, err := gallerySvc.imginfoRepo.CreateImg(ctx, txc, ownerId, data)
 		result = createResult
 		if err != nil {
 			return err
@@ -111,12 +134,67 @@ func (gallerySvc *AlbumService) UploadFile(r *http.Request) (*ent.Imageinfo, err
 	}
 
 	err = gallerySvc.imginfoRepo.WithTx(ctx, gallerySvc.entClient, txFunc)
+	if err != nil && uploaded {
+		// uploaded object without imginfo is orphan
+		delErr := gallerySvc.blobStore.Delete(ctx, idKey)
+		if delErr != nil {
+			gallerySvc.logger.Info("fail to blobStore.Delete", zap.String("key", idKey), zap.Error(delErr))
+		}
+	}
 	if err != nil {
 		return result, err
 	}
 	return result, nil
 }
 
+// sniffAlbumImg: content type detected from file, client content type ignored, file rewound for upload
+func (gallerySvc *AlbumService) sniffAlbumImg(file multipart.File) (string, error) {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		gallerySvc.logger.Info("fail to read file", zap.Error(err))
+		return "", constants.ErrBadRequest
+	}
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		gallerySvc.logger.Info("fail to file.Seek", zap.Error(err))
+		return "", constants.ErrInternalServer
+	}
+	contentType := http.DetectContentType(head[:n])
+	if !lo.Contains(constants.AlbumImgContentTypes, contentType) {
+		gallerySvc.logger.Info("file is not allowed image", zap.String("contentType", contentType))
+		return "", constants.ErrBadRequest
+	}
+	return contentType, nil
+}
+
+// ensureAlbumQuota: images and storage bytes of owner after adding within limit of plan,
+// owner locked so concurrent uploads of shop counted in turn, must be called with a tx client
+func (gallerySvc *AlbumService) ensureAlbumQuota(ctx context.Context, client *ent.Client,
+	ownerId string, addingImgs int, addingBytes int64) error {
+	err := gallerySvc.userRepo.LockUserById(ctx, client, ownerId)
+	if err != nil {
+		return err
+	}
+	limit, err := ownerPlanLimit(ctx, gallerySvc.logger, client, gallerySvc.userRepo, ownerId)
+	if err != nil {
+		return err
+	}
+	total, err := gallerySvc.imginfoRepo.GetImgsTotalByUserId(ctx, client, ownerId)
+	if err != nil {
+		return err
+	}
+	err = ensureQuota(gallerySvc.logger, ownerId, "album images", int64(total), int64(addingImgs), int64(limit.AlbumImgs))
+	if err != nil {
+		return err
+	}
+	size, err := gallerySvc.imginfoRepo.GetImgsSizeByUserId(ctx, client, ownerId)
+	if err != nil {
+		return err
+	}
+	return ensureQuota(gallerySvc.logger, ownerId, "storage bytes", size, addingBytes, limit.StorageBytes)
+}
+
 // GetImgsByUserId
 func (gallerySvc *AlbumService) GetImgsByUserId(ctx context.Context, userId string, payload *dto.QueryImgsInfoDto) (*dto.QueryImgsInfoResponseDto, error) {
 	// validate
@@ -126,11 +204,18 @@ func (gallerySvc *AlbumService) GetImgsByUserId(ctx context.Context, userId stri
 		return nil, constants.ErrBadRequest
 	}
 
+	// check permission in active shop
+	ownerId, err := authorizeShop(ctx, gallerySvc.logger, gallerySvc.entClient, gallerySvc.shopRepo,
+		userId, constants.ShopPermission.AlbumRead)
+	if err != nil {
+		return nil, err
+	}
+
 	ensuredPaging := payload.Ensure()
 	ensuredPayload := dto.NewQueryImgsInfoDto(*ensuredPaging)
 
 	// call repo to GetImgsByUserId
-	result, err := gallerySvc.imginfoRepo.GetImgsByUserId(ctx, gallerySvc.entClient, userId, ensuredPayload)
+	result, err := gallerySvc.imginfoRepo.GetImgsByUserId(ctx, gallerySvc.entClient, ownerId, ensuredPayload)
 	if err != nil {
 		return nil, err
 	}
@@ -155,6 +240,13 @@ func (gallerySvc *AlbumService) UpdateS3ImageDataById(r *http.Request) (*ent.Ima
 		return nil, constants.ErrInternalServer
 	}
 
+	// check permission in active shop
+	ownerId, err := authorizeShop(ctx, gallerySvc.logger, gallerySvc.entClient, gallerySvc.shopRepo,
+		authenticatedUserInfo, constants.ShopPermission.AlbumWrite)
+	if err != nil {
+		return nil, err
+	}
+
 	err = r.ParseMultipartForm(constants.MaxFileSize)
 	if err != nil {
 		gallerySvc.logger.Info("ParseMultipartForm exceeded", zap.Error(err))
@@ -174,7 +266,7 @@ func (gallerySvc *AlbumService) UpdateS3ImageDataById(r *http.Request) (*ent.Ima
 	}
 
 	// call repo to get imginfo
-	imgInfoData, err := gallerySvc.imginfoRepo.GetImgByUserId(ctx, gallerySvc.entClient, authenticatedUserInfo, imgInfoIdParam)
+	imgInfoData, err := gallerySvc.imginfoRepo.GetImgByUserId(ctx, gallerySvc.entClient, ownerId, imgInfoIdParam)
 	if err != nil {
 		return nil, constants.ErrBadRequest
 	}
@@ -186,39 +278,64 @@ func (gallerySvc *AlbumService) UpdateS3ImageDataById(r *http.Request) (*ent.Ima
 		return nil, constants.ErrBadRequest
 	}
 
-	// s3 upload
-	idKey := imgInfoData.ImgS3IDKey
-	uploadInput := &s3manager.UploadInput{
-		Bucket:      aws.String(constants.S3BucketName),
-		Key:         aws.String(idKey),
-		ACL:         aws.String(s3.ObjectCannedACLPublicRead),
-		ContentType: aws.String(header.Header.Get("content-type")),
-		Body:        file,
-	}
-	_, err = gallerySvc.s3Client.GetUploader().Upload(uploadInput)
+	contentType, err := gallerySvc.sniffAlbumImg(file)
 	if err != nil {
 		return nil, err
 	}
 
-	// update with transaction
+	// update with transaction, new object under new key so old image stays served until commit
+	idKey := ownerId + "/" + uuid.NewString()
+	uploaded := false
 	var result *ent.Imageinfo
 	txFunc := func(tx *ent.Tx) error {
 		// extract tx client as ent.cient
 		txc := tx.Client()
 
+		// check storage of owner within plan before object replaced, replaced image size freed
+		err := gallerySvc.ensureAlbumQuota(ctx, txc, ownerId, 0, header.Size-imgInfoData.ImgSize)
+		if err != nil {
+			return err
+		}
+
+		// blob upload, album images are public
+		imgUrl, err := gallerySvc.blobStore.Put(ctx, idKey, file, contentType, true)
+		if err != nil {
+			return err
+		}
+		uploaded = true
+
 		// call repo to UpdateImgInfoById
-		data := dto.NewUpdateImgInfoDto(&header.Size)
+		data := dto.NewUpdateImgInfoDto(&imgUrl, &header.Size, &idKey)
 		updateResult, err := gallerySvc.imginfoRepo.UpdateImgInfoById(ctx, txc, imgInfoIdParam, data)
 		result = updateResult
 		if err != nil {
 			return err
 		}
-		return nil
+
+		// products, variants and homepage showing old url follow the image
+		err = gallerySvc.productRepo.ReplaceImgUrlByUserId(ctx, txc, ownerId, imgInfoData.ImgURL, imgUrl)
+		if err != nil {
+			return err
+		}
+		return gallerySvc.siteuiRepo.ReplaceHomepageImgUrlByUserId(ctx, txc, ownerId, imgInfoData.ImgURL, imgUrl)
 	}
 
 	err = gallerySvc.imginfoRepo.WithTx(ctx, gallerySvc.entClient, txFunc)
 	if err != nil {
-		return result, err
+		if uploaded {
+			// uploaded object without imginfo is orphan
+			delErr := gallerySvc.blobStore.Delete(ctx, idKey)
+			if delErr != nil {
+				gallerySvc.logger.Info("fail to blobStore.Delete", zap.String("key", idKey), zap.Error(delErr))
+			}
+		}
+		return nil, err
+	}
+
+	// replaced object no longer referenced once committed
+	delErr := gallerySvc.blobStore.Delete(ctx, imgInfoData.ImgS3IDKey)
+	if delErr != nil && !errors.Is(delErr, constants.ErrNotFound) {
+		gallerySvc.logger.Info("fail to blobStore.Delete", zap.String("key", imgInfoData.ImgS3IDKey), zap.Error(delErr))
 	}
 	return result, nil
 }
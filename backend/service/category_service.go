@@ -0,0 +1,693 @@
+package service
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/ent/schema"
+	"sthl/repository"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+type ICategoryService interface {
+	// public
+	GetCategories(ctx context.Context, userId string) ([]*ent.Category, error)
+	GetCollections(ctx context.Context, userId string) ([]*ent.Collection, error)
+	// private
+	CreateCategory(ctx context.Context, userId string, payload *dto.CreateCategoryDto) (*ent.Category, error)
+	UpdateCategoryById(ctx context.Context, userId string, categoryId string, version int64, payload *dto.UpdateCategoryDto) (*ent.Category, error)
+	DeleteCategoryById(ctx context.Context, userId string, categoryId string, version int64) error
+	SetProductCategories(ctx context.Context, userId string, productId string, payload *dto.SetProductCategoriesDto) ([]string, error)
+	CreateCollection(ctx context.Context, userId string, payload *dto.CreateCollectionDto) (*dto.CollectionResponseDto, error)
+	UpdateCollectionById(ctx context.Context, userId string, collectionId string, version int64, payload *dto.UpdateCollectionDto) (*dto.CollectionResponseDto, error)
+	DeleteCollectionById(ctx context.Context, userId string, collectionId string, version int64) error
+}
+type CategoryService struct {
+	logger       *zap.Logger
+	client       *ent.Client
+	productRepo  repository.IProductRepository
+	categoryRepo repository.ICategoryRepository
+	shopRepo     repository.IShopRepository
+}
+
+func NewCategoryService(logger *zap.Logger, client *ent.Client, productRepo repository.IProductRepository,
+	categoryRepo repository.ICategoryRepository, shopRepo repository.IShopRepository) ICategoryService {
+	return &CategoryService{
+		logger:       logger,
+		client:       client,
+		productRepo:  productRepo,
+		categoryRepo: categoryRepo,
+		shopRepo:     shopRepo,
+	}
+}
+
+// findCategoryById
+func findCategoryById(categories []*ent.Category, categoryId string) (*ent.Category, bool) {
+	return lo.Find(categories, func(c *ent.Category) bool { return c.ID.String() == categoryId })
+}
+
+// findCategoryByRef: category referred by id, else by slug
+func findCategoryByRef(categories []*ent.Category, ref string) (*ent.Category, bool) {
+	c, ok := findCategoryById(categories, ref)
+	if ok {
+		return c, ok
+	}
+	return lo.Find(categories, func(c *ent.Category) bool { return c.Slug == ref })
+}
+
+// categorySubtreeIds: category and all its descendants
+func categorySubtreeIds(categories []*ent.Category, rootId string) []string {
+	result := []string{rootId}
+	for i := 0; i < len(result); i++ {
+		for _, c := range categories {
+			if c.ParentID != nil && c.ParentID.String() == result[i] {
+				result = append(result, c.ID.String())
+			}
+		}
+	}
+	return result
+}
+
+// categoryDepth: levels from top to category, 1 for top level category
+func categoryDepth(categories []*ent.Category, categoryId string) int {
+	depth := 0
+	current, ok := findCategoryById(categories, categoryId)
+	for ok && depth <= len(categories) {
+		depth++
+		if current.ParentID == nil {
+			break
+		}
+		current, ok = findCategoryById(categories, current.ParentID.String())
+	}
+	return depth
+}
+
+// categoryHeight: levels of subtree under category, 1 for category without children
+func categoryHeight(categories []*ent.Category, categoryId string) int {
+	height := 0
+	for _, c := range categories {
+		if c.ParentID != nil && c.ParentID.String() == categoryId {
+			height = lo.Max([]int{height, categoryHeight(categories, c.ID.String())})
+		}
+	}
+	return height + 1
+}
+
+// checkCategoryParent: parent in same shop, not category itself or its descendant, tree within max depth
+func checkCategoryParent(categories []*ent.Category, categoryId string, parentId *string) error {
+	if parentId == nil {
+		return nil
+	}
+	_, ok := findCategoryById(categories, *parentId)
+	if !ok {
+		return constants.ErrNotFound
+	}
+	height := 1
+	if categoryId != "" {
+		if lo.Contains(categorySubtreeIds(categories, categoryId), *parentId) {
+			return constants.ErrBadRequest
+		}
+		height = categoryHeight(categories, categoryId)
+	}
+	if categoryDepth(categories, *parentId)+height > constants.MaxCategoryDepth {
+		return constants.ErrBadRequest
+	}
+	return nil
+}
+
+// checkCategorySlugUnused: slug unique in shop
+func checkCategorySlugUnused(categories []*ent.Category, excludeId string, slug string) error {
+	if lo.SomeBy(categories, func(c *ent.Category) bool { return c.ID.String() != excludeId && c.Slug == slug }) {
+		return constants.ErrExisted
+	}
+	return nil
+}
+
+// resolveProductsFilter: storefront filter of category with its descendants and of collection,
+// category and collection referred by id or slug, ErrNotFound if not in shop
+func resolveProductsFilter(ctx context.Context, client *ent.Client, categoryRepo repository.ICategoryRepository,
+	userId string, payload *dto.QueryProductsDto) (*dto.ProductsFilterDto, error) {
+	filter := dto.NewProductsFilterDto(nil, nil, nil)
+	if payload.Category != "" {
+		categories, err := categoryRepo.GetCategoriesByUserId(ctx, client, userId)
+		if err != nil {
+			return nil, err
+		}
+		c, ok := findCategoryByRef(categories, payload.Category)
+		if !ok {
+			return nil, constants.ErrNotFound
+		}
+		filter.CategoryIds = categorySubtreeIds(categories, c.ID.String())
+	}
+	if payload.Collection != "" {
+		collections, err := categoryRepo.GetCollectionsByUserId(ctx, client, userId)
+		if err != nil {
+			return nil, err
+		}
+		c, ok := lo.Find(collections, func(c *ent.Collection) bool { return c.ID.String() == payload.Collection })
+		if !ok {
+			c, ok = lo.Find(collections, func(c *ent.Collection) bool { return c.Slug == payload.Collection })
+		}
+		if !ok {
+			return nil, constants.ErrNotFound
+		}
+		if c.Kind == constants.CollectionType.Manual {
+			filter.ProductIds, err = categoryRepo.GetCollectionProductIdsById(ctx, client, c.ID.String())
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			filter.Rules = c.Rules
+		}
+	}
+	return filter, nil
+}
+
+// GetCategories: all categories of shop in display order, tree built by parentId
+func (categorySvc *CategoryService) GetCategories(ctx context.Context, userId string) ([]*ent.Category, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to GetCategoriesByUserId
+	result, err := categorySvc.categoryRepo.GetCategoriesByUserId(ctx, categorySvc.client, userId)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetCollections
+func (categorySvc *CategoryService) GetCollections(ctx context.Context, userId string) ([]*ent.Collection, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to GetCollectionsByUserId
+	result, err := categorySvc.categoryRepo.GetCollectionsByUserId(ctx, categorySvc.client, userId)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CreateCategory: parent must be in same shop and keep tree within max depth
+func (categorySvc *CategoryService) CreateCategory(
+	ctx context.Context, userId string, payload *dto.CreateCategoryDto) (*ent.Category, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		categorySvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to create category with tx
+	var result *ent.Category
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// check permission in active shop, category belongs to shop owner
+		ownerId, err := authorizeShop(ctx, categorySvc.logger, txc, categorySvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		// lock tree of shop
+		categories, err := categorySvc.categoryRepo.LockCategoriesByUserId(ctx, txc, ownerId)
+		if err != nil {
+			return err
+		}
+
+		// checking
+		if len(categories) >= constants.MaxCategories {
+			categorySvc.logger.Info("categories exceed limit")
+			return constants.ErrBadRequest
+		}
+		err = checkCategorySlugUnused(categories, "", *payload.Slug)
+		if err != nil {
+			return err
+		}
+		err = checkCategoryParent(categories, "", payload.ParentId)
+		if err != nil {
+			return err
+		}
+
+		rs, err := categorySvc.categoryRepo.CreateCategory(ctx, txc, ownerId, payload)
+		if err != nil {
+			return err
+		}
+		result = rs
+		return nil
+	}
+	err = categorySvc.categoryRepo.WithTx(ctx, categorySvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateCategoryById: category cannot move under itself or its descendants
+func (categorySvc *CategoryService) UpdateCategoryById(
+	ctx context.Context, userId string, categoryId string, version int64, payload *dto.UpdateCategoryDto) (*ent.Category, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(categoryId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse categoryId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		categorySvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to update category with tx
+	var result *ent.Category
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, categorySvc.logger, txc, categorySvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		// lock tree of shop, category of other shop not found in it
+		categories, err := categorySvc.categoryRepo.LockCategoriesByUserId(ctx, txc, ownerId)
+		if err != nil {
+			return err
+		}
+		current, ok := findCategoryById(categories, categoryId)
+		if !ok {
+			return constants.ErrNotFound
+		}
+
+		// check client edited latest version, repo update is conditional as well
+		if current.Version != version {
+			return constants.ErrPreconditionFailed
+		}
+
+		// checking
+		err = checkCategorySlugUnused(categories, categoryId, *payload.Slug)
+		if err != nil {
+			return err
+		}
+		err = checkCategoryParent(categories, categoryId, payload.ParentId)
+		if err != nil {
+			return err
+		}
+
+		rs, err := categorySvc.categoryRepo.UpdateCategoryById(ctx, txc, categoryId, version, payload)
+		if err != nil {
+			return err
+		}
+		result = rs
+		return nil
+	}
+	err = categorySvc.categoryRepo.WithTx(ctx, categorySvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteCategoryById: category with children cannot be deleted, products are unassigned from it
+func (categorySvc *CategoryService) DeleteCategoryById(
+	ctx context.Context, userId string, categoryId string, version int64) error {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(categoryId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse categoryId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+
+	// call repo to delete category with tx
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, categorySvc.logger, txc, categorySvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		// lock tree of shop, category of other shop not found in it
+		categories, err := categorySvc.categoryRepo.LockCategoriesByUserId(ctx, txc, ownerId)
+		if err != nil {
+			return err
+		}
+		current, ok := findCategoryById(categories, categoryId)
+		if !ok {
+			return constants.ErrNotFound
+		}
+
+		// checking
+		if current.Version != version {
+			return constants.ErrPreconditionFailed
+		}
+		if len(categorySubtreeIds(categories, categoryId)) > 1 {
+			categorySvc.logger.Info("category has children")
+			return constants.ErrBadRequest
+		}
+
+		return categorySvc.categoryRepo.DeleteCategoryById(ctx, txc, categoryId, version)
+	}
+	return categorySvc.categoryRepo.WithTx(ctx, categorySvc.client, txFunc)
+}
+
+// SetProductCategories: replace categories of product, categories must be in shop of product
+func (categorySvc *CategoryService) SetProductCategories(
+	ctx context.Context, userId string, productId string, payload *dto.SetProductCategoriesDto) ([]string, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(productId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		categorySvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to set categories with tx
+	var result []string
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, categorySvc.logger, txc, categorySvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		product, err := categorySvc.productRepo.GetProductById(ctx, txc, productId)
+		if err != nil {
+			return err
+		}
+
+		// check product belong to shop
+		if product.UserID.String() != ownerId {
+			return constants.ErrUnauthorized
+		}
+
+		// check categories in shop, locked so none deleted meanwhile
+		categories, err := categorySvc.categoryRepo.LockCategoriesByUserId(ctx, txc, ownerId)
+		if err != nil {
+			return err
+		}
+		for _, categoryId := range payload.CategoryIds {
+			_, ok := findCategoryById(categories, categoryId)
+			if !ok {
+				return constants.ErrNotFound
+			}
+		}
+
+		err = categorySvc.productRepo.SetProductCategoriesById(ctx, txc, productId, payload.CategoryIds)
+		if err != nil {
+			return err
+		}
+		rs, err := categorySvc.productRepo.GetProductCategoryIdsById(ctx, txc, productId)
+		if err != nil {
+			return err
+		}
+		result = rs
+		return nil
+	}
+	err = categorySvc.categoryRepo.WithTx(ctx, categorySvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// checkCollectionContent: rule collection needs rules and no products, manual collection the opposite
+func checkCollectionContent(kind string, rules int, productIds int) error {
+	if kind == constants.CollectionType.Rule && (rules == 0 || productIds > 0) {
+		return constants.ErrBadRequest
+	}
+	if kind == constants.CollectionType.Manual && rules > 0 {
+		return constants.ErrBadRequest
+	}
+	return nil
+}
+
+// checkCollectionProducts: products of manual collection must be in shop
+func (categorySvc *CategoryService) checkCollectionProducts(
+	ctx context.Context, client *ent.Client, ownerId string, productIds []string) error {
+	products, err := categorySvc.productRepo.GetProductsByIds(ctx, client, productIds)
+	if err != nil {
+		return err
+	}
+	if len(products) != len(productIds) {
+		return constants.ErrNotFound
+	}
+	if lo.SomeBy(products, func(p *ent.Product) bool { return p.UserID.String() != ownerId }) {
+		return constants.ErrUnauthorized
+	}
+	return nil
+}
+
+// setCollectionProducts: replace products of manual collection, rule collection has none
+func (categorySvc *CategoryService) setCollectionProducts(
+	ctx context.Context, client *ent.Client, collection *ent.Collection, productIds []string) ([]string, error) {
+	if collection.Kind != constants.CollectionType.Manual {
+		return []string{}, nil
+	}
+	err := categorySvc.categoryRepo.SetCollectionProductsById(ctx, client, collection.ID.String(), productIds)
+	if err != nil {
+		return nil, err
+	}
+	return categorySvc.categoryRepo.GetCollectionProductIdsById(ctx, client, collection.ID.String())
+}
+
+// CreateCollection: manual collection lists products, rule collection matches products by rules
+func (categorySvc *CategoryService) CreateCollection(
+	ctx context.Context, userId string, payload *dto.CreateCollectionDto) (*dto.CollectionResponseDto, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		categorySvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = checkCollectionContent(*payload.Kind, len(payload.Rules), len(payload.ProductIds))
+	if err != nil {
+		categorySvc.logger.Info("fail to checkCollectionContent", zap.Error(err))
+		return nil, err
+	}
+
+	// call repo to create collection with tx
+	var result *dto.CollectionResponseDto
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// check permission in active shop, collection belongs to shop owner
+		ownerId, err := authorizeShop(ctx, categorySvc.logger, txc, categorySvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		// checking
+		collections, err := categorySvc.categoryRepo.GetCollectionsByUserId(ctx, txc, ownerId)
+		if err != nil {
+			return err
+		}
+		if len(collections) >= constants.MaxCollections {
+			categorySvc.logger.Info("collections exceed limit")
+			return constants.ErrBadRequest
+		}
+		if lo.SomeBy(collections, func(c *ent.Collection) bool { return c.Slug == *payload.Slug }) {
+			return constants.ErrExisted
+		}
+		err = categorySvc.checkCollectionProducts(ctx, txc, ownerId, payload.ProductIds)
+		if err != nil {
+			return err
+		}
+
+		mapped := *payload
+		mapped.Rules = lo.Ternary(payload.Rules == nil, []schema.CollectionRule{}, payload.Rules)
+		rs, err := categorySvc.categoryRepo.CreateCollection(ctx, txc, ownerId, &mapped)
+		if err != nil {
+			return err
+		}
+		productIds, err := categorySvc.setCollectionProducts(ctx, txc, rs, lo.Ternary(payload.ProductIds == nil, []string{}, payload.ProductIds))
+		if err != nil {
+			return err
+		}
+		result = dto.NewCollectionResponseDto(rs, productIds)
+		return nil
+	}
+	err = categorySvc.categoryRepo.WithTx(ctx, categorySvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateCollectionById: replaces rules of rule collection, products of manual collection
+func (categorySvc *CategoryService) UpdateCollectionById(
+	ctx context.Context, userId string, collectionId string, version int64, payload *dto.UpdateCollectionDto) (*dto.CollectionResponseDto, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(collectionId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse collectionId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		categorySvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to update collection with tx
+	var result *dto.CollectionResponseDto
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, categorySvc.logger, txc, categorySvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		collection, err := categorySvc.categoryRepo.GetCollectionById(ctx, txc, collectionId)
+		if err != nil {
+			return err
+		}
+
+		// check collection belong to shop
+		if collection.UserID.String() != ownerId {
+			return constants.ErrUnauthorized
+		}
+
+		// check client edited latest version, repo update is conditional as well
+		if collection.Version != version {
+			return constants.ErrPreconditionFailed
+		}
+
+		// checking
+		err = checkCollectionContent(collection.Kind, len(payload.Rules), len(payload.ProductIds))
+		if err != nil {
+			return err
+		}
+		collections, err := categorySvc.categoryRepo.GetCollectionsByUserId(ctx, txc, ownerId)
+		if err != nil {
+			return err
+		}
+		if lo.SomeBy(collections, func(c *ent.Collection) bool { return c.ID.String() != collectionId && c.Slug == *payload.Slug }) {
+			return constants.ErrExisted
+		}
+		err = categorySvc.checkCollectionProducts(ctx, txc, ownerId, payload.ProductIds)
+		if err != nil {
+			return err
+		}
+
+		mapped := *payload
+		mapped.Rules = lo.Ternary(payload.Rules == nil, []schema.CollectionRule{}, payload.Rules)
+		rs, err := categorySvc.categoryRepo.UpdateCollectionById(ctx, txc, collectionId, version, &mapped)
+		if err != nil {
+			return err
+		}
+		productIds, err := categorySvc.setCollectionProducts(ctx, txc, rs, lo.Ternary(payload.ProductIds == nil, []string{}, payload.ProductIds))
+		if err != nil {
+			return err
+		}
+		result = dto.NewCollectionResponseDto(rs, productIds)
+		return nil
+	}
+	err = categorySvc.categoryRepo.WithTx(ctx, categorySvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteCollectionById: products stay, only membership removed
+func (categorySvc *CategoryService) DeleteCollectionById(
+	ctx context.Context, userId string, collectionId string, version int64) error {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(collectionId)
+	if err != nil {
+		categorySvc.logger.Info("fail to parse collectionId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+
+	// call repo to delete collection with tx
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, categorySvc.logger, txc, categorySvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		collection, err := categorySvc.categoryRepo.GetCollectionById(ctx, txc, collectionId)
+		if err != nil {
+			return err
+		}
+
+		// checking
+		if collection.UserID.String() != ownerId {
+			return constants.ErrUnauthorized
+		}
+		if collection.Version != version {
+			return constants.ErrPreconditionFailed
+		}
+
+		return categorySvc.categoryRepo.DeleteCollectionById(ctx, txc, collectionId, version)
+	}
+	return categorySvc.categoryRepo.WithTx(ctx, categorySvc.client, txFunc)
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/ent/schema"
+	"sthl/logger"
+	"sthl/money"
+	"sthl/repository"
+	"sthl/utils"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// categoryServiceTestSetup: category and product services sharing repos
+func categoryServiceTestSetup(ctx context.Context, t *testing.T) (*assert.Assertions, ICategoryService, IProductService) {
+	t.Setenv("JWT_SECRET", "test_value")
+	assert := assert.New(t)
+	// dependency init
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NotEmpty(zapLogger)
+	assert.NoError(err)
+	userRepo := repository.NewUserRepositoryMock()
+	productRepo := repository.NewProductRepositoryMock()
+	shopRepo := repository.NewShopRepositoryMock()
+	categoryRepo := repository.NewCategoryRepositoryMock()
+	categorySvc := NewCategoryService(zapLogger, nil, productRepo, categoryRepo, shopRepo)
+	productSvc := NewProductService(zapLogger, nil, userRepo, productRepo, shopRepo, categoryRepo, repository.NewImgInfoRepositoryMock())
+	assert.NotEmpty(categorySvc)
+	assert.NotEmpty(productSvc)
+	return assert, categorySvc, productSvc
+}
+
+func createCategoryDto(name string, parent *ent.Category) *dto.CreateCategoryDto {
+	var parentId *string
+	if parent != nil {
+		parentId = utils.PtrOf(parent.ID.String())
+	}
+	return dto.NewCreateCategoryDto(utils.PtrOf(name), utils.PtrOf(name), utils.PtrOf(""), parentId, utils.PtrOf(int32(0)))
+}
+
+func Test_Categories(t *testing.T) {
+	ctx := context.TODO()
+	assert, categorySvc, productSvc := categoryServiceTestSetup(ctx, t)
+	validUserId := uuid.NewString()
+
+	// build tree clothing > tops > tees
+	clothing, err := categorySvc.CreateCategory(ctx, validUserId, createCategoryDto("clothing", nil))
+	assert.NoError(err)
+	assert.Nil(clothing.ParentID)
+	tops, err := categorySvc.CreateCategory(ctx, validUserId, createCategoryDto("tops", clothing))
+	assert.NoError(err)
+	assert.Equal(clothing.ID, *tops.ParentID)
+	tees, err := categorySvc.CreateCategory(ctx, validUserId, createCategoryDto("tees", tops))
+	assert.NoError(err)
+
+	// invalid categories
+	invalidCategoryDtos := map[error]*dto.CreateCategoryDto{
+		constants.ErrExisted:    createCategoryDto("tops", nil),
+		constants.ErrBadRequest: createCategoryDto("Not A Slug", nil),
+		constants.ErrNotFound:   dto.NewCreateCategoryDto(utils.PtrOf("shoes"), utils.PtrOf("shoes"), utils.PtrOf(""), utils.PtrOf(uuid.NewString()), utils.PtrOf(int32(0))),
+	}
+	for expected, invalidCategoryDto := range invalidCategoryDtos {
+		category, err := categorySvc.CreateCategory(ctx, validUserId, invalidCategoryDto)
+		assert.Empty(category)
+		assert.ErrorIs(err, expected)
+	}
+
+	// tree limited to max depth
+	parent := tees
+	for i := 3; i < constants.MaxCategoryDepth; i++ {
+		parent, err = categorySvc.CreateCategory(ctx, validUserId, createCategoryDto(strings.ToLower(gofakeit.LetterN(10)), parent))
+		assert.NoError(err)
+	}
+	tooDeep, err := categorySvc.CreateCategory(ctx, validUserId, createCategoryDto("too-deep", parent))
+	assert.Empty(tooDeep)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// category cannot move under its descendant
+	moved, err := categorySvc.UpdateCategoryById(ctx, validUserId, clothing.ID.String(), clothing.Version,
+		dto.NewUpdateCategoryDto(&clothing.Name, &clothing.Slug, &clothing.Description, utils.PtrOf(tees.ID.String()), &clothing.Position))
+	assert.Empty(moved)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// move tees to top level, stale version rejected
+	moved, err = categorySvc.UpdateCategoryById(ctx, validUserId, tees.ID.String(), tees.Version,
+		dto.NewUpdateCategoryDto(&tees.Name, &tees.Slug, &tees.Description, nil, &tees.Position))
+	assert.NoError(err)
+	assert.Nil(moved.ParentID)
+	moved, err = categorySvc.UpdateCategoryById(ctx, validUserId, tees.ID.String(), tees.Version,
+		dto.NewUpdateCategoryDto(&tees.Name, &tees.Slug, &tees.Description, nil, &tees.Position))
+	assert.Empty(moved)
+	assert.ErrorIs(err, constants.ErrPreconditionFailed)
+
+	// other shop cannot see or update categories
+	moved, err = categorySvc.UpdateCategoryById(ctx, uuid.NewString(), tops.ID.String(), tops.Version,
+		dto.NewUpdateCategoryDto(&tops.Name, &tops.Slug, &tops.Description, nil, &tops.Position))
+	assert.Empty(moved)
+	assert.ErrorIs(err, constants.ErrNotFound)
+
+	// assign product to tops, listed under clothing by its descendants
+	product, err := productSvc.CreateProduct(ctx, validUserId, dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()), utils.PtrOf(money.Amount(1000)), utils.PtrOf(int32(1)), utils.PtrOf(""), utils.PtrOf(""), nil))
+	assert.NoError(err)
+	_, err = productSvc.CreateProduct(ctx, validUserId, dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()+"x"), utils.PtrOf(money.Amount(1000)), utils.PtrOf(int32(1)), utils.PtrOf(""), utils.PtrOf(""), nil))
+	assert.NoError(err)
+	categoryIds, err := categorySvc.SetProductCategories(ctx, validUserId, product.ID.String(),
+		dto.NewSetProductCategoriesDto([]string{tops.ID.String()}))
+	assert.NoError(err)
+	assert.Equal([]string{tops.ID.String()}, categoryIds)
+	categoryIds, err = categorySvc.SetProductCategories(ctx, validUserId, product.ID.String(),
+		dto.NewSetProductCategoriesDto([]string{uuid.NewString()}))
+	assert.Empty(categoryIds)
+	assert.ErrorIs(err, constants.ErrNotFound)
+
+	for _, ref := range []string{"clothing", clothing.ID.String(), "tops"} {
+		products, err := productSvc.GetPrdoucts(ctx, validUserId, dto.NewQueryProductsDto(*dto.NewPaging(1, 20, ""), ref, ""))
+		assert.NoError(err)
+		assert.Equal(1, products.Total)
+		assert.Equal(product.ID, products.Data[0].ID)
+	}
+	products, err := productSvc.GetPrdoucts(ctx, validUserId, dto.NewQueryProductsDto(*dto.NewPaging(1, 20, ""), "tees", ""))
+	assert.NoError(err)
+	assert.Equal(0, products.Total)
+	products, err = productSvc.GetPrdoucts(ctx, validUserId, dto.NewQueryProductsDto(*dto.NewPaging(1, 20, ""), "unknown", ""))
+	assert.Empty(products)
+	assert.ErrorIs(err, constants.ErrNotFound)
+	detail, err := productSvc.GetProductById(ctx, validUserId, product.ID.String())
+	assert.NoError(err)
+	assert.Equal([]string{tops.ID.String()}, detail.CategoryIds)
+
+	// category with children cannot be deleted
+	err = categorySvc.DeleteCategoryById(ctx, validUserId, clothing.ID.String(), clothing.Version)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	err = categorySvc.DeleteCategoryById(ctx, validUserId, parent.ID.String(), parent.Version)
+	assert.NoError(err)
+	categories, err := categorySvc.GetCategories(ctx, validUserId)
+	assert.NoError(err)
+	assert.Len(categories, constants.MaxCategoryDepth-1)
+}
+
+func Test_Collections(t *testing.T) {
+	ctx := context.TODO()
+	assert, categorySvc, productSvc := categoryServiceTestSetup(ctx, t)
+	validUserId := uuid.NewString()
+
+	cheap, err := productSvc.CreateProduct(ctx, validUserId, dto.NewCreateProductDto(
+		utils.PtrOf("Cheap Tee"), utils.PtrOf(money.Amount(500)), utils.PtrOf(int32(1)), utils.PtrOf(""), utils.PtrOf(""), nil))
+	assert.NoError(err)
+	pricey, err := productSvc.CreateProduct(ctx, validUserId, dto.NewCreateProductDto(
+		utils.PtrOf("Pricey Tee"), utils.PtrOf(money.Amount(5000)), utils.PtrOf(int32(1)), utils.PtrOf(""), utils.PtrOf(""), nil))
+	assert.NoError(err)
+	otherShopProduct, err := productSvc.CreateProduct(ctx, uuid.NewString(), dto.NewCreateProductDto(
+		utils.PtrOf("Other Tee"), utils.PtrOf(money.Amount(500)), utils.PtrOf(int32(1)), utils.PtrOf(""), utils.PtrOf(""), nil))
+	assert.NoError(err)
+
+	// manual collection lists its products
+	manual, err := categorySvc.CreateCollection(ctx, validUserId, dto.NewCreateCollectionDto(
+		utils.PtrOf("Staff picks"), utils.PtrOf("staff-picks"), utils.PtrOf(""), utils.PtrOf(constants.CollectionType.Manual),
+		nil, []string{pricey.ID.String()}))
+	assert.NoError(err)
+	assert.Equal([]string{pricey.ID.String()}, manual.ProductIds)
+	products, err := productSvc.GetPrdoucts(ctx, validUserId, dto.NewQueryProductsDto(*dto.NewPaging(1, 20, ""), "", "staff-picks"))
+	assert.NoError(err)
+	assert.Equal(1, products.Total)
+	assert.Equal(pricey.ID, products.Data[0].ID)
+
+	// rule collection matches products by all rules
+	rules := []schema.CollectionRule{
+		{Field: constants.CollectionRuleField.Name, Operator: constants.CollectionRuleOperator.Contains, Value: "tee"},
+		{Field: constants.CollectionRuleField.Price, Operator: constants.CollectionRuleOperator.Lte, Value: "10"},
+	}
+	rule, err := categorySvc.CreateCollection(ctx, validUserId, dto.NewCreateCollectionDto(
+		utils.PtrOf("Under 10"), utils.PtrOf("under-10"), utils.PtrOf(""), utils.PtrOf(constants.CollectionType.Rule), rules, nil))
+	assert.NoError(err)
+	assert.Empty(rule.ProductIds)
+	products, err = productSvc.GetPrdoucts(ctx, validUserId, dto.NewQueryProductsDto(*dto.NewPaging(1, 20, ""), "", rule.ID.String()))
+	assert.NoError(err)
+	assert.Equal(1, products.Total)
+	assert.Equal(cheap.ID, products.Data[0].ID)
+
+	// price rule in major units with decimals
+	decimal, err := categorySvc.CreateCollection(ctx, validUserId, dto.NewCreateCollectionDto(
+		utils.PtrOf("From 49.99"), utils.PtrOf("from-49-99"), utils.PtrOf(""), utils.PtrOf(constants.CollectionType.Rule),
+		[]schema.CollectionRule{{Field: constants.CollectionRuleField.Price, Operator: constants.CollectionRuleOperator.Gte, Value: "49.99"}}, nil))
+	assert.NoError(err)
+	products, err = productSvc.GetPrdoucts(ctx, validUserId, dto.NewQueryProductsDto(*dto.NewPaging(1, 20, ""), "", decimal.ID.String()))
+	assert.NoError(err)
+	assert.Equal(1, products.Total)
+	assert.Equal(pricey.ID, products.Data[0].ID)
+	assert.NoError(categorySvc.DeleteCollectionById(ctx, validUserId, decimal.ID.String(), decimal.Version))
+	for _, value := range []string{"-1", "abc"} {
+		invalid, err := categorySvc.CreateCollection(ctx, validUserId, dto.NewCreateCollectionDto(
+			utils.PtrOf("Invalid"), utils.PtrOf("invalid"), utils.PtrOf(""), utils.PtrOf(constants.CollectionType.Rule),
+			[]schema.CollectionRule{{Field: constants.CollectionRuleField.Price, Operator: constants.CollectionRuleOperator.Gte, Value: value}}, nil))
+		assert.Empty(invalid)
+		assert.ErrorIs(err, constants.ErrBadRequest, value)
+	}
+
+	// invalid collections
+	invalidCollectionDtos := map[error]*dto.CreateCollectionDto{
+		constants.ErrExisted: dto.NewCreateCollectionDto(utils.PtrOf("Picks"), utils.PtrOf("staff-picks"), utils.PtrOf(""),
+			utils.PtrOf(constants.CollectionType.Manual), nil, nil),
+		constants.ErrUnauthorized: dto.NewCreateCollectionDto(utils.PtrOf("Others"), utils.PtrOf("others"), utils.PtrOf(""),
+			utils.PtrOf(constants.CollectionType.Manual), nil, []string{otherShopProduct.ID.String()}),
+		constants.ErrBadRequest: dto.NewCreateCollectionDto(utils.PtrOf("No rules"), utils.PtrOf("no-rules"), utils.PtrOf(""),
+			utils.PtrOf(constants.CollectionType.Rule), nil, nil),
+	}
+	for expected, invalidCollectionDto := range invalidCollectionDtos {
+		collection, err := categorySvc.CreateCollection(ctx, validUserId, invalidCollectionDto)
+		assert.Empty(collection)
+		assert.ErrorIs(err, expected)
+	}
+	unsupported, err := categorySvc.CreateCollection(ctx, validUserId, dto.NewCreateCollectionDto(
+		utils.PtrOf("Bad rule"), utils.PtrOf("bad-rule"), utils.PtrOf(""), utils.PtrOf(constants.CollectionType.Rule),
+		[]schema.CollectionRule{{Field: constants.CollectionRuleField.Status, Operator: constants.CollectionRuleOperator.Gte, Value: "1"}}, nil))
+	assert.Empty(unsupported)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// update manual collection products, stale version rejected
+	updated, err := categorySvc.UpdateCollectionById(ctx, validUserId, manual.ID.String(), manual.Version, dto.NewUpdateCollectionDto(
+		&manual.Name, &manual.Slug, &manual.Description, nil, []string{cheap.ID.String(), pricey.ID.String()}))
+	assert.NoError(err)
+	assert.Len(updated.ProductIds, 2)
+	updated, err = categorySvc.UpdateCollectionById(ctx, validUserId, manual.ID.String(), manual.Version, dto.NewUpdateCollectionDto(
+		&manual.Name, &manual.Slug, &manual.Description, nil, nil))
+	assert.Empty(updated)
+	assert.ErrorIs(err, constants.ErrPreconditionFailed)
+
+	// delete collection
+	err = categorySvc.DeleteCollectionById(ctx, uuid.NewString(), rule.ID.String(), rule.Version)
+	assert.ErrorIs(err, constants.ErrUnauthorized)
+	err = categorySvc.DeleteCollectionById(ctx, validUserId, rule.ID.String(), rule.Version)
+	assert.NoError(err)
+	collections, err := categorySvc.GetCollections(ctx, validUserId)
+	assert.NoError(err)
+	assert.Len(collections, 1)
+}
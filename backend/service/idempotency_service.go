@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/repository"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type IIdempotencyService interface {
+	BeginIdempotentRequest(ctx context.Context, scope string, key string, fingerprint string) (*ent.IdempotencyKey, bool, error)
+	CompleteIdempotentRequest(ctx context.Context, idempotencyKeyId string, claimToken string, statusCode int, responseBody []byte, etag string) error
+	ReleaseIdempotentRequest(ctx context.Context, idempotencyKeyId string, claimToken string) error
+	DeleteExpiredIdempotentRequests(ctx context.Context) (int, error)
+}
+type IdempotencyService struct {
+	logger          *zap.Logger
+	client          *ent.Client
+	idempotencyRepo repository.IIdempotencyRepository
+}
+
+func NewIdempotencyService(logger *zap.Logger, client *ent.Client, idempotencyRepo repository.IIdempotencyRepository) IIdempotencyService {
+	return &IdempotencyService{
+		logger:          logger,
+		client:          client,
+		idempotencyRepo: idempotencyRepo,
+	}
+}
+
+// BeginIdempotentRequest: claim key in scope for a new request,
+// return completed record and true if request should be replayed,
+// ErrIdempotencyKeyReused if payload differs, ErrIdempotencyInProgress if first request not finished.
+// key past retention window, or in progress past its lease, is claimed again.
+// not in tx, unique violation on claim would abort the tx
+func (idempotencySvc *IdempotencyService) BeginIdempotentRequest(
+	ctx context.Context, scope string, key string, fingerprint string) (*ent.IdempotencyKey, bool, error) {
+	if scope == "" || key == "" || len(key) > constants.IdempotencyKeyMaxLength || fingerprint == "" {
+		idempotencySvc.logger.Info("invalid idempotency key")
+		return nil, false, constants.ErrBadRequest
+	}
+
+	// call repo to claim key
+	now := time.Now()
+	leaseExpiresAt := now.Add(constants.IdempotencyKeyLeaseDuration)
+	expiresAt := now.Add(constants.IdempotencyKeyDuration)
+	claimed, err := idempotencySvc.idempotencyRepo.CreateIdempotencyKey(ctx, idempotencySvc.client,
+		scope, key, fingerprint, leaseExpiresAt, expiresAt)
+	if err == nil {
+		return claimed, false, nil
+	}
+	if !errors.Is(err, constants.ErrExisted) {
+		return nil, false, err
+	}
+
+	// key used before, replay only same payload once completed
+	existing, err := idempotencySvc.idempotencyRepo.GetIdempotencyKey(ctx, idempotencySvc.client, scope, key)
+	if err != nil {
+		return nil, false, err
+	}
+	expired := !existing.ExpiresAt.After(now)
+	if !expired && existing.Fingerprint != fingerprint {
+		return nil, false, constants.ErrIdempotencyKeyReused
+	}
+	leaseExpired := existing.StatusCode == 0 && (existing.LeaseExpiresAt == nil || !existing.LeaseExpiresAt.After(now))
+	if !expired && !leaseExpired {
+		if existing.StatusCode == 0 {
+			return nil, false, constants.ErrIdempotencyInProgress
+		}
+		return existing, true, nil
+	}
+
+	// reclaim, only one of concurrent retries wins
+	reclaimed, err := idempotencySvc.idempotencyRepo.ReclaimIdempotencyKeyById(ctx, idempotencySvc.client,
+		existing.ID.String(), fingerprint, now, leaseExpiresAt, expiresAt)
+	if errors.Is(err, constants.ErrNotFound) {
+		idempotencySvc.logger.Info("idempotency key reclaimed concurrently", zap.String("idempotencyKeyId", existing.ID.String()))
+		return nil, false, constants.ErrIdempotencyInProgress
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return reclaimed, false, nil
+}
+
+// CompleteIdempotentRequest: store response and its etag for replay,
+// ErrNotFound if claim was lost, e.g. lease expired and a retry claimed key again
+func (idempotencySvc *IdempotencyService) CompleteIdempotentRequest(
+	ctx context.Context, idempotencyKeyId string, claimToken string, statusCode int, responseBody []byte, etag string) error {
+	ok, err := idempotencySvc.idempotencyRepo.CompleteIdempotencyKeyById(ctx, idempotencySvc.client, idempotencyKeyId, claimToken, statusCode, responseBody, etag)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		idempotencySvc.logger.Info("idempotency key not in progress", zap.String("idempotencyKeyId", idempotencyKeyId))
+		return constants.ErrNotFound
+	}
+	return nil
+}
+
+// ReleaseIdempotentRequest: drop claim so the key can be retried, e.g. after server error,
+// no-op if claim was already lost to a retry
+func (idempotencySvc *IdempotencyService) ReleaseIdempotentRequest(ctx context.Context, idempotencyKeyId string, claimToken string) error {
+	_, err := idempotencySvc.idempotencyRepo.DeleteIdempotencyKeyById(ctx, idempotencySvc.client, idempotencyKeyId, claimToken)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteExpiredIdempotentRequests: purge records past retention window, run periodically off the request path
+func (idempotencySvc *IdempotencyService) DeleteExpiredIdempotentRequests(ctx context.Context) (int, error) {
+	return idempotencySvc.idempotencyRepo.DeleteExpiredIdempotencyKeys(ctx, idempotencySvc.client, time.Now())
+}
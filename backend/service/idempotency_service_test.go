@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sthl/constants"
+	"sthl/logger"
+	"sthl/repository"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+)
+
+// idempotencyServiceTestSetup: repo exposed to seed expired records
+func idempotencyServiceTestSetup(t *testing.T) (*assert.Assertions, IIdempotencyService, repository.IIdempotencyRepository) {
+	assert := assert.New(t)
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NotEmpty(zapLogger)
+	assert.NoError(err)
+	idempotencyRepo := repository.NewIdempotencyRepositoryMock()
+	return assert, NewIdempotencyService(zapLogger, nil, idempotencyRepo), idempotencyRepo
+}
+
+// ****Test_IdempotentRequest
+func Test_IdempotentRequest(t *testing.T) {
+	ctx := context.TODO()
+	assert, idempotencySvc, _ := idempotencyServiceTestSetup(t)
+	scope := "POST /api/v1/orders/" + gofakeit.UUID()
+	key := gofakeit.UUID()
+	fingerprint := gofakeit.LetterN(64)
+
+	// invalid key
+	_, _, err := idempotencySvc.BeginIdempotentRequest(ctx, scope, gofakeit.LetterN(uint(constants.IdempotencyKeyMaxLength+1)), fingerprint)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// first request claims key
+	claimed, isReplay, err := idempotencySvc.BeginIdempotentRequest(ctx, scope, key, fingerprint)
+	assert.NoError(err)
+	assert.False(isReplay)
+	assert.NotEmpty(claimed)
+
+	// retry while in progress, or other payload under same key
+	_, _, err = idempotencySvc.BeginIdempotentRequest(ctx, scope, key, fingerprint)
+	assert.ErrorIs(err, constants.ErrIdempotencyInProgress)
+	_, _, err = idempotencySvc.BeginIdempotentRequest(ctx, scope, key, gofakeit.LetterN(64))
+	assert.ErrorIs(err, constants.ErrIdempotencyKeyReused)
+
+	// same key in other scope is independent
+	_, isReplay, err = idempotencySvc.BeginIdempotentRequest(ctx, scope+"/other", key, fingerprint)
+	assert.NoError(err)
+	assert.False(isReplay)
+
+	// completed request replays stored response
+	body := []byte(`{"code":201}`)
+	err = idempotencySvc.CompleteIdempotentRequest(ctx, claimed.ID.String(), claimed.ClaimToken.String(), http.StatusCreated, body, `"1"`)
+	assert.NoError(err)
+	err = idempotencySvc.CompleteIdempotentRequest(ctx, claimed.ID.String(), claimed.ClaimToken.String(), http.StatusCreated, body, `"1"`)
+	assert.ErrorIs(err, constants.ErrNotFound)
+	replayed, isReplay, err := idempotencySvc.BeginIdempotentRequest(ctx, scope, key, fingerprint)
+	assert.NoError(err)
+	assert.True(isReplay)
+	assert.Equal(http.StatusCreated, replayed.StatusCode)
+	assert.Equal(body, replayed.ResponseBody)
+	assert.Equal(`"1"`, replayed.Etag)
+	_, _, err = idempotencySvc.BeginIdempotentRequest(ctx, scope, key, gofakeit.LetterN(64))
+	assert.ErrorIs(err, constants.ErrIdempotencyKeyReused)
+
+	// released key can be claimed again
+	otherKey := gofakeit.UUID()
+	claimed, _, err = idempotencySvc.BeginIdempotentRequest(ctx, scope, otherKey, fingerprint)
+	assert.NoError(err)
+	err = idempotencySvc.ReleaseIdempotentRequest(ctx, claimed.ID.String(), claimed.ClaimToken.String())
+	assert.NoError(err)
+	_, isReplay, err = idempotencySvc.BeginIdempotentRequest(ctx, scope, otherKey, gofakeit.LetterN(64))
+	assert.NoError(err)
+	assert.False(isReplay)
+}
+
+// ****Test_IdempotentRequestExpired
+func Test_IdempotentRequestExpired(t *testing.T) {
+	ctx := context.TODO()
+	assert, idempotencySvc, idempotencyRepo := idempotencyServiceTestSetup(t)
+	scope := "POST /api/v1/orders/" + gofakeit.UUID()
+	key := gofakeit.UUID()
+
+	// key past retention window is reclaimed for any payload
+	expired, err := idempotencyRepo.CreateIdempotencyKey(ctx, nil, scope, key, gofakeit.LetterN(64),
+		time.Now().Add(-time.Hour), time.Now().Add(-time.Minute))
+	assert.NoError(err)
+	_, err = idempotencyRepo.CompleteIdempotencyKeyById(ctx, nil, expired.ID.String(), expired.ClaimToken.String(), http.StatusCreated, []byte(`{}`), "")
+	assert.NoError(err)
+	fingerprint := gofakeit.LetterN(64)
+	claimed, isReplay, err := idempotencySvc.BeginIdempotentRequest(ctx, scope, key, fingerprint)
+	assert.NoError(err)
+	assert.False(isReplay)
+	assert.Equal(0, claimed.StatusCode)
+	assert.Empty(claimed.ResponseBody)
+	assert.True(claimed.ExpiresAt.After(time.Now()))
+	_, _, err = idempotencySvc.BeginIdempotentRequest(ctx, scope, key, fingerprint)
+	assert.ErrorIs(err, constants.ErrIdempotencyInProgress)
+}
+
+// ****Test_IdempotentRequestLeaseExpired
+func Test_IdempotentRequestLeaseExpired(t *testing.T) {
+	ctx := context.TODO()
+	assert, idempotencySvc, idempotencyRepo := idempotencyServiceTestSetup(t)
+	scope := "POST /api/v1/orders/" + gofakeit.UUID()
+	key := gofakeit.UUID()
+	fingerprint := gofakeit.LetterN(64)
+
+	// claim left in progress by crashed request, lease over but within retention window
+	abandoned, err := idempotencyRepo.CreateIdempotencyKey(ctx, nil, scope, key, fingerprint,
+		time.Now().Add(-time.Second), time.Now().Add(constants.IdempotencyKeyDuration))
+	assert.NoError(err)
+
+	// other payload still rejected
+	_, _, err = idempotencySvc.BeginIdempotentRequest(ctx, scope, key, gofakeit.LetterN(64))
+	assert.ErrorIs(err, constants.ErrIdempotencyKeyReused)
+
+	// retry takes over the claim with a new lease
+	claimed, isReplay, err := idempotencySvc.BeginIdempotentRequest(ctx, scope, key, fingerprint)
+	assert.NoError(err)
+	assert.False(isReplay)
+	assert.Equal(abandoned.ID, claimed.ID)
+	assert.NotEqual(abandoned.ClaimToken, claimed.ClaimToken)
+	assert.True(claimed.LeaseExpiresAt.After(time.Now()))
+	_, _, err = idempotencySvc.BeginIdempotentRequest(ctx, scope, key, fingerprint)
+	assert.ErrorIs(err, constants.ErrIdempotencyInProgress)
+
+	// request outliving its lease can neither complete nor release claim of retry
+	err = idempotencySvc.CompleteIdempotentRequest(ctx, abandoned.ID.String(), abandoned.ClaimToken.String(), http.StatusCreated, []byte(`{}`), "")
+	assert.ErrorIs(err, constants.ErrNotFound)
+	err = idempotencySvc.ReleaseIdempotentRequest(ctx, abandoned.ID.String(), abandoned.ClaimToken.String())
+	assert.NoError(err)
+	_, _, err = idempotencySvc.BeginIdempotentRequest(ctx, scope, key, fingerprint)
+	assert.ErrorIs(err, constants.ErrIdempotencyInProgress)
+
+	// completed key is replayed, never taken over
+	err = idempotencySvc.CompleteIdempotentRequest(ctx, claimed.ID.String(), claimed.ClaimToken.String(), http.StatusCreated, []byte(`{}`), "")
+	assert.NoError(err)
+	_, isReplay, err = idempotencySvc.BeginIdempotentRequest(ctx, scope, key, fingerprint)
+	assert.NoError(err)
+	assert.True(isReplay)
+}
+
+// ****Test_DeleteExpiredIdempotentRequests
+func Test_DeleteExpiredIdempotentRequests(t *testing.T) {
+	ctx := context.TODO()
+	assert, idempotencySvc, idempotencyRepo := idempotencyServiceTestSetup(t)
+	scope := "POST /api/v1/orders/" + gofakeit.UUID()
+
+	// only keys past retention window are purged
+	_, err := idempotencyRepo.CreateIdempotencyKey(ctx, nil, scope, gofakeit.UUID(), gofakeit.LetterN(64),
+		time.Now().Add(-time.Hour), time.Now().Add(-time.Minute))
+	assert.NoError(err)
+	kept, _, err := idempotencySvc.BeginIdempotentRequest(ctx, scope, gofakeit.UUID(), gofakeit.LetterN(64))
+	assert.NoError(err)
+	deleted, err := idempotencySvc.DeleteExpiredIdempotentRequests(ctx)
+	assert.NoError(err)
+	assert.Equal(1, deleted)
+	found, err := idempotencyRepo.GetIdempotencyKey(ctx, nil, scope, kept.Key)
+	assert.NoError(err)
+	assert.Equal(kept.ID, found.ID)
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"sort"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/utils"
+
+	"github.com/samber/lo"
+)
+
+// orderItemsEventValue: items as product, variant, name, price and quantity, sorted by product and variant
+func orderItemsEventValue(items []*ent.OrderItem) []map[string]any {
+	sorted := append([]*ent.OrderItem{}, items...)
+	sort.Slice(sorted, func(i, j int) bool { return orderItemLineKey(sorted[i]) < orderItemLineKey(sorted[j]) })
+	return lo.Map(sorted, func(item *ent.OrderItem, _ int) map[string]any {
+		return map[string]any{
+			"productId":      item.ProductID.String(),
+			"variantId":      orderItemVariantId(item),
+			"purchasedName":  item.PurchasedName,
+			"purchasedPrice": item.PurchasedPrice,
+			"quantity":       item.Quantity,
+		}
+	})
+}
+
+// newOrderCreatedEvent: storefront order placed by customer
+func newOrderCreatedEvent(order *dto.OrderResponseDto) *dto.CreateOrderEventDto {
+	return dto.NewCreateOrderEventDto(constants.OrderEventType.Created, constants.OrderEventActor.Customer, nil, nil,
+		orderCreatedEventValue(order))
+}
+
+// newStaffOrderCreatedEvent: order entered by staff in cms
+func newStaffOrderCreatedEvent(actorId string, order *dto.OrderResponseDto) *dto.CreateOrderEventDto {
+	return dto.NewCreateOrderEventDto(constants.OrderEventType.Created, constants.OrderEventActor.Staff, &actorId, nil,
+		orderCreatedEventValue(order))
+}
+
+// orderCreatedEventValue: snapshot of order as placed
+func orderCreatedEventValue(order *dto.OrderResponseDto) map[string]any {
+	return map[string]any{
+		"status":          order.Status,
+		"paymentStatus":   order.PaymentStatus,
+		"deliveryStatus":  order.DeliveryStatus,
+		"shippingAddress": order.ShippingAddress,
+		"remark":          order.Remark,
+		"currency":        order.Currency,
+		"discount":        order.Discount,
+		"totalAmount":     order.TotalAmount,
+		"items":           orderItemsEventValue(order.Items),
+	}
+}
+
+// diffOrderEvents: one staff event per changed aspect of order, nothing if unchanged
+func diffOrderEvents(actorId string, before *dto.OrderResponseDto, after *dto.OrderResponseDto) []*dto.CreateOrderEventDto {
+	result := []*dto.CreateOrderEventDto{}
+	add := func(eventType string, beforeValue map[string]any, afterValue map[string]any) {
+		result = append(result, dto.NewCreateOrderEventDto(
+			eventType, constants.OrderEventActor.Staff, &actorId, beforeValue, afterValue))
+	}
+
+	if before.Status != after.Status {
+		add(constants.OrderEventType.StatusChanged,
+			map[string]any{"status": before.Status}, map[string]any{"status": after.Status})
+	}
+	if before.PaymentStatus != after.PaymentStatus || before.PaymentMethod != after.PaymentMethod {
+		add(constants.OrderEventType.PaymentChanged,
+			map[string]any{"paymentStatus": before.PaymentStatus, "paymentMethod": before.PaymentMethod},
+			map[string]any{"paymentStatus": after.PaymentStatus, "paymentMethod": after.PaymentMethod})
+	}
+	if before.DeliveryStatus != after.DeliveryStatus {
+		add(constants.OrderEventType.DeliveryChanged,
+			map[string]any{"deliveryStatus": before.DeliveryStatus}, map[string]any{"deliveryStatus": after.DeliveryStatus})
+	}
+	if before.TrackingNumber != after.TrackingNumber {
+		add(constants.OrderEventType.TrackingChanged,
+			map[string]any{"trackingNumber": before.TrackingNumber}, map[string]any{"trackingNumber": after.TrackingNumber})
+	}
+	if isOrderItemsChanged(before.Items, lo.Map(after.Items, func(item *ent.OrderItem, _ int) *dto.OrderItem {
+		return dto.NewOrderItem(utils.PtrOf(item.ProductID.String()), orderItemVariantId(item), nil, nil, utils.PtrOf(item.Quantity))
+	})) {
+		add(constants.OrderEventType.ItemsChanged,
+			map[string]any{"items": orderItemsEventValue(before.Items)}, map[string]any{"items": orderItemsEventValue(after.Items)})
+	}
+	if before.Discount != after.Discount || before.TotalAmount != after.TotalAmount {
+		add(constants.OrderEventType.PricingChanged,
+			map[string]any{"discount": before.Discount, "totalAmount": before.TotalAmount},
+			map[string]any{"discount": after.Discount, "totalAmount": after.TotalAmount})
+	}
+	if before.ShippingAddress != after.ShippingAddress {
+		add(constants.OrderEventType.AddressChanged,
+			map[string]any{"shippingAddress": before.ShippingAddress}, map[string]any{"shippingAddress": after.ShippingAddress})
+	}
+	if before.Remark != after.Remark {
+		add(constants.OrderEventType.NoteChanged,
+			map[string]any{"remark": before.Remark}, map[string]any{"remark": after.Remark})
+	}
+	return result
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"fmt"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// orderStatusTransitions: allowed next order status, staying in the same status is always allowed,
+// canceled and completed are final
+var orderStatusTransitions = map[string][]string{
+	constants.OrderStatus.Initiated: {
+		constants.OrderStatus.Confirmed,
+		constants.OrderStatus.Canceled,
+	},
+	constants.OrderStatus.Confirmed: {
+		constants.OrderStatus.Shipping,
+		constants.OrderStatus.Canceled,
+	},
+	constants.OrderStatus.Shipping: {
+		constants.OrderStatus.Completed,
+	},
+}
+
+// paymentStatusTransitions: allowed next payment status,
+// refunded, noRefund and voided are final
+var paymentStatusTransitions = map[string][]string{
+	constants.PaymentStatus.Pending: {
+		constants.PaymentStatus.Paid,
+		constants.PaymentStatus.Fail,
+		constants.PaymentStatus.Voided,
+	},
+	constants.PaymentStatus.Fail: {
+		constants.PaymentStatus.Pending,
+		constants.PaymentStatus.Paid,
+		constants.PaymentStatus.Voided,
+	},
+	constants.PaymentStatus.Paid: {
+		constants.PaymentStatus.Refunded,
+		constants.PaymentStatus.PartialRefunded,
+		constants.PaymentStatus.NoRefund,
+	},
+	constants.PaymentStatus.PartialRefunded: {
+		constants.PaymentStatus.Refunded,
+	},
+}
+
+// deliveryStatusTransitions: allowed next delivery status, completed is final
+var deliveryStatusTransitions = map[string][]string{
+	constants.DeliveryStatus.Pending: {
+		constants.DeliveryStatus.Shipping,
+	},
+	constants.DeliveryStatus.Shipping: {
+		constants.DeliveryStatus.Completed,
+	},
+}
+
+// isTransitionAllowed: unknown from status has no transition
+func isTransitionAllowed(transitions map[string][]string, from string, to string) bool {
+	return from == to || lo.Contains(transitions[from], to)
+}
+
+// invalidTransition: ErrInvalidTransition with description
+func invalidTransition(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", constants.ErrInvalidTransition, fmt.Sprintf(format, args...))
+}
+
+// isOrderItemsEditable: items can change before shipping only
+func isOrderItemsEditable(status string) bool {
+	return lo.Contains(constants.OrderStatus.GetItemsEditableList(), status)
+}
+
+// isOrderItemsChanged: compare product and quantity of items
+func isOrderItemsChanged(items []*ent.OrderItem, payload []*dto.OrderItem) bool {
+	if len(items) != len(payload) {
+		return true
+	}
+	quantities := lo.SliceToMap(items, func(item *ent.OrderItem) (string, int) { return orderItemLineKey(item), item.Quantity })
+	for _, item := range payload {
+		quantity, ok := quantities[item.LineKey()]
+		if !ok || quantity != *item.Quantity {
+			return true
+		}
+	}
+	return false
+}
+
+// checkOrderTransition: status moves must follow transition tables,
+// and the target states must agree with each other
+func checkOrderTransition(order *dto.OrderResponseDto, payload *dto.UpdateOrderDto) error {
+	status, paymentStatus, deliveryStatus := *payload.Status, *payload.PaymentStatus, *payload.DeliveryStatus
+	trackingNumber := strings.TrimSpace(*payload.TrackingNumber)
+
+	if !isTransitionAllowed(orderStatusTransitions, order.Status, status) {
+		return invalidTransition("order status can not change from %s to %s", order.Status, status)
+	}
+	if !isTransitionAllowed(paymentStatusTransitions, order.PaymentStatus, paymentStatus) {
+		return invalidTransition("payment status can not change from %s to %s", order.PaymentStatus, paymentStatus)
+	}
+	if !isTransitionAllowed(deliveryStatusTransitions, order.DeliveryStatus, deliveryStatus) {
+		return invalidTransition("delivery status can not change from %s to %s", order.DeliveryStatus, deliveryStatus)
+	}
+
+	// items are fixed once order is shipping, canceled or completed
+	if isOrderItemsChanged(order.Items, payload.Items) {
+		if !isOrderItemsEditable(order.Status) {
+			return invalidTransition("items of %s order can not be changed", order.Status)
+		}
+		if status == constants.OrderStatus.Canceled {
+			return invalidTransition("items can not be changed when canceling order")
+		}
+	}
+
+	// cross checks only when state changes, so legacy orders stay editable
+	isStateChanged := status != order.Status || paymentStatus != order.PaymentStatus ||
+		deliveryStatus != order.DeliveryStatus || trackingNumber != order.TrackingNumber
+	if !isStateChanged {
+		return nil
+	}
+	switch status {
+	case constants.OrderStatus.Initiated, constants.OrderStatus.Confirmed, constants.OrderStatus.Canceled:
+		if deliveryStatus != constants.DeliveryStatus.Pending {
+			return invalidTransition("delivery of %s order must be %s", status, constants.DeliveryStatus.Pending)
+		}
+	case constants.OrderStatus.Shipping:
+		if deliveryStatus != constants.DeliveryStatus.Shipping {
+			return invalidTransition("delivery of %s order must be %s", status, constants.DeliveryStatus.Shipping)
+		}
+	case constants.OrderStatus.Completed:
+		if deliveryStatus != constants.DeliveryStatus.Completed {
+			return invalidTransition("delivery of %s order must be %s", status, constants.DeliveryStatus.Completed)
+		}
+		if status != order.Status && paymentStatus != constants.PaymentStatus.Paid {
+			return invalidTransition("order can not be completed with payment %s", paymentStatus)
+		}
+	}
+	if deliveryStatus != constants.DeliveryStatus.Pending && trackingNumber == "" {
+		return invalidTransition("tracking number is required for %s delivery", deliveryStatus)
+	}
+	return nil
+}
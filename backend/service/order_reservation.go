@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// ReleaseExpiredReservations: cancel unpaid orders whose reservation expired and restock items,
+// one tx per order, return number of orders released
+func (orderSvc *OrderService) ReleaseExpiredReservations(ctx context.Context) (int, error) {
+	now := time.Now()
+	expiredOrders, err := orderSvc.orderRepo.GetReservationExpiredOrders(ctx, orderSvc.client, now, constants.StockReservationSweepBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, expiredOrder := range expiredOrders {
+		ok, err := orderSvc.releaseOrderReservation(ctx, expiredOrder.ID.String(), now)
+		if err != nil {
+			// keep releasing others, failed one is retried on next sweep
+			orderSvc.logger.Info("fail to releaseOrderReservation", zap.String("orderId", expiredOrder.ID.String()), zap.Error(err))
+			continue
+		}
+		if ok {
+			released++
+		}
+	}
+	return released, nil
+}
+
+// isReservationExpired: stock still held by unpaid order, pending or failed, with editable items past reservation
+func isReservationExpired(o *ent.Order, now time.Time) bool {
+	return o.ReservedUntil != nil && o.ReservedUntil.Before(now) &&
+		lo.Contains(constants.PaymentStatus.GetUnpaidList(), o.PaymentStatus) && isOrderItemsEditable(o.Status)
+}
+
+// releaseOrderReservation: false if order committed, released or edited meanwhile,
+// restocked from items read under stock locks
+func (orderSvc *OrderService) releaseOrderReservation(ctx context.Context, orderId string, now time.Time) (bool, error) {
+	released := false
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// read items to know stock to lock, staff edit may change them until locked
+		unlockedOrder, err := orderSvc.orderRepo.GetOrderById(ctx, txc, orderId)
+		if err != nil {
+			return err
+		}
+		productIds := lo.Uniq(lo.Map(unlockedOrder.Items, func(item *ent.OrderItem, _ int) string { return item.ProductID.String() }))
+
+		// lock products and variants before order row, same lock order as checkout and staff edit
+		_, err = lockStock(ctx, txc, orderSvc.productRepo, productIds)
+		if err != nil {
+			return err
+		}
+
+		// re-read under stock locks, staff edit committed meanwhile is seen and later ones wait
+		originalOrder, err := orderSvc.orderRepo.GetOrderById(ctx, txc, orderId)
+		if err != nil {
+			return err
+		}
+		if !isReservationExpired(originalOrder.Order, now) {
+			orderSvc.logger.Info("order reservation committed meanwhile", zap.String("orderId", orderId))
+			return nil
+		}
+		unlocked, _ := lo.Difference(
+			lo.Map(originalOrder.Items, func(item *ent.OrderItem, _ int) string { return item.ProductID.String() }), productIds)
+		if len(unlocked) > 0 {
+			orderSvc.logger.Info("order items edited meanwhile, release on next sweep", zap.String("orderId", orderId))
+			return nil
+		}
+
+		// call repo to cancel order, conditional on reservation still expired
+		rsOrder, err := orderSvc.orderRepo.ReleaseOrderReservationById(ctx, txc, orderId, now)
+		if errors.Is(err, constants.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// call repo to restock items
+		for _, item := range originalOrder.Items {
+			err = adjustStock(ctx, txc, orderSvc.productRepo, item.ProductID.String(), dto.NewCreateInventoryMovementDto(orderItemVariantId(item),
+				int32(item.Quantity), constants.InventoryReason.Released, orderId, nil, ""))
+			if err != nil {
+				return err
+			}
+		}
+
+		// call repo to record release by system
+		_, err = orderSvc.orderRepo.CreateOrderEvents(ctx, txc, orderId, []*dto.CreateOrderEventDto{
+			dto.NewCreateOrderEventDto(constants.OrderEventType.Released, constants.OrderEventActor.System, nil,
+				map[string]any{"status": originalOrder.Status, "paymentStatus": originalOrder.PaymentStatus},
+				map[string]any{"status": rsOrder.Status, "paymentStatus": rsOrder.PaymentStatus}),
+		})
+		if err != nil {
+			return err
+		}
+		released = true
+		return nil
+	}
+	err := orderSvc.orderRepo.WithTx(ctx, orderSvc.client, txFunc)
+	if err != nil {
+		return false, err
+	}
+	return released, nil
+}
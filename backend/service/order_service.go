@@ -5,7 +5,10 @@ import (
 	"sthl/constants"
 	"sthl/dto"
 	"sthl/ent"
+	"sthl/money"
 	"sthl/repository"
+	"sthl/utils"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -15,10 +18,14 @@ import (
 type IOrderService interface {
 	// private
 	CreateOrder(ctx context.Context, userId string, payload *dto.CreateOrderDto) (*dto.OrderResponseDto, error)
+	CreateShopOrder(ctx context.Context, userId string, payload *dto.CreateOrderDto) (*dto.OrderResponseDto, error)
 	GetOrders(ctx context.Context, userId string, payload *dto.QueryOrdersDto) (*dto.QueryOrdersResponseDto, error)
 	GetOrderById(ctx context.Context, userId string, orderId string) (*dto.OrderResponseDto, error)
-	UpdateOrderById(ctx context.Context, userId string, orderId string, payload *dto.UpdateOrderDto) (*dto.OrderResponseDto, error)
-	SoftDeleteOrderById(ctx context.Context, userId string, orderId string) (bool, error)
+	UpdateOrderById(ctx context.Context, userId string, orderId string, version int64, payload *dto.UpdateOrderDto) (*dto.OrderResponseDto, error)
+	SoftDeleteOrderById(ctx context.Context, userId string, orderId string, version int64) (bool, error)
+	GetOrderEvents(ctx context.Context, userId string, orderId string) ([]*ent.OrderEvent, error)
+	// system
+	ReleaseExpiredReservations(ctx context.Context) (int, error)
 }
 type OrderService struct {
 	logger      *zap.Logger
@@ -26,20 +33,23 @@ type OrderService struct {
 	userRepo    repository.IUserRepository
 	productRepo repository.IProductRepository
 	orderRepo   repository.IOrderRepository
+	shopRepo    repository.IShopRepository
 }
 
 func NewOrderService(logger *zap.Logger, client *ent.Client,
-	userRepo repository.IUserRepository, productRepo repository.IProductRepository, orderRepo repository.IOrderRepository) IOrderService {
+	userRepo repository.IUserRepository, productRepo repository.IProductRepository, orderRepo repository.IOrderRepository,
+	shopRepo repository.IShopRepository) IOrderService {
 	return &OrderService{
 		logger:      logger,
 		client:      client,
 		userRepo:    userRepo,
 		productRepo: productRepo,
 		orderRepo:   orderRepo,
+		shopRepo:    shopRepo,
 	}
 }
 
-// CreateOrder
+// CreateOrder: storefront checkout by customer at list price
 func (orderSvc *OrderService) CreateOrder(
 	ctx context.Context, userId string, payload *dto.CreateOrderDto) (*dto.OrderResponseDto, error) {
 	// validate
@@ -54,72 +64,201 @@ func (orderSvc *OrderService) CreateOrder(
 		return nil, constants.ErrBadRequest
 	}
 
+	// storefront order can not apply discount on its own
+	if *payload.Discount != money.RateOne {
+		orderSvc.logger.Info("client discount not allowed")
+		return nil, constants.ErrBadRequest
+	}
+
 	// call repo to createOrder with tx
 	var result *dto.OrderResponseDto
 	txFunc := func(tx *ent.Tx) error {
 		// extract tx client as ent.cient
 		txc := tx.Client()
 
-		// call repo to get all related products and check
-		for _, orderitem := range payload.Items {
-			product, err := orderSvc.productRepo.GetProductById(ctx, txc, *orderitem.ProductId)
-			if err != nil {
-				return err
-			}
+		result, err = orderSvc.placeOrder(ctx, txc, userId, payload, newOrderCreatedEvent)
+		return err
+	}
+	err = orderSvc.orderRepo.WithTx(ctx, orderSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
 
-			// check product belong user
-			if product.UserID.String() != userId {
-				return constants.ErrUnauthorized
-			}
+// CreateShopOrder: order entered by staff of active shop, discount allowed
+func (orderSvc *OrderService) CreateShopOrder(
+	ctx context.Context, userId string, payload *dto.CreateOrderDto) (*dto.OrderResponseDto, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		orderSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		orderSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
 
-			// check has sufficient quantity
-			if product.Quantity == 0 {
-				orderSvc.logger.Info("has no sufficient quantity to be purchase")
-				return constants.ErrBadRequest
-			}
-			// Check item.PaidPrice is equal to productDoc.Price
-			if product.Price != *orderitem.PurchasedPrice {
-				orderSvc.logger.Info("product price not equal item price")
-				return constants.ErrBadRequest
-			}
-			// Check item.Quantity is not valid
-			if product.Quantity < int32(*orderitem.Quantity) {
-				orderSvc.logger.Info("item quantity larfe than quantity")
-				return constants.ErrBadRequest
-			}
+	// call repo to createOrder with tx
+	var result *dto.OrderResponseDto
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
 
-			product.Quantity -= int32(*orderitem.Quantity)
-			updateProductPayload := dto.NewUpdateProductDto(
-				&product.Name, &product.Price, &product.Quantity, &product.Description, &product.Status, &product.ImgURL)
-			_, err = orderSvc.productRepo.UpdateProductById(ctx, txc, product.ID.String(), updateProductPayload)
-			if err != nil {
-				return err
-			}
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, orderSvc.logger, txc, orderSvc.shopRepo, userId, constants.ShopPermission.OrderWrite)
+		if err != nil {
+			return err
 		}
 
-		// call repo to create order row
-		mapped := payload.MapToSchema(
-			constants.OrderStatus.Initiated, constants.PaymentStatus.Pending, constants.DeliveryStatus.Pending, uuid.NewString())
-		rsOrder, err := orderSvc.orderRepo.CreateOrder(ctx, txc, userId, mapped)
+		result, err = orderSvc.placeOrder(ctx, txc, ownerId, payload, func(order *dto.OrderResponseDto) *dto.CreateOrderEventDto {
+			return newStaffOrderCreatedEvent(userId, order)
+		})
+		return err
+	}
+	err = orderSvc.orderRepo.WithTx(ctx, orderSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// placeOrder: check items against locked stock, price order on server and reserve stock for owner,
+// client total is informational only as tax and shipping are priced here, must be called with a tx client
+func (orderSvc *OrderService) placeOrder(ctx context.Context, txc *ent.Client, ownerId string, payload *dto.CreateOrderDto,
+	newEvent func(*dto.OrderResponseDto) *dto.CreateOrderEventDto) (*dto.OrderResponseDto, error) {
+	// check merchant email verified before accepting order, shop of unverified merchant
+	// takes no orders as its site cannot be published either; users from before
+	// verification was deployed are marked verified by storage.migrateVerifiedUsers
+	err := ensureEmailVerified(ctx, orderSvc.logger, txc, orderSvc.userRepo, ownerId)
+	if err != nil {
+		return nil, err
+	}
+
+	// check orders of merchant this month within plan
+	err = orderSvc.ensureOrderQuota(ctx, txc, ownerId)
+	if err != nil {
+		return nil, err
+	}
+
+	// lock all related products and variants before check, concurrent checkouts wait here
+	stock, err := lockStock(ctx, txc, orderSvc.productRepo,
+		lo.Map(payload.Items, func(item *dto.OrderItem, _ int) string { return *item.ProductId }))
+	if err != nil {
+		return nil, err
+	}
+
+	// check all related products, stock and price of variant if bought by variant
+	items := []*dto.OrderItem{}
+	lines := []orderLine{}
+	currency := ""
+	for _, orderitem := range payload.Items {
+		line, err := stock.sellableLine(*orderitem.ProductId, orderitem.VariantId)
 		if err != nil {
-			return err
+			orderSvc.logger.Info("fail to sellableLine", zap.Error(err))
+			return nil, err
+		}
+		product := line.product
+
+		// check product belong user
+		if product.UserID.String() != ownerId {
+			return nil, constants.ErrUnauthorized
+		}
+
+		// check has sufficient quantity
+		if line.quantity() == 0 {
+			orderSvc.logger.Info("has no sufficient quantity to be purchase")
+			return nil, constants.ErrBadRequest
 		}
+		// Check item.PaidPrice is equal to productDoc.Price
+		if line.price() != *orderitem.PurchasedPrice {
+			orderSvc.logger.Info("product price not equal item price")
+			return nil, constants.ErrBadRequest
+		}
+		// Check item.Quantity is not valid
+		if line.quantity() < int32(*orderitem.Quantity) {
+			orderSvc.logger.Info("item quantity larfe than quantity")
+			return nil, constants.ErrBadRequest
+		}
+		// check all items in one currency
+		if currency != "" && product.Currency != currency {
+			orderSvc.logger.Info("order items in different currencies")
+			return nil, constants.ErrBadRequest
+		}
+		currency = product.Currency
+
+		// snapshot name and price from product or variant
+		items = append(items, dto.NewOrderItem(orderitem.ProductId, orderitem.VariantId,
+			utils.PtrOf(line.name()), utils.PtrOf(line.price()), orderitem.Quantity))
+		lines = append(lines, orderLine{price: line.price(), quantity: *orderitem.Quantity})
+	}
+
+	// compute totals with shop tax and shipping, returned breakdown is what client pays
+	taxRate, shippingFee, err := shopPricing(ctx, orderSvc.logger, txc, orderSvc.shopRepo, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	breakdown := calculateOrderBreakdown(currency, lines, *payload.Discount, taxRate, shippingFee)
+	if *payload.TotalAmount != breakdown.TotalAmount {
+		orderSvc.logger.Info("ignore client total amount not equal server total amount",
+			zap.Stringer("client", *payload.TotalAmount), zap.Stringer("server", breakdown.TotalAmount))
+	}
+
+	// call repo to create order row, stock reserved until payment or expiry
+	reservedUntil := time.Now().Add(constants.StockReservationDuration)
+	mapped := payload.MapToSchema(items, breakdown,
+		constants.OrderStatus.Initiated, constants.PaymentStatus.Pending, constants.DeliveryStatus.Pending, "", &reservedUntil)
+	rsOrder, err := orderSvc.orderRepo.CreateOrder(ctx, txc, ownerId, mapped)
+	if err != nil {
+		return nil, err
+	}
 
-		// call repo to create order item rows
-		rsOrderItems, err := orderSvc.orderRepo.CreateOrderItems(ctx, txc, rsOrder.ID.String(), payload.Items)
+	// call repo to create order item rows
+	rsOrderItems, err := orderSvc.orderRepo.CreateOrderItems(ctx, txc, rsOrder.ID.String(), mapped.Items)
+	if err != nil {
+		return nil, err
+	}
+	result := dto.NewOrderResponseDto(rsOrder, rsOrderItems)
+
+	// call repo to take stock of locked products or variants, guarded against negative quantity
+	for _, item := range mapped.Items {
+		err = adjustStock(ctx, txc, orderSvc.productRepo, *item.ProductId, dto.NewCreateInventoryMovementDto(item.VariantId,
+			-int32(*item.Quantity), constants.InventoryReason.OrderPlaced, rsOrder.ID.String(), nil, ""))
 		if err != nil {
-			return err
+			return nil, err
 		}
-		result = dto.NewOrderResponseDto(rsOrder, rsOrderItems)
-		return nil
 	}
-	err = orderSvc.orderRepo.WithTx(ctx, orderSvc.client, txFunc)
+
+	// call repo to record order placed
+	_, err = orderSvc.orderRepo.CreateOrderEvents(ctx, txc, rsOrder.ID.String(), []*dto.CreateOrderEventDto{newEvent(result)})
 	if err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
+// ensureOrderQuota: orders of owner this month plus one within limit of plan,
+// owner locked so concurrent checkouts of shop counted in turn, must be called with a tx client.
+// cost: checkouts of one shop run one at a time from here to commit, other shops are not affected,
+// lock is taken before products so checkout and import agree on order owner, products, variants
+func (orderSvc *OrderService) ensureOrderQuota(ctx context.Context, client *ent.Client, ownerId string) error {
+	err := orderSvc.userRepo.LockUserById(ctx, client, ownerId)
+	if err != nil {
+		return err
+	}
+	limit, err := ownerPlanLimit(ctx, orderSvc.logger, client, orderSvc.userRepo, ownerId)
+	if err != nil {
+		return err
+	}
+	monthlyOrders, err := orderSvc.orderRepo.GetOrdersTotalByUserIdSince(ctx, client, ownerId, monthStart(time.Now()))
+	if err != nil {
+		return err
+	}
+	return ensureQuota(orderSvc.logger, ownerId, "monthly orders", int64(monthlyOrders), 1, int64(limit.MonthlyOrders))
+}
+
 // GetOrders
 func (orderSvc *OrderService) GetOrders(
 	ctx context.Context, userId string, payload *dto.QueryOrdersDto) (*dto.QueryOrdersResponseDto, error) {
@@ -138,7 +277,14 @@ func (orderSvc *OrderService) GetOrders(
 	txFunc := func(tx *ent.Tx) error {
 		// extract tx client as ent.cient
 		txc := tx.Client()
-		rs, err := orderSvc.orderRepo.GetOrders(ctx, txc, userId, ensuredPayload)
+
+		// check permission in active shop, orders belong to shop owner
+		ownerId, err := authorizeShop(ctx, orderSvc.logger, txc, orderSvc.shopRepo, userId, constants.ShopPermission.OrderRead)
+		if err != nil {
+			return err
+		}
+
+		rs, err := orderSvc.orderRepo.GetOrders(ctx, txc, ownerId, ensuredPayload)
 		if err != nil {
 			return err
 		}
@@ -173,12 +319,20 @@ func (orderSvc *OrderService) GetOrderById(
 	txFunc := func(tx *ent.Tx) error {
 		// extract tx client as ent.cient
 		txc := tx.Client()
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, orderSvc.logger, txc, orderSvc.shopRepo, userId, constants.ShopPermission.OrderRead)
+		if err != nil {
+			return err
+		}
+
 		rs, err := orderSvc.orderRepo.GetOrderById(ctx, txc, orderId)
 		if err != nil {
 			return err
 		}
 
-		if rs.UserID.String() != userId {
+		// check order belong to shop
+		if rs.UserID.String() != ownerId {
 			return constants.ErrUnauthorized
 		}
 		result = rs
@@ -193,7 +347,7 @@ func (orderSvc *OrderService) GetOrderById(
 
 // UpdateOrderById
 func (orderSvc *OrderService) UpdateOrderById(
-	ctx context.Context, userId string, orderId string, payload *dto.UpdateOrderDto) (*dto.OrderResponseDto, error) {
+	ctx context.Context, userId string, orderId string, version int64, payload *dto.UpdateOrderDto) (*dto.OrderResponseDto, error) {
 	// validate
 	_, err := uuid.Parse(userId)
 	if err != nil {
@@ -215,24 +369,57 @@ func (orderSvc *OrderService) UpdateOrderById(
 	txFunc := func(tx *ent.Tx) error {
 		// extract tx client as ent.cient
 		txc := tx.Client()
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, orderSvc.logger, txc, orderSvc.shopRepo, userId, constants.ShopPermission.OrderWrite)
+		if err != nil {
+			return err
+		}
+
 		originalOrder, err := orderSvc.orderRepo.GetOrderById(ctx, txc, orderId)
 		if err != nil {
 			return err
 		}
 
-		// **handle update order item
-		originalOrderItemProductIds := lo.Map(originalOrder.Items, func(item *ent.OrderItem, _ int) string { return item.ProductID.String() })
-		newsetOrderItemProductIds := lo.Map(payload.Items, func(item *dto.OrderItem, _ int) string { return *item.ProductId })
+		// check order belong to shop
+		if originalOrder.UserID.String() != ownerId {
+			return constants.ErrUnauthorized
+		}
+
+		// check client edited latest version, repo update is conditional as well
+		if originalOrder.Version != version {
+			return constants.ErrPreconditionFailed
+		}
+
+		// check status transitions
+		err = checkOrderTransition(originalOrder, payload)
+		if err != nil {
+			orderSvc.logger.Info("fail to checkOrderTransition", zap.Error(err))
+			return err
+		}
+
+		// **handle update order item, lines keyed by product and variant
+		originalOrderItemKeys := lo.Map(originalOrder.Items, func(item *ent.OrderItem, _ int) string { return orderItemLineKey(item) })
+		newsetOrderItemKeys := lo.Map(payload.Items, func(item *dto.OrderItem, _ int) string { return item.LineKey() })
+
+		// lock products and variants of original and newest items before any stock change
+		stock, err := lockStock(ctx, txc, orderSvc.productRepo, lo.Union(
+			lo.Map(originalOrder.Items, func(item *ent.OrderItem, _ int) string { return item.ProductID.String() }),
+			lo.Map(payload.Items, func(item *dto.OrderItem, _ int) string { return *item.ProductId })))
+		if err != nil {
+			return err
+		}
 		for _, originalOrderItem := range originalOrder.Items {
 			originalOrderItemId := originalOrderItem.ID.String()
 			originalOrderItemProductId := originalOrderItem.ProductID.String()
-			// call repo to get product by id
-			orderitemProduct, err := orderSvc.productRepo.GetProductById(ctx, txc, originalOrderItemProductId)
+			originalOrderItemVariantId := orderItemVariantId(originalOrderItem)
+			originalOrderItemKey := orderItemLineKey(originalOrderItem)
+			orderitemLine, err := stock.line(originalOrderItemProductId, originalOrderItemVariantId)
 			if err != nil {
 				return err
 			}
 
-			isDelete := !lo.Contains(newsetOrderItemProductIds, originalOrderItemProductId)
+			isDelete := !lo.Contains(newsetOrderItemKeys, originalOrderItemKey)
 			// delete case
 			if isDelete {
 				// call repo to delete order item by id
@@ -241,26 +428,22 @@ func (orderSvc *OrderService) UpdateOrderById(
 					return err
 				}
 
-				// call repo to update back quantity for product
-				updateProductPayload := dto.NewUpdateProductDto(
-					&orderitemProduct.Name, &orderitemProduct.Price, &orderitemProduct.Quantity, &orderitemProduct.Description, &orderitemProduct.Status,
-					&orderitemProduct.ImgURL,
-				)
-				*updateProductPayload.Quantity += int32(originalOrderItem.Quantity)
-				_, err = orderSvc.productRepo.UpdateProductById(ctx, txc, originalOrderItemProductId, updateProductPayload)
+				// call repo to update back quantity for product or variant
+				err = adjustStock(ctx, txc, orderSvc.productRepo, originalOrderItemProductId, dto.NewCreateInventoryMovementDto(originalOrderItemVariantId,
+					int32(originalOrderItem.Quantity), constants.InventoryReason.OrderEdited, orderId, &userId, ""))
 				if err != nil {
 					return err
 				}
 			} else { // update case
-				//  find  by orderitemProductId
-				orderItemFromPayload, ok := lo.Find(payload.Items, func(item *dto.OrderItem) bool { return *item.ProductId == originalOrderItemProductId })
+				//  find  by line key
+				orderItemFromPayload, ok := lo.Find(payload.Items, func(item *dto.OrderItem) bool { return item.LineKey() == originalOrderItemKey })
 				if !ok {
 					return constants.ErrBadRequest
 				}
 
 				// create NewOrderItem and validate
 				updateOrderItemPayload := dto.NewOrderItem(
-					&originalOrderItemId, &originalOrderItem.PurchasedName, &originalOrderItem.PurchasedPrice, orderItemFromPayload.Quantity,
+					&originalOrderItemId, originalOrderItemVariantId, &originalOrderItem.PurchasedName, &originalOrderItem.PurchasedPrice, orderItemFromPayload.Quantity,
 				)
 				err := updateOrderItemPayload.Validate()
 				if err != nil {
@@ -271,12 +454,12 @@ func (orderSvc *OrderService) UpdateOrderById(
 				quantityDiff := *orderItemFromPayload.Quantity - originalOrderItem.Quantity
 
 				// check has sufficient quantity
-				if orderitemProduct.Quantity == 0 && quantityDiff > 0 {
+				if orderitemLine.quantity() == 0 && quantityDiff > 0 {
 					orderSvc.logger.Info("has no sufficient quantity to be purchase")
 					return constants.ErrBadRequest
 				}
 				// check item.Quantity is not valid
-				if orderitemProduct.Quantity < int32(quantityDiff) {
+				if orderitemLine.quantity() < int32(quantityDiff) {
 					orderSvc.logger.Info("item quantity larfe than quantity")
 					return constants.ErrBadRequest
 				}
@@ -287,13 +470,11 @@ func (orderSvc *OrderService) UpdateOrderById(
 					return err
 				}
 
-				// call repo to update back quantity for product
-				updateProductPayload := dto.NewUpdateProductDto(
-					&orderitemProduct.Name, &orderitemProduct.Price, &orderitemProduct.Quantity, &orderitemProduct.Description, &orderitemProduct.Status,
-					&orderitemProduct.ImgURL,
-				)
-				*updateProductPayload.Quantity = *updateProductPayload.Quantity - int32(quantityDiff)
-				_, err = orderSvc.productRepo.UpdateProductById(ctx, txc, originalOrderItemProductId, updateProductPayload)
+				// call repo to update back quantity for product or variant
+				if quantityDiff != 0 {
+					err = adjustStock(ctx, txc, orderSvc.productRepo, originalOrderItemProductId, dto.NewCreateInventoryMovementDto(originalOrderItemVariantId,
+						-int32(quantityDiff), constants.InventoryReason.OrderEdited, orderId, &userId, ""))
+				}
 				if err != nil {
 					return err
 				}
@@ -301,21 +482,90 @@ func (orderSvc *OrderService) UpdateOrderById(
 		}
 
 		// create case
-		orderitemsToCreate := lo.FilterMap(payload.Items, func(item *dto.OrderItem, _ int) (*dto.OrderItem, bool) {
-			isCreate := !lo.Contains(originalOrderItemProductIds, *item.ProductId)
-			if isCreate {
-				return item, true
+		orderitemsToCreate := []*dto.OrderItem{}
+		for _, item := range payload.Items {
+			if lo.Contains(originalOrderItemKeys, item.LineKey()) {
+				continue
 			}
-			return nil, false
-		})
+			line, err := stock.sellableLine(*item.ProductId, item.VariantId)
+			if err != nil {
+				orderSvc.logger.Info("fail to sellableLine", zap.Error(err))
+				return err
+			}
+			product := line.product
+
+			// check product belong to shop and in order currency
+			if product.UserID.String() != ownerId {
+				return constants.ErrUnauthorized
+			}
+			if product.Currency != originalOrder.Currency {
+				orderSvc.logger.Info("product currency not equal order currency")
+				return constants.ErrBadRequest
+			}
+			// check has sufficient quantity
+			if line.quantity() < int32(*item.Quantity) {
+				orderSvc.logger.Info("item quantity larfe than quantity")
+				return constants.ErrBadRequest
+			}
+
+			// call repo to take stock, guarded against negative quantity
+			err = adjustStock(ctx, txc, orderSvc.productRepo, product.ID.String(), dto.NewCreateInventoryMovementDto(item.VariantId,
+				-int32(*item.Quantity), constants.InventoryReason.OrderEdited, orderId, &userId, ""))
+			if err != nil {
+				return err
+			}
+
+			// snapshot name and price from product or variant
+			orderitemsToCreate = append(orderitemsToCreate, dto.NewOrderItem(item.ProductId, item.VariantId,
+				utils.PtrOf(line.name()), utils.PtrOf(line.price()), item.Quantity))
+		}
 		// call repo to create order items
 		_, err = orderSvc.orderRepo.CreateOrderItems(ctx, txc, orderId, orderitemsToCreate)
 		if err != nil {
 			return err
 		}
 
+		// **handle cancel, restock items
+		if *payload.Status == constants.OrderStatus.Canceled && originalOrder.Status != constants.OrderStatus.Canceled {
+			for _, item := range originalOrder.Items {
+				err := adjustStock(ctx, txc, orderSvc.productRepo, item.ProductID.String(), dto.NewCreateInventoryMovementDto(orderItemVariantId(item),
+					int32(item.Quantity), constants.InventoryReason.OrderCanceled, orderId, &userId, ""))
+				if err != nil {
+					return err
+				}
+			}
+		}
+
 		// **handle update order
-		_, err = orderSvc.orderRepo.UpdateOrderById(ctx, txc, orderId, payload)
+		_, err = orderSvc.orderRepo.UpdateOrderById(ctx, txc, orderId, version, payload)
+		if err != nil {
+			return err
+		}
+
+		// **handle reservation, payment or shipping commits reserved stock and cancel has restocked it
+		if originalOrder.ReservedUntil != nil &&
+			(*payload.PaymentStatus == constants.PaymentStatus.Paid || !isOrderItemsEditable(*payload.Status)) {
+			_, err = orderSvc.orderRepo.UpdateOrderReservedUntilById(ctx, txc, orderId, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		// **handle order totals, recomputed from item snapshots with tax and shipping of the order
+		updatedOrder, err := orderSvc.orderRepo.GetOrderById(ctx, txc, orderId)
+		if err != nil {
+			return err
+		}
+		lines := lo.Map(updatedOrder.Items, func(item *ent.OrderItem, _ int) orderLine {
+			return orderLine{price: item.PurchasedPrice, quantity: item.Quantity}
+		})
+		breakdown := calculateOrderBreakdown(
+			updatedOrder.Currency, lines, *payload.Discount, updatedOrder.TaxRate, updatedOrder.ShippingFee)
+		if *payload.TotalAmount != breakdown.TotalAmount {
+			orderSvc.logger.Info("ignore client total amount not equal server total amount",
+				zap.Stringer("client", *payload.TotalAmount), zap.Stringer("server", breakdown.TotalAmount))
+		}
+		_, err = orderSvc.orderRepo.UpdateOrderTotalsById(ctx, txc, orderId, breakdown)
 		if err != nil {
 			return err
 		}
@@ -325,6 +575,15 @@ func (orderSvc *OrderService) UpdateOrderById(
 		if err != nil {
 			return err
 		}
+
+		// call repo to record changes with actor
+		events := diffOrderEvents(userId, originalOrder, orderResp)
+		if len(events) > 0 {
+			_, err = orderSvc.orderRepo.CreateOrderEvents(ctx, txc, orderId, events)
+			if err != nil {
+				return err
+			}
+		}
 		result = orderResp
 		return nil
 	}
@@ -337,7 +596,7 @@ func (orderSvc *OrderService) UpdateOrderById(
 
 // SoftDeleteOrderById
 func (orderSvc *OrderService) SoftDeleteOrderById(
-	ctx context.Context, userId string, orderId string) (bool, error) {
+	ctx context.Context, userId string, orderId string, version int64) (bool, error) {
 	_, err := uuid.Parse(userId)
 	if err != nil {
 		orderSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
@@ -353,6 +612,12 @@ func (orderSvc *OrderService) SoftDeleteOrderById(
 		// extract tx client as ent.cient
 		txc := tx.Client()
 
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, orderSvc.logger, txc, orderSvc.shopRepo, userId, constants.ShopPermission.OrderWrite)
+		if err != nil {
+			return err
+		}
+
 		// call repo to get original order
 		originalOrder, err := orderSvc.orderRepo.GetOrderById(ctx, txc, orderId)
 		if err != nil {
@@ -360,15 +625,27 @@ func (orderSvc *OrderService) SoftDeleteOrderById(
 		}
 
 		// checking
-		if originalOrder.UserID.String() != userId {
+		if originalOrder.UserID.String() != ownerId {
 			return constants.ErrUnauthorized
 		}
 		if originalOrder.IsArchived {
 			return constants.ErrBadRequest
 		}
+		if originalOrder.Version != version {
+			return constants.ErrPreconditionFailed
+		}
 
 		// call repo to soft delete order
-		_, err = orderSvc.orderRepo.SoftDeleteOrderById(ctx, txc, orderId)
+		_, err = orderSvc.orderRepo.SoftDeleteOrderById(ctx, txc, orderId, version)
+		if err != nil {
+			return err
+		}
+
+		// call repo to record archive with actor
+		_, err = orderSvc.orderRepo.CreateOrderEvents(ctx, txc, orderId, []*dto.CreateOrderEventDto{
+			dto.NewCreateOrderEventDto(constants.OrderEventType.Archived, constants.OrderEventActor.Staff, &userId,
+				map[string]any{"isArchived": false}, map[string]any{"isArchived": true}),
+		})
 		if err != nil {
 			return err
 		}
@@ -380,3 +657,53 @@ func (orderSvc *OrderService) SoftDeleteOrderById(
 	}
 	return true, nil
 }
+
+// GetOrderEvents: timeline of order, oldest first
+func (orderSvc *OrderService) GetOrderEvents(
+	ctx context.Context, userId string, orderId string) ([]*ent.OrderEvent, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		orderSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(orderId)
+	if err != nil {
+		orderSvc.logger.Info("fail to parse orderId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	var result []*ent.OrderEvent
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, orderSvc.logger, txc, orderSvc.shopRepo, userId, constants.ShopPermission.OrderRead)
+		if err != nil {
+			return err
+		}
+
+		order, err := orderSvc.orderRepo.GetOrderById(ctx, txc, orderId)
+		if err != nil {
+			return err
+		}
+
+		// check order belong to shop
+		if order.UserID.String() != ownerId {
+			return constants.ErrUnauthorized
+		}
+
+		rs, err := orderSvc.orderRepo.GetOrderEventsByOrderId(ctx, txc, orderId)
+		if err != nil {
+			return err
+		}
+		result = rs
+		return nil
+	}
+	err = orderSvc.orderRepo.WithTx(ctx, orderSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
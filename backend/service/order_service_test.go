@@ -2,13 +2,17 @@ package service
 
 import (
 	"context"
+	"sthl/authentication"
 	"sthl/constants"
 	"sthl/dto"
 	"sthl/ent"
+	"sthl/ent/schema"
 	"sthl/logger"
+	"sthl/money"
 	"sthl/repository"
 	"sthl/utils"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/google/uuid"
@@ -28,20 +32,29 @@ func orderServiceTestSetup(ctx context.Context, t *testing.T) (*assert.Assertion
 	userRepo := repository.NewUserRepositoryMock()
 	productRepo := repository.NewProductRepositoryMock()
 	orderRepo := repository.NewOrderRepositoryMock()
-	orderSvc := NewOrderService(zapLogger, nil, userRepo, productRepo, orderRepo)
+	shopRepo := repository.NewShopRepositoryMock()
+	orderSvc := NewOrderService(zapLogger, nil, userRepo, productRepo, orderRepo, shopRepo)
 	assert.NotEmpty(userRepo)
 	assert.NotEmpty(productRepo)
 	assert.NotEmpty(orderRepo)
 	assert.NotEmpty(orderSvc)
 
-	// pre
-	validUserId := uuid.NewString()
+	// pre verified merchant
+	hashedPw, err := authentication.HashPassword(gofakeit.Password(true, true, true, true, false, 6))
+	assert.NoError(err)
+	validUser, err := userRepo.CreateUser(ctx, nil,
+		dto.NewCreateUserDto(utils.PtrOf(gofakeit.Email()), nil).MapToSchema(hashedPw))
+	assert.NoError(err)
+	_, err = userRepo.UpdateUserEmailVerifiedById(ctx, nil, validUser.ID.String(), true)
+	assert.NoError(err)
+	validUserId := validUser.ID.String()
 	validCreateProductDto := dto.NewCreateProductDto(
 		utils.PtrOf(gofakeit.Fruit()),
-		utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 		utils.PtrOf(int32(gofakeit.IntRange(100000, 1000000))),
 		utils.PtrOf(gofakeit.LetterN(100)),
-		utils.PtrOf(gofakeit.LetterN(100)))
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil)
 	p1, err := productRepo.CreateProduct(ctx, nil, validUserId, validCreateProductDto.MapToSchema(constants.ProductStatus.Active))
 	assert.NotEmpty(validUserId)
 	assert.NotEmpty(validCreateProductDto)
@@ -56,7 +69,7 @@ func preCreateOrder(
 	ctx context.Context, assert *assert.Assertions, orderSvc IOrderService, validUserId string, product *ent.Product) *dto.OrderResponseDto {
 	// pre create order
 	validCreateOrderItems := dto.NewOrderItem(
-		utils.PtrOf(product.ID.String()),
+		utils.PtrOf(product.ID.String()), nil,
 		utils.PtrOf(product.Name),
 		utils.PtrOf(product.Price),
 		utils.PtrOf(2),
@@ -64,8 +77,8 @@ func preCreateOrder(
 	validCreateOrderDto := dto.NewCreateOrderDto(
 		[]*dto.OrderItem{validCreateOrderItems},
 		utils.PtrOf(gofakeit.LetterN(100)),
-		utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-		utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+		utils.PtrOf(money.RateOne),
+		utils.PtrOf(product.Price.Mul(2)),
 		utils.PtrOf(constants.PaymentMethod.Card),
 		utils.PtrOf(gofakeit.Address().Address),
 	)
@@ -91,13 +104,13 @@ func Test_CreateOrder(t *testing.T) {
 	assert, orderSvc, validUserId, p1 := orderServiceTestSetup(ctx, t)
 	nonExistUserId := uuid.NewString()
 	validCreateOrderItems := dto.NewOrderItem(
-		utils.PtrOf(p1.ID.String()),
+		utils.PtrOf(p1.ID.String()), nil,
 		utils.PtrOf(p1.Name),
 		utils.PtrOf(p1.Price),
 		utils.PtrOf(2),
 	)
 	nonExistCreateOrderItem := dto.NewOrderItem(
-		utils.PtrOf(uuid.NewString()),
+		utils.PtrOf(uuid.NewString()), nil,
 		utils.PtrOf(p1.Name),
 		utils.PtrOf(p1.Price),
 		utils.PtrOf(2),
@@ -105,16 +118,16 @@ func Test_CreateOrder(t *testing.T) {
 	validCreateOrderDto := dto.NewCreateOrderDto(
 		[]*dto.OrderItem{validCreateOrderItems},
 		utils.PtrOf(gofakeit.LetterN(100)),
-		utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-		utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+		utils.PtrOf(money.RateOne),
+		utils.PtrOf(p1.Price.Mul(2)),
 		utils.PtrOf(constants.PaymentMethod.Card),
 		utils.PtrOf(gofakeit.Address().Address),
 	)
 	invalidCreateOrderDto := dto.NewCreateOrderDto(
 		[]*dto.OrderItem{nonExistCreateOrderItem},
 		utils.PtrOf(gofakeit.LetterN(100)),
-		utils.PtrOf(gofakeit.Float64Range(0.1, 1.0)),
-		utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+		utils.PtrOf(money.RateOne),
+		utils.PtrOf(p1.Price.Mul(2)),
 		utils.PtrOf(constants.PaymentMethod.Card),
 		utils.PtrOf(gofakeit.Address().Address),
 	)
@@ -155,6 +168,205 @@ func Test_CreateOrder(t *testing.T) {
 	}
 }
 
+// ****Test_CreateOrderUnverifiedMerchant
+func Test_CreateOrderUnverifiedMerchant(t *testing.T) {
+	ctx := context.TODO()
+	assert := assert.New(t)
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NoError(err)
+	userRepo := repository.NewUserRepositoryMock()
+	productRepo := repository.NewProductRepositoryMock()
+	orderSvc := NewOrderService(zapLogger, nil, userRepo, productRepo, repository.NewOrderRepositoryMock(), repository.NewShopRepositoryMock())
+
+	// pre unverified merchant with product
+	hashedPw, err := authentication.HashPassword(gofakeit.Password(true, true, true, true, false, 6))
+	assert.NoError(err)
+	unverifiedUser, err := userRepo.CreateUser(ctx, nil,
+		dto.NewCreateUserDto(utils.PtrOf(gofakeit.Email()), nil).MapToSchema(hashedPw))
+	assert.NoError(err)
+	p1, err := productRepo.CreateProduct(ctx, nil, unverifiedUser.ID.String(), dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+		utils.PtrOf(int32(gofakeit.IntRange(100000, 1000000))),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil).MapToSchema(constants.ProductStatus.Active))
+	assert.NoError(err)
+
+	result, err := orderSvc.CreateOrder(ctx, unverifiedUser.ID.String(), dto.NewCreateOrderDto(
+		[]*dto.OrderItem{dto.NewOrderItem(utils.PtrOf(p1.ID.String()), nil, utils.PtrOf(p1.Name), utils.PtrOf(p1.Price), utils.PtrOf(2))},
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(money.RateOne),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+		utils.PtrOf(constants.PaymentMethod.Card),
+		utils.PtrOf(gofakeit.Address().Address),
+	))
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrForbidden)
+}
+
+// ****Test_CreateOrderQuota
+func Test_CreateOrderQuota(t *testing.T) {
+	ctx := context.TODO()
+	assert := assert.New(t)
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NoError(err)
+	userRepo := repository.NewUserRepositoryMock()
+	productRepo := repository.NewProductRepositoryMock()
+	orderSvc := NewOrderService(zapLogger, nil, userRepo, productRepo, repository.NewOrderRepositoryMock(), repository.NewShopRepositoryMock())
+
+	// pre verified merchant on free plan with product
+	hashedPw, err := authentication.HashPassword(gofakeit.Password(true, true, true, true, false, 6))
+	assert.NoError(err)
+	validUser, err := userRepo.CreateUser(ctx, nil,
+		dto.NewCreateUserDto(utils.PtrOf(gofakeit.Email()), nil).MapToSchema(hashedPw))
+	assert.NoError(err)
+	_, err = userRepo.UpdateUserEmailVerifiedById(ctx, nil, validUser.ID.String(), true)
+	assert.NoError(err)
+	p1, err := productRepo.CreateProduct(ctx, nil, validUser.ID.String(), dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+		utils.PtrOf(int32(gofakeit.IntRange(100000, 1000000))),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil).MapToSchema(constants.ProductStatus.Active))
+	assert.NoError(err)
+	createOrder := func() (*dto.OrderResponseDto, error) {
+		return orderSvc.CreateOrder(ctx, validUser.ID.String(), dto.NewCreateOrderDto(
+			[]*dto.OrderItem{dto.NewOrderItem(utils.PtrOf(p1.ID.String()), nil, utils.PtrOf(p1.Name), utils.PtrOf(p1.Price), utils.PtrOf(1))},
+			utils.PtrOf(gofakeit.LetterN(100)),
+			utils.PtrOf(money.RateOne),
+			utils.PtrOf(p1.Price),
+			utils.PtrOf(constants.PaymentMethod.Card),
+			utils.PtrOf(gofakeit.Address().Address),
+		))
+	}
+
+	// orders up to monthly limit of free plan
+	for i := 0; i < constants.Plan.GetLimit(constants.Plan.Free).MonthlyOrders; i++ {
+		_, err = createOrder()
+		assert.NoError(err)
+	}
+	result, err := createOrder()
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrQuotaExceeded)
+
+	// pro plan has higher limit
+	_, err = userRepo.UpdateUserPlanById(ctx, nil, validUser.ID.String(), constants.Plan.Pro)
+	assert.NoError(err)
+	result, err = createOrder()
+	assert.NotEmpty(result)
+	assert.NoError(err)
+}
+
+// ****Test_CreateOrderPricing
+func Test_CreateOrderPricing(t *testing.T) {
+	ctx := context.TODO()
+	assert, orderSvc, validUserId, p1 := orderServiceTestSetup(ctx, t)
+	newCreateOrderDto := func(name string, discount money.Rate, totalAmount money.Amount) *dto.CreateOrderDto {
+		return dto.NewCreateOrderDto(
+			[]*dto.OrderItem{dto.NewOrderItem(utils.PtrOf(p1.ID.String()), nil, utils.PtrOf(name), utils.PtrOf(p1.Price), utils.PtrOf(2))},
+			utils.PtrOf(gofakeit.LetterN(100)),
+			utils.PtrOf(discount),
+			utils.PtrOf(totalAmount),
+			utils.PtrOf(constants.PaymentMethod.Card),
+			utils.PtrOf(gofakeit.Address().Address),
+		)
+	}
+	subtotal := p1.Price.Mul(2)
+
+	// client discount is rejected
+	result, err := orderSvc.CreateOrder(ctx, validUserId, newCreateOrderDto(p1.Name, money.Rate(500000), subtotal.ApplyRate(money.Rate(500000))))
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// purchased name is snapshotted from product, client total is ignored for server total
+	result, err = orderSvc.CreateOrder(ctx, validUserId, newCreateOrderDto("tampered name", money.RateOne, subtotal-1))
+	assert.NotEmpty(result)
+	assert.NoError(err)
+	assert.Equal(p1.Name, result.Items[0].PurchasedName)
+	assert.Equal(subtotal, result.Breakdown.Subtotal)
+	assert.Equal(subtotal, result.Breakdown.TotalAmount)
+	assert.Equal(subtotal, result.TotalAmount)
+	assert.Equal(money.Amount(0), result.Breakdown.DiscountAmount)
+	assert.Equal(p1.Currency, result.Currency)
+
+	// staff order applies discount
+	result, err = orderSvc.CreateShopOrder(ctx, validUserId, newCreateOrderDto(p1.Name, money.Rate(500000), 0))
+	assert.NotEmpty(result)
+	assert.NoError(err)
+	assert.Equal(money.Rate(500000), result.Discount)
+	assert.Equal(subtotal.ApplyRate(money.Rate(500000)), result.TotalAmount)
+}
+
+// ****Test_CreateOrderShopPricing
+func Test_CreateOrderShopPricing(t *testing.T) {
+	ctx := context.TODO()
+	assert := assert.New(t)
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NoError(err)
+	userRepo := repository.NewUserRepositoryMock()
+	productRepo := repository.NewProductRepositoryMock()
+	shopRepo := repository.NewShopRepositoryMock()
+	orderSvc := NewOrderService(zapLogger, nil, userRepo, productRepo, repository.NewOrderRepositoryMock(), shopRepo)
+
+	// pre verified merchant with shop pricing and product
+	hashedPw, err := authentication.HashPassword(gofakeit.Password(true, true, true, true, false, 6))
+	assert.NoError(err)
+	merchant, err := userRepo.CreateUser(ctx, nil,
+		dto.NewCreateUserDto(utils.PtrOf(gofakeit.Email()), nil).MapToSchema(hashedPw))
+	assert.NoError(err)
+	_, err = userRepo.UpdateUserEmailVerifiedById(ctx, nil, merchant.ID.String(), true)
+	assert.NoError(err)
+	shop, err := shopRepo.CreateShop(ctx, nil, merchant.ID.String(), gofakeit.Company())
+	assert.NoError(err)
+	_, err = shopRepo.UpdateShopPricingById(ctx, nil, shop.ID.String(), dto.NewUpdateShopPricingDto(utils.PtrOf(money.Rate(100000)), utils.PtrOf(money.Amount(500))))
+	assert.NoError(err)
+	p1, err := productRepo.CreateProduct(ctx, nil, merchant.ID.String(), dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()),
+		utils.PtrOf(money.Amount(2000)),
+		utils.PtrOf(int32(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil).MapToSchema(constants.ProductStatus.Active))
+	assert.NoError(err)
+
+	// 2 * 20 + 10% tax + 5 shipping
+	result, err := orderSvc.CreateOrder(ctx, merchant.ID.String(), dto.NewCreateOrderDto(
+		[]*dto.OrderItem{dto.NewOrderItem(utils.PtrOf(p1.ID.String()), nil, utils.PtrOf(p1.Name), utils.PtrOf(p1.Price), utils.PtrOf(2))},
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(money.RateOne),
+		utils.PtrOf(money.Amount(4900)),
+		utils.PtrOf(constants.PaymentMethod.Card),
+		utils.PtrOf(gofakeit.Address().Address),
+	))
+	assert.NotEmpty(result)
+	assert.NoError(err)
+	assert.Equal(money.Amount(4000), result.Breakdown.Subtotal)
+	assert.Equal(money.Amount(400), result.Breakdown.TaxAmount)
+	assert.Equal(money.Amount(500), result.Breakdown.ShippingFee)
+	assert.Equal(money.Amount(4900), result.TotalAmount)
+
+	// staff discount recomputes total, client total is ignored
+	result, err = orderSvc.UpdateOrderById(ctx, merchant.ID.String(), result.ID.String(), result.Version, dto.NewUpdateOrderDto(
+		[]*dto.OrderItem{dto.NewOrderItem(utils.PtrOf(p1.ID.String()), nil, utils.PtrOf(p1.Name), utils.PtrOf(p1.Price), utils.PtrOf(2))},
+		&result.Remark,
+		utils.PtrOf(money.Rate(500000)),
+		utils.PtrOf(money.Amount(100)),
+		&result.Status,
+		&result.PaymentStatus,
+		&result.PaymentMethod,
+		&result.DeliveryStatus,
+		&result.ShippingAddress,
+		&result.TrackingNumber,
+	))
+	assert.NotEmpty(result)
+	assert.NoError(err)
+	assert.Equal(money.Amount(2000), result.Breakdown.DiscountAmount)
+	assert.Equal(money.Amount(200), result.Breakdown.TaxAmount)
+	assert.Equal(money.Amount(2700), result.TotalAmount)
+}
+
 // ****Test_GetOrders
 type getOrdersTestCase struct {
 	name   string
@@ -291,6 +503,7 @@ type updateOrderByIdTestCase struct {
 	name    string
 	userId  string
 	orderId string
+	version int64
 	input   *dto.UpdateOrderDto
 	exec    func(*dto.OrderResponseDto, error)
 }
@@ -305,8 +518,8 @@ func Test_UpdateOrderById(t *testing.T) {
 	preOrder1 := preCreateOrder(ctx, assert, orderSvc, validUserId, p1)
 	validUpdateOrderDto := dto.NewUpdateOrderDto(
 		lo.Map(preOrder1.Items, func(item *ent.OrderItem, _ int) *dto.OrderItem {
-			id := item.ID.String()
-			return dto.NewOrderItem(&id, &item.PurchasedName, &item.PurchasedPrice, &item.Quantity)
+			productId := item.ProductID.String()
+			return dto.NewOrderItem(&productId, nil, &item.PurchasedName, &item.PurchasedPrice, &item.Quantity)
 		}),
 		&preOrder1.Remark,
 		&preOrder1.Discount,
@@ -324,10 +537,23 @@ func Test_UpdateOrderById(t *testing.T) {
 			name:    "update with valid params",
 			userId:  validUserId,
 			orderId: preOrder1.ID.String(),
+			version: preOrder1.Version,
 			input:   validUpdateOrderDto,
 			exec: func(result *dto.OrderResponseDto, e error) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
+				assert.Equal(preOrder1.Version+1, result.Version)
+			},
+		},
+		{
+			name:    "update with invalid params, stale version",
+			userId:  validUserId,
+			orderId: preOrder1.ID.String(),
+			version: preOrder1.Version,
+			input:   validUpdateOrderDto,
+			exec: func(result *dto.OrderResponseDto, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrPreconditionFailed)
 			},
 		},
 		{
@@ -354,9 +580,513 @@ func Test_UpdateOrderById(t *testing.T) {
 
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			test.exec(orderSvc.UpdateOrderById(ctx, test.userId, test.orderId, test.input))
+			test.exec(orderSvc.UpdateOrderById(ctx, test.userId, test.orderId, test.version, test.input))
+		})
+	}
+}
+
+// updateOrderStatusDto: keep items and pricing of order, change states only
+func updateOrderStatusDto(order *dto.OrderResponseDto,
+	status string, paymentStatus string, deliveryStatus string, trackingNumber string) *dto.UpdateOrderDto {
+	return dto.NewUpdateOrderDto(
+		lo.Map(order.Items, func(item *ent.OrderItem, _ int) *dto.OrderItem {
+			return dto.NewOrderItem(utils.PtrOf(item.ProductID.String()), orderItemVariantId(item),
+				utils.PtrOf(item.PurchasedName), utils.PtrOf(item.PurchasedPrice), utils.PtrOf(item.Quantity))
+		}),
+		&order.Remark,
+		&order.Discount,
+		&order.TotalAmount,
+		&status,
+		&paymentStatus,
+		&order.PaymentMethod,
+		&deliveryStatus,
+		&order.ShippingAddress,
+		&trackingNumber,
+	)
+}
+
+// ****Test_OrderLifecycle
+type orderLifecycleTestCase struct {
+	name           string
+	status         string
+	paymentStatus  string
+	deliveryStatus string
+	trackingNumber string
+	isErr          bool
+}
+
+func Test_OrderLifecycle(t *testing.T) {
+	ctx := context.TODO()
+	assert, orderSvc, validUserId, p1 := orderServiceTestSetup(ctx, t)
+	order := preCreateOrder(ctx, assert, orderSvc, validUserId, p1)
+	assert.Empty(order.TrackingNumber)
+	trackingNumber := gofakeit.UUID()
+
+	// in order, each case applies on result of previous valid case
+	testCases := []orderLifecycleTestCase{
+		{"initiated to completed", constants.OrderStatus.Completed, constants.PaymentStatus.Paid, constants.DeliveryStatus.Completed, trackingNumber, true},
+		{"confirm", constants.OrderStatus.Confirmed, constants.PaymentStatus.Pending, constants.DeliveryStatus.Pending, "", false},
+		{"delivery shipping while confirmed", constants.OrderStatus.Confirmed, constants.PaymentStatus.Pending, constants.DeliveryStatus.Shipping, trackingNumber, true},
+		{"ship without tracking number", constants.OrderStatus.Shipping, constants.PaymentStatus.Pending, constants.DeliveryStatus.Shipping, " ", true},
+		{"ship", constants.OrderStatus.Shipping, constants.PaymentStatus.Pending, constants.DeliveryStatus.Shipping, trackingNumber, false},
+		{"cancel shipping order", constants.OrderStatus.Canceled, constants.PaymentStatus.Pending, constants.DeliveryStatus.Shipping, trackingNumber, true},
+		{"complete with payment pending", constants.OrderStatus.Completed, constants.PaymentStatus.Pending, constants.DeliveryStatus.Completed, trackingNumber, true},
+		{"payment pending to refunded", constants.OrderStatus.Shipping, constants.PaymentStatus.Refunded, constants.DeliveryStatus.Shipping, trackingNumber, true},
+		{"pay", constants.OrderStatus.Shipping, constants.PaymentStatus.Paid, constants.DeliveryStatus.Shipping, trackingNumber, false},
+		{"complete", constants.OrderStatus.Completed, constants.PaymentStatus.Paid, constants.DeliveryStatus.Completed, trackingNumber, false},
+		{"completed to confirmed", constants.OrderStatus.Confirmed, constants.PaymentStatus.Paid, constants.DeliveryStatus.Completed, trackingNumber, true},
+		{"refund completed order", constants.OrderStatus.Completed, constants.PaymentStatus.Refunded, constants.DeliveryStatus.Completed, trackingNumber, false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := orderSvc.UpdateOrderById(ctx, validUserId, order.ID.String(), order.Version,
+				updateOrderStatusDto(order, test.status, test.paymentStatus, test.deliveryStatus, test.trackingNumber))
+			if test.isErr {
+				assert.Empty(result)
+				assert.ErrorIs(err, constants.ErrInvalidTransition)
+				return
+			}
+			assert.NotEmpty(result)
+			assert.NoError(err)
+			assert.Equal(test.status, result.Status)
+			assert.Equal(test.paymentStatus, result.PaymentStatus)
+			assert.Equal(test.deliveryStatus, result.DeliveryStatus)
+			order = result
 		})
 	}
+
+	// items of completed order are fixed
+	payload := updateOrderStatusDto(order, order.Status, order.PaymentStatus, order.DeliveryStatus, order.TrackingNumber)
+	*payload.Items[0].Quantity += 1
+	result, err := orderSvc.UpdateOrderById(ctx, validUserId, order.ID.String(), order.Version, payload)
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrInvalidTransition)
+}
+
+// ****Test_CancelOrderRestock
+func Test_CancelOrderRestock(t *testing.T) {
+	ctx := context.TODO()
+	assert := assert.New(t)
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NoError(err)
+	userRepo := repository.NewUserRepositoryMock()
+	productRepo := repository.NewProductRepositoryMock()
+	orderSvc := NewOrderService(zapLogger, nil, userRepo, productRepo, repository.NewOrderRepositoryMock(), repository.NewShopRepositoryMock())
+
+	// pre verified merchant with product of 10 in stock
+	hashedPw, err := authentication.HashPassword(gofakeit.Password(true, true, true, true, false, 6))
+	assert.NoError(err)
+	merchant, err := userRepo.CreateUser(ctx, nil,
+		dto.NewCreateUserDto(utils.PtrOf(gofakeit.Email()), nil).MapToSchema(hashedPw))
+	assert.NoError(err)
+	_, err = userRepo.UpdateUserEmailVerifiedById(ctx, nil, merchant.ID.String(), true)
+	assert.NoError(err)
+	p1, err := productRepo.CreateProduct(ctx, nil, merchant.ID.String(), dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+		utils.PtrOf(int32(10)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil).MapToSchema(constants.ProductStatus.Active))
+	assert.NoError(err)
+
+	order := preCreateOrder(ctx, assert, orderSvc, merchant.ID.String(), p1)
+	product, err := productRepo.GetProductById(ctx, nil, p1.ID.String())
+	assert.NoError(err)
+	assert.Equal(int32(8), product.Quantity)
+
+	// cancel with changed items is rejected
+	payload := updateOrderStatusDto(order, constants.OrderStatus.Canceled, order.PaymentStatus, order.DeliveryStatus, order.TrackingNumber)
+	*payload.Items[0].Quantity = 1
+	result, err := orderSvc.UpdateOrderById(ctx, merchant.ID.String(), order.ID.String(), order.Version, payload)
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrInvalidTransition)
+
+	// cancel restocks items
+	result, err = orderSvc.UpdateOrderById(ctx, merchant.ID.String(), order.ID.String(), order.Version,
+		updateOrderStatusDto(order, constants.OrderStatus.Canceled, order.PaymentStatus, order.DeliveryStatus, order.TrackingNumber))
+	assert.NotEmpty(result)
+	assert.NoError(err)
+	product, err = productRepo.GetProductById(ctx, nil, p1.ID.String())
+	assert.NoError(err)
+	assert.Equal(int32(10), product.Quantity)
+
+	// canceled is final, no double restock
+	version := result.Version
+	result, err = orderSvc.UpdateOrderById(ctx, merchant.ID.String(), order.ID.String(), version,
+		updateOrderStatusDto(order, constants.OrderStatus.Confirmed, order.PaymentStatus, order.DeliveryStatus, order.TrackingNumber))
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrInvalidTransition)
+	result, err = orderSvc.UpdateOrderById(ctx, merchant.ID.String(), order.ID.String(), version,
+		updateOrderStatusDto(order, constants.OrderStatus.Canceled, order.PaymentStatus, order.DeliveryStatus, order.TrackingNumber))
+	assert.NotEmpty(result)
+	assert.NoError(err)
+	product, err = productRepo.GetProductById(ctx, nil, p1.ID.String())
+	assert.NoError(err)
+	assert.Equal(int32(10), product.Quantity)
+
+	// ledger has placed and canceled movements of the order, newest first
+	movements, total, err := productRepo.GetInventoryMovementsByProductId(ctx, nil, p1.ID.String(),
+		dto.NewQueryInventoryMovementsDto(*dto.NewPaging(1, 20, "")))
+	assert.NoError(err)
+	assert.Equal(2, total)
+	assert.Equal(constants.InventoryReason.OrderCanceled, movements[0].Reason)
+	assert.Equal(int32(2), movements[0].Delta)
+	assert.Equal(int32(10), movements[0].QuantityAfter)
+	assert.Equal(merchant.ID, *movements[0].ActorID)
+	assert.Equal(constants.InventoryReason.OrderPlaced, movements[1].Reason)
+	assert.Equal(int32(-2), movements[1].Delta)
+	assert.Equal(order.ID.String(), movements[1].ReferenceID)
+	assert.Nil(movements[1].ActorID)
+}
+
+// ****Test_OrderProductVariants
+func Test_OrderProductVariants(t *testing.T) {
+	ctx := context.TODO()
+	assert := assert.New(t)
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NoError(err)
+	userRepo := repository.NewUserRepositoryMock()
+	productRepo := repository.NewProductRepositoryMock()
+	orderSvc := NewOrderService(zapLogger, nil, userRepo, productRepo, repository.NewOrderRepositoryMock(), repository.NewShopRepositoryMock())
+
+	// pre verified merchant with product sold by size, stock on variants
+	hashedPw, err := authentication.HashPassword(gofakeit.Password(true, true, true, true, false, 6))
+	assert.NoError(err)
+	merchant, err := userRepo.CreateUser(ctx, nil,
+		dto.NewCreateUserDto(utils.PtrOf(gofakeit.Email()), nil).MapToSchema(hashedPw))
+	assert.NoError(err)
+	_, err = userRepo.UpdateUserEmailVerifiedById(ctx, nil, merchant.ID.String(), true)
+	assert.NoError(err)
+	merchantId := merchant.ID.String()
+	createProductDto := dto.NewCreateProductDto(
+		utils.PtrOf("Tee"),
+		utils.PtrOf(money.Amount(1000)),
+		utils.PtrOf(int32(5)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil)
+	createProductDto.Options = []schema.ProductOption{{Name: "Size", Values: []string{"S", "M"}}}
+	p1, err := productRepo.CreateProduct(ctx, nil, merchantId, createProductDto.MapToSchema(constants.ProductStatus.Active))
+	assert.NoError(err)
+	productId := p1.ID.String()
+	small, err := productRepo.CreateProductVariant(ctx, nil, productId, dto.NewCreateProductVariantDto(
+		utils.PtrOf("TEE-S"), map[string]string{"Size": "S"}, nil, utils.PtrOf(int32(3)), utils.PtrOf("")))
+	assert.NoError(err)
+	smallId := small.ID.String()
+	medium, err := productRepo.CreateProductVariant(ctx, nil, productId, dto.NewCreateProductVariantDto(
+		utils.PtrOf("TEE-M"), map[string]string{"Size": "M"}, utils.PtrOf(money.Amount(1500)), utils.PtrOf(int32(2)), utils.PtrOf("")))
+	assert.NoError(err)
+	mediumId := medium.ID.String()
+
+	newCreateOrderDto := func(items ...*dto.OrderItem) *dto.CreateOrderDto {
+		total := lo.SumBy(items, func(item *dto.OrderItem) money.Amount { return item.PurchasedPrice.Mul(*item.Quantity) })
+		return dto.NewCreateOrderDto(items, utils.PtrOf(""), utils.PtrOf(money.RateOne), &total,
+			utils.PtrOf(constants.PaymentMethod.Card), utils.PtrOf(gofakeit.Address().Address))
+	}
+	newItem := func(variantId *string, price money.Amount, quantity int) *dto.OrderItem {
+		return dto.NewOrderItem(&productId, variantId, utils.PtrOf(p1.Name), &price, &quantity)
+	}
+
+	// product with variants must be bought by variant, at variant price and stock
+	invalidOrderDtos := []*dto.CreateOrderDto{
+		newCreateOrderDto(newItem(nil, 1000, 1)),
+		newCreateOrderDto(newItem(&mediumId, 1000, 1)),
+		newCreateOrderDto(newItem(&smallId, 1000, 4)),
+		newCreateOrderDto(newItem(utils.PtrOf(uuid.NewString()), 1000, 1)),
+	}
+	for _, invalidOrderDto := range invalidOrderDtos {
+		order, err := orderSvc.CreateOrder(ctx, merchantId, invalidOrderDto)
+		assert.Empty(order)
+		assert.Error(err)
+	}
+
+	// one line per variant of same product
+	order, err := orderSvc.CreateOrder(ctx, merchantId, newCreateOrderDto(newItem(&smallId, 1000, 2), newItem(&mediumId, 1500, 1)))
+	assert.NoError(err)
+	assert.Equal(money.Amount(3500), order.TotalAmount)
+	names := lo.Map(order.Items, func(item *ent.OrderItem, _ int) string { return item.PurchasedName })
+	assert.ElementsMatch([]string{"Tee (S)", "Tee (M)"}, names)
+	assertStock := func(productQuantity int32, smallQuantity int32, mediumQuantity int32) {
+		product, err := productRepo.GetProductById(ctx, nil, productId)
+		assert.NoError(err)
+		assert.Equal(productQuantity, product.Quantity)
+		variant, err := productRepo.GetProductVariantById(ctx, nil, smallId)
+		assert.NoError(err)
+		assert.Equal(smallQuantity, variant.Quantity)
+		variant, err = productRepo.GetProductVariantById(ctx, nil, mediumId)
+		assert.NoError(err)
+		assert.Equal(mediumQuantity, variant.Quantity)
+	}
+	assertStock(5, 1, 1)
+
+	// staff edit takes stock of the edited variant only
+	payload := updateOrderStatusDto(order, order.Status, order.PaymentStatus, order.DeliveryStatus, order.TrackingNumber)
+	smallItem, ok := lo.Find(payload.Items, func(item *dto.OrderItem) bool { return item.VariantId != nil && *item.VariantId == smallId })
+	assert.True(ok)
+	*smallItem.Quantity = 3
+	result, err := orderSvc.UpdateOrderById(ctx, merchantId, order.ID.String(), order.Version, payload)
+	assert.NoError(err)
+	assertStock(5, 0, 1)
+
+	// cancel restocks variants
+	result, err = orderSvc.UpdateOrderById(ctx, merchantId, order.ID.String(), result.Version,
+		updateOrderStatusDto(result, constants.OrderStatus.Canceled, result.PaymentStatus, result.DeliveryStatus, result.TrackingNumber))
+	assert.NoError(err)
+	assert.Equal(constants.OrderStatus.Canceled, result.Status)
+	assertStock(5, 3, 2)
+
+	// movements recorded on variant ledgers, product ledger untouched
+	for _, variantId := range []*string{nil, &smallId, &mediumId} {
+		ledgerQuantity, err := productRepo.GetInventoryLedgerQuantityByProductId(ctx, nil, productId, variantId)
+		assert.NoError(err)
+		assert.Equal(int32(0), ledgerQuantity)
+	}
+}
+
+// ****Test_ReleaseExpiredReservations
+func Test_ReleaseExpiredReservations(t *testing.T) {
+	ctx := context.TODO()
+	assert := assert.New(t)
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NoError(err)
+	userRepo := repository.NewUserRepositoryMock()
+	productRepo := repository.NewProductRepositoryMock()
+	orderRepo := repository.NewOrderRepositoryMock()
+	orderSvc := NewOrderService(zapLogger, nil, userRepo, productRepo, orderRepo, repository.NewShopRepositoryMock())
+
+	// pre verified merchant with product of 10 in stock
+	hashedPw, err := authentication.HashPassword(gofakeit.Password(true, true, true, true, false, 6))
+	assert.NoError(err)
+	merchant, err := userRepo.CreateUser(ctx, nil,
+		dto.NewCreateUserDto(utils.PtrOf(gofakeit.Email()), nil).MapToSchema(hashedPw))
+	assert.NoError(err)
+	_, err = userRepo.UpdateUserEmailVerifiedById(ctx, nil, merchant.ID.String(), true)
+	assert.NoError(err)
+	p1, err := productRepo.CreateProduct(ctx, nil, merchant.ID.String(), dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+		utils.PtrOf(int32(10)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil).MapToSchema(constants.ProductStatus.Active))
+	assert.NoError(err)
+	productQuantity := func() int32 {
+		product, err := productRepo.GetProductById(ctx, nil, p1.ID.String())
+		assert.NoError(err)
+		return product.Quantity
+	}
+
+	// order reserves stock until expiry
+	unpaid := preCreateOrder(ctx, assert, orderSvc, merchant.ID.String(), p1)
+	assert.NotNil(unpaid.ReservedUntil)
+	assert.WithinDuration(time.Now().Add(constants.StockReservationDuration), *unpaid.ReservedUntil, time.Minute)
+	assert.Equal(int32(8), productQuantity())
+
+	// reservation not expired yet
+	released, err := orderSvc.ReleaseExpiredReservations(ctx)
+	assert.NoError(err)
+	assert.Equal(0, released)
+
+	// payment commits reservation
+	paid := preCreateOrder(ctx, assert, orderSvc, merchant.ID.String(), p1)
+	result, err := orderSvc.UpdateOrderById(ctx, merchant.ID.String(), paid.ID.String(), paid.Version,
+		updateOrderStatusDto(paid, paid.Status, constants.PaymentStatus.Paid, paid.DeliveryStatus, paid.TrackingNumber))
+	assert.NoError(err)
+	assert.Nil(result.ReservedUntil)
+	assert.Equal(int32(6), productQuantity())
+
+	// expired reservation is released, order canceled and stock back
+	_, err = orderRepo.UpdateOrderReservedUntilById(ctx, nil, unpaid.ID.String(), utils.PtrOf(time.Now().Add(-time.Second)))
+	assert.NoError(err)
+	released, err = orderSvc.ReleaseExpiredReservations(ctx)
+	assert.NoError(err)
+	assert.Equal(1, released)
+	assert.Equal(int32(8), productQuantity())
+
+	rsOrder, err := orderSvc.GetOrderById(ctx, merchant.ID.String(), unpaid.ID.String())
+	assert.NoError(err)
+	assert.Equal(constants.OrderStatus.Canceled, rsOrder.Status)
+	assert.Equal(constants.PaymentStatus.Voided, rsOrder.PaymentStatus)
+	assert.Nil(rsOrder.ReservedUntil)
+	assert.Equal(unpaid.Version+1, rsOrder.Version)
+
+	events, err := orderSvc.GetOrderEvents(ctx, merchant.ID.String(), unpaid.ID.String())
+	assert.NoError(err)
+	lastEvent := events[len(events)-1]
+	assert.Equal(constants.OrderEventType.Released, lastEvent.Type)
+	assert.Equal(constants.OrderEventActor.System, lastEvent.ActorType)
+	assert.Nil(lastEvent.ActorID)
+
+	movements, _, err := productRepo.GetInventoryMovementsByProductId(ctx, nil, p1.ID.String(),
+		dto.NewQueryInventoryMovementsDto(*dto.NewPaging(1, 20, "")))
+	assert.NoError(err)
+	assert.Equal(constants.InventoryReason.Released, movements[0].Reason)
+	assert.Equal(int32(2), movements[0].Delta)
+	assert.Equal(unpaid.ID.String(), movements[0].ReferenceID)
+
+	// released once, staff edit of released version is stale
+	released, err = orderSvc.ReleaseExpiredReservations(ctx)
+	assert.NoError(err)
+	assert.Equal(0, released)
+	result, err = orderSvc.UpdateOrderById(ctx, merchant.ID.String(), unpaid.ID.String(), unpaid.Version,
+		updateOrderStatusDto(unpaid, unpaid.Status, constants.PaymentStatus.Paid, unpaid.DeliveryStatus, unpaid.TrackingNumber))
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrPreconditionFailed)
+
+	// failed payment is unpaid, its expired reservation is released too
+	failed := preCreateOrder(ctx, assert, orderSvc, merchant.ID.String(), p1)
+	_, err = orderSvc.UpdateOrderById(ctx, merchant.ID.String(), failed.ID.String(), failed.Version,
+		updateOrderStatusDto(failed, failed.Status, constants.PaymentStatus.Fail, failed.DeliveryStatus, failed.TrackingNumber))
+	assert.NoError(err)
+	_, err = orderRepo.UpdateOrderReservedUntilById(ctx, nil, failed.ID.String(), utils.PtrOf(time.Now().Add(-time.Second)))
+	assert.NoError(err)
+	assert.Equal(int32(6), productQuantity())
+	released, err = orderSvc.ReleaseExpiredReservations(ctx)
+	assert.NoError(err)
+	assert.Equal(1, released)
+	assert.Equal(int32(8), productQuantity())
+
+	// expired reservation not releasable is never selected, cannot starve the sweep
+	voided := preCreateOrder(ctx, assert, orderSvc, merchant.ID.String(), p1)
+	_, err = orderSvc.UpdateOrderById(ctx, merchant.ID.String(), voided.ID.String(), voided.Version,
+		updateOrderStatusDto(voided, voided.Status, constants.PaymentStatus.Voided, voided.DeliveryStatus, voided.TrackingNumber))
+	assert.NoError(err)
+	_, err = orderRepo.UpdateOrderReservedUntilById(ctx, nil, voided.ID.String(), utils.PtrOf(time.Now().Add(-time.Second)))
+	assert.NoError(err)
+	expiredOrders, err := orderRepo.GetReservationExpiredOrders(ctx, nil, time.Now(), constants.StockReservationSweepBatch)
+	assert.NoError(err)
+	assert.Empty(expiredOrders)
+}
+
+// editBeforeLockProductRepository: runs edit once before stock locked, as a staff edit committed meanwhile
+type editBeforeLockProductRepository struct {
+	repository.IProductRepository
+	edit func()
+}
+
+func (r *editBeforeLockProductRepository) LockProductsByIds(
+	ctx context.Context, client *ent.Client, productIds []string) ([]*ent.Product, error) {
+	if r.edit != nil {
+		edit := r.edit
+		r.edit = nil
+		edit()
+	}
+	return r.IProductRepository.LockProductsByIds(ctx, client, productIds)
+}
+
+// Test_ReleaseExpiredReservationsEditedMeanwhile: restock from items read under stock locks
+func Test_ReleaseExpiredReservationsEditedMeanwhile(t *testing.T) {
+	ctx := context.TODO()
+	assert := assert.New(t)
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NoError(err)
+	userRepo := repository.NewUserRepositoryMock()
+	productRepo := repository.NewProductRepositoryMock()
+	orderRepo := repository.NewOrderRepositoryMock()
+	shopRepo := repository.NewShopRepositoryMock()
+	orderSvc := NewOrderService(zapLogger, nil, userRepo, productRepo, orderRepo, shopRepo)
+	sweepProductRepo := &editBeforeLockProductRepository{IProductRepository: productRepo}
+	sweeper := NewOrderService(zapLogger, nil, userRepo, sweepProductRepo, orderRepo, shopRepo)
+
+	// pre verified merchant with product of 10 in stock
+	hashedPw, err := authentication.HashPassword(gofakeit.Password(true, true, true, true, false, 6))
+	assert.NoError(err)
+	merchant, err := userRepo.CreateUser(ctx, nil,
+		dto.NewCreateUserDto(utils.PtrOf(gofakeit.Email()), nil).MapToSchema(hashedPw))
+	assert.NoError(err)
+	_, err = userRepo.UpdateUserEmailVerifiedById(ctx, nil, merchant.ID.String(), true)
+	assert.NoError(err)
+	merchantId := merchant.ID.String()
+	p1, err := productRepo.CreateProduct(ctx, nil, merchantId, dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+		utils.PtrOf(int32(10)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil).MapToSchema(constants.ProductStatus.Active))
+	assert.NoError(err)
+	unpaid := preCreateOrder(ctx, assert, orderSvc, merchantId, p1)
+	_, err = orderRepo.UpdateOrderReservedUntilById(ctx, nil, unpaid.ID.String(), utils.PtrOf(time.Now().Add(-time.Second)))
+	assert.NoError(err)
+
+	// staff reduces item from 2 to 1 after sweeper read order
+	sweepProductRepo.edit = func() {
+		payload := updateOrderStatusDto(unpaid, unpaid.Status, unpaid.PaymentStatus, unpaid.DeliveryStatus, unpaid.TrackingNumber)
+		payload.Items[0].Quantity = utils.PtrOf(1)
+		_, err := orderSvc.UpdateOrderById(ctx, merchantId, unpaid.ID.String(), unpaid.Version, payload)
+		assert.NoError(err)
+	}
+	released, err := sweeper.ReleaseExpiredReservations(ctx)
+	assert.NoError(err)
+	assert.Equal(1, released)
+
+	product, err := productRepo.GetProductById(ctx, nil, p1.ID.String())
+	assert.NoError(err)
+	assert.Equal(int32(10), product.Quantity)
+}
+
+// ****Test_GetOrderEvents
+func Test_GetOrderEvents(t *testing.T) {
+	ctx := context.TODO()
+	assert, orderSvc, validUserId, p1 := orderServiceTestSetup(ctx, t)
+	order := preCreateOrder(ctx, assert, orderSvc, validUserId, p1)
+
+	// placed by customer
+	events, err := orderSvc.GetOrderEvents(ctx, validUserId, order.ID.String())
+	assert.NoError(err)
+	assert.Len(events, 1)
+	assert.Equal(constants.OrderEventType.Created, events[0].Type)
+	assert.Equal(constants.OrderEventActor.Customer, events[0].ActorType)
+	assert.Nil(events[0].ActorID)
+
+	// confirm and change note by staff
+	payload := updateOrderStatusDto(order, constants.OrderStatus.Confirmed, order.PaymentStatus, order.DeliveryStatus, order.TrackingNumber)
+	payload.Remark = utils.PtrOf(gofakeit.LetterN(20))
+	result, err := orderSvc.UpdateOrderById(ctx, validUserId, order.ID.String(), order.Version, payload)
+	assert.NoError(err)
+	events, err = orderSvc.GetOrderEvents(ctx, validUserId, order.ID.String())
+	assert.NoError(err)
+	assert.Len(events, 3)
+	eventTypes := lo.Map(events, func(event *ent.OrderEvent, _ int) string { return event.Type })
+	assert.Equal([]string{constants.OrderEventType.Created, constants.OrderEventType.StatusChanged, constants.OrderEventType.NoteChanged}, eventTypes)
+	assert.Equal(constants.OrderEventActor.Staff, events[1].ActorType)
+	assert.Equal(validUserId, events[1].ActorID.String())
+	assert.Equal(map[string]any{"status": constants.OrderStatus.Initiated}, events[1].Before)
+	assert.Equal(map[string]any{"status": constants.OrderStatus.Confirmed}, events[1].After)
+
+	// rejected and unchanged updates record nothing
+	_, err = orderSvc.UpdateOrderById(ctx, validUserId, order.ID.String(), result.Version,
+		updateOrderStatusDto(order, constants.OrderStatus.Completed, order.PaymentStatus, order.DeliveryStatus, order.TrackingNumber))
+	assert.ErrorIs(err, constants.ErrInvalidTransition)
+	result, err = orderSvc.UpdateOrderById(ctx, validUserId, order.ID.String(), result.Version, payload)
+	assert.NoError(err)
+	events, err = orderSvc.GetOrderEvents(ctx, validUserId, order.ID.String())
+	assert.NoError(err)
+	assert.Len(events, 3)
+
+	// items change
+	payload.Items[0].Quantity = utils.PtrOf(*payload.Items[0].Quantity + 1)
+	result, err = orderSvc.UpdateOrderById(ctx, validUserId, order.ID.String(), result.Version, payload)
+	assert.NoError(err)
+	events, err = orderSvc.GetOrderEvents(ctx, validUserId, order.ID.String())
+	assert.NoError(err)
+	eventTypes = lo.Map(events[3:], func(event *ent.OrderEvent, _ int) string { return event.Type })
+	assert.Equal([]string{constants.OrderEventType.ItemsChanged, constants.OrderEventType.PricingChanged}, eventTypes)
+
+	// archive
+	ok, err := orderSvc.SoftDeleteOrderById(ctx, validUserId, order.ID.String(), result.Version)
+	assert.True(ok)
+	assert.NoError(err)
+	events, err = orderSvc.GetOrderEvents(ctx, validUserId, order.ID.String())
+	assert.NoError(err)
+	assert.Equal(constants.OrderEventType.Archived, events[len(events)-1].Type)
+
+	// other user
+	events, err = orderSvc.GetOrderEvents(ctx, uuid.NewString(), order.ID.String())
+	assert.Empty(events)
+	assert.Error(err)
 }
 
 // ****Test_SoftDeleteOrderById
@@ -364,6 +1094,7 @@ type softDeleteOrderByIdTestCase struct {
 	name    string
 	userId  string
 	orderId string
+	version int64
 	exec    func(bool, error)
 }
 
@@ -395,10 +1126,21 @@ func Test_SoftDeleteOrderById(t *testing.T) {
 				assert.Error(e)
 			},
 		},
+		{
+			name:    "soft delete with invalid params, stale version",
+			userId:  validUserId,
+			orderId: preOrder1.ID.String(),
+			version: preOrder1.Version + 1,
+			exec: func(result bool, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrPreconditionFailed)
+			},
+		},
 		{
 			name:    "soft delete with valid params",
 			userId:  validUserId,
 			orderId: preOrder1.ID.String(),
+			version: preOrder1.Version,
 			exec: func(result bool, e error) {
 				assert.True(result)
 				assert.NoError(e)
@@ -408,7 +1150,7 @@ func Test_SoftDeleteOrderById(t *testing.T) {
 
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			test.exec(orderSvc.SoftDeleteOrderById(ctx, test.userId, test.orderId))
+			test.exec(orderSvc.SoftDeleteOrderById(ctx, test.userId, test.orderId, test.version))
 		})
 	}
 }
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sthl/authentication"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/repository"
+
+	"go.uber.org/zap"
+)
+
+// activeShopId: shop carried by access token, empty for token issued to user without shop
+func activeShopId(ctx context.Context) string {
+	shopId, _ := ctx.Value(constants.ActiveShopKey).(string)
+	return shopId
+}
+
+// shopRoleOf: owner of shop is always owner, others need a member row
+func shopRoleOf(ctx context.Context, logger *zap.Logger, client *ent.Client,
+	shopRepo repository.IShopRepository, shop *ent.Shop, userId string) (string, error) {
+	if shop.UserID.String() == userId {
+		return constants.ShopRole.Owner, nil
+	}
+	member, err := shopRepo.GetShopMember(ctx, client, shop.ID.String(), userId)
+	if err != nil {
+		if errors.Is(err, constants.ErrNotFound) {
+			logger.Info("user not member of shop", zap.String("userId", userId), zap.String("shopId", shop.ID.String()))
+			return "", constants.ErrForbidden
+		}
+		return "", err
+	}
+	return member.Role, nil
+}
+
+// authorizeShop: check user has permission in active shop,
+// return owner userId which products, orders, siteui and album are keyed by;
+// without active shop user acts on own resources
+func authorizeShop(ctx context.Context, logger *zap.Logger, client *ent.Client,
+	shopRepo repository.IShopRepository, userId string, permission string) (string, error) {
+	shopId := activeShopId(ctx)
+	if shopId == "" {
+		return userId, nil
+	}
+
+	shop, err := shopRepo.GetShopById(ctx, client, shopId)
+	if err != nil {
+		if errors.Is(err, constants.ErrNotFound) {
+			return "", constants.ErrForbidden
+		}
+		return "", err
+	}
+	role, err := shopRoleOf(ctx, logger, client, shopRepo, shop, userId)
+	if err != nil {
+		return "", err
+	}
+	if !authentication.ShopRoleHasPermission(role, permission) {
+		logger.Info("shop role has no permission",
+			zap.String("userId", userId), zap.String("role", role), zap.String("permission", permission))
+		return "", constants.ErrForbidden
+	}
+	return shop.UserID.String(), nil
+}
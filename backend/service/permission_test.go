@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/logger"
+	"sthl/repository"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// ****Test_AuthorizeShop
+type authorizeShopTestCase struct {
+	name       string
+	ctx        context.Context
+	userId     string
+	permission string
+	exec       func(string, error)
+}
+
+func Test_AuthorizeShop(t *testing.T) {
+	ctx := context.TODO()
+	assert := assert.New(t)
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NoError(err)
+	shopRepo := repository.NewShopRepositoryMock()
+
+	// pre shop with staff
+	ownerId := uuid.NewString()
+	managerId := uuid.NewString()
+	readOnlyId := uuid.NewString()
+	shop, err := shopRepo.CreateShop(ctx, nil, ownerId, "shop")
+	assert.NoError(err)
+	_, err = shopRepo.UpsertShopMember(ctx, nil, shop.ID.String(), managerId, constants.ShopRole.Manager)
+	assert.NoError(err)
+	_, err = shopRepo.UpsertShopMember(ctx, nil, shop.ID.String(), readOnlyId, constants.ShopRole.ReadOnly)
+	assert.NoError(err)
+	shopCtx := context.WithValue(ctx, constants.ActiveShopKey, shop.ID.String())
+
+	testCases := []authorizeShopTestCase{
+		{
+			name:       "without active shop, act on own resources",
+			ctx:        ctx,
+			userId:     managerId,
+			permission: constants.ShopPermission.StaffManage,
+			exec: func(result string, e error) {
+				assert.Equal(managerId, result)
+				assert.NoError(e)
+			},
+		},
+		{
+			name:       "owner",
+			ctx:        shopCtx,
+			userId:     ownerId,
+			permission: constants.ShopPermission.StaffManage,
+			exec: func(result string, e error) {
+				assert.Equal(ownerId, result)
+				assert.NoError(e)
+			},
+		},
+		{
+			name:       "manager with permission",
+			ctx:        shopCtx,
+			userId:     managerId,
+			permission: constants.ShopPermission.ProductWrite,
+			exec: func(result string, e error) {
+				assert.Equal(ownerId, result)
+				assert.NoError(e)
+			},
+		},
+		{
+			name:       "readOnly without permission",
+			ctx:        shopCtx,
+			userId:     readOnlyId,
+			permission: constants.ShopPermission.OrderWrite,
+			exec: func(result string, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrForbidden)
+			},
+		},
+		{
+			name:       "not member",
+			ctx:        shopCtx,
+			userId:     uuid.NewString(),
+			permission: constants.ShopPermission.ProductRead,
+			exec: func(result string, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrForbidden)
+			},
+		},
+		{
+			name:       "unknown shop",
+			ctx:        context.WithValue(ctx, constants.ActiveShopKey, uuid.NewString()),
+			userId:     ownerId,
+			permission: constants.ShopPermission.ProductRead,
+			exec: func(result string, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrForbidden)
+			},
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			test.exec(authorizeShop(test.ctx, zapLogger, nil, shopRepo, test.userId, test.permission))
+		})
+	}
+}
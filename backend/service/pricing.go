@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/money"
+	"sthl/repository"
+
+	"go.uber.org/zap"
+)
+
+// orderLine: snapshot price and quantity of an order item
+type orderLine struct {
+	price    money.Amount
+	quantity int
+}
+
+// calculateOrderBreakdown: discount is a multiplier on subtotal (1 means no discount),
+// tax applies to the discounted subtotal, shipping fee is flat per order,
+// each step rounds half away from zero to minor units
+func calculateOrderBreakdown(currency string, lines []orderLine,
+	discount money.Rate, taxRate money.Rate, shippingFee money.Amount) *dto.OrderBreakdownDto {
+	subtotal := money.Amount(0)
+	for _, line := range lines {
+		subtotal += line.price.Mul(line.quantity)
+	}
+	discounted := subtotal.ApplyRate(discount)
+	taxAmount := discounted.ApplyRate(taxRate)
+
+	return &dto.OrderBreakdownDto{
+		Currency:       currency,
+		Subtotal:       subtotal,
+		Discount:       discount,
+		DiscountAmount: subtotal - discounted,
+		TaxRate:        taxRate,
+		TaxAmount:      taxAmount,
+		ShippingFee:    shippingFee,
+		TotalAmount:    discounted + taxAmount + shippingFee,
+	}
+}
+
+// shopPricing: tax rate and shipping fee of the shop owned by user, zero if no shop
+func shopPricing(ctx context.Context, logger *zap.Logger, client *ent.Client,
+	shopRepo repository.IShopRepository, ownerId string) (money.Rate, money.Amount, error) {
+	shop, err := shopRepo.GetShopByUserId(ctx, client, ownerId)
+	if errors.Is(err, constants.ErrNotFound) {
+		logger.Info("owner has no shop, use zero tax and shipping")
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	return shop.TaxRate, shop.ShippingFee, nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"sthl/constants"
+	"sthl/money"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ****Test_CalculateOrderBreakdown
+type calculateOrderBreakdownTestCase struct {
+	name           string
+	lines          []orderLine
+	discount       money.Rate
+	taxRate        money.Rate
+	shippingFee    money.Amount
+	subtotal       money.Amount
+	discountAmount money.Amount
+	taxAmount      money.Amount
+	totalAmount    money.Amount
+}
+
+func Test_CalculateOrderBreakdown(t *testing.T) {
+	assert := assert.New(t)
+	testCases := []calculateOrderBreakdownTestCase{
+		{
+			name:        "no discount, no tax, no shipping",
+			lines:       []orderLine{{price: 1050, quantity: 2}, {price: 325, quantity: 1}},
+			discount:    money.RateOne,
+			subtotal:    2425,
+			totalAmount: 2425,
+		},
+		{
+			name:           "discount, tax on discounted subtotal and shipping",
+			lines:          []orderLine{{price: 10000, quantity: 1}},
+			discount:       900000,
+			taxRate:        50000,
+			shippingFee:    1000,
+			subtotal:       10000,
+			discountAmount: 1000,
+			taxAmount:      450,
+			totalAmount:    10450,
+		},
+		{
+			name:           "round half away from zero to cents",
+			lines:          []orderLine{{price: 1999, quantity: 3}},
+			discount:       850000,
+			taxRate:        82500,
+			subtotal:       5997,
+			discountAmount: 900,
+			taxAmount:      421,
+			totalAmount:    5518,
+		},
+		{
+			name:        "empty order has shipping only",
+			lines:       []orderLine{},
+			discount:    money.RateOne,
+			shippingFee: 500,
+			totalAmount: 500,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			result := calculateOrderBreakdown(constants.DefaultCurrency, test.lines, test.discount, test.taxRate, test.shippingFee)
+			assert.Equal(constants.DefaultCurrency, result.Currency)
+			assert.Equal(test.subtotal, result.Subtotal)
+			assert.Equal(test.discountAmount, result.DiscountAmount)
+			assert.Equal(test.taxAmount, result.TaxAmount)
+			assert.Equal(test.totalAmount, result.TotalAmount)
+			assert.Equal(result.Subtotal-result.DiscountAmount+result.TaxAmount+result.ShippingFee, result.TotalAmount)
+		})
+	}
+}
@@ -2,38 +2,62 @@ package service
 
 import (
 	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
 	"sthl/constants"
 	"sthl/dto"
 	"sthl/ent"
 	"sthl/repository"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
 
 type IProductService interface {
 	// public
 	GetPrdoucts(ctx context.Context, userId string, payload *dto.QueryProductsDto) (*dto.QueryProductsResponseDto, error)
-	GetProductById(ctx context.Context, productId string) (*ent.Product, error)
+	GetProductById(ctx context.Context, userId string, productId string) (*dto.ProductResponseDto, error)
 	// private
+	GetShopProducts(ctx context.Context, userId string, payload *dto.QueryProductsDto) (*dto.QueryProductsResponseDto, error)
 	CreateProduct(ctx context.Context, userId string, payload *dto.CreateProductDto) (*ent.Product, error)
-	UpdateProductById(ctx context.Context, userId string, productId string, payload *dto.UpdateProductDto) (*ent.Product, error)
-	SoftDeleteProductById(ctx context.Context, userId string, productId string) (*ent.Product, error)
+	UpdateProductById(ctx context.Context, userId string, productId string, version int64, payload *dto.UpdateProductDto) (*ent.Product, error)
+	SoftDeleteProductById(ctx context.Context, userId string, productId string, version int64) (*ent.Product, error)
+	AdjustProductQuantityById(ctx context.Context, userId string, productId string, payload *dto.AdjustInventoryDto) (*ent.Product, error)
+	GetInventoryMovements(ctx context.Context, userId string, productId string, payload *dto.QueryInventoryMovementsDto) (*dto.QueryInventoryMovementsResponseDto, error)
+	CreateProductVariant(ctx context.Context, userId string, productId string, payload *dto.CreateProductVariantDto) (*ent.ProductVariant, error)
+	UpdateProductVariantById(ctx context.Context, userId string, productId string, variantId string, version int64, payload *dto.UpdateProductVariantDto) (*ent.ProductVariant, error)
+	SoftDeleteProductVariantById(ctx context.Context, userId string, productId string, variantId string, version int64) (*ent.ProductVariant, error)
+	AdjustProductVariantQuantityById(ctx context.Context, userId string, productId string, variantId string, payload *dto.AdjustInventoryDto) (*ent.ProductVariant, error)
+	ImportProducts(ctx context.Context, userId string, payload *dto.ImportProductsDto, file io.Reader) (*dto.ImportProductsResponseDto, error)
+	ExportProducts(ctx context.Context, userId string, w io.Writer) error
+	SetProductMedia(ctx context.Context, userId string, productId string, payload *dto.SetProductMediaDto) ([]*dto.ProductMediaResponseDto, error)
 }
 type ProductService struct {
-	logger      *zap.Logger
-	client      *ent.Client
-	userRepo    repository.IUserRepository
-	productRepo repository.IProductRepository
+	logger       *zap.Logger
+	client       *ent.Client
+	userRepo     repository.IUserRepository
+	productRepo  repository.IProductRepository
+	shopRepo     repository.IShopRepository
+	categoryRepo repository.ICategoryRepository
+	imginfoRepo  repository.IImgInfoRepository
 }
 
-func NewProductService(logger *zap.Logger, client *ent.Client,
-	userRepo repository.IUserRepository, productRepo repository.IProductRepository) IProductService {
+func NewProductService(logger *zap.Logger, client *ent.Client, userRepo repository.IUserRepository,
+	productRepo repository.IProductRepository, shopRepo repository.IShopRepository, categoryRepo repository.ICategoryRepository,
+	imginfoRepo repository.IImgInfoRepository) IProductService {
 	return &ProductService{
-		logger:      logger,
-		client:      client,
-		userRepo:    userRepo,
-		productRepo: productRepo,
+		logger:       logger,
+		client:       client,
+		userRepo:     userRepo,
+		productRepo:  productRepo,
+		shopRepo:     shopRepo,
+		categoryRepo: categoryRepo,
+		imginfoRepo:  imginfoRepo,
 	}
 }
 
@@ -58,8 +82,19 @@ func (productSvc *ProductService) CreateProduct(
 		// extract tx client as ent.cient
 		txc := tx.Client()
 
-		mapped := payload.MapToSchema(constants.ProductStatus.Initiated)
-		rs, err := productSvc.productRepo.CreateProduct(ctx, txc, userId, mapped)
+		// check permission in active shop, product belongs to shop owner
+		ownerId, err := authorizeShop(ctx, productSvc.logger, txc, productSvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		// check products of shop within plan
+		err = productSvc.ensureProductQuota(ctx, txc, ownerId, 1)
+		if err != nil {
+			return err
+		}
+
+		rs, err := productSvc.createProduct(ctx, txc, userId, ownerId, payload, constants.InventoryReason.Initial)
 		if err != nil {
 			return err
 		}
@@ -73,7 +108,67 @@ func (productSvc *ProductService) CreateProduct(
 	return result, nil
 }
 
-// GetPrdoucts
+// ensureProductQuota: products of owner after adding within limit of plan,
+// owner locked so concurrent creates and imports of shop counted in turn, must be called with a tx client
+func (productSvc *ProductService) ensureProductQuota(ctx context.Context, client *ent.Client, ownerId string, adding int) error {
+	err := productSvc.userRepo.LockUserById(ctx, client, ownerId)
+	if err != nil {
+		return err
+	}
+	limit, err := ownerPlanLimit(ctx, productSvc.logger, client, productSvc.userRepo, ownerId)
+	if err != nil {
+		return err
+	}
+	total, err := productSvc.productRepo.GetProductsTotalByUserId(ctx, client, ownerId)
+	if err != nil {
+		return err
+	}
+	return ensureQuota(productSvc.logger, ownerId, "products", int64(total), int64(adding), int64(limit.Products))
+}
+
+// createProduct: validated product created as initiated, ledger opened with initial stock by reason, must be called with a tx client
+func (productSvc *ProductService) createProduct(
+	ctx context.Context, client *ent.Client, userId string, ownerId string, payload *dto.CreateProductDto, reason string) (*ent.Product, error) {
+	mapped := payload.MapToSchema(constants.ProductStatus.Initiated)
+	rs, err := productSvc.productRepo.CreateProduct(ctx, client, ownerId, mapped)
+	if err != nil {
+		return nil, err
+	}
+
+	// call repo to open ledger with initial stock
+	if rs.Quantity != 0 {
+		_, err = productSvc.productRepo.CreateInventoryMovement(ctx, client, rs.ID.String(), rs.Quantity,
+			dto.NewCreateInventoryMovementDto(nil, rs.Quantity, reason, "", &userId, ""))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+// getProducts: paging ensured, category and collection of shop resolved to filter
+func (productSvc *ProductService) getProducts(
+	ctx context.Context, client *ent.Client, ownerId string, payload *dto.QueryProductsDto, storefront bool) (*dto.QueryProductsResponseDto, error) {
+	err := payload.Validate()
+	if err != nil {
+		productSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	ensuredPayload := *payload
+	ensuredPayload.Paging = *payload.Ensure()
+
+	// resolve category and collection of shop to filter
+	filter, err := resolveProductsFilter(ctx, client, productSvc.categoryRepo, ownerId, &ensuredPayload)
+	if err != nil {
+		return nil, err
+	}
+	filter.Storefront = storefront
+
+	// call repo to GetProducts
+	return productSvc.productRepo.GetProducts(ctx, client, ownerId, &ensuredPayload, filter)
+}
+
+// GetPrdoucts: storefront listing, archived and inactive products hidden
 func (productSvc *ProductService) GetPrdoucts(
 	ctx context.Context, userId string, payload *dto.QueryProductsDto) (*dto.QueryProductsResponseDto, error) {
 	// validate
@@ -83,38 +178,86 @@ func (productSvc *ProductService) GetPrdoucts(
 		return nil, constants.ErrBadRequest
 	}
 
-	ensuredPaging := payload.Ensure()
-	ensuredPayload := dto.NewQueryProductsDto(*ensuredPaging)
+	result, err := productSvc.getProducts(ctx, productSvc.client, userId, payload, true)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
 
-	// call repo to GetProducts
-	result, err := productSvc.productRepo.GetProducts(ctx, productSvc.client, userId, ensuredPayload)
+// GetShopProducts: all products of active shop for staff, archived and inactive included
+func (productSvc *ProductService) GetShopProducts(
+	ctx context.Context, userId string, payload *dto.QueryProductsDto) (*dto.QueryProductsResponseDto, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// check permission in active shop
+	ownerId, err := authorizeShop(ctx, productSvc.logger, productSvc.client, productSvc.shopRepo, userId, constants.ShopPermission.ProductRead)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := productSvc.getProducts(ctx, productSvc.client, ownerId, payload, false)
 	if err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-// GetProductById
+// GetProductById: storefront product of shop with its active variants and categories, archived and inactive products hidden
 func (productSvc *ProductService) GetProductById(
-	ctx context.Context, productId string) (*ent.Product, error) {
+	ctx context.Context, userId string, productId string) (*dto.ProductResponseDto, error) {
 	// validate
-	_, err := uuid.Parse(productId)
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(productId)
 	if err != nil {
 		productSvc.logger.Info("fail to parse productId to uuid", zap.Error(err))
 		return nil, constants.ErrBadRequest
 	}
 
 	// call repo to GetProducts
-	result, err := productSvc.productRepo.GetProductById(ctx, productSvc.client, productId)
+	product, err := productSvc.productRepo.GetProductById(ctx, productSvc.client, productId)
 	if err != nil {
 		return nil, err
 	}
-	return result, nil
+	// product of other shop or hidden from storefront
+	if product.UserID.String() != userId || product.IsArchived || product.Status == constants.ProductStatus.Inactive {
+		productSvc.logger.Info("product not visible on storefront of shop")
+		return nil, constants.ErrNotFound
+	}
+
+	// call repo to get variant matrix
+	variants, err := productSvc.productRepo.GetProductVariantsByProductId(ctx, productSvc.client, productId)
+	if err != nil {
+		return nil, err
+	}
+	activeVariants := lo.Filter(variants, func(v *ent.ProductVariant, _ int) bool { return !v.IsArchived })
+
+	// call repo to get categories
+	categoryIds, err := productSvc.productRepo.GetProductCategoryIdsById(ctx, productSvc.client, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	// call repo to get gallery
+	media, err := productSvc.productMedia(ctx, productSvc.client, productId)
+	if err != nil {
+		return nil, err
+	}
+	return dto.NewProductResponseDto(product, activeVariants, categoryIds, media), nil
 }
 
 // UpdateProductById
 func (productSvc *ProductService) UpdateProductById(
-	ctx context.Context, userId string, productId string, payload *dto.UpdateProductDto) (*ent.Product, error) {
+	ctx context.Context, userId string, productId string, version int64, payload *dto.UpdateProductDto) (*ent.Product, error) {
 	// validate
 	_, err := uuid.Parse(userId)
 	if err != nil {
@@ -137,16 +280,52 @@ func (productSvc *ProductService) UpdateProductById(
 	txFunc := func(tx *ent.Tx) error {
 		// extract tx client as ent.cient
 		txc := tx.Client()
-		product, err := productSvc.productRepo.GetProductById(ctx, txc, productId)
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, productSvc.logger, txc, productSvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		// lock product, manual movement is measured against stock no sale changes meanwhile
+		products, err := lockProducts(ctx, txc, productSvc.productRepo, []string{productId})
 		if err != nil {
 			return err
 		}
+		product, ok := products[productId]
+		if !ok {
+			return constants.ErrNotFound
+		}
 
-		if product.UserID.String() != userId {
+		// check product belong to shop
+		if product.UserID.String() != ownerId {
 			return constants.ErrUnauthorized
 		}
 
-		rs, err := productSvc.productRepo.UpdateProductById(ctx, txc, productId, payload)
+		// check client edited latest version, repo update is conditional as well
+		if product.Version != version {
+			return constants.ErrPreconditionFailed
+		}
+
+		// check active variants still fit changed options
+		if payload.Options != nil {
+			variants, err := productSvc.productRepo.GetProductVariantsByProductId(ctx, txc, productId)
+			if err != nil {
+				return err
+			}
+			for _, variant := range variants {
+				if variant.IsArchived {
+					continue
+				}
+				err = checkVariantOptions(payload.Options, variant.Options)
+				if err != nil {
+					productSvc.logger.Info("fail to checkVariantOptions", zap.Error(err))
+					return constants.ErrBadRequest
+				}
+			}
+		}
+
+		rs, err := productSvc.updateProduct(ctx, txc, userId, product, payload, constants.InventoryReason.Manual)
 		if err != nil {
 			return err
 		}
@@ -160,9 +339,29 @@ func (productSvc *ProductService) UpdateProductById(
 	return result, nil
 }
 
+// updateProduct: validated update of product at its version, quantity change recorded by reason, must be called with a tx client
+func (productSvc *ProductService) updateProduct(
+	ctx context.Context, client *ent.Client, userId string, product *ent.Product, payload *dto.UpdateProductDto, reason string) (*ent.Product, error) {
+	productId := product.ID.String()
+	rs, err := productSvc.productRepo.UpdateProductById(ctx, client, productId, product.Version, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// call repo to record quantity set by staff
+	if rs.Quantity != product.Quantity {
+		_, err = productSvc.productRepo.CreateInventoryMovement(ctx, client, productId, rs.Quantity,
+			dto.NewCreateInventoryMovementDto(nil, rs.Quantity-product.Quantity, reason, "", &userId, ""))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
 // SoftDeleteProductById
 func (productSvc *ProductService) SoftDeleteProductById(
-	ctx context.Context, userId string, productId string) (*ent.Product, error) {
+	ctx context.Context, userId string, productId string, version int64) (*ent.Product, error) {
 	// validate
 	_, err := uuid.Parse(userId)
 	if err != nil {
@@ -180,17 +379,460 @@ func (productSvc *ProductService) SoftDeleteProductById(
 	txFunc := func(tx *ent.Tx) error {
 		// extract tx client as ent.cient
 		txc := tx.Client()
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, productSvc.logger, txc, productSvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		product, err := productSvc.productRepo.GetProductById(ctx, txc, productId)
+		if err != nil {
+			return err
+		}
+
+		// check product belong to shop
+		if product.UserID.String() != ownerId {
+			return constants.ErrUnauthorized
+		}
+
+		// check client edited latest version, repo update is conditional as well
+		if product.Version != version {
+			return constants.ErrPreconditionFailed
+		}
+
+		rs, err := productSvc.productRepo.SoftDeleteProductById(ctx, txc, productId, version)
+		if err != nil {
+			return err
+		}
+		result = rs
+		return nil
+	}
+	err = productSvc.productRepo.WithTx(ctx, productSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AdjustProductQuantityById: manual stock adjustment by staff, recorded in ledger
+func (productSvc *ProductService) AdjustProductQuantityById(
+	ctx context.Context, userId string, productId string, payload *dto.AdjustInventoryDto) (*ent.Product, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(productId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		productSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to adjust with tx
+	var result *ent.Product
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, productSvc.logger, txc, productSvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		// lock product, adjustment is relative so no version needed
+		products, err := lockProducts(ctx, txc, productSvc.productRepo, []string{productId})
+		if err != nil {
+			return err
+		}
+		product, ok := products[productId]
+		if !ok {
+			return constants.ErrNotFound
+		}
+
+		// check product belong to shop
+		if product.UserID.String() != ownerId {
+			return constants.ErrUnauthorized
+		}
+
+		rs, err := productSvc.productRepo.AdjustProductQuantityById(ctx, txc, productId,
+			dto.NewCreateInventoryMovementDto(nil, *payload.Delta, *payload.Reason, "", &userId, *payload.Note))
+		if err != nil {
+			return err
+		}
+		result = rs
+		return nil
+	}
+	err = productSvc.productRepo.WithTx(ctx, productSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetInventoryMovements: ledger of product newest first, reconciled against product quantity and each variant quantity
+func (productSvc *ProductService) GetInventoryMovements(
+	ctx context.Context, userId string, productId string, payload *dto.QueryInventoryMovementsDto) (*dto.QueryInventoryMovementsResponseDto, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(productId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	ensuredPaging := payload.Ensure()
+	ensuredPayload := dto.NewQueryInventoryMovementsDto(*ensuredPaging)
+
+	// call repo to get ledger with tx, quantity and ledger read in one snapshot
+	var result *dto.QueryInventoryMovementsResponseDto
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, productSvc.logger, txc, productSvc.shopRepo, userId, constants.ShopPermission.ProductRead)
+		if err != nil {
+			return err
+		}
+
+		// lock product and variants, so no stock change between quantity and ledger sum
+		stock, err := lockStock(ctx, txc, productSvc.productRepo, []string{productId})
+		if err != nil {
+			return err
+		}
+		product, ok := stock.products[productId]
+		if !ok {
+			return constants.ErrNotFound
+		}
+
+		// check product belong to shop
+		if product.UserID.String() != ownerId {
+			return constants.ErrUnauthorized
+		}
+
+		movements, total, err := productSvc.productRepo.GetInventoryMovementsByProductId(ctx, txc, productId, ensuredPayload)
+		if err != nil {
+			return err
+		}
+		ledgerQuantity, err := productSvc.productRepo.GetInventoryLedgerQuantityByProductId(ctx, txc, productId, nil)
+		if err != nil {
+			return err
+		}
+
+		// reconcile every variant against its own movements
+		variants := lo.Values(stock.variants)
+		sort.Slice(variants, func(i, j int) bool { return variants[i].CreatedAt.Before(variants[j].CreatedAt) })
+		variantReconciles := []*dto.InventoryVariantReconcileDto{}
+		for _, variant := range variants {
+			variantId := variant.ID.String()
+			variantLedgerQuantity, err := productSvc.productRepo.GetInventoryLedgerQuantityByProductId(ctx, txc, productId, &variantId)
+			if err != nil {
+				return err
+			}
+			variantReconciles = append(variantReconciles, dto.NewInventoryVariantReconcileDto(variantId, variant.Quantity, variantLedgerQuantity))
+		}
+
+		pagingResp := dto.NewPagingResponse(ensuredPayload.Page, ensuredPayload.Limit, total)
+		result = dto.NewQueryInventoryMovementsResponseDto(movements, product.Quantity, ledgerQuantity, variantReconciles, *pagingResp)
+		return nil
+	}
+	err = productSvc.productRepo.WithTx(ctx, productSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// importProductRow: row of csv import, payload nil if row not parsed
+type importProductRow struct {
+	line    int
+	payload *dto.CreateProductDto
+	failed  bool
+}
+
+// ImportProducts: csv rows created, or updated if sku exists in shop, all or nothing,
+// every row validated before any write, nothing written with row errors or in dry run
+func (productSvc *ProductService) ImportProducts(
+	ctx context.Context, userId string, payload *dto.ImportProductsDto, file io.Reader) (*dto.ImportProductsResponseDto, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		productSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// parse header, byte order mark of spreadsheet export dropped
+	reader := csv.NewReader(file)
+	header, err := reader.Read()
+	if err != nil {
+		productSvc.logger.Info("fail to read csv header", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	columns, err := payload.Columns(header)
+	if err != nil {
+		productSvc.logger.Info("fail to payload.Columns", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// parse and validate rows
+	result := dto.NewImportProductsResponseDto(payload.DryRun)
+	rows := []*importProductRow{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			productSvc.logger.Info("fail to read csv row", zap.Error(err))
+			return nil, constants.ErrBadRequest
+		}
+		if len(rows) == constants.MaxProducts {
+			productSvc.logger.Info("too many csv rows")
+			return nil, constants.ErrBadRequest
+		}
+		line, _ := reader.FieldPos(0)
+		row := &importProductRow{line: line}
+		row.payload, err = dto.NewCreateProductDtoFromCsv(record, columns)
+		if err == nil {
+			err = row.payload.Validate()
+		}
+		if err != nil {
+			result.AddError(line, err)
+			row.failed = true
+		}
+		rows = append(rows, row)
+	}
+	result.Total = len(rows)
+
+	// sku unique in file
+	skuLines := map[string]int{}
+	for _, row := range rows {
+		if row.payload == nil || row.payload.Sku == nil {
+			continue
+		}
+		line, ok := skuLines[*row.payload.Sku]
+		if ok {
+			result.AddError(row.line, fmt.Errorf("sku: duplicate of row %d", line))
+			row.failed = true
+			continue
+		}
+		skuLines[*row.payload.Sku] = row.line
+	}
+
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// check permission in active shop, product belongs to shop owner
+		ownerId, err := authorizeShop(ctx, productSvc.logger, txc, productSvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		// lock owner before products like checkout does, quota is checked once rows are known
+		err = productSvc.userRepo.LockUserById(ctx, txc, ownerId)
+		if err != nil {
+			return err
+		}
+
+		// products to update by sku, locked until import ends
+		existing, err := productSvc.productRepo.LockProductsBySkus(ctx, txc, ownerId, lo.Keys(skuLines))
+		if err != nil {
+			return err
+		}
+		bySku := lo.KeyBy(existing, func(p *ent.Product) string { return *p.Sku })
+		for _, row := range rows {
+			if row.failed {
+				continue
+			}
+			if row.payload.Sku != nil && bySku[*row.payload.Sku] != nil {
+				if bySku[*row.payload.Sku].IsArchived {
+					result.AddError(row.line, errors.New("sku: product archived"))
+					row.failed = true
+					continue
+				}
+				result.Updated++
+				continue
+			}
+			result.Created++
+		}
+
+		// check products of shop within plan, owner already locked above
+		err = productSvc.ensureProductQuota(ctx, txc, ownerId, result.Created)
+		if err != nil {
+			if !errors.Is(err, constants.ErrQuotaExceeded) {
+				return err
+			}
+			result.AddError(0, err)
+		}
+		if len(result.Errors) > 0 || payload.DryRun {
+			return nil
+		}
+
+		for _, row := range rows {
+			product := bySku[lo.FromPtr(row.payload.Sku)]
+			if product == nil {
+				_, err = productSvc.createProduct(ctx, txc, userId, ownerId, row.payload, constants.InventoryReason.Import)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			_, err = productSvc.updateProduct(ctx, txc, userId, product, dto.NewImportUpdateProductDto(row.payload, product.Status), constants.InventoryReason.Import)
+			if err != nil {
+				return err
+			}
+		}
+		result.Imported = true
+		return nil
+	}
+	err = productSvc.productRepo.WithTx(ctx, productSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(result.Errors, func(i, j int) bool { return result.Errors[i].Row < result.Errors[j].Row })
+	return result, nil
+}
+
+// ExportProducts: products of active shop except archived as csv, oldest first, written batch by batch,
+// nothing written if error before first batch
+func (productSvc *ProductService) ExportProducts(ctx context.Context, userId string, w io.Writer) error {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return constants.ErrBadRequest
+	}
+
+	// check permission in active shop
+	ownerId, err := authorizeShop(ctx, productSvc.logger, productSvc.client, productSvc.shopRepo, userId, constants.ShopPermission.ProductRead)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	for page := 1; ; page++ {
+		payload := dto.NewQueryProductsDto(*dto.NewPaging(page, constants.ProductExportBatch, ""), "", "")
+		payload.Sort = constants.ProductSort.Created
+		payload.Order = constants.SortOrder.Asc
+		products, err := productSvc.getProducts(ctx, productSvc.client, ownerId, payload, false)
+		if err != nil {
+			return err
+		}
+		if page == 1 {
+			err = writer.Write(constants.ProductCsvField.GetList())
+			if err != nil {
+				return err
+			}
+		}
+		for _, product := range products.Data {
+			if product.IsArchived {
+				continue
+			}
+			err = writer.Write(dto.NewProductCsvRecord(product))
+			if err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		err = writer.Error()
+		if err != nil {
+			productSvc.logger.Info("fail to write csv", zap.Error(err))
+			return err
+		}
+		if page*constants.ProductExportBatch >= products.Total {
+			return nil
+		}
+	}
+}
+
+// SetProductMedia: replace gallery of product, images must be in album of shop owner,
+// cover image of product set to primary image
+func (productSvc *ProductService) SetProductMedia(
+	ctx context.Context, userId string, productId string, payload *dto.SetProductMediaDto) ([]*dto.ProductMediaResponseDto, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(productId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		productSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to set gallery with tx
+	var result []*dto.ProductMediaResponseDto
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, productSvc.logger, txc, productSvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
 		product, err := productSvc.productRepo.GetProductById(ctx, txc, productId)
 		if err != nil {
 			return err
 		}
 
-		// check product belong to user
-		if product.UserID.String() != userId {
+		// check product belong to shop
+		if product.UserID.String() != ownerId {
 			return constants.ErrUnauthorized
 		}
 
-		rs, err := productSvc.productRepo.SoftDeleteProductById(ctx, txc, productId)
+		// check images in album of shop owner
+		imgs, err := productSvc.imginfoRepo.GetImgsByIds(ctx, txc, payload.ImgInfoIds())
+		if err != nil {
+			return err
+		}
+		imgById := lo.KeyBy(imgs, func(img *ent.Imageinfo) int { return img.ID })
+		for _, imgInfoId := range payload.ImgInfoIds() {
+			img, ok := imgById[imgInfoId]
+			if !ok || img.UserID.String() != ownerId {
+				productSvc.logger.Info("image not in album of owner", zap.Int("imgInfoId", imgInfoId))
+				return constants.ErrNotFound
+			}
+		}
+
+		err = productSvc.productRepo.SetProductMediaById(ctx, txc, productId, payload)
+		if err != nil {
+			return err
+		}
+		if len(payload.Media) > 0 {
+			primary := imgById[*payload.Media[payload.PrimaryIndex()].ImgInfoId]
+			_, err = productSvc.productRepo.UpdateProductImgUrlById(ctx, txc, productId, primary.ImgURL)
+			if err != nil {
+				return err
+			}
+		}
+		rs, err := productSvc.productMedia(ctx, txc, productId)
 		if err != nil {
 			return err
 		}
@@ -203,3 +845,28 @@ func (productSvc *ProductService) SoftDeleteProductById(
 	}
 	return result, nil
 }
+
+// productMedia: gallery of product with url of each image read from album
+func (productSvc *ProductService) productMedia(
+	ctx context.Context, client *ent.Client, productId string) ([]*dto.ProductMediaResponseDto, error) {
+	media, err := productSvc.productRepo.GetProductMediaByProductId(ctx, client, productId)
+	if err != nil {
+		return nil, err
+	}
+	imgs, err := productSvc.imginfoRepo.GetImgsByIds(ctx, client,
+		lo.Map(media, func(m *ent.ProductMedia, _ int) int { return m.ImageinfoID }))
+	if err != nil {
+		return nil, err
+	}
+	imgById := lo.KeyBy(imgs, func(img *ent.Imageinfo) int { return img.ID })
+	result := []*dto.ProductMediaResponseDto{}
+	for _, m := range media {
+		img, ok := imgById[m.ImageinfoID]
+		if !ok {
+			productSvc.logger.Info("image of product media not found", zap.Int("imgInfoId", m.ImageinfoID))
+			continue
+		}
+		result = append(result, dto.NewProductMediaResponseDto(m, img.ImgURL))
+	}
+	return result, nil
+}
@@ -2,16 +2,21 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"sthl/constants"
 	"sthl/dto"
 	"sthl/ent"
+	"sthl/ent/schema"
 	"sthl/logger"
+	"sthl/money"
 	"sthl/repository"
 	"sthl/utils"
+	"strings"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,7 +31,8 @@ func productServiceTestSetup(ctx context.Context, t *testing.T) (*assert.Asserti
 	assert.NoError(err)
 	userRepo := repository.NewUserRepositoryMock()
 	productRepo := repository.NewProductRepositoryMock()
-	productService := NewProductService(zapLogger, nil, userRepo, productRepo)
+	shopRepo := repository.NewShopRepositoryMock()
+	productService := NewProductService(zapLogger, nil, userRepo, productRepo, shopRepo, repository.NewCategoryRepositoryMock(), repository.NewImgInfoRepositoryMock())
 	assert.NotEmpty(userRepo)
 	assert.NotEmpty(productRepo)
 	assert.NotEmpty(productService)
@@ -48,10 +54,11 @@ func Test_CreateProduct(t *testing.T) {
 	invalidUserId := "invalid userid"
 	validCreateProductDto := dto.NewCreateProductDto(
 		utils.PtrOf(gofakeit.Fruit()),
-		utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 		utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 		utils.PtrOf(gofakeit.LetterN(100)),
-		utils.PtrOf(gofakeit.LetterN(100)))
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil)
 
 	testCases := []createProductTestCase{
 		{
@@ -68,10 +75,11 @@ func Test_CreateProduct(t *testing.T) {
 			userId: validUserId,
 			input: dto.NewCreateProductDto(
 				utils.PtrOf(gofakeit.Vegetable()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(0)),
-				utils.PtrOf(gofakeit.LetterN(100))),
+				utils.PtrOf(gofakeit.LetterN(100)),
+				nil),
 			exec: func(result *ent.Product, e error) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
@@ -82,10 +90,11 @@ func Test_CreateProduct(t *testing.T) {
 			userId: validUserId,
 			input: dto.NewCreateProductDto(
 				utils.PtrOf(*validCreateProductDto.Name),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(100)),
-				utils.PtrOf(gofakeit.LetterN(100))),
+				utils.PtrOf(gofakeit.LetterN(100)),
+				nil),
 			exec: func(result *ent.Product, e error) {
 				assert.Empty(result)
 				assert.Error(e)
@@ -96,10 +105,11 @@ func Test_CreateProduct(t *testing.T) {
 			userId: invalidUserId,
 			input: dto.NewCreateProductDto(
 				utils.PtrOf(gofakeit.Breakfast()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(100)),
-				utils.PtrOf(gofakeit.LetterN(100))),
+				utils.PtrOf(gofakeit.LetterN(100)),
+				nil),
 			exec: func(result *ent.Product, e error) {
 				assert.Empty(result)
 				assert.Error(e)
@@ -110,10 +120,11 @@ func Test_CreateProduct(t *testing.T) {
 			userId: validUserId,
 			input: dto.NewCreateProductDto(
 				utils.PtrOf(gofakeit.Breakfast()),
-				utils.PtrOf(0.99),
+				utils.PtrOf(money.Amount(99)),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(100)),
-				utils.PtrOf(gofakeit.LetterN(100))),
+				utils.PtrOf(gofakeit.LetterN(100)),
+				nil),
 			exec: func(result *ent.Product, e error) {
 				assert.Empty(result)
 				assert.Error(e)
@@ -124,10 +135,11 @@ func Test_CreateProduct(t *testing.T) {
 			userId: validUserId,
 			input: dto.NewCreateProductDto(
 				utils.PtrOf(gofakeit.Breakfast()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(0)),
 				utils.PtrOf(gofakeit.LetterN(100)),
-				utils.PtrOf(gofakeit.LetterN(100))),
+				utils.PtrOf(gofakeit.LetterN(100)),
+				nil),
 			exec: func(result *ent.Product, e error) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
@@ -158,16 +170,18 @@ func Test_GetProducts(t *testing.T) {
 
 	validProductA := dto.NewCreateProductDto(
 		utils.PtrOf(gofakeit.Fruit()),
-		utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 		utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 		utils.PtrOf(gofakeit.LetterN(100)),
-		utils.PtrOf(gofakeit.LetterN(100)))
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil)
 	validProductB := dto.NewCreateProductDto(
 		utils.PtrOf(gofakeit.Animal()),
-		utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 		utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 		utils.PtrOf(gofakeit.LetterN(100)),
-		utils.PtrOf(gofakeit.LetterN(100)))
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil)
 
 	productAId, err := productSvc.CreateProduct(ctx, validUserId, validProductA)
 	assert.NotEmpty(productAId)
@@ -181,7 +195,7 @@ func Test_GetProducts(t *testing.T) {
 		{
 			name:   "invalid paging",
 			userId: validUserId,
-			input:  dto.NewQueryProductsDto(*dto.NewPaging(-1, 2, "")),
+			input:  dto.NewQueryProductsDto(*dto.NewPaging(-1, 2, ""), "", ""),
 			exec: func(result *dto.QueryProductsResponseDto, e error) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
@@ -190,7 +204,7 @@ func Test_GetProducts(t *testing.T) {
 		{
 			name:   "invalid paging, limit exceed 1000",
 			userId: validUserId,
-			input:  dto.NewQueryProductsDto(*dto.NewPaging(1, 1001, "")),
+			input:  dto.NewQueryProductsDto(*dto.NewPaging(1, 1001, ""), "", ""),
 			exec: func(result *dto.QueryProductsResponseDto, e error) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
@@ -199,7 +213,7 @@ func Test_GetProducts(t *testing.T) {
 		{
 			name:   "valid paging",
 			userId: validUserId,
-			input:  dto.NewQueryProductsDto(*dto.NewPaging(1, 10, "")),
+			input:  dto.NewQueryProductsDto(*dto.NewPaging(1, 10, ""), "", ""),
 			exec: func(result *dto.QueryProductsResponseDto, e error) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
@@ -208,7 +222,7 @@ func Test_GetProducts(t *testing.T) {
 		{
 			name:   "valid paging, limit",
 			userId: validUserId,
-			input:  dto.NewQueryProductsDto(*dto.NewPaging(1, 1, "")),
+			input:  dto.NewQueryProductsDto(*dto.NewPaging(1, 1, ""), "", ""),
 			exec: func(result *dto.QueryProductsResponseDto, e error) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
@@ -217,7 +231,7 @@ func Test_GetProducts(t *testing.T) {
 		{
 			name:   "invalid userId",
 			userId: invalidUserId,
-			input:  dto.NewQueryProductsDto(*dto.NewPaging(1, 1, "")),
+			input:  dto.NewQueryProductsDto(*dto.NewPaging(1, 1, ""), "", ""),
 			exec: func(result *dto.QueryProductsResponseDto, e error) {
 				assert.Empty(result)
 				assert.Error(e)
@@ -226,7 +240,7 @@ func Test_GetProducts(t *testing.T) {
 		{
 			name:   "incorrect userId",
 			userId: uuid.NewString(),
-			input:  dto.NewQueryProductsDto(*dto.NewPaging(1, 1, "")),
+			input:  dto.NewQueryProductsDto(*dto.NewPaging(1, 1, ""), "", ""),
 			exec: func(result *dto.QueryProductsResponseDto, e error) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
@@ -240,11 +254,338 @@ func Test_GetProducts(t *testing.T) {
 	}
 }
 
+// ****Test_QueryProducts
+func Test_QueryProducts(t *testing.T) {
+	ctx := context.TODO()
+	assert, productSvc := productServiceTestSetup(ctx, t)
+
+	validUserId := uuid.NewString()
+	create := func(name string, price int64, quantity int32) *ent.Product {
+		p, err := productSvc.CreateProduct(ctx, validUserId, dto.NewCreateProductDto(
+			utils.PtrOf(name),
+			utils.PtrOf(money.Amount(price)),
+			utils.PtrOf(quantity),
+			utils.PtrOf(gofakeit.LetterN(100)),
+			utils.PtrOf(gofakeit.LetterN(100)),
+			nil))
+		assert.NotEmpty(p)
+		assert.NoError(err)
+		return p
+	}
+	apple := create("apple", 300, 5)
+	banana := create("banana", 100, 0)
+	cherry := create("cherry", 200, 8)
+	durian := create("durian", 400, 3)
+
+	// cherry set inactive, durian archived
+	inactive, err := productSvc.UpdateProductById(ctx, validUserId, cherry.ID.String(), cherry.Version, dto.NewUpdateProductDto(
+		&cherry.Name, &cherry.Price, &cherry.Quantity, &cherry.Description, utils.PtrOf(constants.ProductStatus.Inactive), &cherry.ImgURL))
+	assert.NotEmpty(inactive)
+	assert.NoError(err)
+	archived, err := productSvc.SoftDeleteProductById(ctx, validUserId, durian.ID.String(), durian.Version)
+	assert.NotEmpty(archived)
+	assert.NoError(err)
+
+	names := func(result *dto.QueryProductsResponseDto) []string {
+		return lo.Map(result.Data, func(p *ent.Product, _ int) string { return p.Name })
+	}
+	query := func(modify func(*dto.QueryProductsDto)) *dto.QueryProductsDto {
+		d := dto.NewQueryProductsDto(*dto.NewPaging(1, 10, ""), "", "")
+		modify(d)
+		return d
+	}
+
+	// storefront hides inactive and archived products
+	result, err := productSvc.GetPrdoucts(ctx, validUserId, query(func(d *dto.QueryProductsDto) { d.Sort = constants.ProductSort.Name }))
+	assert.NoError(err)
+	assert.Equal([]string{apple.Name, banana.Name}, names(result))
+	assert.Equal(2, result.Total)
+
+	// staff listing shows all, sorted by price descending
+	result, err = productSvc.GetShopProducts(ctx, validUserId, query(func(d *dto.QueryProductsDto) {
+		d.Sort = constants.ProductSort.Price
+		d.Order = constants.SortOrder.Desc
+	}))
+	assert.NoError(err)
+	assert.Equal([]string{durian.Name, apple.Name, cherry.Name, banana.Name}, names(result))
+	assert.Equal(4, result.Total)
+
+	// price range and in stock
+	result, err = productSvc.GetShopProducts(ctx, validUserId, query(func(d *dto.QueryProductsDto) {
+		d.Sort = constants.ProductSort.Price
+		d.MinPrice = utils.PtrOf(money.Amount(100))
+		d.MaxPrice = utils.PtrOf(money.Amount(300))
+		d.InStock = true
+	}))
+	assert.NoError(err)
+	assert.Equal([]string{cherry.Name, apple.Name}, names(result))
+	assert.Equal(2, result.Total)
+
+	// statuses, total counts all matches beyond page
+	result, err = productSvc.GetShopProducts(ctx, validUserId, query(func(d *dto.QueryProductsDto) {
+		d.Paging = *dto.NewPaging(1, 1, "")
+		d.Statuses = []string{constants.ProductStatus.Initiated}
+	}))
+	assert.NoError(err)
+	assert.Len(result.Data, 1)
+	assert.Equal(3, result.Total)
+
+	// invalid filters
+	for _, invalid := range []*dto.QueryProductsDto{
+		query(func(d *dto.QueryProductsDto) { d.Sort = "wrong" }),
+		query(func(d *dto.QueryProductsDto) { d.Order = "wrong" }),
+		query(func(d *dto.QueryProductsDto) { d.MinPrice = utils.PtrOf(money.Amount(-1)) }),
+		query(func(d *dto.QueryProductsDto) {
+			d.MinPrice = utils.PtrOf(money.Amount(300))
+			d.MaxPrice = utils.PtrOf(money.Amount(100))
+		}),
+		query(func(d *dto.QueryProductsDto) { d.Statuses = []string{"wrong"} }),
+	} {
+		result, err = productSvc.GetPrdoucts(ctx, validUserId, invalid)
+		assert.Empty(result)
+		assert.ErrorIs(err, constants.ErrBadRequest)
+	}
+
+	// staff listing requires shop permission
+	result, err = productSvc.GetShopProducts(ctx, validUserId+"wrong", query(func(d *dto.QueryProductsDto) {}))
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+}
+
+// ****Test_SearchProducts
+func Test_SearchProducts(t *testing.T) {
+	ctx := context.TODO()
+	assert, productSvc := productServiceTestSetup(ctx, t)
+
+	validUserId := uuid.NewString()
+	create := func(name string, description string) *ent.Product {
+		p, err := productSvc.CreateProduct(ctx, validUserId, dto.NewCreateProductDto(
+			utils.PtrOf(name),
+			utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+			utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
+			utils.PtrOf(description),
+			utils.PtrOf(gofakeit.LetterN(100)),
+			nil))
+		assert.NotEmpty(p)
+		assert.NoError(err)
+		return p
+	}
+	redShirt := create("Red Shirt", "cotton tee")
+	blueShirt := create("Blue Shirt", "with red stripes")
+	redMug := create("Red Mug", "ceramic cup")
+	deskLamp := create("Desk Lamp", "warm light")
+
+	names := func(result *dto.QueryProductsResponseDto) []string {
+		return lo.Map(result.Data, func(p *ent.Product, _ int) string { return p.Name })
+	}
+	search := func(query string, sort string) *dto.QueryProductsDto {
+		d := dto.NewQueryProductsDto(*dto.NewPaging(1, 10, query), "", "")
+		d.Sort = sort
+		return d
+	}
+
+	// all terms matched, name hits ranked over description hits
+	result, err := productSvc.GetPrdoucts(ctx, validUserId, search("red shirt", ""))
+	assert.NoError(err)
+	assert.Equal([]string{redShirt.Name, blueShirt.Name}, names(result))
+	assert.Equal(2, result.Total)
+
+	// prefix and case insensitive
+	result, err = productSvc.GetPrdoucts(ctx, validUserId, search("SHI", constants.ProductSort.Name))
+	assert.NoError(err)
+	assert.Equal([]string{blueShirt.Name, redShirt.Name}, names(result))
+
+	// description
+	result, err = productSvc.GetShopProducts(ctx, validUserId, search("ceramic", ""))
+	assert.NoError(err)
+	assert.Equal([]string{redMug.Name}, names(result))
+
+	// typo by similarity
+	result, err = productSvc.GetShopProducts(ctx, validUserId, search("lampp", ""))
+	assert.NoError(err)
+	assert.Equal([]string{deskLamp.Name}, names(result))
+	assert.Equal(1, result.Total)
+
+	// no match
+	result, err = productSvc.GetPrdoucts(ctx, validUserId, search("zzzz", ""))
+	assert.NoError(err)
+	assert.Empty(result.Data)
+	assert.Equal(0, result.Total)
+
+	// relevance requires query
+	result, err = productSvc.GetPrdoucts(ctx, validUserId, search(" ", constants.ProductSort.Relevance))
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+}
+
+// ****Test_ImportProducts
+func Test_ImportProducts(t *testing.T) {
+	ctx := context.TODO()
+	assert, productSvc := productServiceTestSetup(ctx, t)
+
+	validUserId := uuid.NewString()
+	importCsv := func(userId string, csv string, dryRun bool) (*dto.ImportProductsResponseDto, error) {
+		return productSvc.ImportProducts(ctx, userId, dto.NewImportProductsDto(nil, dryRun), strings.NewReader(csv))
+	}
+	shopProducts := func() []*ent.Product {
+		result, err := productSvc.GetShopProducts(ctx, validUserId, dto.NewQueryProductsDto(*dto.NewPaging(1, 100, ""), "", ""))
+		assert.NoError(err)
+		return result.Data
+	}
+
+	// row errors, nothing written
+	result, err := importCsv(validUserId, "sku,name,price,quantity\n"+
+		"A-1,Apple,1.5,3\n"+
+		"A-2,,2,1\n"+
+		"A-3,Cherry,abc,1\n"+
+		"A-1,Durian,4,1\n", false)
+	assert.NoError(err)
+	assert.False(result.Imported)
+	assert.Equal(4, result.Total)
+	assert.Equal([]int{3, 4, 5}, lo.Map(result.Errors, func(e dto.ImportProductRowErrorDto, _ int) int { return e.Row }))
+	assert.Equal("sku: duplicate of row 2", result.Errors[2].Message)
+	assert.Empty(shopProducts())
+
+	// dry run, byte order mark and column order
+	result, err = importCsv(validUserId, "\ufeffquantity,price,name,sku,description\n3,1.5,Apple,A-1,\"red, sweet\"\n", true)
+	assert.NoError(err)
+	assert.Empty(result.Errors)
+	assert.Equal(1, result.Created)
+	assert.False(result.Imported)
+	assert.Empty(shopProducts())
+
+	// import
+	result, err = importCsv(validUserId, "quantity,price,name,sku,description\n3,1.5,Apple,A-1,\"red, sweet\"\n5,2,Banana,,\n", false)
+	assert.NoError(err)
+	assert.True(result.Imported)
+	assert.Equal(2, result.Created)
+	products := shopProducts()
+	assert.Len(products, 2)
+	apple, _ := lo.Find(products, func(p *ent.Product) bool { return p.Name == "Apple" })
+	assert.Equal("A-1", lo.FromPtr(apple.Sku))
+	assert.Equal(money.Amount(150), apple.Price)
+	assert.Equal("red, sweet", apple.Description)
+
+	// upsert by sku, quantity change in ledger
+	result, err = importCsv(validUserId, "sku,name,price,quantity\nA-1,Green Apple,1.8,7\nC-1,Cherry,3,1\n", false)
+	assert.NoError(err)
+	assert.True(result.Imported)
+	assert.Equal(1, result.Created)
+	assert.Equal(1, result.Updated)
+	products = shopProducts()
+	assert.Len(products, 3)
+	updated, _ := lo.Find(products, func(p *ent.Product) bool { return p.ID == apple.ID })
+	assert.Equal("Green Apple", updated.Name)
+	assert.Equal(int32(7), updated.Quantity)
+	assert.Equal(apple.Version+1, updated.Version)
+	movements, err := productSvc.GetInventoryMovements(ctx, validUserId, apple.ID.String(),
+		dto.NewQueryInventoryMovementsDto(*dto.NewPaging(1, 20, "")))
+	assert.NoError(err)
+	assert.True(movements.Reconciled)
+	assert.Equal([]string{constants.InventoryReason.Import, constants.InventoryReason.Import},
+		lo.Map(movements.Data, func(m *ent.InventoryMovement, _ int) string { return m.Reason }))
+	assert.Equal([]int32{4, 3}, lo.Map(movements.Data, func(m *ent.InventoryMovement, _ int) int32 { return m.Delta }))
+
+	// sku of archived product
+	archived, err := productSvc.SoftDeleteProductById(ctx, validUserId, updated.ID.String(), updated.Version)
+	assert.NotEmpty(archived)
+	assert.NoError(err)
+	result, err = importCsv(validUserId, "sku,name,price,quantity\nA-1,Apple,1.8,7\n", false)
+	assert.NoError(err)
+	assert.False(result.Imported)
+	assert.Equal("sku: product archived", result.Errors[0].Message)
+
+	// missing required column, malformed csv, invalid mapping
+	result, err = importCsv(validUserId, "sku,name,price\nA-9,Fig,1\n", false)
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	result, err = importCsv(validUserId, "sku,name,price,quantity\nA-9,Fig,1\n", false)
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	result, err = productSvc.ImportProducts(ctx, validUserId,
+		dto.NewImportProductsDto(map[string]string{"status": "Status"}, false), strings.NewReader("name,price,quantity\n"))
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// invalid userId
+	result, err = importCsv(validUserId+"wrong", "sku,name,price,quantity\n", false)
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+}
+
+// ****Test_ImportProductsLimit
+func Test_ImportProductsLimit(t *testing.T) {
+	ctx := context.TODO()
+	assert, productSvc := productServiceTestSetup(ctx, t)
+
+	validUserId := uuid.NewString()
+	limit := constants.Plan.GetLimit(constants.Plan.Free).Products
+	rows := lo.Times(limit, func(i int) string { return fmt.Sprintf("Product %d,1,1", i) })
+	csv := "name,price,quantity\n" + strings.Join(rows, "\n")
+	result, err := productSvc.ImportProducts(ctx, validUserId, dto.NewImportProductsDto(nil, true), strings.NewReader(csv))
+	assert.NoError(err)
+	assert.Empty(result.Errors)
+
+	// one more than limit of plan with existing product
+	_, err = productSvc.CreateProduct(ctx, validUserId, dto.NewCreateProductDto(
+		utils.PtrOf("Existing"), utils.PtrOf(money.Amount(100)), utils.PtrOf(int32(1)), utils.PtrOf(""), utils.PtrOf(""), nil))
+	assert.NoError(err)
+	result, err = productSvc.ImportProducts(ctx, validUserId, dto.NewImportProductsDto(nil, true), strings.NewReader(csv))
+	assert.NoError(err)
+	assert.Equal(0, result.Errors[0].Row)
+	assert.Contains(result.Errors[0].Message, constants.ErrQuotaExceeded.Error())
+
+	// rows over limit
+	rows = lo.Times(constants.MaxProducts+1, func(i int) string { return fmt.Sprintf("Product %d,1,1", i) })
+	result, err = productSvc.ImportProducts(ctx, validUserId, dto.NewImportProductsDto(nil, true),
+		strings.NewReader("name,price,quantity\n"+strings.Join(rows, "\n")))
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+}
+
+// ****Test_CreateProductQuota
+func Test_CreateProductQuota(t *testing.T) {
+	ctx := context.TODO()
+	assert, productSvc := productServiceTestSetup(ctx, t)
+
+	validUserId := uuid.NewString()
+	limit := constants.Plan.GetLimit(constants.Plan.Free).Products
+	rows := lo.Times(limit-1, func(i int) string { return fmt.Sprintf("Product %d,1,1", i) })
+	result, err := productSvc.ImportProducts(ctx, validUserId, dto.NewImportProductsDto(nil, false),
+		strings.NewReader("name,price,quantity\n"+strings.Join(rows, "\n")))
+	assert.NoError(err)
+	assert.True(result.Imported)
+
+	// last product within limit
+	payload := dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()), utils.PtrOf(money.Amount(100)), utils.PtrOf(int32(1)), utils.PtrOf(""), utils.PtrOf(""), nil)
+	last, err := productSvc.CreateProduct(ctx, validUserId, payload)
+	assert.NoError(err)
+
+	// over limit
+	rs, err := productSvc.CreateProduct(ctx, validUserId, payload)
+	assert.Empty(rs)
+	assert.ErrorIs(err, constants.ErrQuotaExceeded)
+
+	// deleted product frees quota
+	_, err = productSvc.SoftDeleteProductById(ctx, validUserId, last.ID.String(), last.Version)
+	assert.NoError(err)
+	_, err = productSvc.CreateProduct(ctx, validUserId, dto.NewCreateProductDto(
+		utils.PtrOf("Replacement"), utils.PtrOf(money.Amount(100)), utils.PtrOf(int32(1)), utils.PtrOf(""), utils.PtrOf(""), nil))
+	assert.NoError(err)
+
+	// limit per owner
+	_, err = productSvc.CreateProduct(ctx, uuid.NewString(), dto.NewCreateProductDto(
+		utils.PtrOf("Other"), utils.PtrOf(money.Amount(100)), utils.PtrOf(int32(1)), utils.PtrOf(""), utils.PtrOf(""), nil))
+	assert.NoError(err)
+}
+
 // ****Test_GetProductById
 type getProductByIdTestCase struct {
 	name      string
+	userId    string
 	productId string
-	exec      func(*ent.Product, error)
+	exec      func(*dto.ProductResponseDto, error)
 }
 
 func Test_GetProductById(t *testing.T) {
@@ -254,46 +595,103 @@ func Test_GetProductById(t *testing.T) {
 	validUserId := uuid.NewString()
 	invalidUserId := validUserId + "wrong"
 
-	validProductDto := dto.NewCreateProductDto(
-		utils.PtrOf(gofakeit.Fruit()),
-		utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
-		utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
-		utils.PtrOf(gofakeit.LetterN(100)),
-		utils.PtrOf(gofakeit.LetterN(100)))
-	validProduct, err := productSvc.CreateProduct(ctx, validUserId, validProductDto)
+	newProductDto := func() *dto.CreateProductDto {
+		return dto.NewCreateProductDto(
+			utils.PtrOf(gofakeit.LetterN(20)),
+			utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+			utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
+			utils.PtrOf(gofakeit.LetterN(100)),
+			utils.PtrOf(gofakeit.LetterN(100)),
+			nil)
+	}
+	validProduct, err := productSvc.CreateProduct(ctx, validUserId, newProductDto())
 	assert.NotEmpty(validProduct)
 	assert.NoError(err)
 
+	// pre inactive and archived products
+	inactiveProduct, err := productSvc.CreateProduct(ctx, validUserId, newProductDto())
+	assert.NoError(err)
+	inactiveProduct, err = productSvc.UpdateProductById(ctx, validUserId, inactiveProduct.ID.String(), inactiveProduct.Version,
+		dto.NewUpdateProductDto(&inactiveProduct.Name, &inactiveProduct.Price, &inactiveProduct.Quantity, &inactiveProduct.Description,
+			utils.PtrOf(constants.ProductStatus.Inactive), &inactiveProduct.ImgURL))
+	assert.NoError(err)
+	assert.Equal(constants.ProductStatus.Inactive, inactiveProduct.Status)
+	archivedProduct, err := productSvc.CreateProduct(ctx, validUserId, newProductDto())
+	assert.NoError(err)
+	archivedProduct, err = productSvc.SoftDeleteProductById(ctx, validUserId, archivedProduct.ID.String(), archivedProduct.Version)
+	assert.NoError(err)
+	assert.True(archivedProduct.IsArchived)
+
 	testCases := []getProductByIdTestCase{
 		{
 			name:      "get with correct info",
+			userId:    validUserId,
 			productId: validProduct.ID.String(),
-			exec: func(result *ent.Product, e error) {
+			exec: func(result *dto.ProductResponseDto, e error) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
+				assert.Empty(result.Variants)
 			},
 		},
 		{
 			name:      "get with invalid userId",
+			userId:    invalidUserId,
+			productId: validProduct.ID.String(),
+			exec: func(result *dto.ProductResponseDto, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrBadRequest)
+			},
+		},
+		{
+			name:      "get with invalid productId",
+			userId:    validUserId,
 			productId: invalidUserId,
-			exec: func(result *ent.Product, e error) {
+			exec: func(result *dto.ProductResponseDto, e error) {
 				assert.Empty(result)
-				assert.Error(e)
+				assert.ErrorIs(e, constants.ErrBadRequest)
 			},
 		},
 		{
 			name:      "get with incorrect productId",
+			userId:    validUserId,
 			productId: uuid.NewString(),
-			exec: func(result *ent.Product, e error) {
+			exec: func(result *dto.ProductResponseDto, e error) {
 				assert.Empty(result)
 				assert.Error(e)
 			},
 		},
+		{
+			name:      "get under other shop",
+			userId:    uuid.NewString(),
+			productId: validProduct.ID.String(),
+			exec: func(result *dto.ProductResponseDto, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrNotFound)
+			},
+		},
+		{
+			name:      "get inactive product",
+			userId:    validUserId,
+			productId: inactiveProduct.ID.String(),
+			exec: func(result *dto.ProductResponseDto, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrNotFound)
+			},
+		},
+		{
+			name:      "get archived product",
+			userId:    validUserId,
+			productId: archivedProduct.ID.String(),
+			exec: func(result *dto.ProductResponseDto, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrNotFound)
+			},
+		},
 	}
 
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			test.exec(productSvc.GetProductById(ctx, test.productId))
+			test.exec(productSvc.GetProductById(ctx, test.userId, test.productId))
 		})
 	}
 }
@@ -303,6 +701,7 @@ type updateProductByIdTestCase struct {
 	name      string
 	userId    string
 	productId string
+	version   int64
 	input     *dto.UpdateProductDto
 	exec      func(*ent.Product, error)
 }
@@ -316,10 +715,11 @@ func Test_UpdateProductById(t *testing.T) {
 
 	validProductDto := dto.NewCreateProductDto(
 		utils.PtrOf(gofakeit.Fruit()),
-		utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 		utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 		utils.PtrOf(gofakeit.LetterN(100)),
-		utils.PtrOf(gofakeit.LetterN(100)))
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil)
 
 	validProduct, err := productSvc.CreateProduct(ctx, validUserId, validProductDto)
 	invalidProductId := validProduct.ID.String() + "wrong"
@@ -331,9 +731,10 @@ func Test_UpdateProductById(t *testing.T) {
 			name:      "success update",
 			userId:    validUserId,
 			productId: validProduct.ID.String(),
+			version:   validProduct.Version,
 			input: dto.NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Vegetable()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(100)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -342,6 +743,25 @@ func Test_UpdateProductById(t *testing.T) {
 			exec: func(result *ent.Product, e error) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
+				assert.Equal(validProduct.Version+1, result.Version)
+			},
+		},
+		{
+			name:      "update with stale version",
+			userId:    validUserId,
+			productId: validProduct.ID.String(),
+			version:   validProduct.Version,
+			input: dto.NewUpdateProductDto(
+				utils.PtrOf(gofakeit.Vegetable()),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
+				utils.PtrOf(gofakeit.LetterN(100)),
+				utils.PtrOf(constants.ProductStatus.Active),
+				utils.PtrOf(gofakeit.LetterN(100)),
+			),
+			exec: func(result *ent.Product, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrPreconditionFailed)
 			},
 		},
 		{
@@ -350,7 +770,7 @@ func Test_UpdateProductById(t *testing.T) {
 			productId: invalidProductId,
 			input: dto.NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Lunch()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(100)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -367,7 +787,7 @@ func Test_UpdateProductById(t *testing.T) {
 			productId: validProduct.ID.String(),
 			input: dto.NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Lunch()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(100)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -384,7 +804,7 @@ func Test_UpdateProductById(t *testing.T) {
 			productId: validProduct.ID.String(),
 			input: dto.NewUpdateProductDto(
 				utils.PtrOf("a"),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(100)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -401,7 +821,7 @@ func Test_UpdateProductById(t *testing.T) {
 			productId: validProduct.ID.String(),
 			input: dto.NewUpdateProductDto(
 				nil,
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(100)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -418,7 +838,7 @@ func Test_UpdateProductById(t *testing.T) {
 			productId: validProduct.ID.String(),
 			input: dto.NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Lunch()),
-				utils.PtrOf(-1.0),
+				utils.PtrOf(money.Amount(-100)),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(100)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -452,7 +872,7 @@ func Test_UpdateProductById(t *testing.T) {
 			productId: validProduct.ID.String(),
 			input: dto.NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Lunch()),
-				utils.PtrOf(0.0),
+				utils.PtrOf(money.Amount(0)),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(gofakeit.LetterN(100)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -469,7 +889,7 @@ func Test_UpdateProductById(t *testing.T) {
 			productId: validProduct.ID.String(),
 			input: dto.NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Lunch()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				nil,
 				utils.PtrOf(gofakeit.LetterN(100)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -484,9 +904,10 @@ func Test_UpdateProductById(t *testing.T) {
 			name:      "success update with valid params, quantity 0",
 			userId:    validUserId,
 			productId: validProduct.ID.String(),
+			version:   validProduct.Version + 1,
 			input: dto.NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Lunch()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(0)),
 				utils.PtrOf(gofakeit.LetterN(100)),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -501,9 +922,10 @@ func Test_UpdateProductById(t *testing.T) {
 			name:      "success update with valid params, desc empty",
 			userId:    validUserId,
 			productId: validProduct.ID.String(),
+			version:   validProduct.Version + 2,
 			input: dto.NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Lunch()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				utils.PtrOf(""),
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -520,7 +942,7 @@ func Test_UpdateProductById(t *testing.T) {
 			productId: validProduct.ID.String(),
 			input: dto.NewUpdateProductDto(
 				utils.PtrOf(gofakeit.Lunch()),
-				utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+				utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 				utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 				nil,
 				utils.PtrOf(constants.ProductStatus.Active),
@@ -537,7 +959,7 @@ func Test_UpdateProductById(t *testing.T) {
 		// 	productId: validProduct.ID.String(),
 		// 	input: dto.NewUpdateProductDto(
 		// 		utils.PtrOf(gofakeit.Lunch()),
-		// 		utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+		// 		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 		// 		utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 		// 		utils.PtrOf(gofakeit.LetterN(100)),
 		// 		utils.PtrOf(constants.ProductStatus.Archived),
@@ -551,7 +973,7 @@ func Test_UpdateProductById(t *testing.T) {
 
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			test.exec(productSvc.UpdateProductById(ctx, test.userId, test.productId, test.input))
+			test.exec(productSvc.UpdateProductById(ctx, test.userId, test.productId, test.version, test.input))
 		})
 	}
 }
@@ -561,6 +983,7 @@ type deleteProductByIdTestCase struct {
 	name      string
 	userId    string
 	productId string
+	version   int64
 	exec      func(*ent.Product, error)
 }
 
@@ -573,10 +996,11 @@ func Test_DeleteProductById(t *testing.T) {
 
 	validProductDto := dto.NewCreateProductDto(
 		utils.PtrOf(gofakeit.Fruit()),
-		utils.PtrOf(gofakeit.Float64Range(1.0, 1000000.0)),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
 		utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
 		utils.PtrOf(gofakeit.LetterN(100)),
-		utils.PtrOf(gofakeit.LetterN(100)))
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil)
 
 	validProduct, err := productSvc.CreateProduct(ctx, validUserId, validProductDto)
 	invalidProductId := validProduct.ID.String() + "wrong"
@@ -602,10 +1026,21 @@ func Test_DeleteProductById(t *testing.T) {
 				assert.Error(e)
 			},
 		},
+		{
+			name:      "soft delete with stale version",
+			userId:    validUserId,
+			productId: validProduct.ID.String(),
+			version:   validProduct.Version + 1,
+			exec: func(result *ent.Product, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrPreconditionFailed)
+			},
+		},
 		{
 			name:      "success soft delete",
 			userId:    validUserId,
 			productId: validProduct.ID.String(),
+			version:   validProduct.Version,
 			exec: func(result *ent.Product, e error) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
@@ -617,7 +1052,300 @@ func Test_DeleteProductById(t *testing.T) {
 
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			test.exec(productSvc.SoftDeleteProductById(ctx, test.userId, test.productId))
+			test.exec(productSvc.SoftDeleteProductById(ctx, test.userId, test.productId, test.version))
 		})
 	}
 }
+
+// ****Test_InventoryMovements
+func Test_InventoryMovements(t *testing.T) {
+	ctx := context.TODO()
+	assert, productSvc := productServiceTestSetup(ctx, t)
+
+	validUserId := uuid.NewString()
+	validProduct, err := productSvc.CreateProduct(ctx, validUserId, dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+		utils.PtrOf(int32(10)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil))
+	assert.NotEmpty(validProduct)
+	assert.NoError(err)
+	validProductId := validProduct.ID.String()
+
+	// quantity set on update is recorded as manual
+	updated, err := productSvc.UpdateProductById(ctx, validUserId, validProductId, validProduct.Version, dto.NewUpdateProductDto(
+		&validProduct.Name, &validProduct.Price, utils.PtrOf(int32(7)), &validProduct.Description, &validProduct.Status, &validProduct.ImgURL))
+	assert.NotEmpty(updated)
+	assert.NoError(err)
+
+	// manual adjustments
+	adjusted, err := productSvc.AdjustProductQuantityById(ctx, validUserId, validProductId,
+		dto.NewAdjustInventoryDto(utils.PtrOf(int32(5)), utils.PtrOf(constants.InventoryReason.Return), utils.PtrOf("customer return")))
+	assert.NoError(err)
+	assert.Equal(int32(12), adjusted.Quantity)
+	assert.Equal(updated.Version, adjusted.Version)
+
+	// stock movement keeps version, edit read before it applies and nil quantity keeps stock
+	edited, err := productSvc.UpdateProductById(ctx, validUserId, validProductId, updated.Version, dto.NewUpdateProductDto(
+		&validProduct.Name, &validProduct.Price, nil, utils.PtrOf("edited"), &validProduct.Status, &validProduct.ImgURL))
+	assert.NoError(err)
+	assert.Equal(int32(12), edited.Quantity)
+	assert.Equal(updated.Version+1, edited.Version)
+
+	adjusted, err = productSvc.AdjustProductQuantityById(ctx, validUserId, validProductId,
+		dto.NewAdjustInventoryDto(utils.PtrOf(int32(-13)), utils.PtrOf(constants.InventoryReason.Manual), utils.PtrOf("")))
+	assert.Empty(adjusted)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	adjusted, err = productSvc.AdjustProductQuantityById(ctx, validUserId, validProductId,
+		dto.NewAdjustInventoryDto(utils.PtrOf(int32(-2)), utils.PtrOf(constants.InventoryReason.OrderPlaced), utils.PtrOf("")))
+	assert.Empty(adjusted)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	adjusted, err = productSvc.AdjustProductQuantityById(ctx, validUserId, validProductId,
+		dto.NewAdjustInventoryDto(utils.PtrOf(int32(0)), utils.PtrOf(constants.InventoryReason.Manual), utils.PtrOf("")))
+	assert.Empty(adjusted)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	adjusted, err = productSvc.AdjustProductQuantityById(ctx, uuid.NewString(), validProductId,
+		dto.NewAdjustInventoryDto(utils.PtrOf(int32(1)), utils.PtrOf(constants.InventoryReason.Manual), utils.PtrOf("")))
+	assert.Empty(adjusted)
+	assert.ErrorIs(err, constants.ErrUnauthorized)
+
+	// ledger newest first and reconciled with quantity
+	result, err := productSvc.GetInventoryMovements(ctx, validUserId, validProductId,
+		dto.NewQueryInventoryMovementsDto(*dto.NewPaging(1, 20, "")))
+	assert.NoError(err)
+	assert.Equal(3, result.Total)
+	assert.Equal(int32(12), result.Quantity)
+	assert.Equal(int32(12), result.LedgerQuantity)
+	assert.True(result.Reconciled)
+	assert.Equal([]string{constants.InventoryReason.Return, constants.InventoryReason.Manual, constants.InventoryReason.Initial},
+		[]string{result.Data[0].Reason, result.Data[1].Reason, result.Data[2].Reason})
+	assert.Equal([]int32{5, -3, 10}, []int32{result.Data[0].Delta, result.Data[1].Delta, result.Data[2].Delta})
+	assert.Equal("customer return", result.Data[0].Note)
+
+	// ledger of other shop product is not readable
+	result, err = productSvc.GetInventoryMovements(ctx, uuid.NewString(), validProductId,
+		dto.NewQueryInventoryMovementsDto(*dto.NewPaging(1, 20, "")))
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrUnauthorized)
+}
+
+// ****Test_ProductVariants
+func Test_ProductVariants(t *testing.T) {
+	ctx := context.TODO()
+	assert, productSvc := productServiceTestSetup(ctx, t)
+
+	validUserId := uuid.NewString()
+	validProductDto := dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()),
+		utils.PtrOf(money.Amount(1000)),
+		utils.PtrOf(int32(0)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil)
+	validProductDto.Options = []schema.ProductOption{
+		{Name: "Size", Values: []string{"S", "M"}},
+		{Name: "Colour", Values: []string{"Red", "Blue"}},
+	}
+	validProduct, err := productSvc.CreateProduct(ctx, validUserId, validProductDto)
+	assert.NotEmpty(validProduct)
+	assert.NoError(err)
+	validProductId := validProduct.ID.String()
+
+	// option names must be unique
+	invalidProductDto := *validProductDto
+	invalidProductDto.Name = utils.PtrOf(gofakeit.Fruit() + "x")
+	invalidProductDto.Options = []schema.ProductOption{{Name: "Size", Values: []string{"S"}}, {Name: "Size", Values: []string{"M"}}}
+	invalidProduct, err := productSvc.CreateProduct(ctx, validUserId, &invalidProductDto)
+	assert.Empty(invalidProduct)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// create variants, price override optional
+	small, err := productSvc.CreateProductVariant(ctx, validUserId, validProductId, dto.NewCreateProductVariantDto(
+		utils.PtrOf("TEE-S-RED"), map[string]string{"Size": "S", "Colour": "Red"}, nil, utils.PtrOf(int32(4)), utils.PtrOf("")))
+	assert.NoError(err)
+	assert.Nil(small.Price)
+	medium, err := productSvc.CreateProductVariant(ctx, validUserId, validProductId, dto.NewCreateProductVariantDto(
+		utils.PtrOf("TEE-M-RED"), map[string]string{"Size": "M", "Colour": "Red"}, utils.PtrOf(money.Amount(1200)), utils.PtrOf(int32(0)), utils.PtrOf("")))
+	assert.NoError(err)
+	assert.Equal(money.Amount(1200), *medium.Price)
+
+	// invalid variants
+	invalidVariantDtos := map[error]*dto.CreateProductVariantDto{
+		constants.ErrExisted: dto.NewCreateProductVariantDto(
+			utils.PtrOf("TEE-S-RED"), map[string]string{"Size": "S", "Colour": "Blue"}, nil, utils.PtrOf(int32(0)), utils.PtrOf("")),
+		constants.ErrBadRequest: dto.NewCreateProductVariantDto(
+			utils.PtrOf("TEE-L-RED"), map[string]string{"Size": "L", "Colour": "Red"}, nil, utils.PtrOf(int32(0)), utils.PtrOf("")),
+	}
+	for expected, invalidVariantDto := range invalidVariantDtos {
+		variant, err := productSvc.CreateProductVariant(ctx, validUserId, validProductId, invalidVariantDto)
+		assert.Empty(variant)
+		assert.ErrorIs(err, expected)
+	}
+	variant, err := productSvc.CreateProductVariant(ctx, validUserId, validProductId, dto.NewCreateProductVariantDto(
+		utils.PtrOf("TEE-S-RED-2"), map[string]string{"Size": "S", "Colour": "Red"}, nil, utils.PtrOf(int32(0)), utils.PtrOf("")))
+	assert.Empty(variant)
+	assert.ErrorIs(err, constants.ErrExisted)
+	variant, err = productSvc.CreateProductVariant(ctx, validUserId, validProductId, dto.NewCreateProductVariantDto(
+		utils.PtrOf("TEE-S"), map[string]string{"Size": "S"}, nil, utils.PtrOf(int32(0)), utils.PtrOf("")))
+	assert.Empty(variant)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	variant, err = productSvc.CreateProductVariant(ctx, uuid.NewString(), validProductId, dto.NewCreateProductVariantDto(
+		utils.PtrOf("TEE-S-BLUE"), map[string]string{"Size": "S", "Colour": "Blue"}, nil, utils.PtrOf(int32(0)), utils.PtrOf("")))
+	assert.Empty(variant)
+	assert.ErrorIs(err, constants.ErrUnauthorized)
+
+	// update variant, stale version rejected
+	updatedMedium, err := productSvc.UpdateProductVariantById(ctx, validUserId, validProductId, medium.ID.String(), medium.Version,
+		dto.NewUpdateProductVariantDto(&medium.Sku, medium.Options, nil, utils.PtrOf(int32(6)), &medium.ImgURL))
+	assert.NoError(err)
+	assert.Nil(updatedMedium.Price)
+	assert.Equal(int32(6), updatedMedium.Quantity)
+	updatedMedium, err = productSvc.UpdateProductVariantById(ctx, validUserId, validProductId, medium.ID.String(), medium.Version,
+		dto.NewUpdateProductVariantDto(&medium.Sku, medium.Options, nil, utils.PtrOf(int32(6)), &medium.ImgURL))
+	assert.Empty(updatedMedium)
+	assert.ErrorIs(err, constants.ErrPreconditionFailed)
+
+	// adjust variant stock
+	adjusted, err := productSvc.AdjustProductVariantQuantityById(ctx, validUserId, validProductId, small.ID.String(),
+		dto.NewAdjustInventoryDto(utils.PtrOf(int32(-5)), utils.PtrOf(constants.InventoryReason.Manual), utils.PtrOf("")))
+	assert.Empty(adjusted)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	adjusted, err = productSvc.AdjustProductVariantQuantityById(ctx, validUserId, validProductId, small.ID.String(),
+		dto.NewAdjustInventoryDto(utils.PtrOf(int32(-1)), utils.PtrOf(constants.InventoryReason.Manual), utils.PtrOf("")))
+	assert.NoError(err)
+	assert.Equal(int32(3), adjusted.Quantity)
+
+	// options change must keep active variants valid
+	updateProductDto := dto.NewUpdateProductDto(
+		&validProduct.Name, &validProduct.Price, &validProduct.Quantity, &validProduct.Description, &validProduct.Status, &validProduct.ImgURL)
+	updateProductDto.Options = []schema.ProductOption{{Name: "Size", Values: []string{"S", "M"}}}
+	updatedProduct, err := productSvc.UpdateProductById(ctx, validUserId, validProductId, validProduct.Version, updateProductDto)
+	assert.Empty(updatedProduct)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// variant matrix without archived
+	deleted, err := productSvc.SoftDeleteProductVariantById(ctx, validUserId, validProductId, medium.ID.String(), medium.Version)
+	assert.Empty(deleted)
+	assert.ErrorIs(err, constants.ErrPreconditionFailed)
+	deleted, err = productSvc.SoftDeleteProductVariantById(ctx, validUserId, validProductId, medium.ID.String(), medium.Version+1)
+	assert.NoError(err)
+	assert.True(deleted.IsArchived)
+	result, err := productSvc.GetProductById(ctx, validUserId, validProductId)
+	assert.NoError(err)
+	assert.Equal(validProduct.Version, result.Version)
+	assert.Len(result.Options, 2)
+	assert.Len(result.Variants, 1)
+	assert.Equal(small.ID, result.Variants[0].ID)
+
+	// variant ledger reconciled on its own, product ledger untouched
+	movements, err := productSvc.GetInventoryMovements(ctx, validUserId, validProductId,
+		dto.NewQueryInventoryMovementsDto(*dto.NewPaging(1, 20, "")))
+	assert.NoError(err)
+	assert.Equal(3, movements.Total)
+	assert.Equal(int32(0), movements.LedgerQuantity)
+	assert.True(movements.Reconciled)
+	assert.Len(movements.Variants, 2)
+	for _, variantReconcile := range movements.Variants {
+		assert.True(variantReconcile.Reconciled)
+	}
+	assert.Equal([]int32{3, 6}, []int32{movements.Variants[0].LedgerQuantity, movements.Variants[1].LedgerQuantity})
+}
+
+// ****Test_SetProductMedia
+func Test_SetProductMedia(t *testing.T) {
+	ctx := context.TODO()
+	assert := assert.New(t)
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NoError(err)
+	imginfoRepo := repository.NewImgInfoRepositoryMock()
+	productSvc := NewProductService(zapLogger, nil, repository.NewUserRepositoryMock(), repository.NewProductRepositoryMock(),
+		repository.NewShopRepositoryMock(), repository.NewCategoryRepositoryMock(), imginfoRepo)
+
+	// pre product and album images of owner and of other user
+	validUserId := uuid.NewString()
+	otherUserId := uuid.NewString()
+	validProduct, err := productSvc.CreateProduct(ctx, validUserId, dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()), utils.PtrOf(money.Amount(100)), utils.PtrOf(int32(1)), utils.PtrOf(""), utils.PtrOf("legacy.png"), nil))
+	assert.NoError(err)
+	validProductId := validProduct.ID.String()
+	createImg := func(userId string, name string) *ent.Imageinfo {
+		img, err := imginfoRepo.CreateImg(ctx, nil, userId, dto.NewCreateImgDto(
+			utils.PtrOf(name), utils.PtrOf("https://img.test/"+name), utils.PtrOf(int64(10)), utils.PtrOf(userId+"/"+name)))
+		assert.NoError(err)
+		return img
+	}
+	front := createImg(validUserId, "front.png")
+	back := createImg(validUserId, "back.png")
+	foreign := createImg(otherUserId, "foreign.png")
+
+	// gallery in order, primary marked, cover image set to primary
+	media, err := productSvc.SetProductMedia(ctx, validUserId, validProductId, dto.NewSetProductMediaDto([]*dto.ProductMediaItem{
+		dto.NewProductMediaItem(&front.ID, utils.PtrOf("Front"), false),
+		dto.NewProductMediaItem(&back.ID, nil, true),
+	}))
+	assert.NoError(err)
+	assert.Len(media, 2)
+	assert.Equal([]string{front.ImgURL, back.ImgURL}, []string{media[0].ImgUrl, media[1].ImgUrl})
+	assert.Equal([]int{0, 1}, []int{media[0].Position, media[1].Position})
+	assert.Equal("Front", media[0].AltText)
+	assert.False(media[0].IsPrimary)
+	assert.True(media[1].IsPrimary)
+	result, err := productSvc.GetProductById(ctx, validUserId, validProductId)
+	assert.NoError(err)
+	assert.Equal(back.ImgURL, result.ImgURL)
+	assert.Equal(validProduct.Version+1, result.Version)
+	assert.Len(result.Media, 2)
+	assert.Equal(front.ID, result.Media[0].ImageinfoID)
+
+	// first image primary if none marked
+	media, err = productSvc.SetProductMedia(ctx, validUserId, validProductId, dto.NewSetProductMediaDto([]*dto.ProductMediaItem{
+		dto.NewProductMediaItem(&back.ID, nil, false),
+		dto.NewProductMediaItem(&front.ID, nil, false),
+	}))
+	assert.NoError(err)
+	assert.True(media[0].IsPrimary)
+	assert.Equal(back.ID, media[0].ImageinfoID)
+
+	// image of other user or not found
+	for _, imgInfoId := range []int{foreign.ID, foreign.ID + 100} {
+		imgInfoId := imgInfoId
+		media, err = productSvc.SetProductMedia(ctx, validUserId, validProductId, dto.NewSetProductMediaDto([]*dto.ProductMediaItem{
+			dto.NewProductMediaItem(&imgInfoId, nil, false),
+		}))
+		assert.Empty(media)
+		assert.ErrorIs(err, constants.ErrNotFound)
+	}
+
+	// duplicate image, more than one primary, missing image
+	for _, invalid := range [][]*dto.ProductMediaItem{
+		{dto.NewProductMediaItem(&front.ID, nil, false), dto.NewProductMediaItem(&front.ID, nil, false)},
+		{dto.NewProductMediaItem(&front.ID, nil, true), dto.NewProductMediaItem(&back.ID, nil, true)},
+		{dto.NewProductMediaItem(nil, nil, false)},
+		nil,
+	} {
+		media, err = productSvc.SetProductMedia(ctx, validUserId, validProductId, dto.NewSetProductMediaDto(invalid))
+		assert.Empty(media)
+		assert.ErrorIs(err, constants.ErrBadRequest)
+	}
+
+	// product of other shop
+	media, err = productSvc.SetProductMedia(ctx, otherUserId, validProductId, dto.NewSetProductMediaDto([]*dto.ProductMediaItem{
+		dto.NewProductMediaItem(&foreign.ID, nil, false),
+	}))
+	assert.Empty(media)
+	assert.ErrorIs(err, constants.ErrUnauthorized)
+
+	// clear gallery, cover image kept
+	media, err = productSvc.SetProductMedia(ctx, validUserId, validProductId, dto.NewSetProductMediaDto([]*dto.ProductMediaItem{}))
+	assert.NoError(err)
+	assert.Empty(media)
+	result, err = productSvc.GetProductById(ctx, validUserId, validProductId)
+	assert.NoError(err)
+	assert.Empty(result.Media)
+	assert.Equal(back.ImgURL, result.ImgURL)
+}
@@ -0,0 +1,373 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/ent/schema"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// checkVariantOptions: variant must pick one defined value for every option of product and nothing else
+func checkVariantOptions(options []schema.ProductOption, values map[string]string) error {
+	if len(options) == 0 {
+		return errors.New("product has no option")
+	}
+	if len(values) != len(options) {
+		return errors.New("variant options not match product options")
+	}
+	for _, option := range options {
+		value, ok := values[option.Name]
+		if !ok {
+			return fmt.Errorf("variant option %s missing", option.Name)
+		}
+		if !lo.Contains(option.Values, value) {
+			return fmt.Errorf("variant option %s has undefined value %s", option.Name, value)
+		}
+	}
+	return nil
+}
+
+// checkVariantUnique: sku unique per product, option combination unique among active variants
+func checkVariantUnique(variants []*ent.ProductVariant, excludeId string, sku string, values map[string]string) error {
+	for _, variant := range variants {
+		if variant.ID.String() == excludeId {
+			continue
+		}
+		if variant.Sku == sku {
+			return constants.ErrExisted
+		}
+		isSameOptions := len(variant.Options) == len(values) &&
+			lo.EveryBy(lo.Keys(values), func(name string) bool { return variant.Options[name] == values[name] })
+		if !variant.IsArchived && isSameOptions {
+			return constants.ErrExisted
+		}
+	}
+	return nil
+}
+
+// getShopProductForVariant: lock product of variant and check it belongs to shop of user
+func (productSvc *ProductService) getShopProductForVariant(
+	ctx context.Context, client *ent.Client, userId string, productId string, permission string) (*ent.Product, error) {
+	// check permission in active shop
+	ownerId, err := authorizeShop(ctx, productSvc.logger, client, productSvc.shopRepo, userId, permission)
+	if err != nil {
+		return nil, err
+	}
+
+	// lock product, variant writes of one product are serialized for unique check
+	products, err := lockProducts(ctx, client, productSvc.productRepo, []string{productId})
+	if err != nil {
+		return nil, err
+	}
+	product, ok := products[productId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+
+	// check product belong to shop
+	if product.UserID.String() != ownerId {
+		return nil, constants.ErrUnauthorized
+	}
+	return product, nil
+}
+
+// CreateProductVariant: option values must come from product options, initial stock recorded in ledger
+func (productSvc *ProductService) CreateProductVariant(
+	ctx context.Context, userId string, productId string, payload *dto.CreateProductVariantDto) (*ent.ProductVariant, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(productId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		productSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to create variant with tx
+	var result *ent.ProductVariant
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		product, err := productSvc.getShopProductForVariant(ctx, txc, userId, productId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		// check options against product
+		err = checkVariantOptions(product.Options, payload.Options)
+		if err != nil {
+			productSvc.logger.Info("fail to checkVariantOptions", zap.Error(err))
+			return constants.ErrBadRequest
+		}
+
+		// check sku and option combination unused, and under limit
+		variants, err := productSvc.productRepo.GetProductVariantsByProductId(ctx, txc, productId)
+		if err != nil {
+			return err
+		}
+		err = checkVariantUnique(variants, "", *payload.Sku, payload.Options)
+		if err != nil {
+			return err
+		}
+		if lo.CountBy(variants, func(v *ent.ProductVariant) bool { return !v.IsArchived }) >= constants.MaxProductVariants {
+			productSvc.logger.Info("variants exceed limit")
+			return constants.ErrBadRequest
+		}
+
+		rs, err := productSvc.productRepo.CreateProductVariant(ctx, txc, productId, payload)
+		if err != nil {
+			return err
+		}
+
+		// call repo to open ledger of variant with initial stock
+		if rs.Quantity != 0 {
+			variantId := rs.ID.String()
+			_, err = productSvc.productRepo.CreateInventoryMovement(ctx, txc, productId, rs.Quantity,
+				dto.NewCreateInventoryMovementDto(&variantId, rs.Quantity, constants.InventoryReason.Initial, "", &userId, ""))
+			if err != nil {
+				return err
+			}
+		}
+		result = rs
+		return nil
+	}
+	err = productSvc.productRepo.WithTx(ctx, productSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateProductVariantById: quantity set by staff recorded in ledger
+func (productSvc *ProductService) UpdateProductVariantById(
+	ctx context.Context, userId string, productId string, variantId string, version int64, payload *dto.UpdateProductVariantDto) (*ent.ProductVariant, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(productId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(variantId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse variantId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		productSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to update variant with tx
+	var result *ent.ProductVariant
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		product, err := productSvc.getShopProductForVariant(ctx, txc, userId, productId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		// read under product lock, every stock change locks product first so quantity is current
+		variant, err := productSvc.productRepo.GetProductVariantById(ctx, txc, variantId)
+		if err != nil {
+			return err
+		}
+
+		// check variant belong to product
+		if variant.ProductID != product.ID {
+			return constants.ErrNotFound
+		}
+
+		// check client edited latest version, repo update is conditional as well
+		if variant.Version != version {
+			return constants.ErrPreconditionFailed
+		}
+
+		// check options against product, sku and option combination unused by others
+		err = checkVariantOptions(product.Options, payload.Options)
+		if err != nil {
+			productSvc.logger.Info("fail to checkVariantOptions", zap.Error(err))
+			return constants.ErrBadRequest
+		}
+		variants, err := productSvc.productRepo.GetProductVariantsByProductId(ctx, txc, productId)
+		if err != nil {
+			return err
+		}
+		err = checkVariantUnique(variants, variantId, *payload.Sku, payload.Options)
+		if err != nil {
+			return err
+		}
+
+		rs, err := productSvc.productRepo.UpdateProductVariantById(ctx, txc, variantId, version, payload)
+		if err != nil {
+			return err
+		}
+
+		// call repo to record quantity set by staff
+		if rs.Quantity != variant.Quantity {
+			_, err = productSvc.productRepo.CreateInventoryMovement(ctx, txc, productId, rs.Quantity,
+				dto.NewCreateInventoryMovementDto(&variantId, rs.Quantity-variant.Quantity, constants.InventoryReason.Manual, "", &userId, ""))
+			if err != nil {
+				return err
+			}
+		}
+		result = rs
+		return nil
+	}
+	err = productSvc.productRepo.WithTx(ctx, productSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SoftDeleteProductVariantById: archived variant is no longer sold, items already ordered keep it
+func (productSvc *ProductService) SoftDeleteProductVariantById(
+	ctx context.Context, userId string, productId string, variantId string, version int64) (*ent.ProductVariant, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(productId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(variantId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse variantId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to archive variant with tx
+	var result *ent.ProductVariant
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		product, err := productSvc.getShopProductForVariant(ctx, txc, userId, productId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		variant, err := productSvc.productRepo.GetProductVariantById(ctx, txc, variantId)
+		if err != nil {
+			return err
+		}
+
+		// checking
+		if variant.ProductID != product.ID {
+			return constants.ErrNotFound
+		}
+		if variant.IsArchived {
+			return constants.ErrBadRequest
+		}
+		if variant.Version != version {
+			return constants.ErrPreconditionFailed
+		}
+
+		rs, err := productSvc.productRepo.SoftDeleteProductVariantById(ctx, txc, variantId, version)
+		if err != nil {
+			return err
+		}
+		result = rs
+		return nil
+	}
+	err = productSvc.productRepo.WithTx(ctx, productSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AdjustProductVariantQuantityById: manual stock adjustment of variant by staff, recorded in ledger of product
+func (productSvc *ProductService) AdjustProductVariantQuantityById(
+	ctx context.Context, userId string, productId string, variantId string, payload *dto.AdjustInventoryDto) (*ent.ProductVariant, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(productId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse productId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(variantId)
+	if err != nil {
+		productSvc.logger.Info("fail to parse variantId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		productSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to adjust with tx
+	var result *ent.ProductVariant
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// check permission in active shop
+		ownerId, err := authorizeShop(ctx, productSvc.logger, txc, productSvc.shopRepo, userId, constants.ShopPermission.ProductWrite)
+		if err != nil {
+			return err
+		}
+
+		// lock product and variants, adjustment is relative so no version needed
+		stock, err := lockStock(ctx, txc, productSvc.productRepo, []string{productId})
+		if err != nil {
+			return err
+		}
+		line, err := stock.line(productId, &variantId)
+		if err != nil {
+			return err
+		}
+
+		// check product belong to shop
+		if line.product.UserID.String() != ownerId {
+			return constants.ErrUnauthorized
+		}
+
+		rs, err := productSvc.productRepo.AdjustProductVariantQuantityById(ctx, txc, variantId,
+			dto.NewCreateInventoryMovementDto(&variantId, *payload.Delta, *payload.Reason, "", &userId, *payload.Note))
+		if err != nil {
+			return err
+		}
+		result = rs
+		return nil
+	}
+	err = productSvc.productRepo.WithTx(ctx, productSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
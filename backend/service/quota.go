@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sthl/constants"
+	"sthl/ent"
+	"sthl/repository"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ownerPlan: plan of shop owner, free if no user
+func ownerPlan(ctx context.Context, logger *zap.Logger, client *ent.Client,
+	userRepo repository.IUserRepository, ownerId string) (string, error) {
+	owner, err := userRepo.GetUserById(ctx, client, ownerId)
+	if errors.Is(err, constants.ErrNotFound) {
+		logger.Info("owner not found, use free plan")
+		return constants.Plan.Free, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return owner.Plan, nil
+}
+
+// ownerPlanLimit: limits of plan of shop owner
+func ownerPlanLimit(ctx context.Context, logger *zap.Logger, client *ent.Client,
+	userRepo repository.IUserRepository, ownerId string) (constants.PlanLimit, error) {
+	plan, err := ownerPlan(ctx, logger, client, userRepo, ownerId)
+	if err != nil {
+		return constants.PlanLimit{}, err
+	}
+	return constants.Plan.GetLimit(plan), nil
+}
+
+// monthStart: start of calendar month in utc, period of monthly orders limit
+func monthStart(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// ensureQuota: ErrQuotaExceeded with description if used plus adding over limit
+func ensureQuota(logger *zap.Logger, ownerId string, resource string, used int64, adding int64, limit int64) error {
+	if used+adding <= limit {
+		return nil
+	}
+	logger.Info("quota exceeded", zap.String("ownerId", ownerId), zap.String("resource", resource),
+		zap.Int64("used", used), zap.Int64("adding", adding), zap.Int64("limit", limit))
+	return fmt.Errorf("%w: %s limit of %d reached", constants.ErrQuotaExceeded, resource, limit)
+}
@@ -0,0 +1,421 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sthl/authentication"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/mailer"
+	"sthl/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+type IShopService interface {
+	// private
+	GetMyShops(ctx context.Context, userId string) ([]*dto.ShopMembershipResponseDto, error)
+	GetShopMembers(ctx context.Context, userId string) ([]*ent.ShopMember, error)
+	InviteShopMember(ctx context.Context, userId string, payload *dto.CreateShopInviteDto) (bool, error)
+	AcceptShopInvite(ctx context.Context, userId string, payload *dto.AcceptShopInviteDto) (*ent.ShopMember, error)
+	UpdateShopMemberRole(ctx context.Context, userId string, memberUserId string, payload *dto.UpdateShopMemberDto) (*ent.ShopMember, error)
+	RemoveShopMember(ctx context.Context, userId string, memberUserId string) (bool, error)
+	UpdateShopPricing(ctx context.Context, userId string, payload *dto.UpdateShopPricingDto) (*ent.Shop, error)
+}
+type ShopService struct {
+	logger   *zap.Logger
+	client   *ent.Client
+	mailer   mailer.Mailer
+	userRepo repository.IUserRepository
+	shopRepo repository.IShopRepository
+}
+
+func NewShopService(logger *zap.Logger, client *ent.Client, mailer mailer.Mailer,
+	userRepo repository.IUserRepository, shopRepo repository.IShopRepository) IShopService {
+	return &ShopService{
+		logger:   logger,
+		client:   client,
+		mailer:   mailer,
+		userRepo: userRepo,
+		shopRepo: shopRepo,
+	}
+}
+
+// managedShop: active shop, or own shop created on demand for user signed up before shops,
+// user must have the permission in it
+func (shopSvc *ShopService) managedShop(ctx context.Context, client *ent.Client,
+	userId string, permission string) (*ent.Shop, error) {
+	var shop *ent.Shop
+	shopId := activeShopId(ctx)
+	if shopId != "" {
+		rs, err := shopSvc.shopRepo.GetShopById(ctx, client, shopId)
+		if err != nil {
+			if errors.Is(err, constants.ErrNotFound) {
+				return nil, constants.ErrForbidden
+			}
+			return nil, err
+		}
+		shop = rs
+	} else {
+		rs, err := shopSvc.ensureOwnShop(ctx, client, userId)
+		if err != nil {
+			return nil, err
+		}
+		shop = rs
+	}
+
+	role, err := shopRoleOf(ctx, shopSvc.logger, client, shopSvc.shopRepo, shop, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !authentication.ShopRoleHasPermission(role, permission) {
+		shopSvc.logger.Info("shop role has no permission",
+			zap.String("userId", userId), zap.String("role", role), zap.String("permission", permission))
+		return nil, constants.ErrForbidden
+	}
+	return shop, nil
+}
+
+// ensureOwnShop
+func (shopSvc *ShopService) ensureOwnShop(ctx context.Context, client *ent.Client, userId string) (*ent.Shop, error) {
+	shop, err := shopSvc.shopRepo.GetShopByUserId(ctx, client, userId)
+	if err == nil {
+		return shop, nil
+	}
+	if !errors.Is(err, constants.ErrNotFound) {
+		return nil, err
+	}
+
+	user, err := shopSvc.userRepo.GetUserById(ctx, client, userId)
+	if err != nil {
+		return nil, err
+	}
+	shop, err = shopSvc.shopRepo.CreateShop(ctx, client, userId, user.Email)
+	if err != nil {
+		return nil, err
+	}
+	_, err = shopSvc.shopRepo.UpsertShopMember(ctx, client, shop.ID.String(), userId, constants.ShopRole.Owner)
+	if err != nil {
+		return nil, err
+	}
+	return shop, nil
+}
+
+// GetMyShops: shops user can switch to
+func (shopSvc *ShopService) GetMyShops(ctx context.Context, userId string) ([]*dto.ShopMembershipResponseDto, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		shopSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to get memberships
+	result, err := shopSvc.shopRepo.GetShopMembershipsByUserId(ctx, shopSvc.client, userId)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(result, func(m *ent.ShopMember, _ int) *dto.ShopMembershipResponseDto {
+		return dto.NewShopMembershipResponseDto(m)
+	}), nil
+}
+
+// GetShopMembers: members of active shop
+func (shopSvc *ShopService) GetShopMembers(ctx context.Context, userId string) ([]*ent.ShopMember, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		shopSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	var result []*ent.ShopMember
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+		shop, err := shopSvc.managedShop(ctx, txc, userId, constants.ShopPermission.StaffManage)
+		if err != nil {
+			return err
+		}
+		rs, err := shopSvc.shopRepo.GetShopMembersByShopId(ctx, txc, shop.ID.String())
+		if err != nil {
+			return err
+		}
+		result = rs
+		return nil
+	}
+	err = shopSvc.shopRepo.WithTx(ctx, shopSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// InviteShopMember: mail invite token to email, accepted by the user signed in with that email
+func (shopSvc *ShopService) InviteShopMember(
+	ctx context.Context, userId string, payload *dto.CreateShopInviteDto) (bool, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		shopSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		shopSvc.logger.Info("fail to validate", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+	email := strings.ToLower(strings.TrimSpace(*payload.Email))
+
+	// create invite with transaction
+	var shopName string
+	var inviteToken string
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+		shop, err := shopSvc.managedShop(ctx, txc, userId, constants.ShopPermission.StaffManage)
+		if err != nil {
+			return err
+		}
+
+		// owner cannot be invited to own shop
+		owner, err := shopSvc.userRepo.GetUserById(ctx, txc, shop.UserID.String())
+		if err != nil {
+			return err
+		}
+		if strings.EqualFold(owner.Email, email) {
+			shopSvc.logger.Info("fail to invite shop owner")
+			return constants.ErrBadRequest
+		}
+
+		token, tokenHash, err := authentication.GenerateOpaqueToken()
+		if err != nil {
+			shopSvc.logger.Info("fail to generateOpaqueToken", zap.Error(err))
+			return constants.ErrInternalServer
+		}
+		expiresAt := time.Now().Add(constants.ShopInviteDuration)
+		_, err = shopSvc.shopRepo.CreateShopInvite(ctx, txc, shop.ID.String(), userId, email, *payload.Role, tokenHash, expiresAt)
+		if err != nil {
+			return err
+		}
+		shopName = shop.Name
+		inviteToken = token
+		return nil
+	}
+	err = shopSvc.shopRepo.WithTx(ctx, shopSvc.client, txFunc)
+	if err != nil {
+		return false, err
+	}
+
+	// send mail after commit
+	err = shopSvc.mailer.Send(ctx, mailer.NewShopInviteMessage(shopSvc.mailer, email, shopName, *payload.Role, inviteToken))
+	if err != nil {
+		shopSvc.logger.Info("fail to send shop invite email", zap.Error(err))
+		return false, constants.ErrInternalServer
+	}
+	return true, nil
+}
+
+// AcceptShopInvite: invite is single use and bound to invited email
+func (shopSvc *ShopService) AcceptShopInvite(
+	ctx context.Context, userId string, payload *dto.AcceptShopInviteDto) (*ent.ShopMember, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		shopSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		shopSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// accept with transaction
+	var result *ent.ShopMember
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// call repo to get invite by token hash
+		invite, err := shopSvc.shopRepo.GetShopInviteByHash(ctx, txc, authentication.HashOpaqueToken(*payload.Token))
+		if err != nil {
+			if errors.Is(err, constants.ErrNotFound) {
+				return constants.ErrBadRequest
+			}
+			return err
+		}
+
+		// check invite is for current user
+		user, err := shopSvc.userRepo.GetUserById(ctx, txc, userId)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(user.Email, invite.Email) {
+			shopSvc.logger.Info("invite email not equal user email")
+			return constants.ErrForbidden
+		}
+
+		// call repo to consume invite, only one caller can win
+		accepted, err := shopSvc.shopRepo.AcceptShopInviteById(ctx, txc, invite.ID.String())
+		if err != nil {
+			return err
+		}
+		if !accepted {
+			shopSvc.logger.Info("invite already accepted or expired")
+			return constants.ErrBadRequest
+		}
+
+		rs, err := shopSvc.shopRepo.UpsertShopMember(ctx, txc, invite.ShopID.String(), userId, invite.Role)
+		if err != nil {
+			return err
+		}
+		result = rs
+		return nil
+	}
+	err = shopSvc.shopRepo.WithTx(ctx, shopSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateShopMemberRole: owner role cannot be changed
+func (shopSvc *ShopService) UpdateShopMemberRole(
+	ctx context.Context, userId string, memberUserId string, payload *dto.UpdateShopMemberDto) (*ent.ShopMember, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		shopSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(memberUserId)
+	if err != nil {
+		shopSvc.logger.Info("fail to parse memberUserId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		shopSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// update with transaction
+	var result *ent.ShopMember
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+		shop, err := shopSvc.managedShop(ctx, txc, userId, constants.ShopPermission.StaffManage)
+		if err != nil {
+			return err
+		}
+		if shop.UserID.String() == memberUserId {
+			shopSvc.logger.Info("fail to change role of shop owner")
+			return constants.ErrBadRequest
+		}
+
+		// call repo to check member exist
+		_, err = shopSvc.shopRepo.GetShopMember(ctx, txc, shop.ID.String(), memberUserId)
+		if err != nil {
+			return err
+		}
+		rs, err := shopSvc.shopRepo.UpsertShopMember(ctx, txc, shop.ID.String(), memberUserId, *payload.Role)
+		if err != nil {
+			return err
+		}
+		result = rs
+		return nil
+	}
+	err = shopSvc.shopRepo.WithTx(ctx, shopSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RemoveShopMember: owner cannot be removed
+func (shopSvc *ShopService) RemoveShopMember(ctx context.Context, userId string, memberUserId string) (bool, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		shopSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+	_, err = uuid.Parse(memberUserId)
+	if err != nil {
+		shopSvc.logger.Info("fail to parse memberUserId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	// delete with transaction
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+		shop, err := shopSvc.managedShop(ctx, txc, userId, constants.ShopPermission.StaffManage)
+		if err != nil {
+			return err
+		}
+		if shop.UserID.String() == memberUserId {
+			shopSvc.logger.Info("fail to remove shop owner")
+			return constants.ErrBadRequest
+		}
+
+		deleted, err := shopSvc.shopRepo.DeleteShopMember(ctx, txc, shop.ID.String(), memberUserId)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return constants.ErrNotFound
+		}
+		return nil
+	}
+	err = shopSvc.shopRepo.WithTx(ctx, shopSvc.client, txFunc)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UpdateShopPricing: tax rate and shipping fee applied to new orders of the shop
+func (shopSvc *ShopService) UpdateShopPricing(
+	ctx context.Context, userId string, payload *dto.UpdateShopPricingDto) (*ent.Shop, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		shopSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		shopSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// update with transaction
+	var result *ent.Shop
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+		shop, err := shopSvc.managedShop(ctx, txc, userId, constants.ShopPermission.ShopSettings)
+		if err != nil {
+			return err
+		}
+
+		rs, err := shopSvc.shopRepo.UpdateShopPricingById(ctx, txc, shop.ID.String(), payload)
+		if err != nil {
+			return err
+		}
+		result = rs
+		return nil
+	}
+	err = shopSvc.shopRepo.WithTx(ctx, shopSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
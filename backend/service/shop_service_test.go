@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"sthl/authentication"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/logger"
+	"sthl/mailer"
+	"sthl/money"
+	"sthl/repository"
+	"sthl/utils"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+)
+
+// shopServiceTestSetup: user and product services share repos with shop service
+func shopServiceTestSetup(ctx context.Context, t *testing.T) (
+	*assert.Assertions, IUserService, IShopService, IProductService, *mailer.LogMailer) {
+	t.Setenv("JWT_SECRET", "test_value")
+	assert := assert.New(t)
+	// dependency init
+	zapLogger, err := logger.NewDevErrorZapLogger()
+	assert.NotEmpty(zapLogger)
+	assert.NoError(err)
+	userRepo := repository.NewUserRepositoryMock()
+	shopRepo := repository.NewShopRepositoryMock()
+	logMailer := mailer.NewLogMailer(zapLogger, "no-reply@test.local", "http://localhost:3000", "")
+	userService := NewUserService(zapLogger, nil, newTestKeySet(assert), logMailer, userRepo,
+		repository.NewRefreshTokenRepositoryMock(), repository.NewEmailVerificationRepositoryMock(),
+		repository.NewPasswordResetRepositoryMock(), repository.NewLoginAttemptRepositoryMemory(), shopRepo,
+		repository.NewApiKeyRepositoryMock(), repository.NewMfaRepositoryMock())
+	shopService := NewShopService(zapLogger, nil, logMailer, userRepo, shopRepo)
+	productService := NewProductService(zapLogger, nil, userRepo, repository.NewProductRepositoryMock(), shopRepo, repository.NewCategoryRepositoryMock(), repository.NewImgInfoRepositoryMock())
+	return assert, userService, shopService, productService, logMailer
+}
+
+// preSignupShopUser: signup and login, return user and ctx with active shop of token
+func preSignupShopUser(ctx context.Context, assert *assert.Assertions, userSvc IUserService) (*ent.User, context.Context) {
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NotEmpty(validUser)
+	assert.NoError(err)
+	pp, err := userSvc.Login(ctx, dto.NewLoginDto(validCreateUserDto.Email, validCreateUserDto.Password))
+	assert.NotEmpty(pp)
+	assert.NoError(err)
+	return validUser, shopCtxOf(ctx, assert, userSvc, pp)
+}
+
+// shopCtxOf: ctx as set by auth interceptor
+func shopCtxOf(ctx context.Context, assert *assert.Assertions, userSvc IUserService, pp *authentication.Passport) context.Context {
+	info, err := userSvc.Authenticate(ctx, pp.AccessToken)
+	assert.NoError(err)
+	assert.NotEmpty(info.ShopId)
+	return context.WithValue(ctx, constants.ActiveShopKey, info.ShopId)
+}
+
+// preAcceptShopInvite: owner invites staff with role, staff accepts and switches to shop
+func preAcceptShopInvite(ctx context.Context, assert *assert.Assertions, userSvc IUserService, shopSvc IShopService,
+	logMailer *mailer.LogMailer, ownerCtx context.Context, owner *ent.User, staff *ent.User, role string) context.Context {
+	ok, err := shopSvc.InviteShopMember(ownerCtx, owner.ID.String(), dto.NewCreateShopInviteDto(&staff.Email, &role))
+	assert.True(ok)
+	assert.NoError(err)
+	token := sentMailToken(assert, logMailer, staff.Email)
+	member, err := shopSvc.AcceptShopInvite(ctx, staff.ID.String(), dto.NewAcceptShopInviteDto(&token))
+	assert.NotEmpty(member)
+	assert.NoError(err)
+	assert.Equal(role, member.Role)
+	pp, err := userSvc.SwitchShop(ctx, staff.ID.String(), dto.NewSwitchShopDto(utils.PtrOf(member.ShopID.String())))
+	assert.NotEmpty(pp)
+	assert.NoError(err)
+	return shopCtxOf(ctx, assert, userSvc, pp)
+}
+
+// ****Test_InviteAcceptShopMember
+func Test_InviteAcceptShopMember(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc, shopSvc, _, logMailer := shopServiceTestSetup(ctx, t)
+	owner, ownerCtx := preSignupShopUser(ctx, assert, userSvc)
+	staff, _ := preSignupShopUser(ctx, assert, userSvc)
+	other, _ := preSignupShopUser(ctx, assert, userSvc)
+
+	// owner role cannot be invited
+	ok, err := shopSvc.InviteShopMember(ownerCtx, owner.ID.String(),
+		dto.NewCreateShopInviteDto(&staff.Email, utils.PtrOf(constants.ShopRole.Owner)))
+	assert.False(ok)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// owner cannot invite self
+	ok, err = shopSvc.InviteShopMember(ownerCtx, owner.ID.String(),
+		dto.NewCreateShopInviteDto(&owner.Email, utils.PtrOf(constants.ShopRole.Manager)))
+	assert.False(ok)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// invite staff
+	ok, err = shopSvc.InviteShopMember(ownerCtx, owner.ID.String(),
+		dto.NewCreateShopInviteDto(&staff.Email, utils.PtrOf(constants.ShopRole.Fulfilment)))
+	assert.True(ok)
+	assert.NoError(err)
+	token := sentMailToken(assert, logMailer, staff.Email)
+
+	// other user cannot accept
+	member, err := shopSvc.AcceptShopInvite(ctx, other.ID.String(), dto.NewAcceptShopInviteDto(&token))
+	assert.Empty(member)
+	assert.ErrorIs(err, constants.ErrForbidden)
+
+	// staff accepts once
+	member, err = shopSvc.AcceptShopInvite(ctx, staff.ID.String(), dto.NewAcceptShopInviteDto(&token))
+	assert.NotEmpty(member)
+	assert.NoError(err)
+	assert.Equal(constants.ShopRole.Fulfilment, member.Role)
+	shopId := member.ShopID.String()
+	member, err = shopSvc.AcceptShopInvite(ctx, staff.ID.String(), dto.NewAcceptShopInviteDto(&token))
+	assert.Empty(member)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// staff sees own and joined shop
+	shops, err := shopSvc.GetMyShops(ctx, staff.ID.String())
+	assert.NoError(err)
+	assert.Len(shops, 2)
+
+	// owner sees members
+	members, err := shopSvc.GetShopMembers(ownerCtx, owner.ID.String())
+	assert.NoError(err)
+	assert.Len(members, 2)
+
+	// other user cannot switch to shop
+	pp, err := userSvc.SwitchShop(ctx, other.ID.String(), dto.NewSwitchShopDto(&shopId))
+	assert.Empty(pp)
+	assert.ErrorIs(err, constants.ErrForbidden)
+}
+
+// ****Test_ShopMemberPermission
+func Test_ShopMemberPermission(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc, shopSvc, productSvc, logMailer := shopServiceTestSetup(ctx, t)
+	owner, ownerCtx := preSignupShopUser(ctx, assert, userSvc)
+	manager, _ := preSignupShopUser(ctx, assert, userSvc)
+	readOnly, _ := preSignupShopUser(ctx, assert, userSvc)
+	managerCtx := preAcceptShopInvite(ctx, assert, userSvc, shopSvc, logMailer, ownerCtx, owner, manager, constants.ShopRole.Manager)
+	readOnlyCtx := preAcceptShopInvite(ctx, assert, userSvc, shopSvc, logMailer, ownerCtx, owner, readOnly, constants.ShopRole.ReadOnly)
+	validCreateProductDto := dto.NewCreateProductDto(
+		utils.PtrOf(gofakeit.Fruit()),
+		utils.PtrOf(money.Amount(gofakeit.IntRange(100, 100000000))),
+		utils.PtrOf(int32(gofakeit.IntRange(0, 1000000))),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		utils.PtrOf(gofakeit.LetterN(100)),
+		nil)
+
+	// manager creates product of owner
+	product, err := productSvc.CreateProduct(managerCtx, manager.ID.String(), validCreateProductDto)
+	assert.NotEmpty(product)
+	assert.NoError(err)
+	assert.Equal(owner.ID, product.UserID)
+
+	// readOnly cannot write product
+	result, err := productSvc.SoftDeleteProductById(readOnlyCtx, readOnly.ID.String(), product.ID.String(), product.Version)
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrForbidden)
+
+	// manager cannot manage staff
+	members, err := shopSvc.GetShopMembers(managerCtx, manager.ID.String())
+	assert.Empty(members)
+	assert.ErrorIs(err, constants.ErrForbidden)
+
+	// owner role cannot be changed
+	member, err := shopSvc.UpdateShopMemberRole(ownerCtx, owner.ID.String(), owner.ID.String(),
+		dto.NewUpdateShopMemberDto(utils.PtrOf(constants.ShopRole.ReadOnly)))
+	assert.Empty(member)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// owner promotes readOnly
+	member, err = shopSvc.UpdateShopMemberRole(ownerCtx, owner.ID.String(), readOnly.ID.String(),
+		dto.NewUpdateShopMemberDto(utils.PtrOf(constants.ShopRole.Manager)))
+	assert.NotEmpty(member)
+	assert.NoError(err)
+	assert.Equal(constants.ShopRole.Manager, member.Role)
+	result, err = productSvc.SoftDeleteProductById(readOnlyCtx, readOnly.ID.String(), product.ID.String(), product.Version)
+	assert.NotEmpty(result)
+	assert.NoError(err)
+
+	// removed manager loses access at once
+	ok, err := shopSvc.RemoveShopMember(ownerCtx, owner.ID.String(), manager.ID.String())
+	assert.True(ok)
+	assert.NoError(err)
+	product, err = productSvc.CreateProduct(managerCtx, manager.ID.String(), validCreateProductDto)
+	assert.Empty(product)
+	assert.ErrorIs(err, constants.ErrForbidden)
+	ok, err = shopSvc.RemoveShopMember(ownerCtx, owner.ID.String(), manager.ID.String())
+	assert.False(ok)
+	assert.ErrorIs(err, constants.ErrNotFound)
+}
+
+// ****Test_UpdateShopPricing
+func Test_UpdateShopPricing(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc, shopSvc, _, logMailer := shopServiceTestSetup(ctx, t)
+	owner, ownerCtx := preSignupShopUser(ctx, assert, userSvc)
+	readOnly, _ := preSignupShopUser(ctx, assert, userSvc)
+	readOnlyCtx := preAcceptShopInvite(ctx, assert, userSvc, shopSvc, logMailer, ownerCtx, owner, readOnly, constants.ShopRole.ReadOnly)
+
+	// owner sets pricing
+	shop, err := shopSvc.UpdateShopPricing(ownerCtx, owner.ID.String(), dto.NewUpdateShopPricingDto(utils.PtrOf(money.Rate(80000)), utils.PtrOf(money.Amount(500))))
+	assert.NotEmpty(shop)
+	assert.NoError(err)
+	assert.Equal(money.Rate(80000), shop.TaxRate)
+	assert.Equal(money.Amount(500), shop.ShippingFee)
+
+	// zero is valid
+	shop, err = shopSvc.UpdateShopPricing(ownerCtx, owner.ID.String(), dto.NewUpdateShopPricingDto(utils.PtrOf(money.Rate(0)), utils.PtrOf(money.Amount(0))))
+	assert.NotEmpty(shop)
+	assert.NoError(err)
+	assert.Equal(money.Rate(0), shop.TaxRate)
+
+	// invalid tax rate
+	shop, err = shopSvc.UpdateShopPricing(ownerCtx, owner.ID.String(), dto.NewUpdateShopPricingDto(utils.PtrOf(money.Rate(1500000)), utils.PtrOf(money.Amount(0))))
+	assert.Empty(shop)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	shop, err = shopSvc.UpdateShopPricing(ownerCtx, owner.ID.String(), dto.NewUpdateShopPricingDto(nil, utils.PtrOf(money.Amount(0))))
+	assert.Empty(shop)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// readOnly cannot change shop settings
+	shop, err = shopSvc.UpdateShopPricing(readOnlyCtx, readOnly.ID.String(), dto.NewUpdateShopPricingDto(utils.PtrOf(money.Rate(100000)), utils.PtrOf(money.Amount(100))))
+	assert.Empty(shop)
+	assert.ErrorIs(err, constants.ErrForbidden)
+}
@@ -15,22 +15,24 @@ type ISiteUiService interface {
 	// public
 	GetSiteUiByUserId(ctx context.Context, userId string) (*ent.Siteui, error)
 	// private
-	UpsertSiteUiByUserId(ctx context.Context, userId string, payload *dto.UpsertSiteUiDto) (bool, error)
+	UpsertSiteUiByUserId(ctx context.Context, userId string, version int64, payload *dto.UpsertSiteUiDto) (bool, error)
 }
 type SiteUiService struct {
 	logger     *zap.Logger
 	client     *ent.Client
 	userRepo   repository.IUserRepository
 	siteuiRepo repository.ISiteUiRepository
+	shopRepo   repository.IShopRepository
 }
 
 func NewSiteUiService(logger *zap.Logger, client *ent.Client,
-	userRepo repository.IUserRepository, siteuiRepo repository.ISiteUiRepository) ISiteUiService {
+	userRepo repository.IUserRepository, siteuiRepo repository.ISiteUiRepository, shopRepo repository.IShopRepository) ISiteUiService {
 	return &SiteUiService{
 		logger:     logger,
 		client:     client,
 		userRepo:   userRepo,
 		siteuiRepo: siteuiRepo,
+		shopRepo:   shopRepo,
 	}
 }
 
@@ -52,9 +54,9 @@ func (siteuiSvc *SiteUiService) GetSiteUiByUserId(
 	return result, nil
 }
 
-// UpsertSiteUiByUserId
+// UpsertSiteUiByUserId: version 0 creates siteui, otherwise updates siteui of the version
 func (siteuiSvc *SiteUiService) UpsertSiteUiByUserId(
-	ctx context.Context, userId string, payload *dto.UpsertSiteUiDto) (bool, error) {
+	ctx context.Context, userId string, version int64, payload *dto.UpsertSiteUiDto) (bool, error) {
 	// validate
 	_, err := uuid.Parse(userId)
 	if err != nil {
@@ -73,8 +75,20 @@ func (siteuiSvc *SiteUiService) UpsertSiteUiByUserId(
 		// extract tx client as ent.cient
 		txc := tx.Client()
 
+		// check permission in active shop, siteui belongs to shop owner
+		ownerId, err := authorizeShop(ctx, siteuiSvc.logger, txc, siteuiSvc.shopRepo, userId, constants.ShopPermission.SiteUiWrite)
+		if err != nil {
+			return err
+		}
+
+		// check merchant email verified before publishing
+		err = ensureEmailVerified(ctx, siteuiSvc.logger, txc, siteuiSvc.userRepo, ownerId)
+		if err != nil {
+			return err
+		}
+
 		// call repo to UpsertSiteUiByUserId
-		upsertResult, err := siteuiSvc.siteuiRepo.UpsertSiteUiByUserId(ctx, txc, userId, payload)
+		upsertResult, err := siteuiSvc.siteuiRepo.UpsertSiteUiByUserId(ctx, txc, ownerId, version, payload)
 		result = upsertResult
 		if err != nil {
 			return err
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/money"
+	"sthl/repository"
+	"sthl/utils"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// lockProducts: lock product rows for stock changes until the tx ends, keyed by product id.
+// Rows are locked in id order so concurrent checkouts on the same products cannot deadlock
+func lockProducts(ctx context.Context, client *ent.Client, productRepo repository.IProductRepository, productIds []string) (map[string]*ent.Product, error) {
+	products, err := productRepo.LockProductsByIds(ctx, client, lo.Uniq(productIds))
+	if err != nil {
+		return nil, err
+	}
+	return lo.SliceToMap(products, func(p *ent.Product) (string, *ent.Product) { return p.ID.String(), p }), nil
+}
+
+// lockedStock: locked products with all their variants, keyed by id
+type lockedStock struct {
+	products map[string]*ent.Product
+	variants map[string]*ent.ProductVariant
+}
+
+// lockStock: lock products then all variants of them, both in id order,
+// every stock change locks in this order so concurrent checkouts cannot deadlock;
+// a tx that also locks owner for quota must lock owner first, as checkout and import do
+func lockStock(ctx context.Context, client *ent.Client, productRepo repository.IProductRepository, productIds []string) (*lockedStock, error) {
+	products, err := lockProducts(ctx, client, productRepo, productIds)
+	if err != nil {
+		return nil, err
+	}
+	variants, err := productRepo.LockProductVariantsByProductIds(ctx, client, lo.Keys(products))
+	if err != nil {
+		return nil, err
+	}
+	return &lockedStock{
+		products: products,
+		variants: lo.SliceToMap(variants, func(v *ent.ProductVariant) (string, *ent.ProductVariant) { return v.ID.String(), v }),
+	}, nil
+}
+
+// hasVariants: product sold by variant, stock of product itself unused
+func (s *lockedStock) hasVariants(productId string) bool {
+	return lo.SomeBy(lo.Values(s.variants), func(v *ent.ProductVariant) bool {
+		return v.ProductID.String() == productId && !v.IsArchived
+	})
+}
+
+// line: stock line of existing order item, archived variant included for restock
+func (s *lockedStock) line(productId string, variantId *string) (*stockLine, error) {
+	product, ok := s.products[productId]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	if variantId == nil {
+		return &stockLine{product: product}, nil
+	}
+	variant, ok := s.variants[*variantId]
+	if !ok || variant.ProductID != product.ID {
+		return nil, constants.ErrNotFound
+	}
+	return &stockLine{product: product, variant: variant}, nil
+}
+
+// sellableLine: stock line of new order item,
+// product with variants must be bought by an active variant
+func (s *lockedStock) sellableLine(productId string, variantId *string) (*stockLine, error) {
+	line, err := s.line(productId, variantId)
+	if err != nil {
+		return nil, err
+	}
+	if variantId == nil && s.hasVariants(productId) {
+		return nil, constants.ErrBadRequest
+	}
+	if line.variant != nil && line.variant.IsArchived {
+		return nil, constants.ErrBadRequest
+	}
+	return line, nil
+}
+
+// stockLine: product, or one variant of product
+type stockLine struct {
+	product *ent.Product
+	variant *ent.ProductVariant
+}
+
+func (l *stockLine) quantity() int32 {
+	if l.variant != nil {
+		return l.variant.Quantity
+	}
+	return l.product.Quantity
+}
+
+// price: variant price override, else product price
+func (l *stockLine) price() money.Amount {
+	if l.variant != nil && l.variant.Price != nil {
+		return *l.variant.Price
+	}
+	return l.product.Price
+}
+
+// name: product name with option values of variant in option order, eg "Tee (M, Red)",
+// cut to fit order item name
+func (l *stockLine) name() string {
+	if l.variant == nil {
+		return l.product.Name
+	}
+	values := []string{}
+	for _, option := range l.product.Options {
+		value, ok := l.variant.Options[option.Name]
+		if ok {
+			values = append(values, value)
+		}
+	}
+	name := []rune(fmt.Sprintf("%s (%s)", l.product.Name, strings.Join(values, ", ")))
+	if len(name) > 128 {
+		name = name[:128]
+	}
+	return string(name)
+}
+
+// adjustStock: add delta to variant stock if movement of variant, else to product stock
+func adjustStock(ctx context.Context, client *ent.Client, productRepo repository.IProductRepository,
+	productId string, payload *dto.CreateInventoryMovementDto) error {
+	if payload.VariantId != nil {
+		_, err := productRepo.AdjustProductVariantQuantityById(ctx, client, *payload.VariantId, payload)
+		return err
+	}
+	_, err := productRepo.AdjustProductQuantityById(ctx, client, productId, payload)
+	return err
+}
+
+// orderItemVariantId: nil for item of product without variant
+func orderItemVariantId(item *ent.OrderItem) *string {
+	if item.VariantID == nil {
+		return nil
+	}
+	return utils.PtrOf(item.VariantID.String())
+}
+
+// orderItemLineKey: same key as dto.OrderItem.LineKey
+func orderItemLineKey(item *ent.OrderItem) string {
+	return dto.OrderLineKey(item.ProductID.String(), orderItemVariantId(item))
+}
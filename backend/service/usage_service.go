@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"sthl/constants"
+	"sthl/dto"
+	"sthl/ent"
+	"sthl/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type IUsageService interface {
+	// private
+	GetUsage(ctx context.Context, userId string) (*dto.UsageResponseDto, error)
+}
+type UsageService struct {
+	logger      *zap.Logger
+	client      *ent.Client
+	userRepo    repository.IUserRepository
+	productRepo repository.IProductRepository
+	orderRepo   repository.IOrderRepository
+	imginfoRepo repository.IImgInfoRepository
+	shopRepo    repository.IShopRepository
+}
+
+func NewUsageService(logger *zap.Logger, client *ent.Client,
+	userRepo repository.IUserRepository, productRepo repository.IProductRepository, orderRepo repository.IOrderRepository,
+	imginfoRepo repository.IImgInfoRepository, shopRepo repository.IShopRepository) IUsageService {
+	return &UsageService{
+		logger:      logger,
+		client:      client,
+		userRepo:    userRepo,
+		productRepo: productRepo,
+		orderRepo:   orderRepo,
+		imginfoRepo: imginfoRepo,
+		shopRepo:    shopRepo,
+	}
+}
+
+// GetUsage: consumption of active shop owner against limits of plan
+func (usageSvc *UsageService) GetUsage(ctx context.Context, userId string) (*dto.UsageResponseDto, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		usageSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// check permission in active shop, quota belongs to shop owner
+	ownerId, err := authorizeShop(ctx, usageSvc.logger, usageSvc.client, usageSvc.shopRepo, userId, constants.ShopPermission.ShopSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := ownerPlan(ctx, usageSvc.logger, usageSvc.client, usageSvc.userRepo, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	products, err := usageSvc.productRepo.GetProductsTotalByUserId(ctx, usageSvc.client, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	albumImgs, err := usageSvc.imginfoRepo.GetImgsTotalByUserId(ctx, usageSvc.client, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	storageBytes, err := usageSvc.imginfoRepo.GetImgsSizeByUserId(ctx, usageSvc.client, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	periodStart := monthStart(time.Now())
+	monthlyOrders, err := usageSvc.orderRepo.GetOrdersTotalByUserIdSince(ctx, usageSvc.client, ownerId, periodStart)
+	if err != nil {
+		return nil, err
+	}
+	return dto.NewUsageResponseDto(plan, periodStart, products, albumImgs, storageBytes, monthlyOrders), nil
+}
@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"sthl/authentication"
 	"sthl/constants"
 	"sthl/dto"
 	"sthl/ent"
+	"sthl/mailer"
 	"sthl/repository"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -16,26 +19,67 @@ type IUserService interface {
 	// public
 	Signup(ctx context.Context, payload *dto.CreateUserDto) (*ent.User, error)
 	Login(ctx context.Context, payload *dto.LoginDto) (*authentication.Passport, error)
+	GetJwks(ctx context.Context) *authentication.Jwks
+	RefreshAccessToken(ctx context.Context, payload *dto.RefreshAccessTokenDto) (*authentication.Passport, error)
+	VerifyEmail(ctx context.Context, payload *dto.VerifyEmailDto) (bool, error)
+	ForgotPassword(ctx context.Context, payload *dto.ForgotPasswordDto) (bool, error)
+	ResetPassword(ctx context.Context, payload *dto.ResetPasswordDto) (bool, error)
+	VerifyMfaLogin(ctx context.Context, payload *dto.VerifyMfaLoginDto) (*authentication.Passport, error)
 	// private
-	RefreshAccessToken(ctx context.Context, userId string, payload *dto.RefreshAccessTokenDto) (*authentication.Passport, error)
+	ResendVerificationEmail(ctx context.Context, userId string) (bool, error)
+	GetLoginLockouts(ctx context.Context, userId string) ([]*ent.LoginLockoutEvent, error)
+	Logout(ctx context.Context, userId string, payload *dto.LogoutDto) (bool, error)
+	LogoutAll(ctx context.Context, userId string) (bool, error)
 	GetUserById(ctx context.Context, userId string) (*ent.User, error)
 	UpdateUserPasswordById(ctx context.Context, userId string, payload *dto.UpdateUserPasswordDto) (*ent.User, error)
+	SwitchShop(ctx context.Context, userId string, payload *dto.SwitchShopDto) (*authentication.Passport, error)
+	CreateApiKey(ctx context.Context, userId string, payload *dto.CreateApiKeyDto) (*dto.CreateApiKeyResponseDto, error)
+	GetApiKeys(ctx context.Context, userId string) ([]*ent.ApiKey, error)
+	RevokeApiKey(ctx context.Context, userId string, apiKeyId string) (bool, error)
+	EnrollTotp(ctx context.Context, userId string) (*dto.TotpEnrolmentResponseDto, error)
+	ConfirmTotp(ctx context.Context, userId string, payload *dto.ConfirmTotpDto) (*dto.MfaRecoveryCodesResponseDto, error)
+	DisableTotp(ctx context.Context, userId string, payload *dto.DisableTotpDto) (bool, error)
+	// operator
+	UpdateUserPlanById(ctx context.Context, userId string, payload *dto.UpdateUserPlanDto) (*ent.User, error)
 	// internal
 	authentication.Authenticator
 	// testing
 	GetUsers(ctx context.Context, payload *dto.QueryUsersDto) (*dto.QueryUsersResponseDto, error)
 }
 type UserService struct {
-	logger   *zap.Logger
-	client   *ent.Client
-	userRepo repository.IUserRepository
+	logger           *zap.Logger
+	client           *ent.Client
+	keySet           *authentication.KeySet
+	userRepo         repository.IUserRepository
+	refreshTokenRepo repository.IRefreshTokenRepository
+	emailVerifyRepo  repository.IEmailVerificationRepository
+	pwResetRepo      repository.IPasswordResetRepository
+	loginAttemptRepo repository.ILoginAttemptRepository
+	shopRepo         repository.IShopRepository
+	apiKeyRepo       repository.IApiKeyRepository
+	mfaRepo          repository.IMfaRepository
+	mailer           mailer.Mailer
 }
 
-func NewUserService(logger *zap.Logger, client *ent.Client, userRepo repository.IUserRepository) IUserService {
+func NewUserService(logger *zap.Logger, client *ent.Client,
+	keySet *authentication.KeySet, mailer mailer.Mailer,
+	userRepo repository.IUserRepository, refreshTokenRepo repository.IRefreshTokenRepository,
+	emailVerifyRepo repository.IEmailVerificationRepository, pwResetRepo repository.IPasswordResetRepository,
+	loginAttemptRepo repository.ILoginAttemptRepository, shopRepo repository.IShopRepository,
+	apiKeyRepo repository.IApiKeyRepository, mfaRepo repository.IMfaRepository) IUserService {
 	return &UserService{
-		logger:   logger,
-		client:   client,
-		userRepo: userRepo,
+		logger:           logger,
+		client:           client,
+		keySet:           keySet,
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		emailVerifyRepo:  emailVerifyRepo,
+		pwResetRepo:      pwResetRepo,
+		loginAttemptRepo: loginAttemptRepo,
+		shopRepo:         shopRepo,
+		apiKeyRepo:       apiKeyRepo,
+		mfaRepo:          mfaRepo,
+		mailer:           mailer,
 	}
 }
 
@@ -60,6 +104,7 @@ func (userSvc *UserService) Signup(ctx context.Context, payload *dto.CreateUserD
 
 	// call repo to createUser with tx
 	var result *ent.User
+	var verifyToken string
 	txFunc := func(tx *ent.Tx) error {
 		// extract tx client as ent.cient
 		txc := tx.Client()
@@ -68,15 +113,167 @@ func (userSvc *UserService) Signup(ctx context.Context, payload *dto.CreateUserD
 			return err
 		}
 		result = rs
+
+		// every user owns a shop, owner also listed as member
+		shop, err := userSvc.shopRepo.CreateShop(ctx, txc, rs.ID.String(), rs.Email)
+		if err != nil {
+			return err
+		}
+		_, err = userSvc.shopRepo.UpsertShopMember(ctx, txc, shop.ID.String(), rs.ID.String(), constants.ShopRole.Owner)
+		if err != nil {
+			return err
+		}
+
+		// issue email verification token
+		token, err := userSvc.issueEmailVerificationToken(ctx, txc, rs.ID.String())
+		if err != nil {
+			return err
+		}
+		verifyToken = token
 		return nil
 	}
 	err = userSvc.userRepo.WithTx(ctx, userSvc.client, txFunc)
 	if err != nil {
 		return nil, err
 	}
+
+	// send mail after commit, user can resend on failure
+	err = userSvc.mailer.Send(ctx, mailer.NewEmailVerificationMessage(userSvc.mailer, result.Email, verifyToken))
+	if err != nil {
+		userSvc.logger.Info("fail to send verification email", zap.Error(err))
+	}
 	return result, nil
 }
 
+// issueEmailVerificationToken: store hash, return raw token for mail
+func (userSvc *UserService) issueEmailVerificationToken(ctx context.Context, client *ent.Client, userId string) (string, error) {
+	token, tokenHash, err := authentication.GenerateOpaqueToken()
+	if err != nil {
+		userSvc.logger.Info("fail to generateOpaqueToken", zap.Error(err))
+		return "", constants.ErrInternalServer
+	}
+	expiresAt := time.Now().Add(constants.EmailVerificationTokenDuration)
+	_, err = userSvc.emailVerifyRepo.CreateEmailVerificationToken(ctx, client, userId, tokenHash, expiresAt)
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// VerifyEmail: consume single use token and mark email verified
+func (userSvc *UserService) VerifyEmail(ctx context.Context, payload *dto.VerifyEmailDto) (bool, error) {
+	// validate
+	err := payload.Validate()
+	if err != nil {
+		userSvc.logger.Info("fail to validate", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	// verify with transaction
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// call repo to get token by hash
+		found, err := userSvc.emailVerifyRepo.GetEmailVerificationTokenByHash(
+			ctx, txc, authentication.HashOpaqueToken(*payload.Token))
+		if err != nil {
+			if errors.Is(err, constants.ErrNotFound) {
+				return constants.ErrBadRequest
+			}
+			return err
+		}
+
+		// call repo to consume token, used or expired token is rejected
+		used, err := userSvc.emailVerifyRepo.UseEmailVerificationTokenById(ctx, txc, found.ID.String())
+		if err != nil {
+			return err
+		}
+		if !used {
+			userSvc.logger.Info("email verification token used or expired")
+			return constants.ErrBadRequest
+		}
+
+		// call repo to mark email verified
+		_, err = userSvc.userRepo.UpdateUserEmailVerifiedById(ctx, txc, found.UserID.String(), true)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+	err = userSvc.emailVerifyRepo.WithTx(ctx, userSvc.client, txFunc)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ResendVerificationEmail: throttled by interval and by max per window
+func (userSvc *UserService) ResendVerificationEmail(ctx context.Context, userId string) (bool, error) {
+	// parse userId to uuid
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		userSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	// issue with transaction
+	var found *ent.User
+	var verifyToken string
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// call repo to getUserById
+		rs, err := userSvc.userRepo.GetUserById(ctx, txc, userId)
+		if err != nil {
+			return err
+		}
+		if rs.EmailVerified {
+			userSvc.logger.Info("email already verified")
+			return constants.ErrBadRequest
+		}
+		found = rs
+
+		// throttle
+		now := time.Now()
+		recent, err := userSvc.emailVerifyRepo.CountEmailVerificationTokensSince(
+			ctx, txc, userId, now.Add(-constants.EmailVerificationResendInterval))
+		if err != nil {
+			return err
+		}
+		inWindow, err := userSvc.emailVerifyRepo.CountEmailVerificationTokensSince(
+			ctx, txc, userId, now.Add(-constants.EmailVerificationResendWindow))
+		if err != nil {
+			return err
+		}
+		if recent > 0 || inWindow >= constants.EmailVerificationResendMax {
+			userSvc.logger.Info("resend verification email throttled", zap.Int("recent", recent), zap.Int("inWindow", inWindow))
+			return constants.ErrTooManyRequest
+		}
+
+		// issue email verification token
+		token, err := userSvc.issueEmailVerificationToken(ctx, txc, userId)
+		if err != nil {
+			return err
+		}
+		verifyToken = token
+		return nil
+	}
+	err = userSvc.emailVerifyRepo.WithTx(ctx, userSvc.client, txFunc)
+	if err != nil {
+		return false, err
+	}
+
+	// send mail
+	err = userSvc.mailer.Send(ctx, mailer.NewEmailVerificationMessage(userSvc.mailer, found.Email, verifyToken))
+	if err != nil {
+		userSvc.logger.Info("fail to send verification email", zap.Error(err))
+		return false, constants.ErrInternalServer
+	}
+	return true, nil
+}
+
 // Login
 func (userSvc *UserService) Login(ctx context.Context, payload *dto.LoginDto) (*authentication.Passport, error) {
 	// validate
@@ -86,30 +283,214 @@ func (userSvc *UserService) Login(ctx context.Context, payload *dto.LoginDto) (*
 		return nil, constants.ErrBadRequest
 	}
 
-	// call repo to createUser
+	// check throttle by account and by client ip
+	ip, _ := ctx.Value(constants.ClientIpKey).(string)
+	keys := newLoginThrottleKeys(*payload.Email, ip)
+	err = userSvc.checkLoginThrottle(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	// call repo to getUserByEmail, unknown email also counts as failure
 	result, err := userSvc.userRepo.GetUserByEmail(ctx, userSvc.client, *payload.Email)
 	if err != nil {
+		if errors.Is(err, constants.ErrNotFound) {
+			userSvc.registerLoginFailure(ctx, keys, "", ip)
+		}
 		return nil, err
 	}
 
 	// compare password
 	err = authentication.CompareHashPassword(result.HashedPw, *payload.Password)
 	if err != nil {
+		userSvc.registerLoginFailure(ctx, keys, result.ID.String(), ip)
+		return nil, constants.ErrBadRequest
+	}
+
+	// own shop is active after login
+	shopId, err := userSvc.ownShopId(ctx, result.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// with 2fa enabled only hand out mfa pending token,
+	// throttle keeps counting until second factor passes
+	totpEnabled, err := userSvc.isTotpEnabled(ctx, userSvc.client, result.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if totpEnabled {
+		pp, err := authentication.GenerateMfaPendingPassport(userSvc.keySet,
+			constants.MfaPendingTokenDuration, result.ID.String(), shopId)
+		if err != nil {
+			userSvc.logger.Info("fail to generateMfaPendingPassport", zap.Error(err))
+			return nil, constants.ErrInternalServer
+		}
+		return pp, nil
+	}
+
+	// reset account counter, ip counter only decays
+	err = userSvc.loginAttemptRepo.DeleteLoginThrottle(ctx, userSvc.client, keys[0].key)
+	if err != nil {
+		userSvc.logger.Info("fail to reset login throttle", zap.Error(err))
+	}
+
+	// generate pp, start a new refresh token family
+	pp, err := userSvc.issuePassport(ctx, userSvc.client, result.ID.String(), shopId, uuid.NewString())
+	if err != nil {
+		return nil, err
+	}
+	return pp, nil
+}
+
+// loginThrottleKey
+type loginThrottleKey struct {
+	scope string
+	key   string
+}
+
+// newLoginThrottleKeys: account key first, ip key if known
+func newLoginThrottleKeys(email string, ip string) []loginThrottleKey {
+	keys := []loginThrottleKey{{
+		scope: constants.LoginThrottleScope.Account,
+		key:   authentication.LoginThrottleKey(constants.LoginThrottleScope.Account, email),
+	}}
+	if ip != "" {
+		keys = append(keys, loginThrottleKey{
+			scope: constants.LoginThrottleScope.Ip,
+			key:   authentication.LoginThrottleKey(constants.LoginThrottleScope.Ip, ip),
+		})
+	}
+	return keys
+}
+
+// checkLoginThrottle: reject while locked or within backoff of any key
+func (userSvc *UserService) checkLoginThrottle(ctx context.Context, keys []loginThrottleKey) error {
+	now := time.Now()
+	for _, k := range keys {
+		found, err := userSvc.loginAttemptRepo.GetLoginThrottle(ctx, userSvc.client, k.key)
+		if err != nil {
+			if errors.Is(err, constants.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		if found.LockedUntil != nil && now.Before(*found.LockedUntil) {
+			userSvc.logger.Info("login locked out", zap.String("scope", k.scope))
+			return constants.ErrTooManyRequest
+		}
+		if now.Sub(found.LastFailedAt) > constants.LoginFailureWindow {
+			continue
+		}
+		if now.Before(found.LastFailedAt.Add(authentication.LoginBackoff(found.Failures))) {
+			userSvc.logger.Info("login backoff", zap.String("scope", k.scope), zap.Int("failures", found.Failures))
+			return constants.ErrTooManyRequest
+		}
+	}
+	return nil
+}
+
+// registerLoginFailure: count failure on every key, lock out at threshold,
+// record lockout event if account is known; errors are only logged
+func (userSvc *UserService) registerLoginFailure(ctx context.Context, keys []loginThrottleKey, userId string, ip string) {
+	now := time.Now()
+	for _, k := range keys {
+		// failures outside window start over
+		found, err := userSvc.loginAttemptRepo.GetLoginThrottle(ctx, userSvc.client, k.key)
+		if err == nil && now.Sub(found.LastFailedAt) > constants.LoginFailureWindow {
+			err = userSvc.loginAttemptRepo.DeleteLoginThrottle(ctx, userSvc.client, k.key)
+			if err != nil {
+				userSvc.logger.Info("fail to reset stale login throttle", zap.Error(err))
+			}
+		}
+
+		counted, err := userSvc.loginAttemptRepo.AddLoginFailure(ctx, userSvc.client, k.key, now)
+		if err != nil {
+			userSvc.logger.Info("fail to add login failure", zap.Error(err))
+			continue
+		}
+		if counted.Failures < authentication.LoginLockoutThreshold(k.scope) {
+			continue
+		}
+
+		// lock out
+		lockedUntil := now.Add(constants.LoginLockoutDuration)
+		err = userSvc.loginAttemptRepo.LockLoginThrottle(ctx, userSvc.client, k.key, lockedUntil)
+		if err != nil {
+			userSvc.logger.Info("fail to lock login throttle", zap.Error(err))
+			continue
+		}
+		userSvc.logger.Warn("login locked out",
+			zap.String("scope", k.scope), zap.String("userId", userId), zap.String("ip", ip), zap.Int("failures", counted.Failures))
+		if userId == "" {
+			continue
+		}
+		_, err = userSvc.loginAttemptRepo.CreateLoginLockoutEvent(
+			ctx, userSvc.client, userId, k.scope, ip, counted.Failures, lockedUntil)
+		if err != nil {
+			userSvc.logger.Info("fail to create login lockout event", zap.Error(err))
+		}
+	}
+}
+
+// GetLoginLockouts: latest lockout events of user
+func (userSvc *UserService) GetLoginLockouts(ctx context.Context, userId string) ([]*ent.LoginLockoutEvent, error) {
+	// parse userId to uuid
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		userSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
 		return nil, constants.ErrBadRequest
 	}
 
-	// generate pp
-	pp, err := authentication.GeneratePassport(
-		constants.AccessTokenDuration, constants.RefreshTokenDuration, result.ID.String())
+	// call repo to get events
+	result, err := userSvc.loginAttemptRepo.GetLoginLockoutEventsByUserId(
+		ctx, userSvc.client, userId, constants.MaxLoginLockoutEvents)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ownShopId: empty for user signed up before shops
+func (userSvc *UserService) ownShopId(ctx context.Context, userId string) (string, error) {
+	shop, err := userSvc.shopRepo.GetShopByUserId(ctx, userSvc.client, userId)
+	if err != nil {
+		if errors.Is(err, constants.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return shop.ID.String(), nil
+}
+
+// issuePassport: store refresh token under family, then sign passport
+func (userSvc *UserService) issuePassport(ctx context.Context, client *ent.Client,
+	userId string, shopId string, familyId string) (*authentication.Passport, error) {
+	jti := uuid.NewString()
+	expiresAt := time.Now().Add(constants.RefreshTokenDuration)
+	_, err := userSvc.refreshTokenRepo.CreateRefreshToken(ctx, client, userId, jti, familyId, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	pp, err := authentication.GeneratePassport(userSvc.keySet,
+		constants.AccessTokenDuration, constants.RefreshTokenDuration, userId, shopId, jti)
 	if err != nil {
+		userSvc.logger.Info("fail to generatePassport", zap.Error(err))
 		return nil, constants.ErrInternalServer
 	}
 	return pp, nil
 }
 
-// RefreshAccessToken
+// GetJwks
+func (userSvc *UserService) GetJwks(ctx context.Context) *authentication.Jwks {
+	return userSvc.keySet.PublicJwks()
+}
+
+// RefreshAccessToken: rotate refresh token on every refresh,
+// presenting an already rotated or revoked token revokes the whole family
 func (userSvc *UserService) RefreshAccessToken(
-	ctx context.Context, userId string, payload *dto.RefreshAccessTokenDto) (*authentication.Passport, error) {
+	ctx context.Context, payload *dto.RefreshAccessTokenDto) (*authentication.Passport, error) {
 	// validate
 	err := payload.Validate()
 	if err != nil {
@@ -118,25 +499,139 @@ func (userSvc *UserService) RefreshAccessToken(
 	}
 
 	// verify refresh token
-	claim, err := authentication.VerifyJwtToken(*payload.RefreshToken)
+	claim, err := authentication.VerifyJwtToken(userSvc.keySet, constants.TokenUse.Refresh, *payload.RefreshToken)
 	if err != nil {
 		userSvc.logger.Info("fail to verifyJwtToken", zap.Error(err))
 		return nil, constants.ErrBadRequest
 	}
 
-	// check at and rt equal userId
-	if userId != claim.UserId {
+	// rotate with transaction
+	var result *authentication.Passport
+	reused := false
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// call repo to get stored refresh token
+		stored, err := userSvc.refreshTokenRepo.GetRefreshTokenById(ctx, txc, claim.ID)
+		if err != nil {
+			if errors.Is(err, constants.ErrNotFound) {
+				return constants.ErrBadRequest
+			}
+			return err
+		}
+		if stored.UserID.String() != claim.UserId {
+			userSvc.logger.Info("stored.UserID not equal claim.UserId")
+			return constants.ErrBadRequest
+		}
+
+		// call repo to revoke current token, only one caller can win
+		newJti := uuid.NewString()
+		revoked, err := userSvc.refreshTokenRepo.RevokeRefreshTokenById(
+			ctx, txc, claim.ID, constants.RefreshTokenRevokeReason.Rotated, &newJti)
+		if err != nil {
+			return err
+		}
+
+		// reuse detected, revoke family and commit
+		if !revoked {
+			_, err := userSvc.refreshTokenRepo.RevokeRefreshTokensByFamilyId(
+				ctx, txc, stored.FamilyID.String(), constants.RefreshTokenRevokeReason.ReuseDetected)
+			if err != nil {
+				return err
+			}
+			reused = true
+			return nil
+		}
+
+		// call repo to store new token in same family
+		expiresAt := time.Now().Add(constants.RefreshTokenDuration)
+		_, err = userSvc.refreshTokenRepo.CreateRefreshToken(ctx, txc, claim.UserId, newJti, stored.FamilyID.String(), expiresAt)
+		if err != nil {
+			return err
+		}
+		pp, err := authentication.GeneratePassport(userSvc.keySet,
+			constants.AccessTokenDuration, constants.RefreshTokenDuration, claim.UserId, claim.ShopId, newJti)
+		if err != nil {
+			userSvc.logger.Info("fail to generatePassport", zap.Error(err))
+			return constants.ErrInternalServer
+		}
+		result = pp
+		return nil
+	}
+	err = userSvc.refreshTokenRepo.WithTx(ctx, userSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		userSvc.logger.Warn("refresh token reuse detected, family revoked", zap.String("userId", claim.UserId))
+		return nil, constants.ErrUnauthorized
+	}
+	return result, nil
+}
+
+// Logout: revoke the refresh token family of current session
+func (userSvc *UserService) Logout(ctx context.Context, userId string, payload *dto.LogoutDto) (bool, error) {
+	// validate
+	err := payload.Validate()
+	if err != nil {
+		userSvc.logger.Info("fail to validate", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	// verify refresh token
+	claim, err := authentication.VerifyJwtToken(userSvc.keySet, constants.TokenUse.Refresh, *payload.RefreshToken)
+	if err != nil {
+		userSvc.logger.Info("fail to verifyJwtToken", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+	if claim.UserId != userId {
 		userSvc.logger.Info("userId not equal claim.UserId")
-		return nil, constants.ErrBadRequest
+		return false, constants.ErrUnauthorized
 	}
 
-	// pass, re-generate pp
-	pp, err := authentication.GeneratePassport(constants.AccessTokenDuration, constants.RefreshTokenDuration, userId)
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// call repo to get stored refresh token
+		stored, err := userSvc.refreshTokenRepo.GetRefreshTokenById(ctx, txc, claim.ID)
+		if err != nil {
+			return err
+		}
+
+		// call repo to revoke family
+		_, err = userSvc.refreshTokenRepo.RevokeRefreshTokensByFamilyId(
+			ctx, txc, stored.FamilyID.String(), constants.RefreshTokenRevokeReason.Logout)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+	err = userSvc.refreshTokenRepo.WithTx(ctx, userSvc.client, txFunc)
 	if err != nil {
-		userSvc.logger.Info("fail to generatePassport", zap.Error(err))
-		return nil, constants.ErrInternalServer
+		return false, err
 	}
-	return pp, nil
+	return true, nil
+}
+
+// LogoutAll: revoke every refresh token of user,
+// issued access tokens stay valid until their short expiry
+func (userSvc *UserService) LogoutAll(ctx context.Context, userId string) (bool, error) {
+	// parse userId to uuid
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		userSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	// call repo to revoke by user
+	_, err = userSvc.refreshTokenRepo.RevokeRefreshTokensByUserId(
+		ctx, userSvc.client, userId, constants.RefreshTokenRevokeReason.LogoutAll)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 // GetUserById
@@ -162,6 +657,29 @@ func (userSvc *UserService) GetUserById(ctx context.Context, userId string) (*en
 	return result, nil
 }
 
+// UpdateUserPlanById: assign plan of user by operator, limits of shops owned by user follow at once
+func (userSvc *UserService) UpdateUserPlanById(ctx context.Context, userId string, payload *dto.UpdateUserPlanDto) (*ent.User, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		userSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		userSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to update plan
+	result, err := userSvc.userRepo.UpdateUserPlanById(ctx, userSvc.client, userId, *payload.Plan)
+	if err != nil {
+		return nil, err
+	}
+	userSvc.logger.Info("user plan updated", zap.String("userId", userId), zap.String("plan", result.Plan))
+	return result, nil
+}
+
 // UpdateUserPasswordById
 func (userSvc *UserService) UpdateUserPasswordById(
 	ctx context.Context, userId string, payload *dto.UpdateUserPasswordDto) (*ent.User, error) {
@@ -211,6 +729,12 @@ func (userSvc *UserService) UpdateUserPasswordById(
 		if err != nil {
 			return constants.ErrBadRequest
 		}
+
+		// call repo to invalidate outstanding reset tokens
+		_, err = userSvc.pwResetRepo.InvalidatePasswordResetTokensByUserId(ctx, txc, userId)
+		if err != nil {
+			return err
+		}
 		return nil
 	}
 	err = userSvc.userRepo.WithTx(ctx, userSvc.client, txFunc)
@@ -220,14 +744,563 @@ func (userSvc *UserService) UpdateUserPasswordById(
 	return result, nil
 }
 
-// Authenticate
-func (userSvc *UserService) Authenticate(ctx context.Context, token string) (string, error) {
-	result, err := authentication.VerifyJwtToken(token)
+// ForgotPassword: always succeed for valid payload,
+// never reveal whether email is registered
+func (userSvc *UserService) ForgotPassword(ctx context.Context, payload *dto.ForgotPasswordDto) (bool, error) {
+	// validate
+	err := payload.Validate()
+	if err != nil {
+		userSvc.logger.Info("fail to validate", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	// issue with transaction
+	var found *ent.User
+	var resetToken string
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// call repo to getUserByEmail, unknown email is silently ignored
+		rs, err := userSvc.userRepo.GetUserByEmail(ctx, txc, *payload.Email)
+		if err != nil {
+			if errors.Is(err, constants.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		// throttle silently
+		recent, err := userSvc.pwResetRepo.CountPasswordResetTokensSince(
+			ctx, txc, rs.ID.String(), time.Now().Add(-constants.PasswordResetInterval))
+		if err != nil {
+			return err
+		}
+		if recent > 0 {
+			userSvc.logger.Info("forgot password throttled", zap.String("userId", rs.ID.String()))
+			return nil
+		}
+
+		// call repo to store token hash
+		token, tokenHash, err := authentication.GenerateOpaqueToken()
+		if err != nil {
+			userSvc.logger.Info("fail to generateOpaqueToken", zap.Error(err))
+			return constants.ErrInternalServer
+		}
+		expiresAt := time.Now().Add(constants.PasswordResetTokenDuration)
+		_, err = userSvc.pwResetRepo.CreatePasswordResetToken(ctx, txc, rs.ID.String(), tokenHash, expiresAt)
+		if err != nil {
+			return err
+		}
+		found = rs
+		resetToken = token
+		return nil
+	}
+	err = userSvc.pwResetRepo.WithTx(ctx, userSvc.client, txFunc)
+	if err != nil {
+		return false, err
+	}
+	if found == nil {
+		return true, nil
+	}
+
+	// send mail, failure is only logged
+	err = userSvc.mailer.Send(ctx, mailer.NewPasswordResetMessage(userSvc.mailer, found.Email, resetToken))
+	if err != nil {
+		userSvc.logger.Info("fail to send password reset email", zap.Error(err))
+	}
+	return true, nil
+}
+
+// ResetPassword: consume single use token, set new password,
+// invalidate other reset tokens and revoke all sessions
+func (userSvc *UserService) ResetPassword(ctx context.Context, payload *dto.ResetPasswordDto) (bool, error) {
+	// validate
+	err := payload.Validate()
+	if err != nil {
+		userSvc.logger.Info("fail to validate", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	// hash new pw
+	hashedNewPw, err := authentication.HashPassword(*payload.NewPassword)
+	if err != nil {
+		userSvc.logger.Info("fail to new hashPassword", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	// reset with transaction
+	txFunc := func(tx *ent.Tx) error {
+		// extract tx client as ent.cient
+		txc := tx.Client()
+
+		// call repo to get token by hash
+		found, err := userSvc.pwResetRepo.GetPasswordResetTokenByHash(
+			ctx, txc, authentication.HashOpaqueToken(*payload.Token))
+		if err != nil {
+			if errors.Is(err, constants.ErrNotFound) {
+				return constants.ErrBadRequest
+			}
+			return err
+		}
+
+		// call repo to consume token, used or expired token is rejected
+		used, err := userSvc.pwResetRepo.UsePasswordResetTokenById(ctx, txc, found.ID.String())
+		if err != nil {
+			return err
+		}
+		if !used {
+			userSvc.logger.Info("password reset token used or expired")
+			return constants.ErrBadRequest
+		}
+		userId := found.UserID.String()
+
+		// call repo to updateUserPasswordById
+		_, err = userSvc.userRepo.UpdateUserPasswordById(ctx, txc, userId, hashedNewPw)
+		if err != nil {
+			return err
+		}
+
+		// call repo to invalidate other reset tokens
+		_, err = userSvc.pwResetRepo.InvalidatePasswordResetTokensByUserId(ctx, txc, userId)
+		if err != nil {
+			return err
+		}
+
+		// call repo to revoke all sessions
+		_, err = userSvc.refreshTokenRepo.RevokeRefreshTokensByUserId(
+			ctx, txc, userId, constants.RefreshTokenRevokeReason.PasswordReset)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+	err = userSvc.pwResetRepo.WithTx(ctx, userSvc.client, txFunc)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Authenticate
+func (userSvc *UserService) Authenticate(ctx context.Context, token string) (*authentication.AccessTokenInfo, error) {
+	result, err := authentication.VerifyJwtToken(userSvc.keySet, constants.TokenUse.Access, token)
+	if err != nil {
+		userSvc.logger.Info("fail to verifyJwtToken", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	return &authentication.AccessTokenInfo{
+		UserId: result.UserId,
+		ShopId: result.ShopId,
+	}, nil
+}
+
+// SwitchShop: issue new passport with shop active, user must own or be member of shop
+func (userSvc *UserService) SwitchShop(
+	ctx context.Context, userId string, payload *dto.SwitchShopDto) (*authentication.Passport, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		userSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		userSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	// call repo to get shop and check role
+	shop, err := userSvc.shopRepo.GetShopById(ctx, userSvc.client, *payload.ShopId)
+	if err != nil {
+		if errors.Is(err, constants.ErrNotFound) {
+			return nil, constants.ErrForbidden
+		}
+		return nil, err
+	}
+	_, err = shopRoleOf(ctx, userSvc.logger, userSvc.client, userSvc.shopRepo, shop, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// new session family for switched shop
+	pp, err := userSvc.issuePassport(ctx, userSvc.client, userId, shop.ID.String(), uuid.NewString())
+	if err != nil {
+		return nil, err
+	}
+	return pp, nil
+}
+
+// AuthenticateApiKey: key must exist, not revoked and not expired,
+// key acts in the shop it was created for with its scopes only
+func (userSvc *UserService) AuthenticateApiKey(ctx context.Context, key string) (*authentication.AccessTokenInfo, error) {
+	if !authentication.IsApiKeyFormat(key) {
+		userSvc.logger.Info("invalid api key format")
+		return nil, constants.ErrUnauthorized
+	}
+
+	found, err := userSvc.apiKeyRepo.GetApiKeyByHash(ctx, userSvc.client, authentication.HashOpaqueToken(key))
+	if err != nil {
+		if errors.Is(err, constants.ErrNotFound) {
+			return nil, constants.ErrUnauthorized
+		}
+		return nil, err
+	}
+	now := time.Now()
+	if found.RevokedAt != nil || !now.Before(found.ExpiresAt) {
+		userSvc.logger.Info("api key revoked or expired", zap.String("apiKeyId", found.ID.String()))
+		return nil, constants.ErrUnauthorized
+	}
+
+	// last used is informative only, not fail the request
+	err = userSvc.apiKeyRepo.TouchApiKeyById(ctx, userSvc.client, found.ID.String(), now)
+	if err != nil {
+		userSvc.logger.Info("fail to touch api key", zap.Error(err))
+	}
+
+	result := &authentication.AccessTokenInfo{
+		UserId: found.UserID.String(),
+		Scopes: append([]string{}, found.Scopes...),
+	}
+	if found.ShopID != nil {
+		result.ShopId = found.ShopID.String()
+	}
+	return result, nil
+}
+
+// CreateApiKey: key is bound to active shop of session,
+// raw key is returned once and only its hash is stored
+func (userSvc *UserService) CreateApiKey(
+	ctx context.Context, userId string, payload *dto.CreateApiKeyDto) (*dto.CreateApiKeyResponseDto, error) {
+	// validate
+	_, err := uuid.Parse(userId)
+	if err != nil {
+		userSvc.logger.Info("fail to parse userId to uuid", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	err = payload.Validate()
+	if err != nil {
+		userSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	key, prefix, keyHash, err := authentication.GenerateApiKey()
+	if err != nil {
+		userSvc.logger.Info("fail to generateApiKey", zap.Error(err))
+		return nil, constants.ErrInternalServer
+	}
+	now := time.Now()
+	expiresAt := now.Add(constants.ApiKeyDefaultDuration)
+	if payload.ExpiresInDays != nil {
+		expiresAt = now.Add(time.Duration(*payload.ExpiresInDays) * 24 * time.Hour)
+	}
+	var shopId *string
+	if activeShop := activeShopId(ctx); activeShop != "" {
+		shopId = &activeShop
+	}
+
+	// call repo to check limit and create with tx
+	var result *ent.ApiKey
+	txFunc := func(tx *ent.Tx) error {
+		txc := tx.Client()
+		keys, err := userSvc.apiKeyRepo.GetApiKeysByUserId(ctx, txc, userId)
+		if err != nil {
+			return err
+		}
+		active := 0
+		for _, k := range keys {
+			if k.RevokedAt == nil && now.Before(k.ExpiresAt) {
+				active++
+			}
+		}
+		if active >= constants.MaxApiKeys {
+			userSvc.logger.Info("api key limit reached", zap.String("userId", userId))
+			return constants.ErrBadRequest
+		}
+
+		rs, err := userSvc.apiKeyRepo.CreateApiKey(ctx, txc, userId, shopId,
+			*payload.Name, prefix, keyHash, payload.Scopes, expiresAt)
+		if err != nil {
+			return err
+		}
+		result = rs
+		return nil
+	}
+	err = userSvc.apiKeyRepo.WithTx(ctx, userSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return dto.NewCreateApiKeyResponseDto(result, key), nil
+}
+
+// GetApiKeys: keys of user including revoked and expired
+func (userSvc *UserService) GetApiKeys(ctx context.Context, userId string) ([]*ent.ApiKey, error) {
+	result, err := userSvc.apiKeyRepo.GetApiKeysByUserId(ctx, userSvc.client, userId)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RevokeApiKey: user can only revoke own key
+func (userSvc *UserService) RevokeApiKey(ctx context.Context, userId string, apiKeyId string) (bool, error) {
+	revoked, err := userSvc.apiKeyRepo.RevokeApiKeyById(ctx, userSvc.client, userId, apiKeyId)
+	if err != nil {
+		return false, err
+	}
+	if !revoked {
+		userSvc.logger.Info("api key not found or already revoked", zap.String("apiKeyId", apiKeyId))
+		return false, constants.ErrNotFound
+	}
+	return true, nil
+}
+
+// isTotpEnabled: pending enrolment does not count
+func (userSvc *UserService) isTotpEnabled(ctx context.Context, client *ent.Client, userId string) (bool, error) {
+	found, err := userSvc.mfaRepo.GetUserTotpByUserId(ctx, client, userId)
+	if err != nil {
+		if errors.Is(err, constants.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return found.ConfirmedAt != nil, nil
+}
+
+// verifyMfaCode: totp code of unused step, or unused recovery code which is consumed
+func (userSvc *UserService) verifyMfaCode(ctx context.Context, client *ent.Client,
+	totp *ent.UserTotp, code string) (bool, error) {
+	userId := totp.UserID.String()
+	if authentication.IsTotpCodeFormat(code) {
+		step, ok := authentication.VerifyTotp(totp.Secret, code, time.Now())
+		if !ok {
+			return false, nil
+		}
+		return userSvc.mfaRepo.UseUserTotpStepByUserId(ctx, client, userId, step)
+	}
+	return userSvc.mfaRepo.UseMfaRecoveryCode(ctx, client, userId, authentication.HashRecoveryCode(code))
+}
+
+// VerifyMfaLogin: second login step, exchange mfa pending token and code for passport,
+// failures count toward the same throttle as password
+func (userSvc *UserService) VerifyMfaLogin(ctx context.Context, payload *dto.VerifyMfaLoginDto) (*authentication.Passport, error) {
+	// validate
+	err := payload.Validate()
+	if err != nil {
+		userSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+	claim, err := authentication.VerifyJwtToken(userSvc.keySet, constants.TokenUse.MfaPending, *payload.MfaToken)
 	if err != nil {
 		userSvc.logger.Info("fail to verifyJwtToken", zap.Error(err))
-		return "", constants.ErrBadRequest
+		return nil, constants.ErrBadRequest
+	}
+
+	// check throttle by account and by client ip
+	found, err := userSvc.userRepo.GetUserById(ctx, userSvc.client, claim.UserId)
+	if err != nil {
+		return nil, err
+	}
+	ip, _ := ctx.Value(constants.ClientIpKey).(string)
+	keys := newLoginThrottleKeys(found.Email, ip)
+	err = userSvc.checkLoginThrottle(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	totp, err := userSvc.mfaRepo.GetUserTotpByUserId(ctx, userSvc.client, claim.UserId)
+	if err != nil {
+		if errors.Is(err, constants.ErrNotFound) {
+			return nil, constants.ErrBadRequest
+		}
+		return nil, err
+	}
+	if totp.ConfirmedAt == nil {
+		userSvc.logger.Info("totp not enabled", zap.String("userId", claim.UserId))
+		return nil, constants.ErrBadRequest
+	}
+	ok, err := userSvc.verifyMfaCode(ctx, userSvc.client, totp, *payload.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		userSvc.registerLoginFailure(ctx, keys, claim.UserId, ip)
+		return nil, constants.ErrBadRequest
+	}
+
+	// reset account counter, ip counter only decays
+	err = userSvc.loginAttemptRepo.DeleteLoginThrottle(ctx, userSvc.client, keys[0].key)
+	if err != nil {
+		userSvc.logger.Info("fail to reset login throttle", zap.Error(err))
+	}
+
+	// generate pp, start a new refresh token family
+	pp, err := userSvc.issuePassport(ctx, userSvc.client, claim.UserId, claim.ShopId, uuid.NewString())
+	if err != nil {
+		return nil, err
+	}
+	return pp, nil
+}
+
+// EnrollTotp: store pending secret, replaces previous pending one,
+// not allowed while 2fa is enabled
+func (userSvc *UserService) EnrollTotp(ctx context.Context, userId string) (*dto.TotpEnrolmentResponseDto, error) {
+	found, err := userSvc.userRepo.GetUserById(ctx, userSvc.client, userId)
+	if err != nil {
+		return nil, err
+	}
+	enabled, err := userSvc.isTotpEnabled(ctx, userSvc.client, userId)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		userSvc.logger.Info("totp already enabled", zap.String("userId", userId))
+		return nil, constants.ErrBadRequest
+	}
+
+	secret, err := authentication.GenerateTotpSecret()
+	if err != nil {
+		userSvc.logger.Info("fail to generateTotpSecret", zap.Error(err))
+		return nil, constants.ErrInternalServer
+	}
+	_, err = userSvc.mfaRepo.UpsertUserTotp(ctx, userSvc.client, userId, secret)
+	if err != nil {
+		return nil, err
 	}
-	return result.UserId, nil
+	return dto.NewTotpEnrolmentResponseDto(secret, authentication.TotpProvisioningUri(secret, found.Email)), nil
+}
+
+// ConfirmTotp: enable 2fa with first valid code, return recovery codes once
+func (userSvc *UserService) ConfirmTotp(
+	ctx context.Context, userId string, payload *dto.ConfirmTotpDto) (*dto.MfaRecoveryCodesResponseDto, error) {
+	// validate
+	err := payload.Validate()
+	if err != nil {
+		userSvc.logger.Info("fail to validate", zap.Error(err))
+		return nil, constants.ErrBadRequest
+	}
+
+	totp, err := userSvc.mfaRepo.GetUserTotpByUserId(ctx, userSvc.client, userId)
+	if err != nil {
+		if errors.Is(err, constants.ErrNotFound) {
+			return nil, constants.ErrBadRequest
+		}
+		return nil, err
+	}
+	if totp.ConfirmedAt != nil {
+		userSvc.logger.Info("totp already enabled", zap.String("userId", userId))
+		return nil, constants.ErrBadRequest
+	}
+	step, ok := authentication.VerifyTotp(totp.Secret, *payload.Code, time.Now())
+	if !ok {
+		userSvc.logger.Info("invalid totp code", zap.String("userId", userId))
+		return nil, constants.ErrBadRequest
+	}
+	codes, err := authentication.GenerateRecoveryCodes(constants.MfaRecoveryCodeCount)
+	if err != nil {
+		userSvc.logger.Info("fail to generateRecoveryCodes", zap.Error(err))
+		return nil, constants.ErrInternalServer
+	}
+	codeHashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		codeHashes = append(codeHashes, authentication.HashRecoveryCode(code))
+	}
+
+	// confirm and store recovery codes with tx
+	txFunc := func(tx *ent.Tx) error {
+		txc := tx.Client()
+		confirmed, err := userSvc.mfaRepo.ConfirmUserTotpByUserId(ctx, txc, userId, step)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			userSvc.logger.Info("totp confirmed concurrently or code reused", zap.String("userId", userId))
+			return constants.ErrBadRequest
+		}
+		return userSvc.mfaRepo.ReplaceMfaRecoveryCodes(ctx, txc, userId, codeHashes)
+	}
+	err = userSvc.mfaRepo.WithTx(ctx, userSvc.client, txFunc)
+	if err != nil {
+		return nil, err
+	}
+	return dto.NewMfaRecoveryCodesResponseDto(codes), nil
+}
+
+// DisableTotp: re-authenticate with password and second factor,
+// then drop secret and recovery codes; failures count toward the account login throttle
+func (userSvc *UserService) DisableTotp(ctx context.Context, userId string, payload *dto.DisableTotpDto) (bool, error) {
+	// validate
+	err := payload.Validate()
+	if err != nil {
+		userSvc.logger.Info("fail to validate", zap.Error(err))
+		return false, constants.ErrBadRequest
+	}
+
+	found, err := userSvc.userRepo.GetUserById(ctx, userSvc.client, userId)
+	if err != nil {
+		return false, err
+	}
+
+	// check throttle by account only, caller already authenticated
+	ip, _ := ctx.Value(constants.ClientIpKey).(string)
+	keys := newLoginThrottleKeys(found.Email, "")
+	err = userSvc.checkLoginThrottle(ctx, keys)
+	if err != nil {
+		return false, err
+	}
+
+	err = authentication.CompareHashPassword(found.HashedPw, *payload.Password)
+	if err != nil {
+		userSvc.logger.Info("fail to compareHashPassword", zap.Error(err))
+		userSvc.registerLoginFailure(ctx, keys, userId, ip)
+		return false, constants.ErrBadRequest
+	}
+
+	invalidCode := false
+	txFunc := func(tx *ent.Tx) error {
+		txc := tx.Client()
+		totp, err := userSvc.mfaRepo.GetUserTotpByUserId(ctx, txc, userId)
+		if err != nil {
+			if errors.Is(err, constants.ErrNotFound) {
+				return constants.ErrBadRequest
+			}
+			return err
+		}
+		if totp.ConfirmedAt == nil {
+			userSvc.logger.Info("totp not enabled", zap.String("userId", userId))
+			return constants.ErrBadRequest
+		}
+		ok, err := userSvc.verifyMfaCode(ctx, txc, totp, *payload.Code)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			userSvc.logger.Info("invalid mfa code", zap.String("userId", userId))
+			invalidCode = true
+			return constants.ErrBadRequest
+		}
+		_, err = userSvc.mfaRepo.DeleteUserTotpByUserId(ctx, txc, userId)
+		if err != nil {
+			return err
+		}
+		_, err = userSvc.mfaRepo.DeleteMfaRecoveryCodesByUserId(ctx, txc, userId)
+		return err
+	}
+	err = userSvc.mfaRepo.WithTx(ctx, userSvc.client, txFunc)
+	if err != nil {
+		// counted after rollback, failure survives the tx
+		if invalidCode {
+			userSvc.registerLoginFailure(ctx, keys, userId, ip)
+		}
+		return false, err
+	}
+
+	// reset account counter
+	err = userSvc.loginAttemptRepo.DeleteLoginThrottle(ctx, userSvc.client, keys[0].key)
+	if err != nil {
+		userSvc.logger.Info("fail to reset login throttle", zap.Error(err))
+	}
+	return true, nil
 }
 
 // GetUsers
@@ -241,3 +1314,17 @@ func (userSvc *UserService) GetUsers(ctx context.Context, payload *dto.QueryUser
 	}
 	return result, nil
 }
+
+// ensureEmailVerified: merchant with unverified email cannot publish site or accept orders
+func ensureEmailVerified(ctx context.Context, logger *zap.Logger, client *ent.Client,
+	userRepo repository.IUserRepository, userId string) error {
+	found, err := userRepo.GetUserById(ctx, client, userId)
+	if err != nil {
+		return err
+	}
+	if !found.EmailVerified {
+		logger.Info("email not verified", zap.String("userId", userId))
+		return constants.ErrForbidden
+	}
+	return nil
+}
@@ -2,13 +2,18 @@ package service
 
 import (
 	"context"
+	"regexp"
 	"sthl/authentication"
+	"sthl/constants"
 	"sthl/dto"
 	"sthl/ent"
 	"sthl/logger"
+	"sthl/mailer"
 	"sthl/repository"
 	"sthl/utils"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/google/uuid"
@@ -17,6 +22,19 @@ import (
 
 // userServiceTestSetup
 func userServiceTestSetup(ctx context.Context, t *testing.T) (*assert.Assertions, IUserService) {
+	assert, userService, _ := userServiceTestSetupWithMailer(ctx, t)
+	return assert, userService
+}
+
+// userServiceTestSetupWithMailer: expose log mailer to read sent tokens
+func userServiceTestSetupWithMailer(ctx context.Context, t *testing.T) (*assert.Assertions, IUserService, *mailer.LogMailer) {
+	assert, userService, logMailer, _ := userServiceTestSetupWithLoginAttempt(ctx, t)
+	return assert, userService, logMailer
+}
+
+// userServiceTestSetupWithLoginAttempt: also expose login attempt store to seed failures
+func userServiceTestSetupWithLoginAttempt(ctx context.Context, t *testing.T) (
+	*assert.Assertions, IUserService, *mailer.LogMailer, repository.ILoginAttemptRepository) {
 	t.Setenv("JWT_SECRET", "test_value")
 	assert := assert.New(t)
 	// dependency init
@@ -25,10 +43,40 @@ func userServiceTestSetup(ctx context.Context, t *testing.T) (*assert.Assertions
 	assert.NotEmpty(zapLogger)
 	assert.NoError(err)
 	userRepo := repository.NewUserRepositoryMock()
-	userService := NewUserService(zapLogger, nil, userRepo)
+	keySet := newTestKeySet(assert)
+	refreshTokenRepo := repository.NewRefreshTokenRepositoryMock()
+	emailVerifyRepo := repository.NewEmailVerificationRepositoryMock()
+	pwResetRepo := repository.NewPasswordResetRepositoryMock()
+	loginAttemptRepo := repository.NewLoginAttemptRepositoryMemory()
+	shopRepo := repository.NewShopRepositoryMock()
+	apiKeyRepo := repository.NewApiKeyRepositoryMock()
+	mfaRepo := repository.NewMfaRepositoryMock()
+	logMailer := mailer.NewLogMailer(zapLogger, "no-reply@test.local", "http://localhost:3000", "")
+	userService := NewUserService(zapLogger, nil, keySet, logMailer, userRepo, refreshTokenRepo, emailVerifyRepo, pwResetRepo, loginAttemptRepo, shopRepo, apiKeyRepo, mfaRepo)
 	assert.NotEmpty(userRepo)
 	assert.NotEmpty(userService)
-	return assert, userService
+	return assert, userService, logMailer, loginAttemptRepo
+}
+
+// sentMailToken: token of latest mail sent to email
+func sentMailToken(assert *assert.Assertions, logMailer *mailer.LogMailer, email string) string {
+	msg, ok := logMailer.LastSentTo(email)
+	assert.True(ok)
+	assert.NotEmpty(msg)
+	found := regexp.MustCompile(`token=([A-Za-z0-9_-]+)`).FindStringSubmatch(msg.Body)
+	assert.Len(found, 2)
+	return found[1]
+}
+
+// newTestKeySet
+func newTestKeySet(assert *assert.Assertions) *authentication.KeySet {
+	key, err := authentication.NewHmacKey("test", []byte("test_value"))
+	assert.NotEmpty(key)
+	assert.NoError(err)
+	keySet, err := authentication.NewKeySet(key)
+	assert.NotEmpty(keySet)
+	assert.NoError(err)
+	return keySet
 }
 
 // ****Test_Signup
@@ -164,12 +212,139 @@ func Test_Login(t *testing.T) {
 	}
 }
 
+// ****Test_LoginBackoff
+func Test_LoginBackoff(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc := userServiceTestSetup(ctx, t)
+
+	// pre signup user
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NotEmpty(validUser)
+	assert.NoError(err)
+	wrongLoginDto := dto.NewLoginDto(validCreateUserDto.Email, utils.PtrOf("wrongpassword"))
+
+	// failures below threshold are not delayed
+	for i := 0; i < constants.LoginBackoffThreshold-1; i++ {
+		pp, err := userSvc.Login(ctx, wrongLoginDto)
+		assert.Empty(pp)
+		assert.ErrorIs(err, constants.ErrBadRequest)
+	}
+
+	// failure at threshold starts backoff, even with correct password
+	pp, err := userSvc.Login(ctx, wrongLoginDto)
+	assert.Empty(pp)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	pp, err = userSvc.Login(ctx, dto.NewLoginDto(validCreateUserDto.Email, validCreateUserDto.Password))
+	assert.Empty(pp)
+	assert.ErrorIs(err, constants.ErrTooManyRequest)
+
+	// throttle key ignores email case
+	pp, err = userSvc.Login(ctx, dto.NewLoginDto(utils.PtrOf(strings.ToUpper(*validCreateUserDto.Email)), validCreateUserDto.Password))
+	assert.Empty(pp)
+	assert.ErrorIs(err, constants.ErrTooManyRequest)
+
+	// other account not affected
+	otherCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	_, err = userSvc.Signup(ctx, otherCreateUserDto)
+	assert.NoError(err)
+	pp, err = userSvc.Login(ctx, dto.NewLoginDto(otherCreateUserDto.Email, otherCreateUserDto.Password))
+	assert.NotEmpty(pp)
+	assert.NoError(err)
+}
+
+// ****Test_LoginLockout
+func Test_LoginLockout(t *testing.T) {
+	ctx := context.WithValue(context.TODO(), constants.ClientIpKey, "203.0.113.7")
+	assert, userSvc, _, loginAttemptRepo := userServiceTestSetupWithLoginAttempt(ctx, t)
+
+	// pre signup user
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NotEmpty(validUser)
+	assert.NoError(err)
+
+	// seed failures just below lockout, old enough to be past backoff
+	key := authentication.LoginThrottleKey(constants.LoginThrottleScope.Account, validUser.Email)
+	failedAt := time.Now().Add(-constants.LoginBackoffMax - time.Minute)
+	for i := 0; i < constants.LoginAccountLockoutThreshold-1; i++ {
+		_, err = loginAttemptRepo.AddLoginFailure(ctx, nil, key, failedAt)
+		assert.NoError(err)
+	}
+
+	// no lockout event yet
+	events, err := userSvc.GetLoginLockouts(ctx, validUser.ID.String())
+	assert.NoError(err)
+	assert.Len(events, 0)
+
+	// failure at threshold locks account
+	pp, err := userSvc.Login(ctx, dto.NewLoginDto(validCreateUserDto.Email, utils.PtrOf("wrongpassword")))
+	assert.Empty(pp)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	pp, err = userSvc.Login(ctx, dto.NewLoginDto(validCreateUserDto.Email, validCreateUserDto.Password))
+	assert.Empty(pp)
+	assert.ErrorIs(err, constants.ErrTooManyRequest)
+
+	// lockout event recorded for user
+	events, err = userSvc.GetLoginLockouts(ctx, validUser.ID.String())
+	assert.NoError(err)
+	assert.Len(events, 1)
+	assert.Equal(constants.LoginThrottleScope.Account, events[0].Scope)
+	assert.Equal("203.0.113.7", events[0].IP)
+	assert.Equal(constants.LoginAccountLockoutThreshold, events[0].Failures)
+	assert.True(events[0].LockedUntil.After(time.Now()))
+
+	// invalid userId
+	events, err = userSvc.GetLoginLockouts(ctx, "invailduserid")
+	assert.Empty(events)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+}
+
+// ****Test_LoginFailureWindow
+func Test_LoginFailureWindow(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc, _, loginAttemptRepo := userServiceTestSetupWithLoginAttempt(ctx, t)
+
+	// pre signup user
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NotEmpty(validUser)
+	assert.NoError(err)
+
+	// seed failures at lockout threshold outside window
+	key := authentication.LoginThrottleKey(constants.LoginThrottleScope.Account, validUser.Email)
+	failedAt := time.Now().Add(-constants.LoginFailureWindow - time.Minute)
+	for i := 0; i < constants.LoginAccountLockoutThreshold-1; i++ {
+		_, err = loginAttemptRepo.AddLoginFailure(ctx, nil, key, failedAt)
+		assert.NoError(err)
+	}
+
+	// stale failures start over instead of locking
+	pp, err := userSvc.Login(ctx, dto.NewLoginDto(validCreateUserDto.Email, utils.PtrOf("wrongpassword")))
+	assert.Empty(pp)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	found, err := loginAttemptRepo.GetLoginThrottle(ctx, nil, key)
+	assert.NoError(err)
+	assert.Equal(1, found.Failures)
+	assert.Nil(found.LockedUntil)
+
+	// success resets counter
+	pp, err = userSvc.Login(ctx, dto.NewLoginDto(validCreateUserDto.Email, validCreateUserDto.Password))
+	assert.NotEmpty(pp)
+	assert.NoError(err)
+	_, err = loginAttemptRepo.GetLoginThrottle(ctx, nil, key)
+	assert.ErrorIs(err, constants.ErrNotFound)
+}
+
 // ****Test_RefreshAccessToken
 type refreshAccessTokenCase struct {
-	name   string
-	userId string
-	input  *dto.RefreshAccessTokenDto
-	exec   func(*authentication.Passport, error)
+	name  string
+	input *dto.RefreshAccessTokenDto
+	exec  func(*authentication.Passport, error)
 }
 
 func Test_RefreshAccessToken(t *testing.T) {
@@ -189,40 +364,376 @@ func Test_RefreshAccessToken(t *testing.T) {
 
 	testCases := []refreshAccessTokenCase{
 		{
-			name:   "with valid token",
-			userId: validUser.ID.String(),
-			input:  dto.NewRefreshAccessTokenDto(&validPp.RefreshToken),
+			name:  "with valid token",
+			input: dto.NewRefreshAccessTokenDto(&validPp.RefreshToken),
 			exec: func(result *authentication.Passport, e error) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
+				assert.NotEqual(validPp.RefreshToken, result.RefreshToken)
 			},
 		},
 		{
-			name:   "with invalid token",
-			userId: validUser.ID.String(),
-			input:  dto.NewRefreshAccessTokenDto(utils.PtrOf("qwdwefwef")),
+			name:  "with invalid token",
+			input: dto.NewRefreshAccessTokenDto(utils.PtrOf("qwdwefwef")),
 			exec: func(result *authentication.Passport, e error) {
 				assert.Empty(result)
-				assert.Error(e)
+				assert.ErrorIs(e, constants.ErrBadRequest)
 			},
 		},
 		{
-			name:   "with invalid userId",
-			userId: "invailduserid",
-			input:  dto.NewRefreshAccessTokenDto(&validPp.RefreshToken),
+			name:  "with access token",
+			input: dto.NewRefreshAccessTokenDto(&validPp.AccessToken),
 			exec: func(result *authentication.Passport, e error) {
 				assert.Empty(result)
-				assert.Error(e)
+				assert.ErrorIs(e, constants.ErrBadRequest)
+			},
+		},
+		{
+			name:  "with rotated token",
+			input: dto.NewRefreshAccessTokenDto(&validPp.RefreshToken),
+			exec: func(result *authentication.Passport, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrUnauthorized)
+			},
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			test.exec(userSvc.RefreshAccessToken(ctx, test.input))
+		})
+	}
+}
+
+// ****Test_RefreshTokenReuse
+func Test_RefreshTokenReuse(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc := userServiceTestSetup(ctx, t)
+
+	// pre signup a user and login user
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NotEmpty(validUser)
+	assert.NoError(err)
+	loginPp, err := userSvc.Login(ctx,
+		dto.NewLoginDto(utils.PtrOf(*validCreateUserDto.Email), utils.PtrOf(*validCreateUserDto.Password)))
+	assert.NotEmpty(loginPp)
+	assert.NoError(err)
+
+	// another session of same user, other family
+	otherPp, err := userSvc.Login(ctx,
+		dto.NewLoginDto(utils.PtrOf(*validCreateUserDto.Email), utils.PtrOf(*validCreateUserDto.Password)))
+	assert.NotEmpty(otherPp)
+	assert.NoError(err)
+
+	// legit rotation
+	rotatedPp, err := userSvc.RefreshAccessToken(ctx, dto.NewRefreshAccessTokenDto(&loginPp.RefreshToken))
+	assert.NotEmpty(rotatedPp)
+	assert.NoError(err)
+
+	// replay old token, family revoked
+	result, err := userSvc.RefreshAccessToken(ctx, dto.NewRefreshAccessTokenDto(&loginPp.RefreshToken))
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrUnauthorized)
+
+	// latest token of revoked family rejected
+	result, err = userSvc.RefreshAccessToken(ctx, dto.NewRefreshAccessTokenDto(&rotatedPp.RefreshToken))
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrUnauthorized)
+
+	// other family untouched
+	result, err = userSvc.RefreshAccessToken(ctx, dto.NewRefreshAccessTokenDto(&otherPp.RefreshToken))
+	assert.NotEmpty(result)
+	assert.NoError(err)
+}
+
+// ****Test_Logout
+func Test_Logout(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc := userServiceTestSetup(ctx, t)
+
+	// pre signup a user and login user twice
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NotEmpty(validUser)
+	assert.NoError(err)
+	loginDto := dto.NewLoginDto(utils.PtrOf(*validCreateUserDto.Email), utils.PtrOf(*validCreateUserDto.Password))
+	pp1, err := userSvc.Login(ctx, loginDto)
+	assert.NoError(err)
+	pp2, err := userSvc.Login(ctx, loginDto)
+	assert.NoError(err)
+	pp3, err := userSvc.Login(ctx, loginDto)
+	assert.NoError(err)
+
+	// other user cannot logout session
+	ok, err := userSvc.Logout(ctx, uuid.NewString(), dto.NewLogoutDto(&pp1.RefreshToken))
+	assert.False(ok)
+	assert.ErrorIs(err, constants.ErrUnauthorized)
+
+	// logout current session only
+	ok, err = userSvc.Logout(ctx, validUser.ID.String(), dto.NewLogoutDto(&pp1.RefreshToken))
+	assert.True(ok)
+	assert.NoError(err)
+	result, err := userSvc.RefreshAccessToken(ctx, dto.NewRefreshAccessTokenDto(&pp1.RefreshToken))
+	assert.Empty(result)
+	assert.Error(err)
+	result, err = userSvc.RefreshAccessToken(ctx, dto.NewRefreshAccessTokenDto(&pp2.RefreshToken))
+	assert.NotEmpty(result)
+	assert.NoError(err)
+
+	// logout all sessions
+	ok, err = userSvc.LogoutAll(ctx, validUser.ID.String())
+	assert.True(ok)
+	assert.NoError(err)
+	result, err = userSvc.RefreshAccessToken(ctx, dto.NewRefreshAccessTokenDto(&pp3.RefreshToken))
+	assert.Empty(result)
+	assert.Error(err)
+}
+
+// ****Test_VerifyEmail
+type verifyEmailTestCase struct {
+	name  string
+	input *dto.VerifyEmailDto
+	exec  func(bool, error)
+}
+
+func Test_VerifyEmail(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc, logMailer := userServiceTestSetupWithMailer(ctx, t)
+
+	// pre signup user, verification mail sent
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NotEmpty(validUser)
+	assert.NoError(err)
+	assert.False(validUser.EmailVerified)
+	validToken := sentMailToken(assert, logMailer, validUser.Email)
+
+	testCases := []verifyEmailTestCase{
+		{
+			name:  "with empty token",
+			input: dto.NewVerifyEmailDto(utils.PtrOf("")),
+			exec: func(result bool, e error) {
+				assert.False(result)
+				assert.ErrorIs(e, constants.ErrBadRequest)
+			},
+		},
+		{
+			name:  "with unknown token",
+			input: dto.NewVerifyEmailDto(utils.PtrOf("qwdwefwef")),
+			exec: func(result bool, e error) {
+				assert.False(result)
+				assert.ErrorIs(e, constants.ErrBadRequest)
+			},
+		},
+		{
+			name:  "with valid token",
+			input: dto.NewVerifyEmailDto(&validToken),
+			exec: func(result bool, e error) {
+				assert.True(result)
+				assert.NoError(e)
+				found, err := userSvc.GetUserById(ctx, validUser.ID.String())
+				assert.NoError(err)
+				assert.True(found.EmailVerified)
+			},
+		},
+		{
+			name:  "with used token",
+			input: dto.NewVerifyEmailDto(&validToken),
+			exec: func(result bool, e error) {
+				assert.False(result)
+				assert.ErrorIs(e, constants.ErrBadRequest)
+			},
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			test.exec(userSvc.VerifyEmail(ctx, test.input))
+		})
+	}
+}
+
+// ****Test_ResendVerificationEmail
+func Test_ResendVerificationEmail(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc, logMailer := userServiceTestSetupWithMailer(ctx, t)
+
+	// pre signup user
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NotEmpty(validUser)
+	assert.NoError(err)
+	assert.Len(logMailer.Sent(), 1)
+
+	// throttled within resend interval
+	ok, err := userSvc.ResendVerificationEmail(ctx, validUser.ID.String())
+	assert.False(ok)
+	assert.ErrorIs(err, constants.ErrTooManyRequest)
+	assert.Len(logMailer.Sent(), 1)
+
+	// invalid userId
+	ok, err = userSvc.ResendVerificationEmail(ctx, "invailduserid")
+	assert.False(ok)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// already verified
+	validToken := sentMailToken(assert, logMailer, validUser.Email)
+	ok, err = userSvc.VerifyEmail(ctx, dto.NewVerifyEmailDto(&validToken))
+	assert.True(ok)
+	assert.NoError(err)
+	ok, err = userSvc.ResendVerificationEmail(ctx, validUser.ID.String())
+	assert.False(ok)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+}
+
+// ****Test_ForgotPassword
+type forgotPasswordTestCase struct {
+	name  string
+	input *dto.ForgotPasswordDto
+	exec  func(bool, error)
+}
+
+func Test_ForgotPassword(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc, logMailer := userServiceTestSetupWithMailer(ctx, t)
+
+	// pre signup user
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NotEmpty(validUser)
+	assert.NoError(err)
+	sentAfterSignup := len(logMailer.Sent())
+
+	testCases := []forgotPasswordTestCase{
+		{
+			name:  "with invalid email",
+			input: dto.NewForgotPasswordDto(utils.PtrOf("notanemail")),
+			exec: func(result bool, e error) {
+				assert.False(result)
+				assert.ErrorIs(e, constants.ErrBadRequest)
+			},
+		},
+		{
+			name:  "with unregistered email, same result",
+			input: dto.NewForgotPasswordDto(utils.PtrOf(gofakeit.Email())),
+			exec: func(result bool, e error) {
+				assert.True(result)
+				assert.NoError(e)
+				assert.Len(logMailer.Sent(), sentAfterSignup)
+			},
+		},
+		{
+			name:  "with registered email",
+			input: dto.NewForgotPasswordDto(&validUser.Email),
+			exec: func(result bool, e error) {
+				assert.True(result)
+				assert.NoError(e)
+				assert.Len(logMailer.Sent(), sentAfterSignup+1)
+			},
+		},
+		{
+			name:  "with registered email, throttled silently",
+			input: dto.NewForgotPasswordDto(&validUser.Email),
+			exec: func(result bool, e error) {
+				assert.True(result)
+				assert.NoError(e)
+				assert.Len(logMailer.Sent(), sentAfterSignup+1)
 			},
 		},
 	}
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			test.exec(userSvc.RefreshAccessToken(ctx, test.userId, test.input))
+			test.exec(userSvc.ForgotPassword(ctx, test.input))
 		})
 	}
 }
 
+// ****Test_ResetPassword
+func Test_ResetPassword(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc, logMailer := userServiceTestSetupWithMailer(ctx, t)
+
+	// pre signup and login user
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NotEmpty(validUser)
+	assert.NoError(err)
+	validPp, err := userSvc.Login(ctx, dto.NewLoginDto(validCreateUserDto.Email, validCreateUserDto.Password))
+	assert.NotEmpty(validPp)
+	assert.NoError(err)
+
+	// forgot
+	ok, err := userSvc.ForgotPassword(ctx, dto.NewForgotPasswordDto(&validUser.Email))
+	assert.True(ok)
+	assert.NoError(err)
+	resetToken := sentMailToken(assert, logMailer, validUser.Email)
+	newPw := gofakeit.Password(true, true, true, true, false, 8)
+
+	// invalid token
+	ok, err = userSvc.ResetPassword(ctx, dto.NewResetPasswordDto(utils.PtrOf("qwdwefwef"), &newPw))
+	assert.False(ok)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// invalid new password
+	ok, err = userSvc.ResetPassword(ctx, dto.NewResetPasswordDto(&resetToken, utils.PtrOf("123")))
+	assert.False(ok)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// valid reset
+	ok, err = userSvc.ResetPassword(ctx, dto.NewResetPasswordDto(&resetToken, &newPw))
+	assert.True(ok)
+	assert.NoError(err)
+
+	// sessions revoked
+	result, err := userSvc.RefreshAccessToken(ctx, dto.NewRefreshAccessTokenDto(&validPp.RefreshToken))
+	assert.Empty(result)
+	assert.Error(err)
+
+	// old password rejected, new password accepted
+	_, err = userSvc.Login(ctx, dto.NewLoginDto(validCreateUserDto.Email, validCreateUserDto.Password))
+	assert.Error(err)
+	_, err = userSvc.Login(ctx, dto.NewLoginDto(validCreateUserDto.Email, &newPw))
+	assert.NoError(err)
+
+	// token single use
+	ok, err = userSvc.ResetPassword(ctx, dto.NewResetPasswordDto(&resetToken, &newPw))
+	assert.False(ok)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+}
+
+// ****Test_ResetTokenInvalidatedByPasswordChange
+func Test_ResetTokenInvalidatedByPasswordChange(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc, logMailer := userServiceTestSetupWithMailer(ctx, t)
+
+	// pre signup user and request reset
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NotEmpty(validUser)
+	assert.NoError(err)
+	ok, err := userSvc.ForgotPassword(ctx, dto.NewForgotPasswordDto(&validUser.Email))
+	assert.True(ok)
+	assert.NoError(err)
+	resetToken := sentMailToken(assert, logMailer, validUser.Email)
+
+	// change password with current password
+	newPw := gofakeit.Password(true, true, true, true, false, 8)
+	_, err = userSvc.UpdateUserPasswordById(ctx, validUser.ID.String(),
+		dto.NewUpdateUserPasswordDto(validCreateUserDto.Password, &newPw))
+	assert.NoError(err)
+
+	// outstanding reset token rejected
+	ok, err = userSvc.ResetPassword(ctx, dto.NewResetPasswordDto(&resetToken, utils.PtrOf(gofakeit.Password(true, true, true, true, false, 8))))
+	assert.False(ok)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+}
+
 // ****Test_GetUserById
 type getUserByIdTestCase struct {
 	name  string
@@ -274,6 +785,31 @@ func Test_GetUserById(t *testing.T) {
 	}
 }
 
+// ****Test_UpdateUserPlanById
+func Test_UpdateUserPlanById(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc := userServiceTestSetup(ctx, t)
+
+	// pre signup user on free plan
+	validUser, err := userSvc.Signup(ctx, dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6))))
+	assert.NoError(err)
+	assert.Equal(constants.Plan.Free, validUser.Plan)
+
+	// operator assigns pro
+	result, err := userSvc.UpdateUserPlanById(ctx, validUser.ID.String(), dto.NewUpdateUserPlanDto(utils.PtrOf(constants.Plan.Pro)))
+	assert.NoError(err)
+	assert.Equal(constants.Plan.Pro, result.Plan)
+
+	// unknown plan or user
+	result, err = userSvc.UpdateUserPlanById(ctx, validUser.ID.String(), dto.NewUpdateUserPlanDto(utils.PtrOf("gold")))
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	result, err = userSvc.UpdateUserPlanById(ctx, uuid.NewString(), dto.NewUpdateUserPlanDto(utils.PtrOf(constants.Plan.Free)))
+	assert.Empty(result)
+	assert.ErrorIs(err, constants.ErrNotFound)
+}
+
 // ****Test_UpdateUserPasswordById
 type updateUserPasswordByIdTestCase struct {
 	name   string
@@ -361,7 +897,7 @@ func Test_UpdateUserPasswordById(t *testing.T) {
 type authenticateTestCase struct {
 	name  string
 	input string
-	exec  func(string, error)
+	exec  func(*authentication.AccessTokenInfo, error)
 }
 
 func Test_Authenticate(t *testing.T) {
@@ -383,7 +919,7 @@ func Test_Authenticate(t *testing.T) {
 		{
 			name:  "valid tokenString",
 			input: validPp.AccessToken,
-			exec: func(result string, e error) {
+			exec: func(result *authentication.AccessTokenInfo, e error) {
 				assert.NotEmpty(result)
 				assert.NoError(e)
 			},
@@ -391,7 +927,7 @@ func Test_Authenticate(t *testing.T) {
 		{
 			name:  "empty tokenString",
 			input: "",
-			exec: func(result string, e error) {
+			exec: func(result *authentication.AccessTokenInfo, e error) {
 				assert.Empty(result)
 				assert.Error(e)
 			},
@@ -399,7 +935,7 @@ func Test_Authenticate(t *testing.T) {
 		{
 			name:  "invalid tokenString",
 			input: "qwdqwd",
-			exec: func(result string, e error) {
+			exec: func(result *authentication.AccessTokenInfo, e error) {
 				assert.Empty(result)
 				assert.Error(e)
 			},
@@ -412,6 +948,353 @@ func Test_Authenticate(t *testing.T) {
 	}
 }
 
+// ****Test_CreateApiKey
+type createApiKeyTestCase struct {
+	name   string
+	userId string
+	input  *dto.CreateApiKeyDto
+	exec   func(*dto.CreateApiKeyResponseDto, error)
+}
+
+func Test_CreateApiKey(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc := userServiceTestSetup(ctx, t)
+	validUser, shopCtx := preSignupShopUser(ctx, assert, userSvc)
+	validScopes := []string{constants.ApiKeyScope.ProductsWrite, constants.ApiKeyScope.OrdersRead}
+
+	testCases := []createApiKeyTestCase{
+		{
+			name:   "valid param, bound to active shop",
+			userId: validUser.ID.String(),
+			input:  dto.NewCreateApiKeyDto(utils.PtrOf("erp"), validScopes, utils.PtrOf(30)),
+			exec: func(result *dto.CreateApiKeyResponseDto, e error) {
+				assert.NoError(e)
+				assert.NotEmpty(result.Key)
+				assert.True(authentication.IsApiKeyFormat(result.Key))
+				assert.Contains(result.Key, result.ApiKey.Prefix)
+				assert.Equal(authentication.HashOpaqueToken(result.Key), result.ApiKey.KeyHash)
+				assert.NotNil(result.ApiKey.ShopID)
+				assert.Equal(validScopes, result.ApiKey.Scopes)
+				assert.WithinDuration(time.Now().Add(30*24*time.Hour), result.ApiKey.ExpiresAt, time.Minute)
+			},
+		},
+		{
+			name:   "invalid param, unknown scope",
+			userId: validUser.ID.String(),
+			input:  dto.NewCreateApiKeyDto(utils.PtrOf("erp"), []string{"users:write"}, nil),
+			exec: func(result *dto.CreateApiKeyResponseDto, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrBadRequest)
+			},
+		},
+		{
+			name:   "invalid param, empty scopes",
+			userId: validUser.ID.String(),
+			input:  dto.NewCreateApiKeyDto(utils.PtrOf("erp"), []string{}, nil),
+			exec: func(result *dto.CreateApiKeyResponseDto, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrBadRequest)
+			},
+		},
+		{
+			name:   "invalid param, expiry out of range",
+			userId: validUser.ID.String(),
+			input:  dto.NewCreateApiKeyDto(utils.PtrOf("erp"), validScopes, utils.PtrOf(constants.ApiKeyMaxDurationDays+1)),
+			exec: func(result *dto.CreateApiKeyResponseDto, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrBadRequest)
+			},
+		},
+		{
+			name:   "invalid param, userId",
+			userId: "qwe",
+			input:  dto.NewCreateApiKeyDto(utils.PtrOf("erp"), validScopes, nil),
+			exec: func(result *dto.CreateApiKeyResponseDto, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrBadRequest)
+			},
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			test.exec(userSvc.CreateApiKey(shopCtx, test.userId, test.input))
+		})
+	}
+}
+
+// Test_ApiKeyLimit
+func Test_ApiKeyLimit(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc := userServiceTestSetup(ctx, t)
+	validUser, shopCtx := preSignupShopUser(ctx, assert, userSvc)
+	payload := dto.NewCreateApiKeyDto(utils.PtrOf("erp"), []string{constants.ApiKeyScope.OrdersRead}, nil)
+
+	var last *dto.CreateApiKeyResponseDto
+	for i := 0; i < constants.MaxApiKeys; i++ {
+		rs, err := userSvc.CreateApiKey(shopCtx, validUser.ID.String(), payload)
+		assert.NoError(err)
+		last = rs
+	}
+	_, err := userSvc.CreateApiKey(shopCtx, validUser.ID.String(), payload)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// revoked key frees a slot
+	_, err = userSvc.RevokeApiKey(ctx, validUser.ID.String(), last.ApiKey.ID.String())
+	assert.NoError(err)
+	_, err = userSvc.CreateApiKey(shopCtx, validUser.ID.String(), payload)
+	assert.NoError(err)
+}
+
+// ****Test_AuthenticateApiKey
+type authenticateApiKeyTestCase struct {
+	name  string
+	input string
+	exec  func(*authentication.AccessTokenInfo, error)
+}
+
+func Test_AuthenticateApiKey(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc := userServiceTestSetup(ctx, t)
+	validUser, shopCtx := preSignupShopUser(ctx, assert, userSvc)
+	otherUser, _ := preSignupShopUser(ctx, assert, userSvc)
+	scopes := []string{constants.ApiKeyScope.OrdersRead}
+
+	valid, err := userSvc.CreateApiKey(shopCtx, validUser.ID.String(), dto.NewCreateApiKeyDto(utils.PtrOf("valid"), scopes, nil))
+	assert.NoError(err)
+	revoked, err := userSvc.CreateApiKey(shopCtx, validUser.ID.String(), dto.NewCreateApiKeyDto(utils.PtrOf("revoked"), scopes, nil))
+	assert.NoError(err)
+	// other user cannot revoke the key
+	_, err = userSvc.RevokeApiKey(ctx, otherUser.ID.String(), revoked.ApiKey.ID.String())
+	assert.ErrorIs(err, constants.ErrNotFound)
+	_, err = userSvc.RevokeApiKey(ctx, validUser.ID.String(), revoked.ApiKey.ID.String())
+	assert.NoError(err)
+	_, err = userSvc.RevokeApiKey(ctx, validUser.ID.String(), revoked.ApiKey.ID.String())
+	assert.ErrorIs(err, constants.ErrNotFound)
+
+	testCases := []authenticateApiKeyTestCase{
+		{
+			name:  "valid key",
+			input: valid.Key,
+			exec: func(result *authentication.AccessTokenInfo, e error) {
+				assert.NoError(e)
+				assert.Equal(validUser.ID.String(), result.UserId)
+				assert.Equal(valid.ApiKey.ShopID.String(), result.ShopId)
+				assert.Equal(scopes, result.Scopes)
+			},
+		},
+		{
+			name:  "revoked key",
+			input: revoked.Key,
+			exec: func(result *authentication.AccessTokenInfo, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrUnauthorized)
+			},
+		},
+		{
+			name:  "unknown key",
+			input: valid.Key + "x",
+			exec: func(result *authentication.AccessTokenInfo, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrUnauthorized)
+			},
+		},
+		{
+			name:  "invalid format",
+			input: "qwdqwd",
+			exec: func(result *authentication.AccessTokenInfo, e error) {
+				assert.Empty(result)
+				assert.ErrorIs(e, constants.ErrUnauthorized)
+			},
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			test.exec(userSvc.AuthenticateApiKey(ctx, test.input))
+		})
+	}
+
+	// last used recorded
+	keys, err := userSvc.GetApiKeys(ctx, validUser.ID.String())
+	assert.NoError(err)
+	assert.Len(keys, 2)
+	for _, k := range keys {
+		if k.ID == valid.ApiKey.ID {
+			assert.NotNil(k.LastUsedAt)
+		}
+	}
+}
+
+// totpCodeAt: code of secret at step offset from now
+func totpCodeAt(assert *assert.Assertions, secret string, offset int64) string {
+	code, err := authentication.TotpCode(secret, authentication.TotpStep(time.Now())+offset)
+	assert.NoError(err)
+	return code
+}
+
+// preEnableTotp: enroll and confirm totp, return secret and recovery codes
+func preEnableTotp(ctx context.Context, assert *assert.Assertions, userSvc IUserService, userId string) (string, []string) {
+	enrolment, err := userSvc.EnrollTotp(ctx, userId)
+	assert.NoError(err)
+	assert.Contains(enrolment.Uri, enrolment.Secret)
+	codes, err := userSvc.ConfirmTotp(ctx, userId, dto.NewConfirmTotpDto(utils.PtrOf(totpCodeAt(assert, enrolment.Secret, -1))))
+	assert.NoError(err)
+	assert.Len(codes.RecoveryCodes, constants.MfaRecoveryCodeCount)
+	return enrolment.Secret, codes.RecoveryCodes
+}
+
+// Test_EnrollConfirmTotp
+func Test_EnrollConfirmTotp(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc := userServiceTestSetup(ctx, t)
+	validUser, _ := preSignupShopUser(ctx, assert, userSvc)
+	userId := validUser.ID.String()
+
+	// wrong code keeps enrolment pending
+	enrolment, err := userSvc.EnrollTotp(ctx, userId)
+	assert.NoError(err)
+	assert.True(strings.HasPrefix(enrolment.Uri, "otpauth://totp/"))
+	_, err = userSvc.ConfirmTotp(ctx, userId, dto.NewConfirmTotpDto(utils.PtrOf(totpCodeAt(assert, enrolment.Secret, 5))))
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	_, err = userSvc.ConfirmTotp(ctx, userId, dto.NewConfirmTotpDto(utils.PtrOf("abc")))
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// re-enroll replaces pending secret
+	reEnrolment, err := userSvc.EnrollTotp(ctx, userId)
+	assert.NoError(err)
+	assert.NotEqual(enrolment.Secret, reEnrolment.Secret)
+	_, err = userSvc.ConfirmTotp(ctx, userId, dto.NewConfirmTotpDto(utils.PtrOf(totpCodeAt(assert, reEnrolment.Secret, 0))))
+	assert.NoError(err)
+
+	// enabled totp cannot be enrolled or confirmed again
+	_, err = userSvc.EnrollTotp(ctx, userId)
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	_, err = userSvc.ConfirmTotp(ctx, userId, dto.NewConfirmTotpDto(utils.PtrOf(totpCodeAt(assert, reEnrolment.Secret, 1))))
+	assert.ErrorIs(err, constants.ErrBadRequest)
+}
+
+// Test_MfaLogin
+func Test_MfaLogin(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc := userServiceTestSetup(ctx, t)
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NoError(err)
+	loginDto := dto.NewLoginDto(validCreateUserDto.Email, validCreateUserDto.Password)
+	secret, recoveryCodes := preEnableTotp(ctx, assert, userSvc, validUser.ID.String())
+
+	// password only gives mfa pending token, not usable as access token
+	pending, err := userSvc.Login(ctx, loginDto)
+	assert.NoError(err)
+	assert.NotEmpty(pending.MfaToken)
+	assert.Empty(pending.AccessToken)
+	assert.Empty(pending.RefreshToken)
+	_, err = userSvc.Authenticate(ctx, pending.MfaToken)
+	assert.Error(err)
+
+	// code already used on confirm is rejected, fresh step passes once
+	_, err = userSvc.VerifyMfaLogin(ctx, dto.NewVerifyMfaLoginDto(&pending.MfaToken, utils.PtrOf(totpCodeAt(assert, secret, -1))))
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	code := totpCodeAt(assert, secret, 0)
+	pp, err := userSvc.VerifyMfaLogin(ctx, dto.NewVerifyMfaLoginDto(&pending.MfaToken, &code))
+	assert.NoError(err)
+	assert.NotEmpty(pp.AccessToken)
+	assert.NotEmpty(pp.RefreshToken)
+	info, err := userSvc.Authenticate(ctx, pp.AccessToken)
+	assert.NoError(err)
+	assert.NotEmpty(info.ShopId)
+	_, err = userSvc.VerifyMfaLogin(ctx, dto.NewVerifyMfaLoginDto(&pending.MfaToken, &code))
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// recovery code is single use
+	_, err = userSvc.VerifyMfaLogin(ctx, dto.NewVerifyMfaLoginDto(&pending.MfaToken, utils.PtrOf(recoveryCodes[0])))
+	assert.NoError(err)
+	_, err = userSvc.VerifyMfaLogin(ctx, dto.NewVerifyMfaLoginDto(&pending.MfaToken, utils.PtrOf(recoveryCodes[0])))
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// access token cannot replace mfa token
+	_, err = userSvc.VerifyMfaLogin(ctx, dto.NewVerifyMfaLoginDto(&pp.AccessToken, utils.PtrOf(recoveryCodes[1])))
+	assert.ErrorIs(err, constants.ErrBadRequest)
+}
+
+// Test_MfaLoginThrottle
+func Test_MfaLoginThrottle(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc := userServiceTestSetup(ctx, t)
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NoError(err)
+	secret, _ := preEnableTotp(ctx, assert, userSvc, validUser.ID.String())
+	pending, err := userSvc.Login(ctx, dto.NewLoginDto(validCreateUserDto.Email, validCreateUserDto.Password))
+	assert.NoError(err)
+
+	// wrong codes count toward login backoff
+	for i := 0; i < constants.LoginBackoffThreshold; i++ {
+		_, err = userSvc.VerifyMfaLogin(ctx, dto.NewVerifyMfaLoginDto(&pending.MfaToken, utils.PtrOf("zzzzz-zzzzz")))
+		assert.ErrorIs(err, constants.ErrBadRequest)
+	}
+	_, err = userSvc.VerifyMfaLogin(ctx, dto.NewVerifyMfaLoginDto(&pending.MfaToken, utils.PtrOf(totpCodeAt(assert, secret, 0))))
+	assert.ErrorIs(err, constants.ErrTooManyRequest)
+}
+
+// Test_DisableTotp
+func Test_DisableTotp(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc := userServiceTestSetup(ctx, t)
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NoError(err)
+	userId := validUser.ID.String()
+	secret, recoveryCodes := preEnableTotp(ctx, assert, userSvc, userId)
+
+	// needs both password and second factor
+	_, err = userSvc.DisableTotp(ctx, userId, dto.NewDisableTotpDto(utils.PtrOf("wrongpw"), utils.PtrOf(totpCodeAt(assert, secret, 0))))
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	_, err = userSvc.DisableTotp(ctx, userId, dto.NewDisableTotpDto(validCreateUserDto.Password, utils.PtrOf("000000")))
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	ok, err := userSvc.DisableTotp(ctx, userId, dto.NewDisableTotpDto(validCreateUserDto.Password, utils.PtrOf(recoveryCodes[0])))
+	assert.NoError(err)
+	assert.True(ok)
+	_, err = userSvc.DisableTotp(ctx, userId, dto.NewDisableTotpDto(validCreateUserDto.Password, utils.PtrOf(recoveryCodes[1])))
+	assert.ErrorIs(err, constants.ErrBadRequest)
+
+	// password login issues passport again
+	pp, err := userSvc.Login(ctx, dto.NewLoginDto(validCreateUserDto.Email, validCreateUserDto.Password))
+	assert.NoError(err)
+	assert.NotEmpty(pp.AccessToken)
+	assert.Empty(pp.MfaToken)
+}
+
+// Test_DisableTotpThrottle
+func Test_DisableTotpThrottle(t *testing.T) {
+	ctx := context.TODO()
+	assert, userSvc := userServiceTestSetup(ctx, t)
+	validCreateUserDto := dto.NewCreateUserDto(
+		utils.PtrOf(gofakeit.Email()), utils.PtrOf(gofakeit.Password(true, true, true, true, false, 6)))
+	validUser, err := userSvc.Signup(ctx, validCreateUserDto)
+	assert.NoError(err)
+	userId := validUser.ID.String()
+	secret, recoveryCodes := preEnableTotp(ctx, assert, userSvc, userId)
+
+	// wrong passwords and wrong codes count toward account backoff
+	_, err = userSvc.DisableTotp(ctx, userId, dto.NewDisableTotpDto(utils.PtrOf("wrongpw"), utils.PtrOf(totpCodeAt(assert, secret, 0))))
+	assert.ErrorIs(err, constants.ErrBadRequest)
+	for i := 1; i < constants.LoginBackoffThreshold; i++ {
+		_, err = userSvc.DisableTotp(ctx, userId, dto.NewDisableTotpDto(validCreateUserDto.Password, utils.PtrOf("zzzzz-zzzzz")))
+		assert.ErrorIs(err, constants.ErrBadRequest)
+	}
+	ok, err := userSvc.DisableTotp(ctx, userId, dto.NewDisableTotpDto(validCreateUserDto.Password, utils.PtrOf(recoveryCodes[0])))
+	assert.False(ok)
+	assert.ErrorIs(err, constants.ErrTooManyRequest)
+
+	// same account throttle as login
+	pp, err := userSvc.Login(ctx, dto.NewLoginDto(validCreateUserDto.Email, validCreateUserDto.Password))
+	assert.Empty(pp)
+	assert.ErrorIs(err, constants.ErrTooManyRequest)
+}
+
 // ****Test_GetUsers, for testing
 type getUsersTestCase struct {
 	name  string
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sthl/config"
+	"sthl/constants"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// BlobInfo: metadata of stored object, Public not reported by List of s3 store
+type BlobInfo struct {
+	Key         string    `json:"key"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"contentType"`
+	Public      bool      `json:"public"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// BlobStore: objects by key in one bucket, public objects readable by url returned from Put,
+// private objects only by url from SignedUrl, missing key gives constants.ErrNotFound
+type BlobStore interface {
+	Put(ctx context.Context, key string, body io.Reader, contentType string, public bool) (string, error)
+	Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error)
+	Delete(ctx context.Context, key string) error
+	Stat(ctx context.Context, key string) (*BlobInfo, error)
+	List(ctx context.Context, prefix string) ([]*BlobInfo, error)
+	SignedUrl(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// ServedBlobStore: store served by the api under constants.BlobPath, private objects need signed url
+type ServedBlobStore interface {
+	BlobStore
+	VerifySignedUrl(key string, query url.Values) error
+}
+
+// NewBlobStoreFromConfig: build blob store from config for fx, fail if backend unreachable
+func NewBlobStoreFromConfig(logger *zap.Logger, cfg *config.Config) (BlobStore, error) {
+	switch cfg.GetBlobStore() {
+	case constants.BlobStore.S3:
+		return NewS3BlobStore(logger, cfg)
+	case constants.BlobStore.Local, constants.BlobStore.Memory:
+		secret := []byte(cfg.GetBlobSigningSecret())
+		if len(secret) == 0 {
+			logger.Info("BLOB_SIGNING_SECRET not set, use random secret")
+			secret = make([]byte, 32)
+			_, err := rand.Read(secret)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if cfg.GetBlobStore() == constants.BlobStore.Memory {
+			return NewMemoryBlobStore(cfg.GetBlobPublicUrl(), secret), nil
+		}
+		return NewLocalBlobStore(logger, filepath.Join(cfg.GetBlobLocalDir(), cfg.GetBlobBucket()), cfg.GetBlobPublicUrl(), secret)
+	default:
+		return nil, fmt.Errorf("unsupported blob store: %s", cfg.GetBlobStore())
+	}
+}
+
+// checkBlobKey: relative slash separated key without empty, dot or hidden segments
+func checkBlobKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.HasSuffix(key, "/") {
+		return constants.ErrBadRequest
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || strings.HasPrefix(segment, ".") || strings.Contains(segment, "\\") {
+			return constants.ErrBadRequest
+		}
+	}
+	return nil
+}
+
+// blobUrlSigner: urls of served store, signed url carries expires and hmac of key and expires
+type blobUrlSigner struct {
+	baseUrl string
+	secret  []byte
+	now     func() time.Time
+}
+
+func newBlobUrlSigner(publicUrl string, secret []byte) *blobUrlSigner {
+	return &blobUrlSigner{
+		baseUrl: strings.TrimRight(publicUrl, "/") + constants.BlobPath,
+		secret:  secret,
+		now:     time.Now,
+	}
+}
+
+// Url: public url of key, segments escaped
+func (s *blobUrlSigner) Url(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return s.baseUrl + "/" + strings.Join(segments, "/")
+}
+
+// SignedUrl: url of key valid for ttl
+func (s *blobUrlSigner) SignedUrl(key string, ttl time.Duration) string {
+	expires := strconv.FormatInt(s.now().Add(ttl).Unix(), 10)
+	query := url.Values{
+		"expires":   []string{expires},
+		"signature": []string{s.signature(key, expires)},
+	}
+	return s.Url(key) + "?" + query.Encode()
+}
+
+// Verify: expires in future and signature of key matches
+func (s *blobUrlSigner) Verify(key string, query url.Values) error {
+	expires := query.Get("expires")
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return constants.ErrForbidden
+	}
+	if s.now().Unix() > unix {
+		return constants.ErrForbidden
+	}
+	if !hmac.Equal([]byte(query.Get("signature")), []byte(s.signature(key, expires))) {
+		return constants.ErrForbidden
+	}
+	return nil
+}
+
+func (s *blobUrlSigner) signature(key string, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,249 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sthl/constants"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// blobMetaDir: meta of each object kept under hidden dir, keys cannot have hidden segments
+const blobMetaDir = ".meta"
+
+// localBlobMeta: content type and access of object, file content has neither
+type localBlobMeta struct {
+	ContentType string `json:"contentType"`
+	Public      bool   `json:"public"`
+}
+
+// LocalBlobStore: objects as files under dir, served by the api
+type LocalBlobStore struct {
+	logger *zap.Logger
+	dir    string
+	signer *blobUrlSigner
+}
+
+// NewLocalBlobStore: dir created if missing, fail if not writable,
+// objects served under constants.BlobPath of publicUrl, urls signed by secret
+func NewLocalBlobStore(logger *zap.Logger, dir string, publicUrl string, secret []byte) (*LocalBlobStore, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		logger.Info("fail to os.MkdirAll", zap.String("dir", dir), zap.Error(err))
+		return nil, err
+	}
+	probe, err := os.CreateTemp(dir, ".probe-*")
+	if err != nil {
+		logger.Info("blob dir not writable", zap.String("dir", dir), zap.Error(err))
+		return nil, err
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+	logger.Info("local blob store ready", zap.String("dir", dir))
+	return &LocalBlobStore{
+		logger: logger,
+		dir:    dir,
+		signer: newBlobUrlSigner(publicUrl, secret),
+	}, nil
+}
+
+// Put: written to temp file then renamed, readers never see partial object
+func (l *LocalBlobStore) Put(ctx context.Context, key string, body io.Reader, contentType string, public bool) (string, error) {
+	err := checkBlobKey(key)
+	if err != nil {
+		return "", err
+	}
+	p := l.path(key)
+	err = os.MkdirAll(filepath.Dir(p), 0o755)
+	if err != nil {
+		l.logger.Info("fail to os.MkdirAll", zap.Error(err))
+		return "", err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".tmp-*")
+	if err != nil {
+		l.logger.Info("fail to os.CreateTemp", zap.Error(err))
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = io.Copy(tmp, body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		l.logger.Info("fail to write blob", zap.String("key", key), zap.Error(err))
+		return "", err
+	}
+	err = l.writeMeta(key, localBlobMeta{ContentType: contentType, Public: public})
+	if err != nil {
+		return "", err
+	}
+	err = os.Rename(tmp.Name(), p)
+	if err != nil {
+		l.logger.Info("fail to os.Rename", zap.Error(err))
+		return "", err
+	}
+	return l.signer.Url(key), nil
+}
+
+// Get
+func (l *LocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error) {
+	err := checkBlobKey(key)
+	if err != nil {
+		return nil, nil, constants.ErrNotFound
+	}
+	f, err := os.Open(l.path(key))
+	if err != nil {
+		return nil, nil, l.handleFileErr(err)
+	}
+	info, err := l.info(key, f.Stat)
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	return f, info, nil
+}
+
+// Delete
+func (l *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	err := checkBlobKey(key)
+	if err != nil {
+		return constants.ErrNotFound
+	}
+	err = os.Remove(l.path(key))
+	if err != nil {
+		return l.handleFileErr(err)
+	}
+	os.Remove(l.metaPath(key))
+	return nil
+}
+
+// Stat
+func (l *LocalBlobStore) Stat(ctx context.Context, key string) (*BlobInfo, error) {
+	err := checkBlobKey(key)
+	if err != nil {
+		return nil, constants.ErrNotFound
+	}
+	return l.info(key, func() (fs.FileInfo, error) { return os.Stat(l.path(key)) })
+}
+
+// List: objects with key prefix, ordered by key
+func (l *LocalBlobStore) List(ctx context.Context, prefix string) ([]*BlobInfo, error) {
+	result := []*BlobInfo{}
+	err := filepath.WalkDir(l.dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(d.Name(), ".") && p != l.dir {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(l.dir, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := l.info(key, d.Info)
+		if err != nil {
+			return err
+		}
+		result = append(result, info)
+		return nil
+	})
+	if err != nil {
+		l.logger.Info("fail to filepath.WalkDir", zap.Error(err))
+		return nil, err
+	}
+	return result, nil
+}
+
+// SignedUrl
+func (l *LocalBlobStore) SignedUrl(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	_, err := l.Stat(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	return l.signer.SignedUrl(key, ttl), nil
+}
+
+// VerifySignedUrl
+func (l *LocalBlobStore) VerifySignedUrl(key string, query url.Values) error {
+	return l.signer.Verify(key, query)
+}
+
+func (l *LocalBlobStore) path(key string) string {
+	return filepath.Join(l.dir, filepath.FromSlash(key))
+}
+
+func (l *LocalBlobStore) metaPath(key string) string {
+	return filepath.Join(l.dir, blobMetaDir, filepath.FromSlash(key))
+}
+
+func (l *LocalBlobStore) writeMeta(key string, meta localBlobMeta) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	p := l.metaPath(key)
+	err = os.MkdirAll(filepath.Dir(p), 0o755)
+	if err == nil {
+		err = os.WriteFile(p, data, 0o644)
+	}
+	if err != nil {
+		l.logger.Info("fail to write blob meta", zap.String("key", key), zap.Error(err))
+	}
+	return err
+}
+
+// info: object without readable meta is private octet stream
+func (l *LocalBlobStore) info(key string, stat func() (fs.FileInfo, error)) (*BlobInfo, error) {
+	fi, err := stat()
+	if err != nil {
+		return nil, l.handleFileErr(err)
+	}
+	if fi.IsDir() {
+		return nil, constants.ErrNotFound
+	}
+	meta := localBlobMeta{}
+	data, err := os.ReadFile(l.metaPath(key))
+	if err == nil {
+		err = json.Unmarshal(data, &meta)
+	}
+	if err != nil {
+		l.logger.Info("fail to read blob meta", zap.String("key", key), zap.Error(err))
+		meta = localBlobMeta{}
+	}
+	if meta.ContentType == "" {
+		meta.ContentType = "application/octet-stream"
+	}
+	return &BlobInfo{
+		Key:         key,
+		Size:        fi.Size(),
+		ContentType: meta.ContentType,
+		Public:      meta.Public,
+		UpdatedAt:   fi.ModTime(),
+	}, nil
+}
+
+func (l *LocalBlobStore) handleFileErr(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return constants.ErrNotFound
+	}
+	l.logger.Info("blob file err", zap.Error(err))
+	return err
+}
@@ -0,0 +1,130 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"sort"
+	"sthl/constants"
+	"strings"
+	"sync"
+	"time"
+)
+
+type memoryBlob struct {
+	data        []byte
+	contentType string
+	public      bool
+	updatedAt   time.Time
+}
+
+// MemoryBlobStore: objects kept in process, for tests and single instance dev
+type MemoryBlobStore struct {
+	signer *blobUrlSigner
+	blobs  map[string]memoryBlob
+	mu     sync.Mutex
+}
+
+// NewMemoryBlobStore: objects served under constants.BlobPath of publicUrl, urls signed by secret
+func NewMemoryBlobStore(publicUrl string, secret []byte) *MemoryBlobStore {
+	return &MemoryBlobStore{
+		signer: newBlobUrlSigner(publicUrl, secret),
+		blobs:  map[string]memoryBlob{},
+	}
+}
+
+// Put
+func (m *MemoryBlobStore) Put(ctx context.Context, key string, body io.Reader, contentType string, public bool) (string, error) {
+	err := checkBlobKey(key)
+	if err != nil {
+		return "", err
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return "", err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blobs[key] = memoryBlob{
+		data:        data,
+		contentType: contentType,
+		public:      public,
+		updatedAt:   time.Now(),
+	}
+	return m.signer.Url(key), nil
+}
+
+// Get
+func (m *MemoryBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	blob, ok := m.blobs[key]
+	if !ok {
+		return nil, nil, constants.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(blob.data)), blob.info(key), nil
+}
+
+// Delete
+func (m *MemoryBlobStore) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.blobs[key]
+	if !ok {
+		return constants.ErrNotFound
+	}
+	delete(m.blobs, key)
+	return nil
+}
+
+// Stat
+func (m *MemoryBlobStore) Stat(ctx context.Context, key string) (*BlobInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	blob, ok := m.blobs[key]
+	if !ok {
+		return nil, constants.ErrNotFound
+	}
+	return blob.info(key), nil
+}
+
+// List: objects with key prefix, ordered by key
+func (m *MemoryBlobStore) List(ctx context.Context, prefix string) ([]*BlobInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := []*BlobInfo{}
+	for key, blob := range m.blobs {
+		if strings.HasPrefix(key, prefix) {
+			result = append(result, blob.info(key))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result, nil
+}
+
+// SignedUrl
+func (m *MemoryBlobStore) SignedUrl(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	_, err := m.Stat(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	return m.signer.SignedUrl(key, ttl), nil
+}
+
+// VerifySignedUrl
+func (m *MemoryBlobStore) VerifySignedUrl(key string, query url.Values) error {
+	return m.signer.Verify(key, query)
+}
+
+func (b memoryBlob) info(key string) *BlobInfo {
+	return &BlobInfo{
+		Key:         key,
+		Size:        int64(len(b.data)),
+		ContentType: b.contentType,
+		Public:      b.public,
+		UpdatedAt:   b.updatedAt,
+	}
+}
@@ -0,0 +1,216 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sthl/config"
+	"sthl/constants"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"go.uber.org/zap"
+)
+
+// s3PublicMetaKey: user metadata marking object uploaded public read
+const s3PublicMetaKey = "Public"
+
+// S3BlobStore: objects in bucket of s3 compatible storage
+type S3BlobStore struct {
+	logger   *zap.Logger
+	bucket   string
+	s3svc    *s3.S3
+	uploader *s3manager.Uploader
+}
+
+// NewS3BlobStore: fail if bucket not reachable within constants.BlobProbeDuration
+func NewS3BlobStore(logger *zap.Logger, cfg *config.Config) (*S3BlobStore, error) {
+	keyId, err := cfg.GetAwsAccessKeyId()
+	if err != nil {
+		return nil, err
+	}
+	secret, err := cfg.GetAwsSecretAccessKey()
+	if err != nil {
+		return nil, err
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials(keyId, secret, ""),
+		S3ForcePathStyle: aws.Bool(true),
+		Region:           aws.String(cfg.GetAwsRegion()),
+		Endpoint:         aws.String(cfg.GetS3Path()),
+	})
+	if err != nil {
+		logger.Info("Failed to session.NewSession", zap.Error(err))
+		return nil, err
+	}
+
+	s3Client := s3.New(sess)
+	bucket := cfg.GetBlobBucket()
+	ctx, cancel := context.WithTimeout(context.Background(), constants.BlobProbeDuration)
+	defer cancel()
+
+	// GetS3Path() == "" represent using default generated endpoint by aws sdk.
+	// GetS3Path() != "" represent using localstack s3, create bucket every time
+	if cfg.GetS3Path() != "" {
+		_, err := s3Client.CreateBucketWithContext(ctx, &s3.CreateBucketInput{
+			ACL:    aws.String(s3.BucketCannedACLPublicRead),
+			Bucket: aws.String(bucket),
+		})
+		var aerr awserr.Error
+		if err != nil && !(errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeBucketAlreadyOwnedByYou) {
+			logger.Info("Failed to CreateBucket", zap.Error(err))
+		}
+	}
+
+	_, err = s3Client.HeadBucketWithContext(ctx, &s3.HeadBucketInput{Bucket: aws.String(bucket)})
+	if err != nil {
+		logger.Info("Failed to HeadBucket", zap.String("bucket", bucket), zap.Error(err))
+		return nil, fmt.Errorf("s3 bucket %s unreachable: %w", bucket, err)
+	}
+	logger.Info("s3 blob store ready", zap.String("bucket", bucket))
+
+	return &S3BlobStore{
+		logger:   logger,
+		bucket:   bucket,
+		s3svc:    s3Client,
+		uploader: s3manager.NewUploader(sess),
+	}, nil
+}
+
+// Put: public object with public read acl, private object with private acl
+func (s *S3BlobStore) Put(ctx context.Context, key string, body io.Reader, contentType string, public bool) (string, error) {
+	err := checkBlobKey(key)
+	if err != nil {
+		return "", err
+	}
+	acl := s3.ObjectCannedACLPrivate
+	if public {
+		acl = s3.ObjectCannedACLPublicRead
+	}
+	result, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		ACL:         aws.String(acl),
+		ContentType: aws.String(contentType),
+		Metadata:    map[string]*string{s3PublicMetaKey: aws.String(strconv.FormatBool(public))},
+		Body:        body,
+	})
+	if err != nil {
+		s.logger.Info("fail to uploader.Upload", zap.String("key", key), zap.Error(err))
+		return "", err
+	}
+	return result.Location, nil
+}
+
+// Get
+func (s *S3BlobStore) Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error) {
+	output, err := s.s3svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, nil, s.handleS3Err(err)
+	}
+	info := &BlobInfo{
+		Key:         key,
+		Size:        aws.Int64Value(output.ContentLength),
+		ContentType: aws.StringValue(output.ContentType),
+		Public:      aws.StringValue(output.Metadata[s3PublicMetaKey]) == "true",
+		UpdatedAt:   aws.TimeValue(output.LastModified),
+	}
+	return output.Body, info, nil
+}
+
+// Delete
+func (s *S3BlobStore) Delete(ctx context.Context, key string) error {
+	_, err := s.Stat(ctx, key)
+	if err != nil {
+		return err
+	}
+	_, err = s.s3svc.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return s.handleS3Err(err)
+	}
+	return nil
+}
+
+// Stat
+func (s *S3BlobStore) Stat(ctx context.Context, key string) (*BlobInfo, error) {
+	output, err := s.s3svc.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, s.handleS3Err(err)
+	}
+	return &BlobInfo{
+		Key:         key,
+		Size:        aws.Int64Value(output.ContentLength),
+		ContentType: aws.StringValue(output.ContentType),
+		Public:      aws.StringValue(output.Metadata[s3PublicMetaKey]) == "true",
+		UpdatedAt:   aws.TimeValue(output.LastModified),
+	}, nil
+}
+
+// List: objects with key prefix, ordered by key
+func (s *S3BlobStore) List(ctx context.Context, prefix string) ([]*BlobInfo, error) {
+	result := []*BlobInfo{}
+	err := s.s3svc.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			result = append(result, &BlobInfo{
+				Key:       aws.StringValue(object.Key),
+				Size:      aws.Int64Value(object.Size),
+				UpdatedAt: aws.TimeValue(object.LastModified),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, s.handleS3Err(err)
+	}
+	return result, nil
+}
+
+// SignedUrl: presigned get of object
+func (s *S3BlobStore) SignedUrl(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	_, err := s.Stat(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	req, _ := s.s3svc.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	signed, err := req.Presign(ttl)
+	if err != nil {
+		s.logger.Info("fail to req.Presign", zap.Error(err))
+		return "", err
+	}
+	return signed, nil
+}
+
+// handleS3Err: missing object as constants.ErrNotFound
+func (s *S3BlobStore) handleS3Err(err error) error {
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		switch aerr.Code() {
+		case s3.ErrCodeNoSuchKey, "NotFound":
+			return constants.ErrNotFound
+		}
+	}
+	s.logger.Info("s3 err", zap.Error(err))
+	return err
+}
@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"sthl/constants"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// Test_BlobStore: same behaviour of local and memory store
+func Test_BlobStore(t *testing.T) {
+	local, err := NewLocalBlobStore(zap.NewNop(), t.TempDir(), "http://localhost:4000", []byte("testsecret"))
+	assert.NoError(t, err)
+	stores := map[string]ServedBlobStore{
+		constants.BlobStore.Local:  local,
+		constants.BlobStore.Memory: NewMemoryBlobStore("http://localhost:4000", []byte("testsecret")),
+	}
+	for name, store := range stores {
+		store := store
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			ctx := context.TODO()
+
+			// put and get
+			imgUrl, err := store.Put(ctx, "owner/a", strings.NewReader("hello"), "image/png", true)
+			assert.NoError(err)
+			assert.Equal("http://localhost:4000"+constants.BlobPath+"/owner/a", imgUrl)
+			body, info, err := store.Get(ctx, "owner/a")
+			assert.NoError(err)
+			data, err := io.ReadAll(body)
+			assert.NoError(err)
+			assert.NoError(body.Close())
+			assert.Equal("hello", string(data))
+			assert.Equal(int64(5), info.Size)
+			assert.Equal("image/png", info.ContentType)
+			assert.True(info.Public)
+
+			// put replaces object of same key, private now
+			_, err = store.Put(ctx, "owner/a", strings.NewReader("hello world"), "image/jpeg", false)
+			assert.NoError(err)
+			info, err = store.Stat(ctx, "owner/a")
+			assert.NoError(err)
+			assert.Equal(int64(11), info.Size)
+			assert.Equal("image/jpeg", info.ContentType)
+			assert.False(info.Public)
+
+			// list by prefix ordered by key
+			_, err = store.Put(ctx, "owner/b", strings.NewReader("b"), "image/png", true)
+			assert.NoError(err)
+			_, err = store.Put(ctx, "other/c", strings.NewReader("c"), "image/png", true)
+			assert.NoError(err)
+			list, err := store.List(ctx, "owner/")
+			assert.NoError(err)
+			assert.Len(list, 2)
+			assert.Equal("owner/a", list[0].Key)
+			assert.Equal("owner/b", list[1].Key)
+
+			// signed url of key only
+			signedUrl, err := store.SignedUrl(ctx, "owner/a", time.Minute)
+			assert.NoError(err)
+			parsed, err := url.Parse(signedUrl)
+			assert.NoError(err)
+			assert.NoError(store.VerifySignedUrl("owner/a", parsed.Query()))
+			assert.ErrorIs(store.VerifySignedUrl("owner/b", parsed.Query()), constants.ErrForbidden)
+			assert.ErrorIs(store.VerifySignedUrl("owner/a", url.Values{}), constants.ErrForbidden)
+			_, err = store.SignedUrl(ctx, "owner/missing", time.Minute)
+			assert.ErrorIs(err, constants.ErrNotFound)
+
+			// delete
+			assert.NoError(store.Delete(ctx, "owner/a"))
+			_, err = store.Stat(ctx, "owner/a")
+			assert.ErrorIs(err, constants.ErrNotFound)
+			_, _, err = store.Get(ctx, "owner/a")
+			assert.ErrorIs(err, constants.ErrNotFound)
+			assert.ErrorIs(store.Delete(ctx, "owner/a"), constants.ErrNotFound)
+
+			// keys out of store rejected
+			for _, key := range []string{"", "/abs", "../escape", "owner/../../escape", "owner/.meta", "owner/"} {
+				_, err = store.Put(ctx, key, strings.NewReader("x"), "image/png", true)
+				assert.ErrorIs(err, constants.ErrBadRequest, key)
+			}
+		})
+	}
+}
+
+// Test_BlobUrlSignerExpired
+func Test_BlobUrlSignerExpired(t *testing.T) {
+	assert := assert.New(t)
+	signer := newBlobUrlSigner("http://localhost:4000", []byte("testsecret"))
+	signedUrl := signer.SignedUrl("owner/a", time.Minute)
+	parsed, err := url.Parse(signedUrl)
+	assert.NoError(err)
+	assert.NoError(signer.Verify("owner/a", parsed.Query()))
+
+	signer.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	assert.ErrorIs(signer.Verify("owner/a", parsed.Query()), constants.ErrForbidden)
+
+	other := newBlobUrlSigner("http://localhost:4000", []byte("othersecret"))
+	assert.ErrorIs(other.Verify("owner/a", parsed.Query()), constants.ErrForbidden)
+	assert.ErrorIs(other.Verify("owner/a", url.Values{"expires": []string{"x"}}), constants.ErrForbidden)
+}
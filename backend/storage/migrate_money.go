@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sthl/money"
+
+	"go.uber.org/zap"
+)
+
+// moneyColumn: float column converted to integer with scale
+type moneyColumn struct {
+	table  string
+	column string
+	scale  int64
+}
+
+// moneyColumns: amounts to minor units, rates to parts per million
+var moneyColumns = []moneyColumn{
+	{"products", "price", money.AmountScale},
+	{"order_items", "purchased_price", money.AmountScale},
+	{"orders", "discount", money.RateScale},
+	{"orders", "total_amount", money.AmountScale},
+	{"orders", "subtotal", money.AmountScale},
+	{"orders", "discount_amount", money.AmountScale},
+	{"orders", "tax_rate", money.RateScale},
+	{"orders", "tax_amount", money.AmountScale},
+	{"orders", "shipping_fee", money.AmountScale},
+	{"shops", "tax_rate", money.RateScale},
+	{"shops", "shipping_fee", money.AmountScale},
+}
+
+// migrateMoneyColumns: convert float money columns of existing db to bigint before ent auto migrate,
+// ent would otherwise cast 12.34 to 12, no-op on fresh or already migrated db
+func migrateMoneyColumns(ctx context.Context, l *zap.Logger, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, c := range moneyColumns {
+		var dataType string
+		err := tx.QueryRowContext(ctx,
+			"SELECT data_type FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2",
+			c.table, c.column).Scan(&dataType)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if dataType != "double precision" {
+			continue
+		}
+
+		// numeric cast keeps shortest decimal of float, round half away from zero
+		l.Info("migrating money column", zap.String("table", c.table), zap.String("column", c.column))
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(
+			`ALTER TABLE %q ALTER COLUMN %q DROP DEFAULT, ALTER COLUMN %q TYPE bigint USING round(%q::numeric * %d)::bigint`,
+			c.table, c.column, c.column, c.column, c.scale))
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sthl/constants"
+
+	atlas "ariga.io/atlas/sql/schema"
+	entschema "entgo.io/ent/dialect/sql/schema"
+	"go.uber.org/zap"
+)
+
+// productSearchIndex: gin index of generated search column
+const productSearchIndex = "product_search_vector"
+
+// productNameTrgmIndex: gin trigram index of name for similarity fallback
+const productNameTrgmIndex = "product_name_trgm"
+
+// migrateProductSearch: generated tsvector column of products, name weighted over description,
+// with gin index, and pg_trgm with trigram index of name for typo fallback, no-op if exist
+func migrateProductSearch(ctx context.Context, l *zap.Logger, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	l.Info("migrating product search column")
+	statements := []string{
+		`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+		fmt.Sprintf(
+			`ALTER TABLE "products" ADD COLUMN IF NOT EXISTS %q tsvector GENERATED ALWAYS AS (`+
+				`setweight(to_tsvector('%[2]s', coalesce("name", '')), 'A') || `+
+				`setweight(to_tsvector('%[2]s', coalesce("description", '')), 'B')) STORED`,
+			constants.ProductSearchColumn, constants.ProductSearchConfig),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %q ON "products" USING GIN (%q)`,
+			productSearchIndex, constants.ProductSearchColumn),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %q ON "products" USING GIN ("name" gin_trgm_ops)`,
+			productNameTrgmIndex),
+	}
+	for _, statement := range statements {
+		_, err = tx.ExecContext(ctx, statement)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// keepProductSearch: search column and indexes are not in ent schema, skip their drop
+// by ent auto migrate with drop column and drop index
+func keepProductSearch(next entschema.Differ) entschema.Differ {
+	return entschema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+		for _, change := range changes {
+			modify, ok := change.(*atlas.ModifyTable)
+			if !ok || modify.T.Name != "products" {
+				continue
+			}
+			kept := make([]atlas.Change, 0, len(modify.Changes))
+			for _, c := range modify.Changes {
+				switch c := c.(type) {
+				case *atlas.DropColumn:
+					if c.C.Name == constants.ProductSearchColumn {
+						continue
+					}
+				case *atlas.DropIndex:
+					if c.I.Name == productSearchIndex || c.I.Name == productNameTrgmIndex {
+						continue
+					}
+				}
+				kept = append(kept, c)
+			}
+			modify.Changes = kept
+		}
+		return changes, nil
+	})
+}
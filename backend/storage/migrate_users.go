@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sthl/constants"
+
+	"go.uber.org/zap"
+)
+
+// tableExists: table in current schema
+func tableExists(ctx context.Context, tx *sql.Tx, table string) (bool, error) {
+	var exists bool
+	err := tx.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1)",
+		table).Scan(&exists)
+	return exists, err
+}
+
+// columnExists: column of table in current schema
+func columnExists(ctx context.Context, tx *sql.Tx, table string, column string) (bool, error) {
+	var exists bool
+	err := tx.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2)",
+		table, column).Scan(&exists)
+	return exists, err
+}
+
+// migrateVerifiedUsers: users created before email verification was deployed were never sent a token,
+// mark them verified before ent auto migrate creates the token table, no-op on fresh or already migrated db
+func migrateVerifiedUsers(ctx context.Context, l *zap.Logger, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	usersExist, err := tableExists(ctx, tx, "users")
+	if err != nil {
+		return err
+	}
+	tokensExist, err := tableExists(ctx, tx, "email_verification_tokens")
+	if err != nil {
+		return err
+	}
+	if !usersExist || tokensExist {
+		return nil
+	}
+
+	l.Info("marking existing users email verified")
+	_, err = tx.ExecContext(ctx, `UPDATE "users" SET "email_verified" = true`)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// migrateGrandfatheredPlans: users created before plans were deployed had no limits below the Max ones,
+// add plan column as pro for them before ent auto migrate would add it as free, new users default to free,
+// no-op on fresh or already migrated db
+func migrateGrandfatheredPlans(ctx context.Context, l *zap.Logger, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	usersExist, err := tableExists(ctx, tx, "users")
+	if err != nil {
+		return err
+	}
+	if !usersExist {
+		return nil
+	}
+	planExists, err := columnExists(ctx, tx, "users", "plan")
+	if err != nil {
+		return err
+	}
+	if planExists {
+		return nil
+	}
+
+	l.Info("grandfathering existing users on pro plan")
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE "users" ADD COLUMN "plan" character varying(16) NOT NULL DEFAULT '%s'`, constants.Plan.Pro))
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE "users" ALTER COLUMN "plan" SET DEFAULT '%s'`, constants.Plan.Free))
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
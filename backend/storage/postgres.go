@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
 	"go.uber.org/zap"
 )
 
@@ -22,9 +24,31 @@ func NewPostgresDb(l *zap.Logger, c *config.Config) (*ent.Client, error) {
 		"sslmode=disable",
 	}
 	joinedDataSourceName := strings.Join(dataSourceName, " ")
-	client, err := ent.Open(dialect.Postgres, joinedDataSourceName)
+	drv, err := entsql.Open(dialect.Postgres, joinedDataSourceName)
 	if err != nil {
-		l.Info("ent.Open err", zap.Error(err))
+		l.Info("entsql.Open err", zap.Error(err))
+		return nil, err
+	}
+	client := ent.NewClient(ent.Driver(drv))
+
+	// money columns data migration, float to integer minor units
+	err = migrateMoneyColumns(context.TODO(), l, drv.DB())
+	if err != nil {
+		l.Info("money columns migrate err", zap.Error(err))
+		return nil, err
+	}
+
+	// existing users verified, before token table created
+	err = migrateVerifiedUsers(context.TODO(), l, drv.DB())
+	if err != nil {
+		l.Info("verified users migrate err", zap.Error(err))
+		return nil, err
+	}
+
+	// existing users on pro plan, before ent adds plan column as free
+	err = migrateGrandfatheredPlans(context.TODO(), l, drv.DB())
+	if err != nil {
+		l.Info("grandfathered plans migrate err", zap.Error(err))
 		return nil, err
 	}
 
@@ -34,11 +58,19 @@ func NewPostgresDb(l *zap.Logger, c *config.Config) (*ent.Client, error) {
 		migrate.WithGlobalUniqueID(true),
 		migrate.WithDropIndex(true),
 		migrate.WithDropColumn(true),
+		schema.WithDiffHook(keepProductSearch),
 	)
 	if err != nil {
 		l.Info("ent auto migrate err", zap.Error(err))
 		return nil, err
 	}
 	l.Info("ent auto migration finished")
+
+	// product full-text search column, after products table created
+	err = migrateProductSearch(context.TODO(), l, drv.DB())
+	if err != nil {
+		l.Info("product search migrate err", zap.Error(err))
+		return nil, err
+	}
 	return client, nil
 }
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"net/http"
+	"sthl/constants"
+	"strconv"
+	"strings"
+)
+
+// FormatETag: version as quoted entity tag
+func FormatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// SetETag: ETag header of entity version, must be set before response sent
+func SetETag(rw http.ResponseWriter, version int64) {
+	rw.Header().Set("ETag", FormatETag(version))
+}
+
+// ParseETag: entity tag to version, weak tag accepted since version is exact
+func ParseETag(etag string) (int64, error) {
+	tag := strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil {
+		return 0, constants.ErrPreconditionFailed
+	}
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version < 1 {
+		return 0, constants.ErrPreconditionFailed
+	}
+	return version, nil
+}
+
+// GetIfMatchVersion: expected version from If-Match header,
+// ErrPreconditionRequired if missing, ErrPreconditionFailed if not a single version tag
+func GetIfMatchVersion(r *http.Request) (int64, error) {
+	ifMatch := strings.TrimSpace(r.Header.Get("If-Match"))
+	if ifMatch == "" {
+		return 0, constants.ErrPreconditionRequired
+	}
+	return ParseETag(ifMatch)
+}
+
+// IsIfNoneMatchAny: If-None-Match: * for create only writes
+func IsIfNoneMatchAny(r *http.Request) bool {
+	return strings.TrimSpace(r.Header.Get("If-None-Match")) == "*"
+}
@@ -3,6 +3,8 @@ package utils
 import (
 	"encoding/json"
 	"io"
+	"net"
+	"net/http"
 )
 
 func GetRequestBody[T any](payload io.ReadCloser) (*T, error) {
@@ -15,3 +17,12 @@ func GetRequestBody[T any](payload io.ReadCloser) (*T, error) {
 	}
 	return decodedPayload, nil
 }
+
+// GetClientIp: remote ip without port, RealIp middleware already applied forwarding headers of trusted proxies
+func GetClientIp(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
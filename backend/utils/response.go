@@ -42,13 +42,33 @@ func ResponseSend[T any](rw http.ResponseWriter, code int, msg string, data *T)
 		res.Data = nil
 		res.Send(rw)
 	case http.StatusForbidden:
-		res.Msg = "forbidden"
+		if res.Msg == "" {
+			res.Msg = "forbidden"
+		}
 		res.Data = nil
 		res.Send(rw)
 	case http.StatusNotFound:
 		res.Msg = "not found"
 		res.Data = nil
 		res.Send(rw)
+	case http.StatusConflict:
+		if res.Msg == "" {
+			res.Msg = "conflict"
+		}
+		res.Data = nil
+		res.Send(rw)
+	case http.StatusPreconditionFailed:
+		res.Msg = "precondition failed"
+		res.Data = nil
+		res.Send(rw)
+	case http.StatusPreconditionRequired:
+		res.Msg = "precondition required"
+		res.Data = nil
+		res.Send(rw)
+	case http.StatusTooManyRequests:
+		res.Msg = "too many requests"
+		res.Data = nil
+		res.Send(rw)
 	default:
 		res.Msg = "internal server error"
 		res.Data = nil
@@ -65,6 +85,20 @@ func HttpErrorResponseSend(w http.ResponseWriter, err error) {
 		ResponseSend[any](w, http.StatusBadRequest, "", nil)
 	case errors.Is(err, constants.ErrUnauthorized):
 		ResponseSend[any](w, http.StatusUnauthorized, "", nil)
+	case errors.Is(err, constants.ErrQuotaExceeded):
+		ResponseSend[any](w, http.StatusForbidden, err.Error(), nil)
+	case errors.Is(err, constants.ErrForbidden):
+		ResponseSend[any](w, http.StatusForbidden, "", nil)
+	case errors.Is(err, constants.ErrTooManyRequest):
+		ResponseSend[any](w, http.StatusTooManyRequests, "", nil)
+	case errors.Is(err, constants.ErrInvalidTransition):
+		ResponseSend[any](w, http.StatusConflict, err.Error(), nil)
+	case errors.Is(err, constants.ErrIdempotencyKeyReused), errors.Is(err, constants.ErrIdempotencyInProgress):
+		ResponseSend[any](w, http.StatusConflict, err.Error(), nil)
+	case errors.Is(err, constants.ErrPreconditionFailed):
+		ResponseSend[any](w, http.StatusPreconditionFailed, "", nil)
+	case errors.Is(err, constants.ErrPreconditionRequired):
+		ResponseSend[any](w, http.StatusPreconditionRequired, "", nil)
 	default:
 		ResponseSend[any](w, http.StatusInternalServerError, "", nil)
 	}
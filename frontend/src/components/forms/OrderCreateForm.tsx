@@ -96,7 +96,7 @@ export default function OrderCreateForm() {
         paymentMethod: values.paymentMethod,
         shippingAddress: values.shippingAddress,
       }
-      const response = await API.postCreateOrder(user.id, payload)
+      const response = await API.postCreateShopOrder(payload)
       if (response.success && response.status === StatusCode.Created) {
         const message = response.data.msg
         refetch()
@@ -71,7 +71,10 @@ export default function DesignPanel(props: IDesignPanelProps) {
       homepageText: userSiteState.uiData.homepageText,
       homepageTextColor: userSiteState.uiData.homepageTextColor,
     }
-    const res = await API.putUpsertUserSiteUiDataById(payload)
+    const res = await API.putUpsertUserSiteUiDataById(
+      userSiteState.userId,
+      payload
+    )
     // console.log('update res:', res)
 
     if (res.status === StatusCode.Ok) {
@@ -45,6 +45,8 @@ interface CustomAxiosResponse<T = any, D = any> extends AxiosResponse<T, D> {
 
 class HttpClient {
   private api: AxiosInstance
+  // entity tags of last read or written resources by url, sent back as If-Match on write
+  private etags = new Map<string, string>()
 
   public constructor(config: AxiosRequestConfig, lang?: string) {
     this.api = axios.create(config)
@@ -62,6 +64,10 @@ class HttpClient {
   private initReponseInterceptor(): void {
     const noramlInterceptor = (param: AxiosResponse): CustomAxiosResponse => {
       const _param = { ...param, success: true }
+      const etag = param.headers?.etag
+      if (etag && param.config.url) {
+        this.etags.set(param.config.url, etag)
+      }
       // console.log(
       //   'Response Interceptors:',
       //   _param.config.method?.toUpperCase(),
@@ -119,6 +125,17 @@ class HttpClient {
     return authHeaders
   }
 
+  // getETag: entity tag from last response of url, undefined if never fetched
+  public getETag(url: string): string | undefined {
+    return this.etags.get(url)
+  }
+
+  // getIfMatch: If-Match of url so write fails on concurrent change instead of overwriting it
+  private getIfMatch(url: string) {
+    const etag = this.etags.get(url)
+    return etag ? { 'If-Match': etag } : {}
+  }
+
   public getUri(config?: AxiosRequestConfig): string {
     return this.api.getUri(config)
   }
@@ -178,7 +195,11 @@ class HttpClient {
   ): Promise<CustomAxiosResponse<T, D>> {
     return this.api.put<T, CustomAxiosResponse<T>, D>(url, data, {
       ...config,
-      headers: { ...config?.headers, ...this.getStorePassport() },
+      headers: {
+        ...this.getIfMatch(url),
+        ...config?.headers,
+        ...this.getStorePassport(),
+      },
     })
   }
 
@@ -189,7 +210,11 @@ class HttpClient {
   ): Promise<CustomAxiosResponse<T, D>> {
     return this.api.patch<T, CustomAxiosResponse<T>, D>(url, data, {
       ...config,
-      headers: { ...config?.headers, ...this.getStorePassport() },
+      headers: {
+        ...this.getIfMatch(url),
+        ...config?.headers,
+        ...this.getStorePassport(),
+      },
     })
   }
 
@@ -199,7 +224,11 @@ class HttpClient {
   ): Promise<CustomAxiosResponse<T, D>> {
     return this.api.delete<T, CustomAxiosResponse<T>, D>(url, {
       ...config,
-      headers: { ...config?.headers, ...this.getStorePassport() },
+      headers: {
+        ...this.getIfMatch(url),
+        ...config?.headers,
+        ...this.getStorePassport(),
+      },
     })
   }
 }
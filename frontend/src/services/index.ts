@@ -32,6 +32,7 @@ import {
 } from './product'
 import {
   postCreateOrder,
+  postCreateShopOrder,
   getOrders,
   getOrderById,
   putUpdateOrderById,
@@ -61,6 +62,7 @@ const API = {
   deleteProductById,
   // order
   postCreateOrder,
+  postCreateShopOrder,
   getOrders,
   getOrderById,
   putUpdateOrderById,
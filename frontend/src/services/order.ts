@@ -31,15 +31,20 @@ type IUpdateOrderForm = Pick<
   | 'deliveryStatus'
 >
 
+// storefront checkout, list price only, server prices tax and shipping
 async function postCreateOrder(userId: string, payload: ICreateOrderForm) {
   return httpClient.post<IServerResponse<IOrder>, ICreateOrderForm>(
     `/api/v1/orders/${userId}`,
     payload
   )
-  // return httpClient.postWithAuth<IServerResponse<IOrder>, ICreateOrderForm>(
-  //   `/api/v1/orders`,
-  //   payload
-  // )
+}
+
+// order entered by staff in cms, discount allowed
+async function postCreateShopOrder(payload: ICreateOrderForm) {
+  return httpClient.postWithAuth<IServerResponse<IOrder>, ICreateOrderForm>(
+    `/api/v1/orders`,
+    payload
+  )
 }
 
 async function getOrders(payload: IPagingQuery) {
@@ -72,6 +77,7 @@ async function deleteOrderById(value: string) {
 export type { ICreateOrderItem, ICreateOrderForm, IUpdateOrderForm }
 export {
   postCreateOrder,
+  postCreateShopOrder,
   getOrders,
   getOrderById,
   putUpdateOrderById,
@@ -9,10 +9,16 @@ type IUpsertSiteUiForm = Pick<
 async function getUserSiteUiDataById(userId: string) {
   return httpClient.get<IServerResponse<ISiteUi>>(`/api/v1/siteui/${userId}`)
 }
-async function putUpsertUserSiteUiDataById(payload: IUpsertSiteUiForm) {
+// update siteui last fetched, create it if never fetched
+async function putUpsertUserSiteUiDataById(
+  userId: string,
+  payload: IUpsertSiteUiForm
+) {
+  const etag = httpClient.getETag(`/api/v1/siteui/${userId}`)
   return httpClient.putWithAuth<IServerResponse<ISiteUi>, IUpsertSiteUiForm>(
     '/api/v1/siteui',
-    payload
+    payload,
+    { headers: etag ? { 'If-Match': etag } : { 'If-None-Match': '*' } }
   )
 }
 
@@ -11,7 +11,8 @@ export function calculateOrderTotalAmount(
     0
   )
   const total = subTotal * discount
-  return total
+  // whole cents, api accepts at most two fraction digits
+  return Math.round(total * 100) / 100
 }
 
 export function getFormattedOrderTotalAmount(